@@ -7,20 +7,64 @@ import (
 	"dennic_api_gateway/api/models/model_booking_service"
 	pb "dennic_api_gateway/genproto/booking_service"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"net/http"
+	"strconv"
 	"time"
 )
 
+func archiveToRes(archive *pb.Archive) *model_booking_service.Archive {
+	return &model_booking_service.Archive{
+		Id:              archive.Id,
+		AppointmentId:   archive.AppointmentId,
+		PatientId:       archive.PatientId,
+		DoctorId:        archive.DoctorId,
+		DepartmentId:    archive.DepartmentId,
+		BranchId:        archive.BranchId,
+		AppointmentDate: archive.AppointmentDate,
+		StartedAt:       archive.StartedAt,
+		EndedAt:         archive.EndedAt,
+		PatientProblem:  archive.PatientProblem,
+		Outcome:         archive.Outcome,
+		PaymentType:     archive.PaymentType,
+		PaymentAmount:   archive.PaymentAmount,
+		Currency:        archive.Currency,
+		InvoiceId:       archive.InvoiceId,
+		CreatedAt:       archive.CreatedAt,
+		UpdatedAt:       e.UpdateTimeFilter(archive.UpdatedAt),
+	}
+}
+
+// archiveError answers with the status matching an archive call.
+func (h *HandlerV1) archiveError(c *gin.Context, err error, method string) bool {
+	switch status.Code(err) {
+	case codes.OK:
+		return false
+	case codes.InvalidArgument:
+		return e.HandleError(c, err, h.log, http.StatusBadRequest, method)
+	case codes.NotFound:
+		return e.HandleError(c, err, h.log, http.StatusNotFound, method)
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return e.HandleError(c, err, h.log, http.StatusConflict, method)
+	}
+	return e.HandleError(c, err, h.log, http.StatusInternalServerError, method)
+}
+
 // CreateArchive ...
 // @Summary CreateArchive
-// @Description CreateArchive - Api for crete archive
+// @Description CreateArchive - Api for close out a booked appointment.
+// @Description The appointment takes the outcome as its status. Empty times default to the booked slot;
+// @Description with no payment_type the payment is taken from the appointment's invoice.
 // @Tags Archive
 // @Accept json
 // @Produce json
 // @Param CreateArchiveReq body model_booking_service.CreateArchiveReq true "CreateArchiveReq"
 // @Success 200 {object} model_booking_service.Archive
 // @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
 // @Router /v1/archive [post]
 func (h *HandlerV1) CreateArchive(c *gin.Context) {
@@ -40,31 +84,20 @@ func (h *HandlerV1) CreateArchive(c *gin.Context) {
 	defer cancel()
 
 	archive, err := h.serviceManager.BookingService().ArchiveService().CreateArchive(ctx, &pb.CreateArchiveReq{
-		DoctorAvailabilityId: body.DoctorAvailabilityId,
-		StartTime:            body.StartTime,
-		EndTime:              body.EndTime,
-		PatientProblem:       body.PatientProblem,
-		Status:               body.Status,
-		PaymentType:          body.PaymentType,
-		PaymentAmount:        float32(body.PaymentAmount),
+		AppointmentId:  body.AppointmentId,
+		StartedAt:      body.StartedAt,
+		EndedAt:        body.EndedAt,
+		PatientProblem: body.PatientProblem,
+		Outcome:        body.Outcome,
+		PaymentType:    body.PaymentType,
+		PaymentAmount:  body.PaymentAmount,
 	})
 
-	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateArchive") {
+	if h.archiveError(c, err, "CreateArchive") {
 		return
 	}
 
-	c.JSON(http.StatusOK, model_booking_service.Archive{
-		Id:                   archive.Id,
-		DoctorAvailabilityId: archive.DoctorAvailabilityId,
-		StartTime:            archive.StartTime,
-		EndTime:              archive.EndTime,
-		PatientProblem:       archive.PatientProblem,
-		Status:               archive.Status,
-		PaymentType:          archive.PaymentType,
-		PaymentAmount:        float64(archive.PaymentAmount),
-		CreatedAt:            archive.CreatedAt,
-		UpdatedAt:            e.UpdateTimeFilter(archive.UpdatedAt),
-	})
+	c.JSON(http.StatusOK, archiveToRes(archive))
 }
 
 // GetArchive ...
@@ -90,22 +123,11 @@ func (h *HandlerV1) GetArchive(c *gin.Context) {
 		IsActive: false,
 	})
 
-	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetArchive") {
+	if h.archiveError(c, err, "GetArchive") {
 		return
 	}
 
-	c.JSON(http.StatusOK, model_booking_service.Archive{
-		Id:                   archive.Id,
-		DoctorAvailabilityId: archive.DoctorAvailabilityId,
-		StartTime:            archive.StartTime,
-		EndTime:              archive.EndTime,
-		PatientProblem:       archive.PatientProblem,
-		Status:               archive.Status,
-		PaymentType:          archive.PaymentType,
-		PaymentAmount:        float64(archive.PaymentAmount),
-		CreatedAt:            archive.CreatedAt,
-		UpdatedAt:            e.UpdateTimeFilter(archive.UpdatedAt),
-	})
+	c.JSON(http.StatusOK, archiveToRes(archive))
 }
 
 // ListArchive ...
@@ -114,8 +136,11 @@ func (h *HandlerV1) GetArchive(c *gin.Context) {
 // @Tags Archive
 // @Accept json
 // @Produce json
-// @Param searchField query string false "searchField" Enums(status)
+// @Param searchField query string false "searchField" Enums(outcome, payment_type)
 // @Param ListReq query models.ListReq false "ListReq"
+// @Param patient_id query string false "patient_id"
+// @Param doctor_id query string false "doctor_id"
+// @Param appointment_id query integer false "appointment_id"
 // @Success 200 {object} model_booking_service.ArchivesType
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -132,42 +157,39 @@ func (h *HandlerV1) ListArchive(c *gin.Context) {
 		return
 	}
 
+	var appointmentId int64
+	if raw := c.Query("appointment_id"); raw != "" {
+		appointmentId, err = strconv.ParseInt(raw, 10, 64)
+		if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListArchive") {
+			return
+		}
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
 	archives, err := h.serviceManager.BookingService().ArchiveService().GetAllArchives(ctx, &pb.GetAllArchivesReq{
-		Field:    field,
-		Value:    value,
-		IsActive: false,
-		Page:     pageInt,
-		Limit:    limitInt,
-		OrderBy:  orderBy,
+		Field:         field,
+		Value:         value,
+		IsActive:      false,
+		Page:          pageInt,
+		Limit:         limitInt,
+		OrderBy:       orderBy,
+		PatientId:     c.Query("patient_id"),
+		DoctorId:      c.Query("doctor_id"),
+		AppointmentId: appointmentId,
 	})
 
-	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListArchive") {
+	if h.archiveError(c, err, "ListArchive") {
 		return
 	}
 
-	var archivesRes model_booking_service.ArchivesType
-	for _, archiveRes := range archives.Archives {
-		var archive model_booking_service.Archive
-		archive.Id = archiveRes.Id
-		archive.DoctorAvailabilityId = archiveRes.DoctorAvailabilityId
-		archive.StartTime = archiveRes.StartTime
-		archive.EndTime = archiveRes.EndTime
-		archive.PatientProblem = archiveRes.PatientProblem
-		archive.Status = archiveRes.Status
-		archive.PaymentType = archiveRes.PaymentType
-		archive.PaymentAmount = float64(archiveRes.PaymentAmount)
-		archive.CreatedAt = archiveRes.CreatedAt
-		archive.UpdatedAt = e.UpdateTimeFilter(archiveRes.UpdatedAt)
-		archivesRes.Archives = append(archivesRes.Archives, &archive)
+	archivesRes := model_booking_service.ArchivesType{Count: archives.Count}
+	for _, archive := range archives.Archives {
+		archivesRes.Archives = append(archivesRes.Archives, archiveToRes(archive))
 	}
 
-	c.JSON(http.StatusOK, model_booking_service.ArchivesType{
-		Count:    archives.Count,
-		Archives: archivesRes.Archives,
-	})
+	c.JSON(http.StatusOK, archivesRes)
 }
 
 // UpdateArchive ...
@@ -198,33 +220,21 @@ func (h *HandlerV1) UpdateArchive(c *gin.Context) {
 	defer cancel()
 
 	archive, err := h.serviceManager.BookingService().ArchiveService().UpdateArchive(ctx, &pb.UpdateArchiveReq{
-		Field:                "id",
-		Value:                body.ArchiveId,
-		DoctorAvailabilityId: body.DoctorAvailabilityId,
-		StartTime:            body.StartTime,
-		EndTime:              body.EndTime,
-		PatientProblem:       body.PatientProblem,
-		Status:               body.Status,
-		PaymentType:          body.PaymentType,
-		PaymentAmount:        float32(body.PaymentAmount),
+		Field:          "id",
+		Value:          body.ArchiveId,
+		StartedAt:      body.StartedAt,
+		EndedAt:        body.EndedAt,
+		PatientProblem: body.PatientProblem,
+		Outcome:        body.Outcome,
+		PaymentType:    body.PaymentType,
+		PaymentAmount:  body.PaymentAmount,
 	})
 
-	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateArchive") {
+	if h.archiveError(c, err, "UpdateArchive") {
 		return
 	}
 
-	c.JSON(http.StatusOK, model_booking_service.Archive{
-		Id:                   archive.Id,
-		DoctorAvailabilityId: archive.DoctorAvailabilityId,
-		StartTime:            archive.StartTime,
-		EndTime:              archive.EndTime,
-		PatientProblem:       archive.PatientProblem,
-		Status:               archive.Status,
-		PaymentType:          archive.PaymentType,
-		PaymentAmount:        float64(archive.PaymentAmount),
-		CreatedAt:            archive.CreatedAt,
-		UpdatedAt:            e.UpdateTimeFilter(archive.UpdatedAt),
-	})
+	c.JSON(http.StatusOK, archiveToRes(archive))
 }
 
 // DeleteArchive ...
@@ -251,7 +261,7 @@ func (h *HandlerV1) DeleteArchive(c *gin.Context) {
 		IsActive: false,
 	})
 
-	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DeleteArchive") {
+	if h.archiveError(c, err, "DeleteArchive") {
 		return
 	}
 
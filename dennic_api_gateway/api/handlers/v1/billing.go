@@ -0,0 +1,540 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models/model_booking_service"
+	pb "dennic_api_gateway/genproto/booking_service"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func invoiceToRes(invoice *pb.Invoice) *model_booking_service.Invoice {
+	res := &model_booking_service.Invoice{
+		Id:            invoice.Id,
+		Number:        invoice.Number,
+		AppointmentId: invoice.AppointmentId,
+		PatientId:     invoice.PatientId,
+		BranchId:      invoice.BranchId,
+		Currency:      invoice.Currency,
+		Subtotal:      invoice.Subtotal,
+		Discount:      invoice.Discount,
+		Total:         invoice.Total,
+		Paid:          invoice.Paid,
+		Refunded:      invoice.Refunded,
+		Balance:       invoice.Balance,
+		Status:        invoice.Status,
+		CreatedBy:     invoice.CreatedBy,
+		IssuedAt:      invoice.IssuedAt,
+		VoidedAt:      invoice.VoidedAt,
+		UpdatedAt:     invoice.UpdatedAt,
+	}
+	for _, item := range invoice.Items {
+		res.Items = append(res.Items, &model_booking_service.InvoiceItem{
+			Id:              item.Id,
+			Kind:            item.Kind,
+			Description:     item.Description,
+			DoctorServiceId: item.DoctorServiceId,
+			Quantity:        item.Quantity,
+			UnitPrice:       item.UnitPrice,
+			Amount:          item.Amount,
+		})
+	}
+	for _, payment := range invoice.Payments {
+		res.Payments = append(res.Payments, &model_booking_service.InvoicePayment{
+			Id:        payment.Id,
+			Kind:      payment.Kind,
+			Method:    payment.Method,
+			Amount:    payment.Amount,
+			CashierId: payment.CashierId,
+			Reference: payment.Reference,
+			CreatedAt: payment.CreatedAt,
+		})
+	}
+	return res
+}
+
+func invoiceItemToPb(item *model_booking_service.InvoiceItemReq) *pb.InvoiceItem {
+	if item == nil {
+		return nil
+	}
+	return &pb.InvoiceItem{
+		Description:     item.Description,
+		DoctorServiceId: item.DoctorServiceId,
+		Quantity:        item.Quantity,
+		UnitPrice:       item.UnitPrice,
+	}
+}
+
+// billingError answers with the status matching a billing call.
+func (h *HandlerV1) billingError(c *gin.Context, err error, method string) bool {
+	switch status.Code(err) {
+	case codes.OK:
+		return false
+	case codes.InvalidArgument:
+		return e.HandleError(c, err, h.log, http.StatusBadRequest, method)
+	case codes.NotFound:
+		return e.HandleError(c, err, h.log, http.StatusNotFound, method)
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return e.HandleError(c, err, h.log, http.StatusConflict, method)
+	}
+	return e.HandleError(c, err, h.log, http.StatusInternalServerError, method)
+}
+
+// CreateInvoice ...
+// @Summary CreateInvoice
+// @Description CreateInvoice - Api for issuing the invoice of an appointment.
+// @Description The booked doctor service is billed at the appointment price; items add procedures done in the visit.
+// @Description Amounts are decimal strings, e.g. "150000.00". An appointment has at most one invoice that is not void.
+// @Tags Billing
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param InvoiceReq body model_booking_service.InvoiceReq true "InvoiceReq"
+// @Success 200 {object} model_booking_service.Invoice
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/invoice [post]
+func (h *HandlerV1) CreateInvoice(c *gin.Context) {
+	if _, ok := h.requireRole(c, "CreateInvoice", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	var body model_booking_service.InvoiceReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateInvoice") {
+		return
+	}
+
+	req := &pb.CreateInvoiceReq{
+		Id:            uuid.NewString(),
+		AppointmentId: body.AppointmentId,
+		Discount:      body.Discount,
+	}
+	for _, item := range body.Items {
+		if item != nil {
+			req.Items = append(req.Items, invoiceItemToPb(item))
+		}
+	}
+	if userInfo, err := e.GetUserInfo(c); err == nil {
+		req.CreatedBy = userInfo.UserId
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Billing().CreateInvoice(ctx, req)
+	if h.billingError(c, err, "CreateInvoice") {
+		return
+	}
+
+	c.JSON(http.StatusOK, invoiceToRes(res))
+}
+
+// GetInvoice ...
+// @Summary GetInvoice
+// @Description GetInvoice - Api for get invoice with its items and payments
+// @Tags Billing
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.Invoice
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/invoice/get [get]
+func (h *HandlerV1) GetInvoice(c *gin.Context) {
+	if _, ok := h.requireRole(c, "GetInvoice", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Billing().GetInvoice(ctx, &pb.InvoiceFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.billingError(c, err, "GetInvoice") {
+		return
+	}
+
+	c.JSON(http.StatusOK, invoiceToRes(res))
+}
+
+// ListInvoices ...
+// @Summary ListInvoices
+// @Description ListInvoices - Api for list invoices
+// @Tags Billing
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param patient_id query string false "patient_id"
+// @Param branch_id query string false "branch_id"
+// @Param appointment_id query int false "appointment_id"
+// @Param status query string false "status" Enums(issued, partially_paid, paid, refunded, void)
+// @Success 200 {object} model_booking_service.InvoicesType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/invoice [get]
+func (h *HandlerV1) ListInvoices(c *gin.Context) {
+	if _, ok := h.requireRole(c, "ListInvoices", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListInvoices") {
+		return
+	}
+
+	var appointmentId int64
+	if value := c.Query("appointment_id"); value != "" {
+		appointmentId, err = strconv.ParseInt(value, 10, 64)
+		if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListInvoices") {
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Billing().GetAllInvoices(ctx, &pb.GetAllInvoicesReq{
+		Page:          pageInt,
+		Limit:         limitInt,
+		PatientId:     c.Query("patient_id"),
+		BranchId:      c.Query("branch_id"),
+		AppointmentId: appointmentId,
+		Status:        c.Query("status"),
+	})
+	if h.billingError(c, err, "ListInvoices") {
+		return
+	}
+
+	invoices := &model_booking_service.InvoicesType{Count: res.Count}
+	for _, invoice := range res.Invoices {
+		invoices.Invoices = append(invoices.Invoices, invoiceToRes(invoice))
+	}
+
+	c.JSON(http.StatusOK, invoices)
+}
+
+// AddInvoiceItem ...
+// @Summary AddInvoiceItem
+// @Description AddInvoiceItem - Api for adding a procedure to an invoice nothing has been paid on yet
+// @Tags Billing
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param InvoiceItemAddReq body model_booking_service.InvoiceItemAddReq true "InvoiceItemAddReq"
+// @Success 200 {object} model_booking_service.Invoice
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/invoice/item [post]
+func (h *HandlerV1) AddInvoiceItem(c *gin.Context) {
+	if _, ok := h.requireRole(c, "AddInvoiceItem", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	var body model_booking_service.InvoiceItemAddReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "AddInvoiceItem") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Billing().AddInvoiceItem(ctx, &pb.AddInvoiceItemReq{
+		InvoiceId: body.InvoiceId,
+		Item:      invoiceItemToPb(body.Item),
+	})
+	if h.billingError(c, err, "AddInvoiceItem") {
+		return
+	}
+
+	c.JSON(http.StatusOK, invoiceToRes(res))
+}
+
+// SetInvoiceDiscount ...
+// @Summary SetInvoiceDiscount
+// @Description SetInvoiceDiscount - Api for setting the discount of an invoice nothing has been paid on yet
+// @Tags Billing
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param InvoiceDiscountReq body model_booking_service.InvoiceDiscountReq true "InvoiceDiscountReq"
+// @Success 200 {object} model_booking_service.Invoice
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/invoice/discount [put]
+func (h *HandlerV1) SetInvoiceDiscount(c *gin.Context) {
+	if _, ok := h.requireRole(c, "SetInvoiceDiscount", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	var body model_booking_service.InvoiceDiscountReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "SetInvoiceDiscount") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Billing().SetInvoiceDiscount(ctx, &pb.SetInvoiceDiscountReq{
+		InvoiceId: body.InvoiceId,
+		Discount:  body.Discount,
+	})
+	if h.billingError(c, err, "SetInvoiceDiscount") {
+		return
+	}
+
+	c.JSON(http.StatusOK, invoiceToRes(res))
+}
+
+// transactionReq binds a payment or refund; the cashier is the caller.
+func (h *HandlerV1) transactionReq(c *gin.Context, method string) (*pb.InvoiceTransactionReq, bool) {
+	var body model_booking_service.InvoiceTransactionReq
+
+	cashier, ok := h.requireRole(c, method, RoleCashier, RoleAdmin)
+	if !ok {
+		return nil, false
+	}
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, method) {
+		return nil, false
+	}
+
+	return &pb.InvoiceTransactionReq{
+		Id:        uuid.NewString(),
+		InvoiceId: body.InvoiceId,
+		Method:    body.Method,
+		Amount:    body.Amount,
+		CashierId: cashier.UserId,
+		Reference: body.Reference,
+	}, true
+}
+
+// RecordPayment ...
+// @Summary RecordPayment
+// @Description RecordPayment - Api for taking a full or partial payment on an invoice.
+// @Description A payment larger than the balance is rejected.
+// @Tags Billing
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param InvoiceTransactionReq body model_booking_service.InvoiceTransactionReq true "InvoiceTransactionReq"
+// @Success 200 {object} model_booking_service.Invoice
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/invoice/payment [post]
+func (h *HandlerV1) RecordPayment(c *gin.Context) {
+	req, ok := h.transactionReq(c, "RecordPayment")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Billing().RecordPayment(ctx, req)
+	if h.billingError(c, err, "RecordPayment") {
+		return
+	}
+
+	c.JSON(http.StatusOK, invoiceToRes(res))
+}
+
+// RefundPayment ...
+// @Summary RefundPayment
+// @Description RefundPayment - Api for refunding money taken on an invoice.
+// @Description Refunds cannot exceed what was paid.
+// @Tags Billing
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param InvoiceTransactionReq body model_booking_service.InvoiceTransactionReq true "InvoiceTransactionReq"
+// @Success 200 {object} model_booking_service.Invoice
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/invoice/refund [post]
+func (h *HandlerV1) RefundPayment(c *gin.Context) {
+	req, ok := h.transactionReq(c, "RefundPayment")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Billing().RefundPayment(ctx, req)
+	if h.billingError(c, err, "RefundPayment") {
+		return
+	}
+
+	c.JSON(http.StatusOK, invoiceToRes(res))
+}
+
+// VoidInvoice ...
+// @Summary VoidInvoice
+// @Description VoidInvoice - Api for voiding an invoice nothing has been paid on
+// @Tags Billing
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.Invoice
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/invoice/void [put]
+func (h *HandlerV1) VoidInvoice(c *gin.Context) {
+	if _, ok := h.requireRole(c, "VoidInvoice", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Billing().VoidInvoice(ctx, &pb.InvoiceFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.billingError(c, err, "VoidInvoice") {
+		return
+	}
+
+	c.JSON(http.StatusOK, invoiceToRes(res))
+}
+
+// GetInvoiceReceipt ...
+// @Summary GetInvoiceReceipt
+// @Description GetInvoiceReceipt - Api for the printable receipt of an invoice
+// @Tags Billing
+// @Produce application/pdf
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {file} file
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/invoice/receipt [get]
+func (h *HandlerV1) GetInvoiceReceipt(c *gin.Context) {
+	if _, ok := h.requireRole(c, "GetInvoiceReceipt", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	receipt, err := h.serviceManager.BookingService().Billing().RenderReceipt(ctx, &pb.InvoiceFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.billingError(c, err, "GetInvoiceReceipt") {
+		return
+	}
+
+	c.Header("Content-Disposition", "inline; filename=\""+receipt.FileName+"\"")
+	c.Data(http.StatusOK, "application/pdf", receipt.Content)
+}
+
+// GetCashDeskReport ...
+// @Summary GetCashDeskReport
+// @Description GetCashDeskReport - Api for the end-of-day cash desk report of a branch,
+// @Description with payments and refunds per cashier and method, and totals per currency.
+// @Tags Billing
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param branch_id query string true "branch_id"
+// @Param date query string true "date" example(2024-05-01)
+// @Param cashier_id query string false "cashier_id"
+// @Success 200 {object} model_booking_service.CashDeskReport
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/cash-desk/report [get]
+func (h *HandlerV1) GetCashDeskReport(c *gin.Context) {
+	if _, ok := h.requireRole(c, "GetCashDeskReport", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Billing().GetCashDeskReport(ctx, &pb.CashDeskReportReq{
+		BranchId:  c.Query("branch_id"),
+		Date:      c.Query("date"),
+		CashierId: c.Query("cashier_id"),
+	})
+	if h.billingError(c, err, "GetCashDeskReport") {
+		return
+	}
+
+	report := &model_booking_service.CashDeskReport{
+		BranchId: res.BranchId,
+		Date:     res.Date,
+	}
+	for _, row := range res.Rows {
+		report.Rows = append(report.Rows, &model_booking_service.CashDeskRow{
+			CashierId:    row.CashierId,
+			Method:       row.Method,
+			Currency:     row.Currency,
+			Payments:     row.Payments,
+			Refunds:      row.Refunds,
+			Net:          row.Net,
+			PaymentCount: row.PaymentCount,
+			RefundCount:  row.RefundCount,
+		})
+	}
+	for _, total := range res.Totals {
+		report.Totals = append(report.Totals, &model_booking_service.CashDeskTotal{
+			Currency: total.Currency,
+			Payments: total.Payments,
+			Refunds:  total.Refunds,
+			Net:      total.Net,
+		})
+	}
+
+	c.JSON(http.StatusOK, report)
+}
@@ -6,22 +6,31 @@ import (
 	"dennic_api_gateway/api/models"
 	"dennic_api_gateway/api/models/model_booking_service"
 	pb "dennic_api_gateway/genproto/booking_service"
+	pbh "dennic_api_gateway/genproto/healthcare-service"
+	"errors"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // CreateBookedAppointment ...
 // @Summary CreateBookedAppointment
-// @Description CreateBookedAppointment - Api for create booked appointment
+// @Description CreateBookedAppointment - Api for create booked appointment.
+// @Description When doctor_service_id refers to a service that needs equipment, a free resource of that type in the branch is reserved together with the doctor.
+// @Description The price in force for the doctor service and the modality (offline by default, or online) is stored with the appointment.
+// @Description With insurance_policy_id the booking is refused with 409 unless the policy pays for the service on that day; the answer then tells the co-pay.
 // @Tags Appointment
 // @Accept json
 // @Produce json
 // @Param CreateAppointmentReq body model_booking_service.CreateAppointmentReq true "CreateAppointmentReq"
 // @Success 200 {object} model_booking_service.Appointment
 // @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
 // @Router /v1/appointment [post]
 func (h *HandlerV1) CreateBookedAppointment(c *gin.Context) {
@@ -40,18 +49,81 @@ func (h *HandlerV1) CreateBookedAppointment(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
-	res, err := h.serviceManager.BookingService().BookedAppointment().CreateAppointment(ctx, &pb.CreateAppointmentReq{
-		DepartmentId:    body.DepartmentId,
-		DoctorId:        body.DoctorId,
-		PatientId:       body.PatientId,
-		AppointmentDate: body.AppointmentDate,
-		AppointmentTime: body.AppointmentTime,
-		Duration:        body.Duration,
-		Key:             body.Key,
-		ExpiresAt:       body.ExpiresAt,
-		PatientStatus:   false,
-	})
+	if body.Modality == "" {
+		body.Modality = "offline"
+	}
+
+	var insurance *model_booking_service.Eligibility
+	if body.InsurancePolicyId != "" {
+		if body.DoctorServiceId == "" {
+			e.HandleError(c, errors.New("doctor_service_id is required to book on insurance"), h.log, http.StatusBadRequest, "CreateBookedAppointment")
+			return
+		}
+		servicePrice, err := h.serviceManager.HealthcareService().DoctorsService().GetEffectivePrice(ctx, &pbh.GetEffectivePriceReq{
+			DoctorServiceId: body.DoctorServiceId,
+			Modality:        body.Modality,
+		})
+		if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateBookedAppointment") {
+			return
+		}
+		eligibility, err := h.serviceManager.BookingService().Insurance().CheckEligibility(ctx, &pb.EligibilityReq{
+			PatientId:       body.PatientId,
+			PolicyId:        body.InsurancePolicyId,
+			DoctorServiceId: body.DoctorServiceId,
+			Price:           servicePrice.Amount,
+			Currency:        servicePrice.Currency,
+			Date:            body.AppointmentDate,
+		})
+		if h.insuranceError(c, err, "CreateBookedAppointment") {
+			return
+		}
+		if !eligibility.Eligible {
+			e.HandleError(c, errors.New("not covered by the insurance policy: "+eligibility.Reason), h.log, http.StatusConflict, "CreateBookedAppointment")
+			return
+		}
+		insurance = eligibilityToRes(eligibility)
+	}
 
+	candidates := []string{body.ResourceId}
+	if body.ResourceId == "" && body.DoctorServiceId != "" {
+		candidates, err = h.resourceCandidates(ctx, body.DoctorServiceId, body.BranchId)
+		if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateBookedAppointment") {
+			return
+		}
+		if len(candidates) == 0 {
+			e.HandleError(c, errors.New("no equipment of the required type in this branch"), h.log, http.StatusConflict, "CreateBookedAppointment")
+			return
+		}
+	}
+
+	// Each candidate resource is tried in turn; the booking service rejects a
+	// resource (or doctor) that is already taken for that time with AlreadyExists.
+	var res *pb.Appointment
+	for _, resourceId := range candidates {
+		res, err = h.serviceManager.BookingService().BookedAppointment().CreateAppointment(ctx, &pb.CreateAppointmentReq{
+			DepartmentId:    body.DepartmentId,
+			BranchId:        body.BranchId,
+			DoctorId:        body.DoctorId,
+			ResourceId:      resourceId,
+			PatientId:       body.PatientId,
+			AppointmentDate: body.AppointmentDate,
+			AppointmentTime: body.AppointmentTime,
+			Duration:        body.Duration,
+			Key:             body.Key,
+			ExpiresAt:       body.ExpiresAt,
+			PatientStatus:   false,
+			Modality:        body.Modality,
+			DoctorServiceId: body.DoctorServiceId,
+		})
+		if status.Code(err) != codes.AlreadyExists {
+			break
+		}
+	}
+
+	if status.Code(err) == codes.AlreadyExists {
+		e.HandleError(c, err, h.log, http.StatusConflict, "CreateBookedAppointment")
+		return
+	}
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateBookedAppointment") {
 		return
 	}
@@ -59,7 +131,9 @@ func (h *HandlerV1) CreateBookedAppointment(c *gin.Context) {
 	c.JSON(http.StatusOK, model_booking_service.Appointment{
 		Id:              res.Id,
 		DepartmentId:    res.DepartmentId,
+		BranchId:        res.BranchId,
 		DoctorId:        res.DoctorId,
+		ResourceId:      res.ResourceId,
 		PatientId:       res.PatientId,
 		AppointmentDate: res.AppointmentDate,
 		AppointmentTime: res.AppointmentTime,
@@ -67,11 +141,53 @@ func (h *HandlerV1) CreateBookedAppointment(c *gin.Context) {
 		Key:             res.Key,
 		ExpiresAt:       res.ExpiresAt,
 		PatientStatus:   res.PatientStatus,
+		Modality:        res.Modality,
+		DoctorServiceId: res.DoctorServiceId,
+		Price:           res.Price,
+		Currency:        res.Currency,
+		VideoRoomId:     res.VideoRoomId,
+		VideoProvider:   res.VideoProvider,
+		Status:          res.Status,
 		CreatedAt:       res.CreatedAt,
 		UpdatedAt:       e.UpdateTimeFilter(res.UpdatedAt),
+		Insurance:       insurance,
 	})
 }
 
+// resourceCandidates lists the branch resources that can serve the doctor
+// service. A service without a required resource type yields a single empty
+// candidate, i.e. the appointment reserves only the doctor.
+func (h *HandlerV1) resourceCandidates(ctx context.Context, doctorServiceId, branchId string) ([]string, error) {
+	doctorService, err := h.serviceManager.HealthcareService().DoctorsService().GetDoctorServiceByID(ctx, &pbh.GetReqStr{
+		Field:    "id",
+		Value:    doctorServiceId,
+		IsActive: false,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if doctorService.RequiredResourceType == "" {
+		return []string{""}, nil
+	}
+
+	resources, err := h.serviceManager.HealthcareService().ResourceService().GetAllResources(ctx, &pbh.GetAllResource{
+		Page:         1,
+		Limit:        100,
+		IsActive:     false,
+		BranchId:     branchId,
+		ResourceType: doctorService.RequiredResourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []string
+	for _, resource := range resources.Resources {
+		candidates = append(candidates, resource.Id)
+	}
+	return candidates, nil
+}
+
 // GetBookedAppointment ...
 // @Summary GetBookedAppointment
 // @Description GetBookedAppointment - API to get Booked appointment by ID
@@ -102,7 +218,9 @@ func (h *HandlerV1) GetBookedAppointment(c *gin.Context) {
 	c.JSON(http.StatusOK, model_booking_service.Appointment{
 		Id:              res.Id,
 		DepartmentId:    res.DepartmentId,
+		BranchId:        res.BranchId,
 		DoctorId:        res.DoctorId,
+		ResourceId:      res.ResourceId,
 		PatientId:       res.PatientId,
 		AppointmentDate: res.AppointmentDate,
 		AppointmentTime: res.AppointmentTime,
@@ -110,6 +228,13 @@ func (h *HandlerV1) GetBookedAppointment(c *gin.Context) {
 		Key:             res.Key,
 		ExpiresAt:       res.ExpiresAt,
 		PatientStatus:   res.PatientStatus,
+		Modality:        res.Modality,
+		DoctorServiceId: res.DoctorServiceId,
+		Price:           res.Price,
+		Currency:        res.Currency,
+		VideoRoomId:     res.VideoRoomId,
+		VideoProvider:   res.VideoProvider,
+		Status:          res.Status,
 		CreatedAt:       res.CreatedAt,
 		UpdatedAt:       e.UpdateTimeFilter(res.UpdatedAt),
 	})
@@ -123,6 +248,7 @@ func (h *HandlerV1) GetBookedAppointment(c *gin.Context) {
 // @Produce json
 // @Param searchField query string false "searchField" Enums(key)
 // @Param ListReq query models.ListReq false "ListReq"
+// @Param branch_id query string false "branch_id"
 // @Success 200 {object} model_booking_service.Appointment
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -133,6 +259,7 @@ func (h *HandlerV1) ListBookedAppointments(c *gin.Context) {
 	limit := c.Query("limit")
 	page := c.Query("page")
 	orderBy := c.Query("orderBy")
+	branchId := c.Query("branch_id")
 
 	pageInt, limitInt, err := e.ParseQueryParams(page, limit)
 	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListBookedAppointments") {
@@ -149,6 +276,7 @@ func (h *HandlerV1) ListBookedAppointments(c *gin.Context) {
 		Page:     pageInt,
 		Limit:    limitInt,
 		OrderBy:  orderBy,
+		BranchId: branchId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListBookedAppointments") {
@@ -160,7 +288,9 @@ func (h *HandlerV1) ListBookedAppointments(c *gin.Context) {
 		var app model_booking_service.Appointment
 		app.Id = appointment.Id
 		app.DepartmentId = appointment.DepartmentId
+		app.BranchId = appointment.BranchId
 		app.DoctorId = appointment.DoctorId
+		app.ResourceId = appointment.ResourceId
 		app.PatientId = appointment.PatientId
 		app.AppointmentDate = appointment.AppointmentDate
 		app.AppointmentTime = appointment.AppointmentTime
@@ -168,6 +298,13 @@ func (h *HandlerV1) ListBookedAppointments(c *gin.Context) {
 		app.Key = appointment.Key
 		app.ExpiresAt = appointment.ExpiresAt
 		app.PatientStatus = appointment.PatientStatus
+		app.Modality = appointment.Modality
+		app.DoctorServiceId = appointment.DoctorServiceId
+		app.Price = appointment.Price
+		app.Currency = appointment.Currency
+		app.VideoRoomId = appointment.VideoRoomId
+		app.VideoProvider = appointment.VideoProvider
+		app.Status = appointment.Status
 		app.CreatedAt = appointment.CreatedAt
 		app.UpdatedAt = e.UpdateTimeFilter(appointment.UpdatedAt)
 		response.Appointments = append(response.Appointments, &app)
@@ -224,7 +361,9 @@ func (h *HandlerV1) UpdateBookedAppointment(c *gin.Context) {
 	c.JSON(http.StatusOK, model_booking_service.Appointment{
 		Id:              res.Id,
 		DepartmentId:    res.DepartmentId,
+		BranchId:        res.BranchId,
 		DoctorId:        res.DoctorId,
+		ResourceId:      res.ResourceId,
 		PatientId:       res.PatientId,
 		AppointmentDate: res.AppointmentDate,
 		AppointmentTime: res.AppointmentTime,
@@ -232,6 +371,13 @@ func (h *HandlerV1) UpdateBookedAppointment(c *gin.Context) {
 		Key:             res.Key,
 		ExpiresAt:       res.ExpiresAt,
 		PatientStatus:   res.PatientStatus,
+		Modality:        res.Modality,
+		DoctorServiceId: res.DoctorServiceId,
+		Price:           res.Price,
+		Currency:        res.Currency,
+		VideoRoomId:     res.VideoRoomId,
+		VideoProvider:   res.VideoProvider,
+		Status:          res.Status,
 		CreatedAt:       res.CreatedAt,
 		UpdatedAt:       e.UpdateTimeFilter(res.UpdatedAt),
 	})
@@ -267,3 +413,237 @@ func (h *HandlerV1) DeleteBookedAppointment(c *gin.Context) {
 
 	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
 }
+
+// ConfirmBookedAppointment ...
+// @Summary ConfirmBookedAppointment
+// @Description ConfirmBookedAppointment - API to confirm an appointment. A video room is opened for an online appointment.
+// @Description Admins and reception confirm any appointment, a doctor their own.
+// @Security ApiKeyAuth
+// @Tags Appointment
+// @Accept json
+// @Produce json
+// @Param id query integer true "id"
+// @Success 200 {object} model_booking_service.Appointment
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/appointment/confirm [post]
+func (h *HandlerV1) ConfirmBookedAppointment(c *gin.Context) {
+	userInfo, ok := h.requireRole(c, "ConfirmBookedAppointment", RoleAdmin, RoleReception, RoleDoctor)
+	if !ok {
+		return
+	}
+
+	id := c.Query("id")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	if userInfo.Role == RoleDoctor {
+		appointment, err := h.serviceManager.BookingService().BookedAppointment().GetAppointment(ctx, &pb.AppointmentFieldValueReq{
+			Field: "id",
+			Value: id,
+		})
+		if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ConfirmBookedAppointment") {
+			return
+		}
+		if appointment.DoctorId != userInfo.UserId {
+			e.HandleError(c, errors.New("the appointment is another doctor's"), h.log, http.StatusForbidden, "ConfirmBookedAppointment")
+			return
+		}
+	}
+
+	res, err := h.serviceManager.BookingService().BookedAppointment().ConfirmAppointment(ctx, &pb.AppointmentFieldValueReq{
+		Field: "id",
+		Value: id,
+	})
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ConfirmBookedAppointment") {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_booking_service.Appointment{
+		Id:              res.Id,
+		DepartmentId:    res.DepartmentId,
+		BranchId:        res.BranchId,
+		DoctorId:        res.DoctorId,
+		ResourceId:      res.ResourceId,
+		PatientId:       res.PatientId,
+		AppointmentDate: res.AppointmentDate,
+		AppointmentTime: res.AppointmentTime,
+		Duration:        res.Duration,
+		Key:             res.Key,
+		ExpiresAt:       res.ExpiresAt,
+		PatientStatus:   res.PatientStatus,
+		Modality:        res.Modality,
+		DoctorServiceId: res.DoctorServiceId,
+		Price:           res.Price,
+		Currency:        res.Currency,
+		VideoRoomId:     res.VideoRoomId,
+		VideoProvider:   res.VideoProvider,
+		Status:          res.Status,
+		CreatedAt:       res.CreatedAt,
+		UpdatedAt:       e.UpdateTimeFilter(res.UpdatedAt),
+	})
+}
+
+// SetBookedAppointmentStatus ...
+// @Summary SetBookedAppointmentStatus
+// @Description SetBookedAppointmentStatus - API to mark an appointment as attended, cancelled or no-show.
+// @Description Admins and reception mark any appointment, a doctor their own. A cancelled or missed appointment frees its resources.
+// @Security ApiKeyAuth
+// @Tags Appointment
+// @Accept json
+// @Produce json
+// @Param AppointmentStatusReq body model_booking_service.AppointmentStatusReq true "AppointmentStatusReq"
+// @Success 200 {object} model_booking_service.Appointment
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/appointment/status [put]
+func (h *HandlerV1) SetBookedAppointmentStatus(c *gin.Context) {
+	var body model_booking_service.AppointmentStatusReq
+
+	userInfo, ok := h.requireRole(c, "SetBookedAppointmentStatus", RoleAdmin, RoleReception, RoleDoctor)
+	if !ok {
+		return
+	}
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "SetBookedAppointmentStatus") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	if userInfo.Role == RoleDoctor {
+		appointment, err := h.serviceManager.BookingService().BookedAppointment().GetAppointment(ctx, &pb.AppointmentFieldValueReq{
+			Field: "id",
+			Value: strconv.FormatInt(body.AppointmentId, 10),
+		})
+		if e.HandleError(c, err, h.log, http.StatusInternalServerError, "SetBookedAppointmentStatus") {
+			return
+		}
+		if appointment.DoctorId != userInfo.UserId {
+			e.HandleError(c, errors.New("the appointment is another doctor's"), h.log, http.StatusForbidden, "SetBookedAppointmentStatus")
+			return
+		}
+	}
+
+	res, err := h.serviceManager.BookingService().BookedAppointment().SetAppointmentStatus(ctx, &pb.AppointmentStatusReq{
+		AppointmentId: body.AppointmentId,
+		Status:        body.Status,
+	})
+	if status.Code(err) == codes.InvalidArgument {
+		e.HandleError(c, err, h.log, http.StatusBadRequest, "SetBookedAppointmentStatus")
+		return
+	}
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "SetBookedAppointmentStatus") {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_booking_service.Appointment{
+		Id:              res.Id,
+		DepartmentId:    res.DepartmentId,
+		BranchId:        res.BranchId,
+		DoctorId:        res.DoctorId,
+		ResourceId:      res.ResourceId,
+		PatientId:       res.PatientId,
+		AppointmentDate: res.AppointmentDate,
+		AppointmentTime: res.AppointmentTime,
+		Duration:        res.Duration,
+		Key:             res.Key,
+		ExpiresAt:       res.ExpiresAt,
+		PatientStatus:   res.PatientStatus,
+		Modality:        res.Modality,
+		DoctorServiceId: res.DoctorServiceId,
+		Price:           res.Price,
+		Currency:        res.Currency,
+		VideoRoomId:     res.VideoRoomId,
+		VideoProvider:   res.VideoProvider,
+		Status:          res.Status,
+		CreatedAt:       res.CreatedAt,
+		UpdatedAt:       e.UpdateTimeFilter(res.UpdatedAt),
+	})
+}
+
+// GetAppointmentJoinLink ...
+// @Summary GetAppointmentJoinLink
+// @Description GetAppointmentJoinLink - API to get the caller's link to the video room of a confirmed online appointment.
+// @Description Only the patient and the doctor of the appointment get a link, shortly before the start and until the end.
+// @Tags Appointment
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query integer true "id"
+// @Success 200 {object} model_booking_service.JoinLink
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/appointment/join [get]
+func (h *HandlerV1) GetAppointmentJoinLink(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Query("id"), 10, 64)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "GetAppointmentJoinLink") {
+		return
+	}
+
+	userInfo, ok := h.requireRole(c, "GetAppointmentJoinLink", RoleUser, RoleDoctor)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	appointment, err := h.serviceManager.BookingService().BookedAppointment().GetAppointment(ctx, &pb.AppointmentFieldValueReq{
+		Field: "id",
+		Value: strconv.FormatInt(id, 10),
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetAppointmentJoinLink") {
+		return
+	}
+
+	// the patient joins as the patient profile of the appointment, which
+	// must belong to the account, the doctor as themselves
+	participantId := userInfo.UserId
+	if userInfo.Role == RoleUser {
+		if h.myPatientError(c, h.ownPatient(ctx, userInfo.UserId, appointment.PatientId), "GetAppointmentJoinLink") {
+			return
+		}
+		participantId = appointment.PatientId
+	} else if appointment.DoctorId != userInfo.UserId {
+		e.HandleError(c, errors.New("the appointment is another doctor's"), h.log, http.StatusForbidden, "GetAppointmentJoinLink")
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().BookedAppointment().GetJoinLink(ctx, &pb.JoinLinkReq{
+		AppointmentId: id,
+		ParticipantId: participantId,
+	})
+
+	switch status.Code(err) {
+	case codes.PermissionDenied:
+		e.HandleError(c, err, h.log, http.StatusForbidden, "GetAppointmentJoinLink")
+		return
+	case codes.FailedPrecondition:
+		e.HandleError(c, err, h.log, http.StatusConflict, "GetAppointmentJoinLink")
+		return
+	}
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetAppointmentJoinLink") {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_booking_service.JoinLink{
+		Url:       res.Url,
+		RoomId:    res.RoomId,
+		Provider:  res.Provider,
+		NotBefore: res.NotBefore,
+		ExpiresAt: res.ExpiresAt,
+	})
+}
@@ -0,0 +1,233 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models"
+	"dennic_api_gateway/api/models/model_healthcare_service"
+	pb "dennic_api_gateway/genproto/healthcare-service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+)
+
+func branchOpeningHoursToPb(hours []*model_healthcare_service.BranchOpeningHours) []*pb.BranchOpeningHours {
+	var res []*pb.BranchOpeningHours
+	for _, h := range hours {
+		res = append(res, &pb.BranchOpeningHours{
+			DayOfWeek: h.DayOfWeek,
+			OpenTime:  h.OpenTime,
+			CloseTime: h.CloseTime,
+		})
+	}
+	return res
+}
+
+func branchToRes(branch *pb.Branch) *model_healthcare_service.BranchRes {
+	res := model_healthcare_service.BranchRes{
+		Id:          branch.Id,
+		Order:       branch.Order,
+		Name:        branch.Name,
+		Address:     branch.Address,
+		Latitude:    branch.Latitude,
+		Longitude:   branch.Longitude,
+		Timezone:    branch.Timezone,
+		PhoneNumber: branch.PhoneNumber,
+		CreatedAt:   branch.CreatedAt,
+		UpdatedAt:   e.UpdateTimeFilter(branch.UpdatedAt),
+	}
+	for _, h := range branch.OpeningHours {
+		res.OpeningHours = append(res.OpeningHours, &model_healthcare_service.BranchOpeningHours{
+			DayOfWeek: h.DayOfWeek,
+			OpenTime:  h.OpenTime,
+			CloseTime: h.CloseTime,
+		})
+	}
+	return &res
+}
+
+// CreateBranch ...
+// @Summary CreateBranch
+// @Description CreateBranch - Api for create clinic branch
+// @Tags Branch
+// @Accept json
+// @Produce json
+// @Param BranchReq body model_healthcare_service.BranchReq true "BranchReq"
+// @Success 200 {object} model_healthcare_service.BranchRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/branch [post]
+func (h *HandlerV1) CreateBranch(c *gin.Context) {
+	var body model_healthcare_service.BranchReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateBranch") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	branch, err := h.serviceManager.HealthcareService().BranchService().CreateBranch(ctx, &pb.Branch{
+		Id:           uuid.NewString(),
+		Name:         body.Name,
+		Address:      body.Address,
+		Latitude:     body.Latitude,
+		Longitude:    body.Longitude,
+		Timezone:     body.Timezone,
+		PhoneNumber:  body.PhoneNumber,
+		OpeningHours: branchOpeningHoursToPb(body.OpeningHours),
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateBranch") {
+		return
+	}
+
+	c.JSON(http.StatusOK, branchToRes(branch))
+}
+
+// GetBranch ...
+// @Summary GetBranch
+// @Description GetBranch - Api for get clinic branch
+// @Tags Branch
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_healthcare_service.BranchRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/branch/get [get]
+func (h *HandlerV1) GetBranch(c *gin.Context) {
+	id := c.Query("id")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	branch, err := h.serviceManager.HealthcareService().BranchService().GetBranchById(ctx, &pb.GetReqStrBranch{
+		Field:    "id",
+		Value:    id,
+		IsActive: false,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetBranch") {
+		return
+	}
+
+	c.JSON(http.StatusOK, branchToRes(branch))
+}
+
+// ListBranches ...
+// @Summary ListBranches
+// @Description ListBranches - Api for list clinic branches
+// @Tags Branch
+// @Accept json
+// @Produce json
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param search query string false "search" Enums(name, address) "search"
+// @Success 200 {object} model_healthcare_service.ListBranches
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/branch [get]
+func (h *HandlerV1) ListBranches(c *gin.Context) {
+	search := c.Query("search")
+	value := c.Query("value")
+	limit := c.Query("limit")
+	page := c.Query("page")
+	orderBy := c.Query("orderBy")
+
+	pageInt, limitInt, err := e.ParseQueryParams(page, limit)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListBranches") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	branches, err := h.serviceManager.HealthcareService().BranchService().GetAllBranches(ctx, &pb.GetAllBranch{
+		Field:    search,
+		Value:    value,
+		IsActive: false,
+		Page:     int64(pageInt),
+		Limit:    int64(limitInt),
+		OrderBy:  orderBy,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListBranches") {
+		return
+	}
+
+	var branchesRes model_healthcare_service.ListBranches
+	for _, branch := range branches.Branches {
+		branchesRes.Branches = append(branchesRes.Branches, branchToRes(branch))
+	}
+	branchesRes.Count = branches.Count
+
+	c.JSON(http.StatusOK, branchesRes)
+}
+
+// UpdateBranch ...
+// @Summary UpdateBranch
+// @Description UpdateBranch - Api for update clinic branch
+// @Tags Branch
+// @Accept json
+// @Produce json
+// @Param UpdateBranchReq body model_healthcare_service.BranchReq true "UpdateBranchReq"
+// @Success 200 {object} model_healthcare_service.BranchRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/branch [put]
+func (h *HandlerV1) UpdateBranch(c *gin.Context) {
+	var body model_healthcare_service.BranchReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateBranch") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	branch, err := h.serviceManager.HealthcareService().BranchService().UpdateBranch(ctx, &pb.Branch{
+		Id:           body.Id,
+		Name:         body.Name,
+		Address:      body.Address,
+		Latitude:     body.Latitude,
+		Longitude:    body.Longitude,
+		Timezone:     body.Timezone,
+		PhoneNumber:  body.PhoneNumber,
+		OpeningHours: branchOpeningHoursToPb(body.OpeningHours),
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateBranch") {
+		return
+	}
+
+	c.JSON(http.StatusOK, branchToRes(branch))
+}
+
+// DeleteBranch ...
+// @Summary DeleteBranch
+// @Description DeleteBranch - Api for delete clinic branch
+// @Tags Branch
+// @Accept json
+// @Produce json
+// @Param DeleteBranchReq query models.FieldValueReq true "FieldValueReq"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/branch [delete]
+func (h *HandlerV1) DeleteBranch(c *gin.Context) {
+	field := c.Query("field")
+	value := c.Query("value")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().BranchService().DeleteBranch(ctx, &pb.GetReqStrBranch{
+		Field:    field,
+		Value:    value,
+		IsActive: false,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DeleteBranch") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
@@ -47,6 +47,7 @@ func (h *HandlerV1) CreateDepartment(c *gin.Context) {
 		ImageUrl:         body.ImageUrl,
 		FloorNumber:      body.FloorNumber,
 		ShortDescription: body.ShortDescription,
+		BranchId:         body.BranchId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateDepartment") {
@@ -61,6 +62,7 @@ func (h *HandlerV1) CreateDepartment(c *gin.Context) {
 		ImageUrl:         department.ImageUrl,
 		FloorNumber:      department.FloorNumber,
 		ShortDescription: department.ShortDescription,
+		BranchId:         department.BranchId,
 		CreatedAt:        department.CreatedAt,
 		UpdatedAt:        e.UpdateTimeFilter(department.UpdatedAt),
 	})
@@ -100,6 +102,7 @@ func (h *HandlerV1) GetDepartment(c *gin.Context) {
 		ImageUrl:         department.ImageUrl,
 		FloorNumber:      department.FloorNumber,
 		ShortDescription: department.ShortDescription,
+		BranchId:         department.BranchId,
 		CreatedAt:        department.CreatedAt,
 		UpdatedAt:        e.UpdateTimeFilter(department.UpdatedAt),
 	})
@@ -113,6 +116,7 @@ func (h *HandlerV1) GetDepartment(c *gin.Context) {
 // @Produce json
 // @Param ListReq query models.ListReq false "ListReq"
 // @Param search query string false "search" Enums(name, description) "search"
+// @Param branch_id query string false "branch_id"
 // @Success 200 {object} model_healthcare_service.ListDepartments
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -123,6 +127,7 @@ func (h *HandlerV1) ListDepartments(c *gin.Context) {
 	limit := c.Query("limit")
 	page := c.Query("page")
 	orderBy := c.Query("orderBy")
+	branchId := c.Query("branch_id")
 
 	pageInt, limitInt, err := e.ParseQueryParams(page, limit)
 	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListDepartments") {
@@ -139,6 +144,7 @@ func (h *HandlerV1) ListDepartments(c *gin.Context) {
 		Page:     int64(pageInt),
 		Limit:    int64(limitInt),
 		OrderBy:  orderBy,
+		BranchId: branchId,
 	})
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListDepartments") {
 		return
@@ -153,6 +159,7 @@ func (h *HandlerV1) ListDepartments(c *gin.Context) {
 			ImageUrl:         departmentRes.ImageUrl,
 			FloorNumber:      departmentRes.FloorNumber,
 			ShortDescription: departmentRes.ShortDescription,
+			BranchId:         departmentRes.BranchId,
 			CreatedAt:        departmentRes.CreatedAt,
 			UpdatedAt:        e.UpdateTimeFilter(departmentRes.UpdatedAt),
 		})
@@ -198,6 +205,7 @@ func (h *HandlerV1) UpdateDepartment(c *gin.Context) {
 		ImageUrl:         body.ImageUrl,
 		FloorNumber:      body.FloorNumber,
 		ShortDescription: body.ShortDescription,
+		BranchId:         body.BranchId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateDepartment") {
@@ -212,6 +220,7 @@ func (h *HandlerV1) UpdateDepartment(c *gin.Context) {
 		ImageUrl:         department.ImageUrl,
 		FloorNumber:      department.FloorNumber,
 		ShortDescription: department.ShortDescription,
+		BranchId:         department.BranchId,
 		CreatedAt:        department.CreatedAt,
 		UpdatedAt:        e.UpdateTimeFilter(department.UpdatedAt),
 	})
@@ -143,17 +143,21 @@ func (h *HandlerV1) GetDoctor(c *gin.Context) {
 		Password:      doctor.Password,
 		CreatedAt:     doctor.CreatedAt,
 		UpdatedAt:     e.UpdateTimeFilter(doctor.UpdatedAt),
+		Rating:        doctor.Rating,
+		ReviewCount:   doctor.ReviewCount,
 	})
 }
 
 // ListDoctors ...
 // @Summary ListDoctors
 // @Description ListDoctors - Api for list doctor
+// @Description Use orderBy "rating DESC" to list the best rated doctors first.
 // @Tags Doctor
 // @Accept json
 // @Produce json
 // @Param ListReq query models.ListReq false "ListReq"
 // @Param search query string false "search" Enums(first_name, last_name, gender, phone_number, email, address, city, country, biography) "search"
+// @Param branch_id query string false "branch_id"
 // @Success 200 {object} model_healthcare_service.DoctorAndDoctorHours
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -164,6 +168,7 @@ func (h *HandlerV1) ListDoctors(c *gin.Context) {
 	limit := c.Query("limit")
 	page := c.Query("page")
 	orderBy := c.Query("orderBy")
+	branchId := c.Query("branch_id")
 
 	pageInt, limitInt, err := e.ParseQueryParams(page, limit)
 	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListDoctors") {
@@ -180,6 +185,7 @@ func (h *HandlerV1) ListDoctors(c *gin.Context) {
 		Page:     int64(pageInt),
 		Limit:    int64(limitInt),
 		OrderBy:  orderBy,
+		BranchId: branchId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListDoctors") {
@@ -213,6 +219,8 @@ func (h *HandlerV1) ListDoctors(c *gin.Context) {
 			Password:      doctorRes.Password,
 			CreatedAt:     doctorRes.CreatedAt,
 			UpdatedAt:     doctorRes.UpdatedAt,
+			Rating:        doctorRes.Rating,
+			ReviewCount:   doctorRes.ReviewCount,
 		})
 	}
 
@@ -230,6 +238,7 @@ func (h *HandlerV1) ListDoctors(c *gin.Context) {
 // @Produce json
 // @Param ListReq query models.ListReq false "ListReq"
 // @Param specialization_id query string true "specialization_id"
+// @Param branch_id query string false "branch_id"
 // @Success 200 {object} model_healthcare_service.ListDoctors
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -240,6 +249,7 @@ func (h *HandlerV1) ListDoctorsBySpecializationId(c *gin.Context) {
 	limit := c.Query("limit")
 	page := c.Query("page")
 	orderBy := c.Query("orderBy")
+	branchId := c.Query("branch_id")
 
 	specId := c.Query("specialization_id")
 	pageInt, limitInt, err := e.ParseQueryParams(page, limit)
@@ -257,6 +267,7 @@ func (h *HandlerV1) ListDoctorsBySpecializationId(c *gin.Context) {
 		Page:             int32(pageInt),
 		Limit:            int32(limitInt),
 		OrderBy:          orderBy,
+		BranchId:         branchId,
 		SpecializationId: specId,
 	})
 
@@ -41,13 +41,16 @@ func (h *HandlerV1) CreateDoctorService(c *gin.Context) {
 	defer cancel()
 
 	doctorServices, err := h.serviceManager.HealthcareService().DoctorsService().CreateDoctorServices(ctx, &pb.DoctorServices{
-		Id:               uuid.NewString(),
-		DoctorId:         body.DoctorId,
-		SpecializationId: body.SpecializationId,
-		OnlinePrice:      body.OnlinePrice,
-		OfflinePrice:     body.OfflinePrice,
-		Name:             body.Name,
-		Duration:         body.Duration,
+		Id:                   uuid.NewString(),
+		DoctorId:             body.DoctorId,
+		SpecializationId:     body.SpecializationId,
+		OnlinePrice:          body.OnlinePrice,
+		OfflinePrice:         body.OfflinePrice,
+		Name:                 body.Name,
+		Currency:             body.Currency,
+		DurationMinutes:      body.DurationMinutes,
+		PrepInstructions:     body.PrepInstructions,
+		RequiredResourceType: body.RequiredResourceType,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateDoctorService") {
@@ -55,16 +58,19 @@ func (h *HandlerV1) CreateDoctorService(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, model_healthcare_service.DoctorServicesRes{
-		Id:               doctorServices.Id,
-		Order:            doctorServices.DoctorServiceOrder,
-		DoctorId:         doctorServices.DoctorId,
-		SpecializationId: doctorServices.SpecializationId,
-		OnlinePrice:      doctorServices.OnlinePrice,
-		OfflinePrice:     doctorServices.OfflinePrice,
-		Name:             doctorServices.Name,
-		Duration:         doctorServices.Duration,
-		CreatedAt:        doctorServices.CreatedAt,
-		UpdatedAt:        e.UpdateTimeFilter(doctorServices.UpdatedAt),
+		Id:                   doctorServices.Id,
+		Order:                doctorServices.DoctorServiceOrder,
+		DoctorId:             doctorServices.DoctorId,
+		SpecializationId:     doctorServices.SpecializationId,
+		OnlinePrice:          doctorServices.OnlinePrice,
+		OfflinePrice:         doctorServices.OfflinePrice,
+		Name:                 doctorServices.Name,
+		Currency:             doctorServices.Currency,
+		DurationMinutes:      doctorServices.DurationMinutes,
+		PrepInstructions:     doctorServices.PrepInstructions,
+		RequiredResourceType: doctorServices.RequiredResourceType,
+		CreatedAt:            doctorServices.CreatedAt,
+		UpdatedAt:            e.UpdateTimeFilter(doctorServices.UpdatedAt),
 	})
 }
 
@@ -95,16 +101,19 @@ func (h *HandlerV1) GetDoctorService(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, model_healthcare_service.DoctorServicesRes{
-		Id:               doctorServices.Id,
-		Order:            doctorServices.DoctorServiceOrder,
-		DoctorId:         doctorServices.DoctorId,
-		SpecializationId: doctorServices.SpecializationId,
-		OnlinePrice:      doctorServices.OnlinePrice,
-		OfflinePrice:     doctorServices.OfflinePrice,
-		Name:             doctorServices.Name,
-		Duration:         doctorServices.Duration,
-		CreatedAt:        doctorServices.CreatedAt,
-		UpdatedAt:        e.UpdateTimeFilter(doctorServices.UpdatedAt),
+		Id:                   doctorServices.Id,
+		Order:                doctorServices.DoctorServiceOrder,
+		DoctorId:             doctorServices.DoctorId,
+		SpecializationId:     doctorServices.SpecializationId,
+		OnlinePrice:          doctorServices.OnlinePrice,
+		OfflinePrice:         doctorServices.OfflinePrice,
+		Name:                 doctorServices.Name,
+		Currency:             doctorServices.Currency,
+		DurationMinutes:      doctorServices.DurationMinutes,
+		PrepInstructions:     doctorServices.PrepInstructions,
+		RequiredResourceType: doctorServices.RequiredResourceType,
+		CreatedAt:            doctorServices.CreatedAt,
+		UpdatedAt:            e.UpdateTimeFilter(doctorServices.UpdatedAt),
 	})
 }
 
@@ -115,6 +124,7 @@ func (h *HandlerV1) GetDoctorService(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param ListReq query model_healthcare_service.ListReqDoctorServices false "ListReq"
+// @Param branch_id query string false "branch_id"
 // @Success 200 {object} model_healthcare_service.ListDoctorServices
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -123,6 +133,7 @@ func (h *HandlerV1) ListDoctorServices(c *gin.Context) {
 	limit := c.Query("limit")
 	page := c.Query("page")
 	orderBy := c.Query("orderBy")
+	branchId := c.Query("branch_id")
 
 	pageInt, limitInt, err := e.ParseQueryParams(page, limit)
 	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListDoctorServices") {
@@ -139,6 +150,7 @@ func (h *HandlerV1) ListDoctorServices(c *gin.Context) {
 		Page:     int64(pageInt),
 		Limit:    int64(limitInt),
 		OrderBy:  orderBy,
+		BranchId: branchId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListDoctorServices") {
@@ -147,16 +159,19 @@ func (h *HandlerV1) ListDoctorServices(c *gin.Context) {
 	var doctorServicessRes model_healthcare_service.ListDoctorServices
 	for _, doctorServicesRes := range doctorServicess.DoctorServices {
 		doctorServicessRes.DoctorServices = append(doctorServicessRes.DoctorServices, &model_healthcare_service.DoctorServicesRes{
-			Id:               doctorServicesRes.Id,
-			Order:            doctorServicesRes.DoctorServiceOrder,
-			DoctorId:         doctorServicesRes.DoctorId,
-			SpecializationId: doctorServicesRes.SpecializationId,
-			OnlinePrice:      doctorServicesRes.OnlinePrice,
-			OfflinePrice:     doctorServicesRes.OfflinePrice,
-			Name:             doctorServicesRes.Name,
-			Duration:         doctorServicesRes.Duration,
-			CreatedAt:        doctorServicesRes.CreatedAt,
-			UpdatedAt:        e.UpdateTimeFilter(doctorServicesRes.UpdatedAt),
+			Id:                   doctorServicesRes.Id,
+			Order:                doctorServicesRes.DoctorServiceOrder,
+			DoctorId:             doctorServicesRes.DoctorId,
+			SpecializationId:     doctorServicesRes.SpecializationId,
+			OnlinePrice:          doctorServicesRes.OnlinePrice,
+			OfflinePrice:         doctorServicesRes.OfflinePrice,
+			Name:                 doctorServicesRes.Name,
+			Currency:             doctorServicesRes.Currency,
+			DurationMinutes:      doctorServicesRes.DurationMinutes,
+			PrepInstructions:     doctorServicesRes.PrepInstructions,
+			RequiredResourceType: doctorServicesRes.RequiredResourceType,
+			CreatedAt:            doctorServicesRes.CreatedAt,
+			UpdatedAt:            e.UpdateTimeFilter(doctorServicesRes.UpdatedAt),
 		})
 	}
 
@@ -194,13 +209,16 @@ func (h *HandlerV1) UpdateDoctorServices(c *gin.Context) {
 	defer cancel()
 
 	doctorServices, err := h.serviceManager.HealthcareService().DoctorsService().UpdateDoctorServices(ctx, &pb.DoctorServices{
-		Id:               body.Id,
-		DoctorId:         body.DoctorId,
-		SpecializationId: body.SpecializationId,
-		OnlinePrice:      body.OnlinePrice,
-		OfflinePrice:     body.OfflinePrice,
-		Name:             body.Name,
-		Duration:         body.Duration,
+		Id:                   body.Id,
+		DoctorId:             body.DoctorId,
+		SpecializationId:     body.SpecializationId,
+		OnlinePrice:          body.OnlinePrice,
+		OfflinePrice:         body.OfflinePrice,
+		Name:                 body.Name,
+		Currency:             body.Currency,
+		DurationMinutes:      body.DurationMinutes,
+		PrepInstructions:     body.PrepInstructions,
+		RequiredResourceType: body.RequiredResourceType,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateDoctorServices") {
@@ -208,16 +226,19 @@ func (h *HandlerV1) UpdateDoctorServices(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, model_healthcare_service.DoctorServicesRes{
-		Id:               doctorServices.Id,
-		Order:            doctorServices.DoctorServiceOrder,
-		DoctorId:         doctorServices.DoctorId,
-		SpecializationId: doctorServices.SpecializationId,
-		OnlinePrice:      doctorServices.OnlinePrice,
-		OfflinePrice:     doctorServices.OfflinePrice,
-		Name:             doctorServices.Name,
-		Duration:         doctorServices.Duration,
-		CreatedAt:        doctorServices.CreatedAt,
-		UpdatedAt:        e.UpdateTimeFilter(doctorServices.UpdatedAt),
+		Id:                   doctorServices.Id,
+		Order:                doctorServices.DoctorServiceOrder,
+		DoctorId:             doctorServices.DoctorId,
+		SpecializationId:     doctorServices.SpecializationId,
+		OnlinePrice:          doctorServices.OnlinePrice,
+		OfflinePrice:         doctorServices.OfflinePrice,
+		Name:                 doctorServices.Name,
+		Currency:             doctorServices.Currency,
+		DurationMinutes:      doctorServices.DurationMinutes,
+		PrepInstructions:     doctorServices.PrepInstructions,
+		RequiredResourceType: doctorServices.RequiredResourceType,
+		CreatedAt:            doctorServices.CreatedAt,
+		UpdatedAt:            e.UpdateTimeFilter(doctorServices.UpdatedAt),
 	})
 }
 
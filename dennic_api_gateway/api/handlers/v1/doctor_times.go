@@ -41,6 +41,7 @@ func (h *HandlerV1) CreateDoctorTimes(c *gin.Context) {
 
 	res, err := h.serviceManager.BookingService().DoctorTimes().CreateDoctorTime(ctx, &pb.CreateDoctorTimeReq{
 		DepartmentId: body.DepartmentId,
+		BranchId:     body.BranchId,
 		DoctorId:     body.DoctorId,
 		DoctorDate:   body.DoctorDate,
 		StartTime:    body.StartTime,
@@ -55,6 +56,7 @@ func (h *HandlerV1) CreateDoctorTimes(c *gin.Context) {
 	c.JSON(http.StatusOK, model_booking_service.DoctorTime{
 		Id:           res.Id,
 		DepartmentId: res.DepartmentId,
+		BranchId:     res.BranchId,
 		DoctorId:     res.DoctorId,
 		DoctorDate:   res.DoctorDate,
 		StartTime:    res.StartTime,
@@ -95,6 +97,7 @@ func (h *HandlerV1) GetDoctorTimes(c *gin.Context) {
 	c.JSON(http.StatusOK, model_booking_service.DoctorTime{
 		Id:           res.Id,
 		DepartmentId: res.DepartmentId,
+		BranchId:     res.BranchId,
 		DoctorId:     res.DoctorId,
 		DoctorDate:   res.DoctorDate,
 		StartTime:    res.StartTime,
@@ -113,6 +116,8 @@ func (h *HandlerV1) GetDoctorTimes(c *gin.Context) {
 // @Produce json
 // @Param searchField query string false "searchField" Enums(status)
 // @Param ListReq query models.ListReq false "ListReq"
+// @Param branch_id query string false "branch_id"
+// @Param doctor_date query string false "doctor_date"
 // @Success 200 {object} model_booking_service.DoctorTimesType
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -123,6 +128,8 @@ func (h *HandlerV1) ListDoctorTimes(c *gin.Context) {
 	limit := c.Query("limit")
 	page := c.Query("page")
 	orderBy := c.Query("orderBy")
+	branchId := c.Query("branch_id")
+	doctorDate := c.Query("doctor_date")
 
 	pageInt, limitInt, err := e.ParseQueryParams(page, limit)
 	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListDoctorTimes") {
@@ -133,12 +140,14 @@ func (h *HandlerV1) ListDoctorTimes(c *gin.Context) {
 	defer cancel()
 
 	res, err := h.serviceManager.BookingService().DoctorTimes().GetAllDoctorTimes(ctx, &pb.GetAllDoctorTimesReq{
-		Field:    field,
-		Value:    value,
-		IsActive: false,
-		Page:     pageInt,
-		Limit:    limitInt,
-		OrderBy:  orderBy,
+		Field:      field,
+		Value:      value,
+		IsActive:   false,
+		Page:       pageInt,
+		Limit:      limitInt,
+		OrderBy:    orderBy,
+		BranchId:   branchId,
+		DoctorDate: doctorDate,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListDoctorTimes") {
@@ -150,6 +159,7 @@ func (h *HandlerV1) ListDoctorTimes(c *gin.Context) {
 		var doctorTime model_booking_service.DoctorTime
 		doctorTime.Id = times.Id
 		doctorTime.DepartmentId = times.DepartmentId
+		doctorTime.BranchId = times.BranchId
 		doctorTime.DoctorId = times.DoctorId
 		doctorTime.DoctorDate = times.DoctorDate
 		doctorTime.StartTime = times.StartTime
@@ -197,6 +207,7 @@ func (h *HandlerV1) UpdateDoctorTimes(c *gin.Context) {
 		Field:        "id",
 		Value:        body.DoctorTimeId,
 		DepartmentId: body.DepartmentId,
+		BranchId:     body.BranchId,
 		DoctorId:     body.DoctorId,
 		DoctorDate:   body.DoctorDate,
 		StartTime:    body.StartTime,
@@ -211,6 +222,7 @@ func (h *HandlerV1) UpdateDoctorTimes(c *gin.Context) {
 	c.JSON(http.StatusOK, model_booking_service.DoctorTime{
 		Id:           res.Id,
 		DepartmentId: res.DepartmentId,
+		BranchId:     res.BranchId,
 		DoctorId:     res.DoctorId,
 		DoctorDate:   res.DoctorDate,
 		StartTime:    res.StartTime,
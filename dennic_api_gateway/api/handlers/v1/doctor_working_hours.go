@@ -45,6 +45,8 @@ func (h *HandlerV1) CreateDoctorWorkingHours(c *gin.Context) {
 		DayOfWeek:  body.DayOfWeek,
 		StartTime:  body.StartTime,
 		FinishTime: body.FinishTime,
+		BranchId:   body.BranchId,
+		RoomId:     body.RoomId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateDoctorWorkingHours") {
@@ -57,6 +59,8 @@ func (h *HandlerV1) CreateDoctorWorkingHours(c *gin.Context) {
 		DayOfWeek:  dwh.DayOfWeek,
 		StartTime:  dwh.StartTime,
 		FinishTime: dwh.FinishTime,
+		BranchId:   dwh.BranchId,
+		RoomId:     dwh.RoomId,
 		CreatedAt:  dwh.CreatedAt,
 		UpdatedAt:  e.UpdateTimeFilter(dwh.UpdatedAt),
 	})
@@ -94,6 +98,8 @@ func (h *HandlerV1) GetDoctorWorkingHours(c *gin.Context) {
 		DayOfWeek:  dwh.DayOfWeek,
 		StartTime:  dwh.StartTime,
 		FinishTime: dwh.FinishTime,
+		BranchId:   dwh.BranchId,
+		RoomId:     dwh.RoomId,
 		CreatedAt:  dwh.CreatedAt,
 		UpdatedAt:  e.UpdateTimeFilter(dwh.UpdatedAt),
 	})
@@ -107,6 +113,7 @@ func (h *HandlerV1) GetDoctorWorkingHours(c *gin.Context) {
 // @Produce json
 // @Param ListReq query models.ListReq false "ListReq"
 // @Param search query string false "search" Enums(day_of_week) "search"
+// @Param branch_id query string false "branch_id"
 // @Success 200 {object} model_healthcare_service.ListDoctorWorkingHours
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -117,6 +124,7 @@ func (h *HandlerV1) ListDoctorWorkingHours(c *gin.Context) {
 	limit := c.Query("limit")
 	page := c.Query("page")
 	orderBy := c.Query("orderBy")
+	branchId := c.Query("branch_id")
 
 	pageInt, limitInt, err := e.ParseQueryParams(page, limit)
 	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListDoctorWorkingHours") {
@@ -133,6 +141,7 @@ func (h *HandlerV1) ListDoctorWorkingHours(c *gin.Context) {
 		Page:     int64(pageInt),
 		Limit:    int64(limitInt),
 		OrderBy:  orderBy,
+		BranchId: branchId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListDoctorWorkingHours") {
@@ -146,6 +155,8 @@ func (h *HandlerV1) ListDoctorWorkingHours(c *gin.Context) {
 			DayOfWeek:  dwhRes.DayOfWeek,
 			StartTime:  dwhRes.StartTime,
 			FinishTime: dwhRes.FinishTime,
+			BranchId:   dwhRes.BranchId,
+			RoomId:     dwhRes.RoomId,
 			CreatedAt:  dwhRes.CreatedAt,
 			UpdatedAt:  e.UpdateTimeFilter(dwhRes.UpdatedAt),
 		})
@@ -192,6 +203,8 @@ func (h *HandlerV1) UpdateDoctorWorkingHours(c *gin.Context) {
 		DayOfWeek:  body.DayOfWeek,
 		StartTime:  body.StartTime,
 		FinishTime: body.FinishTime,
+		BranchId:   body.BranchId,
+		RoomId:     body.RoomId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateDoctorWorkingHours") {
@@ -204,6 +217,8 @@ func (h *HandlerV1) UpdateDoctorWorkingHours(c *gin.Context) {
 		DayOfWeek:  dwh.DayOfWeek,
 		StartTime:  dwh.StartTime,
 		FinishTime: dwh.FinishTime,
+		BranchId:   dwh.BranchId,
+		RoomId:     dwh.RoomId,
 		CreatedAt:  dwh.CreatedAt,
 		UpdatedAt:  e.UpdateTimeFilter(dwh.UpdatedAt),
 	})
@@ -0,0 +1,576 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models"
+	"dennic_api_gateway/api/models/model_booking_service"
+	pb "dennic_api_gateway/genproto/booking_service"
+	"dennic_api_gateway/internal/pkg/minio"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// documentExtensions lists the content types the vault accepts.
+var documentExtensions = map[string]string{
+	"application/pdf":   ".pdf",
+	"image/jpeg":        ".jpg",
+	"image/png":         ".png",
+	"application/dicom": ".dcm",
+}
+
+// sniffDocument detects the content type from the file itself rather than
+// trusting the name or the header the client sent.
+func sniffDocument(content []byte) (string, bool) {
+	// DICOM files carry "DICM" after a 128 byte preamble
+	if len(content) > 132 && bytes.Equal(content[128:132], []byte("DICM")) {
+		return "application/dicom", true
+	}
+	contentType := http.DetectContentType(content)
+	_, ok := documentExtensions[contentType]
+	return contentType, ok
+}
+
+func documentToRes(document *pb.Document) *model_booking_service.Document {
+	return &model_booking_service.Document{
+		Id:            document.Id,
+		PatientId:     document.PatientId,
+		AppointmentId: document.AppointmentId,
+		Type:          document.Type,
+		Title:         document.Title,
+		FileName:      document.FileName,
+		ContentType:   document.ContentType,
+		SizeBytes:     document.SizeBytes,
+		UploadedBy:    document.UploadedBy,
+		CreatedAt:     document.CreatedAt,
+	}
+}
+
+func documentsToRes(res *pb.DocumentsType) model_booking_service.DocumentsType {
+	var list model_booking_service.DocumentsType
+	for _, document := range res.Documents {
+		list.Documents = append(list.Documents, documentToRes(document))
+	}
+	list.Count = res.Count
+	return list
+}
+
+// documentError answers with the status matching a document call.
+func (h *HandlerV1) documentError(c *gin.Context, err error, method string) bool {
+	switch status.Code(err) {
+	case codes.OK:
+		return false
+	case codes.InvalidArgument:
+		return e.HandleError(c, err, h.log, http.StatusBadRequest, method)
+	case codes.NotFound:
+		return e.HandleError(c, err, h.log, http.StatusNotFound, method)
+	case codes.AlreadyExists:
+		return e.HandleError(c, err, h.log, http.StatusConflict, method)
+	}
+	return e.HandleError(c, err, h.log, http.StatusInternalServerError, method)
+}
+
+// parseDocumentForm parses the multipart upload, refusing bodies above the
+// document size limit before they are read.
+func (h *HandlerV1) parseDocumentForm(c *gin.Context, method string) bool {
+	return h.parseUploadForm(c, h.cfg.MinioService.Documents.MaxSize, method)
+}
+
+// parseUploadForm parses a multipart upload, refusing bodies above maxSize
+// before they are read.
+func (h *HandlerV1) parseUploadForm(c *gin.Context, maxSize int64, method string) bool {
+	// leave room for the other form fields around the file
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxSize+1<<20)
+
+	err := c.Request.ParseMultipartForm(32 << 20)
+	var errTooLarge *http.MaxBytesError
+	if errors.As(err, &errTooLarge) {
+		e.HandleError(c, err, h.log, http.StatusRequestEntityTooLarge, method)
+		return false
+	}
+	return !e.HandleError(c, err, h.log, http.StatusBadRequest, method)
+}
+
+// storeDocument reads the file of a parsed upload, checks its size and type,
+// puts it in the private bucket and records it for the patient. The object is
+// removed again when the record cannot be created.
+func (h *HandlerV1) storeDocument(c *gin.Context, patientId, uploadedBy, method string) (*pb.Document, bool) {
+	maxSize := h.cfg.MinioService.Documents.MaxSize
+
+	if _, err := uuid.Parse(patientId); err != nil {
+		e.HandleError(c, errors.New("patient_id must be a uuid"), h.log, http.StatusBadRequest, method)
+		return nil, false
+	}
+
+	var appointmentId int64
+	if value := c.PostForm("appointment_id"); value != "" {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if e.HandleError(c, err, h.log, http.StatusBadRequest, method) {
+			return nil, false
+		}
+		appointmentId = id
+	}
+
+	file, header, err := c.Request.FormFile("file")
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, method) {
+		return nil, false
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, maxSize+1))
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, method) {
+		return nil, false
+	}
+	if int64(len(content)) > maxSize {
+		e.HandleError(c, fmt.Errorf("document is larger than %d bytes", maxSize), h.log, http.StatusRequestEntityTooLarge, method)
+		return nil, false
+	}
+	if len(content) == 0 {
+		e.HandleError(c, errors.New("document is empty"), h.log, http.StatusBadRequest, method)
+		return nil, false
+	}
+
+	contentType, ok := sniffDocument(content)
+	if !ok {
+		e.HandleError(c, fmt.Errorf("%s documents are not accepted, upload a pdf, jpeg, png or dicom file", contentType), h.log, http.StatusUnsupportedMediaType, method)
+		return nil, false
+	}
+
+	bucket := h.cfg.MinioService.Documents.Bucket
+	objectKey := patientId + "/" + uuid.NewString() + documentExtensions[contentType]
+
+	err = minio.PutPrivateObject(c.Request.Context(), h.cfg, bucket, objectKey, content, contentType)
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, method) {
+		return nil, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Documents().CreateDocument(ctx, &pb.Document{
+		Id:            uuid.NewString(),
+		PatientId:     patientId,
+		AppointmentId: appointmentId,
+		Type:          c.PostForm("type"),
+		Title:         c.PostForm("title"),
+		FileName:      header.Filename,
+		ContentType:   contentType,
+		SizeBytes:     int64(len(content)),
+		Bucket:        bucket,
+		ObjectKey:     objectKey,
+		UploadedBy:    uploadedBy,
+	})
+	if err != nil {
+		if errRemove := minio.RemoveObject(context.Background(), h.cfg, bucket, objectKey); errRemove != nil {
+			h.log.Error("failed to remove unrecorded document " + objectKey + ": " + errRemove.Error())
+		}
+		h.documentError(c, err, method)
+		return nil, false
+	}
+
+	return res, true
+}
+
+// documentURL answers with a presigned download URL of the document.
+func (h *HandlerV1) documentURL(c *gin.Context, document *pb.Document, method string) {
+	ttl := h.cfg.MinioService.Documents.URLTTL
+
+	url, err := minio.PresignedURL(c.Request.Context(), h.cfg, document.Bucket, document.ObjectKey, document.FileName, ttl)
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, method) {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_booking_service.DocumentURL{
+		Url:       url,
+		ExpiresAt: time.Now().Add(ttl).Format(time.RFC3339),
+	})
+}
+
+// removeDocument deletes the stored object before the record, so a failed
+// call can simply be repeated.
+func (h *HandlerV1) removeDocument(c *gin.Context, ctx context.Context, document *pb.Document, method string) {
+	err := minio.RemoveObject(ctx, h.cfg, document.Bucket, document.ObjectKey)
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, method) {
+		return
+	}
+
+	_, err = h.serviceManager.BookingService().Documents().DeleteDocument(ctx, &pb.DocumentFieldValueReq{
+		Field: "id",
+		Value: document.Id,
+	})
+	if h.documentError(c, err, method) {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: true})
+}
+
+// CreateDocument ...
+// @Summary CreateDocument
+// @Description CreateDocument - Api for adding a scan, X-ray or summary to a patient's private document vault.
+// @Description The file type is detected from its content; pdf, jpeg, png and dicom files are accepted.
+// @Description A doctor can only add documents of the patients they have an appointment with.
+// @Tags Document
+// @Accept multipart/form-data
+// @Produce json
+// @Security ApiKeyAuth
+// @Param file formData file true "file"
+// @Param patient_id formData string true "patient_id"
+// @Param appointment_id formData int false "appointment_id"
+// @Param type formData string true "type" Enums(scan, xray, discharge_summary, lab_report, referral, other)
+// @Param title formData string false "title"
+// @Success 201 {object} model_booking_service.Document
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 413 {object} model_common.StandardErrorModel
+// @Failure 415 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/document [post]
+func (h *HandlerV1) CreateDocument(c *gin.Context) {
+	if !h.parseDocumentForm(c, "CreateDocument") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "CreateDocument") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	patientId := c.PostForm("patient_id")
+	if h.staffAccessError(c, h.staffPatientAccess(ctx, userInfo, patientId), "CreateDocument") {
+		return
+	}
+
+	res, ok := h.storeDocument(c, patientId, userInfo.UserId, "CreateDocument")
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusCreated, documentToRes(res))
+}
+
+// ListDocuments ...
+// @Summary ListDocuments
+// @Description ListDocuments - Api for list documents of a patient, optionally of one of their appointments
+// @Tags Document
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param patient_id query string true "patient_id"
+// @Param appointment_id query int false "appointment_id"
+// @Param type query string false "type" Enums(scan, xray, discharge_summary, lab_report, referral, other)
+// @Success 200 {object} model_booking_service.DocumentsType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/document [get]
+func (h *HandlerV1) ListDocuments(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListDocuments") {
+		return
+	}
+
+	var appointmentId int64
+	if value := c.Query("appointment_id"); value != "" {
+		appointmentId, err = strconv.ParseInt(value, 10, 64)
+		if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListDocuments") {
+			return
+		}
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "ListDocuments") {
+		return
+	}
+
+	patientId := c.Query("patient_id")
+	if _, err := uuid.Parse(patientId); err != nil {
+		e.HandleError(c, errors.New("patient_id must be a uuid"), h.log, http.StatusBadRequest, "ListDocuments")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	if h.staffAccessError(c, h.staffPatientAccess(ctx, userInfo, patientId), "ListDocuments") {
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().Documents().GetAllDocuments(ctx, &pb.GetAllDocumentsReq{
+		Page:          pageInt,
+		Limit:         limitInt,
+		PatientId:     patientId,
+		AppointmentId: appointmentId,
+		Type:          c.Query("type"),
+	})
+	if h.documentError(c, err, "ListDocuments") {
+		return
+	}
+
+	c.JSON(http.StatusOK, documentsToRes(res))
+}
+
+// GetDocumentURL ...
+// @Summary GetDocumentURL
+// @Description GetDocumentURL - Api for a short-lived download URL of a document
+// @Tags Document
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.DocumentURL
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/document/download [get]
+func (h *HandlerV1) GetDocumentURL(c *gin.Context) {
+	document, ok := h.staffDocument(c, "GetDocumentURL")
+	if !ok {
+		return
+	}
+
+	h.documentURL(c, document, "GetDocumentURL")
+}
+
+// DeleteDocument ...
+// @Summary DeleteDocument
+// @Description DeleteDocument - Api for deleting a document together with the stored file
+// @Tags Document
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/document [delete]
+func (h *HandlerV1) DeleteDocument(c *gin.Context) {
+	document, ok := h.staffDocument(c, "DeleteDocument")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	h.removeDocument(c, ctx, document, "DeleteDocument")
+}
+
+// UploadMyDocument ...
+// @Summary UploadMyDocument
+// @Description UploadMyDocument - Api for adding a document to a patient profile of the caller's account.
+// @Description The file type is detected from its content; pdf, jpeg, png and dicom files are accepted.
+// @Tags Me
+// @Accept multipart/form-data
+// @Produce json
+// @Security ApiKeyAuth
+// @Param file formData file true "file"
+// @Param patient_id formData string true "patient_id"
+// @Param appointment_id formData int false "appointment_id"
+// @Param type formData string true "type" Enums(scan, xray, discharge_summary, lab_report, referral, other)
+// @Param title formData string false "title"
+// @Success 201 {object} model_booking_service.Document
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 413 {object} model_common.StandardErrorModel
+// @Failure 415 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/documents [post]
+func (h *HandlerV1) UploadMyDocument(c *gin.Context) {
+	if !h.parseDocumentForm(c, "UploadMyDocument") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "UploadMyDocument") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	patientId := c.PostForm("patient_id")
+	if h.myPatientError(c, h.ownPatient(ctx, userInfo.UserId, patientId), "UploadMyDocument") {
+		return
+	}
+
+	res, ok := h.storeDocument(c, patientId, userInfo.UserId, "UploadMyDocument")
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusCreated, documentToRes(res))
+}
+
+// ListMyDocuments ...
+// @Summary ListMyDocuments
+// @Description ListMyDocuments - Api for the documents of a patient profile of the caller's account
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param patient_id query string true "patient_id"
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param type query string false "type" Enums(scan, xray, discharge_summary, lab_report, referral, other)
+// @Success 200 {object} model_booking_service.DocumentsType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/documents [get]
+func (h *HandlerV1) ListMyDocuments(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListMyDocuments") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "ListMyDocuments") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	patientId := c.Query("patient_id")
+	if h.myPatientError(c, h.ownPatient(ctx, userInfo.UserId, patientId), "ListMyDocuments") {
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().Documents().GetAllDocuments(ctx, &pb.GetAllDocumentsReq{
+		Page:      pageInt,
+		Limit:     limitInt,
+		PatientId: patientId,
+		Type:      c.Query("type"),
+	})
+	if h.documentError(c, err, "ListMyDocuments") {
+		return
+	}
+
+	c.JSON(http.StatusOK, documentsToRes(res))
+}
+
+// GetMyDocumentURL ...
+// @Summary GetMyDocumentURL
+// @Description GetMyDocumentURL - Api for a short-lived download URL of a document of a patient profile of the caller's account
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.DocumentURL
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/documents/download [get]
+func (h *HandlerV1) GetMyDocumentURL(c *gin.Context) {
+	document, ok := h.myDocument(c, "GetMyDocumentURL")
+	if !ok {
+		return
+	}
+
+	h.documentURL(c, document, "GetMyDocumentURL")
+}
+
+// DeleteMyDocument ...
+// @Summary DeleteMyDocument
+// @Description DeleteMyDocument - Api for deleting a document the caller uploaded, together with the stored file.
+// @Description Documents added by the clinic cannot be deleted by patients.
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/documents [delete]
+func (h *HandlerV1) DeleteMyDocument(c *gin.Context) {
+	document, ok := h.myDocument(c, "DeleteMyDocument")
+	if !ok {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "DeleteMyDocument") {
+		return
+	}
+	if document.UploadedBy != userInfo.UserId {
+		e.HandleError(c, errors.New("only documents you uploaded can be deleted"), h.log, http.StatusForbidden, "DeleteMyDocument")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	h.removeDocument(c, ctx, document, "DeleteMyDocument")
+}
+
+// myDocument fetches the document in the id query parameter, answering
+// not found unless it belongs to a patient profile of the caller's account.
+func (h *HandlerV1) myDocument(c *gin.Context, method string) (*pb.Document, bool) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, method) {
+		return nil, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	document, err := h.serviceManager.BookingService().Documents().GetDocument(ctx, &pb.DocumentFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.documentError(c, err, method) {
+		return nil, false
+	}
+	if h.myPatientError(c, h.ownPatient(ctx, userInfo.UserId, document.PatientId), method) {
+		return nil, false
+	}
+
+	return document, true
+}
+
+// staffDocument fetches the document in the id query parameter, answering
+// forbidden unless the staff token may see the records of its patient.
+func (h *HandlerV1) staffDocument(c *gin.Context, method string) (*pb.Document, bool) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, method) {
+		return nil, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	document, err := h.serviceManager.BookingService().Documents().GetDocument(ctx, &pb.DocumentFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.documentError(c, err, method) {
+		return nil, false
+	}
+	if h.staffAccessError(c, h.staffPatientAccess(ctx, userInfo, document.PatientId), method) {
+		return nil, false
+	}
+
+	return document, true
+}
@@ -0,0 +1,443 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models"
+	"dennic_api_gateway/api/models/model_booking_service"
+	pb "dennic_api_gateway/genproto/booking_service"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func encounterToPb(id string, appointmentId int64, body model_booking_service.UpdateEncounterReq) *pb.Encounter {
+	req := &pb.Encounter{
+		Id:             id,
+		AppointmentId:  appointmentId,
+		ChiefComplaint: body.ChiefComplaint,
+		Vitals: &pb.Vitals{
+			TemperatureC:     body.Vitals.TemperatureC,
+			PulseBpm:         body.Vitals.PulseBpm,
+			SystolicMmhg:     body.Vitals.SystolicMmHg,
+			DiastolicMmhg:    body.Vitals.DiastolicMmHg,
+			RespiratoryRate:  body.Vitals.RespiratoryRate,
+			OxygenSaturation: body.Vitals.OxygenSaturation,
+			WeightKg:         body.Vitals.WeightKg,
+			HeightCm:         body.Vitals.HeightCm,
+		},
+		FollowUpPlan: body.FollowUpPlan,
+		FollowUpDate: body.FollowUpDate,
+	}
+	for _, diagnosis := range body.Diagnoses {
+		req.Diagnoses = append(req.Diagnoses, &pb.Diagnosis{
+			Icd10Code:   diagnosis.Icd10Code,
+			Description: diagnosis.Description,
+			Primary:     diagnosis.Primary,
+		})
+	}
+	for _, allergy := range body.Allergies {
+		req.Allergies = append(req.Allergies, &pb.Allergy{
+			Substance: allergy.Substance,
+			Reaction:  allergy.Reaction,
+			Severity:  allergy.Severity,
+		})
+	}
+	for _, condition := range body.ChronicConditions {
+		req.ChronicConditions = append(req.ChronicConditions, &pb.ChronicCondition{
+			Icd10Code:   condition.Icd10Code,
+			Description: condition.Description,
+			OnsetDate:   condition.OnsetDate,
+		})
+	}
+	for _, procedure := range body.Procedures {
+		req.Procedures = append(req.Procedures, &pb.Procedure{
+			Code:        procedure.Code,
+			Description: procedure.Description,
+		})
+	}
+	return req
+}
+
+func encounterToRes(encounter *pb.Encounter) *model_booking_service.Encounter {
+	res := &model_booking_service.Encounter{
+		Id:             encounter.Id,
+		AppointmentId:  encounter.AppointmentId,
+		PatientId:      encounter.PatientId,
+		DoctorId:       encounter.DoctorId,
+		EncounteredAt:  encounter.EncounteredAt,
+		ChiefComplaint: encounter.ChiefComplaint,
+		FollowUpPlan:   encounter.FollowUpPlan,
+		FollowUpDate:   encounter.FollowUpDate,
+		CreatedAt:      encounter.CreatedAt,
+		UpdatedAt:      e.UpdateTimeFilter(encounter.UpdatedAt),
+	}
+	if encounter.Vitals != nil {
+		res.Vitals = model_booking_service.Vitals{
+			TemperatureC:     encounter.Vitals.TemperatureC,
+			PulseBpm:         encounter.Vitals.PulseBpm,
+			SystolicMmHg:     encounter.Vitals.SystolicMmhg,
+			DiastolicMmHg:    encounter.Vitals.DiastolicMmhg,
+			RespiratoryRate:  encounter.Vitals.RespiratoryRate,
+			OxygenSaturation: encounter.Vitals.OxygenSaturation,
+			WeightKg:         encounter.Vitals.WeightKg,
+			HeightCm:         encounter.Vitals.HeightCm,
+		}
+	}
+	for _, diagnosis := range encounter.Diagnoses {
+		res.Diagnoses = append(res.Diagnoses, &model_booking_service.Diagnosis{
+			Icd10Code:   diagnosis.Icd10Code,
+			Description: diagnosis.Description,
+			Primary:     diagnosis.Primary,
+		})
+	}
+	for _, allergy := range encounter.Allergies {
+		res.Allergies = append(res.Allergies, &model_booking_service.Allergy{
+			Substance: allergy.Substance,
+			Reaction:  allergy.Reaction,
+			Severity:  allergy.Severity,
+		})
+	}
+	for _, condition := range encounter.ChronicConditions {
+		res.ChronicConditions = append(res.ChronicConditions, &model_booking_service.ChronicCondition{
+			Icd10Code:   condition.Icd10Code,
+			Description: condition.Description,
+			OnsetDate:   condition.OnsetDate,
+		})
+	}
+	for _, procedure := range encounter.Procedures {
+		res.Procedures = append(res.Procedures, &model_booking_service.Procedure{
+			Code:        procedure.Code,
+			Description: procedure.Description,
+		})
+	}
+	return res
+}
+
+// encounterError answers with the status matching an encounter call.
+func (h *HandlerV1) encounterError(c *gin.Context, err error, method string) bool {
+	switch status.Code(err) {
+	case codes.OK:
+		return false
+	case codes.InvalidArgument:
+		return e.HandleError(c, err, h.log, http.StatusBadRequest, method)
+	case codes.NotFound:
+		return e.HandleError(c, err, h.log, http.StatusNotFound, method)
+	case codes.AlreadyExists:
+		return e.HandleError(c, err, h.log, http.StatusConflict, method)
+	}
+	return e.HandleError(c, err, h.log, http.StatusInternalServerError, method)
+}
+
+// CreateEncounter ...
+// @Summary CreateEncounter
+// @Description CreateEncounter - Api for recording the clinical encounter of an appointment.
+// @Description Patient, doctor and time are taken from the appointment, which must be one of the caller's; one encounter per appointment.
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param EncounterReq body model_booking_service.EncounterReq true "EncounterReq"
+// @Success 200 {object} model_booking_service.Encounter
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/encounter [post]
+func (h *HandlerV1) CreateEncounter(c *gin.Context) {
+	var body model_booking_service.EncounterReq
+
+	doctor, ok := h.requireRole(c, "CreateEncounter", RoleDoctor)
+	if !ok {
+		return
+	}
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateEncounter") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	appointment, err := h.serviceManager.BookingService().BookedAppointment().GetAppointment(ctx, &pb.AppointmentFieldValueReq{
+		Field: "id",
+		Value: strconv.FormatInt(body.AppointmentId, 10),
+	})
+	if h.encounterError(c, err, "CreateEncounter") {
+		return
+	}
+	if appointment.DoctorId != doctor.UserId {
+		e.HandleError(c, errors.New("the appointment is another doctor's"), h.log, http.StatusForbidden, "CreateEncounter")
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().Encounters().CreateEncounter(ctx, encounterToPb(uuid.NewString(), body.AppointmentId, model_booking_service.UpdateEncounterReq{
+		ChiefComplaint:    body.ChiefComplaint,
+		Vitals:            body.Vitals,
+		Diagnoses:         body.Diagnoses,
+		Allergies:         body.Allergies,
+		ChronicConditions: body.ChronicConditions,
+		Procedures:        body.Procedures,
+		FollowUpPlan:      body.FollowUpPlan,
+		FollowUpDate:      body.FollowUpDate,
+	}))
+	if h.encounterError(c, err, "CreateEncounter") {
+		return
+	}
+
+	c.JSON(http.StatusOK, encounterToRes(res))
+}
+
+// GetEncounter ...
+// @Summary GetEncounter
+// @Description GetEncounter - Api for get encounter by id or by appointment, for doctors of the patient and admins
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string false "id"
+// @Param appointment_id query string false "appointment_id"
+// @Success 200 {object} model_booking_service.Encounter
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/encounter/get [get]
+func (h *HandlerV1) GetEncounter(c *gin.Context) {
+	field, value := "id", c.Query("id")
+	if appointmentId := c.Query("appointment_id"); appointmentId != "" {
+		field, value = "appointment_id", appointmentId
+	}
+
+	clinician, ok := h.requireRole(c, "GetEncounter", RoleDoctor, RoleAdmin)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Encounters().GetEncounter(ctx, &pb.EncounterFieldValueReq{
+		Field:    field,
+		Value:    value,
+		IsActive: false,
+	})
+	if h.encounterError(c, err, "GetEncounter") {
+		return
+	}
+	if h.staffAccessError(c, h.staffPatientAccess(ctx, clinician, res.PatientId), "GetEncounter") {
+		return
+	}
+
+	c.JSON(http.StatusOK, encounterToRes(res))
+}
+
+// encounterOf fetches the encounter id and answers forbidden unless it is
+// the doctor's; an admin passes for any encounter.
+func (h *HandlerV1) encounterOf(c *gin.Context, ctx context.Context, clinician *e.UserTokenRes, id, method string) bool {
+	if clinician.Role != RoleDoctor {
+		return true
+	}
+
+	encounter, err := h.serviceManager.BookingService().Encounters().GetEncounter(ctx, &pb.EncounterFieldValueReq{
+		Field: "id",
+		Value: id,
+	})
+	if h.encounterError(c, err, method) {
+		return false
+	}
+	if encounter.DoctorId != clinician.UserId {
+		e.HandleError(c, errors.New("the encounter is another doctor's"), h.log, http.StatusForbidden, method)
+		return false
+	}
+	return true
+}
+
+// UpdateEncounter ...
+// @Summary UpdateEncounter
+// @Description UpdateEncounter - Api for the doctor of an encounter to update it. Coded lists are replaced as a whole.
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param UpdateEncounterReq body model_booking_service.UpdateEncounterReq true "UpdateEncounterReq"
+// @Success 200 {object} model_booking_service.Encounter
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/encounter [put]
+func (h *HandlerV1) UpdateEncounter(c *gin.Context) {
+	var body model_booking_service.UpdateEncounterReq
+
+	doctor, ok := h.requireRole(c, "UpdateEncounter", RoleDoctor)
+	if !ok {
+		return
+	}
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateEncounter") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	if !h.encounterOf(c, ctx, doctor, body.Id, "UpdateEncounter") {
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().Encounters().UpdateEncounter(ctx, encounterToPb(body.Id, 0, body))
+	if h.encounterError(c, err, "UpdateEncounter") {
+		return
+	}
+
+	c.JSON(http.StatusOK, encounterToRes(res))
+}
+
+// DeleteEncounter ...
+// @Summary DeleteEncounter
+// @Description DeleteEncounter - Api for the doctor of an encounter or an admin to delete it
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/encounter [delete]
+func (h *HandlerV1) DeleteEncounter(c *gin.Context) {
+	id := c.Query("id")
+
+	clinician, ok := h.requireRole(c, "DeleteEncounter", RoleDoctor, RoleAdmin)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	if !h.encounterOf(c, ctx, clinician, id, "DeleteEncounter") {
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().Encounters().DeleteEncounter(ctx, &pb.EncounterFieldValueReq{
+		Field:    "id",
+		Value:    id,
+		IsActive: false,
+	})
+	if h.encounterError(c, err, "DeleteEncounter") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: res.Status})
+}
+
+// GetPatientTimeline ...
+// @Summary GetPatientTimeline
+// @Description GetPatientTimeline - Api for the patient's encounters across all doctors, the latest first, for doctors of the
+// @Description patient and admins
+// @Tags Encounter
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param patient_id query string true "patient_id"
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param from query string false "from" example(2024-01-01)
+// @Param to query string false "to" example(2024-12-31)
+// @Success 200 {object} model_booking_service.PatientTimeline
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/patient/timeline [get]
+func (h *HandlerV1) GetPatientTimeline(c *gin.Context) {
+	clinician, ok := h.requireRole(c, "GetPatientTimeline", RoleDoctor, RoleAdmin)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	patientId := c.Query("patient_id")
+	if h.staffAccessError(c, h.staffPatientAccess(ctx, clinician, patientId), "GetPatientTimeline") {
+		return
+	}
+
+	h.patientTimeline(c, ctx, patientId, "GetPatientTimeline")
+}
+
+// GetMyTimeline ...
+// @Summary GetMyTimeline
+// @Description GetMyTimeline - Api for the encounters of a patient profile of the caller's account across all doctors, the latest first
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param patient_id query string true "patient_id"
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param from query string false "from" example(2024-01-01)
+// @Param to query string false "to" example(2024-12-31)
+// @Success 200 {object} model_booking_service.PatientTimeline
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/timeline [get]
+func (h *HandlerV1) GetMyTimeline(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "GetMyTimeline") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	patientId := c.Query("patient_id")
+	if h.myPatientError(c, h.ownPatient(ctx, userInfo.UserId, patientId), "GetMyTimeline") {
+		return
+	}
+
+	h.patientTimeline(c, ctx, patientId, "GetMyTimeline")
+}
+
+// patientTimeline answers with a page of the encounters of the patient.
+func (h *HandlerV1) patientTimeline(c *gin.Context, ctx context.Context, patientId, method string) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, method) {
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().Encounters().GetPatientTimeline(ctx, &pb.PatientTimelineReq{
+		PatientId: patientId,
+		Page:      pageInt,
+		Limit:     limitInt,
+		From:      c.Query("from"),
+		To:        c.Query("to"),
+	})
+	if h.encounterError(c, err, method) {
+		return
+	}
+
+	var timeline model_booking_service.PatientTimeline
+	for _, encounter := range res.Encounters {
+		timeline.Encounters = append(timeline.Encounters, encounterToRes(encounter))
+	}
+	timeline.Count = res.Count
+
+	c.JSON(http.StatusOK, timeline)
+}
@@ -18,7 +18,7 @@ import (
 // @Accept image/png
 // @Produce json
 // @Param file formData file true "file"
-// @Param bucketName query string false "bucket" Enums(department, reasons, specialization, doctor, user) "bucket name"
+// @Param bucketName query string false "bucket" Enums(department, reasons, specialization, doctor, user, lab) "bucket name"
 // @Success 200 {object} model_minio.MinioURL
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -2,9 +2,11 @@ package v1
 
 import (
 	grpc_service_clients "dennic_api_gateway/internal/infrastructure/grpc_service_client"
+	redisCache "dennic_api_gateway/internal/infrastructure/redis"
 	"dennic_api_gateway/internal/pkg/config"
 	"dennic_api_gateway/internal/pkg/redis"
 	token "dennic_api_gateway/internal/pkg/tokens"
+	"dennic_api_gateway/internal/usecase/event"
 	"github.com/casbin/casbin/v2"
 	"go.uber.org/zap"
 	"time"
@@ -26,8 +28,9 @@ type HandlerV1 struct {
 	serviceManager grpc_service_clients.ServiceClient
 	cfg            *config.Config
 	redis          *redis.RedisDB
-	//BrokerProducer event.BrokerProducer
-	//kafka          *kafka.Produce
+	registrations  redisCache.Registrations
+	realtime       redisCache.Realtime
+	brokerProducer event.BrokerProducer
 }
 
 // HandlerV1Config ...
@@ -39,9 +42,9 @@ type HandlerV1Config struct {
 	Config         *config.Config
 	Enforcer       casbin.Enforcer
 	Redis          *redis.RedisDB
-
-	//BrokerProducer event.BrokerProducer
-	//Kafka          *kafka.Produce
+	Registrations  redisCache.Registrations
+	Realtime       redisCache.Realtime
+	BrokerProducer event.BrokerProducer
 }
 
 // New ...
@@ -53,7 +56,8 @@ func New(c *HandlerV1Config) *HandlerV1 {
 		cfg:            c.Config,
 		redis:          c.Redis,
 		ContextTimeout: c.ContextTimeout,
-
-		//BrokerProducer: c.BrokerProducer,
+		registrations:  c.Registrations,
+		realtime:       c.Realtime,
+		brokerProducer: c.BrokerProducer,
 	}
 }
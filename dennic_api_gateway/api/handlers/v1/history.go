@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models/model_booking_service"
+	pb "dennic_api_gateway/genproto/booking_service"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxHistoryImportSize keeps an imported bundle under the gRPC message limit.
+const maxHistoryImportSize = 3 << 20
+
+func (h *HandlerV1) historyError(c *gin.Context, err error, method string) bool {
+	switch status.Code(err) {
+	case codes.OK:
+		return false
+	case codes.InvalidArgument:
+		return e.HandleError(c, err, h.log, http.StatusBadRequest, method)
+	case codes.NotFound:
+		return e.HandleError(c, err, h.log, http.StatusNotFound, method)
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return e.HandleError(c, err, h.log, http.StatusConflict, method)
+	}
+	return e.HandleError(c, err, h.log, http.StatusInternalServerError, method)
+}
+
+// ExportPatientHistory ...
+// @Summary ExportPatientHistory
+// @Description ExportPatientHistory - Api for export a patient's medical history as a FHIR R4 Bundle: demographics,
+// @Description visits, encounters, notes, prescriptions, released lab results and records imported from elsewhere
+// @Description of a patient profile of the caller's account, or of a patient the staff member has access to
+// @Tags Patient
+// @Produce application/fhir+json
+// @Security ApiKeyAuth
+// @Param id path string true "id"
+// @Param format query string false "format" Enums(fhir)
+// @Success 200 {file} file
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/patient/{id}/export [get]
+func (h *HandlerV1) ExportPatientHistory(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "ExportPatientHistory") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	if h.staffAccessError(c, h.patientAccess(ctx, userInfo, c.Param("id")), "ExportPatientHistory") {
+		return
+	}
+
+	file, err := h.serviceManager.BookingService().History().ExportPatientHistory(ctx, &pb.ExportHistoryReq{
+		PatientId: c.Param("id"),
+		Format:    c.Query("format"),
+	})
+	if h.historyError(c, err, "ExportPatientHistory") {
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=\""+file.FileName+"\"")
+	c.Data(http.StatusOK, file.ContentType, file.Content)
+}
+
+// ImportPatientHistory ...
+// @Summary ImportPatientHistory
+// @Description ImportPatientHistory - Api for create a patient from another clinic's FHIR R4 Bundle. The body is the bundle
+// @Description itself; its other resources are kept with the patient and come back in the patient's exports. For admins,
+// @Description reception and doctors.
+// @Tags Patient
+// @Accept application/fhir+json,json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param format query string false "format" Enums(fhir)
+// @Param source query string true "where the bundle comes from"
+// @Param bundle body object true "FHIR Bundle"
+// @Success 200 {object} model_booking_service.ImportHistoryRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 413 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/patient/import [post]
+func (h *HandlerV1) ImportPatientHistory(c *gin.Context) {
+	userInfo, ok := h.requireRole(c, "ImportPatientHistory", RoleAdmin, RoleReception, RoleDoctor)
+	if !ok {
+		return
+	}
+
+	content, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxHistoryImportSize))
+	var errTooLarge *http.MaxBytesError
+	if errors.As(err, &errTooLarge) {
+		e.HandleError(c, err, h.log, http.StatusRequestEntityTooLarge, "ImportPatientHistory")
+		return
+	}
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ImportPatientHistory") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().History().ImportPatientHistory(ctx, &pb.ImportHistoryReq{
+		Format:     c.Query("format"),
+		Content:    content,
+		Source:     c.Query("source"),
+		ImportedBy: userInfo.UserId,
+	})
+	if h.historyError(c, err, "ImportPatientHistory") {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_booking_service.ImportHistoryRes{
+		Patient:  patientToRes(res.Patient),
+		Imported: res.Imported,
+		Skipped:  res.Skipped,
+	})
+}
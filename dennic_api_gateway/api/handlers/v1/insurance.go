@@ -0,0 +1,941 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models"
+	"dennic_api_gateway/api/models/model_booking_service"
+	pb "dennic_api_gateway/genproto/booking_service"
+	pbh "dennic_api_gateway/genproto/healthcare-service"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func insurerToRes(insurer *pb.Insurer) *model_booking_service.Insurer {
+	return &model_booking_service.Insurer{
+		Id:          insurer.Id,
+		Name:        insurer.Name,
+		Code:        insurer.Code,
+		Email:       insurer.Email,
+		PhoneNumber: insurer.PhoneNumber,
+		CreatedAt:   insurer.CreatedAt,
+		UpdatedAt:   insurer.UpdatedAt,
+	}
+}
+
+func insurancePolicyToRes(policy *pb.InsurancePolicy) *model_booking_service.InsurancePolicy {
+	return &model_booking_service.InsurancePolicy{
+		Id:           policy.Id,
+		InsurerId:    policy.InsurerId,
+		PatientId:    policy.PatientId,
+		PolicyNumber: policy.PolicyNumber,
+		ValidFrom:    policy.ValidFrom,
+		ValidTo:      policy.ValidTo,
+		AnnualLimit:  policy.AnnualLimit,
+		Currency:     policy.Currency,
+		Status:       policy.Status,
+		CreatedAt:    policy.CreatedAt,
+		UpdatedAt:    policy.UpdatedAt,
+	}
+}
+
+func coverageRuleToRes(rule *pb.CoverageRule) *model_booking_service.CoverageRule {
+	return &model_booking_service.CoverageRule{
+		Id:              rule.Id,
+		InsurerId:       rule.InsurerId,
+		DoctorServiceId: rule.DoctorServiceId,
+		Kind:            rule.Kind,
+		Percentage:      rule.Percentage,
+		Copay:           rule.Copay,
+		AnnualLimit:     rule.AnnualLimit,
+		CreatedAt:       rule.CreatedAt,
+		UpdatedAt:       rule.UpdatedAt,
+	}
+}
+
+func eligibilityToRes(eligibility *pb.Eligibility) *model_booking_service.Eligibility {
+	return &model_booking_service.Eligibility{
+		Eligible:  eligibility.Eligible,
+		Reason:    eligibility.Reason,
+		PolicyId:  eligibility.PolicyId,
+		InsurerId: eligibility.InsurerId,
+		Price:     eligibility.Price,
+		Covered:   eligibility.Covered,
+		Copay:     eligibility.Copay,
+		Currency:  eligibility.Currency,
+	}
+}
+
+func claimToRes(claim *pb.InsuranceClaim) *model_booking_service.InsuranceClaim {
+	return &model_booking_service.InsuranceClaim{
+		Id:              claim.Id,
+		InsurerId:       claim.InsurerId,
+		PolicyId:        claim.PolicyId,
+		PatientId:       claim.PatientId,
+		AppointmentId:   claim.AppointmentId,
+		InvoiceId:       claim.InvoiceId,
+		DoctorServiceId: claim.DoctorServiceId,
+		ServiceDate:     claim.ServiceDate,
+		Billed:          claim.Billed,
+		Covered:         claim.Covered,
+		Copay:           claim.Copay,
+		Approved:        claim.Approved,
+		Currency:        claim.Currency,
+		Status:          claim.Status,
+		StatusReason:    claim.StatusReason,
+		BatchId:         claim.BatchId,
+		CreatedAt:       claim.CreatedAt,
+		UpdatedAt:       claim.UpdatedAt,
+	}
+}
+
+// insuranceError answers with the status matching an insurance call.
+func (h *HandlerV1) insuranceError(c *gin.Context, err error, method string) bool {
+	switch status.Code(err) {
+	case codes.OK:
+		return false
+	case codes.InvalidArgument:
+		return e.HandleError(c, err, h.log, http.StatusBadRequest, method)
+	case codes.NotFound:
+		return e.HandleError(c, err, h.log, http.StatusNotFound, method)
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return e.HandleError(c, err, h.log, http.StatusConflict, method)
+	}
+	return e.HandleError(c, err, h.log, http.StatusInternalServerError, method)
+}
+
+// CreateInsurer ...
+// @Summary CreateInsurer
+// @Description CreateInsurer - Api for create an insurance company
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param InsurerReq body model_booking_service.InsurerReq true "InsurerReq"
+// @Success 200 {object} model_booking_service.Insurer
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/insurer [post]
+func (h *HandlerV1) CreateInsurer(c *gin.Context) {
+	if _, ok := h.requireRole(c, "CreateInsurer", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	var body model_booking_service.InsurerReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateInsurer") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().CreateInsurer(ctx, &pb.Insurer{
+		Id:          uuid.NewString(),
+		Name:        body.Name,
+		Code:        body.Code,
+		Email:       body.Email,
+		PhoneNumber: body.PhoneNumber,
+	})
+	if h.insuranceError(c, err, "CreateInsurer") {
+		return
+	}
+
+	c.JSON(http.StatusOK, insurerToRes(res))
+}
+
+// GetInsurer ...
+// @Summary GetInsurer
+// @Description GetInsurer - Api for get an insurance company
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.Insurer
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/insurer/get [get]
+func (h *HandlerV1) GetInsurer(c *gin.Context) {
+	if _, ok := h.requireRole(c, "GetInsurer", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().GetInsurer(ctx, &pb.InsuranceFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.insuranceError(c, err, "GetInsurer") {
+		return
+	}
+
+	c.JSON(http.StatusOK, insurerToRes(res))
+}
+
+// ListInsurers ...
+// @Summary ListInsurers
+// @Description ListInsurers - Api for list insurance companies
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ListReq query models.ListReq false "ListReq"
+// @Success 200 {object} model_booking_service.InsurersType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/insurer [get]
+func (h *HandlerV1) ListInsurers(c *gin.Context) {
+	if _, ok := h.requireRole(c, "ListInsurers", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListInsurers") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().GetAllInsurers(ctx, &pb.GetAllInsuranceReq{
+		Page:  pageInt,
+		Limit: limitInt,
+		Value: c.Query("value"),
+	})
+	if h.insuranceError(c, err, "ListInsurers") {
+		return
+	}
+
+	insurers := &model_booking_service.InsurersType{Count: res.Count}
+	for _, insurer := range res.Insurers {
+		insurers.Insurers = append(insurers.Insurers, insurerToRes(insurer))
+	}
+
+	c.JSON(http.StatusOK, insurers)
+}
+
+// UpdateInsurer ...
+// @Summary UpdateInsurer
+// @Description UpdateInsurer - Api for update an insurance company
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param UpdateInsurerReq body model_booking_service.UpdateInsurerReq true "UpdateInsurerReq"
+// @Success 200 {object} model_booking_service.Insurer
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/insurer [put]
+func (h *HandlerV1) UpdateInsurer(c *gin.Context) {
+	if _, ok := h.requireRole(c, "UpdateInsurer", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	var body model_booking_service.UpdateInsurerReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateInsurer") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().UpdateInsurer(ctx, &pb.Insurer{
+		Id:          body.Id,
+		Name:        body.Name,
+		Code:        body.Code,
+		Email:       body.Email,
+		PhoneNumber: body.PhoneNumber,
+	})
+	if h.insuranceError(c, err, "UpdateInsurer") {
+		return
+	}
+
+	c.JSON(http.StatusOK, insurerToRes(res))
+}
+
+// DeleteInsurer ...
+// @Summary DeleteInsurer
+// @Description DeleteInsurer - Api for delete an insurance company; its policies and claims are kept
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/insurer [delete]
+func (h *HandlerV1) DeleteInsurer(c *gin.Context) {
+	if _, ok := h.requireRole(c, "DeleteInsurer", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().DeleteInsurer(ctx, &pb.InsuranceFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.insuranceError(c, err, "DeleteInsurer") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: res.Status})
+}
+
+// CreateInsurancePolicy ...
+// @Summary CreateInsurancePolicy
+// @Description CreateInsurancePolicy - Api for record a patient's insurance policy.
+// @Description annual_limit caps what the insurer pays for the patient in a calendar year; empty or "0.00" means no cap.
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param InsurancePolicyReq body model_booking_service.InsurancePolicyReq true "InsurancePolicyReq"
+// @Success 200 {object} model_booking_service.InsurancePolicy
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/insurance-policy [post]
+func (h *HandlerV1) CreateInsurancePolicy(c *gin.Context) {
+	if _, ok := h.requireRole(c, "CreateInsurancePolicy", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	var body model_booking_service.InsurancePolicyReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateInsurancePolicy") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().CreatePolicy(ctx, &pb.InsurancePolicy{
+		Id:           uuid.NewString(),
+		InsurerId:    body.InsurerId,
+		PatientId:    body.PatientId,
+		PolicyNumber: body.PolicyNumber,
+		ValidFrom:    body.ValidFrom,
+		ValidTo:      body.ValidTo,
+		AnnualLimit:  body.AnnualLimit,
+		Currency:     body.Currency,
+	})
+	if h.insuranceError(c, err, "CreateInsurancePolicy") {
+		return
+	}
+
+	c.JSON(http.StatusOK, insurancePolicyToRes(res))
+}
+
+// GetInsurancePolicy ...
+// @Summary GetInsurancePolicy
+// @Description GetInsurancePolicy - Api for get an insurance policy, for cashiers, admins and the patient's account
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.InsurancePolicy
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/insurance-policy/get [get]
+func (h *HandlerV1) GetInsurancePolicy(c *gin.Context) {
+	userInfo, ok := h.requireRole(c, "GetInsurancePolicy", RoleUser, RoleCashier, RoleAdmin)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().GetPolicy(ctx, &pb.InsuranceFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.insuranceError(c, err, "GetInsurancePolicy") {
+		return
+	}
+	if !h.insuranceAccess(c, ctx, userInfo, res.PatientId, "GetInsurancePolicy") {
+		return
+	}
+
+	c.JSON(http.StatusOK, insurancePolicyToRes(res))
+}
+
+// ListInsurancePolicies ...
+// @Summary ListInsurancePolicies
+// @Description ListInsurancePolicies - Api for list insurance policies of a patient or an insurer, for cashiers and admins.
+// @Description The patient's account lists those of its patient with patient_id.
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param patient_id query string false "patient_id"
+// @Param insurer_id query string false "insurer_id"
+// @Success 200 {object} model_booking_service.InsurancePoliciesType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/insurance-policy [get]
+func (h *HandlerV1) ListInsurancePolicies(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListInsurancePolicies") {
+		return
+	}
+
+	userInfo, ok := h.requireRole(c, "ListInsurancePolicies", RoleUser, RoleCashier, RoleAdmin)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	if !h.insuranceAccess(c, ctx, userInfo, c.Query("patient_id"), "ListInsurancePolicies") {
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().Insurance().GetAllPolicies(ctx, &pb.GetAllInsuranceReq{
+		Page:      pageInt,
+		Limit:     limitInt,
+		PatientId: c.Query("patient_id"),
+		InsurerId: c.Query("insurer_id"),
+		Value:     c.Query("value"),
+	})
+	if h.insuranceError(c, err, "ListInsurancePolicies") {
+		return
+	}
+
+	policies := &model_booking_service.InsurancePoliciesType{Count: res.Count}
+	for _, policy := range res.Policies {
+		policies.Policies = append(policies.Policies, insurancePolicyToRes(policy))
+	}
+
+	c.JSON(http.StatusOK, policies)
+}
+
+// CancelInsurancePolicy ...
+// @Summary CancelInsurancePolicy
+// @Description CancelInsurancePolicy - Api for cancel an insurance policy; claims already filed are kept
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.InsurancePolicy
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/insurance-policy/cancel [put]
+func (h *HandlerV1) CancelInsurancePolicy(c *gin.Context) {
+	if _, ok := h.requireRole(c, "CancelInsurancePolicy", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().CancelPolicy(ctx, &pb.InsuranceFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.insuranceError(c, err, "CancelInsurancePolicy") {
+		return
+	}
+
+	c.JSON(http.StatusOK, insurancePolicyToRes(res))
+}
+
+// SetCoverageRule ...
+// @Summary SetCoverageRule
+// @Description SetCoverageRule - Api for set what an insurer covers of a doctor service, replacing its earlier rule.
+// @Description A percentage rule pays that share of the price; a copay rule leaves the patient the copay amount and pays the rest.
+// @Description annual_limit caps what the insurer pays for the service per patient in a calendar year; empty or "0.00" means no cap.
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param CoverageRuleReq body model_booking_service.CoverageRuleReq true "CoverageRuleReq"
+// @Success 200 {object} model_booking_service.CoverageRule
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/coverage-rule [put]
+func (h *HandlerV1) SetCoverageRule(c *gin.Context) {
+	if _, ok := h.requireRole(c, "SetCoverageRule", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	var body model_booking_service.CoverageRuleReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "SetCoverageRule") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().SetCoverageRule(ctx, &pb.CoverageRule{
+		Id:              uuid.NewString(),
+		InsurerId:       body.InsurerId,
+		DoctorServiceId: body.DoctorServiceId,
+		Kind:            body.Kind,
+		Percentage:      body.Percentage,
+		Copay:           body.Copay,
+		AnnualLimit:     body.AnnualLimit,
+	})
+	if h.insuranceError(c, err, "SetCoverageRule") {
+		return
+	}
+
+	c.JSON(http.StatusOK, coverageRuleToRes(res))
+}
+
+// ListCoverageRules ...
+// @Summary ListCoverageRules
+// @Description ListCoverageRules - Api for list the coverage rules of an insurer
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param insurer_id query string false "insurer_id"
+// @Success 200 {object} model_booking_service.CoverageRulesType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/coverage-rule [get]
+func (h *HandlerV1) ListCoverageRules(c *gin.Context) {
+	if _, ok := h.requireRole(c, "ListCoverageRules", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListCoverageRules") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().GetAllCoverageRules(ctx, &pb.GetAllInsuranceReq{
+		Page:      pageInt,
+		Limit:     limitInt,
+		InsurerId: c.Query("insurer_id"),
+	})
+	if h.insuranceError(c, err, "ListCoverageRules") {
+		return
+	}
+
+	rules := &model_booking_service.CoverageRulesType{Count: res.Count}
+	for _, rule := range res.Rules {
+		rules.Rules = append(rules.Rules, coverageRuleToRes(rule))
+	}
+
+	c.JSON(http.StatusOK, rules)
+}
+
+// DeleteCoverageRule ...
+// @Summary DeleteCoverageRule
+// @Description DeleteCoverageRule - Api for stop an insurer covering a doctor service
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/coverage-rule [delete]
+func (h *HandlerV1) DeleteCoverageRule(c *gin.Context) {
+	if _, ok := h.requireRole(c, "DeleteCoverageRule", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().DeleteCoverageRule(ctx, &pb.InsuranceFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.insuranceError(c, err, "DeleteCoverageRule") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: res.Status})
+}
+
+// CheckInsuranceEligibility ...
+// @Summary CheckInsuranceEligibility
+// @Description CheckInsuranceEligibility - Api for how much of a doctor service's price a patient's insurance pays on a day,
+// @Description taking the price in force for the modality and what the policy already paid this year.
+// @Description Without policy_id the patient's first policy in force that covers the service is used.
+// @Description For cashiers, admins and the patient's account.
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param patient_id query string true "patient_id"
+// @Param doctor_service_id query string true "doctor_service_id"
+// @Param modality query string false "modality" Enums(offline, online)
+// @Param policy_id query string false "policy_id"
+// @Param date query string false "date" example(2024-05-01)
+// @Success 200 {object} model_booking_service.Eligibility
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/insurance/eligibility [get]
+func (h *HandlerV1) CheckInsuranceEligibility(c *gin.Context) {
+	userInfo, ok := h.requireRole(c, "CheckInsuranceEligibility", RoleUser, RoleCashier, RoleAdmin)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	if !h.insuranceAccess(c, ctx, userInfo, c.Query("patient_id"), "CheckInsuranceEligibility") {
+		return
+	}
+
+	modality := c.Query("modality")
+	if modality == "" {
+		modality = "offline"
+	}
+
+	res, err := h.eligibility(ctx, c.Query("patient_id"), c.Query("policy_id"), c.Query("doctor_service_id"), modality, c.Query("date"))
+	if h.insuranceError(c, err, "CheckInsuranceEligibility") {
+		return
+	}
+
+	c.JSON(http.StatusOK, eligibilityToRes(res))
+}
+
+// insuranceAccess lets cashiers and admins see the insurance of every
+// patient, and a user that of the patient profiles of their account only.
+func (h *HandlerV1) insuranceAccess(c *gin.Context, ctx context.Context, userInfo *e.UserTokenRes, patientId, method string) bool {
+	if userInfo.Role != RoleUser {
+		return true
+	}
+	return !h.staffAccessError(c, h.patientAccess(ctx, userInfo, patientId), method)
+}
+
+// eligibility checks the patient's insurance against the price in force for
+// the doctor service and modality.
+func (h *HandlerV1) eligibility(ctx context.Context, patientId, policyId, doctorServiceId, modality, date string) (*pb.Eligibility, error) {
+	servicePrice, err := h.serviceManager.HealthcareService().DoctorsService().GetEffectivePrice(ctx, &pbh.GetEffectivePriceReq{
+		DoctorServiceId: doctorServiceId,
+		Modality:        modality,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h.serviceManager.BookingService().Insurance().CheckEligibility(ctx, &pb.EligibilityReq{
+		PatientId:       patientId,
+		PolicyId:        policyId,
+		DoctorServiceId: doctorServiceId,
+		Price:           servicePrice.Amount,
+		Currency:        servicePrice.Currency,
+		Date:            date,
+	})
+}
+
+// CreateClaim ...
+// @Summary CreateClaim
+// @Description CreateClaim - Api for file an insurance claim for the service booked in an appointment.
+// @Description The claim asks for what the policy covers of the booked price, within the annual limits; one claim per appointment.
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ClaimReq body model_booking_service.ClaimReq true "ClaimReq"
+// @Success 200 {object} model_booking_service.InsuranceClaim
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/claim [post]
+func (h *HandlerV1) CreateClaim(c *gin.Context) {
+	if _, ok := h.requireRole(c, "CreateClaim", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	var body model_booking_service.ClaimReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateClaim") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().CreateClaim(ctx, &pb.CreateClaimReq{
+		Id:            uuid.NewString(),
+		AppointmentId: body.AppointmentId,
+		PolicyId:      body.PolicyId,
+	})
+	if h.insuranceError(c, err, "CreateClaim") {
+		return
+	}
+
+	c.JSON(http.StatusOK, claimToRes(res))
+}
+
+// GetClaim ...
+// @Summary GetClaim
+// @Description GetClaim - Api for get an insurance claim
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.InsuranceClaim
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/claim/get [get]
+func (h *HandlerV1) GetClaim(c *gin.Context) {
+	if _, ok := h.requireRole(c, "GetClaim", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().GetClaim(ctx, &pb.InsuranceFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.insuranceError(c, err, "GetClaim") {
+		return
+	}
+
+	c.JSON(http.StatusOK, claimToRes(res))
+}
+
+// ListClaims ...
+// @Summary ListClaims
+// @Description ListClaims - Api for list insurance claims, e.g. the pending ones of an insurer or those of an export batch
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param insurer_id query string false "insurer_id"
+// @Param patient_id query string false "patient_id"
+// @Param batch_id query string false "batch_id"
+// @Param status query string false "status" Enums(pending, submitted, approved, rejected, paid)
+// @Success 200 {object} model_booking_service.InsuranceClaimsType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/claim [get]
+func (h *HandlerV1) ListClaims(c *gin.Context) {
+	if _, ok := h.requireRole(c, "ListClaims", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListClaims") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().GetAllClaims(ctx, &pb.GetAllClaimsReq{
+		Page:      pageInt,
+		Limit:     limitInt,
+		InsurerId: c.Query("insurer_id"),
+		PatientId: c.Query("patient_id"),
+		BatchId:   c.Query("batch_id"),
+		Status:    c.Query("status"),
+	})
+	if h.insuranceError(c, err, "ListClaims") {
+		return
+	}
+
+	claims := &model_booking_service.InsuranceClaimsType{Count: res.Count}
+	for _, claim := range res.Claims {
+		claims.Claims = append(claims.Claims, claimToRes(claim))
+	}
+
+	c.JSON(http.StatusOK, claims)
+}
+
+// SetClaimStatus ...
+// @Summary SetClaimStatus
+// @Description SetClaimStatus - Api for record the insurer's decision on a submitted claim.
+// @Description approved may be less than claimed; empty approves the whole claim.
+// @Description A paid claim is credited to the appointment's invoice as an insurance payment.
+// @Tags Insurance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ClaimStatusReq body model_booking_service.ClaimStatusReq true "ClaimStatusReq"
+// @Success 200 {object} model_booking_service.InsuranceClaim
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/claim/status [put]
+func (h *HandlerV1) SetClaimStatus(c *gin.Context) {
+	if _, ok := h.requireRole(c, "SetClaimStatus", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	var body model_booking_service.ClaimStatusReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "SetClaimStatus") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Insurance().SetClaimStatus(ctx, &pb.ClaimStatusReq{
+		Id:       body.Id,
+		Status:   body.Status,
+		Approved: body.Approved,
+		Reason:   body.Reason,
+	})
+	if h.insuranceError(c, err, "SetClaimStatus") {
+		return
+	}
+
+	c.JSON(http.StatusOK, claimToRes(res))
+}
+
+// ExportClaims ...
+// @Summary ExportClaims
+// @Description ExportClaims - Api for export an insurer's pending claims serviced in a period as a CSV or JSON file.
+// @Description The exported claims are marked submitted and can be downloaded again by the batch id in the X-Claim-Batch-Id header.
+// @Tags Insurance
+// @Accept json
+// @Produce text/csv,application/json
+// @Security ApiKeyAuth
+// @Param ExportClaimsReq body model_booking_service.ExportClaimsReq true "ExportClaimsReq"
+// @Success 200 {file} file
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/claim-batch [post]
+func (h *HandlerV1) ExportClaims(c *gin.Context) {
+	cashier, ok := h.requireRole(c, "ExportClaims", RoleCashier, RoleAdmin)
+	if !ok {
+		return
+	}
+
+	var body model_booking_service.ExportClaimsReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ExportClaims") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	file, err := h.serviceManager.BookingService().Insurance().ExportClaims(ctx, &pb.ExportClaimsReq{
+		Id:         uuid.NewString(),
+		InsurerId:  body.InsurerId,
+		PeriodFrom: body.PeriodFrom,
+		PeriodTo:   body.PeriodTo,
+		Format:     body.Format,
+		CreatedBy:  cashier.UserId,
+	})
+	if h.insuranceError(c, err, "ExportClaims") {
+		return
+	}
+
+	c.Header("X-Claim-Batch-Id", file.Batch.Id)
+	c.Header("Content-Disposition", "attachment; filename=\""+file.FileName+"\"")
+	c.Data(http.StatusOK, file.ContentType, file.Content)
+}
+
+// DownloadClaimBatch ...
+// @Summary DownloadClaimBatch
+// @Description DownloadClaimBatch - Api for download an earlier claims export again
+// @Tags Insurance
+// @Produce text/csv,application/json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {file} file
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/claim-batch/download [get]
+func (h *HandlerV1) DownloadClaimBatch(c *gin.Context) {
+	if _, ok := h.requireRole(c, "DownloadClaimBatch", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	file, err := h.serviceManager.BookingService().Insurance().DownloadClaimBatch(ctx, &pb.InsuranceFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.insuranceError(c, err, "DownloadClaimBatch") {
+		return
+	}
+
+	c.Header("X-Claim-Batch-Id", file.Batch.Id)
+	c.Header("Content-Disposition", "attachment; filename=\""+file.FileName+"\"")
+	c.Data(http.StatusOK, file.ContentType, file.Content)
+}
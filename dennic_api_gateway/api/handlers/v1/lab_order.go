@@ -0,0 +1,458 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models/model_booking_service"
+	pb "dennic_api_gateway/genproto/booking_service"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func labOrderToRes(order *pb.LabOrder) *model_booking_service.LabOrder {
+	res := &model_booking_service.LabOrder{
+		Id:            order.Id,
+		AppointmentId: order.AppointmentId,
+		PatientId:     order.PatientId,
+		DoctorId:      order.DoctorId,
+		Status:        order.Status,
+		Notes:         order.Notes,
+		OrderedAt:     order.OrderedAt,
+		ResultedAt:    order.ResultedAt,
+		ReleasedAt:    order.ReleasedAt,
+		CancelledAt:   order.CancelledAt,
+	}
+	for _, test := range order.Tests {
+		res.Tests = append(res.Tests, &model_booking_service.LabTest{
+			Code: test.Code,
+			Name: test.Name,
+		})
+	}
+	for _, result := range order.Results {
+		res.Results = append(res.Results, &model_booking_service.LabResult{
+			TestCode:       result.TestCode,
+			Analyte:        result.Analyte,
+			Value:          result.Value,
+			Unit:           result.Unit,
+			ReferenceRange: result.ReferenceRange,
+			Flag:           result.Flag,
+		})
+	}
+	for _, attachment := range order.Attachments {
+		res.Attachments = append(res.Attachments, &model_booking_service.LabAttachment{
+			FileName: attachment.FileName,
+			Url:      attachment.Url,
+		})
+	}
+	return res
+}
+
+// labOrderError answers with the status matching a lab order call.
+func (h *HandlerV1) labOrderError(c *gin.Context, err error, method string) bool {
+	switch status.Code(err) {
+	case codes.OK:
+		return false
+	case codes.InvalidArgument:
+		return e.HandleError(c, err, h.log, http.StatusBadRequest, method)
+	case codes.NotFound:
+		return e.HandleError(c, err, h.log, http.StatusNotFound, method)
+	case codes.FailedPrecondition:
+		return e.HandleError(c, err, h.log, http.StatusConflict, method)
+	}
+	return e.HandleError(c, err, h.log, http.StatusInternalServerError, method)
+}
+
+// CreateLabOrder ...
+// @Summary CreateLabOrder
+// @Description CreateLabOrder - Api for ordering lab tests in an appointment.
+// @Description Patient and doctor are taken from the appointment, which must be one of the caller's.
+// @Tags LabOrder
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param LabOrderReq body model_booking_service.LabOrderReq true "LabOrderReq"
+// @Success 200 {object} model_booking_service.LabOrder
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/lab-order [post]
+func (h *HandlerV1) CreateLabOrder(c *gin.Context) {
+	var body model_booking_service.LabOrderReq
+
+	doctor, ok := h.requireRole(c, "CreateLabOrder", RoleDoctor)
+	if !ok {
+		return
+	}
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateLabOrder") {
+		return
+	}
+
+	req := &pb.CreateLabOrderReq{
+		Id:            uuid.NewString(),
+		AppointmentId: body.AppointmentId,
+		Notes:         body.Notes,
+	}
+	for _, test := range body.Tests {
+		req.Tests = append(req.Tests, &pb.LabTest{
+			Code: test.Code,
+			Name: test.Name,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	appointment, err := h.serviceManager.BookingService().BookedAppointment().GetAppointment(ctx, &pb.AppointmentFieldValueReq{
+		Field: "id",
+		Value: strconv.FormatInt(body.AppointmentId, 10),
+	})
+	if h.labOrderError(c, err, "CreateLabOrder") {
+		return
+	}
+	if appointment.DoctorId != doctor.UserId {
+		e.HandleError(c, errors.New("the appointment is another doctor's"), h.log, http.StatusForbidden, "CreateLabOrder")
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().LabOrders().CreateLabOrder(ctx, req)
+	if h.labOrderError(c, err, "CreateLabOrder") {
+		return
+	}
+
+	c.JSON(http.StatusOK, labOrderToRes(res))
+}
+
+// GetLabOrder ...
+// @Summary GetLabOrder
+// @Description GetLabOrder - Api for get lab order with its results
+// @Tags LabOrder
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.LabOrder
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/lab-order/get [get]
+func (h *HandlerV1) GetLabOrder(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().LabOrders().GetLabOrder(ctx, &pb.LabOrderFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.labOrderError(c, err, "GetLabOrder") {
+		return
+	}
+
+	c.JSON(http.StatusOK, labOrderToRes(res))
+}
+
+// ListLabOrders ...
+// @Summary ListLabOrders
+// @Description ListLabOrders - Api for list lab orders, e.g. the lab's work queue with status=ordered
+// @Tags LabOrder
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param patient_id query string false "patient_id"
+// @Param doctor_id query string false "doctor_id"
+// @Param status query string false "status" Enums(ordered, resulted, released, cancelled)
+// @Success 200 {object} model_booking_service.LabOrdersType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/lab-order [get]
+func (h *HandlerV1) ListLabOrders(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListLabOrders") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().LabOrders().GetAllLabOrders(ctx, &pb.GetAllLabOrdersReq{
+		Page:      pageInt,
+		Limit:     limitInt,
+		PatientId: c.Query("patient_id"),
+		DoctorId:  c.Query("doctor_id"),
+		Status:    c.Query("status"),
+	})
+	if h.labOrderError(c, err, "ListLabOrders") {
+		return
+	}
+
+	c.JSON(http.StatusOK, labOrdersToRes(res))
+}
+
+// SubmitLabResults ...
+// @Summary SubmitLabResults
+// @Description SubmitLabResults - Api for the lab to report results. Values are flagged against their reference range.
+// @Description Reports are uploaded first through /v1/file-upload with bucketName=lab. Results can be corrected until released.
+// @Description For lab accounts only.
+// @Tags LabOrder
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param LabResultsReq body model_booking_service.LabResultsReq true "LabResultsReq"
+// @Success 200 {object} model_booking_service.LabOrder
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/lab-order/results [put]
+func (h *HandlerV1) SubmitLabResults(c *gin.Context) {
+	var body model_booking_service.LabResultsReq
+
+	if _, ok := h.requireRole(c, "SubmitLabResults", RoleLab); !ok {
+		return
+	}
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "SubmitLabResults") {
+		return
+	}
+
+	req := &pb.SubmitLabResultsReq{Id: body.Id}
+	for _, result := range body.Results {
+		req.Results = append(req.Results, &pb.LabResult{
+			TestCode:       result.TestCode,
+			Analyte:        result.Analyte,
+			Value:          result.Value,
+			Unit:           result.Unit,
+			ReferenceRange: result.ReferenceRange,
+		})
+	}
+	for _, attachment := range body.Attachments {
+		req.Attachments = append(req.Attachments, &pb.LabAttachment{
+			FileName: attachment.FileName,
+			Url:      attachment.Url,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().LabOrders().SubmitLabResults(ctx, req)
+	if h.labOrderError(c, err, "SubmitLabResults") {
+		return
+	}
+
+	c.JSON(http.StatusOK, labOrderToRes(res))
+}
+
+// ReleaseLabOrder ...
+// @Summary ReleaseLabOrder
+// @Description ReleaseLabOrder - Api for the ordering doctor to release resulted lab results to the patient
+// @Tags LabOrder
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.LabOrder
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/lab-order/release [put]
+func (h *HandlerV1) ReleaseLabOrder(c *gin.Context) {
+	doctor, ok := h.requireRole(c, "ReleaseLabOrder", RoleDoctor)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	if !h.orderedBy(c, ctx, doctor, "ReleaseLabOrder") {
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().LabOrders().ReleaseLabOrder(ctx, &pb.LabOrderFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.labOrderError(c, err, "ReleaseLabOrder") {
+		return
+	}
+
+	c.JSON(http.StatusOK, labOrderToRes(res))
+}
+
+// CancelLabOrder ...
+// @Summary CancelLabOrder
+// @Description CancelLabOrder - Api for the ordering doctor or an admin to cancel a lab order the lab has not reported on
+// @Tags LabOrder
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.LabOrder
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/lab-order/cancel [put]
+func (h *HandlerV1) CancelLabOrder(c *gin.Context) {
+	userInfo, ok := h.requireRole(c, "CancelLabOrder", RoleDoctor, RoleAdmin)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	if userInfo.Role == RoleDoctor && !h.orderedBy(c, ctx, userInfo, "CancelLabOrder") {
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().LabOrders().CancelLabOrder(ctx, &pb.LabOrderFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.labOrderError(c, err, "CancelLabOrder") {
+		return
+	}
+
+	c.JSON(http.StatusOK, labOrderToRes(res))
+}
+
+// ListMyLabResults ...
+// @Summary ListMyLabResults
+// @Description ListMyLabResults - Api for the released lab results of a patient profile of the caller's account
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param patient_id query string true "patient_id"
+// @Param ListReq query models.ListReq false "ListReq"
+// @Success 200 {object} model_booking_service.LabOrdersType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/lab-results [get]
+func (h *HandlerV1) ListMyLabResults(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListMyLabResults") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "ListMyLabResults") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	patientId := c.Query("patient_id")
+	if h.myPatientError(c, h.ownPatient(ctx, userInfo.UserId, patientId), "ListMyLabResults") {
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().LabOrders().GetAllLabOrders(ctx, &pb.GetAllLabOrdersReq{
+		Page:      pageInt,
+		Limit:     limitInt,
+		PatientId: patientId,
+		Status:    "released",
+	})
+	if h.labOrderError(c, err, "ListMyLabResults") {
+		return
+	}
+
+	c.JSON(http.StatusOK, labOrdersToRes(res))
+}
+
+// GetMyLabResult ...
+// @Summary GetMyLabResult
+// @Description GetMyLabResult - Api for a released lab result of a patient profile of the caller's account
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.LabOrder
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/lab-results/get [get]
+func (h *HandlerV1) GetMyLabResult(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "GetMyLabResult") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().LabOrders().GetLabOrder(ctx, &pb.LabOrderFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.labOrderError(c, err, "GetMyLabResult") {
+		return
+	}
+
+	// unreleased results and other accounts' results look the same: not found
+	if res.Status != "released" {
+		e.HandleError(c, errors.New("lab result not found"), h.log, http.StatusNotFound, "GetMyLabResult")
+		return
+	}
+	if h.myPatientError(c, h.ownPatient(ctx, userInfo.UserId, res.PatientId), "GetMyLabResult") {
+		return
+	}
+
+	c.JSON(http.StatusOK, labOrderToRes(res))
+}
+
+// ownPatient reports NotFound unless the patient profile belongs to the account.
+// orderedBy answers forbidden unless the lab order in the id query parameter
+// was ordered by the doctor.
+func (h *HandlerV1) orderedBy(c *gin.Context, ctx context.Context, doctor *e.UserTokenRes, method string) bool {
+	order, err := h.serviceManager.BookingService().LabOrders().GetLabOrder(ctx, &pb.LabOrderFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.labOrderError(c, err, method) {
+		return false
+	}
+	if order.DoctorId != doctor.UserId {
+		e.HandleError(c, errors.New("the lab order was ordered by another doctor"), h.log, http.StatusForbidden, method)
+		return false
+	}
+	return true
+}
+
+func (h *HandlerV1) ownPatient(ctx context.Context, userId, patientId string) error {
+	_, err := h.serviceManager.BookingService().PatientService().GetPatient(ctx, &pb.PatientFieldValueReq{
+		Field:  "id",
+		Value:  patientId,
+		UserId: userId,
+	})
+	return err
+}
+
+func labOrdersToRes(res *pb.LabOrdersType) model_booking_service.LabOrdersType {
+	var orders model_booking_service.LabOrdersType
+	for _, order := range res.LabOrders {
+		orders.LabOrders = append(orders.LabOrders, labOrderToRes(order))
+	}
+	orders.Count = res.Count
+	return orders
+}
@@ -0,0 +1,273 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models"
+	"dennic_api_gateway/api/models/model_booking_service"
+	pb "dennic_api_gateway/genproto/booking_service"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func patientToRes(patient *pb.Patient) *model_booking_service.Patient {
+	return &model_booking_service.Patient{
+		Id:             patient.Id,
+		FirstName:      patient.FirstName,
+		LastName:       patient.LastName,
+		BirthDate:      patient.BirthDate,
+		Gender:         patient.Gender,
+		Address:        patient.Address,
+		BloodGroup:     patient.BloodGroup,
+		PhoneNumber:    patient.PhoneNumber,
+		City:           patient.City,
+		Country:        patient.Country,
+		PatientProblem: patient.PatientProblem,
+		UserId:         patient.UserId,
+		Relationship:   patient.Relationship,
+		Consent:        patient.Consent,
+		ConsentAt:      patient.ConsentAt,
+		CreatedAt:      patient.CreatedAt,
+		UpdatedAt:      e.UpdateTimeFilter(patient.UpdatedAt),
+	}
+}
+
+// myPatientError answers with the status matching an ownership-scoped patient call.
+func (h *HandlerV1) myPatientError(c *gin.Context, err error, method string) bool {
+	switch status.Code(err) {
+	case codes.OK:
+		return false
+	case codes.InvalidArgument:
+		return e.HandleError(c, err, h.log, http.StatusBadRequest, method)
+	case codes.NotFound:
+		return e.HandleError(c, err, h.log, http.StatusNotFound, method)
+	case codes.AlreadyExists:
+		return e.HandleError(c, err, h.log, http.StatusConflict, method)
+	}
+	return e.HandleError(c, err, h.log, http.StatusInternalServerError, method)
+}
+
+// CreateMyPatient ...
+// @Summary CreateMyPatient
+// @Description CreateMyPatient - Api for adding a patient profile to the caller's account.
+// @Description An account has one "self" profile; profiles of dependents need consent.
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param MyPatientReq body model_booking_service.MyPatientReq true "MyPatientReq"
+// @Success 200 {object} model_booking_service.Patient
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/patients [post]
+func (h *HandlerV1) CreateMyPatient(c *gin.Context) {
+	var body model_booking_service.MyPatientReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateMyPatient") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "CreateMyPatient") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().PatientService().CreatePatient(ctx, &pb.CreatePatientReq{
+		Id:             uuid.NewString(),
+		FirstName:      body.FirstName,
+		LastName:       body.LastName,
+		BirthDate:      body.BirthDate,
+		Gender:         body.Gender,
+		Address:        body.Address,
+		BloodGroup:     body.BloodGroup,
+		PhoneNumber:    body.PhoneNumber,
+		City:           body.City,
+		Country:        body.Country,
+		PatientProblem: body.PatientProblem,
+		UserId:         userInfo.UserId,
+		Relationship:   body.Relationship,
+		Consent:        body.Consent,
+	})
+	if h.myPatientError(c, err, "CreateMyPatient") {
+		return
+	}
+
+	c.JSON(http.StatusOK, patientToRes(res))
+}
+
+// GetMyPatient ...
+// @Summary GetMyPatient
+// @Description GetMyPatient - Api for get a patient profile of the caller's account
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.Patient
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/patients/get [get]
+func (h *HandlerV1) GetMyPatient(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "GetMyPatient") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().PatientService().GetPatient(ctx, &pb.PatientFieldValueReq{
+		Field:  "id",
+		Value:  c.Query("id"),
+		UserId: userInfo.UserId,
+	})
+	if h.myPatientError(c, err, "GetMyPatient") {
+		return
+	}
+
+	c.JSON(http.StatusOK, patientToRes(res))
+}
+
+// ListMyPatients ...
+// @Summary ListMyPatients
+// @Description ListMyPatients - Api for list the patient profiles the caller manages
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ListReq query models.ListReq false "ListReq"
+// @Success 200 {object} model_booking_service.PatientsType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/patients [get]
+func (h *HandlerV1) ListMyPatients(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListMyPatients") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "ListMyPatients") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().PatientService().GetAllPatients(ctx, &pb.GetAllPatientsReq{
+		Page:    pageInt,
+		Limit:   limitInt,
+		OrderBy: "created_at",
+		UserId:  userInfo.UserId,
+	})
+	if h.myPatientError(c, err, "ListMyPatients") {
+		return
+	}
+
+	var patients model_booking_service.PatientsType
+	for _, patient := range res.Patients {
+		patients.Patients = append(patients.Patients, patientToRes(patient))
+	}
+	patients.Count = res.Count
+
+	c.JSON(http.StatusOK, patients)
+}
+
+// UpdateMyPatient ...
+// @Summary UpdateMyPatient
+// @Description UpdateMyPatient - Api for update a patient profile of the caller's account
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param UpdateMyPatientReq body model_booking_service.UpdateMyPatientReq true "UpdateMyPatientReq"
+// @Success 200 {object} model_booking_service.Patient
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/patients [put]
+func (h *HandlerV1) UpdateMyPatient(c *gin.Context) {
+	var body model_booking_service.UpdateMyPatientReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateMyPatient") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "UpdateMyPatient") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().PatientService().UpdatePatient(ctx, &pb.UpdatePatientReq{
+		Field:          "id",
+		Value:          body.PatientId,
+		FirstName:      body.FirstName,
+		LastName:       body.LastName,
+		BirthDate:      body.BirthDate,
+		Gender:         body.Gender,
+		Address:        body.Address,
+		BloodGroup:     body.BloodGroup,
+		City:           body.City,
+		Country:        body.Country,
+		PatientProblem: body.PatientProblem,
+		UserId:         userInfo.UserId,
+		Relationship:   body.Relationship,
+		Consent:        body.Consent,
+	})
+	if h.myPatientError(c, err, "UpdateMyPatient") {
+		return
+	}
+
+	c.JSON(http.StatusOK, patientToRes(res))
+}
+
+// DeleteMyPatient ...
+// @Summary DeleteMyPatient
+// @Description DeleteMyPatient - Api for removing a patient profile from the caller's account
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/patients [delete]
+func (h *HandlerV1) DeleteMyPatient(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "DeleteMyPatient") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().PatientService().DeletePatient(ctx, &pb.PatientFieldValueReq{
+		Field:  "id",
+		Value:  c.Query("id"),
+		UserId: userInfo.UserId,
+	})
+	if h.myPatientError(c, err, "DeleteMyPatient") {
+		return
+	}
+	if !res.Status {
+		c.JSON(http.StatusNotFound, models.StatusRes{Status: false})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: true})
+}
@@ -0,0 +1,580 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models/model_booking_service"
+	pb "dennic_api_gateway/genproto/booking_service"
+	"dennic_api_gateway/internal/pkg/minio"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Sides of a conversation. A doctor signs in with their doctor id, so the
+// caller is the doctor of a conversation when their user id is its doctor id.
+const (
+	rolePatient = "patient"
+	roleDoctor  = "doctor"
+)
+
+func conversationToRes(conversation *pb.Conversation) *model_booking_service.Conversation {
+	return &model_booking_service.Conversation{
+		Id:              conversation.Id,
+		PatientId:       conversation.PatientId,
+		DoctorId:        conversation.DoctorId,
+		AppointmentId:   conversation.AppointmentId,
+		LastMessageAt:   conversation.LastMessageAt,
+		CreatedAt:       conversation.CreatedAt,
+		Unread:          conversation.Unread,
+		DoctorAvailable: conversation.DoctorAvailable,
+		RepliesFrom:     conversation.RepliesFrom,
+	}
+}
+
+func messageToRes(message *pb.ConversationMessage) *model_booking_service.ConversationMessage {
+	res := &model_booking_service.ConversationMessage{
+		Id:             message.Id,
+		ConversationId: message.ConversationId,
+		SenderId:       message.SenderId,
+		SenderRole:     message.SenderRole,
+		Body:           message.Body,
+		ReadAt:         message.ReadAt,
+		CreatedAt:      message.CreatedAt,
+	}
+	if attachment := message.Attachment; attachment != nil {
+		res.Attachment = &model_booking_service.MessageAttachment{
+			FileName:    attachment.FileName,
+			ContentType: attachment.ContentType,
+			SizeBytes:   attachment.SizeBytes,
+		}
+	}
+	return res
+}
+
+func replyHoursToRes(hours *pb.ReplyHours) *model_booking_service.ReplyHours {
+	res := &model_booking_service.ReplyHours{DoctorId: hours.DoctorId, Windows: []*model_booking_service.ReplyWindow{}}
+	for _, window := range hours.Windows {
+		res.Windows = append(res.Windows, &model_booking_service.ReplyWindow{
+			Weekday:  window.Weekday,
+			StartsAt: window.StartsAt,
+			EndsAt:   window.EndsAt,
+		})
+	}
+	return res
+}
+
+// messageError answers with the status matching a messaging call.
+func (h *HandlerV1) messageError(c *gin.Context, err error, method string) bool {
+	switch status.Code(err) {
+	case codes.OK:
+		return false
+	case codes.InvalidArgument:
+		return e.HandleError(c, err, h.log, http.StatusBadRequest, method)
+	case codes.NotFound:
+		return e.HandleError(c, err, h.log, http.StatusNotFound, method)
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return e.HandleError(c, err, h.log, http.StatusConflict, method)
+	}
+	return e.HandleError(c, err, h.log, http.StatusInternalServerError, method)
+}
+
+// myConversation fetches a conversation the caller takes part in, as its
+// doctor or through a patient profile of their account, and tells the side
+// they are on. Other conversations are not found.
+func (h *HandlerV1) myConversation(c *gin.Context, ctx context.Context, userId, id, method string) (*pb.Conversation, string, bool) {
+	if _, err := uuid.Parse(id); err != nil {
+		e.HandleError(c, errors.New("conversation_id must be a uuid"), h.log, http.StatusBadRequest, method)
+		return nil, "", false
+	}
+
+	conversation, err := h.serviceManager.BookingService().Messages().GetConversation(ctx, &pb.ConversationReq{
+		Id:         id,
+		ReaderRole: rolePatient,
+	})
+	if h.messageError(c, err, method) {
+		return nil, "", false
+	}
+
+	if conversation.DoctorId == userId {
+		conversation, err = h.serviceManager.BookingService().Messages().GetConversation(ctx, &pb.ConversationReq{
+			Id:         id,
+			ReaderRole: roleDoctor,
+		})
+		if h.messageError(c, err, method) {
+			return nil, "", false
+		}
+		return conversation, roleDoctor, true
+	}
+
+	if h.myPatientError(c, h.ownPatient(ctx, userId, conversation.PatientId), method) {
+		return nil, "", false
+	}
+
+	return conversation, rolePatient, true
+}
+
+// StartMyConversation ...
+// @Summary StartMyConversation
+// @Description StartMyConversation - Api for opening a conversation with a doctor a patient profile of the caller's account had an appointment with,
+// @Description or with a patient of the calling doctor. A conversation is about the given appointment, or about none when appointment_id is 0.
+// @Description Starting a conversation that exists returns it.
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param StartConversationReq body model_booking_service.StartConversationReq true "StartConversationReq"
+// @Success 200 {object} model_booking_service.Conversation
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/conversations [post]
+func (h *HandlerV1) StartMyConversation(c *gin.Context) {
+	var body model_booking_service.StartConversationReq
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "StartMyConversation") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "StartMyConversation") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	role := roleDoctor
+	if body.DoctorId != userInfo.UserId {
+		role = rolePatient
+		if h.myPatientError(c, h.ownPatient(ctx, userInfo.UserId, body.PatientId), "StartMyConversation") {
+			return
+		}
+	}
+
+	res, err := h.serviceManager.BookingService().Messages().StartConversation(ctx, &pb.StartConversationReq{
+		PatientId:     body.PatientId,
+		DoctorId:      body.DoctorId,
+		AppointmentId: body.AppointmentId,
+		ReaderRole:    role,
+	})
+	if h.messageError(c, err, "StartMyConversation") {
+		return
+	}
+
+	c.JSON(http.StatusOK, conversationToRes(res))
+}
+
+// ListMyConversations ...
+// @Summary ListMyConversations
+// @Description ListMyConversations - Api for the conversations of a patient profile of the caller's account,
+// @Description or, without patient_id, the conversations of the calling doctor. The latest conversations come first.
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param patient_id query string false "patient_id"
+// @Param ListReq query models.ListReq false "ListReq"
+// @Success 200 {object} model_booking_service.ConversationsType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/conversations [get]
+func (h *HandlerV1) ListMyConversations(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListMyConversations") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "ListMyConversations") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	req := &pb.GetAllConversationsReq{
+		Page:       pageInt,
+		Limit:      limitInt,
+		DoctorId:   userInfo.UserId,
+		ReaderRole: roleDoctor,
+	}
+	if patientId := c.Query("patient_id"); patientId != "" {
+		if h.myPatientError(c, h.ownPatient(ctx, userInfo.UserId, patientId), "ListMyConversations") {
+			return
+		}
+		req.PatientId, req.DoctorId, req.ReaderRole = patientId, "", rolePatient
+	}
+
+	res, err := h.serviceManager.BookingService().Messages().GetAllConversations(ctx, req)
+	if h.messageError(c, err, "ListMyConversations") {
+		return
+	}
+
+	list := model_booking_service.ConversationsType{Count: res.Count}
+	for _, conversation := range res.Conversations {
+		list.Conversations = append(list.Conversations, conversationToRes(conversation))
+	}
+
+	c.JSON(http.StatusOK, list)
+}
+
+// GetMyConversation ...
+// @Summary GetMyConversation
+// @Description GetMyConversation - Api for a conversation the caller takes part in, with its unread count and when the doctor replies
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.Conversation
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/conversations/get [get]
+func (h *HandlerV1) GetMyConversation(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "GetMyConversation") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	conversation, _, ok := h.myConversation(c, ctx, userInfo.UserId, c.Query("id"), "GetMyConversation")
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, conversationToRes(conversation))
+}
+
+// ListMyMessages ...
+// @Summary ListMyMessages
+// @Description ListMyMessages - Api for the messages of a conversation the caller takes part in, the newest first
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param conversation_id query string true "conversation_id"
+// @Param ListReq query models.ListReq false "ListReq"
+// @Success 200 {object} model_booking_service.MessagesType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/conversations/messages [get]
+func (h *HandlerV1) ListMyMessages(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListMyMessages") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "ListMyMessages") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	conversation, _, ok := h.myConversation(c, ctx, userInfo.UserId, c.Query("conversation_id"), "ListMyMessages")
+	if !ok {
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().Messages().GetAllMessages(ctx, &pb.GetAllMessagesReq{
+		ConversationId: conversation.Id,
+		Page:           pageInt,
+		Limit:          limitInt,
+	})
+	if h.messageError(c, err, "ListMyMessages") {
+		return
+	}
+
+	list := model_booking_service.MessagesType{Count: res.Count}
+	for _, message := range res.Messages {
+		list.Messages = append(list.Messages, messageToRes(message))
+	}
+
+	c.JSON(http.StatusOK, list)
+}
+
+// SendMyMessage ...
+// @Summary SendMyMessage
+// @Description SendMyMessage - Api for sending a text, a file or both to a conversation the caller takes part in.
+// @Description The file type is detected from its content; pdf, jpeg and png files are accepted.
+// @Tags Me
+// @Accept multipart/form-data
+// @Produce json
+// @Security ApiKeyAuth
+// @Param conversation_id formData string true "conversation_id"
+// @Param body formData string false "body"
+// @Param file formData file false "file"
+// @Success 201 {object} model_booking_service.ConversationMessage
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 413 {object} model_common.StandardErrorModel
+// @Failure 415 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/conversations/messages [post]
+func (h *HandlerV1) SendMyMessage(c *gin.Context) {
+	if !h.parseUploadForm(c, h.cfg.MinioService.Messages.MaxSize, "SendMyMessage") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "SendMyMessage") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	conversation, role, ok := h.myConversation(c, ctx, userInfo.UserId, c.PostForm("conversation_id"), "SendMyMessage")
+	if !ok {
+		return
+	}
+
+	attachment, ok := h.storeAttachment(c, conversation.Id, "SendMyMessage")
+	if !ok {
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().Messages().SendMessage(ctx, &pb.ConversationMessage{
+		ConversationId: conversation.Id,
+		SenderId:       userInfo.UserId,
+		SenderRole:     role,
+		Body:           c.PostForm("body"),
+		Attachment:     attachment,
+	})
+	if err != nil {
+		if attachment != nil {
+			if errRemove := minio.RemoveObject(context.Background(), h.cfg, attachment.Bucket, attachment.ObjectKey); errRemove != nil {
+				h.log.Error("failed to remove unsent attachment " + attachment.ObjectKey + ": " + errRemove.Error())
+			}
+		}
+		h.messageError(c, err, "SendMyMessage")
+		return
+	}
+
+	c.JSON(http.StatusCreated, messageToRes(res))
+}
+
+// storeAttachment puts the file of a parsed message upload, when there is
+// one, in the private bucket of message attachments.
+func (h *HandlerV1) storeAttachment(c *gin.Context, conversationId, method string) (*pb.MessageAttachment, bool) {
+	maxSize := h.cfg.MinioService.Messages.MaxSize
+
+	file, header, err := c.Request.FormFile("file")
+	if errors.Is(err, http.ErrMissingFile) {
+		return nil, true
+	}
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, method) {
+		return nil, false
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, maxSize+1))
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, method) {
+		return nil, false
+	}
+	if int64(len(content)) > maxSize {
+		e.HandleError(c, fmt.Errorf("attachment is larger than %d bytes", maxSize), h.log, http.StatusRequestEntityTooLarge, method)
+		return nil, false
+	}
+	if len(content) == 0 {
+		e.HandleError(c, errors.New("attachment is empty"), h.log, http.StatusBadRequest, method)
+		return nil, false
+	}
+
+	contentType, ok := sniffDocument(content)
+	if !ok || contentType == "application/dicom" {
+		e.HandleError(c, fmt.Errorf("%s attachments are not accepted, send a pdf, jpeg or png file", contentType), h.log, http.StatusUnsupportedMediaType, method)
+		return nil, false
+	}
+
+	attachment := &pb.MessageAttachment{
+		FileName:    header.Filename,
+		ContentType: contentType,
+		SizeBytes:   int64(len(content)),
+		Bucket:      h.cfg.MinioService.Messages.Bucket,
+		ObjectKey:   conversationId + "/" + uuid.NewString() + documentExtensions[contentType],
+	}
+
+	err = minio.PutExpiringObject(c.Request.Context(), h.cfg, attachment.Bucket, attachment.ObjectKey, content, contentType,
+		h.cfg.MinioService.Messages.RetentionDays)
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, method) {
+		return nil, false
+	}
+
+	return attachment, true
+}
+
+// MarkMyConversationRead ...
+// @Summary MarkMyConversationRead
+// @Description MarkMyConversationRead - Api for marking the messages of the other side of a conversation read; the sender sees the read receipt
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param conversation_id query string true "conversation_id"
+// @Success 200 {object} model_booking_service.ReadReceipt
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/conversations/read [put]
+func (h *HandlerV1) MarkMyConversationRead(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "MarkMyConversationRead") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	conversation, role, ok := h.myConversation(c, ctx, userInfo.UserId, c.Query("conversation_id"), "MarkMyConversationRead")
+	if !ok {
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().Messages().MarkConversationRead(ctx, &pb.MarkConversationReadReq{
+		ConversationId: conversation.Id,
+		ReaderRole:     role,
+	})
+	if h.messageError(c, err, "MarkMyConversationRead") {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_booking_service.ReadReceipt{
+		ConversationId: res.ConversationId,
+		ReaderRole:     res.ReaderRole,
+		Read:           res.Read,
+		ReadAt:         res.ReadAt,
+	})
+}
+
+// GetMyMessageAttachmentURL ...
+// @Summary GetMyMessageAttachmentURL
+// @Description GetMyMessageAttachmentURL - Api for a short-lived download URL of the file of a message in a conversation the caller takes part in
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param message_id query string true "message_id"
+// @Success 200 {object} model_booking_service.DocumentURL
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/conversations/attachment [get]
+func (h *HandlerV1) GetMyMessageAttachmentURL(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "GetMyMessageAttachmentURL") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	message, err := h.serviceManager.BookingService().Messages().GetMessage(ctx, &pb.MessageReq{Id: c.Query("message_id")})
+	if h.messageError(c, err, "GetMyMessageAttachmentURL") {
+		return
+	}
+	if _, _, ok := h.myConversation(c, ctx, userInfo.UserId, message.ConversationId, "GetMyMessageAttachmentURL"); !ok {
+		return
+	}
+	if message.Attachment == nil {
+		e.HandleError(c, errors.New("the message has no attachment"), h.log, http.StatusNotFound, "GetMyMessageAttachmentURL")
+		return
+	}
+
+	ttl := h.cfg.MinioService.Messages.URLTTL
+	url, err := minio.PresignedURL(c.Request.Context(), h.cfg, message.Attachment.Bucket, message.Attachment.ObjectKey,
+		message.Attachment.FileName, ttl)
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetMyMessageAttachmentURL") {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_booking_service.DocumentURL{
+		Url:       url,
+		ExpiresAt: time.Now().Add(ttl).Format(time.RFC3339),
+	})
+}
+
+// GetMyReplyHours ...
+// @Summary GetMyReplyHours
+// @Description GetMyReplyHours - Api for the weekly windows the calling doctor answers messages in
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} model_booking_service.ReplyHours
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/reply-hours [get]
+func (h *HandlerV1) GetMyReplyHours(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "GetMyReplyHours") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Messages().GetReplyHours(ctx, &pb.ReplyHoursReq{DoctorId: userInfo.UserId})
+	if h.messageError(c, err, "GetMyReplyHours") {
+		return
+	}
+
+	c.JSON(http.StatusOK, replyHoursToRes(res))
+}
+
+// SetMyReplyHours ...
+// @Summary SetMyReplyHours
+// @Description SetMyReplyHours - Api for replacing the weekly windows the calling doctor answers messages in.
+// @Description Weekday 0 is Sunday; times are HH:MM in the clinic's zone. No windows means patients are not told when to expect a reply.
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ReplyHoursReq body model_booking_service.ReplyHoursReq true "ReplyHoursReq"
+// @Success 200 {object} model_booking_service.ReplyHours
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/reply-hours [put]
+func (h *HandlerV1) SetMyReplyHours(c *gin.Context) {
+	var body model_booking_service.ReplyHoursReq
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "SetMyReplyHours") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "SetMyReplyHours") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	req := &pb.ReplyHours{DoctorId: userInfo.UserId}
+	for _, window := range body.Windows {
+		req.Windows = append(req.Windows, &pb.ReplyWindow{
+			Weekday:  window.Weekday,
+			StartsAt: window.StartsAt,
+			EndsAt:   window.EndsAt,
+		})
+	}
+
+	res, err := h.serviceManager.BookingService().Messages().SetReplyHours(ctx, req)
+	if h.messageError(c, err, "SetMyReplyHours") {
+		return
+	}
+
+	c.JSON(http.StatusOK, replyHoursToRes(res))
+}
@@ -0,0 +1,384 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models/model_notification_service"
+	pb "dennic_api_gateway/genproto/notification_service"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *HandlerV1) notificationError(c *gin.Context, err error, method string) bool {
+	switch status.Code(err) {
+	case codes.OK:
+		return false
+	case codes.InvalidArgument:
+		return e.HandleError(c, err, h.log, http.StatusBadRequest, method)
+	case codes.NotFound:
+		return e.HandleError(c, err, h.log, http.StatusNotFound, method)
+	}
+	return e.HandleError(c, err, h.log, http.StatusInternalServerError, method)
+}
+
+func preferencesToRes(preferences *pb.NotificationPreferences) *model_notification_service.Preferences {
+	return &model_notification_service.Preferences{
+		Language:     preferences.Language,
+		Push:         preferences.Push,
+		Sms:          preferences.Sms,
+		Email:        preferences.Email,
+		EmailAddress: preferences.EmailAddress,
+		Reminders:    preferences.Reminders,
+		UpdatedAt:    preferences.UpdatedAt,
+	}
+}
+
+// GetMyNotificationPreferences ...
+// @Summary GetMyNotificationPreferences
+// @Description GetMyNotificationPreferences - Api for get how the caller is notified: the language of messages,
+// @Description the channels (push to signed in devices, SMS to the patient's phone, email) and whether appointment reminders are sent
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} model_notification_service.Preferences
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/notifications/preferences [get]
+func (h *HandlerV1) GetMyNotificationPreferences(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "GetMyNotificationPreferences") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().NotificationService().GetPreferences(ctx, &pb.NotificationUserReq{
+		UserId: userInfo.UserId,
+	})
+	if h.notificationError(c, err, "GetMyNotificationPreferences") {
+		return
+	}
+
+	c.JSON(http.StatusOK, preferencesToRes(res))
+}
+
+// UpdateMyNotificationPreferences ...
+// @Summary UpdateMyNotificationPreferences
+// @Description UpdateMyNotificationPreferences - Api for set how the caller is notified. Email needs email_address.
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param PreferencesReq body model_notification_service.PreferencesReq true "PreferencesReq"
+// @Success 200 {object} model_notification_service.Preferences
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/notifications/preferences [put]
+func (h *HandlerV1) UpdateMyNotificationPreferences(c *gin.Context) {
+	var body model_notification_service.PreferencesReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateMyNotificationPreferences") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "UpdateMyNotificationPreferences") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().NotificationService().UpdatePreferences(ctx, &pb.NotificationPreferences{
+		UserId:       userInfo.UserId,
+		Language:     body.Language,
+		Push:         body.Push,
+		Sms:          body.Sms,
+		Email:        body.Email,
+		EmailAddress: body.EmailAddress,
+		Reminders:    body.Reminders,
+	})
+	if h.notificationError(c, err, "UpdateMyNotificationPreferences") {
+		return
+	}
+
+	c.JSON(http.StatusOK, preferencesToRes(res))
+}
+
+// ListMyNotifications ...
+// @Summary ListMyNotifications
+// @Description ListMyNotifications - Api for list the caller's in-app notifications, the newest first: appointments booked,
+// @Description confirmed, moved or cancelled, lab results ready and prescriptions issued. link is the deep link to open.
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param unread query bool false "unread"
+// @Success 200 {object} model_notification_service.InboxType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/notifications [get]
+func (h *HandlerV1) ListMyNotifications(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListMyNotifications") {
+		return
+	}
+
+	var unread bool
+	if value := c.Query("unread"); value != "" {
+		unread, err = strconv.ParseBool(value)
+		if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListMyNotifications") {
+			return
+		}
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "ListMyNotifications") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().NotificationService().GetInbox(ctx, &pb.GetInboxReq{
+		UserId: userInfo.UserId,
+		Page:   pageInt,
+		Limit:  limitInt,
+		Unread: unread,
+	})
+	if h.notificationError(c, err, "ListMyNotifications") {
+		return
+	}
+
+	inbox := &model_notification_service.InboxType{Count: res.Count, Unread: res.Unread}
+	for _, notification := range res.Notifications {
+		inbox.Notifications = append(inbox.Notifications, &model_notification_service.InboxNotification{
+			Id:        notification.Id,
+			Kind:      notification.Kind,
+			Title:     notification.Title,
+			Body:      notification.Body,
+			Link:      notification.Link,
+			Data:      notification.Data,
+			Read:      notification.Read,
+			ReadAt:    notification.ReadAt,
+			CreatedAt: notification.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, inbox)
+}
+
+// GetMyUnreadCount ...
+// @Summary GetMyUnreadCount
+// @Description GetMyUnreadCount - Api for get how many of the caller's in-app notifications are unread
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} model_notification_service.UnreadCount
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/notifications/unread-count [get]
+func (h *HandlerV1) GetMyUnreadCount(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "GetMyUnreadCount") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().NotificationService().GetUnreadCount(ctx, &pb.NotificationUserReq{
+		UserId: userInfo.UserId,
+	})
+	if h.notificationError(c, err, "GetMyUnreadCount") {
+		return
+	}
+
+	c.JSON(http.StatusOK, &model_notification_service.UnreadCount{Unread: res.Unread})
+}
+
+// MarkMyNotificationsRead ...
+// @Summary MarkMyNotificationsRead
+// @Description MarkMyNotificationsRead - Api for mark the given in-app notifications of the caller read, or all of them
+// @Description with all=true. Returns how many are left unread.
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param MarkReadReq body model_notification_service.MarkReadReq true "MarkReadReq"
+// @Success 200 {object} model_notification_service.UnreadCount
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/notifications/read [put]
+func (h *HandlerV1) MarkMyNotificationsRead(c *gin.Context) {
+	var body model_notification_service.MarkReadReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "MarkMyNotificationsRead") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "MarkMyNotificationsRead") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().NotificationService().MarkInboxRead(ctx, &pb.MarkInboxReadReq{
+		UserId: userInfo.UserId,
+		Ids:    body.Ids,
+		All:    body.All,
+	})
+	if h.notificationError(c, err, "MarkMyNotificationsRead") {
+		return
+	}
+
+	c.JSON(http.StatusOK, &model_notification_service.UnreadCount{Unread: res.Unread})
+}
+
+// ListNotificationDeliveries ...
+// @Summary ListNotificationDeliveries
+// @Description ListNotificationDeliveries - Api for list sent and pending notifications with their delivery status,
+// @Description e.g. status=failed for reminders that did not reach the patient
+// @Tags Notification
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param user_id query string false "user_id"
+// @Param patient_id query string false "patient_id"
+// @Param appointment_id query int false "appointment_id"
+// @Param channel query string false "channel" Enums(push, sms, email)
+// @Param status query string false "status" Enums(pending, sent, failed)
+// @Success 200 {object} model_notification_service.DeliveriesType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/notification/deliveries [get]
+func (h *HandlerV1) ListNotificationDeliveries(c *gin.Context) {
+	if _, ok := h.requireRole(c, "ListNotificationDeliveries", RoleAdmin); !ok {
+		return
+	}
+
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListNotificationDeliveries") {
+		return
+	}
+
+	var appointmentId int64
+	if value := c.Query("appointment_id"); value != "" {
+		appointmentId, err = strconv.ParseInt(value, 10, 64)
+		if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListNotificationDeliveries") {
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().NotificationService().GetAllDeliveries(ctx, &pb.GetAllDeliveriesReq{
+		Page:          pageInt,
+		Limit:         limitInt,
+		UserId:        c.Query("user_id"),
+		PatientId:     c.Query("patient_id"),
+		AppointmentId: appointmentId,
+		Channel:       c.Query("channel"),
+		Status:        c.Query("status"),
+	})
+	if h.notificationError(c, err, "ListNotificationDeliveries") {
+		return
+	}
+
+	deliveries := &model_notification_service.DeliveriesType{Count: res.Count}
+	for _, delivery := range res.Deliveries {
+		deliveries.Deliveries = append(deliveries.Deliveries, &model_notification_service.Delivery{
+			Id:            delivery.Id,
+			ReminderId:    delivery.ReminderId,
+			AppointmentId: delivery.AppointmentId,
+			Kind:          delivery.Kind,
+			UserId:        delivery.UserId,
+			PatientId:     delivery.PatientId,
+			Channel:       delivery.Channel,
+			Recipient:     delivery.Recipient,
+			Language:      delivery.Language,
+			Title:         delivery.Title,
+			Body:          delivery.Body,
+			Status:        delivery.Status,
+			Attempts:      delivery.Attempts,
+			LastError:     delivery.LastError,
+			ProviderRef:   delivery.ProviderRef,
+			NextAttemptAt: delivery.NextAttemptAt,
+			SentAt:        delivery.SentAt,
+			CreatedAt:     delivery.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// ListAppointmentReminders ...
+// @Summary ListAppointmentReminders
+// @Description ListAppointmentReminders - Api for list the reminders of an appointment, sent 24 and 2 hours before it
+// @Tags Notification
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param appointment_id query int true "appointment_id"
+// @Success 200 {object} model_notification_service.RemindersType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/notification/reminders [get]
+func (h *HandlerV1) ListAppointmentReminders(c *gin.Context) {
+	if _, ok := h.requireRole(c, "ListAppointmentReminders", RoleAdmin); !ok {
+		return
+	}
+
+	appointmentId, err := strconv.ParseInt(c.Query("appointment_id"), 10, 64)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListAppointmentReminders") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().NotificationService().GetAppointmentReminders(ctx, &pb.AppointmentRemindersReq{
+		AppointmentId: appointmentId,
+	})
+	if h.notificationError(c, err, "ListAppointmentReminders") {
+		return
+	}
+
+	reminders := &model_notification_service.RemindersType{}
+	for _, reminder := range res.Reminders {
+		reminders.Reminders = append(reminders.Reminders, &model_notification_service.Reminder{
+			Id:            reminder.Id,
+			AppointmentId: reminder.AppointmentId,
+			Kind:          reminder.Kind,
+			PatientId:     reminder.PatientId,
+			StartsAt:      reminder.StartsAt,
+			SendAt:        reminder.SendAt,
+			Status:        reminder.Status,
+			UpdatedAt:     reminder.UpdatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, reminders)
+}
@@ -8,6 +8,8 @@ import (
 	pb "dennic_api_gateway/genproto/booking_service"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"net/http"
 	"time"
@@ -54,6 +56,10 @@ func (h *HandlerV1) CreatePatient(c *gin.Context) {
 		PatientProblem: body.PatientProblem,
 	})
 
+	if status.Code(err) == codes.InvalidArgument {
+		e.HandleError(c, err, h.log, http.StatusBadRequest, "CreatePatient")
+		return
+	}
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreatePatient") {
 		return
 	}
@@ -70,6 +76,10 @@ func (h *HandlerV1) CreatePatient(c *gin.Context) {
 		City:           res.City,
 		Country:        res.Country,
 		PatientProblem: res.PatientProblem,
+		UserId:         res.UserId,
+		Relationship:   res.Relationship,
+		Consent:        res.Consent,
+		ConsentAt:      res.ConsentAt,
 		CreatedAt:      res.CreatedAt,
 		UpdatedAt:      e.UpdateTimeFilter(res.UpdatedAt),
 	})
@@ -114,6 +124,10 @@ func (h *HandlerV1) GetPatient(c *gin.Context) {
 		City:           res.City,
 		Country:        res.Country,
 		PatientProblem: res.PatientProblem,
+		UserId:         res.UserId,
+		Relationship:   res.Relationship,
+		Consent:        res.Consent,
+		ConsentAt:      res.ConsentAt,
 		CreatedAt:      res.CreatedAt,
 		UpdatedAt:      e.UpdateTimeFilter(res.UpdatedAt),
 	})
@@ -173,6 +187,10 @@ func (h *HandlerV1) ListPatient(c *gin.Context) {
 		patientRes.City = patient.City
 		patientRes.Country = patient.Country
 		patientRes.PatientProblem = patient.PatientProblem
+		patientRes.UserId = patient.UserId
+		patientRes.Relationship = patient.Relationship
+		patientRes.Consent = patient.Consent
+		patientRes.ConsentAt = patient.ConsentAt
 		patientRes.CreatedAt = patient.CreatedAt
 		patientRes.UpdatedAt = e.UpdateTimeFilter(patient.UpdatedAt)
 		patients.Patients = append(patients.Patients, &patientRes)
@@ -225,6 +243,10 @@ func (h *HandlerV1) UpdatePatient(c *gin.Context) {
 		PatientProblem: body.PatientProblem,
 	})
 
+	if status.Code(err) == codes.InvalidArgument {
+		e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdatePatient")
+		return
+	}
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdatePatient") {
 		return
 	}
@@ -241,6 +263,10 @@ func (h *HandlerV1) UpdatePatient(c *gin.Context) {
 		City:           res.City,
 		Country:        res.Country,
 		PatientProblem: res.PatientProblem,
+		UserId:         res.UserId,
+		Relationship:   res.Relationship,
+		Consent:        res.Consent,
+		ConsentAt:      res.ConsentAt,
 		CreatedAt:      res.CreatedAt,
 		UpdatedAt:      e.UpdateTimeFilter(res.UpdatedAt),
 	})
@@ -0,0 +1,277 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models"
+	"dennic_api_gateway/api/models/model_booking_service"
+	pb "dennic_api_gateway/genproto/booking_service"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxWebhookSize bounds the body a payment provider may post.
+const maxWebhookSize = 64 << 10
+
+func paymentIntentToRes(intent *pb.PaymentIntent) *model_booking_service.PaymentIntent {
+	return &model_booking_service.PaymentIntent{
+		Id:             intent.Id,
+		AppointmentId:  intent.AppointmentId,
+		PatientId:      intent.PatientId,
+		Amount:         intent.Amount,
+		Currency:       intent.Currency,
+		Provider:       intent.Provider,
+		ProviderRef:    intent.ProviderRef,
+		CheckoutUrl:    intent.CheckoutUrl,
+		Status:         intent.Status,
+		FailureReason:  intent.FailureReason,
+		IdempotencyKey: intent.IdempotencyKey,
+		InvoiceId:      intent.InvoiceId,
+		ExpiresAt:      intent.ExpiresAt,
+		CreatedAt:      intent.CreatedAt,
+		UpdatedAt:      intent.UpdatedAt,
+	}
+}
+
+// paymentError answers with the status matching a payment call.
+func (h *HandlerV1) paymentError(c *gin.Context, err error, method string) bool {
+	switch status.Code(err) {
+	case codes.OK:
+		return false
+	case codes.InvalidArgument:
+		return e.HandleError(c, err, h.log, http.StatusBadRequest, method)
+	case codes.PermissionDenied:
+		return e.HandleError(c, err, h.log, http.StatusUnauthorized, method)
+	case codes.NotFound:
+		return e.HandleError(c, err, h.log, http.StatusNotFound, method)
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return e.HandleError(c, err, h.log, http.StatusConflict, method)
+	}
+	return e.HandleError(c, err, h.log, http.StatusInternalServerError, method)
+}
+
+// CreateMyPayment ...
+// @Summary CreateMyPayment
+// @Description CreateMyPayment - Api for prepaying a booked appointment of a patient profile of the caller's account.
+// @Description The appointment must be unconfirmed and its hold not expired. The patient pays at checkout_url;
+// @Description the booking is confirmed when the provider reports the payment and released when it fails or the hold runs out.
+// @Description Send a unique Idempotency-Key per attempt: retrying with the same key returns the same payment.
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param Idempotency-Key header string true "Idempotency-Key"
+// @Param PaymentIntentReq body model_booking_service.PaymentIntentReq true "PaymentIntentReq"
+// @Success 200 {object} model_booking_service.PaymentIntent
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/payments [post]
+func (h *HandlerV1) CreateMyPayment(c *gin.Context) {
+	var body model_booking_service.PaymentIntentReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateMyPayment") {
+		return
+	}
+
+	idempotencyKey := c.GetHeader("Idempotency-Key")
+	if idempotencyKey == "" {
+		e.HandleError(c, errors.New("the Idempotency-Key header is required"), h.log, http.StatusBadRequest, "CreateMyPayment")
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "CreateMyPayment") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	if h.myPatientError(c, h.ownPatient(ctx, userInfo.UserId, body.PatientId), "CreateMyPayment") {
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().Payments().CreatePaymentIntent(ctx, &pb.CreatePaymentIntentReq{
+		Id:             uuid.NewString(),
+		AppointmentId:  body.AppointmentId,
+		PatientId:      body.PatientId,
+		IdempotencyKey: idempotencyKey,
+	})
+	if h.paymentError(c, err, "CreateMyPayment") {
+		return
+	}
+
+	c.JSON(http.StatusOK, paymentIntentToRes(res))
+}
+
+// GetMyPayment ...
+// @Summary GetMyPayment
+// @Description GetMyPayment - Api for the state of a prepayment of the caller's account
+// @Tags Me
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.PaymentIntent
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/payments/get [get]
+func (h *HandlerV1) GetMyPayment(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "GetMyPayment") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Payments().GetPaymentIntent(ctx, &pb.PaymentIntentFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.paymentError(c, err, "GetMyPayment") {
+		return
+	}
+	if h.myPatientError(c, h.ownPatient(ctx, userInfo.UserId, res.PatientId), "GetMyPayment") {
+		return
+	}
+
+	c.JSON(http.StatusOK, paymentIntentToRes(res))
+}
+
+// GetPayment ...
+// @Summary GetPayment
+// @Description GetPayment - Api for get an online payment
+// @Tags Payment
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.PaymentIntent
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/payment/get [get]
+func (h *HandlerV1) GetPayment(c *gin.Context) {
+	if _, ok := h.requireRole(c, "GetPayment", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Payments().GetPaymentIntent(ctx, &pb.PaymentIntentFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.paymentError(c, err, "GetPayment") {
+		return
+	}
+
+	c.JSON(http.StatusOK, paymentIntentToRes(res))
+}
+
+// ListPayments ...
+// @Summary ListPayments
+// @Description ListPayments - Api for list online payments, e.g. status=refund_required for the ones to pay back
+// @Tags Payment
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param patient_id query string false "patient_id"
+// @Param appointment_id query int false "appointment_id"
+// @Param status query string false "status" Enums(pending, succeeded, failed, expired, refund_required)
+// @Success 200 {object} model_booking_service.PaymentIntentsType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/payment [get]
+func (h *HandlerV1) ListPayments(c *gin.Context) {
+	if _, ok := h.requireRole(c, "ListPayments", RoleCashier, RoleAdmin); !ok {
+		return
+	}
+
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListPayments") {
+		return
+	}
+
+	var appointmentId int64
+	if value := c.Query("appointment_id"); value != "" {
+		appointmentId, err = strconv.ParseInt(value, 10, 64)
+		if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListPayments") {
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Payments().GetAllPaymentIntents(ctx, &pb.GetAllPaymentIntentsReq{
+		Page:          pageInt,
+		Limit:         limitInt,
+		PatientId:     c.Query("patient_id"),
+		AppointmentId: appointmentId,
+		Status:        c.Query("status"),
+	})
+	if h.paymentError(c, err, "ListPayments") {
+		return
+	}
+
+	intents := &model_booking_service.PaymentIntentsType{Count: res.Count}
+	for _, intent := range res.Intents {
+		intents.Intents = append(intents.Intents, paymentIntentToRes(intent))
+	}
+
+	c.JSON(http.StatusOK, intents)
+}
+
+// PaymentWebhook ...
+// @Summary PaymentWebhook
+// @Description PaymentWebhook - Callback for the payment provider. The body is passed on untouched and must carry
+// @Description the provider's signature in the X-Webhook-Signature header. Redelivered events are answered with the payment as it stands.
+// @Tags Payment
+// @Accept json
+// @Produce json
+// @Param provider query string true "provider" Enums(fake)
+// @Param X-Webhook-Signature header string true "X-Webhook-Signature"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/payment/webhook [post]
+func (h *HandlerV1) PaymentWebhook(c *gin.Context) {
+	payload, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxWebhookSize))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "PaymentWebhook") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	_, err = h.serviceManager.BookingService().Payments().HandlePaymentWebhook(ctx, &pb.PaymentWebhookReq{
+		Provider:  c.Query("provider"),
+		Payload:   payload,
+		Signature: c.GetHeader("X-Webhook-Signature"),
+	})
+	if h.paymentError(c, err, "PaymentWebhook") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: true})
+}
@@ -0,0 +1,636 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models"
+	"dennic_api_gateway/api/models/model_booking_service"
+	pb "dennic_api_gateway/genproto/booking_service"
+	pbh "dennic_api_gateway/genproto/healthcare-service"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func medicationToRes(medication *pb.Medication) *model_booking_service.Medication {
+	return &model_booking_service.Medication{
+		Id:               medication.Id,
+		Name:             medication.Name,
+		Form:             medication.Form,
+		Strength:         medication.Strength,
+		ActiveIngredient: medication.ActiveIngredient,
+		CreatedAt:        medication.CreatedAt,
+		UpdatedAt:        e.UpdateTimeFilter(medication.UpdatedAt),
+	}
+}
+
+func prescriptionItemToRes(item *pb.PrescriptionItem) *model_booking_service.PrescriptionItem {
+	return &model_booking_service.PrescriptionItem{
+		MedicationId:     item.MedicationId,
+		MedicationName:   item.MedicationName,
+		Form:             item.Form,
+		Strength:         item.Strength,
+		ActiveIngredient: item.ActiveIngredient,
+		Dose:             item.Dose,
+		Frequency:        item.Frequency,
+		DurationDays:     item.DurationDays,
+		Route:            item.Route,
+		Instructions:     item.Instructions,
+	}
+}
+
+func prescriptionToRes(prescription *pb.Prescription) *model_booking_service.Prescription {
+	res := &model_booking_service.Prescription{
+		Id:               prescription.Id,
+		AppointmentId:    prescription.AppointmentId,
+		PatientId:        prescription.PatientId,
+		DoctorId:         prescription.DoctorId,
+		VerificationCode: prescription.VerificationCode,
+		IssuedAt:         prescription.IssuedAt,
+		RevokedAt:        prescription.RevokedAt,
+		CreatedAt:        prescription.CreatedAt,
+	}
+	for _, item := range prescription.Items {
+		res.Items = append(res.Items, prescriptionItemToRes(item))
+	}
+	for _, warning := range prescription.Warnings {
+		res.Warnings = append(res.Warnings, &model_booking_service.PrescriptionWarning{
+			Kind:        warning.Kind,
+			Severity:    warning.Severity,
+			Description: warning.Description,
+		})
+	}
+	return res
+}
+
+// prescriptionError answers with the status matching a prescription call.
+func (h *HandlerV1) prescriptionError(c *gin.Context, err error, method string) bool {
+	switch status.Code(err) {
+	case codes.OK:
+		return false
+	case codes.InvalidArgument:
+		return e.HandleError(c, err, h.log, http.StatusBadRequest, method)
+	case codes.NotFound:
+		return e.HandleError(c, err, h.log, http.StatusNotFound, method)
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return e.HandleError(c, err, h.log, http.StatusConflict, method)
+	}
+	return e.HandleError(c, err, h.log, http.StatusInternalServerError, method)
+}
+
+// CreateMedication ...
+// @Summary CreateMedication
+// @Description CreateMedication - Api for adding a medication to the catalogue
+// @Tags Prescription
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param MedicationReq body model_booking_service.MedicationReq true "MedicationReq"
+// @Success 200 {object} model_booking_service.Medication
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/medication [post]
+func (h *HandlerV1) CreateMedication(c *gin.Context) {
+	var body model_booking_service.MedicationReq
+
+	if _, ok := h.requireRole(c, "CreateMedication", RoleAdmin); !ok {
+		return
+	}
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateMedication") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Prescriptions().CreateMedication(ctx, &pb.Medication{
+		Id:               uuid.NewString(),
+		Name:             body.Name,
+		Form:             body.Form,
+		Strength:         body.Strength,
+		ActiveIngredient: body.ActiveIngredient,
+	})
+	if h.prescriptionError(c, err, "CreateMedication") {
+		return
+	}
+
+	c.JSON(http.StatusOK, medicationToRes(res))
+}
+
+// GetMedication ...
+// @Summary GetMedication
+// @Description GetMedication - Api for get medication
+// @Tags Prescription
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.Medication
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/medication/get [get]
+func (h *HandlerV1) GetMedication(c *gin.Context) {
+	id := c.Query("id")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Prescriptions().GetMedication(ctx, &pb.PrescriptionFieldValueReq{
+		Field:    "id",
+		Value:    id,
+		IsActive: false,
+	})
+	if h.prescriptionError(c, err, "GetMedication") {
+		return
+	}
+
+	c.JSON(http.StatusOK, medicationToRes(res))
+}
+
+// ListMedications ...
+// @Summary ListMedications
+// @Description ListMedications - Api for searching the medication catalogue by name or active ingredient
+// @Tags Prescription
+// @Accept json
+// @Produce json
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param search query string false "search"
+// @Success 200 {object} model_booking_service.MedicationsType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/medication [get]
+func (h *HandlerV1) ListMedications(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListMedications") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Prescriptions().GetAllMedications(ctx, &pb.GetAllMedicationsReq{
+		Page:   pageInt,
+		Limit:  limitInt,
+		Search: c.Query("search"),
+	})
+	if h.prescriptionError(c, err, "ListMedications") {
+		return
+	}
+
+	var medications model_booking_service.MedicationsType
+	for _, medication := range res.Medications {
+		medications.Medications = append(medications.Medications, medicationToRes(medication))
+	}
+	medications.Count = res.Count
+
+	c.JSON(http.StatusOK, medications)
+}
+
+// UpdateMedication ...
+// @Summary UpdateMedication
+// @Description UpdateMedication - Api for update medication. Issued prescriptions keep their copy.
+// @Tags Prescription
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param UpdateMedicationReq body model_booking_service.UpdateMedicationReq true "UpdateMedicationReq"
+// @Success 200 {object} model_booking_service.Medication
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/medication [put]
+func (h *HandlerV1) UpdateMedication(c *gin.Context) {
+	var body model_booking_service.UpdateMedicationReq
+
+	if _, ok := h.requireRole(c, "UpdateMedication", RoleAdmin); !ok {
+		return
+	}
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateMedication") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Prescriptions().UpdateMedication(ctx, &pb.Medication{
+		Id:               body.Id,
+		Name:             body.Name,
+		Form:             body.Form,
+		Strength:         body.Strength,
+		ActiveIngredient: body.ActiveIngredient,
+	})
+	if h.prescriptionError(c, err, "UpdateMedication") {
+		return
+	}
+
+	c.JSON(http.StatusOK, medicationToRes(res))
+}
+
+// DeleteMedication ...
+// @Summary DeleteMedication
+// @Description DeleteMedication - Api for delete medication
+// @Tags Prescription
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/medication [delete]
+func (h *HandlerV1) DeleteMedication(c *gin.Context) {
+	if _, ok := h.requireRole(c, "DeleteMedication", RoleAdmin); !ok {
+		return
+	}
+
+	id := c.Query("id")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Prescriptions().DeleteMedication(ctx, &pb.PrescriptionFieldValueReq{
+		Field:    "id",
+		Value:    id,
+		IsActive: false,
+	})
+	if h.prescriptionError(c, err, "DeleteMedication") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: res.Status})
+}
+
+// CreateInteraction ...
+// @Summary CreateInteraction
+// @Description CreateInteraction - Api for recording an interaction between two active ingredients
+// @Tags Prescription
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param Interaction body model_booking_service.Interaction true "Interaction"
+// @Success 200 {object} model_booking_service.Interaction
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/medication/interaction [post]
+func (h *HandlerV1) CreateInteraction(c *gin.Context) {
+	var body model_booking_service.Interaction
+
+	if _, ok := h.requireRole(c, "CreateInteraction", RoleAdmin); !ok {
+		return
+	}
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateInteraction") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Prescriptions().CreateInteraction(ctx, &pb.Interaction{
+		IngredientA: body.IngredientA,
+		IngredientB: body.IngredientB,
+		Severity:    body.Severity,
+		Description: body.Description,
+	})
+	if h.prescriptionError(c, err, "CreateInteraction") {
+		return
+	}
+
+	c.JSON(http.StatusOK, model_booking_service.Interaction{
+		IngredientA: res.IngredientA,
+		IngredientB: res.IngredientB,
+		Severity:    res.Severity,
+		Description: res.Description,
+	})
+}
+
+// CreatePrescription ...
+// @Summary CreatePrescription
+// @Description CreatePrescription - Api for issuing a prescription for an appointment.
+// @Description Allergy and interaction warnings are returned with the prescription. For the doctor of the appointment only.
+// @Tags Prescription
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param PrescriptionReq body model_booking_service.PrescriptionReq true "PrescriptionReq"
+// @Success 200 {object} model_booking_service.Prescription
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/prescription [post]
+func (h *HandlerV1) CreatePrescription(c *gin.Context) {
+	var body model_booking_service.PrescriptionReq
+
+	doctor, ok := h.requireRole(c, "CreatePrescription", RoleDoctor)
+	if !ok {
+		return
+	}
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreatePrescription") {
+		return
+	}
+
+	req := &pb.CreatePrescriptionReq{AppointmentId: body.AppointmentId}
+	for _, item := range body.Items {
+		req.Items = append(req.Items, &pb.PrescriptionItem{
+			MedicationId: item.MedicationId,
+			Dose:         item.Dose,
+			Frequency:    item.Frequency,
+			DurationDays: item.DurationDays,
+			Route:        item.Route,
+			Instructions: item.Instructions,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	appointment, err := h.serviceManager.BookingService().BookedAppointment().GetAppointment(ctx, &pb.AppointmentFieldValueReq{
+		Field: "id",
+		Value: strconv.FormatInt(body.AppointmentId, 10),
+	})
+	if h.prescriptionError(c, err, "CreatePrescription") {
+		return
+	}
+	if appointment.DoctorId != doctor.UserId {
+		e.HandleError(c, errors.New("the appointment is another doctor's"), h.log, http.StatusForbidden, "CreatePrescription")
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().Prescriptions().CreatePrescription(ctx, req)
+	if h.prescriptionError(c, err, "CreatePrescription") {
+		return
+	}
+
+	c.JSON(http.StatusOK, prescriptionToRes(res))
+}
+
+// GetPrescription ...
+// @Summary GetPrescription
+// @Description GetPrescription - Api for get prescription, for the patient's account and staff with access to the patient
+// @Tags Prescription
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.Prescription
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/prescription/get [get]
+func (h *HandlerV1) GetPrescription(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, ok := h.patientPrescription(c, ctx, "GetPrescription")
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, prescriptionToRes(res))
+}
+
+// ListPrescriptions ...
+// @Summary ListPrescriptions
+// @Description ListPrescriptions - Api for list prescriptions of a patient, the latest first, for the patient's account and
+// @Description staff with access to the patient. Without patient_id a doctor gets the prescriptions they issued.
+// @Tags Prescription
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param patient_id query string false "patient_id"
+// @Param doctor_id query string false "doctor_id"
+// @Success 200 {object} model_booking_service.PrescriptionsType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/prescription [get]
+func (h *HandlerV1) ListPrescriptions(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListPrescriptions") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "ListPrescriptions") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	patientId, doctorId := c.Query("patient_id"), c.Query("doctor_id")
+	switch {
+	case patientId != "":
+		if h.staffAccessError(c, h.patientAccess(ctx, userInfo, patientId), "ListPrescriptions") {
+			return
+		}
+	case userInfo.Role == RoleDoctor:
+		doctorId = userInfo.UserId
+	default:
+		e.HandleError(c, errors.New("patient_id is required"), h.log, http.StatusBadRequest, "ListPrescriptions")
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().Prescriptions().GetAllPrescriptions(ctx, &pb.GetAllPrescriptionsReq{
+		Page:      pageInt,
+		Limit:     limitInt,
+		PatientId: patientId,
+		DoctorId:  doctorId,
+	})
+	if h.prescriptionError(c, err, "ListPrescriptions") {
+		return
+	}
+
+	var prescriptions model_booking_service.PrescriptionsType
+	for _, prescription := range res.Prescriptions {
+		prescriptions.Prescriptions = append(prescriptions.Prescriptions, prescriptionToRes(prescription))
+	}
+	prescriptions.Count = res.Count
+
+	c.JSON(http.StatusOK, prescriptions)
+}
+
+// RevokePrescription ...
+// @Summary RevokePrescription
+// @Description RevokePrescription - Api for revoking a prescription, pharmacies see it as revoked on verification.
+// @Description For the doctor who issued it only.
+// @Tags Prescription
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} model_booking_service.Prescription
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/prescription/revoke [put]
+func (h *HandlerV1) RevokePrescription(c *gin.Context) {
+	id := c.Query("id")
+
+	doctor, ok := h.requireRole(c, "RevokePrescription", RoleDoctor)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	prescription, err := h.serviceManager.BookingService().Prescriptions().GetPrescription(ctx, &pb.PrescriptionFieldValueReq{
+		Field: "id",
+		Value: id,
+	})
+	if h.prescriptionError(c, err, "RevokePrescription") {
+		return
+	}
+	if prescription.DoctorId != doctor.UserId {
+		e.HandleError(c, errors.New("the prescription is another doctor's"), h.log, http.StatusForbidden, "RevokePrescription")
+		return
+	}
+
+	res, err := h.serviceManager.BookingService().Prescriptions().RevokePrescription(ctx, &pb.PrescriptionFieldValueReq{
+		Field: "id",
+		Value: id,
+	})
+	if h.prescriptionError(c, err, "RevokePrescription") {
+		return
+	}
+
+	c.JSON(http.StatusOK, prescriptionToRes(res))
+}
+
+// GetPrescriptionPdf ...
+// @Summary GetPrescriptionPdf
+// @Description GetPrescriptionPdf - Api for the printable, signed prescription with its QR verification code, for the
+// @Description patient's account and staff with access to the patient
+// @Tags Prescription
+// @Produce application/pdf
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {file} file
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/prescription/pdf [get]
+func (h *HandlerV1) GetPrescriptionPdf(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	prescription, ok := h.patientPrescription(c, ctx, "GetPrescriptionPdf")
+	if !ok {
+		return
+	}
+
+	// the doctor's name is printed when the healthcare service knows the doctor
+	var doctorName string
+	if prescription.DoctorId != "" {
+		doctor, err := h.serviceManager.HealthcareService().DoctorService().GetDoctorById(ctx, &pbh.GetReqStrDoctor{
+			Field:    "id",
+			Value:    prescription.DoctorId,
+			IsActive: false,
+		})
+		if err == nil {
+			doctorName = strings.TrimSpace(doctor.FirstName + " " + doctor.LastName)
+		}
+	}
+
+	document, err := h.serviceManager.BookingService().Prescriptions().RenderPrescription(ctx, &pb.RenderPrescriptionReq{
+		Id:         prescription.Id,
+		DoctorName: doctorName,
+	})
+	if h.prescriptionError(c, err, "GetPrescriptionPdf") {
+		return
+	}
+
+	c.Header("Content-Disposition", "inline; filename=\""+document.FileName+"\"")
+	c.Data(http.StatusOK, "application/pdf", document.Content)
+}
+
+// VerifyPrescription ...
+// @Summary VerifyPrescription
+// @Description VerifyPrescription - Public api for pharmacies to check the code printed on a prescription
+// @Tags Prescription
+// @Accept json
+// @Produce json
+// @Param code query string true "code"
+// @Success 200 {object} model_booking_service.PrescriptionVerification
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/prescription/verify [get]
+func (h *HandlerV1) VerifyPrescription(c *gin.Context) {
+	code := c.Query("code")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.BookingService().Prescriptions().VerifyPrescription(ctx, &pb.VerifyPrescriptionReq{
+		Code: code,
+	})
+	if h.prescriptionError(c, err, "VerifyPrescription") {
+		return
+	}
+
+	verification := model_booking_service.PrescriptionVerification{
+		Valid:           res.Valid,
+		Revoked:         res.Revoked,
+		IssuedAt:        res.IssuedAt,
+		DoctorId:        res.DoctorId,
+		PatientInitials: res.PatientInitials,
+	}
+	for _, item := range res.Items {
+		verification.Items = append(verification.Items, prescriptionItemToRes(item))
+	}
+
+	c.JSON(http.StatusOK, verification)
+}
+
+// patientPrescription fetches the prescription of the id query and answers
+// unless the token may see the records of its patient.
+func (h *HandlerV1) patientPrescription(c *gin.Context, ctx context.Context, method string) (*pb.Prescription, bool) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, method) {
+		return nil, false
+	}
+
+	prescription, err := h.serviceManager.BookingService().Prescriptions().GetPrescription(ctx, &pb.PrescriptionFieldValueReq{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if h.prescriptionError(c, err, method) {
+		return nil, false
+	}
+	if h.staffAccessError(c, h.patientAccess(ctx, userInfo, prescription.PatientId), method) {
+		return nil, false
+	}
+
+	return prescription, true
+}
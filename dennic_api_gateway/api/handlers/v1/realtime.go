@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	redisCache "dennic_api_gateway/internal/infrastructure/redis"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StreamMyEvents ...
+// @Summary StreamMyEvents
+// @Description StreamMyEvents - Api for stream the changes the caller watches as server-sent events, instead of polling:
+// @Description appointment.created, appointment.updated, appointment.confirmed, appointment.status_changed (the queue moving)
+// @Description and appointment.deleted with the appointment after the change, and notification.unread_count with the unread
+// @Description count of the caller's inbox, message.sent and message.read for the caller's conversations. A doctor gets their own schedule, a patient their appointments;
+// @Description a reception account every appointment of the branch it works at. branch_id, when given, must be that branch.
+// @Description ready is sent once the stream is live; reload then and again after every reconnect, as changes made while
+// @Description disconnected are not replayed.
+// @Tags Me
+// @Produce text/event-stream
+// @Security ApiKeyAuth
+// @Param branch_id query string false "branch_id"
+// @Success 200 {string} string "event stream"
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/me/events [get]
+func (h *HandlerV1) StreamMyEvents(c *gin.Context) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "StreamMyEvents") {
+		return
+	}
+
+	channels := []string{redisCache.UserChannel(userInfo.UserId)}
+
+	// the branch is the one of the reception account, never the caller's word
+	var branchId string
+	if userInfo.Role == RoleReception {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+		account, err := h.staffAccount(ctx, userInfo.UserId)
+		cancel()
+		if e.HandleError(c, err, h.log, http.StatusInternalServerError, "StreamMyEvents") {
+			return
+		}
+		branchId = account.BranchId
+	}
+	if requested := c.Query("branch_id"); requested != "" && requested != branchId {
+		_ = e.HandleError(c, errors.New("not a reception account of the branch"), h.log, http.StatusForbidden, "StreamMyEvents")
+		return
+	}
+	if branchId != "" {
+		channels = append(channels, redisCache.BranchChannel(branchId))
+	}
+
+	// the stream outlives the write timeout of the server
+	err = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "StreamMyEvents") {
+		return
+	}
+
+	events, cancel := h.realtime.Subscribe(channels...)
+	defer cancel()
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.SSEvent("ready", gin.H{"channels": channels})
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(h.cfg.Realtime.Heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case event, ok := <-events:
+			// closed when the client fell behind
+			if !ok {
+				return
+			}
+			c.SSEvent(event.Type, event.Data)
+		case <-heartbeat.C:
+			if _, err := io.WriteString(c.Writer, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+		c.Writer.Flush()
+	}
+}
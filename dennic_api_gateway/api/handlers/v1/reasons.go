@@ -105,6 +105,7 @@ func (h *HandlerV1) GetReasons(c *gin.Context) {
 // @Produce json
 // @Param ListReq query models.ListReq false "ListReq"
 // @Param search query string false "search" Enums(name) "search"
+// @Param branch_id query string false "branch_id"
 // @Success 200 {object} model_healthcare_service.ListReasons
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -115,6 +116,7 @@ func (h *HandlerV1) ListReasons(c *gin.Context) {
 	limit := c.Query("limit")
 	page := c.Query("page")
 	orderBy := c.Query("orderBy")
+	branchId := c.Query("branch_id")
 
 	pageInt, limitInt, err := e.ParseQueryParams(page, limit)
 	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListReasons") {
@@ -131,6 +133,7 @@ func (h *HandlerV1) ListReasons(c *gin.Context) {
 		Page:     int32(pageInt),
 		Limit:    int32(limitInt),
 		OrderBy:  orderBy,
+		BranchId: branchId,
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListReasons") {
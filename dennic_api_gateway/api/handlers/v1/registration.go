@@ -2,11 +2,15 @@ package v1
 
 import (
 	"context"
+	"crypto/rand"
 	e "dennic_api_gateway/api/handlers/regtool"
 	"dennic_api_gateway/api/models"
 	"dennic_api_gateway/api/models/model_user_service"
+	"dennic_api_gateway/genproto/events"
 	ps "dennic_api_gateway/genproto/session_service"
 	pb "dennic_api_gateway/genproto/user_service"
+	redisCache "dennic_api_gateway/internal/infrastructure/redis"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -101,12 +105,12 @@ func (h *HandlerV1) Register(c *gin.Context) {
 
 // Verify ...
 // @Summary Verify
-// @Description customer - Api for registering users
+// @Description customer - Api for registering users. The token answered is sent in X-Registration-Token to poll the registration.
 // @Tags customer
 // @Accept json
 // @Produce json
 // @Param Verify body model_user_service.Verify true "RegisterModelReq"
-// @Failure 200 {object} model_user_service.Response
+// @Success 202 {object} model_user_service.RegistrationStatus
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
 // @Router /v1/customer/verify [post]
@@ -129,7 +133,8 @@ func (h *HandlerV1) Verify(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	// the request context carries the trace the user create event continues
+	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
 	redisRes, err := h.redis.Client.Get(ctx, body.PhoneNumber).Result()
@@ -150,49 +155,47 @@ func (h *HandlerV1) Verify(c *gin.Context) {
 		return
 	}
 
-	sessions, err := h.serviceManager.SessionService().SessionService().GetUserSessions(ctx, &ps.StrUserReq{
-		UserId: user.Id,
-	})
+	// the user and their session are created by the services from the
+	// event, so the tokens are signed for the session id chosen here
+	sessionId := uuid.New().String()
+
+	user.Password, err = e.HashPassword(user.Password)
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, SERVICE_ERROR) {
 		return
 	}
 
-	if sessions != nil {
-		if sessions.Count >= 3 {
-			err = errors.New("the number of devices has exceeded the limit")
-			_ = e.HandleError(c, err, h.log, http.StatusBadRequest, "the number of devices has exceeded the limit")
-			return
-		}
-	}
-
-	sessionId := uuid.New().String()
+	access, refresh, err := h.jwthandler.GenerateAuthJWT(user.PhoneNumber, user.Id, sessionId, "user")
 
-	session, err := h.serviceManager.SessionService().SessionService().CreateSession(ctx, &ps.SessionRequests{
-		Id:           sessionId,
-		IpAddress:    c.RemoteIP(),
-		UserId:       user.Id,
-		FcmToken:     body.FcmToken,
-		PlatformName: body.PlatformName,
-		PlatformType: body.PlatformType,
-	})
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, SERVICE_ERROR) {
 		return
 	}
 
-	user.Password, err = e.HashPassword(user.Password)
-
+	// the registration is polled with a token of its own, not the user id
+	token, err := registrationToken()
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, SERVICE_ERROR) {
 		return
 	}
 
-	access, refresh, err := h.jwthandler.GenerateAuthJWT(user.PhoneNumber, user.Id, session.Id, "user")
+	err = h.registrations.Save(ctx, &redisCache.Registration{
+		Id:          user.Id,
+		TokenHash:   redisCache.RegistrationTokenHash(token),
+		Status:      redisCache.RegistrationPending,
+		FirstName:   user.FirstName,
+		LastName:    user.LastName,
+		BirthDate:   user.BrithDate,
+		PhoneNumber: user.PhoneNumber,
+		Gender:      user.Gender,
+	}, &redisCache.RegistrationTokens{
+		AccessToken:  access,
+		RefreshToken: refresh,
+	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, SERVICE_ERROR) {
 		return
 	}
 
-	_, err = h.serviceManager.UserService().UserService().Create(ctx, &pb.User{
+	err = h.brokerProducer.PublishUserCreate(ctx, &events.UserCreate{
 		Id:           user.Id,
 		FirstName:    user.FirstName,
 		LastName:     user.LastName,
@@ -201,6 +204,13 @@ func (h *HandlerV1) Verify(c *gin.Context) {
 		Password:     user.Password,
 		Gender:       user.Gender,
 		RefreshToken: refresh,
+		Device: &events.Device{
+			SessionId:    sessionId,
+			IpAddress:    c.RemoteIP(),
+			FcmToken:     body.FcmToken,
+			PlatformName: body.PlatformName,
+			PlatformType: body.PlatformType,
+		},
 	})
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, SERVICE_ERROR) {
@@ -213,17 +223,79 @@ func (h *HandlerV1) Verify(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, &model_user_service.Response{
-		Id:           user.Id,
-		FirstName:    user.FirstName,
-		LastName:     user.LastName,
-		BrithDate:    user.BrithDate,
-		PhoneNumber:  user.PhoneNumber,
-		Gender:       user.Gender,
-		AccessToken:  access,
-		RefreshToken: refresh,
+	c.JSON(http.StatusAccepted, &model_user_service.RegistrationStatus{
+		Id:     user.Id,
+		Token:  token,
+		Status: redisCache.RegistrationPending,
 	})
+}
+
+// registrationToken is the random secret a registration is polled with.
+func registrationToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// GetRegistration ...
+// @Summary GetRegistration
+// @Description GetRegistration - Api for polling a registration until the user is created, with the token answered by Verify.
+// @Description The tokens of the user are answered the first time the created registration is read only; sign in afterwards.
+// @Tags customer
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param X-Registration-Token header string true "token answered by Verify"
+// @Success 200 {object} model_user_service.RegistrationStatus
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/customer/registration/{id} [get]
+func (h *HandlerV1) GetRegistration(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	registration, err := h.registrations.Get(ctx, c.Param("id"))
+	if err == nil && !registration.ValidToken(c.GetHeader("X-Registration-Token")) {
+		// not told apart from a missing one
+		err = redisCache.ErrRegistrationNotFound
+	}
+	if errors.Is(err, redisCache.ErrRegistrationNotFound) {
+		_ = e.HandleError(c, err, h.log, http.StatusNotFound, "registration not found")
+		return
+	}
+
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, SERVICE_ERROR) {
+		return
+	}
+
+	response := &model_user_service.RegistrationStatus{
+		Id:     registration.Id,
+		Status: registration.Status,
+		Reason: registration.Reason,
+	}
+	if registration.Status == redisCache.RegistrationCreated {
+		tokens, err := h.registrations.TakeTokens(ctx, registration.Id)
+		if err != nil && !errors.Is(err, redisCache.ErrRegistrationNotFound) {
+			_ = e.HandleError(c, err, h.log, http.StatusInternalServerError, SERVICE_ERROR)
+			return
+		}
+		if err == nil {
+			response.User = &model_user_service.Response{
+				Id:           registration.Id,
+				FirstName:    registration.FirstName,
+				LastName:     registration.LastName,
+				BrithDate:    registration.BirthDate,
+				PhoneNumber:  registration.PhoneNumber,
+				Gender:       registration.Gender,
+				AccessToken:  tokens.AccessToken,
+				RefreshToken: tokens.RefreshToken,
+			}
+		}
+	}
 
+	c.JSON(http.StatusOK, response)
 }
 
 // ForgetPassword ...
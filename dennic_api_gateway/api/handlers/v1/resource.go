@@ -0,0 +1,214 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models"
+	"dennic_api_gateway/api/models/model_healthcare_service"
+	pb "dennic_api_gateway/genproto/healthcare-service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+)
+
+func resourceToRes(resource *pb.Resource) *model_healthcare_service.ResourceRes {
+	return &model_healthcare_service.ResourceRes{
+		Id:           resource.Id,
+		Order:        resource.Order,
+		BranchId:     resource.BranchId,
+		RoomId:       resource.RoomId,
+		ResourceType: resource.ResourceType,
+		Name:         resource.Name,
+		CreatedAt:    resource.CreatedAt,
+		UpdatedAt:    e.UpdateTimeFilter(resource.UpdatedAt),
+	}
+}
+
+// CreateResource ...
+// @Summary CreateResource
+// @Description CreateResource - Api for create branch equipment
+// @Tags Resource
+// @Accept json
+// @Produce json
+// @Param ResourceReq body model_healthcare_service.ResourceReq true "ResourceReq"
+// @Success 200 {object} model_healthcare_service.ResourceRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/resource [post]
+func (h *HandlerV1) CreateResource(c *gin.Context) {
+	var body model_healthcare_service.ResourceReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateResource") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	resource, err := h.serviceManager.HealthcareService().ResourceService().CreateResource(ctx, &pb.Resource{
+		Id:           uuid.NewString(),
+		BranchId:     body.BranchId,
+		RoomId:       body.RoomId,
+		ResourceType: body.ResourceType,
+		Name:         body.Name,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateResource") {
+		return
+	}
+
+	c.JSON(http.StatusOK, resourceToRes(resource))
+}
+
+// GetResource ...
+// @Summary GetResource
+// @Description GetResource - Api for get branch equipment
+// @Tags Resource
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_healthcare_service.ResourceRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/resource/get [get]
+func (h *HandlerV1) GetResource(c *gin.Context) {
+	id := c.Query("id")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	resource, err := h.serviceManager.HealthcareService().ResourceService().GetResourceById(ctx, &pb.GetReqStrResource{
+		Field:    "id",
+		Value:    id,
+		IsActive: false,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetResource") {
+		return
+	}
+
+	c.JSON(http.StatusOK, resourceToRes(resource))
+}
+
+// ListResources ...
+// @Summary ListResources
+// @Description ListResources - Api for list branch equipments
+// @Tags Resource
+// @Accept json
+// @Produce json
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param search query string false "search" Enums(name) "search"
+// @Param branch_id query string false "branch_id"
+// @Param room_id query string false "room_id"
+// @Param resource_type query string false "resource_type"
+// @Success 200 {object} model_healthcare_service.ListResources
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/resource [get]
+func (h *HandlerV1) ListResources(c *gin.Context) {
+	search := c.Query("search")
+	value := c.Query("value")
+	limit := c.Query("limit")
+	page := c.Query("page")
+	orderBy := c.Query("orderBy")
+	branchId := c.Query("branch_id")
+	roomId := c.Query("room_id")
+	resourceType := c.Query("resource_type")
+
+	pageInt, limitInt, err := e.ParseQueryParams(page, limit)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListResources") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	resources, err := h.serviceManager.HealthcareService().ResourceService().GetAllResources(ctx, &pb.GetAllResource{
+		Field:        search,
+		Value:        value,
+		IsActive:     false,
+		Page:         int64(pageInt),
+		Limit:        int64(limitInt),
+		OrderBy:      orderBy,
+		BranchId:     branchId,
+		RoomId:       roomId,
+		ResourceType: resourceType,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListResources") {
+		return
+	}
+
+	var resourcesRes model_healthcare_service.ListResources
+	for _, resource := range resources.Resources {
+		resourcesRes.Resources = append(resourcesRes.Resources, resourceToRes(resource))
+	}
+	resourcesRes.Count = resources.Count
+
+	c.JSON(http.StatusOK, resourcesRes)
+}
+
+// UpdateResource ...
+// @Summary UpdateResource
+// @Description UpdateResource - Api for update branch equipment
+// @Tags Resource
+// @Accept json
+// @Produce json
+// @Param UpdateResourceReq body model_healthcare_service.ResourceReq true "UpdateResourceReq"
+// @Success 200 {object} model_healthcare_service.ResourceRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/resource [put]
+func (h *HandlerV1) UpdateResource(c *gin.Context) {
+	var body model_healthcare_service.ResourceReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateResource") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	resource, err := h.serviceManager.HealthcareService().ResourceService().UpdateResource(ctx, &pb.Resource{
+		Id:           body.Id,
+		BranchId:     body.BranchId,
+		RoomId:       body.RoomId,
+		ResourceType: body.ResourceType,
+		Name:         body.Name,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateResource") {
+		return
+	}
+
+	c.JSON(http.StatusOK, resourceToRes(resource))
+}
+
+// DeleteResource ...
+// @Summary DeleteResource
+// @Description DeleteResource - Api for delete branch equipment
+// @Tags Resource
+// @Accept json
+// @Produce json
+// @Param DeleteResourceReq query models.FieldValueReq true "FieldValueReq"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/resource [delete]
+func (h *HandlerV1) DeleteResource(c *gin.Context) {
+	field := c.Query("field")
+	value := c.Query("value")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().ResourceService().DeleteResource(ctx, &pb.GetReqStrResource{
+		Field:    field,
+		Value:    value,
+		IsActive: false,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DeleteResource") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
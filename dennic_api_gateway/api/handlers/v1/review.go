@@ -0,0 +1,310 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models"
+	"dennic_api_gateway/api/models/model_healthcare_service"
+	pbb "dennic_api_gateway/genproto/booking_service"
+	pb "dennic_api_gateway/genproto/healthcare-service"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func reviewToRes(review *pb.Review) *model_healthcare_service.ReviewRes {
+	return &model_healthcare_service.ReviewRes{
+		Id:             review.Id,
+		DoctorId:       review.DoctorId,
+		PatientId:      review.PatientId,
+		AppointmentId:  review.AppointmentId,
+		Rating:         review.Rating,
+		Comment:        review.Comment,
+		Status:         review.Status,
+		ModerationNote: review.ModerationNote,
+		Reply:          review.Reply,
+		RepliedAt:      review.RepliedAt,
+		CreatedAt:      review.CreatedAt,
+		UpdatedAt:      e.UpdateTimeFilter(review.UpdatedAt),
+	}
+}
+
+// CreateReview ...
+// @Summary CreateReview
+// @Description CreateReview - Api for rating a doctor after an attended appointment.
+// @Description One review per appointment of a patient profile of the caller's account; it is published after moderation.
+// @Tags Review
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ReviewReq body model_healthcare_service.ReviewReq true "ReviewReq"
+// @Success 200 {object} model_healthcare_service.ReviewRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 409 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/review [post]
+func (h *HandlerV1) CreateReview(c *gin.Context) {
+	var body model_healthcare_service.ReviewReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateReview") {
+		return
+	}
+
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, "CreateReview") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	appointment, err := h.serviceManager.BookingService().BookedAppointment().GetAppointment(ctx, &pbb.AppointmentFieldValueReq{
+		Field: "id",
+		Value: strconv.FormatInt(body.AppointmentId, 10),
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateReview") {
+		return
+	}
+	// the patient is the caller's, whatever profile the appointment is for
+	if err := h.ownPatient(ctx, userInfo.UserId, appointment.PatientId); err != nil {
+		if status.Code(err) != codes.NotFound {
+			e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateReview")
+			return
+		}
+		e.HandleError(c, errors.New("the appointment belongs to another patient"), h.log, http.StatusForbidden, "CreateReview")
+		return
+	}
+	if appointment.Status != "attended" {
+		e.HandleError(c, errors.New("only an attended appointment can be reviewed"), h.log, http.StatusConflict, "CreateReview")
+		return
+	}
+
+	review, err := h.serviceManager.HealthcareService().ReviewService().CreateReview(ctx, &pb.Review{
+		Id:            uuid.NewString(),
+		DoctorId:      appointment.DoctorId,
+		PatientId:     appointment.PatientId,
+		AppointmentId: appointment.Id,
+		Rating:        body.Rating,
+		Comment:       body.Comment,
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		e.HandleError(c, err, h.log, http.StatusConflict, "CreateReview")
+		return
+	}
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateReview") {
+		return
+	}
+
+	c.JSON(http.StatusOK, reviewToRes(review))
+}
+
+// GetReview ...
+// @Summary GetReview
+// @Description GetReview - Api for get review
+// @Tags Review
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_healthcare_service.ReviewRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/review/get [get]
+func (h *HandlerV1) GetReview(c *gin.Context) {
+	id := c.Query("id")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	review, err := h.serviceManager.HealthcareService().ReviewService().GetReviewById(ctx, &pb.GetReqStrReview{
+		Field:    "id",
+		Value:    id,
+		IsActive: false,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetReview") {
+		return
+	}
+
+	c.JSON(http.StatusOK, reviewToRes(review))
+}
+
+// ListReviews ...
+// @Summary ListReviews
+// @Description ListReviews - Api for list reviews. Only approved reviews are listed unless another status is asked for.
+// @Tags Review
+// @Accept json
+// @Produce json
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param doctor_id query string false "doctor_id"
+// @Param patient_id query string false "patient_id"
+// @Param status query string false "status" Enums(pending, approved, rejected)
+// @Success 200 {object} model_healthcare_service.ListReviews
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/review [get]
+func (h *HandlerV1) ListReviews(c *gin.Context) {
+	limit := c.Query("limit")
+	page := c.Query("page")
+	orderBy := c.Query("orderBy")
+	reviewStatus := c.DefaultQuery("status", "approved")
+
+	pageInt, limitInt, err := e.ParseQueryParams(page, limit)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListReviews") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	reviews, err := h.serviceManager.HealthcareService().ReviewService().GetAllReviews(ctx, &pb.GetAllReview{
+		Page:      int64(pageInt),
+		Limit:     int64(limitInt),
+		OrderBy:   orderBy,
+		IsActive:  false,
+		DoctorId:  c.Query("doctor_id"),
+		PatientId: c.Query("patient_id"),
+		Status:    reviewStatus,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListReviews") {
+		return
+	}
+
+	var reviewsRes model_healthcare_service.ListReviews
+	for _, review := range reviews.Reviews {
+		reviewsRes.Reviews = append(reviewsRes.Reviews, reviewToRes(review))
+	}
+	reviewsRes.Count = reviews.Count
+
+	c.JSON(http.StatusOK, reviewsRes)
+}
+
+// ModerateReview ...
+// @Summary ModerateReview
+// @Description ModerateReview - Api for admins to approve or reject a review. The doctor's rating is recalculated.
+// @Tags Review
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ModerateReviewReq body model_healthcare_service.ModerateReviewReq true "ModerateReviewReq"
+// @Success 200 {object} model_healthcare_service.ReviewRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/review/moderate [put]
+func (h *HandlerV1) ModerateReview(c *gin.Context) {
+	var body model_healthcare_service.ModerateReviewReq
+
+	if _, ok := h.requireRole(c, "ModerateReview", RoleAdmin); !ok {
+		return
+	}
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ModerateReview") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	review, err := h.serviceManager.HealthcareService().ReviewService().ModerateReview(ctx, &pb.ModerateReviewReq{
+		Id:             body.Id,
+		Status:         body.Status,
+		ModerationNote: body.ModerationNote,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ModerateReview") {
+		return
+	}
+
+	c.JSON(http.StatusOK, reviewToRes(review))
+}
+
+// ReplyToReview ...
+// @Summary ReplyToReview
+// @Description ReplyToReview - Api for the reviewed doctor to answer a review
+// @Tags Review
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param ReplyReviewReq body model_healthcare_service.ReplyReviewReq true "ReplyReviewReq"
+// @Success 200 {object} model_healthcare_service.ReviewRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/review/reply [put]
+func (h *HandlerV1) ReplyToReview(c *gin.Context) {
+	var body model_healthcare_service.ReplyReviewReq
+
+	doctor, ok := h.requireRole(c, "ReplyToReview", RoleDoctor)
+	if !ok {
+		return
+	}
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ReplyToReview") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	review, err := h.serviceManager.HealthcareService().ReviewService().ReplyToReview(ctx, &pb.ReplyReviewReq{
+		Id:       body.Id,
+		DoctorId: doctor.UserId,
+		Reply:    body.Reply,
+	})
+	if status.Code(err) == codes.PermissionDenied {
+		e.HandleError(c, err, h.log, http.StatusForbidden, "ReplyToReview")
+		return
+	}
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ReplyToReview") {
+		return
+	}
+
+	c.JSON(http.StatusOK, reviewToRes(review))
+}
+
+// DeleteReview ...
+// @Summary DeleteReview
+// @Description DeleteReview - Api for delete review
+// @Tags Review
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 401 {object} model_common.StandardErrorModel
+// @Failure 403 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/review [delete]
+func (h *HandlerV1) DeleteReview(c *gin.Context) {
+	id := c.Query("id")
+
+	if _, ok := h.requireRole(c, "DeleteReview", RoleAdmin); !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	deleted, err := h.serviceManager.HealthcareService().ReviewService().DeleteReview(ctx, &pb.GetReqStrReview{
+		Field:    "id",
+		Value:    id,
+		IsActive: false,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DeleteReview") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: deleted.Status})
+}
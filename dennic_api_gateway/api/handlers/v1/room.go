@@ -0,0 +1,214 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models"
+	"dennic_api_gateway/api/models/model_healthcare_service"
+	pb "dennic_api_gateway/genproto/healthcare-service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+)
+
+func roomToRes(room *pb.Room) *model_healthcare_service.RoomRes {
+	return &model_healthcare_service.RoomRes{
+		Id:           room.Id,
+		Order:        room.Order,
+		BranchId:     room.BranchId,
+		DepartmentId: room.DepartmentId,
+		RoomNumber:   room.RoomNumber,
+		FloorNumber:  room.FloorNumber,
+		Name:         room.Name,
+		CreatedAt:    room.CreatedAt,
+		UpdatedAt:    e.UpdateTimeFilter(room.UpdatedAt),
+	}
+}
+
+// CreateRoom ...
+// @Summary CreateRoom
+// @Description CreateRoom - Api for create branch room
+// @Tags Room
+// @Accept json
+// @Produce json
+// @Param RoomReq body model_healthcare_service.RoomReq true "RoomReq"
+// @Success 200 {object} model_healthcare_service.RoomRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/room [post]
+func (h *HandlerV1) CreateRoom(c *gin.Context) {
+	var body model_healthcare_service.RoomReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateRoom") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	room, err := h.serviceManager.HealthcareService().RoomService().CreateRoom(ctx, &pb.Room{
+		Id:           uuid.NewString(),
+		BranchId:     body.BranchId,
+		DepartmentId: body.DepartmentId,
+		RoomNumber:   body.RoomNumber,
+		FloorNumber:  body.FloorNumber,
+		Name:         body.Name,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateRoom") {
+		return
+	}
+
+	c.JSON(http.StatusOK, roomToRes(room))
+}
+
+// GetRoom ...
+// @Summary GetRoom
+// @Description GetRoom - Api for get branch room
+// @Tags Room
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_healthcare_service.RoomRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/room/get [get]
+func (h *HandlerV1) GetRoom(c *gin.Context) {
+	id := c.Query("id")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	room, err := h.serviceManager.HealthcareService().RoomService().GetRoomById(ctx, &pb.GetReqStrRoom{
+		Field:    "id",
+		Value:    id,
+		IsActive: false,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetRoom") {
+		return
+	}
+
+	c.JSON(http.StatusOK, roomToRes(room))
+}
+
+// ListRooms ...
+// @Summary ListRooms
+// @Description ListRooms - Api for list branch rooms
+// @Tags Room
+// @Accept json
+// @Produce json
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param search query string false "search" Enums(name, room_number) "search"
+// @Param branch_id query string false "branch_id"
+// @Param department_id query string false "department_id"
+// @Success 200 {object} model_healthcare_service.ListRooms
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/room [get]
+func (h *HandlerV1) ListRooms(c *gin.Context) {
+	search := c.Query("search")
+	value := c.Query("value")
+	limit := c.Query("limit")
+	page := c.Query("page")
+	orderBy := c.Query("orderBy")
+	branchId := c.Query("branch_id")
+	departmentId := c.Query("department_id")
+
+	pageInt, limitInt, err := e.ParseQueryParams(page, limit)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListRooms") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	rooms, err := h.serviceManager.HealthcareService().RoomService().GetAllRooms(ctx, &pb.GetAllRoom{
+		Field:        search,
+		Value:        value,
+		IsActive:     false,
+		Page:         int64(pageInt),
+		Limit:        int64(limitInt),
+		OrderBy:      orderBy,
+		BranchId:     branchId,
+		DepartmentId: departmentId,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "ListRooms") {
+		return
+	}
+
+	var roomsRes model_healthcare_service.ListRooms
+	for _, room := range rooms.Rooms {
+		roomsRes.Rooms = append(roomsRes.Rooms, roomToRes(room))
+	}
+	roomsRes.Count = rooms.Count
+
+	c.JSON(http.StatusOK, roomsRes)
+}
+
+// UpdateRoom ...
+// @Summary UpdateRoom
+// @Description UpdateRoom - Api for update branch room
+// @Tags Room
+// @Accept json
+// @Produce json
+// @Param UpdateRoomReq body model_healthcare_service.RoomReq true "UpdateRoomReq"
+// @Success 200 {object} model_healthcare_service.RoomRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/room [put]
+func (h *HandlerV1) UpdateRoom(c *gin.Context) {
+	var body model_healthcare_service.RoomReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateRoom") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	room, err := h.serviceManager.HealthcareService().RoomService().UpdateRoom(ctx, &pb.Room{
+		Id:           body.Id,
+		BranchId:     body.BranchId,
+		DepartmentId: body.DepartmentId,
+		RoomNumber:   body.RoomNumber,
+		FloorNumber:  body.FloorNumber,
+		Name:         body.Name,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "UpdateRoom") {
+		return
+	}
+
+	c.JSON(http.StatusOK, roomToRes(room))
+}
+
+// DeleteRoom ...
+// @Summary DeleteRoom
+// @Description DeleteRoom - Api for delete branch room
+// @Tags Room
+// @Accept json
+// @Produce json
+// @Param DeleteRoomReq query models.FieldValueReq true "FieldValueReq"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/room [delete]
+func (h *HandlerV1) DeleteRoom(c *gin.Context) {
+	field := c.Query("field")
+	value := c.Query("value")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().RoomService().DeleteRoom(ctx, &pb.GetReqStrRoom{
+		Field:    field,
+		Value:    value,
+		IsActive: false,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DeleteRoom") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
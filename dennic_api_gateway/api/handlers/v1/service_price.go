@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models"
+	"dennic_api_gateway/api/models/model_healthcare_service"
+	pb "dennic_api_gateway/genproto/healthcare-service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+)
+
+func servicePriceToRes(price *pb.ServicePrice) *model_healthcare_service.ServicePriceRes {
+	return &model_healthcare_service.ServicePriceRes{
+		Id:              price.Id,
+		DoctorServiceId: price.DoctorServiceId,
+		Modality:        price.Modality,
+		Amount:          price.Amount,
+		Currency:        price.Currency,
+		EffectiveFrom:   price.EffectiveFrom,
+		EffectiveTo:     price.EffectiveTo,
+		IsPromo:         price.IsPromo,
+		PromoName:       price.PromoName,
+		CreatedAt:       price.CreatedAt,
+		DeletedAt:       price.DeletedAt,
+	}
+}
+
+// CreateServicePrice ...
+// @Summary CreateServicePrice
+// @Description CreateServicePrice - Api for adding a price list entry or promo to a doctor service
+// @Tags Doctor Services
+// @Accept json
+// @Produce json
+// @Param ServicePriceReq body model_healthcare_service.ServicePriceReq true "ServicePriceReq"
+// @Success 200 {object} model_healthcare_service.ServicePriceRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-services/price [post]
+func (h *HandlerV1) CreateServicePrice(c *gin.Context) {
+	var body model_healthcare_service.ServicePriceReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateServicePrice") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	price, err := h.serviceManager.HealthcareService().DoctorsService().CreateServicePrice(ctx, &pb.ServicePrice{
+		Id:              uuid.NewString(),
+		DoctorServiceId: body.DoctorServiceId,
+		Modality:        body.Modality,
+		Amount:          body.Amount,
+		Currency:        body.Currency,
+		EffectiveFrom:   body.EffectiveFrom,
+		EffectiveTo:     body.EffectiveTo,
+		IsPromo:         body.IsPromo,
+		PromoName:       body.PromoName,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "CreateServicePrice") {
+		return
+	}
+
+	c.JSON(http.StatusOK, servicePriceToRes(price))
+}
+
+// GetServicePriceHistory ...
+// @Summary GetServicePriceHistory
+// @Description GetServicePriceHistory - Api for listing all price list entries of a doctor service, newest first
+// @Tags Doctor Services
+// @Accept json
+// @Produce json
+// @Param doctor_service_id query string true "doctor_service_id"
+// @Param modality query string false "modality" Enums(online, offline)
+// @Param page query string false "page"
+// @Param limit query string false "limit"
+// @Success 200 {object} model_healthcare_service.ListServicePrices
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-services/price/history [get]
+func (h *HandlerV1) GetServicePriceHistory(c *gin.Context) {
+	doctorServiceId := c.Query("doctor_service_id")
+	modality := c.Query("modality")
+
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "GetServicePriceHistory") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	prices, err := h.serviceManager.HealthcareService().DoctorsService().GetServicePriceHistory(ctx, &pb.GetServicePrices{
+		DoctorServiceId: doctorServiceId,
+		Modality:        modality,
+		Page:            int64(pageInt),
+		Limit:           int64(limitInt),
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetServicePriceHistory") {
+		return
+	}
+
+	var pricesRes model_healthcare_service.ListServicePrices
+	for _, price := range prices.Prices {
+		pricesRes.Prices = append(pricesRes.Prices, servicePriceToRes(price))
+	}
+	pricesRes.Count = prices.Count
+
+	c.JSON(http.StatusOK, pricesRes)
+}
+
+// GetEffectivePrice ...
+// @Summary GetEffectivePrice
+// @Description GetEffectivePrice - Api for the price of a doctor service in force at a moment (now by default)
+// @Tags Doctor Services
+// @Accept json
+// @Produce json
+// @Param doctor_service_id query string true "doctor_service_id"
+// @Param modality query string true "modality" Enums(online, offline)
+// @Param at query string false "at" example("2024-03-01 10:00:00")
+// @Success 200 {object} model_healthcare_service.ServicePriceRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-services/price [get]
+func (h *HandlerV1) GetEffectivePrice(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	price, err := h.serviceManager.HealthcareService().DoctorsService().GetEffectivePrice(ctx, &pb.GetEffectivePriceReq{
+		DoctorServiceId: c.Query("doctor_service_id"),
+		Modality:        c.Query("modality"),
+		At:              c.Query("at"),
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "GetEffectivePrice") {
+		return
+	}
+
+	c.JSON(http.StatusOK, servicePriceToRes(price))
+}
+
+// DeleteServicePrice ...
+// @Summary DeleteServicePrice
+// @Description DeleteServicePrice - Api for withdrawing a price list entry; it stays in the history
+// @Tags Doctor Services
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} models.StatusRes
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/doctor-services/price [delete]
+func (h *HandlerV1) DeleteServicePrice(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	status, err := h.serviceManager.HealthcareService().DoctorsService().DeleteServicePrice(ctx, &pb.GetReqStr{
+		Field: "id",
+		Value: c.Query("id"),
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, "DeleteServicePrice") {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.StatusRes{Status: status.Status})
+}
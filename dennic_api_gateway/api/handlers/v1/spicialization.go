@@ -111,6 +111,7 @@ func (h *HandlerV1) GetSpecialization(c *gin.Context) {
 // @Param ListReq query models.ListReq false "ListReq"
 // @Param department_id query string false "department_id"
 // @Param search query string false "search" Enums(name, description) "search"
+// @Param branch_id query string false "branch_id"
 // @Success 200 {object} model_healthcare_service.ListSpecializations
 // @Failure 400 {object} model_common.StandardErrorModel
 // @Failure 500 {object} model_common.StandardErrorModel
@@ -121,6 +122,7 @@ func (h *HandlerV1) ListSpecializations(c *gin.Context) {
 	limit := c.Query("limit")
 	page := c.Query("page")
 	orderBy := c.Query("orderBy")
+	branchId := c.Query("branch_id")
 
 	departmentId := c.Query("department_id")
 	pageInt, limitInt, err := e.ParseQueryParams(page, limit)
@@ -138,6 +140,7 @@ func (h *HandlerV1) ListSpecializations(c *gin.Context) {
 		Page:         int32(pageInt),
 		Limit:        int32(limitInt),
 		OrderBy:      orderBy,
+		BranchId:     branchId,
 		DepartmentId: departmentId,
 	})
 
@@ -0,0 +1,172 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models/model_user_service"
+	pbb "dennic_api_gateway/genproto/booking_service"
+	pb "dennic_api_gateway/genproto/user_service"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Roles of the access tokens. Patients' accounts sign in as users, staff as
+// the role of their admin record; auth.csv says which routes a role reaches.
+const (
+	RoleUser       = "user"
+	RoleSuperadmin = "superadmin"
+	RoleAdmin      = "admin"
+	RoleDoctor     = "doctor"
+	RoleReception  = "reception"
+	RoleLab        = "lab"
+	RoleCashier    = "cashier"
+)
+
+// errNoPatientAccess is answered with forbidden by staffAccessError.
+var errNoPatientAccess = errors.New("no access to the records of this patient")
+
+// isStaffRole tells a staff token from a user one.
+func isStaffRole(role string) bool {
+	switch role {
+	case RoleSuperadmin, RoleAdmin, RoleDoctor, RoleReception, RoleLab, RoleCashier:
+		return true
+	}
+	return false
+}
+
+// StaffLogin ...
+// @Summary StaffLogin
+// @Description StaffLogin - Api for signing in a staff account. The role of the tokens is the role of the account: superadmin, admin,
+// @Description doctor, reception, lab or cashier. A doctor's account has the id of their doctor.
+// @Tags staff
+// @Accept json
+// @Produce json
+// @Param StaffLogin body model_user_service.StaffLoginReq true "StaffLoginReq"
+// @Success 200 {object} model_user_service.StaffResponse
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/staff/login [post]
+func (h *HandlerV1) StaffLogin(c *gin.Context) {
+	var body model_user_service.StaffLoginReq
+
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "StaffLogin") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	admin, err := h.serviceManager.UserService().AdminService().Get(ctx, &pb.GetAdminReq{
+		Field: "phone_number",
+		Value: body.PhoneNumber,
+	})
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, NOT_REGISTERED) {
+		return
+	}
+
+	if !e.CheckHashPassword(admin.Password, body.Password) {
+		err = errors.New("incorrect password")
+		_ = e.HandleError(c, err, h.log, http.StatusBadRequest, INVALID_REQUET_BODY)
+		return
+	}
+
+	access, refresh, err := h.jwthandler.GenerateAuthJWT(admin.PhoneNumber, admin.Id, "", admin.Role)
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, SERVICE_ERROR) {
+		return
+	}
+
+	_, err = h.serviceManager.UserService().AdminService().UpdateRefreshToken(ctx, &pb.UpdateRefreshTokenAdminReq{
+		Id:           admin.Id,
+		RefreshToken: refresh,
+	})
+	if e.HandleError(c, err, h.log, http.StatusInternalServerError, SERVICE_ERROR) {
+		return
+	}
+
+	c.JSON(http.StatusOK, &model_user_service.StaffResponse{
+		Id:           admin.Id,
+		Role:         admin.Role,
+		FirstName:    admin.FirstName,
+		LastName:     admin.LastName,
+		PhoneNumber:  admin.PhoneNumber,
+		AccessToken:  access,
+		RefreshToken: refresh,
+	})
+}
+
+// staffAccount is the admin record of a staff token, e.g. for the branch of
+// a reception account.
+func (h *HandlerV1) staffAccount(ctx context.Context, id string) (*pb.Admin, error) {
+	return h.serviceManager.UserService().AdminService().Get(ctx, &pb.GetAdminReq{
+		Field: "id",
+		Value: id,
+	})
+}
+
+// staffPatientAccess tells whether a staff token may see the records of a
+// patient: admins and reception those of every patient, a doctor those of
+// the patients they have an appointment with, other staff none.
+func (h *HandlerV1) staffPatientAccess(ctx context.Context, staff *e.UserTokenRes, patientId string) error {
+	switch staff.Role {
+	case RoleSuperadmin, RoleAdmin, RoleReception:
+		return nil
+	case RoleDoctor:
+		if patientId == "" {
+			return errNoPatientAccess
+		}
+		res, err := h.serviceManager.BookingService().BookedAppointment().GetAllAppointment(ctx, &pbb.GetAllAppointmentsReq{
+			Page:      1,
+			Limit:     1,
+			DoctorId:  staff.UserId,
+			PatientId: patientId,
+		})
+		if err != nil {
+			return err
+		}
+		if res.Count > 0 {
+			return nil
+		}
+	}
+	return errNoPatientAccess
+}
+
+// patientAccess tells whether a token may see the records of a patient: a
+// user those of the patient profiles of their account, staff as for
+// staffPatientAccess.
+func (h *HandlerV1) patientAccess(ctx context.Context, userInfo *e.UserTokenRes, patientId string) error {
+	if userInfo.Role == RoleUser {
+		return h.ownPatient(ctx, userInfo.UserId, patientId)
+	}
+	return h.staffPatientAccess(ctx, userInfo, patientId)
+}
+
+// staffAccessError answers with forbidden for errNoPatientAccess and as for
+// the patient calls otherwise.
+func (h *HandlerV1) staffAccessError(c *gin.Context, err error, method string) bool {
+	if errors.Is(err, errNoPatientAccess) {
+		return e.HandleError(c, err, h.log, http.StatusForbidden, method)
+	}
+	return h.myPatientError(c, err, method)
+}
+
+// requireRole answers forbidden unless the token has one of roles; a
+// superadmin passes wherever an admin does.
+func (h *HandlerV1) requireRole(c *gin.Context, method string, roles ...string) (*e.UserTokenRes, bool) {
+	userInfo, err := e.GetUserInfo(c)
+	if e.HandleError(c, err, h.log, http.StatusUnauthorized, method) {
+		return nil, false
+	}
+
+	for _, role := range roles {
+		if userInfo.Role == role || role == RoleAdmin && userInfo.Role == RoleSuperadmin {
+			return userInfo, true
+		}
+	}
+
+	_ = e.HandleError(c, errors.New("the role of the token is not allowed here"), h.log, http.StatusForbidden, method)
+	return nil, false
+}
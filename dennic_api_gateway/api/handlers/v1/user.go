@@ -217,7 +217,12 @@ func (h *HandlerV1) UpdateRefreshToken(c *gin.Context) {
 		return
 	}
 
-	access, refresh, err := h.jwthandler.GenerateAuthJWT(cast.ToString(claims["phone"]), cast.ToString(claims["id"]), cast.ToString(claims["session_id"]), "user")
+	role := cast.ToString(claims["role"])
+	if !isStaffRole(role) {
+		role = RoleUser
+	}
+
+	access, refresh, err := h.jwthandler.GenerateAuthJWT(cast.ToString(claims["phone"]), cast.ToString(claims["id"]), cast.ToString(claims["session_id"]), role)
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, SERVICE_ERROR) {
 		return
 	}
@@ -225,10 +230,17 @@ func (h *HandlerV1) UpdateRefreshToken(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
 	defer cancel()
 
-	_, err = h.serviceManager.UserService().UserService().UpdateRefreshToken(ctx, &pb.UpdateRefreshTokenUserReq{
-		Id:           cast.ToString(claims["id"]),
-		RefreshToken: refresh,
-	})
+	if role == RoleUser {
+		_, err = h.serviceManager.UserService().UserService().UpdateRefreshToken(ctx, &pb.UpdateRefreshTokenUserReq{
+			Id:           cast.ToString(claims["id"]),
+			RefreshToken: refresh,
+		})
+	} else {
+		_, err = h.serviceManager.UserService().AdminService().UpdateRefreshToken(ctx, &pb.UpdateRefreshTokenAdminReq{
+			Id:           cast.ToString(claims["id"]),
+			RefreshToken: refresh,
+		})
+	}
 
 	if e.HandleError(c, err, h.log, http.StatusInternalServerError, SERVICE_ERROR) {
 		return
@@ -0,0 +1,567 @@
+package v1
+
+import (
+	"context"
+	e "dennic_api_gateway/api/handlers/regtool"
+	"dennic_api_gateway/api/models/model_notification_service"
+	pb "dennic_api_gateway/genproto/notification_service"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func webhookPartnerToRes(partner *pb.WebhookPartner) *model_notification_service.WebhookPartner {
+	return &model_notification_service.WebhookPartner{
+		Id:        partner.Id,
+		Name:      partner.Name,
+		CreatedAt: partner.CreatedAt,
+	}
+}
+
+func webhookPartnerPatientToRes(link *pb.WebhookPartnerPatient) *model_notification_service.WebhookPartnerPatient {
+	return &model_notification_service.WebhookPartnerPatient{
+		PartnerId: link.PartnerId,
+		PatientId: link.PatientId,
+		CreatedAt: link.CreatedAt,
+	}
+}
+
+func webhookSubscriptionToRes(subscription *pb.WebhookSubscription) *model_notification_service.WebhookSubscription {
+	return &model_notification_service.WebhookSubscription{
+		Id:          subscription.Id,
+		PartnerId:   subscription.PartnerId,
+		Url:         subscription.Url,
+		EventTypes:  subscription.EventTypes,
+		Description: subscription.Description,
+		Active:      subscription.Active,
+		Secret:      subscription.Secret,
+		CreatedAt:   subscription.CreatedAt,
+		UpdatedAt:   subscription.UpdatedAt,
+	}
+}
+
+func webhookDeliveryToRes(delivery *pb.WebhookDelivery) *model_notification_service.WebhookDelivery {
+	res := &model_notification_service.WebhookDelivery{
+		Id:             delivery.Id,
+		SubscriptionId: delivery.SubscriptionId,
+		EventId:        delivery.EventId,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseCode:   delivery.ResponseCode,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+	for _, attempt := range delivery.AttemptLog {
+		res.AttemptLog = append(res.AttemptLog, &model_notification_service.WebhookAttempt{
+			Attempt:      attempt.Attempt,
+			ResponseCode: attempt.ResponseCode,
+			Error:        attempt.Error,
+			DurationMs:   attempt.DurationMs,
+			CreatedAt:    attempt.CreatedAt,
+		})
+	}
+	return res
+}
+
+// CreateWebhookPartner ...
+// @Summary CreateWebhookPartner
+// @Description CreateWebhookPartner - Api for register a partner, a lab or corporate client. Its subscriptions are told about the events
+// @Description of the patients linked to it only, e.g. its employees.
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param CreateWebhookPartnerReq body model_notification_service.CreateWebhookPartnerReq true "CreateWebhookPartnerReq"
+// @Success 201 {object} model_notification_service.WebhookPartner
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/partners [post]
+func (h *HandlerV1) CreateWebhookPartner(c *gin.Context) {
+	var body model_notification_service.CreateWebhookPartnerReq
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateWebhookPartner") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().CreatePartner(ctx, &pb.WebhookPartner{
+		Name: body.Name,
+	})
+	if h.notificationError(c, err, "CreateWebhookPartner") {
+		return
+	}
+
+	c.JSON(http.StatusCreated, webhookPartnerToRes(res))
+}
+
+// ListWebhookPartners ...
+// @Summary ListWebhookPartners
+// @Description ListWebhookPartners - Api for list webhook partners by name
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param ListReq query models.ListReq false "ListReq"
+// @Success 200 {object} model_notification_service.WebhookPartnersType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/partners [get]
+func (h *HandlerV1) ListWebhookPartners(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListWebhookPartners") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().GetAllPartners(ctx, &pb.GetAllWebhookPartnersReq{
+		Page:  pageInt,
+		Limit: limitInt,
+	})
+	if h.notificationError(c, err, "ListWebhookPartners") {
+		return
+	}
+
+	partners := &model_notification_service.WebhookPartnersType{Count: res.Count}
+	for _, partner := range res.Partners {
+		partners.Partners = append(partners.Partners, webhookPartnerToRes(partner))
+	}
+
+	c.JSON(http.StatusOK, partners)
+}
+
+// DeleteWebhookPartner ...
+// @Summary DeleteWebhookPartner
+// @Description DeleteWebhookPartner - Api for delete a webhook partner with its subscriptions and patient links
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_notification_service.WebhookPartner
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/partners [delete]
+func (h *HandlerV1) DeleteWebhookPartner(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().DeletePartner(ctx, &pb.WebhookPartnerReq{
+		Id: c.Query("id"),
+	})
+	if h.notificationError(c, err, "DeleteWebhookPartner") {
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookPartnerToRes(res))
+}
+
+// LinkWebhookPartnerPatient ...
+// @Summary LinkWebhookPartnerPatient
+// @Description LinkWebhookPartnerPatient - Api for tell a partner about the events of a patient from now on, e.g. of an employee
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param WebhookPartnerPatientReq body model_notification_service.WebhookPartnerPatientReq true "WebhookPartnerPatientReq"
+// @Success 200 {object} model_notification_service.WebhookPartnerPatient
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/partners/patients [post]
+func (h *HandlerV1) LinkWebhookPartnerPatient(c *gin.Context) {
+	var body model_notification_service.WebhookPartnerPatientReq
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "LinkWebhookPartnerPatient") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().LinkPatient(ctx, &pb.WebhookPartnerPatient{
+		PartnerId: body.PartnerId,
+		PatientId: body.PatientId,
+	})
+	if h.notificationError(c, err, "LinkWebhookPartnerPatient") {
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookPartnerPatientToRes(res))
+}
+
+// UnlinkWebhookPartnerPatient ...
+// @Summary UnlinkWebhookPartnerPatient
+// @Description UnlinkWebhookPartnerPatient - Api for stop telling a partner about the events of a patient
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param partner_id query string true "partner_id"
+// @Param patient_id query string true "patient_id"
+// @Success 200 {object} model_notification_service.WebhookPartnerPatient
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/partners/patients [delete]
+func (h *HandlerV1) UnlinkWebhookPartnerPatient(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().UnlinkPatient(ctx, &pb.WebhookPartnerPatient{
+		PartnerId: c.Query("partner_id"),
+		PatientId: c.Query("patient_id"),
+	})
+	if h.notificationError(c, err, "UnlinkWebhookPartnerPatient") {
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookPartnerPatientToRes(res))
+}
+
+// ListWebhookPartnerPatients ...
+// @Summary ListWebhookPartnerPatients
+// @Description ListWebhookPartnerPatients - Api for list the patients linked to a partner, the latest linked first
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param partner_id query string true "partner_id"
+// @Param ListReq query models.ListReq false "ListReq"
+// @Success 200 {object} model_notification_service.WebhookPartnerPatientsType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/partners/patients [get]
+func (h *HandlerV1) ListWebhookPartnerPatients(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListWebhookPartnerPatients") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().GetAllPartnerPatients(ctx, &pb.GetAllWebhookPartnerPatientsReq{
+		PartnerId: c.Query("partner_id"),
+		Page:      pageInt,
+		Limit:     limitInt,
+	})
+	if h.notificationError(c, err, "ListWebhookPartnerPatients") {
+		return
+	}
+
+	patients := &model_notification_service.WebhookPartnerPatientsType{Count: res.Count}
+	for _, link := range res.Patients {
+		patients.Patients = append(patients.Patients, webhookPartnerPatientToRes(link))
+	}
+
+	c.JSON(http.StatusOK, patients)
+}
+
+// CreateWebhookSubscription ...
+// @Summary CreateWebhookSubscription
+// @Description CreateWebhookSubscription - Api for register an endpoint of a partner told about the events of the patients linked to it: appointment.created,
+// @Description appointment.updated, appointment.confirmed, appointment.status_changed, appointment.deleted, appointment.completed,
+// @Description lab_order.released and prescription.issued. Deliveries are JSON POSTs signed in the X-Dennic-Signature header,
+// @Description "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">". The secret is returned only here and on rotate.
+// @Description The url must be https on a public address; private, loopback and link-local addresses are refused here and on delivery.
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param CreateWebhookSubscriptionReq body model_notification_service.CreateWebhookSubscriptionReq true "CreateWebhookSubscriptionReq"
+// @Success 201 {object} model_notification_service.WebhookSubscription
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/subscriptions [post]
+func (h *HandlerV1) CreateWebhookSubscription(c *gin.Context) {
+	var body model_notification_service.CreateWebhookSubscriptionReq
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "CreateWebhookSubscription") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().CreateSubscription(ctx, &pb.WebhookSubscription{
+		PartnerId:   body.PartnerId,
+		Url:         body.Url,
+		EventTypes:  body.EventTypes,
+		Description: body.Description,
+	})
+	if h.notificationError(c, err, "CreateWebhookSubscription") {
+		return
+	}
+
+	c.JSON(http.StatusCreated, webhookSubscriptionToRes(res))
+}
+
+// ListWebhookSubscriptions ...
+// @Summary ListWebhookSubscriptions
+// @Description ListWebhookSubscriptions - Api for list webhook subscriptions, the newest first, e.g. the ones of a partner to an event type
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param event_type query string false "event_type"
+// @Param partner_id query string false "partner_id"
+// @Success 200 {object} model_notification_service.WebhookSubscriptionsType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/subscriptions [get]
+func (h *HandlerV1) ListWebhookSubscriptions(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListWebhookSubscriptions") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().GetAllSubscriptions(ctx, &pb.GetAllWebhookSubscriptionsReq{
+		Page:      pageInt,
+		Limit:     limitInt,
+		EventType: c.Query("event_type"),
+		PartnerId: c.Query("partner_id"),
+	})
+	if h.notificationError(c, err, "ListWebhookSubscriptions") {
+		return
+	}
+
+	subscriptions := &model_notification_service.WebhookSubscriptionsType{Count: res.Count}
+	for _, subscription := range res.Subscriptions {
+		subscriptions.Subscriptions = append(subscriptions.Subscriptions, webhookSubscriptionToRes(subscription))
+	}
+
+	c.JSON(http.StatusOK, subscriptions)
+}
+
+// GetWebhookSubscription ...
+// @Summary GetWebhookSubscription
+// @Description GetWebhookSubscription - Api for get a webhook subscription
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_notification_service.WebhookSubscription
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/subscriptions/get [get]
+func (h *HandlerV1) GetWebhookSubscription(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().GetSubscription(ctx, &pb.WebhookSubscriptionReq{
+		Id: c.Query("id"),
+	})
+	if h.notificationError(c, err, "GetWebhookSubscription") {
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookSubscriptionToRes(res))
+}
+
+// UpdateWebhookSubscription ...
+// @Summary UpdateWebhookSubscription
+// @Description UpdateWebhookSubscription - Api for change the url, event types, description and active of a webhook subscription.
+// @Description An inactive subscription gets no new deliveries and its pending ones fail.
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param UpdateWebhookSubscriptionReq body model_notification_service.UpdateWebhookSubscriptionReq true "UpdateWebhookSubscriptionReq"
+// @Success 200 {object} model_notification_service.WebhookSubscription
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/subscriptions [put]
+func (h *HandlerV1) UpdateWebhookSubscription(c *gin.Context) {
+	var body model_notification_service.UpdateWebhookSubscriptionReq
+	err := c.ShouldBindJSON(&body)
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "UpdateWebhookSubscription") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().UpdateSubscription(ctx, &pb.WebhookSubscription{
+		Id:          body.Id,
+		Url:         body.Url,
+		EventTypes:  body.EventTypes,
+		Description: body.Description,
+		Active:      body.Active,
+	})
+	if h.notificationError(c, err, "UpdateWebhookSubscription") {
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookSubscriptionToRes(res))
+}
+
+// RotateWebhookSecret ...
+// @Summary RotateWebhookSecret
+// @Description RotateWebhookSecret - Api for give a webhook subscription a new secret; deliveries sent from now on, retries included,
+// @Description are signed with it
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_notification_service.WebhookSubscription
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/subscriptions/rotate-secret [post]
+func (h *HandlerV1) RotateWebhookSecret(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().RotateSecret(ctx, &pb.WebhookSubscriptionReq{
+		Id: c.Query("id"),
+	})
+	if h.notificationError(c, err, "RotateWebhookSecret") {
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookSubscriptionToRes(res))
+}
+
+// DeleteWebhookSubscription ...
+// @Summary DeleteWebhookSubscription
+// @Description DeleteWebhookSubscription - Api for delete a webhook subscription with its delivery log
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_notification_service.WebhookSubscription
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/subscriptions [delete]
+func (h *HandlerV1) DeleteWebhookSubscription(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().DeleteSubscription(ctx, &pb.WebhookSubscriptionReq{
+		Id: c.Query("id"),
+	})
+	if h.notificationError(c, err, "DeleteWebhookSubscription") {
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookSubscriptionToRes(res))
+}
+
+// ListWebhookDeliveries ...
+// @Summary ListWebhookDeliveries
+// @Description ListWebhookDeliveries - Api for the webhook delivery log with the response codes partners answered with, the newest first,
+// @Description e.g. status=failed for deliveries that ran out of retries
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param ListReq query models.ListReq false "ListReq"
+// @Param subscription_id query string false "subscription_id"
+// @Param event_type query string false "event_type"
+// @Param status query string false "status" Enums(pending, delivered, failed)
+// @Success 200 {object} model_notification_service.WebhookDeliveriesType
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/deliveries [get]
+func (h *HandlerV1) ListWebhookDeliveries(c *gin.Context) {
+	pageInt, limitInt, err := e.ParseQueryParams(c.Query("page"), c.Query("limit"))
+	if e.HandleError(c, err, h.log, http.StatusBadRequest, "ListWebhookDeliveries") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().GetAllDeliveries(ctx, &pb.GetAllWebhookDeliveriesReq{
+		Page:           pageInt,
+		Limit:          limitInt,
+		SubscriptionId: c.Query("subscription_id"),
+		EventType:      c.Query("event_type"),
+		Status:         c.Query("status"),
+	})
+	if h.notificationError(c, err, "ListWebhookDeliveries") {
+		return
+	}
+
+	deliveries := &model_notification_service.WebhookDeliveriesType{Count: res.Count}
+	for _, delivery := range res.Deliveries {
+		deliveries.Deliveries = append(deliveries.Deliveries, webhookDeliveryToRes(delivery))
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// GetWebhookDelivery ...
+// @Summary GetWebhookDelivery
+// @Description GetWebhookDelivery - Api for get a webhook delivery with every attempt made to send it
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_notification_service.WebhookDelivery
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/deliveries/get [get]
+func (h *HandlerV1) GetWebhookDelivery(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().GetDelivery(ctx, &pb.WebhookDeliveryReq{
+		Id: c.Query("id"),
+	})
+	if h.notificationError(c, err, "GetWebhookDelivery") {
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookDeliveryToRes(res))
+}
+
+// RedeliverWebhook ...
+// @Summary RedeliverWebhook
+// @Description RedeliverWebhook - Api for send a webhook delivery again with the body it was first sent with and a fresh set of retries,
+// @Description e.g. once a partner fixed their endpoint
+// @Tags Webhook
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id query string true "id"
+// @Success 200 {object} model_notification_service.WebhookDelivery
+// @Failure 400 {object} model_common.StandardErrorModel
+// @Failure 404 {object} model_common.StandardErrorModel
+// @Failure 500 {object} model_common.StandardErrorModel
+// @Router /v1/webhook/deliveries/redeliver [post]
+func (h *HandlerV1) RedeliverWebhook(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.Context.Timeout))
+	defer cancel()
+
+	res, err := h.serviceManager.NotificationService().WebhookService().Redeliver(ctx, &pb.WebhookDeliveryReq{
+		Id: c.Query("id"),
+	})
+	if h.notificationError(c, err, "RedeliverWebhook") {
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookDeliveryToRes(res))
+}
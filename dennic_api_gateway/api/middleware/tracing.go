@@ -10,6 +10,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 const RequestIDHeader = "X-Request-ID"
@@ -18,11 +19,12 @@ const RequestIDHeader = "X-Request-ID"
 func GinTracing() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		rw := response.NewResponseWriter(c.Writer, http.StatusOK)
-		// Tracing
-		ctx := c.Request.Context()
+		// Tracing, continuing a trace the client started
+		ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
 		tracer := otel.Tracer("")
 		ctx, span := tracer.Start(ctx, c.FullPath()) // Use Gin's FullPath
 		defer span.End()
+		c.Request = c.Request.WithContext(ctx)
 
 		// Add request ID to header
 		c.Writer.Header().Add(RequestIDHeader, span.SpanContext().TraceID().String())
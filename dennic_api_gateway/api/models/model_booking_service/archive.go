@@ -1,16 +1,24 @@
 package model_booking_service
 
+// Archive is the closed-out record of a booked appointment.
 type Archive struct {
-	Id                   int64   `json:"id"`
-	DoctorAvailabilityId int64   `json:"doctor_availability_id"`
-	StartTime            string  `json:"start_time"`
-	EndTime              string  `json:"end_time"`
-	PatientProblem       string  `json:"patient_problem"`
-	Status               string  `json:"status"`
-	PaymentType          string  `json:"payment_type"`
-	PaymentAmount        float64 `json:"payment_amount"`
-	CreatedAt            string  `json:"created_at"`
-	UpdatedAt            string  `json:"updated_at"`
+	Id              int64  `json:"id"`
+	AppointmentId   int64  `json:"appointment_id"`
+	PatientId       string `json:"patient_id"`
+	DoctorId        string `json:"doctor_id"`
+	DepartmentId    string `json:"department_id"`
+	BranchId        string `json:"branch_id"`
+	AppointmentDate string `json:"appointment_date"`
+	StartedAt       string `json:"started_at"`
+	EndedAt         string `json:"ended_at"`
+	PatientProblem  string `json:"patient_problem"`
+	Outcome         string `json:"outcome"`
+	PaymentType     string `json:"payment_type"`
+	PaymentAmount   string `json:"payment_amount"`
+	Currency        string `json:"currency"`
+	InvoiceId       string `json:"invoice_id"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
 }
 
 type ArchivesType struct {
@@ -18,23 +26,24 @@ type ArchivesType struct {
 	Archives []*Archive `json:"archives"`
 }
 
+// CreateArchiveReq closes out an appointment. Empty times default to the
+// booked time; with no payment_type the payment is taken from the invoice.
 type CreateArchiveReq struct {
-	DoctorAvailabilityId int64   `json:"doctor_availability_id"`
-	StartTime            string  `json:"start_time"`
-	EndTime              string  `json:"end_time"`
-	PatientProblem       string  `json:"patient_problem"`
-	Status               string  `json:"status"`
-	PaymentType          string  `json:"payment_type"`
-	PaymentAmount        float64 `json:"payment_amount"`
+	AppointmentId  int64  `json:"appointment_id"`
+	StartedAt      string `json:"started_at" example:"2024-05-06 10:05:00"`
+	EndedAt        string `json:"ended_at" example:"2024-05-06 10:30:00"`
+	PatientProblem string `json:"patient_problem"`
+	Outcome        string `json:"outcome" example:"attended"`
+	PaymentType    string `json:"payment_type" example:"card"`
+	PaymentAmount  string `json:"payment_amount" example:"150000.00"`
 }
 
 type UpdateArchiveReq struct {
-	ArchiveId            string  `json:"archive_id"`
-	DoctorAvailabilityId int64   `json:"doctor_availability_id"`
-	StartTime            string  `json:"start_time"`
-	EndTime              string  `json:"end_time"`
-	PatientProblem       string  `json:"patient_problem"`
-	Status               string  `json:"status"`
-	PaymentType          string  `json:"payment_type"`
-	PaymentAmount        float64 `json:"payment_amount"`
+	ArchiveId      string `json:"archive_id"`
+	StartedAt      string `json:"started_at" example:"2024-05-06 10:05:00"`
+	EndedAt        string `json:"ended_at" example:"2024-05-06 10:30:00"`
+	PatientProblem string `json:"patient_problem"`
+	Outcome        string `json:"outcome" example:"attended"`
+	PaymentType    string `json:"payment_type" example:"card"`
+	PaymentAmount  string `json:"payment_amount" example:"150000.00"`
 }
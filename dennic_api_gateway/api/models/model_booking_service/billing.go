@@ -0,0 +1,105 @@
+package model_booking_service
+
+// Amounts are decimal strings with two digits after the point.
+
+type InvoiceItemReq struct {
+	Description     string `json:"description" example:"ECG"`
+	DoctorServiceId string `json:"doctor_service_id"`
+	Quantity        int64  `json:"quantity" example:"1"`
+	UnitPrice       string `json:"unit_price" example:"50000.00"`
+}
+
+type InvoiceItem struct {
+	Id              int64  `json:"id"`
+	Kind            string `json:"kind" enums:"service,procedure"`
+	Description     string `json:"description"`
+	DoctorServiceId string `json:"doctor_service_id"`
+	Quantity        int64  `json:"quantity"`
+	UnitPrice       string `json:"unit_price"`
+	Amount          string `json:"amount"`
+}
+
+type InvoicePayment struct {
+	Id        string `json:"id"`
+	Kind      string `json:"kind" enums:"payment,refund"`
+	Method    string `json:"method" enums:"cash,card,insurance"`
+	Amount    string `json:"amount"`
+	CashierId string `json:"cashier_id"`
+	Reference string `json:"reference"`
+	CreatedAt string `json:"created_at"`
+}
+
+type Invoice struct {
+	Id            string            `json:"id"`
+	Number        string            `json:"number"`
+	AppointmentId int64             `json:"appointment_id"`
+	PatientId     string            `json:"patient_id"`
+	BranchId      string            `json:"branch_id"`
+	Currency      string            `json:"currency"`
+	Subtotal      string            `json:"subtotal"`
+	Discount      string            `json:"discount"`
+	Total         string            `json:"total"`
+	Paid          string            `json:"paid"`
+	Refunded      string            `json:"refunded"`
+	Balance       string            `json:"balance"`
+	Status        string            `json:"status" enums:"issued,partially_paid,paid,refunded,void"`
+	CreatedBy     string            `json:"created_by"`
+	Items         []*InvoiceItem    `json:"items"`
+	Payments      []*InvoicePayment `json:"payments"`
+	IssuedAt      string            `json:"issued_at"`
+	VoidedAt      string            `json:"voided_at"`
+	UpdatedAt     string            `json:"updated_at"`
+}
+
+type InvoicesType struct {
+	Count    int64      `json:"count"`
+	Invoices []*Invoice `json:"invoices"`
+}
+
+type InvoiceReq struct {
+	AppointmentId int64             `json:"appointment_id"`
+	Discount      string            `json:"discount" example:"0.00"`
+	Items         []*InvoiceItemReq `json:"items"`
+}
+
+type InvoiceItemAddReq struct {
+	InvoiceId string          `json:"invoice_id"`
+	Item      *InvoiceItemReq `json:"item"`
+}
+
+type InvoiceDiscountReq struct {
+	InvoiceId string `json:"invoice_id"`
+	Discount  string `json:"discount" example:"10000.00"`
+}
+
+type InvoiceTransactionReq struct {
+	InvoiceId string `json:"invoice_id"`
+	Method    string `json:"method" enums:"cash,card,insurance"`
+	Amount    string `json:"amount" example:"150000.00"`
+	Reference string `json:"reference" example:"card slip 004211"`
+}
+
+type CashDeskRow struct {
+	CashierId    string `json:"cashier_id"`
+	Method       string `json:"method"`
+	Currency     string `json:"currency"`
+	Payments     string `json:"payments"`
+	Refunds      string `json:"refunds"`
+	Net          string `json:"net"`
+	PaymentCount int64  `json:"payment_count"`
+	RefundCount  int64  `json:"refund_count"`
+}
+
+type CashDeskTotal struct {
+	Currency string `json:"currency"`
+	Payments string `json:"payments"`
+	Refunds  string `json:"refunds"`
+	Net      string `json:"net"`
+}
+
+type CashDeskReport struct {
+	BranchId string           `json:"branch_id"`
+	Date     string           `json:"date"`
+	Rows     []*CashDeskRow   `json:"rows"`
+	Totals   []*CashDeskTotal `json:"totals"`
+}
@@ -3,7 +3,9 @@ package model_booking_service
 type Appointment struct {
 	Id              int64  `json:"id"`
 	DepartmentId    string `json:"department_id"`
+	BranchId        string `json:"branch_id"`
 	DoctorId        string `json:"doctor_id"`
+	ResourceId      string `json:"resource_id"`
 	PatientId       string `json:"patient_id"`
 	AppointmentDate string `json:"appointment_date"`
 	AppointmentTime string `json:"appointment_time"`
@@ -11,8 +13,17 @@ type Appointment struct {
 	Key             string `json:"key"`
 	ExpiresAt       string `json:"expires_at"`
 	PatientStatus   bool   `json:"patient_status"`
+	Modality        string `json:"modality"`
+	DoctorServiceId string `json:"doctor_service_id"`
+	Price           string `json:"price"`
+	Currency        string `json:"currency"`
+	VideoRoomId     string `json:"video_room_id"`
+	VideoProvider   string `json:"video_provider"`
+	Status          string `json:"status"`
 	CreatedAt       string `json:"created_at"`
 	UpdatedAt       string `json:"updated_at"`
+	// Insurance is what the policy pays, when booked on insurance.
+	Insurance *Eligibility `json:"insurance,omitempty"`
 }
 
 type AppointmentsType struct {
@@ -22,7 +33,10 @@ type AppointmentsType struct {
 
 type CreateAppointmentReq struct {
 	DepartmentId    string `json:"department_id"`
+	BranchId        string `json:"branch_id"`
 	DoctorId        string `json:"doctor_id"`
+	DoctorServiceId string `json:"doctor_service_id"`
+	ResourceId      string `json:"resource_id"`
 	PatientId       string `json:"patient_id"`
 	AppointmentDate string `json:"appointment_date"`
 	AppointmentTime string `json:"appointment_time"`
@@ -30,6 +44,10 @@ type CreateAppointmentReq struct {
 	Key             string `json:"key"`
 	ExpiresAt       string `json:"expires_at"`
 	PatientStatus   bool   `json:"patient_status"`
+	Modality        string `json:"modality" example:"offline" enums:"offline,online"`
+	// InsurancePolicyId books the service on the patient's insurance; the
+	// booking is refused when the policy does not pay for it.
+	InsurancePolicyId string `json:"insurance_policy_id"`
 }
 
 type UpdateAppointmentReq struct {
@@ -41,3 +59,16 @@ type UpdateAppointmentReq struct {
 	ExpiresAt           string `json:"expires_at"`
 	PatientStatus       bool   `json:"patient_status"`
 }
+
+type AppointmentStatusReq struct {
+	AppointmentId int64  `json:"appointment_id"`
+	Status        string `json:"status" example:"attended" enums:"scheduled,attended,cancelled,no_show"`
+}
+
+type JoinLink struct {
+	Url       string `json:"url"`
+	RoomId    string `json:"room_id"`
+	Provider  string `json:"provider"`
+	NotBefore string `json:"not_before"`
+	ExpiresAt string `json:"expires_at"`
+}
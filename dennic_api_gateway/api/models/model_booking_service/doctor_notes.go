@@ -15,6 +15,8 @@ type DoctorNotesType struct {
 	DoctorNotes []*DoctorNote `json:"doctor_notes"`
 }
 
+// CreateDoctorNotesReq adds a note to a visit; appointment_id is the id of
+// the booked appointment.
 type CreateDoctorNotesReq struct {
 	AppointmentId int64  `json:"appointment_id"`
 	DoctorId      string `json:"doctor_id"`
@@ -3,6 +3,7 @@ package model_booking_service
 type DoctorTime struct {
 	Id           int64  `json:"id"`
 	DepartmentId string `json:"department_id"`
+	BranchId     string `json:"branch_id"`
 	DoctorId     string `json:"doctor_id"`
 	DoctorDate   string `json:"doctor_date"`
 	StartTime    string `json:"start_time"`
@@ -19,6 +20,7 @@ type DoctorTimesType struct {
 
 type CreateDoctorTimeReq struct {
 	DepartmentId string `json:"department_id"`
+	BranchId     string `json:"branch_id"`
 	DoctorId     string `json:"doctor_id"`
 	DoctorDate   string `json:"doctor_date"`
 	StartTime    string `json:"start_time"`
@@ -29,6 +31,7 @@ type CreateDoctorTimeReq struct {
 type UpdateDoctorTimeReq struct {
 	DoctorTimeId string `json:"doctor_time_id"`
 	DepartmentId string `json:"department_id"`
+	BranchId     string `json:"branch_id"`
 	DoctorId     string `json:"doctor_id"`
 	DoctorDate   string `json:"doctor_date"`
 	StartTime    string `json:"start_time"`
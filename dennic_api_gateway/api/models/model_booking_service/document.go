@@ -0,0 +1,26 @@
+package model_booking_service
+
+// Document is the metadata of a file in a patient's vault. The file itself is
+// fetched through a short-lived download URL.
+type Document struct {
+	Id            string `json:"id"`
+	PatientId     string `json:"patient_id"`
+	AppointmentId int64  `json:"appointment_id"`
+	Type          string `json:"type" enums:"scan,xray,discharge_summary,lab_report,referral,other"`
+	Title         string `json:"title"`
+	FileName      string `json:"file_name"`
+	ContentType   string `json:"content_type"`
+	SizeBytes     int64  `json:"size_bytes"`
+	UploadedBy    string `json:"uploaded_by"`
+	CreatedAt     string `json:"created_at"`
+}
+
+type DocumentsType struct {
+	Count     int64       `json:"count"`
+	Documents []*Document `json:"documents"`
+}
+
+type DocumentURL struct {
+	Url       string `json:"url"`
+	ExpiresAt string `json:"expires_at"`
+}
@@ -0,0 +1,82 @@
+package model_booking_service
+
+type Vitals struct {
+	TemperatureC     float64 `json:"temperature_c" example:"36.6"`
+	PulseBpm         int32   `json:"pulse_bpm" example:"72"`
+	SystolicMmHg     int32   `json:"systolic_mmhg" example:"120"`
+	DiastolicMmHg    int32   `json:"diastolic_mmhg" example:"80"`
+	RespiratoryRate  int32   `json:"respiratory_rate" example:"16"`
+	OxygenSaturation int32   `json:"oxygen_saturation" example:"98"`
+	WeightKg         float64 `json:"weight_kg" example:"70.5"`
+	HeightCm         float64 `json:"height_cm" example:"175"`
+}
+
+type Diagnosis struct {
+	Icd10Code   string `json:"icd10_code" example:"J06.9"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary"`
+}
+
+type Allergy struct {
+	Substance string `json:"substance" example:"Penicillin"`
+	Reaction  string `json:"reaction"`
+	Severity  string `json:"severity" example:"moderate" enums:"mild,moderate,severe"`
+}
+
+type ChronicCondition struct {
+	Icd10Code   string `json:"icd10_code" example:"E11.9"`
+	Description string `json:"description"`
+	OnsetDate   string `json:"onset_date" example:"2020-01-31"`
+}
+
+type Procedure struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+type EncounterReq struct {
+	AppointmentId     int64               `json:"appointment_id"`
+	ChiefComplaint    string              `json:"chief_complaint"`
+	Vitals            Vitals              `json:"vitals"`
+	Diagnoses         []*Diagnosis        `json:"diagnoses"`
+	Allergies         []*Allergy          `json:"allergies"`
+	ChronicConditions []*ChronicCondition `json:"chronic_conditions"`
+	Procedures        []*Procedure        `json:"procedures"`
+	FollowUpPlan      string              `json:"follow_up_plan"`
+	FollowUpDate      string              `json:"follow_up_date" example:"2024-12-31"`
+}
+
+type UpdateEncounterReq struct {
+	Id                string              `json:"id"`
+	ChiefComplaint    string              `json:"chief_complaint"`
+	Vitals            Vitals              `json:"vitals"`
+	Diagnoses         []*Diagnosis        `json:"diagnoses"`
+	Allergies         []*Allergy          `json:"allergies"`
+	ChronicConditions []*ChronicCondition `json:"chronic_conditions"`
+	Procedures        []*Procedure        `json:"procedures"`
+	FollowUpPlan      string              `json:"follow_up_plan"`
+	FollowUpDate      string              `json:"follow_up_date" example:"2024-12-31"`
+}
+
+type Encounter struct {
+	Id                string              `json:"id"`
+	AppointmentId     int64               `json:"appointment_id"`
+	PatientId         string              `json:"patient_id"`
+	DoctorId          string              `json:"doctor_id"`
+	EncounteredAt     string              `json:"encountered_at"`
+	ChiefComplaint    string              `json:"chief_complaint"`
+	Vitals            Vitals              `json:"vitals"`
+	Diagnoses         []*Diagnosis        `json:"diagnoses"`
+	Allergies         []*Allergy          `json:"allergies"`
+	ChronicConditions []*ChronicCondition `json:"chronic_conditions"`
+	Procedures        []*Procedure        `json:"procedures"`
+	FollowUpPlan      string              `json:"follow_up_plan"`
+	FollowUpDate      string              `json:"follow_up_date"`
+	CreatedAt         string              `json:"created_at"`
+	UpdatedAt         string              `json:"updated_at"`
+}
+
+type PatientTimeline struct {
+	Count      int64        `json:"count"`
+	Encounters []*Encounter `json:"encounters"`
+}
@@ -0,0 +1,9 @@
+package model_booking_service
+
+// ImportHistoryRes is the patient created from an imported bundle. Skipped
+// counts the resources of types that are not kept.
+type ImportHistoryRes struct {
+	Patient  *Patient `json:"patient"`
+	Imported int64    `json:"imported"`
+	Skipped  int64    `json:"skipped"`
+}
@@ -0,0 +1,142 @@
+package model_booking_service
+
+type InsurerReq struct {
+	Name        string `json:"name"`
+	Code        string `json:"code"`
+	Email       string `json:"email"`
+	PhoneNumber string `json:"phone_number"`
+}
+
+type UpdateInsurerReq struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Code        string `json:"code"`
+	Email       string `json:"email"`
+	PhoneNumber string `json:"phone_number"`
+}
+
+type Insurer struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Code        string `json:"code"`
+	Email       string `json:"email"`
+	PhoneNumber string `json:"phone_number"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type InsurersType struct {
+	Count    int64      `json:"count"`
+	Insurers []*Insurer `json:"insurers"`
+}
+
+type InsurancePolicyReq struct {
+	InsurerId    string `json:"insurer_id"`
+	PatientId    string `json:"patient_id"`
+	PolicyNumber string `json:"policy_number"`
+	ValidFrom    string `json:"valid_from" example:"2024-01-01"`
+	ValidTo      string `json:"valid_to" example:"2024-12-31"`
+	AnnualLimit  string `json:"annual_limit" example:"5000000.00"`
+	Currency     string `json:"currency" example:"UZS"`
+}
+
+type InsurancePolicy struct {
+	Id           string `json:"id"`
+	InsurerId    string `json:"insurer_id"`
+	PatientId    string `json:"patient_id"`
+	PolicyNumber string `json:"policy_number"`
+	ValidFrom    string `json:"valid_from"`
+	ValidTo      string `json:"valid_to"`
+	AnnualLimit  string `json:"annual_limit"`
+	Currency     string `json:"currency"`
+	Status       string `json:"status" enums:"active,cancelled"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+type InsurancePoliciesType struct {
+	Count    int64              `json:"count"`
+	Policies []*InsurancePolicy `json:"policies"`
+}
+
+type CoverageRuleReq struct {
+	InsurerId       string `json:"insurer_id"`
+	DoctorServiceId string `json:"doctor_service_id"`
+	Kind            string `json:"kind" example:"percentage" enums:"percentage,copay"`
+	Percentage      int64  `json:"percentage" example:"80"`
+	Copay           string `json:"copay" example:"50000.00"`
+	AnnualLimit     string `json:"annual_limit" example:"0.00"`
+}
+
+type CoverageRule struct {
+	Id              string `json:"id"`
+	InsurerId       string `json:"insurer_id"`
+	DoctorServiceId string `json:"doctor_service_id"`
+	Kind            string `json:"kind" enums:"percentage,copay"`
+	Percentage      int64  `json:"percentage"`
+	Copay           string `json:"copay"`
+	AnnualLimit     string `json:"annual_limit"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
+}
+
+type CoverageRulesType struct {
+	Count int64           `json:"count"`
+	Rules []*CoverageRule `json:"rules"`
+}
+
+type Eligibility struct {
+	Eligible  bool   `json:"eligible"`
+	Reason    string `json:"reason"`
+	PolicyId  string `json:"policy_id"`
+	InsurerId string `json:"insurer_id"`
+	Price     string `json:"price"`
+	Covered   string `json:"covered"`
+	Copay     string `json:"copay"`
+	Currency  string `json:"currency"`
+}
+
+type ClaimReq struct {
+	AppointmentId int64  `json:"appointment_id"`
+	PolicyId      string `json:"policy_id"`
+}
+
+type ClaimStatusReq struct {
+	Id       string `json:"id"`
+	Status   string `json:"status" example:"approved" enums:"approved,rejected,paid"`
+	Approved string `json:"approved"`
+	Reason   string `json:"reason"`
+}
+
+type InsuranceClaim struct {
+	Id              string `json:"id"`
+	InsurerId       string `json:"insurer_id"`
+	PolicyId        string `json:"policy_id"`
+	PatientId       string `json:"patient_id"`
+	AppointmentId   int64  `json:"appointment_id"`
+	InvoiceId       string `json:"invoice_id"`
+	DoctorServiceId string `json:"doctor_service_id"`
+	ServiceDate     string `json:"service_date"`
+	Billed          string `json:"billed"`
+	Covered         string `json:"covered"`
+	Copay           string `json:"copay"`
+	Approved        string `json:"approved"`
+	Currency        string `json:"currency"`
+	Status          string `json:"status" enums:"pending,submitted,approved,rejected,paid"`
+	StatusReason    string `json:"status_reason"`
+	BatchId         string `json:"batch_id"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
+}
+
+type InsuranceClaimsType struct {
+	Count  int64             `json:"count"`
+	Claims []*InsuranceClaim `json:"claims"`
+}
+
+type ExportClaimsReq struct {
+	InsurerId  string `json:"insurer_id"`
+	PeriodFrom string `json:"period_from" example:"2024-05-01"`
+	PeriodTo   string `json:"period_to" example:"2024-05-31"`
+	Format     string `json:"format" example:"csv" enums:"csv,json"`
+}
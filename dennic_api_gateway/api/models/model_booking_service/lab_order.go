@@ -0,0 +1,62 @@
+package model_booking_service
+
+type LabTest struct {
+	Code string `json:"code" example:"GLU"`
+	Name string `json:"name" example:"Glucose"`
+}
+
+type LabResultReq struct {
+	TestCode       string  `json:"test_code" example:"GLU"`
+	Analyte        string  `json:"analyte" example:"Glucose"`
+	Value          float64 `json:"value" example:"5.2"`
+	Unit           string  `json:"unit" example:"mmol/L"`
+	ReferenceRange string  `json:"reference_range" example:"3.9-5.5"`
+}
+
+type LabResult struct {
+	TestCode       string  `json:"test_code"`
+	Analyte        string  `json:"analyte"`
+	Value          float64 `json:"value"`
+	Unit           string  `json:"unit"`
+	ReferenceRange string  `json:"reference_range"`
+	Flag           string  `json:"flag" enums:"normal,low,high"`
+}
+
+// LabAttachment points to a report uploaded through /v1/file-upload.
+type LabAttachment struct {
+	FileName string `json:"file_name" example:"report.pdf"`
+	Url      string `json:"url" example:"http://dennic.uz:9000/lab/0b6f.pdf"`
+}
+
+type LabOrderReq struct {
+	AppointmentId int64      `json:"appointment_id"`
+	Notes         string     `json:"notes" example:"Fasting sample"`
+	Tests         []*LabTest `json:"tests"`
+}
+
+type LabResultsReq struct {
+	Id          string           `json:"id"`
+	Results     []*LabResultReq  `json:"results"`
+	Attachments []*LabAttachment `json:"attachments"`
+}
+
+type LabOrder struct {
+	Id            string           `json:"id"`
+	AppointmentId int64            `json:"appointment_id"`
+	PatientId     string           `json:"patient_id"`
+	DoctorId      string           `json:"doctor_id"`
+	Status        string           `json:"status" enums:"ordered,resulted,released,cancelled"`
+	Notes         string           `json:"notes"`
+	Tests         []*LabTest       `json:"tests"`
+	Results       []*LabResult     `json:"results"`
+	Attachments   []*LabAttachment `json:"attachments"`
+	OrderedAt     string           `json:"ordered_at"`
+	ResultedAt    string           `json:"resulted_at"`
+	ReleasedAt    string           `json:"released_at"`
+	CancelledAt   string           `json:"cancelled_at"`
+}
+
+type LabOrdersType struct {
+	Count     int64       `json:"count"`
+	LabOrders []*LabOrder `json:"lab_orders"`
+}
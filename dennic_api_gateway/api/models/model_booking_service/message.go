@@ -0,0 +1,76 @@
+package model_booking_service
+
+// Conversation is the thread of a patient and a doctor about an appointment,
+// or about none when appointment_id is 0. Unread counts the messages of the
+// other side; replies_from is when the doctor answers next, empty when they
+// set no reply hours.
+type Conversation struct {
+	Id              string `json:"id"`
+	PatientId       string `json:"patient_id"`
+	DoctorId        string `json:"doctor_id"`
+	AppointmentId   int64  `json:"appointment_id"`
+	LastMessageAt   string `json:"last_message_at"`
+	CreatedAt       string `json:"created_at"`
+	Unread          int64  `json:"unread"`
+	DoctorAvailable bool   `json:"doctor_available"`
+	RepliesFrom     string `json:"replies_from"`
+}
+
+type ConversationsType struct {
+	Count         int64           `json:"count"`
+	Conversations []*Conversation `json:"conversations"`
+}
+
+type StartConversationReq struct {
+	PatientId     string `json:"patient_id"`
+	DoctorId      string `json:"doctor_id"`
+	AppointmentId int64  `json:"appointment_id"`
+}
+
+// MessageAttachment is a file sent with a message, fetched through a
+// short-lived download URL.
+type MessageAttachment struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	SizeBytes   int64  `json:"size_bytes"`
+}
+
+type ConversationMessage struct {
+	Id             string             `json:"id"`
+	ConversationId string             `json:"conversation_id"`
+	SenderId       string             `json:"sender_id"`
+	SenderRole     string             `json:"sender_role" enums:"patient,doctor"`
+	Body           string             `json:"body"`
+	Attachment     *MessageAttachment `json:"attachment"`
+	ReadAt         string             `json:"read_at"`
+	CreatedAt      string             `json:"created_at"`
+}
+
+type MessagesType struct {
+	Count    int64                  `json:"count"`
+	Messages []*ConversationMessage `json:"messages"`
+}
+
+type ReadReceipt struct {
+	ConversationId string `json:"conversation_id"`
+	ReaderRole     string `json:"reader_role" enums:"patient,doctor"`
+	Read           int64  `json:"read"`
+	ReadAt         string `json:"read_at"`
+}
+
+// ReplyWindow is a weekly stretch of time a doctor answers messages in;
+// weekday 0 is Sunday, times are HH:MM in the clinic's zone.
+type ReplyWindow struct {
+	Weekday  int32  `json:"weekday"`
+	StartsAt string `json:"starts_at"`
+	EndsAt   string `json:"ends_at"`
+}
+
+type ReplyHours struct {
+	DoctorId string         `json:"doctor_id"`
+	Windows  []*ReplyWindow `json:"windows"`
+}
+
+type ReplyHoursReq struct {
+	Windows []*ReplyWindow `json:"windows"`
+}
@@ -12,6 +12,10 @@ type Patient struct {
 	City           string `json:"city"`
 	Country        string `json:"country"`
 	PatientProblem string `json:"patient_problem"`
+	UserId         string `json:"user_id"`
+	Relationship   string `json:"relationship"`
+	Consent        bool   `json:"consent"`
+	ConsentAt      string `json:"consent_at"`
 	CreatedAt      string `json:"created_at"`
 	UpdatedAt      string `json:"updated_at"`
 }
@@ -47,3 +51,33 @@ type UpdatePatientReq struct {
 	Country        string `json:"country"`
 	PatientProblem string `json:"patient_problem"`
 }
+
+type MyPatientReq struct {
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+	BirthDate      string `json:"birth_date"`
+	Gender         string `json:"gender"`
+	Address        string `json:"address"`
+	BloodGroup     string `json:"blood_group"`
+	PhoneNumber    string `json:"phone_number"`
+	City           string `json:"city"`
+	Country        string `json:"country"`
+	PatientProblem string `json:"patient_problem"`
+	Relationship   string `json:"relationship" example:"child" enums:"self,child,parent,spouse,other"`
+	Consent        bool   `json:"consent"`
+}
+
+type UpdateMyPatientReq struct {
+	PatientId      string `json:"patient_id"`
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+	BirthDate      string `json:"birth_date"`
+	Gender         string `json:"gender"`
+	Address        string `json:"address"`
+	BloodGroup     string `json:"blood_group"`
+	City           string `json:"city"`
+	Country        string `json:"country"`
+	PatientProblem string `json:"patient_problem"`
+	Relationship   string `json:"relationship" example:"child" enums:"self,child,parent,spouse,other"`
+	Consent        bool   `json:"consent"`
+}
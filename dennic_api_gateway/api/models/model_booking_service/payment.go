@@ -0,0 +1,29 @@
+package model_booking_service
+
+type PaymentIntentReq struct {
+	PatientId     string `json:"patient_id"`
+	AppointmentId int64  `json:"appointment_id"`
+}
+
+type PaymentIntent struct {
+	Id             string `json:"id"`
+	AppointmentId  int64  `json:"appointment_id"`
+	PatientId      string `json:"patient_id"`
+	Amount         string `json:"amount"`
+	Currency       string `json:"currency"`
+	Provider       string `json:"provider"`
+	ProviderRef    string `json:"provider_ref"`
+	CheckoutUrl    string `json:"checkout_url"`
+	Status         string `json:"status" enums:"pending,succeeded,failed,expired,refund_required"`
+	FailureReason  string `json:"failure_reason"`
+	IdempotencyKey string `json:"idempotency_key"`
+	InvoiceId      string `json:"invoice_id"`
+	ExpiresAt      string `json:"expires_at"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+}
+
+type PaymentIntentsType struct {
+	Count   int64            `json:"count"`
+	Intents []*PaymentIntent `json:"intents"`
+}
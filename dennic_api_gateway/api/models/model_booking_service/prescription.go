@@ -0,0 +1,98 @@
+package model_booking_service
+
+type MedicationReq struct {
+	Name             string `json:"name" example:"Nurofen"`
+	Form             string `json:"form" example:"tablet"`
+	Strength         string `json:"strength" example:"400 mg"`
+	ActiveIngredient string `json:"active_ingredient" example:"ibuprofen"`
+}
+
+type UpdateMedicationReq struct {
+	Id               string `json:"id"`
+	Name             string `json:"name" example:"Nurofen"`
+	Form             string `json:"form" example:"tablet"`
+	Strength         string `json:"strength" example:"400 mg"`
+	ActiveIngredient string `json:"active_ingredient" example:"ibuprofen"`
+}
+
+type Medication struct {
+	Id               string `json:"id"`
+	Name             string `json:"name"`
+	Form             string `json:"form"`
+	Strength         string `json:"strength"`
+	ActiveIngredient string `json:"active_ingredient"`
+	CreatedAt        string `json:"created_at"`
+	UpdatedAt        string `json:"updated_at"`
+}
+
+type MedicationsType struct {
+	Count       int64         `json:"count"`
+	Medications []*Medication `json:"medications"`
+}
+
+type Interaction struct {
+	IngredientA string `json:"ingredient_a" example:"ibuprofen"`
+	IngredientB string `json:"ingredient_b" example:"warfarin"`
+	Severity    string `json:"severity" example:"severe" enums:"mild,moderate,severe"`
+	Description string `json:"description" example:"Increased risk of bleeding"`
+}
+
+type PrescriptionItemReq struct {
+	MedicationId string `json:"medication_id"`
+	Dose         string `json:"dose" example:"1 tablet"`
+	Frequency    string `json:"frequency" example:"3 times a day"`
+	DurationDays int32  `json:"duration_days" example:"5"`
+	Route        string `json:"route" example:"oral" enums:"oral,sublingual,topical,inhaled,nasal,ophthalmic,otic,rectal,intravenous,intramuscular,subcutaneous"`
+	Instructions string `json:"instructions" example:"After meals"`
+}
+
+type PrescriptionReq struct {
+	AppointmentId int64                  `json:"appointment_id"`
+	Items         []*PrescriptionItemReq `json:"items"`
+}
+
+type PrescriptionItem struct {
+	MedicationId     string `json:"medication_id"`
+	MedicationName   string `json:"medication_name"`
+	Form             string `json:"form"`
+	Strength         string `json:"strength"`
+	ActiveIngredient string `json:"active_ingredient"`
+	Dose             string `json:"dose"`
+	Frequency        string `json:"frequency"`
+	DurationDays     int32  `json:"duration_days"`
+	Route            string `json:"route"`
+	Instructions     string `json:"instructions"`
+}
+
+type PrescriptionWarning struct {
+	Kind        string `json:"kind" enums:"allergy,interaction"`
+	Severity    string `json:"severity"`
+	Description string `json:"description"`
+}
+
+type Prescription struct {
+	Id               string                 `json:"id"`
+	AppointmentId    int64                  `json:"appointment_id"`
+	PatientId        string                 `json:"patient_id"`
+	DoctorId         string                 `json:"doctor_id"`
+	Items            []*PrescriptionItem    `json:"items"`
+	Warnings         []*PrescriptionWarning `json:"warnings"`
+	VerificationCode string                 `json:"verification_code"`
+	IssuedAt         string                 `json:"issued_at"`
+	RevokedAt        string                 `json:"revoked_at"`
+	CreatedAt        string                 `json:"created_at"`
+}
+
+type PrescriptionsType struct {
+	Count         int64           `json:"count"`
+	Prescriptions []*Prescription `json:"prescriptions"`
+}
+
+type PrescriptionVerification struct {
+	Valid           bool                `json:"valid"`
+	Revoked         bool                `json:"revoked"`
+	IssuedAt        string              `json:"issued_at"`
+	DoctorId        string              `json:"doctor_id"`
+	PatientInitials string              `json:"patient_initials"`
+	Items           []*PrescriptionItem `json:"items"`
+}
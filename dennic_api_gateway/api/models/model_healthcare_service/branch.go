@@ -0,0 +1,37 @@
+package model_healthcare_service
+
+type BranchOpeningHours struct {
+	DayOfWeek string `json:"day_of_week" example:"Monday"`
+	OpenTime  string `json:"open_time" example:"08:00"`
+	CloseTime string `json:"close_time" example:"18:00"`
+}
+
+type BranchReq struct {
+	Id           string                `json:"id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	Name         string                `json:"name" example:"Chilonzor branch"`
+	Address      string                `json:"address" example:"Tashkent, Chilonzor 9"`
+	Latitude     float64               `json:"latitude" example:"41.2856"`
+	Longitude    float64               `json:"longitude" example:"69.2034"`
+	Timezone     string                `json:"timezone" example:"Asia/Tashkent"`
+	PhoneNumber  string                `json:"phone_number" example:"+998901234567"`
+	OpeningHours []*BranchOpeningHours `json:"opening_hours"`
+}
+
+type BranchRes struct {
+	Id           string                `json:"id"`
+	Order        int32                 `json:"order"`
+	Name         string                `json:"name"`
+	Address      string                `json:"address"`
+	Latitude     float64               `json:"latitude"`
+	Longitude    float64               `json:"longitude"`
+	Timezone     string                `json:"timezone"`
+	PhoneNumber  string                `json:"phone_number"`
+	OpeningHours []*BranchOpeningHours `json:"opening_hours"`
+	CreatedAt    string                `json:"created_at"`
+	UpdatedAt    string                `json:"updated_at"`
+}
+
+type ListBranches struct {
+	Count    int64        `json:"count"`
+	Branches []*BranchRes `json:"branches"`
+}
@@ -7,6 +7,7 @@ type DepartmentReq struct {
 	ImageUrl         string `json:"image_url" example:"http://example.com/image.png"`
 	FloorNumber      int32  `json:"floor_number" example:"2"`
 	ShortDescription string `json:"short_description" example:"short_description"`
+	BranchId         string `json:"branch_id" example:"123e4567-e89b-12d3-a456-426614274001"`
 }
 
 type DepartmentRes struct {
@@ -17,6 +18,7 @@ type DepartmentRes struct {
 	ImageUrl         string `json:"image_url"`
 	FloorNumber      int32  `json:"floor_number"`
 	ShortDescription string `json:"short_description"`
+	BranchId         string `json:"branch_id"`
 	CreatedAt        string `json:"created_at"`
 	UpdatedAt        string `json:"updated_at"`
 }
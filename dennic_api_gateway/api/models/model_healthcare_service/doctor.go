@@ -98,6 +98,8 @@ type DoctorAndDoctorHours struct {
 	Password      string  `json:"password"`
 	CreatedAt     string  `json:"created_at"`
 	UpdatedAt     string  `json:"updated_at"`
+	Rating        float32 `json:"rating"`
+	ReviewCount   int32   `json:"review_count"`
 }
 
 type ListDoctorsAndHours struct {
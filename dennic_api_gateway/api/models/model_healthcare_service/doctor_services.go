@@ -1,26 +1,32 @@
 package model_healthcare_service
 
 type DoctorServicesReq struct {
-	Id               string  `json:"id" example:"123e4567-e89b-12d3-a456-426614274001"`
-	DoctorId         string  `json:"doctor_id" example:"123e4567-e89b-12d3-a456-426614274001"`
-	SpecializationId string  `json:"specialization_id" example:"123e4567-e89b-12d3-a456-426614375001"`
-	OnlinePrice      float32 `json:"online_price" example:"1.1"`
-	OfflinePrice     float32 `json:"offline_price" example:"1.1"`
-	Name             string  `json:"name" example:"name"`
-	Duration         string  `json:"duration" example:"12:12"`
+	Id                   string `json:"id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	DoctorId             string `json:"doctor_id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	SpecializationId     string `json:"specialization_id" example:"123e4567-e89b-12d3-a456-426614375001"`
+	OnlinePrice          string `json:"online_price" example:"150000.00"`
+	OfflinePrice         string `json:"offline_price" example:"120000.00"`
+	Currency             string `json:"currency" example:"UZS"`
+	Name                 string `json:"name" example:"name"`
+	DurationMinutes      int32  `json:"duration_minutes" example:"30"`
+	PrepInstructions     string `json:"prep_instructions" example:"Come on an empty stomach"`
+	RequiredResourceType string `json:"required_resource_type" example:"ultrasound"`
 }
 
 type DoctorServicesRes struct {
-	Id               string  `json:"id"`
-	Order            int32   `json:"order"`
-	DoctorId         string  `json:"doctor_id"`
-	SpecializationId string  `json:"specialization_id"`
-	OnlinePrice      float32 `json:"online_price"`
-	OfflinePrice     float32 `json:"offline_price"`
-	Name             string  `json:"name"`
-	Duration         string  `json:"duration"`
-	CreatedAt        string  `json:"created_at"`
-	UpdatedAt        string  `json:"updated_at"`
+	Id                   string `json:"id"`
+	Order                int32  `json:"order"`
+	DoctorId             string `json:"doctor_id"`
+	SpecializationId     string `json:"specialization_id"`
+	OnlinePrice          string `json:"online_price"`
+	OfflinePrice         string `json:"offline_price"`
+	Currency             string `json:"currency"`
+	Name                 string `json:"name"`
+	DurationMinutes      int32  `json:"duration_minutes"`
+	PrepInstructions     string `json:"prep_instructions"`
+	RequiredResourceType string `json:"required_resource_type"`
+	CreatedAt            string `json:"created_at"`
+	UpdatedAt            string `json:"updated_at"`
 }
 
 type ListDoctorServices struct {
@@ -34,3 +40,33 @@ type ListReqDoctorServices struct {
 	OrderBy      string `json:"order_by"`
 	DeleteStatus bool   `json:"-"`
 }
+
+type ServicePriceReq struct {
+	DoctorServiceId string `json:"doctor_service_id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	Modality        string `json:"modality" example:"offline"`
+	Amount          string `json:"amount" example:"150000.00"`
+	Currency        string `json:"currency" example:"UZS"`
+	EffectiveFrom   string `json:"effective_from" example:"2024-03-01"`
+	EffectiveTo     string `json:"effective_to" example:"2024-04-01"`
+	IsPromo         bool   `json:"is_promo" example:"false"`
+	PromoName       string `json:"promo_name" example:""`
+}
+
+type ServicePriceRes struct {
+	Id              string `json:"id"`
+	DoctorServiceId string `json:"doctor_service_id"`
+	Modality        string `json:"modality"`
+	Amount          string `json:"amount"`
+	Currency        string `json:"currency"`
+	EffectiveFrom   string `json:"effective_from"`
+	EffectiveTo     string `json:"effective_to"`
+	IsPromo         bool   `json:"is_promo"`
+	PromoName       string `json:"promo_name"`
+	CreatedAt       string `json:"created_at"`
+	DeletedAt       string `json:"deleted_at"`
+}
+
+type ListServicePrices struct {
+	Count  int64              `json:"count"`
+	Prices []*ServicePriceRes `json:"prices"`
+}
@@ -6,6 +6,8 @@ type DoctorWorkingHoursRes struct {
 	DayOfWeek  string `json:"day_of_week"`
 	StartTime  string `json:"start_time"`
 	FinishTime string `json:"finish_time"`
+	BranchId   string `json:"branch_id"`
+	RoomId     string `json:"room_id"`
 	CreatedAt  string `json:"created_at"`
 	UpdatedAt  string `json:"updated_at"`
 }
@@ -16,6 +18,8 @@ type DoctorWorkingHoursReq struct {
 	DayOfWeek  string `json:"day_of_week" example:"Monday"`
 	StartTime  string `json:"start_time" example:"12:00:00"`
 	FinishTime string `json:"finish_time" example:"12:00:00"`
+	BranchId   string `json:"branch_id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	RoomId     string `json:"room_id" example:"123e4567-e89b-12d3-a456-426614274001"`
 }
 
 type ListDoctorWorkingHours struct {
@@ -0,0 +1,25 @@
+package model_healthcare_service
+
+type ResourceReq struct {
+	Id           string `json:"id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	BranchId     string `json:"branch_id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	RoomId       string `json:"room_id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	ResourceType string `json:"resource_type" example:"ultrasound"`
+	Name         string `json:"name" example:"Ultrasound #1"`
+}
+
+type ResourceRes struct {
+	Id           string `json:"id"`
+	Order        int32  `json:"order"`
+	BranchId     string `json:"branch_id"`
+	RoomId       string `json:"room_id"`
+	ResourceType string `json:"resource_type"`
+	Name         string `json:"name"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+type ListResources struct {
+	Count     int64          `json:"count"`
+	Resources []*ResourceRes `json:"resources"`
+}
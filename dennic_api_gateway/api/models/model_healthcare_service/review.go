@@ -0,0 +1,38 @@
+package model_healthcare_service
+
+type ReviewReq struct {
+	AppointmentId int64  `json:"appointment_id" example:"1"`
+	Rating        int32  `json:"rating" example:"5"`
+	Comment       string `json:"comment" example:"Very attentive doctor"`
+}
+
+type ModerateReviewReq struct {
+	Id             string `json:"id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	Status         string `json:"status" example:"approved" enums:"pending,approved,rejected"`
+	ModerationNote string `json:"moderation_note" example:""`
+}
+
+type ReplyReviewReq struct {
+	Id    string `json:"id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	Reply string `json:"reply" example:"Thank you, get well soon!"`
+}
+
+type ReviewRes struct {
+	Id             string `json:"id"`
+	DoctorId       string `json:"doctor_id"`
+	PatientId      string `json:"patient_id"`
+	AppointmentId  int64  `json:"appointment_id"`
+	Rating         int32  `json:"rating"`
+	Comment        string `json:"comment"`
+	Status         string `json:"status"`
+	ModerationNote string `json:"moderation_note"`
+	Reply          string `json:"reply"`
+	RepliedAt      string `json:"replied_at"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+}
+
+type ListReviews struct {
+	Count   int64        `json:"count"`
+	Reviews []*ReviewRes `json:"reviews"`
+}
@@ -0,0 +1,27 @@
+package model_healthcare_service
+
+type RoomReq struct {
+	Id           string `json:"id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	BranchId     string `json:"branch_id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	DepartmentId string `json:"department_id" example:"123e4567-e89b-12d3-a456-426614274001"`
+	RoomNumber   string `json:"room_number" example:"204"`
+	FloorNumber  int32  `json:"floor_number" example:"2"`
+	Name         string `json:"name" example:"Ultrasound room"`
+}
+
+type RoomRes struct {
+	Id           string `json:"id"`
+	Order        int32  `json:"order"`
+	BranchId     string `json:"branch_id"`
+	DepartmentId string `json:"department_id"`
+	RoomNumber   string `json:"room_number"`
+	FloorNumber  int32  `json:"floor_number"`
+	Name         string `json:"name"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+type ListRooms struct {
+	Count int64      `json:"count"`
+	Rooms []*RoomRes `json:"rooms"`
+}
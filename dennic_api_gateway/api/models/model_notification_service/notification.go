@@ -0,0 +1,89 @@
+package model_notification_service
+
+type Preferences struct {
+	Language     string `json:"language" enums:"uz,ru,en"`
+	Push         bool   `json:"push"`
+	Sms          bool   `json:"sms"`
+	Email        bool   `json:"email"`
+	EmailAddress string `json:"email_address"`
+	Reminders    bool   `json:"reminders"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+type PreferencesReq struct {
+	Language     string `json:"language" enums:"uz,ru,en" example:"uz"`
+	Push         bool   `json:"push" example:"true"`
+	Sms          bool   `json:"sms" example:"true"`
+	Email        bool   `json:"email" example:"false"`
+	EmailAddress string `json:"email_address" example:""`
+	Reminders    bool   `json:"reminders" example:"true"`
+}
+
+type Delivery struct {
+	Id            string `json:"id"`
+	ReminderId    string `json:"reminder_id"`
+	AppointmentId int64  `json:"appointment_id"`
+	Kind          string `json:"kind" enums:"reminder_24h,reminder_2h"`
+	UserId        string `json:"user_id"`
+	PatientId     string `json:"patient_id"`
+	Channel       string `json:"channel" enums:"push,sms,email"`
+	Recipient     string `json:"recipient"`
+	Language      string `json:"language"`
+	Title         string `json:"title"`
+	Body          string `json:"body"`
+	Status        string `json:"status" enums:"pending,sent,failed"`
+	Attempts      int32  `json:"attempts"`
+	LastError     string `json:"last_error"`
+	ProviderRef   string `json:"provider_ref"`
+	NextAttemptAt string `json:"next_attempt_at"`
+	SentAt        string `json:"sent_at"`
+	CreatedAt     string `json:"created_at"`
+}
+
+type DeliveriesType struct {
+	Count      int64       `json:"count"`
+	Deliveries []*Delivery `json:"deliveries"`
+}
+
+type Reminder struct {
+	Id            string `json:"id"`
+	AppointmentId int64  `json:"appointment_id"`
+	Kind          string `json:"kind" enums:"reminder_24h,reminder_2h"`
+	PatientId     string `json:"patient_id"`
+	StartsAt      string `json:"starts_at"`
+	SendAt        string `json:"send_at"`
+	Status        string `json:"status" enums:"scheduled,dispatched,cancelled"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+type RemindersType struct {
+	Reminders []*Reminder `json:"reminders"`
+}
+
+type InboxNotification struct {
+	Id    string `json:"id"`
+	Kind  string `json:"kind" enums:"appointment_booked,appointment_confirmed,appointment_rescheduled,appointment_cancelled,lab_results_ready,prescription_issued"`
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	// Link is the deep link the app opens, e.g. dennic://appointments/17
+	Link      string            `json:"link"`
+	Data      map[string]string `json:"data"`
+	Read      bool              `json:"read"`
+	ReadAt    string            `json:"read_at"`
+	CreatedAt string            `json:"created_at"`
+}
+
+type InboxType struct {
+	Count         int64                `json:"count"`
+	Unread        int64                `json:"unread"`
+	Notifications []*InboxNotification `json:"notifications"`
+}
+
+type MarkReadReq struct {
+	Ids []string `json:"ids"`
+	All bool     `json:"all" example:"false"`
+}
+
+type UnreadCount struct {
+	Unread int64 `json:"unread"`
+}
@@ -0,0 +1,99 @@
+package model_notification_service
+
+// WebhookPartner is a lab or corporate client; it is told about the events
+// of the patients linked to it only.
+type WebhookPartner struct {
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at"`
+}
+
+type WebhookPartnersType struct {
+	Count    int64             `json:"count"`
+	Partners []*WebhookPartner `json:"partners"`
+}
+
+type CreateWebhookPartnerReq struct {
+	Name string `json:"name" example:"Partner lab"`
+}
+
+type WebhookPartnerPatient struct {
+	PartnerId string `json:"partner_id"`
+	PatientId string `json:"patient_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+type WebhookPartnerPatientsType struct {
+	Count    int64                    `json:"count"`
+	Patients []*WebhookPartnerPatient `json:"patients"`
+}
+
+type WebhookPartnerPatientReq struct {
+	PartnerId string `json:"partner_id"`
+	PatientId string `json:"patient_id"`
+}
+
+// WebhookSubscription is a partner endpoint told about domain events. The
+// secret signing the deliveries is only returned on create and rotate.
+type WebhookSubscription struct {
+	Id          string   `json:"id"`
+	PartnerId   string   `json:"partner_id"`
+	Url         string   `json:"url"`
+	EventTypes  []string `json:"event_types"`
+	Description string   `json:"description"`
+	Active      bool     `json:"active"`
+	Secret      string   `json:"secret,omitempty"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+type WebhookSubscriptionsType struct {
+	Count         int64                  `json:"count"`
+	Subscriptions []*WebhookSubscription `json:"subscriptions"`
+}
+
+type CreateWebhookSubscriptionReq struct {
+	PartnerId   string   `json:"partner_id"`
+	Url         string   `json:"url" example:"https://partner.example/dennic/hooks"`
+	EventTypes  []string `json:"event_types" example:"appointment.created,appointment.completed"`
+	Description string   `json:"description" example:"Partner lab"`
+}
+
+type UpdateWebhookSubscriptionReq struct {
+	Id          string   `json:"id"`
+	Url         string   `json:"url" example:"https://partner.example/dennic/hooks"`
+	EventTypes  []string `json:"event_types" example:"appointment.created,appointment.completed"`
+	Description string   `json:"description" example:"Partner lab"`
+	Active      bool     `json:"active" example:"true"`
+}
+
+// WebhookAttempt is one try to send a delivery; response_code is 0 when the
+// partner did not answer.
+type WebhookAttempt struct {
+	Attempt      int32  `json:"attempt"`
+	ResponseCode int32  `json:"response_code"`
+	Error        string `json:"error"`
+	DurationMs   int64  `json:"duration_ms"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type WebhookDelivery struct {
+	Id             string            `json:"id"`
+	SubscriptionId string            `json:"subscription_id"`
+	EventId        string            `json:"event_id"`
+	EventType      string            `json:"event_type"`
+	Payload        string            `json:"payload"`
+	Status         string            `json:"status" enums:"pending,delivered,failed"`
+	Attempts       int32             `json:"attempts"`
+	ResponseCode   int32             `json:"response_code"`
+	LastError      string            `json:"last_error"`
+	NextAttemptAt  string            `json:"next_attempt_at"`
+	DeliveredAt    string            `json:"delivered_at"`
+	CreatedAt      string            `json:"created_at"`
+	AttemptLog     []*WebhookAttempt `json:"attempt_log,omitempty"`
+}
+
+type WebhookDeliveriesType struct {
+	Count      int64              `json:"count"`
+	Deliveries []*WebhookDelivery `json:"deliveries"`
+}
@@ -81,6 +81,34 @@ type Response struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+type StaffLoginReq struct {
+	PhoneNumber string `json:"phone_number" example:"+998950230605"`
+	Password    string `json:"password" example:"password"`
+}
+
+// StaffResponse is a signed in staff account; Role is what the gateway lets
+// it do.
+type StaffResponse struct {
+	Id           string `json:"id"`
+	Role         string `json:"role" enums:"superadmin,admin,doctor,reception,lab,cashier"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name"`
+	PhoneNumber  string `json:"phone_number"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RegistrationStatus is pending until the user service registered the user;
+// User carries the tokens once it is created, the first time it is read
+// only, Reason why it failed. Token is answered by Verify only.
+type RegistrationStatus struct {
+	Id     string    `json:"id"`
+	Token  string    `json:"token,omitempty"`
+	Status string    `json:"status" example:"pending"`
+	Reason string    `json:"reason,omitempty"`
+	User   *Response `json:"user,omitempty"`
+}
+
 type PhoneNumberReq struct {
 	PhoneNumber string `json:"phone_number" example:"+998950230605"`
 }
@@ -4,7 +4,9 @@ import (
 	// "github.com/casbin/casbin/v2"
 	_ "dennic_api_gateway/api/docs"
 	"dennic_api_gateway/api/middleware/casbin"
+	redisCache "dennic_api_gateway/internal/infrastructure/redis"
 	"dennic_api_gateway/internal/pkg/redis"
+	"dennic_api_gateway/internal/usecase/event"
 	"time"
 
 	v1 "dennic_api_gateway/api/handlers/v1"
@@ -26,8 +28,9 @@ type RouteOption struct {
 	ContextTimeout time.Duration
 	Service        grpcClients.ServiceClient
 	Redis          *redis.RedisDB
-	//BrokerProducer event.BrokerProducer
-
+	Registrations  redisCache.Registrations
+	Realtime       redisCache.Realtime
+	BrokerProducer event.BrokerProducer
 }
 
 // NewRoute
@@ -49,8 +52,9 @@ func NewRoute(option RouteOption) *gin.Engine {
 		ContextTimeout: option.ContextTimeout,
 		Service:        option.Service,
 		Redis:          option.Redis,
-
-		//BrokerProducer: option.BrokerProducer,
+		Registrations:  option.Registrations,
+		Realtime:       option.Realtime,
+		BrokerProducer: option.BrokerProducer,
 	})
 
 	corsConfig := cors.DefaultConfig()
@@ -73,6 +77,7 @@ func NewRoute(option RouteOption) *gin.Engine {
 	customer := api.Group("/customer")
 	customer.POST("/register", HandlerV1.Register)
 	customer.POST("/verify", HandlerV1.Verify)
+	customer.GET("/registration/:id", HandlerV1.GetRegistration)
 	customer.POST("/forget-password", HandlerV1.ForgetPassword)
 	customer.PUT("/update-password", HandlerV1.UpdatePassword)
 	customer.POST("/verify-otp-code", HandlerV1.VerifyOtpCode)
@@ -114,6 +119,9 @@ func NewRoute(option RouteOption) *gin.Engine {
 	appointment.GET("/", HandlerV1.ListBookedAppointments)
 	appointment.PUT("/", HandlerV1.UpdateBookedAppointment)
 	appointment.DELETE("/", HandlerV1.DeleteBookedAppointment)
+	appointment.POST("/confirm", HandlerV1.ConfirmBookedAppointment)
+	appointment.GET("/join", HandlerV1.GetAppointmentJoinLink)
+	appointment.PUT("/status", HandlerV1.SetBookedAppointmentStatus)
 
 	// doctorTime
 	doctorTime := api.Group("/doctor-time")
@@ -131,6 +139,194 @@ func NewRoute(option RouteOption) *gin.Engine {
 	patient.PUT("/", HandlerV1.UpdatePatient)
 	patient.PUT("/phone", HandlerV1.UpdatePhonePatient)
 	patient.DELETE("/", HandlerV1.DeletePatient)
+	patient.GET("/timeline", HandlerV1.GetPatientTimeline)
+	patient.GET("/:id/export", HandlerV1.ExportPatientHistory)
+	patient.POST("/import", HandlerV1.ImportPatientHistory)
+
+	// encounter
+	encounter := api.Group("/encounter")
+	encounter.POST("/", HandlerV1.CreateEncounter)
+	encounter.GET("/get", HandlerV1.GetEncounter)
+	encounter.PUT("/", HandlerV1.UpdateEncounter)
+	encounter.DELETE("/", HandlerV1.DeleteEncounter)
+
+	// medication catalogue
+	medication := api.Group("/medication")
+	medication.POST("/", HandlerV1.CreateMedication)
+	medication.GET("/get", HandlerV1.GetMedication)
+	medication.GET("/", HandlerV1.ListMedications)
+	medication.PUT("/", HandlerV1.UpdateMedication)
+	medication.DELETE("/", HandlerV1.DeleteMedication)
+	medication.POST("/interaction", HandlerV1.CreateInteraction)
+
+	// prescription
+	prescription := api.Group("/prescription")
+	prescription.POST("/", HandlerV1.CreatePrescription)
+	prescription.GET("/get", HandlerV1.GetPrescription)
+	prescription.GET("/", HandlerV1.ListPrescriptions)
+	prescription.PUT("/revoke", HandlerV1.RevokePrescription)
+	prescription.GET("/pdf", HandlerV1.GetPrescriptionPdf)
+	prescription.GET("/verify", HandlerV1.VerifyPrescription)
+
+	// lab order
+	labOrder := api.Group("/lab-order")
+	labOrder.POST("/", HandlerV1.CreateLabOrder)
+	labOrder.GET("/get", HandlerV1.GetLabOrder)
+	labOrder.GET("/", HandlerV1.ListLabOrders)
+	labOrder.PUT("/results", HandlerV1.SubmitLabResults)
+	labOrder.PUT("/release", HandlerV1.ReleaseLabOrder)
+	labOrder.PUT("/cancel", HandlerV1.CancelLabOrder)
+
+	// patient document vault
+	document := api.Group("/document")
+	document.POST("/", HandlerV1.CreateDocument)
+	document.GET("/", HandlerV1.ListDocuments)
+	document.GET("/download", HandlerV1.GetDocumentURL)
+	document.DELETE("/", HandlerV1.DeleteDocument)
+
+	// billing
+	invoice := api.Group("/invoice")
+	invoice.POST("/", HandlerV1.CreateInvoice)
+	invoice.GET("/get", HandlerV1.GetInvoice)
+	invoice.GET("/", HandlerV1.ListInvoices)
+	invoice.POST("/item", HandlerV1.AddInvoiceItem)
+	invoice.PUT("/discount", HandlerV1.SetInvoiceDiscount)
+	invoice.POST("/payment", HandlerV1.RecordPayment)
+	invoice.POST("/refund", HandlerV1.RefundPayment)
+	invoice.PUT("/void", HandlerV1.VoidInvoice)
+	invoice.GET("/receipt", HandlerV1.GetInvoiceReceipt)
+
+	cashDesk := api.Group("/cash-desk")
+	cashDesk.GET("/report", HandlerV1.GetCashDeskReport)
+
+	// online payments
+	payment := api.Group("/payment")
+	payment.GET("/get", HandlerV1.GetPayment)
+	payment.GET("/", HandlerV1.ListPayments)
+	payment.POST("/webhook", HandlerV1.PaymentWebhook)
+
+	// insurance
+	insurer := api.Group("/insurer")
+	insurer.POST("/", HandlerV1.CreateInsurer)
+	insurer.GET("/get", HandlerV1.GetInsurer)
+	insurer.GET("/", HandlerV1.ListInsurers)
+	insurer.PUT("/", HandlerV1.UpdateInsurer)
+	insurer.DELETE("/", HandlerV1.DeleteInsurer)
+
+	insurancePolicy := api.Group("/insurance-policy")
+	insurancePolicy.POST("/", HandlerV1.CreateInsurancePolicy)
+	insurancePolicy.GET("/get", HandlerV1.GetInsurancePolicy)
+	insurancePolicy.GET("/", HandlerV1.ListInsurancePolicies)
+	insurancePolicy.PUT("/cancel", HandlerV1.CancelInsurancePolicy)
+
+	coverageRule := api.Group("/coverage-rule")
+	coverageRule.PUT("/", HandlerV1.SetCoverageRule)
+	coverageRule.GET("/", HandlerV1.ListCoverageRules)
+	coverageRule.DELETE("/", HandlerV1.DeleteCoverageRule)
+
+	api.GET("/insurance/eligibility", HandlerV1.CheckInsuranceEligibility)
+
+	claim := api.Group("/claim")
+	claim.POST("/", HandlerV1.CreateClaim)
+	claim.GET("/get", HandlerV1.GetClaim)
+	claim.GET("/", HandlerV1.ListClaims)
+	claim.PUT("/status", HandlerV1.SetClaimStatus)
+
+	claimBatch := api.Group("/claim-batch")
+	claimBatch.POST("/", HandlerV1.ExportClaims)
+	claimBatch.GET("/download", HandlerV1.DownloadClaimBatch)
+
+	// patient profiles of the logged-in account
+	me := api.Group("/me")
+	me.POST("/patients", HandlerV1.CreateMyPatient)
+	me.GET("/patients/get", HandlerV1.GetMyPatient)
+	me.GET("/patients", HandlerV1.ListMyPatients)
+	me.PUT("/patients", HandlerV1.UpdateMyPatient)
+	me.DELETE("/patients", HandlerV1.DeleteMyPatient)
+	me.GET("/timeline", HandlerV1.GetMyTimeline)
+	me.GET("/lab-results", HandlerV1.ListMyLabResults)
+	me.GET("/lab-results/get", HandlerV1.GetMyLabResult)
+	me.POST("/documents", HandlerV1.UploadMyDocument)
+	me.GET("/documents", HandlerV1.ListMyDocuments)
+	me.GET("/documents/download", HandlerV1.GetMyDocumentURL)
+	me.DELETE("/documents", HandlerV1.DeleteMyDocument)
+	me.POST("/payments", HandlerV1.CreateMyPayment)
+	me.GET("/payments/get", HandlerV1.GetMyPayment)
+	me.GET("/notifications/preferences", HandlerV1.GetMyNotificationPreferences)
+	me.PUT("/notifications/preferences", HandlerV1.UpdateMyNotificationPreferences)
+	me.GET("/notifications", HandlerV1.ListMyNotifications)
+	me.GET("/notifications/unread-count", HandlerV1.GetMyUnreadCount)
+	me.PUT("/notifications/read", HandlerV1.MarkMyNotificationsRead)
+	me.GET("/events", HandlerV1.StreamMyEvents)
+	me.POST("/conversations", HandlerV1.StartMyConversation)
+	me.GET("/conversations", HandlerV1.ListMyConversations)
+	me.GET("/conversations/get", HandlerV1.GetMyConversation)
+	me.GET("/conversations/messages", HandlerV1.ListMyMessages)
+	me.POST("/conversations/messages", HandlerV1.SendMyMessage)
+	me.PUT("/conversations/read", HandlerV1.MarkMyConversationRead)
+	me.GET("/conversations/attachment", HandlerV1.GetMyMessageAttachmentURL)
+	me.GET("/reply-hours", HandlerV1.GetMyReplyHours)
+	me.PUT("/reply-hours", HandlerV1.SetMyReplyHours)
+
+	// notification
+	notification := api.Group("/notification")
+	notification.GET("/deliveries", HandlerV1.ListNotificationDeliveries)
+	notification.GET("/reminders", HandlerV1.ListAppointmentReminders)
+
+	// staff
+	staff := api.Group("/staff")
+	staff.POST("/login", HandlerV1.StaffLogin)
+
+	// webhook
+	webhook := api.Group("/webhook")
+	webhook.POST("/partners", HandlerV1.CreateWebhookPartner)
+	webhook.GET("/partners", HandlerV1.ListWebhookPartners)
+	webhook.DELETE("/partners", HandlerV1.DeleteWebhookPartner)
+	webhook.POST("/partners/patients", HandlerV1.LinkWebhookPartnerPatient)
+	webhook.GET("/partners/patients", HandlerV1.ListWebhookPartnerPatients)
+	webhook.DELETE("/partners/patients", HandlerV1.UnlinkWebhookPartnerPatient)
+	webhook.POST("/subscriptions", HandlerV1.CreateWebhookSubscription)
+	webhook.GET("/subscriptions", HandlerV1.ListWebhookSubscriptions)
+	webhook.GET("/subscriptions/get", HandlerV1.GetWebhookSubscription)
+	webhook.PUT("/subscriptions", HandlerV1.UpdateWebhookSubscription)
+	webhook.DELETE("/subscriptions", HandlerV1.DeleteWebhookSubscription)
+	webhook.POST("/subscriptions/rotate-secret", HandlerV1.RotateWebhookSecret)
+	webhook.GET("/deliveries", HandlerV1.ListWebhookDeliveries)
+	webhook.GET("/deliveries/get", HandlerV1.GetWebhookDelivery)
+	webhook.POST("/deliveries/redeliver", HandlerV1.RedeliverWebhook)
+
+	// branch
+	branch := api.Group("/branch")
+	branch.POST("/", HandlerV1.CreateBranch)
+	branch.GET("/get", HandlerV1.GetBranch)
+	branch.GET("/", HandlerV1.ListBranches)
+	branch.PUT("/", HandlerV1.UpdateBranch)
+	branch.DELETE("/", HandlerV1.DeleteBranch)
+
+	// room
+	room := api.Group("/room")
+	room.POST("/", HandlerV1.CreateRoom)
+	room.GET("/get", HandlerV1.GetRoom)
+	room.GET("/", HandlerV1.ListRooms)
+	room.PUT("/", HandlerV1.UpdateRoom)
+	room.DELETE("/", HandlerV1.DeleteRoom)
+
+	// resource
+	resource := api.Group("/resource")
+	resource.POST("/", HandlerV1.CreateResource)
+	resource.GET("/get", HandlerV1.GetResource)
+	resource.GET("/", HandlerV1.ListResources)
+	resource.PUT("/", HandlerV1.UpdateResource)
+	resource.DELETE("/", HandlerV1.DeleteResource)
+
+	// review
+	review := api.Group("/review")
+	review.POST("/", HandlerV1.CreateReview)
+	review.GET("/get", HandlerV1.GetReview)
+	review.GET("/", HandlerV1.ListReviews)
+	review.PUT("/moderate", HandlerV1.ModerateReview)
+	review.PUT("/reply", HandlerV1.ReplyToReview)
+	review.DELETE("/", HandlerV1.DeleteReview)
 
 	// department
 	department := api.Group("/department")
@@ -164,6 +360,10 @@ func NewRoute(option RouteOption) *gin.Engine {
 	doctorServices.GET("/", HandlerV1.ListDoctorServices)
 	doctorServices.PUT("/", HandlerV1.UpdateDoctorServices)
 	doctorServices.DELETE("/", HandlerV1.DeleteDoctorService)
+	doctorServices.POST("/price", HandlerV1.CreateServicePrice)
+	doctorServices.GET("/price", HandlerV1.GetEffectivePrice)
+	doctorServices.GET("/price/history", HandlerV1.GetServicePriceHistory)
+	doctorServices.DELETE("/price", HandlerV1.DeleteServicePrice)
 
 	// doctorWorkingHours
 
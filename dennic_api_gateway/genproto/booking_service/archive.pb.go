@@ -5,7 +5,6 @@ package booking_service
 
 import (
 	context "context"
-	encoding_binary "encoding/binary"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -27,18 +26,31 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// Archive is the closed-out record of a booked appointment.
 type Archive struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	DoctorAvailabilityId int64    `protobuf:"varint,2,opt,name=doctor_availability_id,json=doctorAvailabilityId,proto3" json:"doctor_availability_id"`
-	StartTime            string   `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time"`
-	EndTime              string   `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time"`
-	PatientProblem       string   `protobuf:"bytes,5,opt,name=patient_problem,json=patientProblem,proto3" json:"patient_problem"`
-	Status               string   `protobuf:"bytes,6,opt,name=status,proto3" json:"status"`
-	PaymentType          string   `protobuf:"bytes,7,opt,name=payment_type,json=paymentType,proto3" json:"payment_type"`
-	PaymentAmount        float32  `protobuf:"fixed32,8,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount"`
-	CreatedAt            string   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	DeletedAt            string   `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	Id            int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	AppointmentId int64  `protobuf:"varint,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
+	PatientId     string `protobuf:"bytes,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	DoctorId      string `protobuf:"bytes,4,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	DepartmentId  string `protobuf:"bytes,5,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	BranchId      string `protobuf:"bytes,6,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
+	// YYYY-MM-DD
+	AppointmentDate string `protobuf:"bytes,7,opt,name=appointment_date,json=appointmentDate,proto3" json:"appointment_date"`
+	// YYYY-MM-DD HH:MM:SS
+	StartedAt      string `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at"`
+	EndedAt        string `protobuf:"bytes,9,opt,name=ended_at,json=endedAt,proto3" json:"ended_at"`
+	PatientProblem string `protobuf:"bytes,10,opt,name=patient_problem,json=patientProblem,proto3" json:"patient_problem"`
+	// attended, cancelled or no_show
+	Outcome string `protobuf:"bytes,11,opt,name=outcome,proto3" json:"outcome"`
+	// cash, card, insurance, online or empty when nothing was paid
+	PaymentType string `protobuf:"bytes,12,opt,name=payment_type,json=paymentType,proto3" json:"payment_type"`
+	// decimal string, e.g. "150000.00"
+	PaymentAmount        string   `protobuf:"bytes,13,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount"`
+	Currency             string   `protobuf:"bytes,14,opt,name=currency,proto3" json:"currency"`
+	InvoiceId            string   `protobuf:"bytes,15,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id"`
+	CreatedAt            string   `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt            string   `protobuf:"bytes,18,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -84,23 +96,58 @@ func (m *Archive) GetId() int64 {
 	return 0
 }
 
-func (m *Archive) GetDoctorAvailabilityId() int64 {
+func (m *Archive) GetAppointmentId() int64 {
 	if m != nil {
-		return m.DoctorAvailabilityId
+		return m.AppointmentId
 	}
 	return 0
 }
 
-func (m *Archive) GetStartTime() string {
+func (m *Archive) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *Archive) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *Archive) GetDepartmentId() string {
+	if m != nil {
+		return m.DepartmentId
+	}
+	return ""
+}
+
+func (m *Archive) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
+func (m *Archive) GetAppointmentDate() string {
+	if m != nil {
+		return m.AppointmentDate
+	}
+	return ""
+}
+
+func (m *Archive) GetStartedAt() string {
 	if m != nil {
-		return m.StartTime
+		return m.StartedAt
 	}
 	return ""
 }
 
-func (m *Archive) GetEndTime() string {
+func (m *Archive) GetEndedAt() string {
 	if m != nil {
-		return m.EndTime
+		return m.EndedAt
 	}
 	return ""
 }
@@ -112,9 +159,9 @@ func (m *Archive) GetPatientProblem() string {
 	return ""
 }
 
-func (m *Archive) GetStatus() string {
+func (m *Archive) GetOutcome() string {
 	if m != nil {
-		return m.Status
+		return m.Outcome
 	}
 	return ""
 }
@@ -126,11 +173,25 @@ func (m *Archive) GetPaymentType() string {
 	return ""
 }
 
-func (m *Archive) GetPaymentAmount() float32 {
+func (m *Archive) GetPaymentAmount() string {
 	if m != nil {
 		return m.PaymentAmount
 	}
-	return 0
+	return ""
+}
+
+func (m *Archive) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *Archive) GetInvoiceId() string {
+	if m != nil {
+		return m.InvoiceId
+	}
+	return ""
 }
 
 func (m *Archive) GetCreatedAt() string {
@@ -209,14 +270,17 @@ func (m *Archives) GetArchives() []*Archive {
 	return nil
 }
 
+// CreateArchiveReq closes out an appointment. Empty times default to the
+// booked time; with no payment_type the payment is taken from the
+// appointment's invoice.
 type CreateArchiveReq struct {
-	DoctorAvailabilityId int64    `protobuf:"varint,1,opt,name=doctor_availability_id,json=doctorAvailabilityId,proto3" json:"doctor_availability_id"`
-	StartTime            string   `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time"`
-	EndTime              string   `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time"`
+	AppointmentId        int64    `protobuf:"varint,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
+	StartedAt            string   `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at"`
+	EndedAt              string   `protobuf:"bytes,3,opt,name=ended_at,json=endedAt,proto3" json:"ended_at"`
 	PatientProblem       string   `protobuf:"bytes,4,opt,name=patient_problem,json=patientProblem,proto3" json:"patient_problem"`
-	Status               string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status"`
+	Outcome              string   `protobuf:"bytes,5,opt,name=outcome,proto3" json:"outcome"`
 	PaymentType          string   `protobuf:"bytes,6,opt,name=payment_type,json=paymentType,proto3" json:"payment_type"`
-	PaymentAmount        float32  `protobuf:"fixed32,7,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount"`
+	PaymentAmount        string   `protobuf:"bytes,7,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -255,23 +319,23 @@ func (m *CreateArchiveReq) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CreateArchiveReq proto.InternalMessageInfo
 
-func (m *CreateArchiveReq) GetDoctorAvailabilityId() int64 {
+func (m *CreateArchiveReq) GetAppointmentId() int64 {
 	if m != nil {
-		return m.DoctorAvailabilityId
+		return m.AppointmentId
 	}
 	return 0
 }
 
-func (m *CreateArchiveReq) GetStartTime() string {
+func (m *CreateArchiveReq) GetStartedAt() string {
 	if m != nil {
-		return m.StartTime
+		return m.StartedAt
 	}
 	return ""
 }
 
-func (m *CreateArchiveReq) GetEndTime() string {
+func (m *CreateArchiveReq) GetEndedAt() string {
 	if m != nil {
-		return m.EndTime
+		return m.EndedAt
 	}
 	return ""
 }
@@ -283,9 +347,9 @@ func (m *CreateArchiveReq) GetPatientProblem() string {
 	return ""
 }
 
-func (m *CreateArchiveReq) GetStatus() string {
+func (m *CreateArchiveReq) GetOutcome() string {
 	if m != nil {
-		return m.Status
+		return m.Outcome
 	}
 	return ""
 }
@@ -297,23 +361,22 @@ func (m *CreateArchiveReq) GetPaymentType() string {
 	return ""
 }
 
-func (m *CreateArchiveReq) GetPaymentAmount() float32 {
+func (m *CreateArchiveReq) GetPaymentAmount() string {
 	if m != nil {
 		return m.PaymentAmount
 	}
-	return 0
+	return ""
 }
 
 type UpdateArchiveReq struct {
 	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
-	DoctorAvailabilityId int64    `protobuf:"varint,3,opt,name=doctor_availability_id,json=doctorAvailabilityId,proto3" json:"doctor_availability_id"`
-	StartTime            string   `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time"`
-	EndTime              string   `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time"`
-	PatientProblem       string   `protobuf:"bytes,6,opt,name=patient_problem,json=patientProblem,proto3" json:"patient_problem"`
-	Status               string   `protobuf:"bytes,7,opt,name=status,proto3" json:"status"`
-	PaymentType          string   `protobuf:"bytes,8,opt,name=payment_type,json=paymentType,proto3" json:"payment_type"`
-	PaymentAmount        float32  `protobuf:"fixed32,9,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount"`
+	StartedAt            string   `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at"`
+	EndedAt              string   `protobuf:"bytes,4,opt,name=ended_at,json=endedAt,proto3" json:"ended_at"`
+	PatientProblem       string   `protobuf:"bytes,5,opt,name=patient_problem,json=patientProblem,proto3" json:"patient_problem"`
+	Outcome              string   `protobuf:"bytes,6,opt,name=outcome,proto3" json:"outcome"`
+	PaymentType          string   `protobuf:"bytes,7,opt,name=payment_type,json=paymentType,proto3" json:"payment_type"`
+	PaymentAmount        string   `protobuf:"bytes,8,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -366,23 +429,16 @@ func (m *UpdateArchiveReq) GetValue() string {
 	return ""
 }
 
-func (m *UpdateArchiveReq) GetDoctorAvailabilityId() int64 {
-	if m != nil {
-		return m.DoctorAvailabilityId
-	}
-	return 0
-}
-
-func (m *UpdateArchiveReq) GetStartTime() string {
+func (m *UpdateArchiveReq) GetStartedAt() string {
 	if m != nil {
-		return m.StartTime
+		return m.StartedAt
 	}
 	return ""
 }
 
-func (m *UpdateArchiveReq) GetEndTime() string {
+func (m *UpdateArchiveReq) GetEndedAt() string {
 	if m != nil {
-		return m.EndTime
+		return m.EndedAt
 	}
 	return ""
 }
@@ -394,9 +450,9 @@ func (m *UpdateArchiveReq) GetPatientProblem() string {
 	return ""
 }
 
-func (m *UpdateArchiveReq) GetStatus() string {
+func (m *UpdateArchiveReq) GetOutcome() string {
 	if m != nil {
-		return m.Status
+		return m.Outcome
 	}
 	return ""
 }
@@ -408,11 +464,11 @@ func (m *UpdateArchiveReq) GetPaymentType() string {
 	return ""
 }
 
-func (m *UpdateArchiveReq) GetPaymentAmount() float32 {
+func (m *UpdateArchiveReq) GetPaymentAmount() string {
 	if m != nil {
 		return m.PaymentAmount
 	}
-	return 0
+	return ""
 }
 
 type ArchiveFieldValueReq struct {
@@ -532,6 +588,9 @@ type GetAllArchivesReq struct {
 	Page                 uint64   `protobuf:"varint,4,opt,name=page,proto3" json:"page"`
 	Limit                uint64   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
 	OrderBy              string   `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
+	PatientId            string   `protobuf:"bytes,7,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	DoctorId             string   `protobuf:"bytes,8,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	AppointmentId        int64    `protobuf:"varint,9,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -612,6 +671,27 @@ func (m *GetAllArchivesReq) GetOrderBy() string {
 	return ""
 }
 
+func (m *GetAllArchivesReq) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *GetAllArchivesReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *GetAllArchivesReq) GetAppointmentId() int64 {
+	if m != nil {
+		return m.AppointmentId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Archive)(nil), "booking_service.Archive")
 	proto.RegisterType((*Archives)(nil), "booking_service.Archives")
@@ -625,47 +705,53 @@ func init() {
 func init() { proto.RegisterFile("booking_service/archive.proto", fileDescriptor_9b57b3fb89da7a89) }
 
 var fileDescriptor_9b57b3fb89da7a89 = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6e, 0xd3, 0x4e,
-	0x18, 0xfd, 0xf9, 0x4f, 0x62, 0xfb, 0xcb, 0x2f, 0x69, 0x18, 0xa2, 0xca, 0x2d, 0x6a, 0x94, 0x5a,
-	0x54, 0x64, 0x43, 0x90, 0x4a, 0x2f, 0xe0, 0x82, 0x40, 0x48, 0x20, 0x90, 0x29, 0x5d, 0x21, 0x59,
-	0x13, 0x7b, 0x28, 0x23, 0xec, 0xd8, 0xd8, 0x93, 0x48, 0x3e, 0x04, 0x7b, 0xb6, 0x6c, 0xb9, 0x01,
-	0x37, 0x60, 0xc9, 0x11, 0x50, 0x38, 0x01, 0x37, 0x40, 0x9e, 0x19, 0x43, 0xf3, 0xcf, 0x09, 0x12,
-	0xbb, 0x7c, 0xef, 0x7d, 0x99, 0xcc, 0x7b, 0x6f, 0x9e, 0x02, 0x47, 0xe3, 0x24, 0x79, 0x47, 0x27,
-	0x57, 0x7e, 0x4e, 0xb2, 0x19, 0x0d, 0xc8, 0x3d, 0x9c, 0x05, 0x6f, 0xe9, 0x8c, 0x8c, 0xd2, 0x2c,
-	0x61, 0x09, 0xda, 0x5b, 0xa2, 0x9d, 0x9f, 0x2a, 0x18, 0xae, 0x58, 0x41, 0x1d, 0x50, 0x69, 0x68,
-	0x2b, 0x03, 0x65, 0xa8, 0x79, 0x2a, 0x0d, 0xd1, 0x19, 0xec, 0x87, 0x49, 0xc0, 0x92, 0xcc, 0xc7,
-	0x33, 0x4c, 0x23, 0x3c, 0xa6, 0x11, 0x65, 0x85, 0x4f, 0x43, 0x5b, 0xe5, 0x3b, 0x3d, 0xc1, 0xba,
-	0xd7, 0xc8, 0x27, 0x21, 0x3a, 0x02, 0xc8, 0x19, 0xce, 0x98, 0xcf, 0x68, 0x4c, 0x6c, 0x6d, 0xa0,
-	0x0c, 0x2d, 0xcf, 0xe2, 0xc8, 0x05, 0x8d, 0x09, 0x3a, 0x00, 0x93, 0x4c, 0x42, 0x41, 0xea, 0x9c,
-	0x34, 0xc8, 0x24, 0xe4, 0xd4, 0x1d, 0xd8, 0x4b, 0x31, 0xa3, 0x64, 0xc2, 0xfc, 0x34, 0x4b, 0xc6,
-	0x11, 0x89, 0xed, 0x06, 0xdf, 0xe8, 0x48, 0xf8, 0x85, 0x40, 0xd1, 0x3e, 0x34, 0x73, 0x86, 0xd9,
-	0x34, 0xb7, 0x9b, 0x9c, 0x97, 0x13, 0x3a, 0x86, 0xff, 0x53, 0x5c, 0xc4, 0xe5, 0x01, 0xac, 0x48,
-	0x89, 0x6d, 0x70, 0xb6, 0x25, 0xb1, 0x8b, 0x22, 0x25, 0xe8, 0x04, 0x3a, 0xd5, 0x0a, 0x8e, 0x93,
-	0xe9, 0x84, 0xd9, 0xe6, 0x40, 0x19, 0xaa, 0x5e, 0x5b, 0xa2, 0x2e, 0x07, 0x4b, 0x11, 0x41, 0x46,
-	0x30, 0x23, 0xa1, 0x8f, 0x99, 0x6d, 0x09, 0x11, 0x12, 0x71, 0x39, 0x3d, 0x4d, 0xc3, 0x8a, 0x06,
-	0x41, 0x4b, 0x44, 0xd0, 0x21, 0x89, 0x88, 0xa4, 0x5b, 0x82, 0x96, 0x88, 0xcb, 0x9c, 0x4b, 0x30,
-	0xa5, 0xe5, 0x39, 0xea, 0x41, 0x23, 0xe0, 0xd7, 0x10, 0xb6, 0x8b, 0x01, 0x9d, 0x81, 0x29, 0x73,
-	0xcb, 0x6d, 0x75, 0xa0, 0x0d, 0x5b, 0xa7, 0xf6, 0x68, 0x29, 0xb9, 0x91, 0x3c, 0xc2, 0xfb, 0xbd,
-	0xe9, 0x7c, 0x50, 0xa1, 0xfb, 0x80, 0xdf, 0xb1, 0xe2, 0xc8, 0xfb, 0x9a, 0x10, 0x95, 0x9d, 0x43,
-	0x54, 0xeb, 0x42, 0xd4, 0xb6, 0x86, 0xa8, 0x6f, 0x09, 0xb1, 0x51, 0x1b, 0x62, 0x73, 0x97, 0x10,
-	0x8d, 0x35, 0x21, 0x3a, 0x5f, 0x54, 0xe8, 0xbe, 0xe2, 0xa1, 0x5c, 0xf3, 0xa3, 0x07, 0x8d, 0x37,
-	0x94, 0x44, 0x42, 0xbe, 0xe5, 0x89, 0xa1, 0x44, 0x67, 0x38, 0x9a, 0x56, 0x52, 0xc5, 0x50, 0xe3,
-	0x9d, 0xb6, 0xb3, 0x77, 0x7a, 0x9d, 0x77, 0x8d, 0xad, 0xde, 0x35, 0xb7, 0x78, 0x67, 0xd4, 0x7a,
-	0x67, 0xee, 0xe2, 0x9d, 0xb5, 0xce, 0x3b, 0x1f, 0x7a, 0xd2, 0xb4, 0x47, 0xa5, 0x41, 0x97, 0xa5,
-	0x1f, 0x7f, 0x6b, 0xdf, 0x2d, 0xb0, 0x68, 0xee, 0xe3, 0x80, 0xd1, 0x99, 0x78, 0x26, 0xa6, 0x67,
-	0xd2, 0xdc, 0xe5, 0xb3, 0x73, 0x17, 0x6e, 0x3e, 0xe4, 0x8d, 0x90, 0x3f, 0xf3, 0x52, 0x28, 0xf8,
-	0xa3, 0x4c, 0xe1, 0x5f, 0x90, 0x93, 0xf3, 0x49, 0x81, 0x1b, 0x8f, 0x09, 0x73, 0xa3, 0xa8, 0xaa,
-	0xce, 0xbf, 0xbc, 0x0d, 0x42, 0xa0, 0xa7, 0xf8, 0x4a, 0xa4, 0xa5, 0x7b, 0xfc, 0x73, 0x79, 0x4c,
-	0x44, 0x63, 0xca, 0x78, 0x4a, 0xba, 0x27, 0x86, 0x32, 0xbe, 0x24, 0x0b, 0x49, 0xe6, 0x8f, 0x0b,
-	0x19, 0x8e, 0xc1, 0xe7, 0xf3, 0xe2, 0xf4, 0xb3, 0x06, 0x9d, 0x4a, 0x8d, 0x28, 0x29, 0x7a, 0x0a,
-	0xed, 0x85, 0x46, 0xa2, 0xe3, 0x95, 0x1e, 0x2f, 0x37, 0xf6, 0x70, 0x63, 0xd5, 0xd1, 0x33, 0x80,
-	0xd2, 0x03, 0x39, 0x9d, 0x6c, 0xda, 0x5b, 0x48, 0xac, 0xe6, 0xb8, 0xe7, 0xd0, 0x59, 0xb4, 0x14,
-	0x39, 0x2b, 0xbb, 0x2b, 0x9e, 0x1f, 0x1e, 0x6c, 0x3a, 0x2f, 0x2f, 0xd5, 0x2e, 0xf4, 0x6d, 0x8d,
-	0xda, 0xe5, 0x3e, 0xd6, 0x5c, 0xef, 0x35, 0xb4, 0x17, 0x5e, 0xc8, 0xae, 0x82, 0x6f, 0xaf, 0xac,
-	0xad, 0x79, 0x68, 0xe7, 0xdd, 0xaf, 0xf3, 0xbe, 0xf2, 0x6d, 0xde, 0x57, 0xbe, 0xcf, 0xfb, 0xca,
-	0xc7, 0x1f, 0xfd, 0xff, 0xc6, 0x4d, 0xfe, 0x17, 0x79, 0xff, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xaa, 0xf6, 0x01, 0xff, 0x43, 0x07, 0x00, 0x00,
+	// 732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0xd3, 0x4a,
+	0x10, 0x3e, 0x71, 0x9c, 0xd8, 0x9e, 0x36, 0x3f, 0xdd, 0x53, 0x1d, 0xb9, 0xad, 0x1a, 0xb5, 0x3e,
+	0xa7, 0x3a, 0xe5, 0x82, 0x22, 0x15, 0x5e, 0xc0, 0xa5, 0x02, 0x45, 0x02, 0x81, 0x0c, 0xf4, 0x0a,
+	0x29, 0xda, 0x78, 0x97, 0x76, 0x85, 0x63, 0x1b, 0x7b, 0x13, 0x29, 0x77, 0x5c, 0xc0, 0x3b, 0xf0,
+	0x0c, 0x3c, 0x49, 0x2f, 0x79, 0x04, 0x54, 0x5e, 0x04, 0xed, 0x8f, 0x4b, 0xe2, 0x24, 0x4e, 0x91,
+	0xb8, 0xcb, 0x7c, 0xdf, 0x68, 0x3c, 0xf3, 0x7d, 0x33, 0xab, 0xc0, 0xfe, 0x30, 0x49, 0xde, 0xb3,
+	0xf8, 0x72, 0x90, 0xd3, 0x6c, 0xc2, 0x42, 0xfa, 0x00, 0x67, 0xe1, 0x15, 0x9b, 0xd0, 0x93, 0x34,
+	0x4b, 0x78, 0x82, 0x3a, 0x25, 0xda, 0xbb, 0x36, 0xc1, 0xf2, 0x55, 0x0a, 0x6a, 0x83, 0xc1, 0x88,
+	0x5b, 0x3b, 0xa8, 0x1d, 0xd7, 0x03, 0x83, 0x11, 0x74, 0x04, 0x6d, 0x9c, 0xa6, 0x09, 0x8b, 0xf9,
+	0x88, 0xc6, 0x7c, 0xc0, 0x88, 0x6b, 0x48, 0xae, 0x35, 0x83, 0xf6, 0x09, 0xda, 0x07, 0x48, 0x31,
+	0x67, 0x3a, 0xa5, 0x7e, 0x50, 0x3b, 0x76, 0x02, 0x47, 0x23, 0x7d, 0x82, 0xf6, 0xc0, 0x21, 0x49,
+	0xc8, 0x93, 0x4c, 0xb0, 0xa6, 0x64, 0x6d, 0x05, 0xf4, 0x09, 0xfa, 0x17, 0x5a, 0x84, 0xa6, 0x38,
+	0xbb, 0xfd, 0x42, 0x43, 0x26, 0x6c, 0xfe, 0x02, 0x55, 0x85, 0x61, 0x86, 0xe3, 0xf0, 0x4a, 0x24,
+	0x34, 0x55, 0x05, 0x05, 0xf4, 0x09, 0xba, 0x07, 0xdd, 0xd9, 0x26, 0x09, 0xe6, 0xd4, 0xb5, 0x64,
+	0x4e, 0x67, 0x06, 0x3f, 0xc7, 0x9c, 0x8a, 0x46, 0x73, 0x8e, 0x33, 0x4e, 0xc9, 0x00, 0x73, 0xd7,
+	0x56, 0x8d, 0x6a, 0xc4, 0xe7, 0x68, 0x07, 0x6c, 0x1a, 0x13, 0x45, 0x3a, 0x92, 0xb4, 0x64, 0xec,
+	0x73, 0xf4, 0x3f, 0x74, 0x8a, 0x11, 0xd3, 0x2c, 0x19, 0x46, 0x74, 0xe4, 0x82, 0xcc, 0x68, 0x6b,
+	0xf8, 0xa5, 0x42, 0x91, 0x0b, 0x56, 0x32, 0xe6, 0x61, 0x32, 0xa2, 0xee, 0x86, 0x2a, 0xa1, 0x43,
+	0x74, 0x08, 0x9b, 0x29, 0x9e, 0xca, 0x1e, 0xf9, 0x34, 0xa5, 0xee, 0xa6, 0xa4, 0x37, 0x34, 0xf6,
+	0x7a, 0x9a, 0x52, 0xa1, 0x77, 0x91, 0x82, 0x47, 0xc9, 0x38, 0xe6, 0x6e, 0x4b, 0x26, 0xb5, 0x34,
+	0xea, 0x4b, 0x10, 0xed, 0x82, 0x1d, 0x8e, 0xb3, 0x8c, 0xc6, 0xe1, 0xd4, 0x6d, 0x2b, 0x35, 0x8a,
+	0x58, 0x8c, 0xc8, 0xe2, 0x49, 0xc2, 0x42, 0x2a, 0xb4, 0xea, 0xa8, 0x11, 0x35, 0xa2, 0xac, 0x0a,
+	0x33, 0x8a, 0xb5, 0x02, 0x5d, 0x45, 0x6b, 0xc4, 0xe7, 0x82, 0x1e, 0xa7, 0xa4, 0xa0, 0xb7, 0x14,
+	0xad, 0x11, 0x45, 0x13, 0x1a, 0x51, 0x4d, 0x23, 0x45, 0x6b, 0xc4, 0xe7, 0xde, 0x05, 0xd8, 0x7a,
+	0x93, 0x72, 0xb4, 0x0d, 0x8d, 0x50, 0x4e, 0xa0, 0xb6, 0x49, 0x05, 0xe8, 0x11, 0xd8, 0x7a, 0x1d,
+	0x73, 0xd7, 0x38, 0xa8, 0x1f, 0x6f, 0x9c, 0xba, 0x27, 0xa5, 0x85, 0x3c, 0xd1, 0x25, 0x82, 0xdb,
+	0x4c, 0xef, 0xa3, 0x01, 0xdd, 0xc7, 0xb2, 0xc7, 0x82, 0xa3, 0x1f, 0x96, 0xec, 0x66, 0x6d, 0xc5,
+	0x6e, 0xce, 0x58, 0x6e, 0x54, 0x59, 0x5e, 0x5f, 0x6b, 0xb9, 0xb9, 0xce, 0xf2, 0x46, 0xb5, 0xe5,
+	0xcd, 0xbb, 0x58, 0x6e, 0x2d, 0xb1, 0xdc, 0xfb, 0x6c, 0x40, 0xf7, 0x4d, 0x4a, 0xe6, 0x25, 0xd8,
+	0x86, 0xc6, 0x3b, 0x46, 0x23, 0x35, 0xb9, 0x13, 0xa8, 0x40, 0xa0, 0x13, 0x1c, 0x8d, 0xa9, 0x1e,
+	0x56, 0x05, 0x25, 0x1d, 0xea, 0x55, 0x3a, 0x98, 0x6b, 0x75, 0x68, 0xac, 0xd3, 0xa1, 0x59, 0xad,
+	0x83, 0x75, 0x17, 0x1d, 0xec, 0x65, 0x3a, 0x0c, 0x60, 0x5b, 0x0b, 0xf0, 0x44, 0x0c, 0x7b, 0x21,
+	0x66, 0xfb, 0x5d, 0x29, 0xf6, 0xc0, 0x61, 0xf9, 0x00, 0x87, 0x9c, 0x4d, 0xa8, 0x54, 0xc2, 0x0e,
+	0x6c, 0x96, 0xfb, 0x32, 0xf6, 0xee, 0xc3, 0xdf, 0xe7, 0x72, 0xa1, 0xf5, 0x67, 0x5e, 0x71, 0xcc,
+	0xc7, 0x39, 0xfa, 0x07, 0x9a, 0xb9, 0xfc, 0x25, 0x3f, 0x60, 0x07, 0x3a, 0xf2, 0x3e, 0x19, 0xb0,
+	0xf5, 0x94, 0x72, 0x3f, 0x8a, 0x8a, 0xcd, 0xff, 0x93, 0xdd, 0x20, 0x04, 0x66, 0x8a, 0x2f, 0xa9,
+	0xb4, 0xc4, 0x0c, 0xe4, 0x6f, 0x51, 0x26, 0x62, 0x23, 0xc6, 0xa5, 0x0b, 0x66, 0xa0, 0x02, 0x61,
+	0x60, 0x92, 0x11, 0x9a, 0x0d, 0x86, 0xd3, 0x5b, 0xf5, 0x45, 0x7c, 0x36, 0x2d, 0x3d, 0xcf, 0x56,
+	0xe5, 0xf3, 0x6c, 0x97, 0x9e, 0xe7, 0xc5, 0x2b, 0x73, 0x96, 0x5c, 0xd9, 0xe9, 0xd7, 0x3a, 0xb4,
+	0x0b, 0xc1, 0xd4, 0x19, 0xa3, 0x67, 0xd0, 0x9a, 0xbb, 0x59, 0x74, 0xb8, 0x70, 0xe9, 0xe5, 0x9b,
+	0xde, 0x5d, 0xf9, 0x18, 0xa0, 0xe7, 0x00, 0x42, 0x66, 0x1d, 0x1d, 0xad, 0xca, 0x9b, 0x5b, 0x8a,
+	0x8a, 0x72, 0x2f, 0xa0, 0x3d, 0xef, 0x1a, 0xf2, 0x16, 0x72, 0x17, 0x6c, 0xdd, 0xdd, 0x59, 0x55,
+	0x2f, 0x17, 0xd3, 0xce, 0x9d, 0xe7, 0x92, 0x69, 0xcb, 0xe7, 0x5b, 0xd1, 0xde, 0x5b, 0x68, 0xcd,
+	0x2d, 0xe1, 0x5d, 0x07, 0xfe, 0x6f, 0x21, 0x6d, 0xc9, 0x2e, 0x9f, 0x75, 0xaf, 0x6f, 0x7a, 0xb5,
+	0x6f, 0x37, 0xbd, 0xda, 0xf7, 0x9b, 0x5e, 0xed, 0xcb, 0x8f, 0xde, 0x5f, 0xc3, 0xa6, 0xfc, 0x6f,
+	0xf0, 0xf0, 0xe7, 0x00, 0x6b, 0x6e, 0xd8, 0x26, 0x3c, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -923,65 +1009,121 @@ func (m *Archive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.DeletedAt)
 		i = encodeVarintArchive(dAtA, i, uint64(len(m.DeletedAt)))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
 	if len(m.UpdatedAt) > 0 {
 		i -= len(m.UpdatedAt)
 		copy(dAtA[i:], m.UpdatedAt)
 		i = encodeVarintArchive(dAtA, i, uint64(len(m.UpdatedAt)))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
 	if len(m.CreatedAt) > 0 {
 		i -= len(m.CreatedAt)
 		copy(dAtA[i:], m.CreatedAt)
 		i = encodeVarintArchive(dAtA, i, uint64(len(m.CreatedAt)))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.InvoiceId) > 0 {
+		i -= len(m.InvoiceId)
+		copy(dAtA[i:], m.InvoiceId)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.InvoiceId)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.PaymentAmount != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.PaymentAmount))))
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.Currency)))
 		i--
-		dAtA[i] = 0x45
+		dAtA[i] = 0x72
+	}
+	if len(m.PaymentAmount) > 0 {
+		i -= len(m.PaymentAmount)
+		copy(dAtA[i:], m.PaymentAmount)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.PaymentAmount)))
+		i--
+		dAtA[i] = 0x6a
 	}
 	if len(m.PaymentType) > 0 {
 		i -= len(m.PaymentType)
 		copy(dAtA[i:], m.PaymentType)
 		i = encodeVarintArchive(dAtA, i, uint64(len(m.PaymentType)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x62
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintArchive(dAtA, i, uint64(len(m.Status)))
+	if len(m.Outcome) > 0 {
+		i -= len(m.Outcome)
+		copy(dAtA[i:], m.Outcome)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.Outcome)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x5a
 	}
 	if len(m.PatientProblem) > 0 {
 		i -= len(m.PatientProblem)
 		copy(dAtA[i:], m.PatientProblem)
 		i = encodeVarintArchive(dAtA, i, uint64(len(m.PatientProblem)))
 		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.EndedAt) > 0 {
+		i -= len(m.EndedAt)
+		copy(dAtA[i:], m.EndedAt)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.EndedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.StartedAt) > 0 {
+		i -= len(m.StartedAt)
+		copy(dAtA[i:], m.StartedAt)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.StartedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AppointmentDate) > 0 {
+		i -= len(m.AppointmentDate)
+		copy(dAtA[i:], m.AppointmentDate)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.AppointmentDate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DepartmentId) > 0 {
+		i -= len(m.DepartmentId)
+		copy(dAtA[i:], m.DepartmentId)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.DepartmentId)))
+		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.EndTime) > 0 {
-		i -= len(m.EndTime)
-		copy(dAtA[i:], m.EndTime)
-		i = encodeVarintArchive(dAtA, i, uint64(len(m.EndTime)))
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.DoctorId)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.StartTime) > 0 {
-		i -= len(m.StartTime)
-		copy(dAtA[i:], m.StartTime)
-		i = encodeVarintArchive(dAtA, i, uint64(len(m.StartTime)))
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.PatientId)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.DoctorAvailabilityId != 0 {
-		i = encodeVarintArchive(dAtA, i, uint64(m.DoctorAvailabilityId))
+	if m.AppointmentId != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.AppointmentId))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -1063,11 +1205,12 @@ func (m *CreateArchiveReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PaymentAmount != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.PaymentAmount))))
+	if len(m.PaymentAmount) > 0 {
+		i -= len(m.PaymentAmount)
+		copy(dAtA[i:], m.PaymentAmount)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.PaymentAmount)))
 		i--
-		dAtA[i] = 0x3d
+		dAtA[i] = 0x3a
 	}
 	if len(m.PaymentType) > 0 {
 		i -= len(m.PaymentType)
@@ -1076,10 +1219,10 @@ func (m *CreateArchiveReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x32
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintArchive(dAtA, i, uint64(len(m.Status)))
+	if len(m.Outcome) > 0 {
+		i -= len(m.Outcome)
+		copy(dAtA[i:], m.Outcome)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.Outcome)))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1090,22 +1233,22 @@ func (m *CreateArchiveReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.EndTime) > 0 {
-		i -= len(m.EndTime)
-		copy(dAtA[i:], m.EndTime)
-		i = encodeVarintArchive(dAtA, i, uint64(len(m.EndTime)))
+	if len(m.EndedAt) > 0 {
+		i -= len(m.EndedAt)
+		copy(dAtA[i:], m.EndedAt)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.EndedAt)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.StartTime) > 0 {
-		i -= len(m.StartTime)
-		copy(dAtA[i:], m.StartTime)
-		i = encodeVarintArchive(dAtA, i, uint64(len(m.StartTime)))
+	if len(m.StartedAt) > 0 {
+		i -= len(m.StartedAt)
+		copy(dAtA[i:], m.StartedAt)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.StartedAt)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.DoctorAvailabilityId != 0 {
-		i = encodeVarintArchive(dAtA, i, uint64(m.DoctorAvailabilityId))
+	if m.AppointmentId != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.AppointmentId))
 		i--
 		dAtA[i] = 0x8
 	}
@@ -1136,51 +1279,47 @@ func (m *UpdateArchiveReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PaymentAmount != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.PaymentAmount))))
+	if len(m.PaymentAmount) > 0 {
+		i -= len(m.PaymentAmount)
+		copy(dAtA[i:], m.PaymentAmount)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.PaymentAmount)))
 		i--
-		dAtA[i] = 0x4d
+		dAtA[i] = 0x42
 	}
 	if len(m.PaymentType) > 0 {
 		i -= len(m.PaymentType)
 		copy(dAtA[i:], m.PaymentType)
 		i = encodeVarintArchive(dAtA, i, uint64(len(m.PaymentType)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x3a
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintArchive(dAtA, i, uint64(len(m.Status)))
+	if len(m.Outcome) > 0 {
+		i -= len(m.Outcome)
+		copy(dAtA[i:], m.Outcome)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.Outcome)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x32
 	}
 	if len(m.PatientProblem) > 0 {
 		i -= len(m.PatientProblem)
 		copy(dAtA[i:], m.PatientProblem)
 		i = encodeVarintArchive(dAtA, i, uint64(len(m.PatientProblem)))
 		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.EndTime) > 0 {
-		i -= len(m.EndTime)
-		copy(dAtA[i:], m.EndTime)
-		i = encodeVarintArchive(dAtA, i, uint64(len(m.EndTime)))
-		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.StartTime) > 0 {
-		i -= len(m.StartTime)
-		copy(dAtA[i:], m.StartTime)
-		i = encodeVarintArchive(dAtA, i, uint64(len(m.StartTime)))
+	if len(m.EndedAt) > 0 {
+		i -= len(m.EndedAt)
+		copy(dAtA[i:], m.EndedAt)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.EndedAt)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.DoctorAvailabilityId != 0 {
-		i = encodeVarintArchive(dAtA, i, uint64(m.DoctorAvailabilityId))
+	if len(m.StartedAt) > 0 {
+		i -= len(m.StartedAt)
+		copy(dAtA[i:], m.StartedAt)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.StartedAt)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
@@ -1311,6 +1450,25 @@ func (m *GetAllArchivesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AppointmentId != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.AppointmentId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.OrderBy) > 0 {
 		i -= len(m.OrderBy)
 		copy(dAtA[i:], m.OrderBy)
@@ -1375,14 +1533,34 @@ func (m *Archive) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovArchive(uint64(m.Id))
 	}
-	if m.DoctorAvailabilityId != 0 {
-		n += 1 + sovArchive(uint64(m.DoctorAvailabilityId))
+	if m.AppointmentId != 0 {
+		n += 1 + sovArchive(uint64(m.AppointmentId))
+	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	l = len(m.DepartmentId)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	l = len(m.AppointmentDate)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
 	}
-	l = len(m.StartTime)
+	l = len(m.StartedAt)
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
-	l = len(m.EndTime)
+	l = len(m.EndedAt)
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
@@ -1390,7 +1568,7 @@ func (m *Archive) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
-	l = len(m.Status)
+	l = len(m.Outcome)
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
@@ -1398,20 +1576,29 @@ func (m *Archive) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
-	if m.PaymentAmount != 0 {
-		n += 5
+	l = len(m.PaymentAmount)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
 	}
-	l = len(m.CreatedAt)
+	l = len(m.Currency)
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
-	l = len(m.UpdatedAt)
+	l = len(m.InvoiceId)
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 2 + l + sovArchive(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 2 + l + sovArchive(uint64(l))
+	}
 	l = len(m.DeletedAt)
 	if l > 0 {
-		n += 1 + l + sovArchive(uint64(l))
+		n += 2 + l + sovArchive(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1446,14 +1633,14 @@ func (m *CreateArchiveReq) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.DoctorAvailabilityId != 0 {
-		n += 1 + sovArchive(uint64(m.DoctorAvailabilityId))
+	if m.AppointmentId != 0 {
+		n += 1 + sovArchive(uint64(m.AppointmentId))
 	}
-	l = len(m.StartTime)
+	l = len(m.StartedAt)
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
-	l = len(m.EndTime)
+	l = len(m.EndedAt)
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
@@ -1461,7 +1648,7 @@ func (m *CreateArchiveReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
-	l = len(m.Status)
+	l = len(m.Outcome)
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
@@ -1469,8 +1656,9 @@ func (m *CreateArchiveReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
-	if m.PaymentAmount != 0 {
-		n += 5
+	l = len(m.PaymentAmount)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1492,14 +1680,11 @@ func (m *UpdateArchiveReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
-	if m.DoctorAvailabilityId != 0 {
-		n += 1 + sovArchive(uint64(m.DoctorAvailabilityId))
-	}
-	l = len(m.StartTime)
+	l = len(m.StartedAt)
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
-	l = len(m.EndTime)
+	l = len(m.EndedAt)
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
@@ -1507,7 +1692,7 @@ func (m *UpdateArchiveReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
-	l = len(m.Status)
+	l = len(m.Outcome)
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
@@ -1515,8 +1700,9 @@ func (m *UpdateArchiveReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
-	if m.PaymentAmount != 0 {
-		n += 5
+	l = len(m.PaymentAmount)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1589,6 +1775,17 @@ func (m *GetAllArchivesReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovArchive(uint64(l))
 	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.AppointmentId != 0 {
+		n += 1 + sovArchive(uint64(m.AppointmentId))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1651,9 +1848,9 @@ func (m *Archive) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorAvailabilityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
 			}
-			m.DoctorAvailabilityId = 0
+			m.AppointmentId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowArchive
@@ -1663,14 +1860,14 @@ func (m *Archive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DoctorAvailabilityId |= int64(b&0x7F) << shift
+				m.AppointmentId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1698,11 +1895,11 @@ func (m *Archive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartTime = string(dAtA[iNdEx:postIndex])
+			m.PatientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1730,11 +1927,11 @@ func (m *Archive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndTime = string(dAtA[iNdEx:postIndex])
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatientProblem", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1762,11 +1959,11 @@ func (m *Archive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PatientProblem = string(dAtA[iNdEx:postIndex])
+			m.DepartmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1794,11 +1991,11 @@ func (m *Archive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.BranchId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1826,22 +2023,11 @@ func (m *Archive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentType = string(dAtA[iNdEx:postIndex])
+			m.AppointmentDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAmount", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.PaymentAmount = float32(math.Float32frombits(v))
-		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1869,11 +2055,11 @@ func (m *Archive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.StartedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1901,11 +2087,11 @@ func (m *Archive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.EndedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientProblem", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1933,64 +2119,13 @@ func (m *Archive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			m.PatientProblem = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipArchive(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthArchive
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Archives) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowArchive
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Archives: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Archives: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowArchive
@@ -2000,16 +2135,29 @@ func (m *Archives) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outcome = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Archives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowArchive
@@ -2019,12 +2167,306 @@ func (m *Archives) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvoiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvoiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Archives) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Archives: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Archives: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthArchive
 			}
 			postIndex := iNdEx + msglen
@@ -2092,9 +2534,9 @@ func (m *CreateArchiveReq) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorAvailabilityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
 			}
-			m.DoctorAvailabilityId = 0
+			m.AppointmentId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowArchive
@@ -2104,14 +2546,14 @@ func (m *CreateArchiveReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DoctorAvailabilityId |= int64(b&0x7F) << shift
+				m.AppointmentId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2139,11 +2581,11 @@ func (m *CreateArchiveReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartTime = string(dAtA[iNdEx:postIndex])
+			m.StartedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2171,7 +2613,7 @@ func (m *CreateArchiveReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndTime = string(dAtA[iNdEx:postIndex])
+			m.EndedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -2207,7 +2649,7 @@ func (m *CreateArchiveReq) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2235,7 +2677,7 @@ func (m *CreateArchiveReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Outcome = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
@@ -2270,16 +2712,37 @@ func (m *CreateArchiveReq) Unmarshal(dAtA []byte) error {
 			m.PaymentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 5 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAmount", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.PaymentAmount = float32(math.Float32frombits(v))
+			m.PaymentAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipArchive(dAtA[iNdEx:])
@@ -2396,10 +2859,10 @@ func (m *UpdateArchiveReq) Unmarshal(dAtA []byte) error {
 			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorAvailabilityId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			m.DoctorAvailabilityId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowArchive
@@ -2409,14 +2872,27 @@ func (m *UpdateArchiveReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DoctorAvailabilityId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2444,11 +2920,11 @@ func (m *UpdateArchiveReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartTime = string(dAtA[iNdEx:postIndex])
+			m.EndedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientProblem", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2476,11 +2952,11 @@ func (m *UpdateArchiveReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndTime = string(dAtA[iNdEx:postIndex])
+			m.PatientProblem = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatientProblem", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2508,11 +2984,11 @@ func (m *UpdateArchiveReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PatientProblem = string(dAtA[iNdEx:postIndex])
+			m.Outcome = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2540,11 +3016,11 @@ func (m *UpdateArchiveReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.PaymentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2572,19 +3048,8 @@ func (m *UpdateArchiveReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentType = string(dAtA[iNdEx:postIndex])
+			m.PaymentAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAmount", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.PaymentAmount = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipArchive(dAtA[iNdEx:])
@@ -2996,6 +3461,89 @@ func (m *GetAllArchivesReq) Unmarshal(dAtA []byte) error {
 			}
 			m.OrderBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
+			}
+			m.AppointmentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppointmentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipArchive(dAtA[iNdEx:])
@@ -0,0 +1,8698 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: booking_service/insurance.proto
+
+package booking_service
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Insurer struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Code                 string   `protobuf:"bytes,3,opt,name=code,proto3" json:"code"`
+	Email                string   `protobuf:"bytes,4,opt,name=email,proto3" json:"email"`
+	PhoneNumber          string   `protobuf:"bytes,5,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
+	CreatedAt            string   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Insurer) Reset()         { *m = Insurer{} }
+func (m *Insurer) String() string { return proto.CompactTextString(m) }
+func (*Insurer) ProtoMessage()    {}
+func (*Insurer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{0}
+}
+func (m *Insurer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Insurer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Insurer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Insurer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Insurer.Merge(m, src)
+}
+func (m *Insurer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Insurer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Insurer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Insurer proto.InternalMessageInfo
+
+func (m *Insurer) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Insurer) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Insurer) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Insurer) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Insurer) GetPhoneNumber() string {
+	if m != nil {
+		return m.PhoneNumber
+	}
+	return ""
+}
+
+func (m *Insurer) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *Insurer) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type InsurersType struct {
+	Count                int64      `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Insurers             []*Insurer `protobuf:"bytes,2,rep,name=insurers,proto3" json:"insurers"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *InsurersType) Reset()         { *m = InsurersType{} }
+func (m *InsurersType) String() string { return proto.CompactTextString(m) }
+func (*InsurersType) ProtoMessage()    {}
+func (*InsurersType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{1}
+}
+func (m *InsurersType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsurersType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsurersType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsurersType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsurersType.Merge(m, src)
+}
+func (m *InsurersType) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsurersType) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsurersType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsurersType proto.InternalMessageInfo
+
+func (m *InsurersType) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *InsurersType) GetInsurers() []*Insurer {
+	if m != nil {
+		return m.Insurers
+	}
+	return nil
+}
+
+type InsurancePolicy struct {
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	InsurerId    string `protobuf:"bytes,2,opt,name=insurer_id,json=insurerId,proto3" json:"insurer_id"`
+	PatientId    string `protobuf:"bytes,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	PolicyNumber string `protobuf:"bytes,4,opt,name=policy_number,json=policyNumber,proto3" json:"policy_number"`
+	ValidFrom    string `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from"`
+	ValidTo      string `protobuf:"bytes,6,opt,name=valid_to,json=validTo,proto3" json:"valid_to"`
+	// what the insurer pays for the patient in a calendar year, "0.00" for no cap
+	AnnualLimit string `protobuf:"bytes,7,opt,name=annual_limit,json=annualLimit,proto3" json:"annual_limit"`
+	Currency    string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency"`
+	// active, cancelled
+	Status               string   `protobuf:"bytes,9,opt,name=status,proto3" json:"status"`
+	CreatedAt            string   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InsurancePolicy) Reset()         { *m = InsurancePolicy{} }
+func (m *InsurancePolicy) String() string { return proto.CompactTextString(m) }
+func (*InsurancePolicy) ProtoMessage()    {}
+func (*InsurancePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{2}
+}
+func (m *InsurancePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsurancePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsurancePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsurancePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsurancePolicy.Merge(m, src)
+}
+func (m *InsurancePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsurancePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsurancePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsurancePolicy proto.InternalMessageInfo
+
+func (m *InsurancePolicy) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *InsurancePolicy) GetInsurerId() string {
+	if m != nil {
+		return m.InsurerId
+	}
+	return ""
+}
+
+func (m *InsurancePolicy) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *InsurancePolicy) GetPolicyNumber() string {
+	if m != nil {
+		return m.PolicyNumber
+	}
+	return ""
+}
+
+func (m *InsurancePolicy) GetValidFrom() string {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return ""
+}
+
+func (m *InsurancePolicy) GetValidTo() string {
+	if m != nil {
+		return m.ValidTo
+	}
+	return ""
+}
+
+func (m *InsurancePolicy) GetAnnualLimit() string {
+	if m != nil {
+		return m.AnnualLimit
+	}
+	return ""
+}
+
+func (m *InsurancePolicy) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *InsurancePolicy) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *InsurancePolicy) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *InsurancePolicy) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type InsurancePoliciesType struct {
+	Count                int64              `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Policies             []*InsurancePolicy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *InsurancePoliciesType) Reset()         { *m = InsurancePoliciesType{} }
+func (m *InsurancePoliciesType) String() string { return proto.CompactTextString(m) }
+func (*InsurancePoliciesType) ProtoMessage()    {}
+func (*InsurancePoliciesType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{3}
+}
+func (m *InsurancePoliciesType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsurancePoliciesType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsurancePoliciesType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsurancePoliciesType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsurancePoliciesType.Merge(m, src)
+}
+func (m *InsurancePoliciesType) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsurancePoliciesType) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsurancePoliciesType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsurancePoliciesType proto.InternalMessageInfo
+
+func (m *InsurancePoliciesType) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *InsurancePoliciesType) GetPolicies() []*InsurancePolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+type CoverageRule struct {
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	InsurerId       string `protobuf:"bytes,2,opt,name=insurer_id,json=insurerId,proto3" json:"insurer_id"`
+	DoctorServiceId string `protobuf:"bytes,3,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	// percentage, copay
+	Kind       string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind"`
+	Percentage int64  `protobuf:"varint,5,opt,name=percentage,proto3" json:"percentage"`
+	Copay      string `protobuf:"bytes,6,opt,name=copay,proto3" json:"copay"`
+	// what the insurer pays for the service per patient in a calendar year, "0.00" for no cap
+	AnnualLimit          string   `protobuf:"bytes,7,opt,name=annual_limit,json=annualLimit,proto3" json:"annual_limit"`
+	CreatedAt            string   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CoverageRule) Reset()         { *m = CoverageRule{} }
+func (m *CoverageRule) String() string { return proto.CompactTextString(m) }
+func (*CoverageRule) ProtoMessage()    {}
+func (*CoverageRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{4}
+}
+func (m *CoverageRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoverageRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoverageRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CoverageRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoverageRule.Merge(m, src)
+}
+func (m *CoverageRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoverageRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoverageRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoverageRule proto.InternalMessageInfo
+
+func (m *CoverageRule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CoverageRule) GetInsurerId() string {
+	if m != nil {
+		return m.InsurerId
+	}
+	return ""
+}
+
+func (m *CoverageRule) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *CoverageRule) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *CoverageRule) GetPercentage() int64 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
+}
+
+func (m *CoverageRule) GetCopay() string {
+	if m != nil {
+		return m.Copay
+	}
+	return ""
+}
+
+func (m *CoverageRule) GetAnnualLimit() string {
+	if m != nil {
+		return m.AnnualLimit
+	}
+	return ""
+}
+
+func (m *CoverageRule) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *CoverageRule) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type CoverageRulesType struct {
+	Count                int64           `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Rules                []*CoverageRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CoverageRulesType) Reset()         { *m = CoverageRulesType{} }
+func (m *CoverageRulesType) String() string { return proto.CompactTextString(m) }
+func (*CoverageRulesType) ProtoMessage()    {}
+func (*CoverageRulesType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{5}
+}
+func (m *CoverageRulesType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoverageRulesType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoverageRulesType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CoverageRulesType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoverageRulesType.Merge(m, src)
+}
+func (m *CoverageRulesType) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoverageRulesType) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoverageRulesType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoverageRulesType proto.InternalMessageInfo
+
+func (m *CoverageRulesType) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *CoverageRulesType) GetRules() []*CoverageRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type EligibilityReq struct {
+	PatientId string `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	// empty picks the patient's first policy in force that covers the service
+	PolicyId        string `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	DoctorServiceId string `protobuf:"bytes,3,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	Price           string `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+	Currency        string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency"`
+	// defaults to today
+	Date                 string   `protobuf:"bytes,6,opt,name=date,proto3" json:"date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EligibilityReq) Reset()         { *m = EligibilityReq{} }
+func (m *EligibilityReq) String() string { return proto.CompactTextString(m) }
+func (*EligibilityReq) ProtoMessage()    {}
+func (*EligibilityReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{6}
+}
+func (m *EligibilityReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EligibilityReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EligibilityReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EligibilityReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EligibilityReq.Merge(m, src)
+}
+func (m *EligibilityReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *EligibilityReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EligibilityReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EligibilityReq proto.InternalMessageInfo
+
+func (m *EligibilityReq) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *EligibilityReq) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *EligibilityReq) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *EligibilityReq) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *EligibilityReq) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *EligibilityReq) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+type Eligibility struct {
+	Eligible bool `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible"`
+	// why the service is not covered
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	PolicyId             string   `protobuf:"bytes,3,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	InsurerId            string   `protobuf:"bytes,4,opt,name=insurer_id,json=insurerId,proto3" json:"insurer_id"`
+	Price                string   `protobuf:"bytes,5,opt,name=price,proto3" json:"price"`
+	Covered              string   `protobuf:"bytes,6,opt,name=covered,proto3" json:"covered"`
+	Copay                string   `protobuf:"bytes,7,opt,name=copay,proto3" json:"copay"`
+	Currency             string   `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Eligibility) Reset()         { *m = Eligibility{} }
+func (m *Eligibility) String() string { return proto.CompactTextString(m) }
+func (*Eligibility) ProtoMessage()    {}
+func (*Eligibility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{7}
+}
+func (m *Eligibility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eligibility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eligibility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Eligibility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eligibility.Merge(m, src)
+}
+func (m *Eligibility) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eligibility) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eligibility.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eligibility proto.InternalMessageInfo
+
+func (m *Eligibility) GetEligible() bool {
+	if m != nil {
+		return m.Eligible
+	}
+	return false
+}
+
+func (m *Eligibility) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Eligibility) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *Eligibility) GetInsurerId() string {
+	if m != nil {
+		return m.InsurerId
+	}
+	return ""
+}
+
+func (m *Eligibility) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *Eligibility) GetCovered() string {
+	if m != nil {
+		return m.Covered
+	}
+	return ""
+}
+
+func (m *Eligibility) GetCopay() string {
+	if m != nil {
+		return m.Copay
+	}
+	return ""
+}
+
+func (m *Eligibility) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+type InsuranceClaim struct {
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	InsurerId       string `protobuf:"bytes,2,opt,name=insurer_id,json=insurerId,proto3" json:"insurer_id"`
+	PolicyId        string `protobuf:"bytes,3,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	PatientId       string `protobuf:"bytes,4,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	AppointmentId   int64  `protobuf:"varint,5,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
+	InvoiceId       string `protobuf:"bytes,6,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id"`
+	DoctorServiceId string `protobuf:"bytes,7,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	ServiceDate     string `protobuf:"bytes,8,opt,name=service_date,json=serviceDate,proto3" json:"service_date"`
+	Billed          string `protobuf:"bytes,9,opt,name=billed,proto3" json:"billed"`
+	Covered         string `protobuf:"bytes,10,opt,name=covered,proto3" json:"covered"`
+	Copay           string `protobuf:"bytes,11,opt,name=copay,proto3" json:"copay"`
+	Approved        string `protobuf:"bytes,12,opt,name=approved,proto3" json:"approved"`
+	Currency        string `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency"`
+	// pending, submitted, approved, rejected, paid
+	Status               string   `protobuf:"bytes,14,opt,name=status,proto3" json:"status"`
+	StatusReason         string   `protobuf:"bytes,15,opt,name=status_reason,json=statusReason,proto3" json:"status_reason"`
+	BatchId              string   `protobuf:"bytes,16,opt,name=batch_id,json=batchId,proto3" json:"batch_id"`
+	CreatedAt            string   `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InsuranceClaim) Reset()         { *m = InsuranceClaim{} }
+func (m *InsuranceClaim) String() string { return proto.CompactTextString(m) }
+func (*InsuranceClaim) ProtoMessage()    {}
+func (*InsuranceClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{8}
+}
+func (m *InsuranceClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceClaim.Merge(m, src)
+}
+func (m *InsuranceClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceClaim proto.InternalMessageInfo
+
+func (m *InsuranceClaim) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetInsurerId() string {
+	if m != nil {
+		return m.InsurerId
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetAppointmentId() int64 {
+	if m != nil {
+		return m.AppointmentId
+	}
+	return 0
+}
+
+func (m *InsuranceClaim) GetInvoiceId() string {
+	if m != nil {
+		return m.InvoiceId
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetServiceDate() string {
+	if m != nil {
+		return m.ServiceDate
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetBilled() string {
+	if m != nil {
+		return m.Billed
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetCovered() string {
+	if m != nil {
+		return m.Covered
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetCopay() string {
+	if m != nil {
+		return m.Copay
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetApproved() string {
+	if m != nil {
+		return m.Approved
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetStatusReason() string {
+	if m != nil {
+		return m.StatusReason
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetBatchId() string {
+	if m != nil {
+		return m.BatchId
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *InsuranceClaim) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type InsuranceClaimsType struct {
+	Count                int64             `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Claims               []*InsuranceClaim `protobuf:"bytes,2,rep,name=claims,proto3" json:"claims"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InsuranceClaimsType) Reset()         { *m = InsuranceClaimsType{} }
+func (m *InsuranceClaimsType) String() string { return proto.CompactTextString(m) }
+func (*InsuranceClaimsType) ProtoMessage()    {}
+func (*InsuranceClaimsType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{9}
+}
+func (m *InsuranceClaimsType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceClaimsType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceClaimsType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceClaimsType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceClaimsType.Merge(m, src)
+}
+func (m *InsuranceClaimsType) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceClaimsType) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceClaimsType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceClaimsType proto.InternalMessageInfo
+
+func (m *InsuranceClaimsType) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *InsuranceClaimsType) GetClaims() []*InsuranceClaim {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+type CreateClaimReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	AppointmentId        int64    `protobuf:"varint,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
+	PolicyId             string   `protobuf:"bytes,3,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateClaimReq) Reset()         { *m = CreateClaimReq{} }
+func (m *CreateClaimReq) String() string { return proto.CompactTextString(m) }
+func (*CreateClaimReq) ProtoMessage()    {}
+func (*CreateClaimReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{10}
+}
+func (m *CreateClaimReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateClaimReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateClaimReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateClaimReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateClaimReq.Merge(m, src)
+}
+func (m *CreateClaimReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateClaimReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateClaimReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateClaimReq proto.InternalMessageInfo
+
+func (m *CreateClaimReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateClaimReq) GetAppointmentId() int64 {
+	if m != nil {
+		return m.AppointmentId
+	}
+	return 0
+}
+
+func (m *CreateClaimReq) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+type ClaimStatusReq struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// approved, rejected, paid
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	// the amount the insurer approved, the whole claim when empty
+	Approved             string   `protobuf:"bytes,3,opt,name=approved,proto3" json:"approved"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClaimStatusReq) Reset()         { *m = ClaimStatusReq{} }
+func (m *ClaimStatusReq) String() string { return proto.CompactTextString(m) }
+func (*ClaimStatusReq) ProtoMessage()    {}
+func (*ClaimStatusReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{11}
+}
+func (m *ClaimStatusReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimStatusReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimStatusReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimStatusReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimStatusReq.Merge(m, src)
+}
+func (m *ClaimStatusReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimStatusReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimStatusReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimStatusReq proto.InternalMessageInfo
+
+func (m *ClaimStatusReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ClaimStatusReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ClaimStatusReq) GetApproved() string {
+	if m != nil {
+		return m.Approved
+	}
+	return ""
+}
+
+func (m *ClaimStatusReq) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type GetAllClaimsReq struct {
+	Page                 uint64   `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit                uint64   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	InsurerId            string   `protobuf:"bytes,3,opt,name=insurer_id,json=insurerId,proto3" json:"insurer_id"`
+	PatientId            string   `protobuf:"bytes,4,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	BatchId              string   `protobuf:"bytes,5,opt,name=batch_id,json=batchId,proto3" json:"batch_id"`
+	Status               string   `protobuf:"bytes,6,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAllClaimsReq) Reset()         { *m = GetAllClaimsReq{} }
+func (m *GetAllClaimsReq) String() string { return proto.CompactTextString(m) }
+func (*GetAllClaimsReq) ProtoMessage()    {}
+func (*GetAllClaimsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{12}
+}
+func (m *GetAllClaimsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllClaimsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllClaimsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAllClaimsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllClaimsReq.Merge(m, src)
+}
+func (m *GetAllClaimsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllClaimsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllClaimsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllClaimsReq proto.InternalMessageInfo
+
+func (m *GetAllClaimsReq) GetPage() uint64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *GetAllClaimsReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetAllClaimsReq) GetInsurerId() string {
+	if m != nil {
+		return m.InsurerId
+	}
+	return ""
+}
+
+func (m *GetAllClaimsReq) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *GetAllClaimsReq) GetBatchId() string {
+	if m != nil {
+		return m.BatchId
+	}
+	return ""
+}
+
+func (m *GetAllClaimsReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type ExportClaimsReq struct {
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	InsurerId  string `protobuf:"bytes,2,opt,name=insurer_id,json=insurerId,proto3" json:"insurer_id"`
+	PeriodFrom string `protobuf:"bytes,3,opt,name=period_from,json=periodFrom,proto3" json:"period_from"`
+	PeriodTo   string `protobuf:"bytes,4,opt,name=period_to,json=periodTo,proto3" json:"period_to"`
+	// csv, json
+	Format               string   `protobuf:"bytes,5,opt,name=format,proto3" json:"format"`
+	CreatedBy            string   `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportClaimsReq) Reset()         { *m = ExportClaimsReq{} }
+func (m *ExportClaimsReq) String() string { return proto.CompactTextString(m) }
+func (*ExportClaimsReq) ProtoMessage()    {}
+func (*ExportClaimsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{13}
+}
+func (m *ExportClaimsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportClaimsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportClaimsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportClaimsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportClaimsReq.Merge(m, src)
+}
+func (m *ExportClaimsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportClaimsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportClaimsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportClaimsReq proto.InternalMessageInfo
+
+func (m *ExportClaimsReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExportClaimsReq) GetInsurerId() string {
+	if m != nil {
+		return m.InsurerId
+	}
+	return ""
+}
+
+func (m *ExportClaimsReq) GetPeriodFrom() string {
+	if m != nil {
+		return m.PeriodFrom
+	}
+	return ""
+}
+
+func (m *ExportClaimsReq) GetPeriodTo() string {
+	if m != nil {
+		return m.PeriodTo
+	}
+	return ""
+}
+
+func (m *ExportClaimsReq) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportClaimsReq) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+type ClaimBatch struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	InsurerId            string   `protobuf:"bytes,2,opt,name=insurer_id,json=insurerId,proto3" json:"insurer_id"`
+	PeriodFrom           string   `protobuf:"bytes,3,opt,name=period_from,json=periodFrom,proto3" json:"period_from"`
+	PeriodTo             string   `protobuf:"bytes,4,opt,name=period_to,json=periodTo,proto3" json:"period_to"`
+	Format               string   `protobuf:"bytes,5,opt,name=format,proto3" json:"format"`
+	ClaimCount           int64    `protobuf:"varint,6,opt,name=claim_count,json=claimCount,proto3" json:"claim_count"`
+	CreatedBy            string   `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by"`
+	CreatedAt            string   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClaimBatch) Reset()         { *m = ClaimBatch{} }
+func (m *ClaimBatch) String() string { return proto.CompactTextString(m) }
+func (*ClaimBatch) ProtoMessage()    {}
+func (*ClaimBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{14}
+}
+func (m *ClaimBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimBatch.Merge(m, src)
+}
+func (m *ClaimBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimBatch proto.InternalMessageInfo
+
+func (m *ClaimBatch) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ClaimBatch) GetInsurerId() string {
+	if m != nil {
+		return m.InsurerId
+	}
+	return ""
+}
+
+func (m *ClaimBatch) GetPeriodFrom() string {
+	if m != nil {
+		return m.PeriodFrom
+	}
+	return ""
+}
+
+func (m *ClaimBatch) GetPeriodTo() string {
+	if m != nil {
+		return m.PeriodTo
+	}
+	return ""
+}
+
+func (m *ClaimBatch) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ClaimBatch) GetClaimCount() int64 {
+	if m != nil {
+		return m.ClaimCount
+	}
+	return 0
+}
+
+func (m *ClaimBatch) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+func (m *ClaimBatch) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+type ClaimExportFile struct {
+	Batch                *ClaimBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch"`
+	FileName             string      `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name"`
+	ContentType          string      `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type"`
+	Content              []byte      `protobuf:"bytes,4,opt,name=content,proto3" json:"content"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ClaimExportFile) Reset()         { *m = ClaimExportFile{} }
+func (m *ClaimExportFile) String() string { return proto.CompactTextString(m) }
+func (*ClaimExportFile) ProtoMessage()    {}
+func (*ClaimExportFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{15}
+}
+func (m *ClaimExportFile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimExportFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimExportFile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimExportFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimExportFile.Merge(m, src)
+}
+func (m *ClaimExportFile) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimExportFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimExportFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimExportFile proto.InternalMessageInfo
+
+func (m *ClaimExportFile) GetBatch() *ClaimBatch {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (m *ClaimExportFile) GetFileName() string {
+	if m != nil {
+		return m.FileName
+	}
+	return ""
+}
+
+func (m *ClaimExportFile) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *ClaimExportFile) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+type InsuranceFieldValueReq struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InsuranceFieldValueReq) Reset()         { *m = InsuranceFieldValueReq{} }
+func (m *InsuranceFieldValueReq) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFieldValueReq) ProtoMessage()    {}
+func (*InsuranceFieldValueReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{16}
+}
+func (m *InsuranceFieldValueReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFieldValueReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFieldValueReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceFieldValueReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFieldValueReq.Merge(m, src)
+}
+func (m *InsuranceFieldValueReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFieldValueReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFieldValueReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFieldValueReq proto.InternalMessageInfo
+
+func (m *InsuranceFieldValueReq) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *InsuranceFieldValueReq) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type InsuranceStatusRes struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InsuranceStatusRes) Reset()         { *m = InsuranceStatusRes{} }
+func (m *InsuranceStatusRes) String() string { return proto.CompactTextString(m) }
+func (*InsuranceStatusRes) ProtoMessage()    {}
+func (*InsuranceStatusRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{17}
+}
+func (m *InsuranceStatusRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceStatusRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceStatusRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceStatusRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceStatusRes.Merge(m, src)
+}
+func (m *InsuranceStatusRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceStatusRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceStatusRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceStatusRes proto.InternalMessageInfo
+
+func (m *InsuranceStatusRes) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+type GetAllInsuranceReq struct {
+	Page                 uint64   `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit                uint64   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	InsurerId            string   `protobuf:"bytes,3,opt,name=insurer_id,json=insurerId,proto3" json:"insurer_id"`
+	PatientId            string   `protobuf:"bytes,4,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	Value                string   `protobuf:"bytes,5,opt,name=value,proto3" json:"value"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAllInsuranceReq) Reset()         { *m = GetAllInsuranceReq{} }
+func (m *GetAllInsuranceReq) String() string { return proto.CompactTextString(m) }
+func (*GetAllInsuranceReq) ProtoMessage()    {}
+func (*GetAllInsuranceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d986a5e76d28883d, []int{18}
+}
+func (m *GetAllInsuranceReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllInsuranceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllInsuranceReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAllInsuranceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllInsuranceReq.Merge(m, src)
+}
+func (m *GetAllInsuranceReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllInsuranceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllInsuranceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllInsuranceReq proto.InternalMessageInfo
+
+func (m *GetAllInsuranceReq) GetPage() uint64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *GetAllInsuranceReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetAllInsuranceReq) GetInsurerId() string {
+	if m != nil {
+		return m.InsurerId
+	}
+	return ""
+}
+
+func (m *GetAllInsuranceReq) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *GetAllInsuranceReq) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Insurer)(nil), "booking_service.Insurer")
+	proto.RegisterType((*InsurersType)(nil), "booking_service.InsurersType")
+	proto.RegisterType((*InsurancePolicy)(nil), "booking_service.InsurancePolicy")
+	proto.RegisterType((*InsurancePoliciesType)(nil), "booking_service.InsurancePoliciesType")
+	proto.RegisterType((*CoverageRule)(nil), "booking_service.CoverageRule")
+	proto.RegisterType((*CoverageRulesType)(nil), "booking_service.CoverageRulesType")
+	proto.RegisterType((*EligibilityReq)(nil), "booking_service.EligibilityReq")
+	proto.RegisterType((*Eligibility)(nil), "booking_service.Eligibility")
+	proto.RegisterType((*InsuranceClaim)(nil), "booking_service.InsuranceClaim")
+	proto.RegisterType((*InsuranceClaimsType)(nil), "booking_service.InsuranceClaimsType")
+	proto.RegisterType((*CreateClaimReq)(nil), "booking_service.CreateClaimReq")
+	proto.RegisterType((*ClaimStatusReq)(nil), "booking_service.ClaimStatusReq")
+	proto.RegisterType((*GetAllClaimsReq)(nil), "booking_service.GetAllClaimsReq")
+	proto.RegisterType((*ExportClaimsReq)(nil), "booking_service.ExportClaimsReq")
+	proto.RegisterType((*ClaimBatch)(nil), "booking_service.ClaimBatch")
+	proto.RegisterType((*ClaimExportFile)(nil), "booking_service.ClaimExportFile")
+	proto.RegisterType((*InsuranceFieldValueReq)(nil), "booking_service.InsuranceFieldValueReq")
+	proto.RegisterType((*InsuranceStatusRes)(nil), "booking_service.InsuranceStatusRes")
+	proto.RegisterType((*GetAllInsuranceReq)(nil), "booking_service.GetAllInsuranceReq")
+}
+
+func init() { proto.RegisterFile("booking_service/insurance.proto", fileDescriptor_d986a5e76d28883d) }
+
+var fileDescriptor_d986a5e76d28883d = []byte{
+	// 1428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x8f, 0x1b, 0x45,
+	0x13, 0x7e, 0xc7, 0xdf, 0x2e, 0x8f, 0xed, 0x4d, 0x27, 0x6f, 0x64, 0x1c, 0x76, 0x37, 0x99, 0xe5,
+	0x23, 0x42, 0x28, 0x88, 0x04, 0x89, 0x0b, 0x97, 0xc4, 0x9b, 0xac, 0x56, 0x42, 0x09, 0xcc, 0x2e,
+	0x91, 0x40, 0x4a, 0xac, 0xf1, 0x4c, 0xef, 0xa6, 0xb5, 0xe3, 0xe9, 0xc9, 0xb8, 0x6d, 0xf0, 0xdf,
+	0x40, 0x1c, 0x38, 0x71, 0xe4, 0xc4, 0x15, 0x89, 0x13, 0x67, 0x8e, 0x48, 0xfc, 0x00, 0x50, 0xf8,
+	0x03, 0x1c, 0x39, 0xa2, 0xee, 0xea, 0x19, 0xcf, 0x8c, 0xed, 0xf1, 0xae, 0x12, 0x21, 0x6e, 0x53,
+	0xd5, 0xe5, 0xee, 0xaa, 0xe7, 0xa9, 0xaa, 0xae, 0x36, 0xec, 0x8e, 0x38, 0x3f, 0x63, 0xc1, 0xe9,
+	0x70, 0x42, 0xa3, 0x19, 0x73, 0xe9, 0x7b, 0x2c, 0x98, 0x4c, 0x23, 0x27, 0x70, 0xe9, 0xad, 0x30,
+	0xe2, 0x82, 0x93, 0x6e, 0xce, 0xc0, 0xfa, 0xc9, 0x80, 0xfa, 0xa1, 0x34, 0xa2, 0x11, 0xe9, 0x40,
+	0x89, 0x79, 0x3d, 0xe3, 0xba, 0x71, 0xb3, 0x69, 0x97, 0x98, 0x47, 0x08, 0x54, 0x02, 0x67, 0x4c,
+	0x7b, 0x25, 0xa5, 0x51, 0xdf, 0x52, 0xe7, 0x72, 0x8f, 0xf6, 0xca, 0xa8, 0x93, 0xdf, 0xe4, 0x0a,
+	0x54, 0xe9, 0xd8, 0x61, 0x7e, 0xaf, 0xa2, 0x94, 0x28, 0x90, 0x1b, 0x60, 0x86, 0xcf, 0x78, 0x40,
+	0x87, 0xc1, 0x74, 0x3c, 0xa2, 0x51, 0xaf, 0xaa, 0x16, 0x5b, 0x4a, 0xf7, 0x50, 0xa9, 0xc8, 0x36,
+	0x80, 0x1b, 0x51, 0x47, 0x50, 0x6f, 0xe8, 0x88, 0x5e, 0x4d, 0x19, 0x34, 0xb5, 0xe6, 0xae, 0x90,
+	0xcb, 0xd3, 0xd0, 0x8b, 0x97, 0xeb, 0xb8, 0xac, 0x35, 0x77, 0x85, 0xf5, 0x05, 0x98, 0xda, 0xf3,
+	0xc9, 0xf1, 0x3c, 0x54, 0x6e, 0xb8, 0x7c, 0x1a, 0x08, 0x15, 0x41, 0xd9, 0x46, 0x81, 0x7c, 0x00,
+	0x0d, 0xa6, 0xad, 0x7a, 0xa5, 0xeb, 0xe5, 0x9b, 0xad, 0xdb, 0xbd, 0x5b, 0x39, 0x10, 0x6e, 0xe9,
+	0x6d, 0xec, 0xc4, 0xd2, 0xfa, 0xad, 0x04, 0xdd, 0xc3, 0x18, 0xbb, 0x4f, 0xb8, 0xcf, 0xdc, 0xf9,
+	0x12, 0x3c, 0xdb, 0x00, 0xda, 0x7e, 0xc8, 0x3c, 0x0d, 0x52, 0x53, 0x6b, 0x0e, 0xd5, 0x72, 0xe8,
+	0x08, 0x46, 0x03, 0x21, 0x97, 0x11, 0xaf, 0xa6, 0xd6, 0x1c, 0x7a, 0x64, 0x0f, 0xda, 0xa1, 0xda,
+	0x37, 0xc6, 0x07, 0xc1, 0x33, 0x51, 0xb9, 0x00, 0x68, 0xe6, 0xf8, 0xcc, 0x1b, 0x9e, 0x44, 0x7c,
+	0xac, 0x11, 0x6c, 0x2a, 0xcd, 0x83, 0x88, 0x8f, 0xc9, 0x6b, 0xd0, 0xc0, 0x65, 0xc1, 0x35, 0x7a,
+	0x75, 0x25, 0x1f, 0x73, 0x89, 0xbe, 0x13, 0x04, 0x53, 0xc7, 0x1f, 0xfa, 0x6c, 0xcc, 0x62, 0xf4,
+	0x5a, 0xa8, 0xfb, 0x58, 0xaa, 0x48, 0x1f, 0x1a, 0xee, 0x34, 0x8a, 0x68, 0xe0, 0xce, 0x7b, 0x0d,
+	0xb5, 0x9c, 0xc8, 0xe4, 0x2a, 0xd4, 0x26, 0xc2, 0x11, 0xd3, 0x49, 0xaf, 0xa9, 0x56, 0xb4, 0x94,
+	0x63, 0x0c, 0x8a, 0x19, 0x6b, 0xe5, 0x19, 0x3b, 0x83, 0xff, 0x67, 0x41, 0x65, 0xb4, 0x88, 0xba,
+	0x8f, 0xa0, 0x11, 0x6a, 0x2b, 0x4d, 0xdd, 0xf5, 0xd5, 0xd4, 0x2d, 0x48, 0xb2, 0x93, 0x5f, 0x58,
+	0xdf, 0x94, 0xc0, 0x1c, 0xf0, 0x19, 0x8d, 0x9c, 0x53, 0x6a, 0x4f, 0x7d, 0x7a, 0x51, 0xfe, 0xde,
+	0x81, 0x4b, 0x1e, 0x77, 0x05, 0x8f, 0xe2, 0xb3, 0x16, 0x34, 0x76, 0x71, 0xe1, 0x08, 0xf5, 0x87,
+	0xaa, 0x52, 0xce, 0x58, 0xe0, 0x69, 0x0e, 0xd5, 0x37, 0xd9, 0x01, 0x08, 0x69, 0xe4, 0xd2, 0x40,
+	0x38, 0xa7, 0x54, 0x71, 0x57, 0xb6, 0x53, 0x1a, 0x8c, 0x39, 0x74, 0xe6, 0x9a, 0x39, 0x14, 0xce,
+	0xc3, 0x5b, 0x96, 0x83, 0x46, 0x31, 0x07, 0xcd, 0x3c, 0x07, 0x4f, 0xe1, 0x52, 0x1a, 0x95, 0x22,
+	0xfc, 0xef, 0x40, 0x35, 0x9a, 0xfa, 0x09, 0xf8, 0xdb, 0x4b, 0xe0, 0xa7, 0x37, 0xb2, 0xd1, 0xd6,
+	0xfa, 0xd9, 0x80, 0xce, 0x7d, 0x9f, 0x9d, 0xb2, 0x11, 0xf3, 0x99, 0x98, 0xdb, 0xf4, 0x79, 0xae,
+	0x12, 0x8c, 0x7c, 0x25, 0x5c, 0x83, 0xa6, 0xae, 0x84, 0x84, 0x06, 0x64, 0x71, 0x7e, 0x41, 0x16,
+	0xae, 0x40, 0x35, 0x8c, 0x98, 0x4b, 0xe3, 0x3e, 0xa4, 0x84, 0x4c, 0x9a, 0x57, 0x73, 0x69, 0x4e,
+	0xa0, 0x22, 0x71, 0xd1, 0x14, 0xa8, 0x6f, 0xeb, 0x77, 0x03, 0x5a, 0xa9, 0x00, 0xe4, 0xef, 0xa9,
+	0x12, 0x7d, 0xaa, 0x7c, 0x6f, 0xd8, 0x89, 0x2c, 0xcb, 0x24, 0xa2, 0xce, 0x84, 0x07, 0xda, 0x6f,
+	0x2d, 0x65, 0x43, 0x2a, 0xe7, 0x42, 0xca, 0xe6, 0x5d, 0x25, 0x9f, 0x77, 0x49, 0x14, 0xd5, 0x74,
+	0x14, 0x3d, 0xa8, 0xbb, 0x12, 0x6d, 0xea, 0xc5, 0x95, 0xae, 0xc5, 0x45, 0x1e, 0xd5, 0xd3, 0x79,
+	0x54, 0x50, 0xdc, 0xd6, 0xf7, 0x15, 0xe8, 0x24, 0x75, 0x33, 0xf0, 0x1d, 0x36, 0xbe, 0x68, 0x6d,
+	0x6c, 0x8a, 0x2f, 0x45, 0x77, 0x25, 0x4f, 0xf7, 0x9b, 0xd0, 0x71, 0xc2, 0x90, 0xb3, 0x40, 0x8c,
+	0xb5, 0x09, 0xd6, 0x46, 0x3b, 0xa5, 0x8d, 0x51, 0x9a, 0x71, 0xcd, 0x78, 0x2d, 0xf6, 0x40, 0x69,
+	0xd6, 0xe5, 0x45, 0x7d, 0x75, 0x5e, 0xdc, 0x00, 0x33, 0x36, 0x52, 0x6c, 0x23, 0x1e, 0x2d, 0xad,
+	0xdb, 0x77, 0x84, 0x22, 0x72, 0xc4, 0x7c, 0x9f, 0x7a, 0x71, 0xbf, 0x43, 0x29, 0x0d, 0x3b, 0xac,
+	0x81, 0xbd, 0x95, 0x83, 0xdd, 0x09, 0xc3, 0x88, 0xcf, 0xa8, 0xd7, 0x33, 0x11, 0x97, 0x58, 0xce,
+	0x50, 0xd2, 0x5e, 0xdb, 0x6f, 0x3b, 0x99, 0x7e, 0xbb, 0x07, 0x6d, 0xfc, 0x1a, 0xea, 0x3c, 0xeb,
+	0xe2, 0x2d, 0x81, 0x4a, 0x5b, 0xe9, 0xe4, 0x35, 0x30, 0x72, 0x84, 0xfb, 0x4c, 0x42, 0xb0, 0x85,
+	0x5e, 0x2a, 0x19, 0x51, 0x4c, 0xf5, 0x8a, 0x4b, 0xc5, 0xbd, 0x82, 0xe4, 0x7b, 0x85, 0x07, 0x97,
+	0xb3, 0x79, 0x52, 0xd4, 0x2d, 0x3e, 0x84, 0x9a, 0xab, 0x6c, 0x74, 0xbb, 0xd8, 0x5d, 0xdf, 0xab,
+	0xd5, 0x5e, 0xb6, 0x36, 0xb7, 0x3c, 0xe8, 0x0c, 0x94, 0x47, 0xa8, 0xa6, 0xcf, 0x97, 0xb2, 0x71,
+	0x39, 0x65, 0x4a, 0xab, 0x52, 0xa6, 0x28, 0x2b, 0x2d, 0x1f, 0x3a, 0x6a, 0xff, 0x23, 0x8d, 0xdc,
+	0xf2, 0x29, 0x0b, 0x0e, 0x4a, 0x19, 0x0e, 0xd2, 0x9c, 0x96, 0x73, 0x9c, 0x2e, 0x1a, 0x40, 0x25,
+	0xdd, 0x00, 0xac, 0x1f, 0x0c, 0xe8, 0x1e, 0x50, 0x71, 0xd7, 0xf7, 0x11, 0x37, 0x79, 0x1e, 0x81,
+	0x4a, 0x28, 0xaf, 0x02, 0x79, 0x62, 0xc5, 0x56, 0xdf, 0x12, 0x4a, 0xec, 0xf3, 0x25, 0xa5, 0x44,
+	0x21, 0x57, 0x7d, 0xe5, 0xe2, 0xc9, 0x62, 0xa9, 0xc0, 0xd2, 0xe9, 0x50, 0xcd, 0xa6, 0xc3, 0x22,
+	0xc4, 0x5a, 0x3a, 0x44, 0xeb, 0x47, 0x03, 0xba, 0xf7, 0xbf, 0x0a, 0x79, 0x24, 0x16, 0xee, 0x5e,
+	0xb0, 0x25, 0xec, 0x42, 0x2b, 0xa4, 0x11, 0xe3, 0x7a, 0x56, 0x41, 0xa7, 0x01, 0x55, 0x6a, 0x58,
+	0x91, 0xec, 0xa0, 0x81, 0xe0, 0xda, 0xe9, 0x06, 0x2a, 0x8e, 0xb9, 0x74, 0xec, 0x84, 0x47, 0x63,
+	0x47, 0x68, 0x8f, 0xb5, 0x94, 0xce, 0xdf, 0xd1, 0x3c, 0x37, 0x21, 0xde, 0x9b, 0x5b, 0x7f, 0x19,
+	0x00, 0xca, 0xe3, 0x7b, 0x32, 0xc0, 0xff, 0x86, 0xcb, 0xbb, 0xd0, 0x52, 0x89, 0x3d, 0xc4, 0x1a,
+	0xa9, 0xe1, 0xc5, 0xaf, 0x54, 0x03, 0xa9, 0xc9, 0xc5, 0x54, 0xcf, 0xc5, 0xb4, 0xe1, 0x7a, 0xb7,
+	0xbe, 0x33, 0xa0, 0xab, 0x42, 0x46, 0xbe, 0x1e, 0x30, 0x9f, 0x92, 0xf7, 0xa1, 0xaa, 0x18, 0x56,
+	0xa1, 0xb7, 0x6e, 0x5f, 0x5b, 0xbe, 0xa8, 0x13, 0x8c, 0x6c, 0xb4, 0x94, 0xa1, 0x9d, 0x30, 0x9f,
+	0x0e, 0x53, 0x03, 0x7e, 0x43, 0x2a, 0x1e, 0xca, 0x21, 0xff, 0x06, 0x98, 0x2e, 0x0f, 0x84, 0x4c,
+	0x30, 0x31, 0x0f, 0xe3, 0x61, 0xbf, 0xa5, 0x75, 0xaa, 0x07, 0xa8, 0xc6, 0xa8, 0x44, 0x05, 0x8c,
+	0x69, 0xc7, 0xa2, 0xb5, 0x0f, 0x57, 0x93, 0x42, 0x7f, 0xc0, 0xa8, 0xef, 0x3d, 0x76, 0xfc, 0x29,
+	0x95, 0x19, 0x75, 0x05, 0xaa, 0x27, 0x52, 0xa1, 0x19, 0x42, 0x41, 0x6a, 0x67, 0xd2, 0x42, 0x7b,
+	0x81, 0x82, 0xf5, 0x2e, 0x90, 0x64, 0x97, 0xb8, 0x64, 0x27, 0xa9, 0xfc, 0xc5, 0x9b, 0x38, 0xce,
+	0xdf, 0xaf, 0x0d, 0x20, 0x58, 0x6e, 0xc9, 0x8f, 0xfe, 0xcd, 0x8a, 0x4b, 0x42, 0xa8, 0xa6, 0x42,
+	0xb8, 0xfd, 0xb7, 0x09, 0x5b, 0x8b, 0x18, 0x90, 0x09, 0x32, 0x80, 0x36, 0x36, 0xbb, 0xf8, 0xd1,
+	0xb5, 0xf6, 0x35, 0xd2, 0x5f, 0xbb, 0x42, 0x1e, 0x01, 0x1c, 0x50, 0x11, 0x4b, 0x6f, 0xaf, 0x6f,
+	0xb4, 0x19, 0xfc, 0x0b, 0x36, 0x3c, 0x86, 0x4e, 0x0a, 0x3e, 0x1a, 0x4d, 0xc8, 0xde, 0x92, 0xed,
+	0x32, 0xbe, 0xfd, 0xed, 0x75, 0x1b, 0xe2, 0x3d, 0x31, 0x80, 0xf6, 0x67, 0xea, 0x2e, 0x79, 0x99,
+	0x58, 0x9f, 0x40, 0x7b, 0x9f, 0xfa, 0x54, 0xd0, 0x0b, 0x87, 0xbb, 0xb7, 0xde, 0x70, 0x91, 0x51,
+	0x36, 0x98, 0xc8, 0x87, 0x7e, 0xe4, 0x6d, 0x7c, 0x61, 0xf4, 0x37, 0x5a, 0x90, 0xc7, 0xd0, 0x3c,
+	0xa0, 0x42, 0x0b, 0xe7, 0x76, 0x77, 0xf3, 0xbe, 0x4f, 0x62, 0x96, 0xe2, 0xb7, 0xd3, 0xf9, 0x58,
+	0x7a, 0x6b, 0xc3, 0xc6, 0xf1, 0x23, 0xec, 0x73, 0x30, 0x07, 0x52, 0xe9, 0xbf, 0x7a, 0xcf, 0x1f,
+	0x41, 0xf7, 0x88, 0x8a, 0xcc, 0x6b, 0xac, 0xf8, 0x35, 0xd1, 0x2f, 0x5e, 0x26, 0x4f, 0xe1, 0xb2,
+	0xbe, 0x5e, 0x53, 0xda, 0x73, 0xe2, 0x61, 0x15, 0x6e, 0x8d, 0x58, 0x8c, 0x80, 0x60, 0xd6, 0x65,
+	0x4e, 0x7d, 0xb5, 0xa9, 0xf7, 0x29, 0x6c, 0x0d, 0x9e, 0x51, 0xf7, 0x2c, 0xfd, 0xd8, 0x58, 0x1e,
+	0x9a, 0xb2, 0x6f, 0xa9, 0xfe, 0xeb, 0x45, 0x06, 0xe4, 0x11, 0xb4, 0x52, 0xa3, 0xd4, 0x8a, 0xdd,
+	0xb2, 0x83, 0x56, 0x7f, 0xd3, 0x8c, 0x46, 0x8e, 0xa1, 0x71, 0x40, 0x71, 0x28, 0x38, 0x7f, 0xf4,
+	0x1b, 0x77, 0x7d, 0x0c, 0x66, 0x7a, 0x38, 0x5a, 0x51, 0x74, 0xb9, 0xd9, 0xa9, 0xff, 0xc6, 0x86,
+	0x2d, 0x91, 0x35, 0x1b, 0x3a, 0x47, 0xda, 0x5b, 0x84, 0x79, 0x15, 0x02, 0x99, 0x21, 0x70, 0xb3,
+	0xaf, 0x36, 0x98, 0xe9, 0xc9, 0x68, 0x85, 0xaf, 0xb9, 0xc1, 0x69, 0x45, 0x39, 0xe4, 0xef, 0xeb,
+	0x21, 0x90, 0x7d, 0xfe, 0x65, 0xe0, 0x73, 0xc7, 0x4b, 0x4d, 0x2f, 0x2f, 0x51, 0x6f, 0xb9, 0x03,
+	0xee, 0x6d, 0xfd, 0xf2, 0x62, 0xc7, 0xf8, 0xf5, 0xc5, 0x8e, 0xf1, 0xc7, 0x8b, 0x1d, 0xe3, 0xdb,
+	0x3f, 0x77, 0xfe, 0x37, 0xaa, 0xa9, 0xff, 0xff, 0xee, 0xfc, 0x33, 0x00, 0x8c, 0xda, 0x44, 0xbf,
+	0x22, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// InsuranceServiceClient is the client API for InsuranceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type InsuranceServiceClient interface {
+	CreateInsurer(ctx context.Context, in *Insurer, opts ...grpc.CallOption) (*Insurer, error)
+	GetInsurer(ctx context.Context, in *InsuranceFieldValueReq, opts ...grpc.CallOption) (*Insurer, error)
+	GetAllInsurers(ctx context.Context, in *GetAllInsuranceReq, opts ...grpc.CallOption) (*InsurersType, error)
+	UpdateInsurer(ctx context.Context, in *Insurer, opts ...grpc.CallOption) (*Insurer, error)
+	DeleteInsurer(ctx context.Context, in *InsuranceFieldValueReq, opts ...grpc.CallOption) (*InsuranceStatusRes, error)
+	CreatePolicy(ctx context.Context, in *InsurancePolicy, opts ...grpc.CallOption) (*InsurancePolicy, error)
+	GetPolicy(ctx context.Context, in *InsuranceFieldValueReq, opts ...grpc.CallOption) (*InsurancePolicy, error)
+	GetAllPolicies(ctx context.Context, in *GetAllInsuranceReq, opts ...grpc.CallOption) (*InsurancePoliciesType, error)
+	CancelPolicy(ctx context.Context, in *InsuranceFieldValueReq, opts ...grpc.CallOption) (*InsurancePolicy, error)
+	// creates the insurer's rule for the service or replaces it
+	SetCoverageRule(ctx context.Context, in *CoverageRule, opts ...grpc.CallOption) (*CoverageRule, error)
+	GetAllCoverageRules(ctx context.Context, in *GetAllInsuranceReq, opts ...grpc.CallOption) (*CoverageRulesType, error)
+	DeleteCoverageRule(ctx context.Context, in *InsuranceFieldValueReq, opts ...grpc.CallOption) (*InsuranceStatusRes, error)
+	CheckEligibility(ctx context.Context, in *EligibilityReq, opts ...grpc.CallOption) (*Eligibility, error)
+	CreateClaim(ctx context.Context, in *CreateClaimReq, opts ...grpc.CallOption) (*InsuranceClaim, error)
+	GetClaim(ctx context.Context, in *InsuranceFieldValueReq, opts ...grpc.CallOption) (*InsuranceClaim, error)
+	GetAllClaims(ctx context.Context, in *GetAllClaimsReq, opts ...grpc.CallOption) (*InsuranceClaimsType, error)
+	// a paid claim is credited to the appointment's invoice
+	SetClaimStatus(ctx context.Context, in *ClaimStatusReq, opts ...grpc.CallOption) (*InsuranceClaim, error)
+	// batches the insurer's pending claims of the period and marks them submitted
+	ExportClaims(ctx context.Context, in *ExportClaimsReq, opts ...grpc.CallOption) (*ClaimExportFile, error)
+	DownloadClaimBatch(ctx context.Context, in *InsuranceFieldValueReq, opts ...grpc.CallOption) (*ClaimExportFile, error)
+}
+
+type insuranceServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewInsuranceServiceClient(cc *grpc.ClientConn) InsuranceServiceClient {
+	return &insuranceServiceClient{cc}
+}
+
+func (c *insuranceServiceClient) CreateInsurer(ctx context.Context, in *Insurer, opts ...grpc.CallOption) (*Insurer, error) {
+	out := new(Insurer)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/CreateInsurer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) GetInsurer(ctx context.Context, in *InsuranceFieldValueReq, opts ...grpc.CallOption) (*Insurer, error) {
+	out := new(Insurer)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/GetInsurer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) GetAllInsurers(ctx context.Context, in *GetAllInsuranceReq, opts ...grpc.CallOption) (*InsurersType, error) {
+	out := new(InsurersType)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/GetAllInsurers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) UpdateInsurer(ctx context.Context, in *Insurer, opts ...grpc.CallOption) (*Insurer, error) {
+	out := new(Insurer)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/UpdateInsurer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) DeleteInsurer(ctx context.Context, in *InsuranceFieldValueReq, opts ...grpc.CallOption) (*InsuranceStatusRes, error) {
+	out := new(InsuranceStatusRes)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/DeleteInsurer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) CreatePolicy(ctx context.Context, in *InsurancePolicy, opts ...grpc.CallOption) (*InsurancePolicy, error) {
+	out := new(InsurancePolicy)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/CreatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) GetPolicy(ctx context.Context, in *InsuranceFieldValueReq, opts ...grpc.CallOption) (*InsurancePolicy, error) {
+	out := new(InsurancePolicy)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/GetPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) GetAllPolicies(ctx context.Context, in *GetAllInsuranceReq, opts ...grpc.CallOption) (*InsurancePoliciesType, error) {
+	out := new(InsurancePoliciesType)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/GetAllPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) CancelPolicy(ctx context.Context, in *InsuranceFieldValueReq, opts ...grpc.CallOption) (*InsurancePolicy, error) {
+	out := new(InsurancePolicy)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/CancelPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) SetCoverageRule(ctx context.Context, in *CoverageRule, opts ...grpc.CallOption) (*CoverageRule, error) {
+	out := new(CoverageRule)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/SetCoverageRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) GetAllCoverageRules(ctx context.Context, in *GetAllInsuranceReq, opts ...grpc.CallOption) (*CoverageRulesType, error) {
+	out := new(CoverageRulesType)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/GetAllCoverageRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) DeleteCoverageRule(ctx context.Context, in *InsuranceFieldValueReq, opts ...grpc.CallOption) (*InsuranceStatusRes, error) {
+	out := new(InsuranceStatusRes)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/DeleteCoverageRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) CheckEligibility(ctx context.Context, in *EligibilityReq, opts ...grpc.CallOption) (*Eligibility, error) {
+	out := new(Eligibility)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/CheckEligibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) CreateClaim(ctx context.Context, in *CreateClaimReq, opts ...grpc.CallOption) (*InsuranceClaim, error) {
+	out := new(InsuranceClaim)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/CreateClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) GetClaim(ctx context.Context, in *InsuranceFieldValueReq, opts ...grpc.CallOption) (*InsuranceClaim, error) {
+	out := new(InsuranceClaim)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/GetClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) GetAllClaims(ctx context.Context, in *GetAllClaimsReq, opts ...grpc.CallOption) (*InsuranceClaimsType, error) {
+	out := new(InsuranceClaimsType)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/GetAllClaims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) SetClaimStatus(ctx context.Context, in *ClaimStatusReq, opts ...grpc.CallOption) (*InsuranceClaim, error) {
+	out := new(InsuranceClaim)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/SetClaimStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) ExportClaims(ctx context.Context, in *ExportClaimsReq, opts ...grpc.CallOption) (*ClaimExportFile, error) {
+	out := new(ClaimExportFile)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/ExportClaims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *insuranceServiceClient) DownloadClaimBatch(ctx context.Context, in *InsuranceFieldValueReq, opts ...grpc.CallOption) (*ClaimExportFile, error) {
+	out := new(ClaimExportFile)
+	err := c.cc.Invoke(ctx, "/booking_service.InsuranceService/DownloadClaimBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InsuranceServiceServer is the server API for InsuranceService service.
+type InsuranceServiceServer interface {
+	CreateInsurer(context.Context, *Insurer) (*Insurer, error)
+	GetInsurer(context.Context, *InsuranceFieldValueReq) (*Insurer, error)
+	GetAllInsurers(context.Context, *GetAllInsuranceReq) (*InsurersType, error)
+	UpdateInsurer(context.Context, *Insurer) (*Insurer, error)
+	DeleteInsurer(context.Context, *InsuranceFieldValueReq) (*InsuranceStatusRes, error)
+	CreatePolicy(context.Context, *InsurancePolicy) (*InsurancePolicy, error)
+	GetPolicy(context.Context, *InsuranceFieldValueReq) (*InsurancePolicy, error)
+	GetAllPolicies(context.Context, *GetAllInsuranceReq) (*InsurancePoliciesType, error)
+	CancelPolicy(context.Context, *InsuranceFieldValueReq) (*InsurancePolicy, error)
+	// creates the insurer's rule for the service or replaces it
+	SetCoverageRule(context.Context, *CoverageRule) (*CoverageRule, error)
+	GetAllCoverageRules(context.Context, *GetAllInsuranceReq) (*CoverageRulesType, error)
+	DeleteCoverageRule(context.Context, *InsuranceFieldValueReq) (*InsuranceStatusRes, error)
+	CheckEligibility(context.Context, *EligibilityReq) (*Eligibility, error)
+	CreateClaim(context.Context, *CreateClaimReq) (*InsuranceClaim, error)
+	GetClaim(context.Context, *InsuranceFieldValueReq) (*InsuranceClaim, error)
+	GetAllClaims(context.Context, *GetAllClaimsReq) (*InsuranceClaimsType, error)
+	// a paid claim is credited to the appointment's invoice
+	SetClaimStatus(context.Context, *ClaimStatusReq) (*InsuranceClaim, error)
+	// batches the insurer's pending claims of the period and marks them submitted
+	ExportClaims(context.Context, *ExportClaimsReq) (*ClaimExportFile, error)
+	DownloadClaimBatch(context.Context, *InsuranceFieldValueReq) (*ClaimExportFile, error)
+}
+
+// UnimplementedInsuranceServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedInsuranceServiceServer struct {
+}
+
+func (*UnimplementedInsuranceServiceServer) CreateInsurer(ctx context.Context, req *Insurer) (*Insurer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInsurer not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) GetInsurer(ctx context.Context, req *InsuranceFieldValueReq) (*Insurer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInsurer not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) GetAllInsurers(ctx context.Context, req *GetAllInsuranceReq) (*InsurersType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllInsurers not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) UpdateInsurer(ctx context.Context, req *Insurer) (*Insurer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInsurer not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) DeleteInsurer(ctx context.Context, req *InsuranceFieldValueReq) (*InsuranceStatusRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInsurer not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) CreatePolicy(ctx context.Context, req *InsurancePolicy) (*InsurancePolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePolicy not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) GetPolicy(ctx context.Context, req *InsuranceFieldValueReq) (*InsurancePolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPolicy not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) GetAllPolicies(ctx context.Context, req *GetAllInsuranceReq) (*InsurancePoliciesType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPolicies not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) CancelPolicy(ctx context.Context, req *InsuranceFieldValueReq) (*InsurancePolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPolicy not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) SetCoverageRule(ctx context.Context, req *CoverageRule) (*CoverageRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCoverageRule not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) GetAllCoverageRules(ctx context.Context, req *GetAllInsuranceReq) (*CoverageRulesType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCoverageRules not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) DeleteCoverageRule(ctx context.Context, req *InsuranceFieldValueReq) (*InsuranceStatusRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCoverageRule not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) CheckEligibility(ctx context.Context, req *EligibilityReq) (*Eligibility, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckEligibility not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) CreateClaim(ctx context.Context, req *CreateClaimReq) (*InsuranceClaim, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClaim not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) GetClaim(ctx context.Context, req *InsuranceFieldValueReq) (*InsuranceClaim, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaim not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) GetAllClaims(ctx context.Context, req *GetAllClaimsReq) (*InsuranceClaimsType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllClaims not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) SetClaimStatus(ctx context.Context, req *ClaimStatusReq) (*InsuranceClaim, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClaimStatus not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) ExportClaims(ctx context.Context, req *ExportClaimsReq) (*ClaimExportFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportClaims not implemented")
+}
+func (*UnimplementedInsuranceServiceServer) DownloadClaimBatch(ctx context.Context, req *InsuranceFieldValueReq) (*ClaimExportFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadClaimBatch not implemented")
+}
+
+func RegisterInsuranceServiceServer(s *grpc.Server, srv InsuranceServiceServer) {
+	s.RegisterService(&_InsuranceService_serviceDesc, srv)
+}
+
+func _InsuranceService_CreateInsurer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Insurer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).CreateInsurer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/CreateInsurer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).CreateInsurer(ctx, req.(*Insurer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_GetInsurer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsuranceFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).GetInsurer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/GetInsurer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).GetInsurer(ctx, req.(*InsuranceFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_GetAllInsurers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllInsuranceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).GetAllInsurers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/GetAllInsurers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).GetAllInsurers(ctx, req.(*GetAllInsuranceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_UpdateInsurer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Insurer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).UpdateInsurer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/UpdateInsurer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).UpdateInsurer(ctx, req.(*Insurer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_DeleteInsurer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsuranceFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).DeleteInsurer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/DeleteInsurer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).DeleteInsurer(ctx, req.(*InsuranceFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_CreatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsurancePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).CreatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/CreatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).CreatePolicy(ctx, req.(*InsurancePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_GetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsuranceFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).GetPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/GetPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).GetPolicy(ctx, req.(*InsuranceFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_GetAllPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllInsuranceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).GetAllPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/GetAllPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).GetAllPolicies(ctx, req.(*GetAllInsuranceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_CancelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsuranceFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).CancelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/CancelPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).CancelPolicy(ctx, req.(*InsuranceFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_SetCoverageRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoverageRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).SetCoverageRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/SetCoverageRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).SetCoverageRule(ctx, req.(*CoverageRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_GetAllCoverageRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllInsuranceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).GetAllCoverageRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/GetAllCoverageRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).GetAllCoverageRules(ctx, req.(*GetAllInsuranceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_DeleteCoverageRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsuranceFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).DeleteCoverageRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/DeleteCoverageRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).DeleteCoverageRule(ctx, req.(*InsuranceFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_CheckEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EligibilityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).CheckEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/CheckEligibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).CheckEligibility(ctx, req.(*EligibilityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_CreateClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClaimReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).CreateClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/CreateClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).CreateClaim(ctx, req.(*CreateClaimReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_GetClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsuranceFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).GetClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/GetClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).GetClaim(ctx, req.(*InsuranceFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_GetAllClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllClaimsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).GetAllClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/GetAllClaims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).GetAllClaims(ctx, req.(*GetAllClaimsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_SetClaimStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).SetClaimStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/SetClaimStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).SetClaimStatus(ctx, req.(*ClaimStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_ExportClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportClaimsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).ExportClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/ExportClaims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).ExportClaims(ctx, req.(*ExportClaimsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InsuranceService_DownloadClaimBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsuranceFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InsuranceServiceServer).DownloadClaimBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.InsuranceService/DownloadClaimBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InsuranceServiceServer).DownloadClaimBatch(ctx, req.(*InsuranceFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _InsuranceService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "booking_service.InsuranceService",
+	HandlerType: (*InsuranceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateInsurer",
+			Handler:    _InsuranceService_CreateInsurer_Handler,
+		},
+		{
+			MethodName: "GetInsurer",
+			Handler:    _InsuranceService_GetInsurer_Handler,
+		},
+		{
+			MethodName: "GetAllInsurers",
+			Handler:    _InsuranceService_GetAllInsurers_Handler,
+		},
+		{
+			MethodName: "UpdateInsurer",
+			Handler:    _InsuranceService_UpdateInsurer_Handler,
+		},
+		{
+			MethodName: "DeleteInsurer",
+			Handler:    _InsuranceService_DeleteInsurer_Handler,
+		},
+		{
+			MethodName: "CreatePolicy",
+			Handler:    _InsuranceService_CreatePolicy_Handler,
+		},
+		{
+			MethodName: "GetPolicy",
+			Handler:    _InsuranceService_GetPolicy_Handler,
+		},
+		{
+			MethodName: "GetAllPolicies",
+			Handler:    _InsuranceService_GetAllPolicies_Handler,
+		},
+		{
+			MethodName: "CancelPolicy",
+			Handler:    _InsuranceService_CancelPolicy_Handler,
+		},
+		{
+			MethodName: "SetCoverageRule",
+			Handler:    _InsuranceService_SetCoverageRule_Handler,
+		},
+		{
+			MethodName: "GetAllCoverageRules",
+			Handler:    _InsuranceService_GetAllCoverageRules_Handler,
+		},
+		{
+			MethodName: "DeleteCoverageRule",
+			Handler:    _InsuranceService_DeleteCoverageRule_Handler,
+		},
+		{
+			MethodName: "CheckEligibility",
+			Handler:    _InsuranceService_CheckEligibility_Handler,
+		},
+		{
+			MethodName: "CreateClaim",
+			Handler:    _InsuranceService_CreateClaim_Handler,
+		},
+		{
+			MethodName: "GetClaim",
+			Handler:    _InsuranceService_GetClaim_Handler,
+		},
+		{
+			MethodName: "GetAllClaims",
+			Handler:    _InsuranceService_GetAllClaims_Handler,
+		},
+		{
+			MethodName: "SetClaimStatus",
+			Handler:    _InsuranceService_SetClaimStatus_Handler,
+		},
+		{
+			MethodName: "ExportClaims",
+			Handler:    _InsuranceService_ExportClaims_Handler,
+		},
+		{
+			MethodName: "DownloadClaimBatch",
+			Handler:    _InsuranceService_DownloadClaimBatch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking_service/insurance.proto",
+}
+
+func (m *Insurer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Insurer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Insurer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsurersType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsurersType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsurersType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Insurers) > 0 {
+		for iNdEx := len(m.Insurers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Insurers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInsurance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsurancePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsurancePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsurancePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AnnualLimit) > 0 {
+		i -= len(m.AnnualLimit)
+		copy(dAtA[i:], m.AnnualLimit)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.AnnualLimit)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ValidTo) > 0 {
+		i -= len(m.ValidTo)
+		copy(dAtA[i:], m.ValidTo)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.ValidTo)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ValidFrom) > 0 {
+		i -= len(m.ValidFrom)
+		copy(dAtA[i:], m.ValidFrom)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.ValidFrom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PolicyNumber) > 0 {
+		i -= len(m.PolicyNumber)
+		copy(dAtA[i:], m.PolicyNumber)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PolicyNumber)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InsurerId) > 0 {
+		i -= len(m.InsurerId)
+		copy(dAtA[i:], m.InsurerId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.InsurerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsurancePoliciesType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsurancePoliciesType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsurancePoliciesType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInsurance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoverageRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoverageRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoverageRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AnnualLimit) > 0 {
+		i -= len(m.AnnualLimit)
+		copy(dAtA[i:], m.AnnualLimit)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.AnnualLimit)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Copay) > 0 {
+		i -= len(m.Copay)
+		copy(dAtA[i:], m.Copay)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Copay)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Percentage != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.Percentage))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InsurerId) > 0 {
+		i -= len(m.InsurerId)
+		copy(dAtA[i:], m.InsurerId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.InsurerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoverageRulesType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoverageRulesType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoverageRulesType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInsurance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EligibilityReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EligibilityReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EligibilityReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Eligibility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eligibility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Eligibility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Copay) > 0 {
+		i -= len(m.Copay)
+		copy(dAtA[i:], m.Copay)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Copay)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Covered) > 0 {
+		i -= len(m.Covered)
+		copy(dAtA[i:], m.Covered)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Covered)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.InsurerId) > 0 {
+		i -= len(m.InsurerId)
+		copy(dAtA[i:], m.InsurerId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.InsurerId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Eligible {
+		i--
+		if m.Eligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.BatchId) > 0 {
+		i -= len(m.BatchId)
+		copy(dAtA[i:], m.BatchId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.BatchId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.StatusReason) > 0 {
+		i -= len(m.StatusReason)
+		copy(dAtA[i:], m.StatusReason)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.StatusReason)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Approved) > 0 {
+		i -= len(m.Approved)
+		copy(dAtA[i:], m.Approved)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Approved)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Copay) > 0 {
+		i -= len(m.Copay)
+		copy(dAtA[i:], m.Copay)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Copay)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Covered) > 0 {
+		i -= len(m.Covered)
+		copy(dAtA[i:], m.Covered)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Covered)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Billed) > 0 {
+		i -= len(m.Billed)
+		copy(dAtA[i:], m.Billed)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Billed)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ServiceDate) > 0 {
+		i -= len(m.ServiceDate)
+		copy(dAtA[i:], m.ServiceDate)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.ServiceDate)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.InvoiceId) > 0 {
+		i -= len(m.InvoiceId)
+		copy(dAtA[i:], m.InvoiceId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.InvoiceId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AppointmentId != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.AppointmentId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InsurerId) > 0 {
+		i -= len(m.InsurerId)
+		copy(dAtA[i:], m.InsurerId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.InsurerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceClaimsType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceClaimsType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceClaimsType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInsurance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateClaimReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateClaimReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateClaimReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppointmentId != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.AppointmentId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimStatusReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimStatusReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimStatusReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Approved) > 0 {
+		i -= len(m.Approved)
+		copy(dAtA[i:], m.Approved)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Approved)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllClaimsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllClaimsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllClaimsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BatchId) > 0 {
+		i -= len(m.BatchId)
+		copy(dAtA[i:], m.BatchId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.BatchId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InsurerId) > 0 {
+		i -= len(m.InsurerId)
+		copy(dAtA[i:], m.InsurerId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.InsurerId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportClaimsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportClaimsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportClaimsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedBy) > 0 {
+		i -= len(m.CreatedBy)
+		copy(dAtA[i:], m.CreatedBy)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.CreatedBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PeriodTo) > 0 {
+		i -= len(m.PeriodTo)
+		copy(dAtA[i:], m.PeriodTo)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PeriodTo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PeriodFrom) > 0 {
+		i -= len(m.PeriodFrom)
+		copy(dAtA[i:], m.PeriodFrom)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PeriodFrom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InsurerId) > 0 {
+		i -= len(m.InsurerId)
+		copy(dAtA[i:], m.InsurerId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.InsurerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CreatedBy) > 0 {
+		i -= len(m.CreatedBy)
+		copy(dAtA[i:], m.CreatedBy)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.CreatedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ClaimCount != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.ClaimCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PeriodTo) > 0 {
+		i -= len(m.PeriodTo)
+		copy(dAtA[i:], m.PeriodTo)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PeriodTo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PeriodFrom) > 0 {
+		i -= len(m.PeriodFrom)
+		copy(dAtA[i:], m.PeriodFrom)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PeriodFrom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InsurerId) > 0 {
+		i -= len(m.InsurerId)
+		copy(dAtA[i:], m.InsurerId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.InsurerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimExportFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimExportFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimExportFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInsurance(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFieldValueReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFieldValueReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFieldValueReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceStatusRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceStatusRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceStatusRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllInsuranceReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllInsuranceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllInsuranceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InsurerId) > 0 {
+		i -= len(m.InsurerId)
+		copy(dAtA[i:], m.InsurerId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.InsurerId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInsurance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInsurance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Insurer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InsurersType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovInsurance(uint64(m.Count))
+	}
+	if len(m.Insurers) > 0 {
+		for _, e := range m.Insurers {
+			l = e.Size()
+			n += 1 + l + sovInsurance(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InsurancePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.InsurerId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.PolicyNumber)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.ValidFrom)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.ValidTo)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.AnnualLimit)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InsurancePoliciesType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovInsurance(uint64(m.Count))
+	}
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovInsurance(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CoverageRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.InsurerId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.Percentage != 0 {
+		n += 1 + sovInsurance(uint64(m.Percentage))
+	}
+	l = len(m.Copay)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.AnnualLimit)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CoverageRulesType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovInsurance(uint64(m.Count))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovInsurance(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EligibilityReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eligibility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Eligible {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.InsurerId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Covered)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Copay)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InsuranceClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.InsurerId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.AppointmentId != 0 {
+		n += 1 + sovInsurance(uint64(m.AppointmentId))
+	}
+	l = len(m.InvoiceId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.ServiceDate)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Billed)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Covered)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Copay)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Approved)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.StatusReason)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.BatchId)
+	if l > 0 {
+		n += 2 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 2 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 2 + l + sovInsurance(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InsuranceClaimsType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovInsurance(uint64(m.Count))
+	}
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovInsurance(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateClaimReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.AppointmentId != 0 {
+		n += 1 + sovInsurance(uint64(m.AppointmentId))
+	}
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClaimStatusReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Approved)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAllClaimsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovInsurance(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovInsurance(uint64(m.Limit))
+	}
+	l = len(m.InsurerId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.BatchId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportClaimsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.InsurerId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.PeriodFrom)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.PeriodTo)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClaimBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.InsurerId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.PeriodFrom)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.PeriodTo)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.ClaimCount != 0 {
+		n += 1 + sovInsurance(uint64(m.ClaimCount))
+	}
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClaimExportFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InsuranceFieldValueReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InsuranceStatusRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAllInsuranceReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovInsurance(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovInsurance(uint64(m.Limit))
+	}
+	l = len(m.InsurerId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovInsurance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInsurance(x uint64) (n int) {
+	return sovInsurance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Insurer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Insurer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Insurer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsurersType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsurersType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsurersType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insurers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Insurers = append(m.Insurers, &Insurer{})
+			if err := m.Insurers[len(m.Insurers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsurancePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsurancePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsurancePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsurerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsurerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnualLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsurancePoliciesType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsurancePoliciesType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsurancePoliciesType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, &InsurancePolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoverageRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoverageRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoverageRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsurerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsurerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			m.Percentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percentage |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Copay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Copay = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnualLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoverageRulesType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoverageRulesType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoverageRulesType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, &CoverageRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EligibilityReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EligibilityReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EligibilityReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Eligibility) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Eligibility: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Eligibility: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Eligible = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsurerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsurerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Covered", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Covered = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Copay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Copay = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsurerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsurerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
+			}
+			m.AppointmentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppointmentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvoiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvoiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Billed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Billed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Covered", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Covered = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Copay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Copay = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approved = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceClaimsType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceClaimsType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceClaimsType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, &InsuranceClaim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateClaimReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateClaimReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateClaimReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
+			}
+			m.AppointmentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppointmentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimStatusReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimStatusReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimStatusReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approved = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllClaimsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllClaimsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllClaimsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsurerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsurerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportClaimsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportClaimsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportClaimsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsurerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsurerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsurerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsurerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimCount", wireType)
+			}
+			m.ClaimCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimExportFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimExportFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimExportFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batch == nil {
+				m.Batch = &ClaimBatch{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceFieldValueReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFieldValueReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFieldValueReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceStatusRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceStatusRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceStatusRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllInsuranceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllInsuranceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllInsuranceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsurerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsurerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInsurance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInsurance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInsurance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInsurance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInsurance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInsurance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInsurance = fmt.Errorf("proto: unexpected end of group")
+)
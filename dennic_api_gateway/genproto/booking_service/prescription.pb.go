@@ -0,0 +1,5923 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: booking_service/prescription.proto
+
+package booking_service
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Medication struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Form                 string   `protobuf:"bytes,3,opt,name=form,proto3" json:"form"`
+	Strength             string   `protobuf:"bytes,4,opt,name=strength,proto3" json:"strength"`
+	ActiveIngredient     string   `protobuf:"bytes,5,opt,name=active_ingredient,json=activeIngredient,proto3" json:"active_ingredient"`
+	CreatedAt            string   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt            string   `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Medication) Reset()         { *m = Medication{} }
+func (m *Medication) String() string { return proto.CompactTextString(m) }
+func (*Medication) ProtoMessage()    {}
+func (*Medication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{0}
+}
+func (m *Medication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Medication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Medication.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Medication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Medication.Merge(m, src)
+}
+func (m *Medication) XXX_Size() int {
+	return m.Size()
+}
+func (m *Medication) XXX_DiscardUnknown() {
+	xxx_messageInfo_Medication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Medication proto.InternalMessageInfo
+
+func (m *Medication) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Medication) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Medication) GetForm() string {
+	if m != nil {
+		return m.Form
+	}
+	return ""
+}
+
+func (m *Medication) GetStrength() string {
+	if m != nil {
+		return m.Strength
+	}
+	return ""
+}
+
+func (m *Medication) GetActiveIngredient() string {
+	if m != nil {
+		return m.ActiveIngredient
+	}
+	return ""
+}
+
+func (m *Medication) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *Medication) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *Medication) GetDeletedAt() string {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return ""
+}
+
+type GetAllMedicationsReq struct {
+	Page                 uint64   `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit                uint64   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	Search               string   `protobuf:"bytes,3,opt,name=search,proto3" json:"search"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAllMedicationsReq) Reset()         { *m = GetAllMedicationsReq{} }
+func (m *GetAllMedicationsReq) String() string { return proto.CompactTextString(m) }
+func (*GetAllMedicationsReq) ProtoMessage()    {}
+func (*GetAllMedicationsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{1}
+}
+func (m *GetAllMedicationsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllMedicationsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllMedicationsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAllMedicationsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllMedicationsReq.Merge(m, src)
+}
+func (m *GetAllMedicationsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllMedicationsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllMedicationsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllMedicationsReq proto.InternalMessageInfo
+
+func (m *GetAllMedicationsReq) GetPage() uint64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *GetAllMedicationsReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetAllMedicationsReq) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+type MedicationsType struct {
+	Count                int64         `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Medications          []*Medication `protobuf:"bytes,2,rep,name=medications,proto3" json:"medications"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *MedicationsType) Reset()         { *m = MedicationsType{} }
+func (m *MedicationsType) String() string { return proto.CompactTextString(m) }
+func (*MedicationsType) ProtoMessage()    {}
+func (*MedicationsType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{2}
+}
+func (m *MedicationsType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MedicationsType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MedicationsType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MedicationsType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MedicationsType.Merge(m, src)
+}
+func (m *MedicationsType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MedicationsType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MedicationsType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MedicationsType proto.InternalMessageInfo
+
+func (m *MedicationsType) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *MedicationsType) GetMedications() []*Medication {
+	if m != nil {
+		return m.Medications
+	}
+	return nil
+}
+
+type Interaction struct {
+	IngredientA string `protobuf:"bytes,1,opt,name=ingredient_a,json=ingredientA,proto3" json:"ingredient_a"`
+	IngredientB string `protobuf:"bytes,2,opt,name=ingredient_b,json=ingredientB,proto3" json:"ingredient_b"`
+	// mild, moderate or severe
+	Severity             string   `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Interaction) Reset()         { *m = Interaction{} }
+func (m *Interaction) String() string { return proto.CompactTextString(m) }
+func (*Interaction) ProtoMessage()    {}
+func (*Interaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{3}
+}
+func (m *Interaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Interaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Interaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Interaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Interaction.Merge(m, src)
+}
+func (m *Interaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Interaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Interaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Interaction proto.InternalMessageInfo
+
+func (m *Interaction) GetIngredientA() string {
+	if m != nil {
+		return m.IngredientA
+	}
+	return ""
+}
+
+func (m *Interaction) GetIngredientB() string {
+	if m != nil {
+		return m.IngredientB
+	}
+	return ""
+}
+
+func (m *Interaction) GetSeverity() string {
+	if m != nil {
+		return m.Severity
+	}
+	return ""
+}
+
+func (m *Interaction) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type PrescriptionItem struct {
+	MedicationId string `protobuf:"bytes,1,opt,name=medication_id,json=medicationId,proto3" json:"medication_id"`
+	// name, form, strength and active_ingredient are copied from the catalogue
+	MedicationName       string   `protobuf:"bytes,2,opt,name=medication_name,json=medicationName,proto3" json:"medication_name"`
+	Form                 string   `protobuf:"bytes,3,opt,name=form,proto3" json:"form"`
+	Strength             string   `protobuf:"bytes,4,opt,name=strength,proto3" json:"strength"`
+	ActiveIngredient     string   `protobuf:"bytes,5,opt,name=active_ingredient,json=activeIngredient,proto3" json:"active_ingredient"`
+	Dose                 string   `protobuf:"bytes,6,opt,name=dose,proto3" json:"dose"`
+	Frequency            string   `protobuf:"bytes,7,opt,name=frequency,proto3" json:"frequency"`
+	DurationDays         int32    `protobuf:"varint,8,opt,name=duration_days,json=durationDays,proto3" json:"duration_days"`
+	Route                string   `protobuf:"bytes,9,opt,name=route,proto3" json:"route"`
+	Instructions         string   `protobuf:"bytes,10,opt,name=instructions,proto3" json:"instructions"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrescriptionItem) Reset()         { *m = PrescriptionItem{} }
+func (m *PrescriptionItem) String() string { return proto.CompactTextString(m) }
+func (*PrescriptionItem) ProtoMessage()    {}
+func (*PrescriptionItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{4}
+}
+func (m *PrescriptionItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrescriptionItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrescriptionItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrescriptionItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrescriptionItem.Merge(m, src)
+}
+func (m *PrescriptionItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrescriptionItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrescriptionItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrescriptionItem proto.InternalMessageInfo
+
+func (m *PrescriptionItem) GetMedicationId() string {
+	if m != nil {
+		return m.MedicationId
+	}
+	return ""
+}
+
+func (m *PrescriptionItem) GetMedicationName() string {
+	if m != nil {
+		return m.MedicationName
+	}
+	return ""
+}
+
+func (m *PrescriptionItem) GetForm() string {
+	if m != nil {
+		return m.Form
+	}
+	return ""
+}
+
+func (m *PrescriptionItem) GetStrength() string {
+	if m != nil {
+		return m.Strength
+	}
+	return ""
+}
+
+func (m *PrescriptionItem) GetActiveIngredient() string {
+	if m != nil {
+		return m.ActiveIngredient
+	}
+	return ""
+}
+
+func (m *PrescriptionItem) GetDose() string {
+	if m != nil {
+		return m.Dose
+	}
+	return ""
+}
+
+func (m *PrescriptionItem) GetFrequency() string {
+	if m != nil {
+		return m.Frequency
+	}
+	return ""
+}
+
+func (m *PrescriptionItem) GetDurationDays() int32 {
+	if m != nil {
+		return m.DurationDays
+	}
+	return 0
+}
+
+func (m *PrescriptionItem) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *PrescriptionItem) GetInstructions() string {
+	if m != nil {
+		return m.Instructions
+	}
+	return ""
+}
+
+type PrescriptionWarning struct {
+	// allergy or interaction
+	Kind                 string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind"`
+	Severity             string   `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrescriptionWarning) Reset()         { *m = PrescriptionWarning{} }
+func (m *PrescriptionWarning) String() string { return proto.CompactTextString(m) }
+func (*PrescriptionWarning) ProtoMessage()    {}
+func (*PrescriptionWarning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{5}
+}
+func (m *PrescriptionWarning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrescriptionWarning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrescriptionWarning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrescriptionWarning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrescriptionWarning.Merge(m, src)
+}
+func (m *PrescriptionWarning) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrescriptionWarning) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrescriptionWarning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrescriptionWarning proto.InternalMessageInfo
+
+func (m *PrescriptionWarning) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *PrescriptionWarning) GetSeverity() string {
+	if m != nil {
+		return m.Severity
+	}
+	return ""
+}
+
+func (m *PrescriptionWarning) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type Prescription struct {
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	AppointmentId int64  `protobuf:"varint,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
+	// patient and doctor are taken from the appointment
+	PatientId            string                 `protobuf:"bytes,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	DoctorId             string                 `protobuf:"bytes,4,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	Items                []*PrescriptionItem    `protobuf:"bytes,5,rep,name=items,proto3" json:"items"`
+	Warnings             []*PrescriptionWarning `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings"`
+	VerificationCode     string                 `protobuf:"bytes,7,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code"`
+	Signature            string                 `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature"`
+	IssuedAt             string                 `protobuf:"bytes,9,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at"`
+	RevokedAt            string                 `protobuf:"bytes,10,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at"`
+	CreatedAt            string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *Prescription) Reset()         { *m = Prescription{} }
+func (m *Prescription) String() string { return proto.CompactTextString(m) }
+func (*Prescription) ProtoMessage()    {}
+func (*Prescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{6}
+}
+func (m *Prescription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Prescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Prescription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Prescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Prescription.Merge(m, src)
+}
+func (m *Prescription) XXX_Size() int {
+	return m.Size()
+}
+func (m *Prescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Prescription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Prescription proto.InternalMessageInfo
+
+func (m *Prescription) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Prescription) GetAppointmentId() int64 {
+	if m != nil {
+		return m.AppointmentId
+	}
+	return 0
+}
+
+func (m *Prescription) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *Prescription) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *Prescription) GetItems() []*PrescriptionItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Prescription) GetWarnings() []*PrescriptionWarning {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+func (m *Prescription) GetVerificationCode() string {
+	if m != nil {
+		return m.VerificationCode
+	}
+	return ""
+}
+
+func (m *Prescription) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *Prescription) GetIssuedAt() string {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return ""
+}
+
+func (m *Prescription) GetRevokedAt() string {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return ""
+}
+
+func (m *Prescription) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+type CreatePrescriptionReq struct {
+	AppointmentId        int64               `protobuf:"varint,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
+	Items                []*PrescriptionItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CreatePrescriptionReq) Reset()         { *m = CreatePrescriptionReq{} }
+func (m *CreatePrescriptionReq) String() string { return proto.CompactTextString(m) }
+func (*CreatePrescriptionReq) ProtoMessage()    {}
+func (*CreatePrescriptionReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{7}
+}
+func (m *CreatePrescriptionReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreatePrescriptionReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreatePrescriptionReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreatePrescriptionReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePrescriptionReq.Merge(m, src)
+}
+func (m *CreatePrescriptionReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreatePrescriptionReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePrescriptionReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePrescriptionReq proto.InternalMessageInfo
+
+func (m *CreatePrescriptionReq) GetAppointmentId() int64 {
+	if m != nil {
+		return m.AppointmentId
+	}
+	return 0
+}
+
+func (m *CreatePrescriptionReq) GetItems() []*PrescriptionItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type GetAllPrescriptionsReq struct {
+	Page                 uint64   `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit                uint64   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	PatientId            string   `protobuf:"bytes,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	DoctorId             string   `protobuf:"bytes,4,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAllPrescriptionsReq) Reset()         { *m = GetAllPrescriptionsReq{} }
+func (m *GetAllPrescriptionsReq) String() string { return proto.CompactTextString(m) }
+func (*GetAllPrescriptionsReq) ProtoMessage()    {}
+func (*GetAllPrescriptionsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{8}
+}
+func (m *GetAllPrescriptionsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllPrescriptionsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllPrescriptionsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAllPrescriptionsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllPrescriptionsReq.Merge(m, src)
+}
+func (m *GetAllPrescriptionsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllPrescriptionsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllPrescriptionsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllPrescriptionsReq proto.InternalMessageInfo
+
+func (m *GetAllPrescriptionsReq) GetPage() uint64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *GetAllPrescriptionsReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetAllPrescriptionsReq) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *GetAllPrescriptionsReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+type PrescriptionsType struct {
+	Count                int64           `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Prescriptions        []*Prescription `protobuf:"bytes,2,rep,name=prescriptions,proto3" json:"prescriptions"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PrescriptionsType) Reset()         { *m = PrescriptionsType{} }
+func (m *PrescriptionsType) String() string { return proto.CompactTextString(m) }
+func (*PrescriptionsType) ProtoMessage()    {}
+func (*PrescriptionsType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{9}
+}
+func (m *PrescriptionsType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrescriptionsType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrescriptionsType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrescriptionsType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrescriptionsType.Merge(m, src)
+}
+func (m *PrescriptionsType) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrescriptionsType) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrescriptionsType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrescriptionsType proto.InternalMessageInfo
+
+func (m *PrescriptionsType) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PrescriptionsType) GetPrescriptions() []*Prescription {
+	if m != nil {
+		return m.Prescriptions
+	}
+	return nil
+}
+
+type PrescriptionFieldValueReq struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+	IsActive             bool     `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrescriptionFieldValueReq) Reset()         { *m = PrescriptionFieldValueReq{} }
+func (m *PrescriptionFieldValueReq) String() string { return proto.CompactTextString(m) }
+func (*PrescriptionFieldValueReq) ProtoMessage()    {}
+func (*PrescriptionFieldValueReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{10}
+}
+func (m *PrescriptionFieldValueReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrescriptionFieldValueReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrescriptionFieldValueReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrescriptionFieldValueReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrescriptionFieldValueReq.Merge(m, src)
+}
+func (m *PrescriptionFieldValueReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrescriptionFieldValueReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrescriptionFieldValueReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrescriptionFieldValueReq proto.InternalMessageInfo
+
+func (m *PrescriptionFieldValueReq) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *PrescriptionFieldValueReq) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *PrescriptionFieldValueReq) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+type PrescriptionStatus struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrescriptionStatus) Reset()         { *m = PrescriptionStatus{} }
+func (m *PrescriptionStatus) String() string { return proto.CompactTextString(m) }
+func (*PrescriptionStatus) ProtoMessage()    {}
+func (*PrescriptionStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{11}
+}
+func (m *PrescriptionStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrescriptionStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrescriptionStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrescriptionStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrescriptionStatus.Merge(m, src)
+}
+func (m *PrescriptionStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrescriptionStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrescriptionStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrescriptionStatus proto.InternalMessageInfo
+
+func (m *PrescriptionStatus) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+type RenderPrescriptionReq struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// printed as the prescriber, the doctor id is printed when empty
+	DoctorName           string   `protobuf:"bytes,2,opt,name=doctor_name,json=doctorName,proto3" json:"doctor_name"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenderPrescriptionReq) Reset()         { *m = RenderPrescriptionReq{} }
+func (m *RenderPrescriptionReq) String() string { return proto.CompactTextString(m) }
+func (*RenderPrescriptionReq) ProtoMessage()    {}
+func (*RenderPrescriptionReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{12}
+}
+func (m *RenderPrescriptionReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenderPrescriptionReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenderPrescriptionReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RenderPrescriptionReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderPrescriptionReq.Merge(m, src)
+}
+func (m *RenderPrescriptionReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenderPrescriptionReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderPrescriptionReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenderPrescriptionReq proto.InternalMessageInfo
+
+func (m *RenderPrescriptionReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RenderPrescriptionReq) GetDoctorName() string {
+	if m != nil {
+		return m.DoctorName
+	}
+	return ""
+}
+
+type PrescriptionDocument struct {
+	FileName             string   `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name"`
+	Content              []byte   `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrescriptionDocument) Reset()         { *m = PrescriptionDocument{} }
+func (m *PrescriptionDocument) String() string { return proto.CompactTextString(m) }
+func (*PrescriptionDocument) ProtoMessage()    {}
+func (*PrescriptionDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{13}
+}
+func (m *PrescriptionDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrescriptionDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrescriptionDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrescriptionDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrescriptionDocument.Merge(m, src)
+}
+func (m *PrescriptionDocument) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrescriptionDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrescriptionDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrescriptionDocument proto.InternalMessageInfo
+
+func (m *PrescriptionDocument) GetFileName() string {
+	if m != nil {
+		return m.FileName
+	}
+	return ""
+}
+
+func (m *PrescriptionDocument) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+type VerifyPrescriptionReq struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyPrescriptionReq) Reset()         { *m = VerifyPrescriptionReq{} }
+func (m *VerifyPrescriptionReq) String() string { return proto.CompactTextString(m) }
+func (*VerifyPrescriptionReq) ProtoMessage()    {}
+func (*VerifyPrescriptionReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{14}
+}
+func (m *VerifyPrescriptionReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyPrescriptionReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyPrescriptionReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyPrescriptionReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyPrescriptionReq.Merge(m, src)
+}
+func (m *VerifyPrescriptionReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyPrescriptionReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyPrescriptionReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyPrescriptionReq proto.InternalMessageInfo
+
+func (m *VerifyPrescriptionReq) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type PrescriptionVerification struct {
+	Valid                bool                `protobuf:"varint,1,opt,name=valid,proto3" json:"valid"`
+	Revoked              bool                `protobuf:"varint,2,opt,name=revoked,proto3" json:"revoked"`
+	IssuedAt             string              `protobuf:"bytes,3,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at"`
+	DoctorId             string              `protobuf:"bytes,4,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	PatientInitials      string              `protobuf:"bytes,5,opt,name=patient_initials,json=patientInitials,proto3" json:"patient_initials"`
+	Items                []*PrescriptionItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *PrescriptionVerification) Reset()         { *m = PrescriptionVerification{} }
+func (m *PrescriptionVerification) String() string { return proto.CompactTextString(m) }
+func (*PrescriptionVerification) ProtoMessage()    {}
+func (*PrescriptionVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd326c7f35064b51, []int{15}
+}
+func (m *PrescriptionVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrescriptionVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrescriptionVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrescriptionVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrescriptionVerification.Merge(m, src)
+}
+func (m *PrescriptionVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrescriptionVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrescriptionVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrescriptionVerification proto.InternalMessageInfo
+
+func (m *PrescriptionVerification) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *PrescriptionVerification) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+func (m *PrescriptionVerification) GetIssuedAt() string {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return ""
+}
+
+func (m *PrescriptionVerification) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *PrescriptionVerification) GetPatientInitials() string {
+	if m != nil {
+		return m.PatientInitials
+	}
+	return ""
+}
+
+func (m *PrescriptionVerification) GetItems() []*PrescriptionItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Medication)(nil), "booking_service.Medication")
+	proto.RegisterType((*GetAllMedicationsReq)(nil), "booking_service.GetAllMedicationsReq")
+	proto.RegisterType((*MedicationsType)(nil), "booking_service.MedicationsType")
+	proto.RegisterType((*Interaction)(nil), "booking_service.Interaction")
+	proto.RegisterType((*PrescriptionItem)(nil), "booking_service.PrescriptionItem")
+	proto.RegisterType((*PrescriptionWarning)(nil), "booking_service.PrescriptionWarning")
+	proto.RegisterType((*Prescription)(nil), "booking_service.Prescription")
+	proto.RegisterType((*CreatePrescriptionReq)(nil), "booking_service.CreatePrescriptionReq")
+	proto.RegisterType((*GetAllPrescriptionsReq)(nil), "booking_service.GetAllPrescriptionsReq")
+	proto.RegisterType((*PrescriptionsType)(nil), "booking_service.PrescriptionsType")
+	proto.RegisterType((*PrescriptionFieldValueReq)(nil), "booking_service.PrescriptionFieldValueReq")
+	proto.RegisterType((*PrescriptionStatus)(nil), "booking_service.PrescriptionStatus")
+	proto.RegisterType((*RenderPrescriptionReq)(nil), "booking_service.RenderPrescriptionReq")
+	proto.RegisterType((*PrescriptionDocument)(nil), "booking_service.PrescriptionDocument")
+	proto.RegisterType((*VerifyPrescriptionReq)(nil), "booking_service.VerifyPrescriptionReq")
+	proto.RegisterType((*PrescriptionVerification)(nil), "booking_service.PrescriptionVerification")
+}
+
+func init() {
+	proto.RegisterFile("booking_service/prescription.proto", fileDescriptor_cd326c7f35064b51)
+}
+
+var fileDescriptor_cd326c7f35064b51 = []byte{
+	// 1120 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0x1b, 0xc5,
+	0x17, 0xff, 0xfb, 0x2b, 0x5d, 0x1f, 0x27, 0xb1, 0x33, 0x4d, 0xaa, 0xfd, 0xbb, 0x6d, 0x48, 0xb7,
+	0x84, 0xb6, 0x14, 0x05, 0xa9, 0x5c, 0x70, 0x85, 0x84, 0x9b, 0x88, 0x60, 0xa9, 0x05, 0xb4, 0x85,
+	0x50, 0x89, 0x4a, 0xd6, 0x66, 0x67, 0xec, 0x8e, 0x62, 0xcf, 0xba, 0xb3, 0xb3, 0xae, 0x7c, 0xc3,
+	0x3b, 0x70, 0xc7, 0x35, 0x4f, 0xc3, 0x15, 0xe2, 0x11, 0x50, 0xb8, 0x41, 0x48, 0xbc, 0x03, 0x9a,
+	0x8f, 0xdd, 0x1d, 0xef, 0x9a, 0x45, 0x01, 0x7a, 0x37, 0xe7, 0x77, 0xce, 0x9c, 0xd9, 0xf3, 0xf5,
+	0x9b, 0x59, 0xf0, 0xce, 0xa3, 0xe8, 0x82, 0xb2, 0xc9, 0x28, 0x26, 0x7c, 0x41, 0x43, 0xf2, 0xfe,
+	0x9c, 0x93, 0x38, 0xe4, 0x74, 0x2e, 0x68, 0xc4, 0x8e, 0xe6, 0x3c, 0x12, 0x11, 0xea, 0x16, 0x6c,
+	0xbc, 0xdf, 0x6b, 0x00, 0x4f, 0x09, 0xa6, 0x61, 0x20, 0xad, 0xd0, 0x36, 0xd4, 0x29, 0x76, 0x6b,
+	0x07, 0xb5, 0xfb, 0x6d, 0xbf, 0x4e, 0x31, 0x42, 0xd0, 0x64, 0xc1, 0x8c, 0xb8, 0x75, 0x85, 0xa8,
+	0xb5, 0xc4, 0xc6, 0x11, 0x9f, 0xb9, 0x0d, 0x8d, 0xc9, 0x35, 0xea, 0x83, 0x13, 0x0b, 0x4e, 0xd8,
+	0x44, 0xbc, 0x74, 0x9b, 0x0a, 0xcf, 0x64, 0xf4, 0x10, 0x76, 0x82, 0x50, 0xd0, 0x05, 0x19, 0x51,
+	0x36, 0xe1, 0x04, 0x53, 0xc2, 0x84, 0xdb, 0x52, 0x46, 0x3d, 0xad, 0x18, 0x66, 0x38, 0xba, 0x0d,
+	0x10, 0x72, 0x12, 0x08, 0x82, 0x47, 0x81, 0x70, 0x37, 0x94, 0x55, 0xdb, 0x20, 0x03, 0xa5, 0x4e,
+	0xe6, 0x38, 0x55, 0x5f, 0xd3, 0x6a, 0x83, 0x68, 0x35, 0x26, 0x53, 0x62, 0xd4, 0x8e, 0x56, 0x1b,
+	0x64, 0x20, 0xbc, 0xe7, 0xb0, 0x7b, 0x4a, 0xc4, 0x60, 0x3a, 0xcd, 0x23, 0x8e, 0x7d, 0xf2, 0x4a,
+	0x46, 0x34, 0x0f, 0x26, 0x44, 0xc5, 0xdd, 0xf4, 0xd5, 0x1a, 0xed, 0x42, 0x6b, 0x4a, 0x67, 0x54,
+	0xa8, 0xd0, 0x9b, 0xbe, 0x16, 0xd0, 0x0d, 0xd8, 0x88, 0x49, 0xc0, 0xc3, 0x97, 0x26, 0x7a, 0x23,
+	0x79, 0x63, 0xe8, 0x5a, 0x3e, 0xbf, 0x5c, 0xce, 0x95, 0x83, 0x30, 0x4a, 0x98, 0x50, 0x5e, 0x1b,
+	0xbe, 0x16, 0xd0, 0x47, 0xd0, 0x99, 0xe5, 0x86, 0x6e, 0xfd, 0xa0, 0x71, 0xbf, 0xf3, 0xe8, 0xe6,
+	0x51, 0xa1, 0x2c, 0x47, 0xb9, 0x33, 0xdf, 0xb6, 0xf7, 0xbe, 0xab, 0x41, 0x67, 0xc8, 0x04, 0xe1,
+	0x32, 0x71, 0x11, 0x43, 0x77, 0x60, 0x33, 0x4f, 0xea, 0x28, 0x30, 0x95, 0xeb, 0xe4, 0xd8, 0xa0,
+	0x60, 0x72, 0xee, 0xd6, 0x8b, 0x26, 0x8f, 0x55, 0xf5, 0xc8, 0x82, 0x70, 0x2a, 0x96, 0x26, 0xae,
+	0x4c, 0x46, 0x07, 0xd0, 0xc1, 0x79, 0x1b, 0x99, 0xe2, 0xda, 0x90, 0xf7, 0x53, 0x1d, 0x7a, 0x5f,
+	0x58, 0xad, 0x36, 0x14, 0x64, 0x86, 0xee, 0xc2, 0x56, 0xfe, 0xdd, 0xa3, 0xac, 0xa7, 0x36, 0x73,
+	0x70, 0x88, 0xd1, 0x3d, 0xe8, 0x5a, 0x46, 0x56, 0xa3, 0x6d, 0xe7, 0xf0, 0x67, 0x6f, 0xbc, 0xe5,
+	0x10, 0x34, 0x71, 0x14, 0x13, 0xd3, 0x6c, 0x6a, 0x8d, 0x6e, 0x41, 0x7b, 0xcc, 0xc9, 0xab, 0x84,
+	0xb0, 0x70, 0x99, 0xb6, 0x59, 0x06, 0xc8, 0xe0, 0x70, 0xc2, 0xf5, 0x57, 0xe3, 0x60, 0x19, 0xab,
+	0x4e, 0x6b, 0xf9, 0x9b, 0x29, 0x78, 0x12, 0x2c, 0x63, 0x59, 0x7f, 0x1e, 0x25, 0x82, 0xb8, 0x6d,
+	0xb5, 0x5d, 0x0b, 0xc8, 0x93, 0xd5, 0x88, 0x05, 0x4f, 0x42, 0xdd, 0x00, 0xa0, 0xd3, 0x62, 0x63,
+	0xde, 0x04, 0xae, 0xdb, 0xf9, 0xfc, 0x3a, 0xe0, 0x8c, 0xb2, 0x89, 0xfc, 0xce, 0x0b, 0xca, 0xd2,
+	0x4c, 0xaa, 0xf5, 0x4a, 0xe5, 0xea, 0xd5, 0x95, 0x6b, 0x94, 0x2b, 0xf7, 0x43, 0x03, 0x36, 0xed,
+	0x93, 0x4a, 0xe3, 0x7f, 0x08, 0xdb, 0xc1, 0x7c, 0x1e, 0x51, 0x26, 0x66, 0xb2, 0x79, 0x28, 0x56,
+	0x87, 0x34, 0xfc, 0x2d, 0x0b, 0x1d, 0x62, 0x39, 0x76, 0xf3, 0x40, 0x50, 0x63, 0xa2, 0x0f, 0x6a,
+	0x1b, 0x64, 0x88, 0xd1, 0x4d, 0x68, 0xe3, 0x28, 0x14, 0x11, 0x97, 0x5a, 0x53, 0x2a, 0x0d, 0x0c,
+	0x31, 0xfa, 0x10, 0x5a, 0x54, 0x90, 0x59, 0xec, 0xb6, 0xd4, 0x28, 0xdc, 0x29, 0x8d, 0x42, 0xb1,
+	0xb5, 0x7c, 0x6d, 0x8f, 0x3e, 0x06, 0xe7, 0xb5, 0xce, 0x4c, 0xec, 0x6e, 0xa8, 0xbd, 0x6f, 0x57,
+	0xee, 0x35, 0x69, 0xf4, 0xb3, 0x5d, 0xb2, 0x4b, 0x64, 0xaa, 0xc6, 0x69, 0x03, 0x86, 0x11, 0x26,
+	0xa6, 0xd8, 0x3d, 0x5b, 0x71, 0x1c, 0x61, 0xd5, 0x11, 0x31, 0x9d, 0xb0, 0x40, 0x24, 0x9c, 0xa4,
+	0xcc, 0x92, 0x01, 0x32, 0x44, 0x1a, 0xc7, 0x89, 0xe6, 0x1d, 0x5d, 0x70, 0x47, 0x03, 0x9a, 0x95,
+	0x38, 0x59, 0x44, 0x17, 0x5a, 0xab, 0x2b, 0xde, 0x36, 0xc8, 0xa0, 0x48, 0x79, 0x9d, 0x02, 0xe5,
+	0x79, 0xaf, 0x61, 0xef, 0x58, 0x09, 0x76, 0x30, 0x92, 0xb5, 0xca, 0xc5, 0xa9, 0xad, 0x2b, 0x4e,
+	0x96, 0xe0, 0xfa, 0xd5, 0x12, 0xec, 0x7d, 0x0b, 0x37, 0x34, 0x5b, 0xda, 0x06, 0x57, 0xe4, 0xcb,
+	0x7f, 0xd1, 0x19, 0x1e, 0x83, 0x9d, 0x95, 0x93, 0x2b, 0x58, 0xf5, 0x18, 0xb6, 0xec, 0xcb, 0x2e,
+	0x8d, 0xf5, 0x76, 0x65, 0xac, 0xfe, 0xea, 0x1e, 0x0f, 0xc3, 0xff, 0x6d, 0xf5, 0x27, 0x94, 0x4c,
+	0xf1, 0x59, 0x30, 0x4d, 0x88, 0x0c, 0x79, 0x17, 0x5a, 0x63, 0x09, 0x98, 0xe1, 0xd0, 0x82, 0x44,
+	0x17, 0xd2, 0xc2, 0xcc, 0x9e, 0x16, 0x74, 0x33, 0x8c, 0x34, 0xcf, 0xa8, 0x98, 0x1d, 0xd9, 0x0c,
+	0x03, 0x25, 0x7b, 0xef, 0x01, 0xb2, 0x4f, 0x79, 0x26, 0x02, 0x91, 0xc4, 0xea, 0x5e, 0x51, 0x2b,
+	0xe5, 0xdf, 0xf1, 0x8d, 0xe4, 0x7d, 0x0a, 0x7b, 0x3e, 0x61, 0x98, 0xf0, 0x62, 0xf1, 0x8b, 0x93,
+	0xfa, 0x16, 0x74, 0x4c, 0x26, 0x2d, 0x1a, 0x05, 0x0d, 0x49, 0x0a, 0xf5, 0x9e, 0xc2, 0xae, 0xed,
+	0xe3, 0x24, 0x0a, 0x13, 0xd9, 0x20, 0xf2, 0x63, 0xc7, 0x74, 0x4a, 0xf4, 0x36, 0xed, 0xcf, 0x91,
+	0x80, 0xdc, 0x84, 0x5c, 0xb8, 0x16, 0x46, 0x4c, 0x48, 0xf6, 0x94, 0x1e, 0x37, 0xfd, 0x54, 0xf4,
+	0x1e, 0xc2, 0xde, 0x99, 0x1c, 0x91, 0x65, 0xf1, 0xc3, 0x10, 0x34, 0xd5, 0x1c, 0x19, 0x96, 0x92,
+	0x6b, 0xef, 0xb7, 0x1a, 0xb8, 0xb6, 0xdd, 0x99, 0x35, 0x5c, 0x26, 0x87, 0x26, 0x18, 0xc7, 0xd7,
+	0x82, 0x3c, 0xd9, 0x4c, 0x88, 0x3a, 0xd9, 0xf1, 0x53, 0x71, 0x75, 0xd4, 0x1a, 0x85, 0x51, 0xab,
+	0xa4, 0x9a, 0x07, 0xd0, 0xcb, 0x9a, 0x91, 0x51, 0x41, 0x83, 0x69, 0x6c, 0x2e, 0x85, 0x6e, 0xda,
+	0x92, 0x06, 0xce, 0x87, 0x66, 0xe3, 0x6a, 0x43, 0xf3, 0xe8, 0x0f, 0x67, 0x95, 0xbc, 0x9f, 0x69,
+	0x7b, 0xf4, 0x04, 0x7a, 0x7a, 0x8a, 0xad, 0xc7, 0x56, 0xd5, 0xb5, 0xdf, 0xaf, 0x52, 0xa2, 0xe7,
+	0xb0, 0x75, 0x4a, 0x84, 0x05, 0xbc, 0x5b, 0xf9, 0x81, 0x2b, 0xad, 0x5c, 0xed, 0xf9, 0x05, 0xec,
+	0x94, 0x9e, 0x48, 0xe8, 0xb0, 0xb4, 0x63, 0xdd, 0x33, 0xaa, 0x7f, 0x50, 0xe1, 0x58, 0x4f, 0xef,
+	0x13, 0xe8, 0x7d, 0xa5, 0x1e, 0x6b, 0xff, 0x49, 0x16, 0x42, 0xe8, 0x9d, 0xa8, 0xb7, 0xdd, 0x3f,
+	0x4c, 0xc4, 0xdd, 0x4a, 0x5b, 0x33, 0x99, 0x9f, 0xc3, 0x8e, 0x2e, 0x9c, 0xfd, 0xec, 0xba, 0x55,
+	0xda, 0x69, 0x69, 0xfb, 0x95, 0x5a, 0xf4, 0x0d, 0xa0, 0x32, 0x9f, 0xa3, 0x77, 0x4a, 0x7b, 0xd6,
+	0x92, 0x7e, 0xbf, 0x9a, 0xd2, 0xd0, 0x0b, 0xe8, 0x9e, 0x12, 0xb1, 0x02, 0x5d, 0x25, 0x23, 0x7f,
+	0xe3, 0xfd, 0x1c, 0xae, 0xaf, 0xb9, 0x11, 0xd0, 0xbd, 0xbf, 0x68, 0x8f, 0xe2, 0xbd, 0xd1, 0xf7,
+	0x2a, 0xdd, 0xeb, 0x16, 0x19, 0x01, 0xf2, 0xd5, 0xa4, 0xbf, 0xa9, 0x20, 0x42, 0x40, 0x65, 0x4a,
+	0x5d, 0x93, 0xff, 0xb5, 0xbc, 0xdb, 0x3f, 0xac, 0x74, 0x9e, 0xb1, 0xea, 0x04, 0x50, 0x99, 0x1e,
+	0xd7, 0x1c, 0xb2, 0x96, 0x43, 0xfb, 0x0f, 0x2a, 0x0f, 0xb1, 0xd9, 0xf3, 0x71, 0xef, 0xc7, 0xcb,
+	0xfd, 0xda, 0xcf, 0x97, 0xfb, 0xb5, 0x5f, 0x2e, 0xf7, 0x6b, 0xdf, 0xff, 0xba, 0xff, 0xbf, 0xf3,
+	0x0d, 0xf5, 0xa7, 0xf7, 0xc1, 0x9f, 0x03, 0x00, 0x6c, 0xdc, 0xa5, 0xe9, 0x0f, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PrescriptionServiceClient is the client API for PrescriptionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PrescriptionServiceClient interface {
+	// medication catalogue
+	CreateMedication(ctx context.Context, in *Medication, opts ...grpc.CallOption) (*Medication, error)
+	GetMedication(ctx context.Context, in *PrescriptionFieldValueReq, opts ...grpc.CallOption) (*Medication, error)
+	GetAllMedications(ctx context.Context, in *GetAllMedicationsReq, opts ...grpc.CallOption) (*MedicationsType, error)
+	UpdateMedication(ctx context.Context, in *Medication, opts ...grpc.CallOption) (*Medication, error)
+	DeleteMedication(ctx context.Context, in *PrescriptionFieldValueReq, opts ...grpc.CallOption) (*PrescriptionStatus, error)
+	CreateInteraction(ctx context.Context, in *Interaction, opts ...grpc.CallOption) (*Interaction, error)
+	// prescriptions
+	CreatePrescription(ctx context.Context, in *CreatePrescriptionReq, opts ...grpc.CallOption) (*Prescription, error)
+	GetPrescription(ctx context.Context, in *PrescriptionFieldValueReq, opts ...grpc.CallOption) (*Prescription, error)
+	GetAllPrescriptions(ctx context.Context, in *GetAllPrescriptionsReq, opts ...grpc.CallOption) (*PrescriptionsType, error)
+	RevokePrescription(ctx context.Context, in *PrescriptionFieldValueReq, opts ...grpc.CallOption) (*Prescription, error)
+	RenderPrescription(ctx context.Context, in *RenderPrescriptionReq, opts ...grpc.CallOption) (*PrescriptionDocument, error)
+	VerifyPrescription(ctx context.Context, in *VerifyPrescriptionReq, opts ...grpc.CallOption) (*PrescriptionVerification, error)
+}
+
+type prescriptionServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPrescriptionServiceClient(cc *grpc.ClientConn) PrescriptionServiceClient {
+	return &prescriptionServiceClient{cc}
+}
+
+func (c *prescriptionServiceClient) CreateMedication(ctx context.Context, in *Medication, opts ...grpc.CallOption) (*Medication, error) {
+	out := new(Medication)
+	err := c.cc.Invoke(ctx, "/booking_service.PrescriptionService/CreateMedication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) GetMedication(ctx context.Context, in *PrescriptionFieldValueReq, opts ...grpc.CallOption) (*Medication, error) {
+	out := new(Medication)
+	err := c.cc.Invoke(ctx, "/booking_service.PrescriptionService/GetMedication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) GetAllMedications(ctx context.Context, in *GetAllMedicationsReq, opts ...grpc.CallOption) (*MedicationsType, error) {
+	out := new(MedicationsType)
+	err := c.cc.Invoke(ctx, "/booking_service.PrescriptionService/GetAllMedications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) UpdateMedication(ctx context.Context, in *Medication, opts ...grpc.CallOption) (*Medication, error) {
+	out := new(Medication)
+	err := c.cc.Invoke(ctx, "/booking_service.PrescriptionService/UpdateMedication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) DeleteMedication(ctx context.Context, in *PrescriptionFieldValueReq, opts ...grpc.CallOption) (*PrescriptionStatus, error) {
+	out := new(PrescriptionStatus)
+	err := c.cc.Invoke(ctx, "/booking_service.PrescriptionService/DeleteMedication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) CreateInteraction(ctx context.Context, in *Interaction, opts ...grpc.CallOption) (*Interaction, error) {
+	out := new(Interaction)
+	err := c.cc.Invoke(ctx, "/booking_service.PrescriptionService/CreateInteraction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) CreatePrescription(ctx context.Context, in *CreatePrescriptionReq, opts ...grpc.CallOption) (*Prescription, error) {
+	out := new(Prescription)
+	err := c.cc.Invoke(ctx, "/booking_service.PrescriptionService/CreatePrescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) GetPrescription(ctx context.Context, in *PrescriptionFieldValueReq, opts ...grpc.CallOption) (*Prescription, error) {
+	out := new(Prescription)
+	err := c.cc.Invoke(ctx, "/booking_service.PrescriptionService/GetPrescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) GetAllPrescriptions(ctx context.Context, in *GetAllPrescriptionsReq, opts ...grpc.CallOption) (*PrescriptionsType, error) {
+	out := new(PrescriptionsType)
+	err := c.cc.Invoke(ctx, "/booking_service.PrescriptionService/GetAllPrescriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) RevokePrescription(ctx context.Context, in *PrescriptionFieldValueReq, opts ...grpc.CallOption) (*Prescription, error) {
+	out := new(Prescription)
+	err := c.cc.Invoke(ctx, "/booking_service.PrescriptionService/RevokePrescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) RenderPrescription(ctx context.Context, in *RenderPrescriptionReq, opts ...grpc.CallOption) (*PrescriptionDocument, error) {
+	out := new(PrescriptionDocument)
+	err := c.cc.Invoke(ctx, "/booking_service.PrescriptionService/RenderPrescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) VerifyPrescription(ctx context.Context, in *VerifyPrescriptionReq, opts ...grpc.CallOption) (*PrescriptionVerification, error) {
+	out := new(PrescriptionVerification)
+	err := c.cc.Invoke(ctx, "/booking_service.PrescriptionService/VerifyPrescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PrescriptionServiceServer is the server API for PrescriptionService service.
+type PrescriptionServiceServer interface {
+	// medication catalogue
+	CreateMedication(context.Context, *Medication) (*Medication, error)
+	GetMedication(context.Context, *PrescriptionFieldValueReq) (*Medication, error)
+	GetAllMedications(context.Context, *GetAllMedicationsReq) (*MedicationsType, error)
+	UpdateMedication(context.Context, *Medication) (*Medication, error)
+	DeleteMedication(context.Context, *PrescriptionFieldValueReq) (*PrescriptionStatus, error)
+	CreateInteraction(context.Context, *Interaction) (*Interaction, error)
+	// prescriptions
+	CreatePrescription(context.Context, *CreatePrescriptionReq) (*Prescription, error)
+	GetPrescription(context.Context, *PrescriptionFieldValueReq) (*Prescription, error)
+	GetAllPrescriptions(context.Context, *GetAllPrescriptionsReq) (*PrescriptionsType, error)
+	RevokePrescription(context.Context, *PrescriptionFieldValueReq) (*Prescription, error)
+	RenderPrescription(context.Context, *RenderPrescriptionReq) (*PrescriptionDocument, error)
+	VerifyPrescription(context.Context, *VerifyPrescriptionReq) (*PrescriptionVerification, error)
+}
+
+// UnimplementedPrescriptionServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPrescriptionServiceServer struct {
+}
+
+func (*UnimplementedPrescriptionServiceServer) CreateMedication(ctx context.Context, req *Medication) (*Medication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMedication not implemented")
+}
+func (*UnimplementedPrescriptionServiceServer) GetMedication(ctx context.Context, req *PrescriptionFieldValueReq) (*Medication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMedication not implemented")
+}
+func (*UnimplementedPrescriptionServiceServer) GetAllMedications(ctx context.Context, req *GetAllMedicationsReq) (*MedicationsType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllMedications not implemented")
+}
+func (*UnimplementedPrescriptionServiceServer) UpdateMedication(ctx context.Context, req *Medication) (*Medication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMedication not implemented")
+}
+func (*UnimplementedPrescriptionServiceServer) DeleteMedication(ctx context.Context, req *PrescriptionFieldValueReq) (*PrescriptionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMedication not implemented")
+}
+func (*UnimplementedPrescriptionServiceServer) CreateInteraction(ctx context.Context, req *Interaction) (*Interaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInteraction not implemented")
+}
+func (*UnimplementedPrescriptionServiceServer) CreatePrescription(ctx context.Context, req *CreatePrescriptionReq) (*Prescription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePrescription not implemented")
+}
+func (*UnimplementedPrescriptionServiceServer) GetPrescription(ctx context.Context, req *PrescriptionFieldValueReq) (*Prescription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrescription not implemented")
+}
+func (*UnimplementedPrescriptionServiceServer) GetAllPrescriptions(ctx context.Context, req *GetAllPrescriptionsReq) (*PrescriptionsType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPrescriptions not implemented")
+}
+func (*UnimplementedPrescriptionServiceServer) RevokePrescription(ctx context.Context, req *PrescriptionFieldValueReq) (*Prescription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePrescription not implemented")
+}
+func (*UnimplementedPrescriptionServiceServer) RenderPrescription(ctx context.Context, req *RenderPrescriptionReq) (*PrescriptionDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderPrescription not implemented")
+}
+func (*UnimplementedPrescriptionServiceServer) VerifyPrescription(ctx context.Context, req *VerifyPrescriptionReq) (*PrescriptionVerification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPrescription not implemented")
+}
+
+func RegisterPrescriptionServiceServer(s *grpc.Server, srv PrescriptionServiceServer) {
+	s.RegisterService(&_PrescriptionService_serviceDesc, srv)
+}
+
+func _PrescriptionService_CreateMedication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Medication)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).CreateMedication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PrescriptionService/CreateMedication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).CreateMedication(ctx, req.(*Medication))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_GetMedication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrescriptionFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).GetMedication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PrescriptionService/GetMedication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).GetMedication(ctx, req.(*PrescriptionFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_GetAllMedications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllMedicationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).GetAllMedications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PrescriptionService/GetAllMedications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).GetAllMedications(ctx, req.(*GetAllMedicationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_UpdateMedication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Medication)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).UpdateMedication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PrescriptionService/UpdateMedication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).UpdateMedication(ctx, req.(*Medication))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_DeleteMedication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrescriptionFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).DeleteMedication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PrescriptionService/DeleteMedication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).DeleteMedication(ctx, req.(*PrescriptionFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_CreateInteraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Interaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).CreateInteraction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PrescriptionService/CreateInteraction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).CreateInteraction(ctx, req.(*Interaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_CreatePrescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePrescriptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).CreatePrescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PrescriptionService/CreatePrescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).CreatePrescription(ctx, req.(*CreatePrescriptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_GetPrescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrescriptionFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).GetPrescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PrescriptionService/GetPrescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).GetPrescription(ctx, req.(*PrescriptionFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_GetAllPrescriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllPrescriptionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).GetAllPrescriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PrescriptionService/GetAllPrescriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).GetAllPrescriptions(ctx, req.(*GetAllPrescriptionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_RevokePrescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrescriptionFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).RevokePrescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PrescriptionService/RevokePrescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).RevokePrescription(ctx, req.(*PrescriptionFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_RenderPrescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderPrescriptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).RenderPrescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PrescriptionService/RenderPrescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).RenderPrescription(ctx, req.(*RenderPrescriptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_VerifyPrescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPrescriptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).VerifyPrescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.PrescriptionService/VerifyPrescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).VerifyPrescription(ctx, req.(*VerifyPrescriptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PrescriptionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "booking_service.PrescriptionService",
+	HandlerType: (*PrescriptionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMedication",
+			Handler:    _PrescriptionService_CreateMedication_Handler,
+		},
+		{
+			MethodName: "GetMedication",
+			Handler:    _PrescriptionService_GetMedication_Handler,
+		},
+		{
+			MethodName: "GetAllMedications",
+			Handler:    _PrescriptionService_GetAllMedications_Handler,
+		},
+		{
+			MethodName: "UpdateMedication",
+			Handler:    _PrescriptionService_UpdateMedication_Handler,
+		},
+		{
+			MethodName: "DeleteMedication",
+			Handler:    _PrescriptionService_DeleteMedication_Handler,
+		},
+		{
+			MethodName: "CreateInteraction",
+			Handler:    _PrescriptionService_CreateInteraction_Handler,
+		},
+		{
+			MethodName: "CreatePrescription",
+			Handler:    _PrescriptionService_CreatePrescription_Handler,
+		},
+		{
+			MethodName: "GetPrescription",
+			Handler:    _PrescriptionService_GetPrescription_Handler,
+		},
+		{
+			MethodName: "GetAllPrescriptions",
+			Handler:    _PrescriptionService_GetAllPrescriptions_Handler,
+		},
+		{
+			MethodName: "RevokePrescription",
+			Handler:    _PrescriptionService_RevokePrescription_Handler,
+		},
+		{
+			MethodName: "RenderPrescription",
+			Handler:    _PrescriptionService_RenderPrescription_Handler,
+		},
+		{
+			MethodName: "VerifyPrescription",
+			Handler:    _PrescriptionService_VerifyPrescription_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking_service/prescription.proto",
+}
+
+func (m *Medication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Medication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Medication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ActiveIngredient) > 0 {
+		i -= len(m.ActiveIngredient)
+		copy(dAtA[i:], m.ActiveIngredient)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.ActiveIngredient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Strength) > 0 {
+		i -= len(m.Strength)
+		copy(dAtA[i:], m.Strength)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Strength)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Form) > 0 {
+		i -= len(m.Form)
+		copy(dAtA[i:], m.Form)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Form)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllMedicationsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllMedicationsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllMedicationsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Search) > 0 {
+		i -= len(m.Search)
+		copy(dAtA[i:], m.Search)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Search)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintPrescription(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintPrescription(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MedicationsType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MedicationsType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MedicationsType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Medications) > 0 {
+		for iNdEx := len(m.Medications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Medications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPrescription(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintPrescription(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Interaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Interaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Interaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Severity) > 0 {
+		i -= len(m.Severity)
+		copy(dAtA[i:], m.Severity)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Severity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IngredientB) > 0 {
+		i -= len(m.IngredientB)
+		copy(dAtA[i:], m.IngredientB)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.IngredientB)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IngredientA) > 0 {
+		i -= len(m.IngredientA)
+		copy(dAtA[i:], m.IngredientA)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.IngredientA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrescriptionItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrescriptionItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrescriptionItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Instructions) > 0 {
+		i -= len(m.Instructions)
+		copy(dAtA[i:], m.Instructions)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Instructions)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DurationDays != 0 {
+		i = encodeVarintPrescription(dAtA, i, uint64(m.DurationDays))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Frequency) > 0 {
+		i -= len(m.Frequency)
+		copy(dAtA[i:], m.Frequency)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Frequency)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Dose) > 0 {
+		i -= len(m.Dose)
+		copy(dAtA[i:], m.Dose)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Dose)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ActiveIngredient) > 0 {
+		i -= len(m.ActiveIngredient)
+		copy(dAtA[i:], m.ActiveIngredient)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.ActiveIngredient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Strength) > 0 {
+		i -= len(m.Strength)
+		copy(dAtA[i:], m.Strength)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Strength)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Form) > 0 {
+		i -= len(m.Form)
+		copy(dAtA[i:], m.Form)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Form)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MedicationName) > 0 {
+		i -= len(m.MedicationName)
+		copy(dAtA[i:], m.MedicationName)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.MedicationName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MedicationId) > 0 {
+		i -= len(m.MedicationId)
+		copy(dAtA[i:], m.MedicationId)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.MedicationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrescriptionWarning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrescriptionWarning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrescriptionWarning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Severity) > 0 {
+		i -= len(m.Severity)
+		copy(dAtA[i:], m.Severity)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Severity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Prescription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Prescription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Prescription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.RevokedAt) > 0 {
+		i -= len(m.RevokedAt)
+		copy(dAtA[i:], m.RevokedAt)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.RevokedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.IssuedAt) > 0 {
+		i -= len(m.IssuedAt)
+		copy(dAtA[i:], m.IssuedAt)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.IssuedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.VerificationCode) > 0 {
+		i -= len(m.VerificationCode)
+		copy(dAtA[i:], m.VerificationCode)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.VerificationCode)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Warnings) > 0 {
+		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Warnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPrescription(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPrescription(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppointmentId != 0 {
+		i = encodeVarintPrescription(dAtA, i, uint64(m.AppointmentId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreatePrescriptionReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePrescriptionReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatePrescriptionReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPrescription(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AppointmentId != 0 {
+		i = encodeVarintPrescription(dAtA, i, uint64(m.AppointmentId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllPrescriptionsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllPrescriptionsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllPrescriptionsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintPrescription(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintPrescription(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrescriptionsType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrescriptionsType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrescriptionsType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Prescriptions) > 0 {
+		for iNdEx := len(m.Prescriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prescriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPrescription(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintPrescription(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrescriptionFieldValueReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrescriptionFieldValueReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrescriptionFieldValueReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrescriptionStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrescriptionStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrescriptionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenderPrescriptionReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenderPrescriptionReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenderPrescriptionReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DoctorName) > 0 {
+		i -= len(m.DoctorName)
+		copy(dAtA[i:], m.DoctorName)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.DoctorName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrescriptionDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrescriptionDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrescriptionDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyPrescriptionReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyPrescriptionReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyPrescriptionReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrescriptionVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrescriptionVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrescriptionVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPrescription(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PatientInitials) > 0 {
+		i -= len(m.PatientInitials)
+		copy(dAtA[i:], m.PatientInitials)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.PatientInitials)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IssuedAt) > 0 {
+		i -= len(m.IssuedAt)
+		copy(dAtA[i:], m.IssuedAt)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.IssuedAt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPrescription(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPrescription(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Medication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Form)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Strength)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.ActiveIngredient)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAllMedicationsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovPrescription(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPrescription(uint64(m.Limit))
+	}
+	l = len(m.Search)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MedicationsType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovPrescription(uint64(m.Count))
+	}
+	if len(m.Medications) > 0 {
+		for _, e := range m.Medications {
+			l = e.Size()
+			n += 1 + l + sovPrescription(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Interaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IngredientA)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.IngredientB)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Severity)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrescriptionItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MedicationId)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.MedicationName)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Form)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Strength)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.ActiveIngredient)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Dose)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Frequency)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.DurationDays != 0 {
+		n += 1 + sovPrescription(uint64(m.DurationDays))
+	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Instructions)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrescriptionWarning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Severity)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Prescription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.AppointmentId != 0 {
+		n += 1 + sovPrescription(uint64(m.AppointmentId))
+	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovPrescription(uint64(l))
+		}
+	}
+	if len(m.Warnings) > 0 {
+		for _, e := range m.Warnings {
+			l = e.Size()
+			n += 1 + l + sovPrescription(uint64(l))
+		}
+	}
+	l = len(m.VerificationCode)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.IssuedAt)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.RevokedAt)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreatePrescriptionReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppointmentId != 0 {
+		n += 1 + sovPrescription(uint64(m.AppointmentId))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovPrescription(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAllPrescriptionsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovPrescription(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPrescription(uint64(m.Limit))
+	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrescriptionsType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovPrescription(uint64(m.Count))
+	}
+	if len(m.Prescriptions) > 0 {
+		for _, e := range m.Prescriptions {
+			l = e.Size()
+			n += 1 + l + sovPrescription(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrescriptionFieldValueReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrescriptionStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenderPrescriptionReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.DoctorName)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrescriptionDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyPrescriptionReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrescriptionVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if m.Revoked {
+		n += 2
+	}
+	l = len(m.IssuedAt)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.PatientInitials)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovPrescription(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPrescription(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPrescription(x uint64) (n int) {
+	return sovPrescription(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Medication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Medication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Medication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Form", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Form = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strength", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strength = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveIngredient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveIngredient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllMedicationsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllMedicationsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllMedicationsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MedicationsType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MedicationsType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MedicationsType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Medications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Medications = append(m.Medications, &Medication{})
+			if err := m.Medications[len(m.Medications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Interaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Interaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Interaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IngredientA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IngredientA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IngredientB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IngredientB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Severity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrescriptionItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrescriptionItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrescriptionItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedicationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MedicationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedicationName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MedicationName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Form", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Form = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strength", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strength = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveIngredient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveIngredient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dose = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Frequency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationDays", wireType)
+			}
+			m.DurationDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationDays |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instructions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instructions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrescriptionWarning) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrescriptionWarning: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrescriptionWarning: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Severity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Prescription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Prescription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Prescription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
+			}
+			m.AppointmentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppointmentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &PrescriptionItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, &PrescriptionWarning{})
+			if err := m.Warnings[len(m.Warnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreatePrescriptionReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePrescriptionReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePrescriptionReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
+			}
+			m.AppointmentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppointmentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &PrescriptionItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllPrescriptionsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllPrescriptionsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllPrescriptionsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrescriptionsType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrescriptionsType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrescriptionsType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prescriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prescriptions = append(m.Prescriptions, &Prescription{})
+			if err := m.Prescriptions[len(m.Prescriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrescriptionFieldValueReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrescriptionFieldValueReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrescriptionFieldValueReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrescriptionStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrescriptionStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrescriptionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenderPrescriptionReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenderPrescriptionReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenderPrescriptionReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrescriptionDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrescriptionDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrescriptionDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyPrescriptionReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyPrescriptionReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyPrescriptionReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrescriptionVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrescriptionVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrescriptionVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientInitials", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientInitials = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &PrescriptionItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPrescription(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPrescription
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPrescription
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPrescription
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPrescription        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPrescription          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPrescription = fmt.Errorf("proto: unexpected end of group")
+)
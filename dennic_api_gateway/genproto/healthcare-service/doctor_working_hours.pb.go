@@ -206,6 +206,7 @@ type GetAllDoctorWorkingHoursReq struct {
 	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value"`
 	OrderBy              string   `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
 	IsActive             bool     `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	BranchId             string   `protobuf:"bytes,7,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -286,6 +287,13 @@ func (m *GetAllDoctorWorkingHoursReq) GetIsActive() bool {
 	return false
 }
 
+func (m *GetAllDoctorWorkingHoursReq) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
 type DoctorWorkingHours struct {
 	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
 	DoctorId             string   `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
@@ -295,6 +303,8 @@ type DoctorWorkingHours struct {
 	CreatedAt            string   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string   `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	DeletedAt            string   `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	BranchId             string   `protobuf:"bytes,9,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
+	RoomId               string   `protobuf:"bytes,10,opt,name=room_id,json=roomId,proto3" json:"room_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -389,6 +399,20 @@ func (m *DoctorWorkingHours) GetDeletedAt() string {
 	return ""
 }
 
+func (m *DoctorWorkingHours) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
+func (m *DoctorWorkingHours) GetRoomId() string {
+	if m != nil {
+		return m.RoomId
+	}
+	return ""
+}
+
 type ListDoctorWorkingHours struct {
 	Dwh                  []*DoctorWorkingHours `protobuf:"bytes,1,rep,name=dwh,proto3" json:"dwh"`
 	Count                int32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
@@ -458,43 +482,45 @@ func init() {
 }
 
 var fileDescriptor_2f24b76898b6e348 = []byte{
-	// 569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x51, 0x6e, 0xda, 0x4c,
-	0x10, 0xfe, 0x8d, 0x03, 0xb1, 0x07, 0xfd, 0x6d, 0xb5, 0x4a, 0x23, 0x93, 0x28, 0x14, 0x59, 0x55,
-	0xcb, 0x4b, 0xa8, 0x44, 0x4f, 0x00, 0x45, 0x22, 0x48, 0x95, 0x2a, 0x39, 0x54, 0xbc, 0xc5, 0x5d,
-	0xbc, 0x03, 0x5e, 0x61, 0x30, 0x59, 0x2f, 0x20, 0xde, 0x7a, 0x8c, 0xde, 0xa1, 0x17, 0xe9, 0x63,
-	0x8f, 0x50, 0xd1, 0x23, 0xf4, 0x02, 0x95, 0x77, 0x9d, 0x02, 0x89, 0x51, 0xfa, 0xd2, 0x37, 0xcf,
-	0xf7, 0xcd, 0xcc, 0xce, 0x7c, 0x9f, 0x77, 0xe1, 0x32, 0x44, 0x1a, 0xc9, 0x30, 0xa0, 0x02, 0x2f,
-	0x13, 0x14, 0x4b, 0x1e, 0xe0, 0x1b, 0x16, 0x07, 0x32, 0x16, 0xfe, 0x2a, 0x16, 0x13, 0x3e, 0x1b,
-	0xfb, 0x61, 0xbc, 0x10, 0x49, 0x63, 0x2e, 0x62, 0x19, 0x13, 0xd8, 0xa6, 0xbb, 0x7d, 0xb0, 0xbb,
-	0x28, 0x3d, 0xbc, 0xed, 0xcd, 0x24, 0x39, 0x81, 0xe2, 0x88, 0x63, 0xc4, 0x1c, 0xa3, 0x66, 0xd4,
-	0x6d, 0x4f, 0x07, 0x29, 0xba, 0xa4, 0xd1, 0x02, 0x9d, 0x82, 0x46, 0x55, 0x40, 0xce, 0xc1, 0xe6,
-	0x89, 0x4f, 0x03, 0xc9, 0x97, 0xe8, 0x98, 0x35, 0xa3, 0x6e, 0x79, 0x16, 0x4f, 0x5a, 0x2a, 0x76,
-	0x3f, 0x81, 0xdd, 0xc1, 0x28, 0xeb, 0xfa, 0x04, 0x0a, 0x5c, 0xb7, 0x2c, 0x7a, 0x05, 0xce, 0xf6,
-	0x2b, 0x0b, 0xfb, 0x95, 0xe4, 0x15, 0x3c, 0xe5, 0x89, 0x1f, 0x52, 0xc1, 0x7c, 0x86, 0x11, 0x4a,
-	0x64, 0x59, 0xf3, 0xff, 0x79, 0x72, 0x45, 0x05, 0xeb, 0x68, 0xd0, 0x6d, 0x82, 0x73, 0x2d, 0xa9,
-	0x5c, 0x24, 0x1d, 0xb5, 0xe7, 0x40, 0xaf, 0x79, 0x95, 0x6e, 0x49, 0x4e, 0xa1, 0x94, 0x28, 0x4e,
-	0x1d, 0x6a, 0x79, 0x59, 0xe4, 0x7e, 0x35, 0xe0, 0xbc, 0x8b, 0xb2, 0x15, 0x45, 0x0f, 0x8b, 0x3c,
-	0xbc, 0x25, 0x04, 0x8e, 0xe6, 0x74, 0x8c, 0xaa, 0xca, 0xf4, 0xd4, 0x77, 0xba, 0x7c, 0xc4, 0xa7,
-	0x5c, 0xaa, 0x41, 0x4d, 0x4f, 0x07, 0x5b, 0xa1, 0xcc, 0x5c, 0xa1, 0x8e, 0x76, 0x85, 0xaa, 0x80,
-	0x15, 0x0b, 0x86, 0xc2, 0x1f, 0xae, 0x9d, 0xa2, 0x22, 0x8e, 0x55, 0xdc, 0x5e, 0xef, 0x2b, 0x51,
-	0xba, 0xa7, 0xe1, 0xe7, 0x02, 0x9c, 0x74, 0x72, 0x4c, 0xcc, 0xd3, 0x33, 0x33, 0x9b, 0xb3, 0xcc,
-	0x23, 0x4b, 0x03, 0x3d, 0x46, 0xaa, 0x50, 0x66, 0x74, 0xed, 0xc7, 0x23, 0x7f, 0x85, 0x38, 0xc9,
-	0xe6, 0xb5, 0x19, 0x5d, 0x7f, 0x18, 0x0d, 0x10, 0x27, 0xe4, 0x02, 0x20, 0x91, 0x54, 0x48, 0x5f,
-	0xf2, 0xe9, 0xdd, 0xe0, 0xb6, 0x42, 0xfa, 0x7c, 0x8a, 0xe4, 0x05, 0x94, 0x47, 0x7c, 0xc6, 0x93,
-	0x50, 0xf3, 0x7a, 0x7e, 0xd0, 0x90, 0x4a, 0xb8, 0x00, 0x08, 0x04, 0x52, 0x89, 0xcc, 0xa7, 0x52,
-	0xed, 0x60, 0x7b, 0x76, 0x86, 0xb4, 0x64, 0x4a, 0x2f, 0xe6, 0xec, 0x8e, 0x3e, 0xd6, 0x74, 0x86,
-	0x68, 0x3a, 0x73, 0x39, 0xa5, 0xad, 0x6c, 0x38, 0x8d, 0xb4, 0xa4, 0x3b, 0x84, 0xd3, 0xf7, 0x3c,
-	0x91, 0x39, 0x16, 0x37, 0xc1, 0x64, 0xab, 0xd0, 0x31, 0x6a, 0x66, 0xbd, 0xdc, 0xac, 0x35, 0xb6,
-	0x3f, 0x74, 0x23, 0x4f, 0x32, 0x2f, 0x4d, 0x4e, 0xed, 0x09, 0xe2, 0xc5, 0x4c, 0x5b, 0x59, 0xf4,
-	0x74, 0xd0, 0xfc, 0x65, 0x42, 0xe5, 0xe1, 0x01, 0xd7, 0xfa, 0x16, 0x91, 0x1b, 0x70, 0xde, 0xa9,
-	0x65, 0x72, 0x66, 0x78, 0xf4, 0xd8, 0xb3, 0x47, 0x33, 0x48, 0x1f, 0x2a, 0x5d, 0xcc, 0x59, 0xb0,
-	0xbd, 0xee, 0x31, 0xf2, 0x7c, 0xb7, 0xfc, 0xcf, 0x2d, 0xfd, 0x8b, 0xae, 0x63, 0x70, 0x0e, 0xfd,
-	0xe7, 0xe4, 0xf5, 0xbd, 0xa6, 0x87, 0x6e, 0xc3, 0x99, 0xbb, 0x9b, 0x78, 0xc0, 0x86, 0x1b, 0x70,
-	0x3e, 0x2a, 0x33, 0xff, 0x91, 0x3c, 0x03, 0x70, 0xf4, 0x85, 0xcf, 0xe9, 0x7f, 0x40, 0x9d, 0x97,
-	0xbb, 0xf0, 0xa1, 0x27, 0xa2, 0xfd, 0xec, 0xdb, 0xa6, 0x6a, 0x7c, 0xdf, 0x54, 0x8d, 0x1f, 0x9b,
-	0xaa, 0xf1, 0xe5, 0x67, 0xf5, 0xbf, 0x61, 0x49, 0xbd, 0x8d, 0x6f, 0x7f, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x54, 0xd2, 0xbf, 0x70, 0x4c, 0x05, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x5d, 0x6e, 0xda, 0x40,
+	0x10, 0xae, 0x71, 0x20, 0xf6, 0x44, 0xfd, 0xd1, 0x2a, 0x4d, 0x9d, 0xa0, 0x50, 0x64, 0x55, 0x2d,
+	0x2f, 0xa1, 0x12, 0x3d, 0x01, 0x14, 0x89, 0x20, 0x55, 0xaa, 0xe4, 0x50, 0xf1, 0x16, 0x77, 0xf1,
+	0x0e, 0x78, 0x85, 0xc1, 0x64, 0xbd, 0x80, 0xb8, 0x49, 0x4f, 0xd2, 0x33, 0xe4, 0xb1, 0x47, 0xa8,
+	0xe8, 0x11, 0x7a, 0x81, 0xca, 0xbb, 0x4e, 0x81, 0xc4, 0x28, 0x7d, 0xe9, 0x9b, 0xe7, 0xfb, 0x66,
+	0xc6, 0xfb, 0x7d, 0x3b, 0xb3, 0x70, 0x11, 0x22, 0x8d, 0x64, 0x18, 0x50, 0x81, 0x17, 0x09, 0x8a,
+	0x05, 0x0f, 0xf0, 0x3d, 0x8b, 0x03, 0x19, 0x0b, 0x7f, 0x19, 0x8b, 0x31, 0x9f, 0x8e, 0xfc, 0x30,
+	0x9e, 0x8b, 0xa4, 0x3e, 0x13, 0xb1, 0x8c, 0x09, 0x6c, 0xd2, 0xdd, 0x1e, 0xd8, 0x1d, 0x94, 0x1e,
+	0xde, 0x74, 0xa7, 0x92, 0x1c, 0x43, 0x71, 0xc8, 0x31, 0x62, 0x8e, 0x51, 0x35, 0x6a, 0xb6, 0xa7,
+	0x83, 0x14, 0x5d, 0xd0, 0x68, 0x8e, 0x4e, 0x41, 0xa3, 0x2a, 0x20, 0x65, 0xb0, 0x79, 0xe2, 0xd3,
+	0x40, 0xf2, 0x05, 0x3a, 0x66, 0xd5, 0xa8, 0x59, 0x9e, 0xc5, 0x93, 0xa6, 0x8a, 0xdd, 0xaf, 0x60,
+	0xb7, 0x31, 0xca, 0xba, 0x3e, 0x83, 0x02, 0xd7, 0x2d, 0x8b, 0x5e, 0x81, 0xb3, 0xdd, 0xca, 0xc2,
+	0x6e, 0x25, 0x79, 0x0b, 0xcf, 0x79, 0xe2, 0x87, 0x54, 0x30, 0x9f, 0x61, 0x84, 0x12, 0x59, 0xd6,
+	0xfc, 0x29, 0x4f, 0x2e, 0xa9, 0x60, 0x6d, 0x0d, 0xba, 0x0d, 0x70, 0xae, 0x24, 0x95, 0xf3, 0xa4,
+	0xad, 0x74, 0xf6, 0xb5, 0xcc, 0xcb, 0x54, 0x25, 0x39, 0x81, 0x52, 0xa2, 0x38, 0xf5, 0x53, 0xcb,
+	0xcb, 0x22, 0xf7, 0xd6, 0x80, 0x72, 0x07, 0x65, 0x33, 0x8a, 0x1e, 0x16, 0x79, 0x78, 0x43, 0x08,
+	0x1c, 0xcc, 0xe8, 0x08, 0x55, 0x95, 0xe9, 0xa9, 0xef, 0x54, 0x7c, 0xc4, 0x27, 0x5c, 0xaa, 0x83,
+	0x9a, 0x9e, 0x0e, 0x36, 0x46, 0x99, 0xb9, 0x46, 0x1d, 0x6c, 0x1b, 0x75, 0x0a, 0x56, 0x2c, 0x18,
+	0x0a, 0x7f, 0xb0, 0x72, 0x8a, 0x8a, 0x38, 0x54, 0x71, 0x6b, 0xb5, 0xeb, 0x44, 0xe9, 0x9e, 0x13,
+	0x65, 0xb0, 0x07, 0x82, 0x4e, 0x83, 0xd0, 0xe7, 0xcc, 0x39, 0x54, 0x85, 0x96, 0x06, 0xba, 0xcc,
+	0xfd, 0x5e, 0x80, 0xe3, 0x76, 0xce, 0x0d, 0xe7, 0x99, 0x9d, 0x4d, 0x02, 0x67, 0xd9, 0x05, 0x5a,
+	0x1a, 0xe8, 0x32, 0x52, 0x81, 0x23, 0x46, 0x57, 0x7e, 0x3c, 0xf4, 0x97, 0x88, 0xe3, 0x4c, 0x8c,
+	0xcd, 0xe8, 0xea, 0xf3, 0xb0, 0x8f, 0x38, 0x26, 0xe7, 0x00, 0x89, 0xa4, 0x42, 0xfa, 0x92, 0x4f,
+	0xee, 0x54, 0xd9, 0x0a, 0xe9, 0xf1, 0x09, 0x92, 0xd7, 0x70, 0x34, 0xe4, 0x53, 0x9e, 0x84, 0x9a,
+	0xd7, 0xe2, 0x40, 0x43, 0x2a, 0xe1, 0x1c, 0x20, 0x10, 0x48, 0x25, 0x32, 0x9f, 0x4a, 0x25, 0xd0,
+	0xf6, 0xec, 0x0c, 0x69, 0xca, 0x94, 0x9e, 0xcf, 0xd8, 0x1d, 0xad, 0x25, 0xda, 0x19, 0xa2, 0xe9,
+	0x6c, 0x04, 0x52, 0xda, 0xca, 0x0e, 0xa7, 0x91, 0xa6, 0xdc, 0xf5, 0xc7, 0xde, 0xf5, 0x87, 0xbc,
+	0x82, 0x43, 0x11, 0xc7, 0x93, 0x94, 0x02, 0x45, 0x95, 0xd2, 0xb0, 0xcb, 0xdc, 0x01, 0x9c, 0x7c,
+	0xe2, 0x89, 0xcc, 0x99, 0x9a, 0x06, 0x98, 0x6c, 0x19, 0x3a, 0x46, 0xd5, 0xac, 0x1d, 0x35, 0xaa,
+	0xf5, 0xcd, 0x8e, 0xd4, 0xf3, 0x8c, 0xf6, 0xd2, 0xe4, 0xf4, 0xc6, 0x83, 0x78, 0x3e, 0xd5, 0xd3,
+	0x51, 0xf4, 0x74, 0xd0, 0xf8, 0x6d, 0xc2, 0xe9, 0xc3, 0x1f, 0x5c, 0xe9, 0xc5, 0x24, 0xd7, 0xe0,
+	0x7c, 0x54, 0x16, 0xe4, 0x9c, 0xe1, 0xd1, 0xdf, 0x9e, 0x3d, 0x9a, 0x41, 0x7a, 0x70, 0xda, 0xc1,
+	0x1c, 0x81, 0xad, 0x55, 0x97, 0x91, 0x97, 0xdb, 0xe5, 0x7f, 0x17, 0xff, 0x1f, 0xba, 0x8e, 0xc0,
+	0xd9, 0xb7, 0x3a, 0xe4, 0xdd, 0xbd, 0xa6, 0xfb, 0x16, 0xec, 0xcc, 0xdd, 0x4e, 0xdc, 0x73, 0x0d,
+	0xd7, 0xe0, 0x7c, 0x51, 0x23, 0xf0, 0x9f, 0xec, 0xe9, 0x83, 0xa3, 0xdf, 0x90, 0x9c, 0xfe, 0x7b,
+	0xdc, 0x79, 0xb3, 0x0d, 0xef, 0x7b, 0x75, 0x5a, 0x2f, 0x6e, 0xd7, 0x15, 0xe3, 0xc7, 0xba, 0x62,
+	0xfc, 0x5c, 0x57, 0x8c, 0x6f, 0xbf, 0x2a, 0x4f, 0x06, 0x25, 0xf5, 0xdc, 0x7e, 0xf8, 0x33, 0x00,
+	0x97, 0x04, 0x31, 0x76, 0x9f, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -885,6 +911,13 @@ func (m *GetAllDoctorWorkingHoursReq) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDoctorWorkingHours(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.IsActive {
 		i--
 		if m.IsActive {
@@ -953,6 +986,20 @@ func (m *DoctorWorkingHours) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RoomId) > 0 {
+		i -= len(m.RoomId)
+		copy(dAtA[i:], m.RoomId)
+		i = encodeVarintDoctorWorkingHours(dAtA, i, uint64(len(m.RoomId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDoctorWorkingHours(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DeletedAt) > 0 {
 		i -= len(m.DeletedAt)
 		copy(dAtA[i:], m.DeletedAt)
@@ -1153,6 +1200,10 @@ func (m *GetAllDoctorWorkingHoursReq) Size() (n int) {
 	if m.IsActive {
 		n += 2
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovDoctorWorkingHours(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1196,6 +1247,14 @@ func (m *DoctorWorkingHours) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDoctorWorkingHours(uint64(l))
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovDoctorWorkingHours(uint64(l))
+	}
+	l = len(m.RoomId)
+	if l > 0 {
+		n += 1 + l + sovDoctorWorkingHours(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1728,6 +1787,38 @@ func (m *GetAllDoctorWorkingHoursReq) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsActive = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorWorkingHours
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorWorkingHours
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorWorkingHours
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctorWorkingHours(dAtA[iNdEx:])
@@ -2022,6 +2113,70 @@ func (m *DoctorWorkingHours) Unmarshal(dAtA []byte) error {
 			}
 			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorWorkingHours
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorWorkingHours
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorWorkingHours
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorWorkingHours
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorWorkingHours
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorWorkingHours
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctorWorkingHours(dAtA[iNdEx:])
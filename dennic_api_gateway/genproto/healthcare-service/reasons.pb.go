@@ -246,6 +246,7 @@ type GetAllReas struct {
 	Field                string   `protobuf:"bytes,4,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,5,opt,name=value,proto3" json:"value"`
 	OrderBy              string   `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
+	BranchId             string   `protobuf:"bytes,7,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -326,6 +327,13 @@ func (m *GetAllReas) GetOrderBy() string {
 	return ""
 }
 
+func (m *GetAllReas) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
 type StatusReasons struct {
 	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -384,37 +392,38 @@ func init() {
 func init() { proto.RegisterFile("healthcare-service/reasons.proto", fileDescriptor_0a511642d7e1e60c) }
 
 var fileDescriptor_0a511642d7e1e60c = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0x69, 0x9d, 0x38, 0x53, 0x39, 0x0a, 0x0b, 0x02, 0xb7, 0x40, 0x14, 0xf9, 0x42, 0x24,
-	0xd4, 0x20, 0x95, 0x53, 0x6f, 0x24, 0x45, 0x2a, 0x95, 0x38, 0x6d, 0xd4, 0x13, 0x07, 0x6b, 0x6b,
-	0x0f, 0xed, 0x4a, 0x9b, 0x38, 0xac, 0xd7, 0x91, 0xc2, 0x93, 0x20, 0x9e, 0x88, 0x23, 0x07, 0x24,
-	0xae, 0x28, 0xbc, 0x08, 0xf2, 0x78, 0x97, 0xb8, 0x55, 0x54, 0x89, 0x9b, 0xbf, 0x9f, 0x99, 0xec,
-	0x7c, 0x33, 0x81, 0xe1, 0x0d, 0x0a, 0x65, 0x6e, 0x52, 0xa1, 0xf1, 0xb8, 0x40, 0xbd, 0x92, 0x29,
-	0xbe, 0xd6, 0x28, 0x8a, 0x7c, 0x51, 0x8c, 0x97, 0x3a, 0x37, 0x39, 0x83, 0xad, 0x23, 0xfe, 0x08,
-	0xfd, 0x73, 0x34, 0x1c, 0x3f, 0xcf, 0x8c, 0xe6, 0xb5, 0x8b, 0x3d, 0x06, 0xff, 0x93, 0x44, 0x95,
-	0x45, 0xde, 0xd0, 0x1b, 0x75, 0x79, 0x0d, 0x2a, 0x76, 0x25, 0x54, 0x89, 0x51, 0xab, 0x66, 0x09,
-	0xb0, 0x67, 0xd0, 0x95, 0x45, 0x22, 0x52, 0x23, 0x57, 0x18, 0xed, 0x0d, 0xbd, 0x51, 0xc0, 0x03,
-	0x59, 0x4c, 0x08, 0xc7, 0x3f, 0x3d, 0xe8, 0xb8, 0xa6, 0x3d, 0x68, 0x49, 0xd7, 0xb1, 0x25, 0x33,
-	0xc6, 0x60, 0x7f, 0x21, 0xe6, 0xae, 0x1b, 0x7d, 0xb3, 0x57, 0xf0, 0xb0, 0x58, 0x62, 0x2a, 0x85,
-	0x92, 0x5f, 0x84, 0x91, 0xf9, 0x22, 0x91, 0x19, 0x35, 0xed, 0xf2, 0xfe, 0x6d, 0xe1, 0x22, 0xa3,
-	0x5f, 0x9e, 0x8b, 0x6b, 0x4c, 0x4a, 0xad, 0xa2, 0x7d, 0x32, 0x05, 0x44, 0x5c, 0x6a, 0xc5, 0x5e,
-	0x00, 0xa4, 0x1a, 0x85, 0xc1, 0x2c, 0x11, 0x26, 0xf2, 0x49, 0xed, 0x5a, 0x66, 0x62, 0x2a, 0xb9,
-	0x5c, 0x66, 0x4e, 0x6e, 0xd7, 0xb2, 0x65, 0x6a, 0x39, 0x43, 0x85, 0x56, 0xee, 0xd4, 0xb2, 0x65,
-	0x26, 0x26, 0xe6, 0x70, 0xf0, 0x41, 0x16, 0xc6, 0x4d, 0x76, 0x0c, 0x1d, 0x9b, 0x6f, 0xe4, 0x0d,
-	0xf7, 0x46, 0x07, 0x27, 0x8f, 0xc6, 0xdb, 0x80, 0xc7, 0xd6, 0xc5, 0x9d, 0xa7, 0xca, 0x31, 0xcd,
-	0xcb, 0x85, 0xa1, 0xc9, 0x7d, 0x5e, 0x83, 0xf8, 0x9b, 0x07, 0x70, 0x8e, 0x66, 0xa2, 0x54, 0x55,
-	0x50, 0xa5, 0xb3, 0x14, 0xd7, 0x48, 0x79, 0xf9, 0x9c, 0xbe, 0xab, 0x42, 0x25, 0xe7, 0xf2, 0x5f,
-	0x21, 0x81, 0x7b, 0x17, 0xb0, 0xdd, 0xe4, 0xfe, 0xce, 0x4d, 0xfa, 0xcd, 0x4d, 0x1e, 0x42, 0x90,
-	0xeb, 0x0c, 0x75, 0x72, 0xb5, 0xb6, 0x89, 0x74, 0x08, 0x4f, 0xd7, 0xf1, 0x4b, 0x08, 0x67, 0x46,
-	0x98, 0xb2, 0x70, 0x23, 0x3f, 0x81, 0x76, 0x41, 0x04, 0x3d, 0x30, 0xe0, 0x16, 0x9d, 0xfc, 0x6a,
-	0x41, 0xcf, 0x7a, 0x66, 0xf5, 0xe9, 0xb1, 0x53, 0x08, 0xcf, 0x28, 0x77, 0x57, 0xbb, 0x2b, 0x9d,
-	0xa3, 0x5d, 0x24, 0x3b, 0x83, 0x1e, 0xdd, 0x26, 0xa1, 0xe9, 0xfa, 0x22, 0x63, 0xcf, 0x9b, 0xb6,
-	0xbb, 0x77, 0xbb, 0xbb, 0xc9, 0x5b, 0x08, 0xb7, 0xb9, 0xd2, 0xdb, 0xef, 0xf4, 0xb0, 0xd2, 0xd1,
-	0xd3, 0x26, 0xdf, 0xdc, 0xef, 0x29, 0x84, 0x97, 0x74, 0x1a, 0xff, 0x3f, 0xc1, 0x7b, 0x08, 0xdf,
-	0xd1, 0xd9, 0x38, 0xe2, 0xfe, 0x01, 0x0e, 0x9b, 0xea, 0xad, 0xc4, 0xa7, 0xfd, 0xef, 0x9b, 0x81,
-	0xf7, 0x63, 0x33, 0xf0, 0x7e, 0x6f, 0x06, 0xde, 0xd7, 0x3f, 0x83, 0x07, 0x57, 0x6d, 0xfa, 0x33,
-	0xbf, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x6c, 0xf5, 0x63, 0xf0, 0x03, 0x00, 0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xc5, 0x69, 0x3e, 0x9c, 0xa9, 0x1c, 0x85, 0x05, 0x81, 0x5b, 0x20, 0x8a, 0x7c, 0x21, 0x12,
+	0x6a, 0x90, 0xca, 0xa9, 0x37, 0x92, 0x22, 0x95, 0x48, 0x9c, 0x36, 0xea, 0x89, 0x83, 0xb5, 0xf1,
+	0x2e, 0xcd, 0x4a, 0x1b, 0x3b, 0xac, 0xd7, 0x91, 0xc2, 0x2f, 0xe1, 0x9f, 0xf0, 0x17, 0x38, 0x72,
+	0x40, 0xe2, 0x8a, 0xc2, 0x1f, 0x41, 0x9e, 0x5d, 0x13, 0xb7, 0x8a, 0x2a, 0x71, 0xf3, 0x7b, 0x6f,
+	0x66, 0xec, 0x79, 0x6f, 0x0c, 0xc3, 0xa5, 0x60, 0xca, 0x2c, 0x13, 0xa6, 0xc5, 0x59, 0x2e, 0xf4,
+	0x46, 0x26, 0xe2, 0xb5, 0x16, 0x2c, 0xcf, 0xd2, 0x7c, 0xbc, 0xd6, 0x99, 0xc9, 0x08, 0xec, 0x2b,
+	0xa2, 0x8f, 0xd0, 0xbf, 0x12, 0x86, 0x8a, 0xcf, 0x73, 0xa3, 0xa9, 0xad, 0x22, 0x8f, 0xa1, 0xf5,
+	0x49, 0x0a, 0xc5, 0x43, 0x6f, 0xe8, 0x8d, 0xba, 0xd4, 0x82, 0x92, 0xdd, 0x30, 0x55, 0x88, 0xb0,
+	0x61, 0x59, 0x04, 0xe4, 0x19, 0x74, 0x65, 0x1e, 0xb3, 0xc4, 0xc8, 0x8d, 0x08, 0x8f, 0x86, 0xde,
+	0xc8, 0xa7, 0xbe, 0xcc, 0x27, 0x88, 0xa3, 0x9f, 0x1e, 0x74, 0xaa, 0xa1, 0x3d, 0x68, 0xc8, 0x6a,
+	0x62, 0x43, 0x72, 0x42, 0xa0, 0x99, 0xb2, 0x55, 0x35, 0x0d, 0x9f, 0xc9, 0x2b, 0x78, 0x98, 0xaf,
+	0x45, 0x22, 0x99, 0x92, 0x5f, 0x98, 0x91, 0x59, 0x1a, 0x4b, 0x8e, 0x43, 0xbb, 0xb4, 0x7f, 0x5b,
+	0x98, 0x71, 0x7c, 0xf3, 0x8a, 0xdd, 0x88, 0xb8, 0xd0, 0x2a, 0x6c, 0x62, 0x91, 0x8f, 0xc4, 0xb5,
+	0x56, 0xe4, 0x05, 0x40, 0xa2, 0x05, 0x33, 0x82, 0xc7, 0xcc, 0x84, 0x2d, 0x54, 0xbb, 0x8e, 0x99,
+	0x98, 0x52, 0x2e, 0xd6, 0xbc, 0x92, 0xdb, 0x56, 0x76, 0x8c, 0x95, 0xb9, 0x50, 0xc2, 0xc9, 0x1d,
+	0x2b, 0x3b, 0x66, 0x62, 0x22, 0x0a, 0xc7, 0x1f, 0x64, 0x6e, 0xaa, 0xcd, 0xce, 0xa0, 0xe3, 0xfc,
+	0x0d, 0xbd, 0xe1, 0xd1, 0xe8, 0xf8, 0xfc, 0xd1, 0x78, 0x6f, 0xf0, 0xd8, 0x55, 0xd1, 0xaa, 0xa6,
+	0xf4, 0x31, 0xc9, 0x8a, 0xd4, 0xe0, 0xe6, 0x2d, 0x6a, 0x41, 0xf4, 0xcd, 0x03, 0xb8, 0x12, 0x66,
+	0xa2, 0x54, 0xd9, 0x50, 0xba, 0xb3, 0x66, 0x37, 0x02, 0xfd, 0x6a, 0x51, 0x7c, 0x2e, 0x1b, 0x95,
+	0x5c, 0xc9, 0x7f, 0x8d, 0x08, 0xee, 0x0d, 0x60, 0x9f, 0x64, 0xf3, 0x60, 0x92, 0xad, 0x7a, 0x92,
+	0x27, 0xe0, 0x67, 0x9a, 0x0b, 0x1d, 0x2f, 0xb6, 0xce, 0x91, 0x0e, 0xe2, 0xe9, 0xb6, 0x7c, 0xc7,
+	0x42, 0xb3, 0x34, 0x59, 0x96, 0x79, 0x58, 0x3b, 0x7c, 0x4b, 0xcc, 0x78, 0xf4, 0x12, 0x82, 0xb9,
+	0x61, 0xa6, 0xc8, 0x2b, 0x3f, 0x9e, 0x40, 0x3b, 0x47, 0x02, 0xbf, 0xde, 0xa7, 0x0e, 0x9d, 0xff,
+	0x6a, 0x40, 0xcf, 0xd5, 0xcc, 0xed, 0x5d, 0x92, 0x0b, 0x08, 0x2e, 0x31, 0x94, 0xaa, 0xf7, 0x90,
+	0x75, 0xa7, 0x87, 0x48, 0x72, 0x09, 0x3d, 0x3c, 0x5c, 0x44, 0xd3, 0xed, 0x8c, 0x93, 0xe7, 0xf5,
+	0xb2, 0xbb, 0x47, 0x7d, 0x78, 0xc8, 0x5b, 0x08, 0xf6, 0xa6, 0xe3, 0xb7, 0xdf, 0x99, 0xe1, 0xa4,
+	0xd3, 0xa7, 0x75, 0xbe, 0x1e, 0xfe, 0x05, 0x04, 0xd7, 0x78, 0x37, 0xff, 0xbf, 0xc1, 0x7b, 0x08,
+	0xde, 0xe1, 0x4d, 0x55, 0xc4, 0xfd, 0x0b, 0x9c, 0xd4, 0xd5, 0x5b, 0x8e, 0x4f, 0xfb, 0xdf, 0x77,
+	0x03, 0xef, 0xc7, 0x6e, 0xe0, 0xfd, 0xde, 0x0d, 0xbc, 0xaf, 0x7f, 0x06, 0x0f, 0x16, 0x6d, 0xfc,
+	0xd3, 0xdf, 0xfc, 0x1d, 0x00, 0x31, 0x1b, 0x8d, 0xfe, 0x0d, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -838,6 +847,13 @@ func (m *GetAllReas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintReasons(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.OrderBy) > 0 {
 		i -= len(m.OrderBy)
 		copy(dAtA[i:], m.OrderBy)
@@ -1041,6 +1057,10 @@ func (m *GetAllReas) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovReasons(uint64(l))
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovReasons(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1765,6 +1785,38 @@ func (m *GetAllReas) Unmarshal(dAtA []byte) error {
 			}
 			m.OrderBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReasons
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReasons
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReasons
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReasons(dAtA[iNdEx:])
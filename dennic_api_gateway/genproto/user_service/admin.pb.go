@@ -28,26 +28,28 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type Admin struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	AdminOrder           int64    `protobuf:"varint,2,opt,name=admin_order,json=adminOrder,proto3" json:"admin_order"`
-	Role                 string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role"`
-	FirstName            string   `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name"`
-	LastName             string   `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name"`
-	BirthDate            string   `protobuf:"bytes,6,opt,name=birth_date,json=birthDate,proto3" json:"birth_date"`
-	PhoneNumber          string   `protobuf:"bytes,7,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
-	Email                string   `protobuf:"bytes,8,opt,name=email,proto3" json:"email"`
-	Password             string   `protobuf:"bytes,9,opt,name=password,proto3" json:"password"`
-	Gender               string   `protobuf:"bytes,10,opt,name=gender,proto3" json:"gender"`
-	Salary               float32  `protobuf:"fixed32,11,opt,name=salary,proto3" json:"salary"`
-	Biography            string   `protobuf:"bytes,12,opt,name=biography,proto3" json:"biography"`
-	StartWorkYear        string   `protobuf:"bytes,13,opt,name=start_work_year,json=startWorkYear,proto3" json:"start_work_year"`
-	EndWorkYear          string   `protobuf:"bytes,14,opt,name=end_work_year,json=endWorkYear,proto3" json:"end_work_year"`
-	WorkYears            uint64   `protobuf:"varint,15,opt,name=work_years,json=workYears,proto3" json:"work_years"`
-	RefreshToken         string   `protobuf:"bytes,16,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token"`
-	ImageUrl             string   `protobuf:"bytes,17,opt,name=image_url,json=imageUrl,proto3" json:"image_url"`
-	CreatedAt            string   `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,19,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	DeletedAt            string   `protobuf:"bytes,20,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	AdminOrder    int64   `protobuf:"varint,2,opt,name=admin_order,json=adminOrder,proto3" json:"admin_order"`
+	Role          string  `protobuf:"bytes,3,opt,name=role,proto3" json:"role"`
+	FirstName     string  `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name"`
+	LastName      string  `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name"`
+	BirthDate     string  `protobuf:"bytes,6,opt,name=birth_date,json=birthDate,proto3" json:"birth_date"`
+	PhoneNumber   string  `protobuf:"bytes,7,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
+	Email         string  `protobuf:"bytes,8,opt,name=email,proto3" json:"email"`
+	Password      string  `protobuf:"bytes,9,opt,name=password,proto3" json:"password"`
+	Gender        string  `protobuf:"bytes,10,opt,name=gender,proto3" json:"gender"`
+	Salary        float32 `protobuf:"fixed32,11,opt,name=salary,proto3" json:"salary"`
+	Biography     string  `protobuf:"bytes,12,opt,name=biography,proto3" json:"biography"`
+	StartWorkYear string  `protobuf:"bytes,13,opt,name=start_work_year,json=startWorkYear,proto3" json:"start_work_year"`
+	EndWorkYear   string  `protobuf:"bytes,14,opt,name=end_work_year,json=endWorkYear,proto3" json:"end_work_year"`
+	WorkYears     uint64  `protobuf:"varint,15,opt,name=work_years,json=workYears,proto3" json:"work_years"`
+	RefreshToken  string  `protobuf:"bytes,16,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token"`
+	ImageUrl      string  `protobuf:"bytes,17,opt,name=image_url,json=imageUrl,proto3" json:"image_url"`
+	CreatedAt     string  `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt     string  `protobuf:"bytes,19,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt     string  `protobuf:"bytes,20,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	// the branch a reception account works at
+	BranchId             string   `protobuf:"bytes,21,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -226,6 +228,13 @@ func (m *Admin) GetDeletedAt() string {
 	return ""
 }
 
+func (m *Admin) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
 type GetAdminReq struct {
 	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
@@ -873,58 +882,59 @@ func init() {
 func init() { proto.RegisterFile("user_service/admin.proto", fileDescriptor_cc32bb425e570901) }
 
 var fileDescriptor_cc32bb425e570901 = []byte{
-	// 815 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0x66, 0xed, 0x8d, 0x6b, 0x3f, 0xc7, 0x6e, 0x3b, 0x89, 0xca, 0x74, 0xdb, 0x04, 0x77, 0x2b,
-	0x90, 0x2f, 0x04, 0x51, 0x84, 0x90, 0x38, 0xe1, 0xa6, 0xa2, 0x07, 0xa0, 0xc0, 0x42, 0x40, 0x39,
-	0xad, 0xc6, 0xde, 0x17, 0x7b, 0xe5, 0xfd, 0xc5, 0xcc, 0x38, 0x91, 0xff, 0x13, 0x2e, 0xfc, 0x3f,
-	0x1c, 0x39, 0x70, 0x07, 0x85, 0x7f, 0x04, 0xcd, 0x9b, 0x5d, 0x67, 0x1d, 0xc7, 0x46, 0x48, 0xbd,
-	0xf9, 0x7d, 0xdf, 0x37, 0x6f, 0xde, 0xcc, 0x7c, 0xdf, 0x1a, 0xf8, 0x42, 0xa1, 0x0c, 0x15, 0xca,
-	0xcb, 0x78, 0x82, 0x1f, 0x89, 0x28, 0x8d, 0xb3, 0x93, 0x42, 0xe6, 0x3a, 0x67, 0xae, 0x61, 0xfc,
-	0x3f, 0x5d, 0xd8, 0x1b, 0x19, 0x94, 0xf5, 0xa1, 0x11, 0x47, 0xdc, 0x19, 0x38, 0xc3, 0x4e, 0xd0,
-	0x88, 0x23, 0xf6, 0x1e, 0x74, 0x49, 0x1e, 0xe6, 0x32, 0x42, 0xc9, 0x1b, 0x03, 0x67, 0xd8, 0x0c,
-	0x80, 0xa0, 0x6f, 0x0d, 0xc2, 0x18, 0xb8, 0x32, 0x4f, 0x90, 0x37, 0x69, 0x09, 0xfd, 0x66, 0x47,
-	0x00, 0x17, 0xb1, 0x54, 0x3a, 0xcc, 0x44, 0x8a, 0xdc, 0x25, 0xa6, 0x43, 0xc8, 0x1b, 0x91, 0x22,
-	0x7b, 0x02, 0x9d, 0x44, 0x54, 0xec, 0x1e, 0xb1, 0x6d, 0x03, 0x10, 0x79, 0x04, 0x30, 0x8e, 0xa5,
-	0x9e, 0x85, 0x91, 0xd0, 0xc8, 0x5b, 0x76, 0x2d, 0x21, 0xaf, 0x84, 0x46, 0xf6, 0x0c, 0xf6, 0x8b,
-	0x59, 0x9e, 0x61, 0x98, 0x2d, 0xd2, 0x31, 0x4a, 0x7e, 0x8f, 0x04, 0x5d, 0xc2, 0xde, 0x10, 0xc4,
-	0x0e, 0x61, 0x0f, 0x53, 0x11, 0x27, 0xbc, 0x4d, 0x9c, 0x2d, 0x98, 0x07, 0xed, 0x42, 0x28, 0x75,
-	0x95, 0xcb, 0x88, 0x77, 0xec, 0x9e, 0x55, 0xcd, 0x1e, 0x41, 0x6b, 0x8a, 0x99, 0x39, 0x1f, 0x10,
-	0x53, 0x56, 0x06, 0x57, 0x22, 0x11, 0x72, 0xc9, 0xbb, 0x03, 0x67, 0xd8, 0x08, 0xca, 0x8a, 0x3d,
-	0x85, 0xce, 0x38, 0xce, 0xa7, 0x52, 0x14, 0xb3, 0x25, 0xdf, 0xaf, 0x46, 0x2c, 0x01, 0xf6, 0x01,
-	0xdc, 0x57, 0x5a, 0x48, 0x1d, 0x5e, 0xe5, 0x72, 0x1e, 0x2e, 0x51, 0x48, 0xde, 0x23, 0x4d, 0x8f,
-	0xe0, 0x9f, 0x73, 0x39, 0x3f, 0x47, 0x21, 0x99, 0x0f, 0x3d, 0xcc, 0xa2, 0x9a, 0xaa, 0x6f, 0xcf,
-	0x82, 0x59, 0xb4, 0xd2, 0x1c, 0x01, 0xac, 0x78, 0xc5, 0xef, 0x0f, 0x9c, 0xa1, 0x1b, 0x74, 0xae,
-	0x4a, 0x56, 0xb1, 0xe7, 0xd0, 0x93, 0x78, 0x21, 0x51, 0xcd, 0x42, 0x9d, 0xcf, 0x31, 0xe3, 0x0f,
-	0xa8, 0xc5, 0x7e, 0x09, 0xfe, 0x68, 0x30, 0x73, 0xdd, 0x71, 0x2a, 0xa6, 0x18, 0x2e, 0x64, 0xc2,
-	0x1f, 0xda, 0xa3, 0x13, 0x70, 0x26, 0x13, 0xb3, 0xc1, 0x44, 0xa2, 0xd0, 0x18, 0x85, 0x42, 0x73,
-	0x66, 0xcf, 0x52, 0x22, 0x23, 0x6d, 0xe8, 0x45, 0x11, 0x55, 0xf4, 0x81, 0xa5, 0x4b, 0xc4, 0xd2,
-	0x11, 0x26, 0x58, 0xd2, 0x87, 0x96, 0x2e, 0x91, 0x91, 0xf6, 0x7f, 0x82, 0xee, 0x6b, 0xd4, 0x64,
-	0xac, 0x00, 0x7f, 0x31, 0x0f, 0x73, 0x11, 0x63, 0x52, 0xd9, 0xcb, 0x16, 0x06, 0xbd, 0x14, 0xc9,
-	0x02, 0xc9, 0x5b, 0x9d, 0xc0, 0x16, 0x34, 0xb4, 0x0a, 0xc5, 0x44, 0xc7, 0x97, 0xd6, 0x5b, 0xed,
-	0xa0, 0x1d, 0xab, 0x11, 0xd5, 0xfe, 0x6f, 0x0e, 0xf4, 0xbe, 0x8e, 0x95, 0xed, 0xac, 0x4c, 0x6b,
-	0x06, 0x6e, 0x21, 0xa6, 0x48, 0x9d, 0xdd, 0x80, 0x7e, 0x9b, 0xc6, 0x49, 0x9c, 0xc6, 0x9a, 0x1a,
-	0xbb, 0x81, 0x2d, 0x76, 0x36, 0xbe, 0x99, 0xc5, 0xad, 0xcf, 0xb2, 0x9a, 0x7b, 0xaf, 0x3e, 0xf7,
-	0x63, 0x68, 0x53, 0x26, 0xc2, 0xf1, 0xb2, 0xb4, 0xe9, 0x3d, 0xaa, 0x5f, 0x2e, 0xfd, 0xaf, 0xa0,
-	0x5f, 0x1f, 0x4f, 0x15, 0xec, 0x39, 0xb4, 0x28, 0x33, 0x8a, 0x3b, 0x83, 0xe6, 0xb0, 0xfb, 0xa2,
-	0x7b, 0x62, 0x72, 0x77, 0x62, 0xaf, 0xa6, 0xa4, 0xcc, 0x3e, 0x93, 0x7c, 0x91, 0xad, 0x06, 0xa6,
-	0xc2, 0x4f, 0xe1, 0xd1, 0xe9, 0x4c, 0x64, 0x53, 0x24, 0xf1, 0x77, 0xa5, 0x67, 0xcd, 0xa1, 0x6f,
-	0x67, 0xc1, 0xd9, 0x91, 0x85, 0xc6, 0xb6, 0x2c, 0x34, 0xd7, 0xb3, 0xe0, 0x9f, 0x43, 0xff, 0x15,
-	0x3d, 0xe0, 0xdb, 0x7f, 0xb6, 0x8f, 0xe1, 0xdd, 0x3b, 0x4f, 0xa2, 0x0a, 0x4a, 0x9a, 0x16, 0x7a,
-	0xa1, 0x68, 0x93, 0x76, 0x50, 0x56, 0xfe, 0x17, 0xc0, 0x4e, 0x67, 0x38, 0x99, 0xd3, 0x8a, 0x2f,
-	0xcd, 0xc6, 0xff, 0x73, 0x22, 0xff, 0x43, 0x38, 0xd8, 0xe8, 0xb0, 0x63, 0xc3, 0x13, 0x38, 0xbc,
-	0x91, 0xdb, 0x8b, 0xd8, 0xa9, 0xff, 0x1e, 0xbc, 0x33, 0x8a, 0x43, 0x50, 0x8b, 0xdc, 0xea, 0xea,
-	0x6e, 0x7f, 0x4d, 0x37, 0xf2, 0xda, 0xd8, 0xcc, 0xab, 0xff, 0x29, 0x3c, 0xd9, 0xda, 0x72, 0xfb,
-	0x24, 0x2f, 0xfe, 0x6a, 0xc2, 0x3e, 0xa9, 0x7e, 0xb0, 0x9f, 0x79, 0xe6, 0x43, 0xeb, 0x94, 0x82,
-	0xcc, 0xea, 0x6e, 0xf3, 0xea, 0x85, 0xd1, 0xd8, 0xbd, 0x76, 0x68, 0xde, 0x87, 0xe6, 0x6b, 0xd4,
-	0xec, 0xa1, 0xc5, 0x6a, 0x81, 0x5e, 0x97, 0x7d, 0x06, 0x70, 0x63, 0x7a, 0x76, 0x60, 0xa9, 0xb5,
-	0x94, 0x7a, 0x87, 0x9b, 0xa0, 0x2a, 0xd8, 0xe7, 0xd0, 0xb2, 0x17, 0xcd, 0x4a, 0x7e, 0xdd, 0x7f,
-	0x9e, 0x67, 0xd1, 0x3b, 0x9f, 0x65, 0x04, 0x40, 0x38, 0x3d, 0x2c, 0xe3, 0xb7, 0x95, 0x95, 0x63,
-	0xbc, 0xc7, 0x5b, 0x18, 0x55, 0xb0, 0x6f, 0xa0, 0x6f, 0x5d, 0x59, 0x19, 0x92, 0x3d, 0xad, 0xc4,
-	0x77, 0xa5, 0xce, 0x3b, 0xda, 0xc1, 0xaa, 0x82, 0x9d, 0x03, 0xdb, 0x7c, 0x3d, 0x36, 0xb0, 0x8b,
-	0xb6, 0x5b, 0xc5, 0x7b, 0xf6, 0x1f, 0x0a, 0x55, 0xbc, 0x7c, 0xf0, 0xfb, 0xf5, 0xb1, 0xf3, 0xc7,
-	0xf5, 0xb1, 0xf3, 0xf7, 0xf5, 0xb1, 0xf3, 0xeb, 0x3f, 0xc7, 0xef, 0x8c, 0x5b, 0xf4, 0x27, 0xfe,
-	0xc9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xff, 0x49, 0xea, 0x51, 0xe0, 0x07, 0x00, 0x00,
+	// 829 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x66, 0xed, 0x8d, 0x6b, 0x1f, 0xc7, 0x6e, 0x3b, 0x09, 0x65, 0xba, 0x6d, 0x82, 0xbb, 0x15,
+	0xc8, 0x37, 0x04, 0x51, 0x84, 0x90, 0xb8, 0xc2, 0x4d, 0x45, 0x85, 0x80, 0x02, 0x0b, 0x05, 0xe5,
+	0x6a, 0x35, 0xf6, 0x9e, 0xd8, 0xa3, 0xac, 0x77, 0x97, 0x99, 0x71, 0x22, 0xbf, 0x09, 0x37, 0xbc,
+	0x0f, 0x97, 0xbc, 0x01, 0x28, 0x3c, 0x01, 0x6f, 0x80, 0xe6, 0xcc, 0xae, 0xb3, 0x89, 0x63, 0x23,
+	0xa4, 0xde, 0xf9, 0x7c, 0xdf, 0x37, 0xe7, 0x67, 0xe6, 0x7c, 0x5e, 0xe0, 0x0b, 0x8d, 0x2a, 0xd6,
+	0xa8, 0xce, 0xe5, 0x04, 0x3f, 0x14, 0xc9, 0x5c, 0x66, 0x47, 0x85, 0xca, 0x4d, 0xce, 0x7c, 0xcb,
+	0x84, 0xff, 0xf8, 0xb0, 0x33, 0xb2, 0x28, 0xeb, 0x43, 0x43, 0x26, 0xdc, 0x1b, 0x78, 0xc3, 0x4e,
+	0xd4, 0x90, 0x09, 0x7b, 0x17, 0xba, 0x24, 0x8f, 0x73, 0x95, 0xa0, 0xe2, 0x8d, 0x81, 0x37, 0x6c,
+	0x46, 0x40, 0xd0, 0xb7, 0x16, 0x61, 0x0c, 0x7c, 0x95, 0xa7, 0xc8, 0x9b, 0x74, 0x84, 0x7e, 0xb3,
+	0x03, 0x80, 0x53, 0xa9, 0xb4, 0x89, 0x33, 0x31, 0x47, 0xee, 0x13, 0xd3, 0x21, 0xe4, 0x95, 0x98,
+	0x23, 0x7b, 0x04, 0x9d, 0x54, 0x54, 0xec, 0x0e, 0xb1, 0xed, 0x54, 0x94, 0xe4, 0x01, 0xc0, 0x58,
+	0x2a, 0x33, 0x8b, 0x13, 0x61, 0x90, 0xb7, 0xdc, 0x59, 0x42, 0x5e, 0x08, 0x83, 0xec, 0x09, 0xec,
+	0x16, 0xb3, 0x3c, 0xc3, 0x38, 0x5b, 0xcc, 0xc7, 0xa8, 0xf8, 0x1d, 0x12, 0x74, 0x09, 0x7b, 0x45,
+	0x10, 0xdb, 0x87, 0x1d, 0x9c, 0x0b, 0x99, 0xf2, 0x36, 0x71, 0x2e, 0x60, 0x01, 0xb4, 0x0b, 0xa1,
+	0xf5, 0x45, 0xae, 0x12, 0xde, 0x71, 0x35, 0xab, 0x98, 0x3d, 0x80, 0xd6, 0x14, 0x33, 0x3b, 0x1f,
+	0x10, 0x53, 0x46, 0x16, 0xd7, 0x22, 0x15, 0x6a, 0xc9, 0xbb, 0x03, 0x6f, 0xd8, 0x88, 0xca, 0x88,
+	0x3d, 0x86, 0xce, 0x58, 0xe6, 0x53, 0x25, 0x8a, 0xd9, 0x92, 0xef, 0x56, 0x2d, 0x96, 0x00, 0x7b,
+	0x1f, 0xee, 0x6a, 0x23, 0x94, 0x89, 0x2f, 0x72, 0x75, 0x16, 0x2f, 0x51, 0x28, 0xde, 0x23, 0x4d,
+	0x8f, 0xe0, 0x9f, 0x73, 0x75, 0x76, 0x82, 0x42, 0xb1, 0x10, 0x7a, 0x98, 0x25, 0x35, 0x55, 0xdf,
+	0xcd, 0x82, 0x59, 0xb2, 0xd2, 0x1c, 0x00, 0xac, 0x78, 0xcd, 0xef, 0x0e, 0xbc, 0xa1, 0x1f, 0x75,
+	0x2e, 0x4a, 0x56, 0xb3, 0xa7, 0xd0, 0x53, 0x78, 0xaa, 0x50, 0xcf, 0x62, 0x93, 0x9f, 0x61, 0xc6,
+	0xef, 0x51, 0x8a, 0xdd, 0x12, 0xfc, 0xd1, 0x62, 0xf6, 0xba, 0xe5, 0x5c, 0x4c, 0x31, 0x5e, 0xa8,
+	0x94, 0xdf, 0x77, 0xa3, 0x13, 0xf0, 0x5a, 0xa5, 0xb6, 0xc0, 0x44, 0xa1, 0x30, 0x98, 0xc4, 0xc2,
+	0x70, 0xe6, 0x66, 0x29, 0x91, 0x91, 0xb1, 0xf4, 0xa2, 0x48, 0x2a, 0x7a, 0xcf, 0xd1, 0x25, 0xe2,
+	0xe8, 0x04, 0x53, 0x2c, 0xe9, 0x7d, 0x47, 0x97, 0xc8, 0xc8, 0xd8, 0xca, 0x63, 0x25, 0xb2, 0xc9,
+	0x2c, 0x96, 0x09, 0x7f, 0xdb, 0x55, 0x76, 0xc0, 0x97, 0x49, 0xf8, 0x13, 0x74, 0x5f, 0xa2, 0xa1,
+	0xad, 0x8b, 0xf0, 0x17, 0xfb, 0x6a, 0xa7, 0x12, 0xd3, 0x6a, 0xf7, 0x5c, 0x60, 0xd1, 0x73, 0x91,
+	0x2e, 0x90, 0x16, 0xaf, 0x13, 0xb9, 0x80, 0x26, 0xd2, 0xb1, 0x98, 0x18, 0x79, 0xee, 0x16, 0xaf,
+	0x1d, 0xb5, 0xa5, 0x1e, 0x51, 0x1c, 0xfe, 0xe6, 0x41, 0xef, 0x6b, 0xa9, 0x5d, 0x66, 0x6d, 0x53,
+	0x33, 0xf0, 0x0b, 0x31, 0x45, 0xca, 0xec, 0x47, 0xf4, 0xdb, 0x26, 0x4e, 0xe5, 0x5c, 0x1a, 0x4a,
+	0xec, 0x47, 0x2e, 0xd8, 0x9a, 0xf8, 0xaa, 0x17, 0xbf, 0xde, 0xcb, 0xaa, 0xef, 0x9d, 0x7a, 0xdf,
+	0x0f, 0xa1, 0x4d, 0x86, 0x89, 0xc7, 0xcb, 0x72, 0x87, 0xef, 0x50, 0xfc, 0x7c, 0x19, 0x7e, 0x05,
+	0xfd, 0x7a, 0x7b, 0xba, 0x60, 0x4f, 0xa1, 0x45, 0x86, 0xd2, 0xdc, 0x1b, 0x34, 0x87, 0xdd, 0x67,
+	0xdd, 0x23, 0x6b, 0xca, 0x23, 0x77, 0x35, 0x25, 0x65, 0xeb, 0x4c, 0xf2, 0x45, 0xb6, 0x6a, 0x98,
+	0x82, 0x70, 0x0e, 0x0f, 0x8e, 0x67, 0x22, 0x9b, 0x22, 0x89, 0xbf, 0x2b, 0x17, 0xda, 0x0e, 0x7d,
+	0xd3, 0x28, 0xde, 0x16, 0xa3, 0x34, 0x36, 0x19, 0xa5, 0x79, 0xdd, 0x28, 0xe1, 0x09, 0xf4, 0x5f,
+	0xd0, 0xeb, 0xbe, 0xf9, 0x67, 0xfb, 0x08, 0xde, 0xb9, 0x75, 0x12, 0x5d, 0x90, 0x0d, 0x8d, 0x30,
+	0x0b, 0x4d, 0x45, 0xda, 0x51, 0x19, 0x85, 0x9f, 0x03, 0x3b, 0x9e, 0xe1, 0xe4, 0x8c, 0x4e, 0x7c,
+	0x61, 0x0b, 0xff, 0xcf, 0x8e, 0xc2, 0x0f, 0x60, 0x6f, 0x2d, 0xc3, 0x96, 0x82, 0x47, 0xb0, 0x7f,
+	0x25, 0x77, 0x17, 0xb1, 0x55, 0xff, 0x3d, 0x04, 0xaf, 0xc9, 0x2b, 0x51, 0xcd, 0x8f, 0xab, 0xab,
+	0xbb, 0xf9, 0x57, 0xbb, 0x66, 0xe6, 0xc6, 0xba, 0x99, 0xc3, 0x4f, 0xe0, 0xd1, 0xc6, 0x94, 0x9b,
+	0x3b, 0x79, 0xf6, 0x67, 0x13, 0x76, 0x49, 0xf5, 0x83, 0xfb, 0x06, 0xb0, 0x10, 0x5a, 0xc7, 0xe4,
+	0x72, 0x56, 0xdf, 0xb6, 0xa0, 0x1e, 0x58, 0x8d, 0xab, 0xb5, 0x45, 0xf3, 0x1e, 0x34, 0x5f, 0xa2,
+	0x61, 0xf7, 0x1d, 0x56, 0x33, 0xf4, 0x75, 0xd9, 0xa7, 0x00, 0x57, 0x4b, 0xcf, 0xf6, 0x1c, 0x75,
+	0xcd, 0xa5, 0xc1, 0xfe, 0x3a, 0xa8, 0x0b, 0xf6, 0x19, 0xb4, 0xdc, 0x45, 0xb3, 0x92, 0xbf, 0xbe,
+	0x7f, 0x41, 0xe0, 0xd0, 0x5b, 0x9f, 0x65, 0x04, 0x40, 0x38, 0x3d, 0x2c, 0xe3, 0x37, 0x95, 0xd5,
+	0xc6, 0x04, 0x0f, 0x37, 0x30, 0xba, 0x60, 0xdf, 0x40, 0xdf, 0x6d, 0x65, 0xb5, 0x90, 0xec, 0x71,
+	0x25, 0xbe, 0xcd, 0x75, 0xc1, 0xc1, 0x16, 0x56, 0x17, 0xec, 0x04, 0xd8, 0xfa, 0xeb, 0xb1, 0x81,
+	0x3b, 0xb4, 0x79, 0x55, 0x82, 0x27, 0xff, 0xa1, 0xd0, 0xc5, 0xf3, 0x7b, 0xbf, 0x5f, 0x1e, 0x7a,
+	0x7f, 0x5c, 0x1e, 0x7a, 0x7f, 0x5d, 0x1e, 0x7a, 0xbf, 0xfe, 0x7d, 0xf8, 0xd6, 0xb8, 0x45, 0x5f,
+	0xf8, 0x8f, 0xff, 0x1d, 0x00, 0x83, 0x39, 0x71, 0x72, 0xfd, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1283,6 +1293,15 @@ func (m *Admin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
 	if len(m.DeletedAt) > 0 {
 		i -= len(m.DeletedAt)
 		copy(dAtA[i:], m.DeletedAt)
@@ -2019,6 +2038,10 @@ func (m *Admin) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovAdmin(uint64(l))
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 2 + l + sovAdmin(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2877,6 +2900,38 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			}
 			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
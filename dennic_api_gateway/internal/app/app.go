@@ -3,12 +3,17 @@ package app
 import (
 	"context"
 	"dennic_api_gateway/api"
+	"dennic_api_gateway/internal/delivery/kafka/handlers"
 	grpcService "dennic_api_gateway/internal/infrastructure/grpc_service_client"
+	"dennic_api_gateway/internal/infrastructure/kafka"
+	redisCache "dennic_api_gateway/internal/infrastructure/redis"
 	"dennic_api_gateway/internal/pkg/config"
 	"dennic_api_gateway/internal/pkg/logger"
 	"dennic_api_gateway/internal/pkg/otlp"
 	"dennic_api_gateway/internal/pkg/postgres"
 	"dennic_api_gateway/internal/pkg/redis"
+	"dennic_api_gateway/internal/usecase/event"
+	"dennic_kafka/eventbus"
 	"fmt"
 	"go.uber.org/zap"
 	"net/http"
@@ -16,14 +21,16 @@ import (
 )
 
 type App struct {
-	Config       *config.Config
-	Logger       *zap.Logger
-	DB           *postgres.PostgresDB
-	RedisDB      *redis.RedisDB
-	server       *http.Server
-	Clients      grpcService.ServiceClient
-	ShutdownOTLP func() error
-	//BrokerProducer event.BrokerProducer
+	Config         *config.Config
+	Logger         *zap.Logger
+	DB             *postgres.PostgresDB
+	RedisDB        *redis.RedisDB
+	server         *http.Server
+	Clients        grpcService.ServiceClient
+	ShutdownOTLP   func() error
+	BrokerProducer event.BrokerProducer
+	BrokerConsumer event.BrokerConsumer
+	stopRealtime   context.CancelFunc
 }
 
 func NewApp(cfg *config.Config) (*App, error) {
@@ -33,8 +40,18 @@ func NewApp(cfg *config.Config) (*App, error) {
 		return nil, err
 	}
 
-	// kafka producer init
-	//kafkaProducer := kafka.NewProducer(&cfg, logger)
+	// kafka init
+	kafkaProducer := kafka.NewProducer(cfg, l)
+	kafkaConsumer, err := eventbus.NewConsumer(eventbus.Config{
+		Brokers:          cfg.Kafka.Address,
+		MaxAttempts:      cfg.Kafka.Retry.MaxAttempts,
+		Backoff:          cfg.Kafka.Retry.Backoff,
+		MaxBackoff:       cfg.Kafka.Retry.MaxBackoff,
+		DeadLetterSuffix: cfg.Kafka.DeadLetterSuffix,
+	}, l)
+	if err != nil {
+		return nil, err
+	}
 
 	// postgres init
 	db, err := postgres.New(cfg)
@@ -57,12 +74,13 @@ func NewApp(cfg *config.Config) (*App, error) {
 	// initialization enforcer
 
 	return &App{
-		Config:  cfg,
-		Logger:  l,
-		DB:      db,
-		RedisDB: redisdb,
-		//BrokerProducer: kafkaProducer,
-		ShutdownOTLP: shutdownOTLP,
+		Config:         cfg,
+		Logger:         l,
+		DB:             db,
+		RedisDB:        redisdb,
+		BrokerProducer: kafkaProducer,
+		BrokerConsumer: kafkaConsumer,
+		ShutdownOTLP:   shutdownOTLP,
 		//appVersion:     appVersionUseCase,
 	}, nil
 }
@@ -81,6 +99,21 @@ func (a *App) Run() error {
 	}
 	a.Clients = clients
 
+	// registrations published to the user service follow its events
+	registrations := redisCache.NewRegistrations(a.RedisDB, a.Config.Registration.TTL)
+	if err := handlers.NewRegistrationHandler(a.Config, a.BrokerConsumer, a.Logger, registrations).HandlerEvents(); err != nil {
+		return fmt.Errorf("error during run registration consumer: %w", err)
+	}
+
+	// changes clients watch reach the streams of every replica
+	realtime := redisCache.NewRealtime(a.RedisDB, a.Logger, a.Config.Realtime.Buffer)
+	realtimeCtx, stopRealtime := context.WithCancel(context.Background())
+	a.stopRealtime = stopRealtime
+	go realtime.Run(realtimeCtx)
+	if err := handlers.NewRealtimeHandler(a.Config, a.BrokerConsumer, a.Logger, clients, realtime).HandlerEvents(); err != nil {
+		return fmt.Errorf("error during run realtime consumer: %w", err)
+	}
+
 	// api init
 	handler := api.NewRoute(api.RouteOption{
 		Config:         a.Config,
@@ -88,7 +121,9 @@ func (a *App) Run() error {
 		ContextTimeout: contextTimeout,
 		Service:        clients,
 		Redis:          a.RedisDB,
-		//BrokerProducer: a.BrokerProducer,
+		Registrations:  registrations,
+		Realtime:       realtime,
+		BrokerProducer: a.BrokerProducer,
 	})
 
 	// server init
@@ -101,6 +136,14 @@ func (a *App) Run() error {
 }
 
 func (a *App) Stop() {
+	// stop streaming to clients
+	if a.stopRealtime != nil {
+		a.stopRealtime()
+	}
+
+	// close broker consumer and producer
+	a.BrokerConsumer.Close()
+	a.BrokerProducer.Close()
 
 	// close database
 	a.DB.Close()
@@ -0,0 +1,256 @@
+package handlers
+
+import (
+	"context"
+	"dennic_api_gateway/genproto/booking_service"
+	"dennic_api_gateway/genproto/events"
+	"dennic_api_gateway/internal/entity"
+	grpcService "dennic_api_gateway/internal/infrastructure/grpc_service_client"
+	"dennic_api_gateway/internal/infrastructure/redis"
+	"dennic_api_gateway/internal/pkg/config"
+	"dennic_api_gateway/internal/usecase/event"
+	"dennic_kafka/eventbus"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Events streamed to clients: the changes of appointments the booking
+// service publishes, status changes being the queue moving, and the unread
+// counts of inboxes the notification service publishes, and the messages
+// and read receipts of patient–doctor conversations.
+const (
+	EventAppointmentCreated       = "appointment.created"
+	EventAppointmentUpdated       = "appointment.updated"
+	EventAppointmentConfirmed     = "appointment.confirmed"
+	EventAppointmentStatusChanged = "appointment.status_changed"
+	EventAppointmentDeleted       = "appointment.deleted"
+	EventUnreadCount              = "notification.unread_count"
+	EventMessageSent              = "message.sent"
+	EventMessageRead              = "message.read"
+)
+
+var (
+	appointmentSchemas = eventbus.NewSchemas(map[string]eventbus.Schema{
+		EventAppointmentCreated:       {Version: 1},
+		EventAppointmentUpdated:       {Version: 1},
+		EventAppointmentConfirmed:     {Version: 1},
+		EventAppointmentStatusChanged: {Version: 1},
+		EventAppointmentDeleted:       {Version: 1},
+	}, nil)
+	unreadCountSchemas = eventbus.NewSchemas(map[string]eventbus.Schema{
+		EventUnreadCount: {Version: 1},
+	}, nil)
+	messageSchemas = eventbus.NewSchemas(map[string]eventbus.Schema{
+		EventMessageSent: {Version: 1},
+		EventMessageRead: {Version: 1},
+	}, nil)
+)
+
+// realtimeHandler publishes the events clients watch to every gateway
+// replica. The replicas share the consumer group, so each event is
+// published once; a redelivered one is streamed again, which clients
+// reloading on an event do not mind.
+type realtimeHandler struct {
+	config         *config.Config
+	brokerConsumer event.BrokerConsumer
+	logger         *zap.Logger
+	clients        grpcService.ServiceClient
+	realtime       redis.Realtime
+}
+
+func NewRealtimeHandler(config *config.Config,
+	brokerConsumer event.BrokerConsumer,
+	logger *zap.Logger,
+	clients grpcService.ServiceClient,
+	realtime redis.Realtime) *realtimeHandler {
+	return &realtimeHandler{
+		config:         config,
+		brokerConsumer: brokerConsumer,
+		logger:         logger,
+		clients:        clients,
+		realtime:       realtime,
+	}
+}
+
+func (h *realtimeHandler) HandlerEvents() error {
+	h.brokerConsumer.RegisterConsumer(eventbus.NewConsumerConfig(
+		h.config.Kafka.Address,
+		h.config.Kafka.Topic.Appointments,
+		h.config.Kafka.GroupId,
+		func(ctx context.Context, key, value []byte) error {
+			envelope, err := appointmentSchemas.Decode(value)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+			if h.stale(envelope) {
+				return nil
+			}
+
+			var appointment events.AppointmentChanged
+			if err := appointment.Unmarshal(envelope.Payload); err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			// the doctor signs in with their doctor id, the patient with the
+			// user their patient record belongs to
+			var audience []string
+			if appointment.DoctorId != "" {
+				audience = append(audience, redis.UserChannel(appointment.DoctorId))
+			}
+			if appointment.BranchId != "" {
+				audience = append(audience, redis.BranchChannel(appointment.BranchId))
+			}
+			audience, err = h.withPatient(ctx, audience, appointment.PatientId)
+			if err != nil {
+				return err
+			}
+
+			return h.publish(ctx, envelope, audience, &entity.AppointmentChange{
+				Id:               appointment.Id,
+				PatientId:        appointment.PatientId,
+				DoctorId:         appointment.DoctorId,
+				DepartmentId:     appointment.DepartmentId,
+				BranchId:         appointment.BranchId,
+				StartsAt:         appointment.StartsAt,
+				Duration:         appointment.Duration,
+				Modality:         appointment.Modality,
+				Status:           appointment.Status,
+				PatientStatus:    appointment.PatientStatus,
+				PreviousStartsAt: appointment.PreviousStartsAt,
+				OccurredAt:       envelope.OccurredAt,
+			})
+		},
+	))
+
+	h.brokerConsumer.RegisterConsumer(eventbus.NewConsumerConfig(
+		h.config.Kafka.Address,
+		h.config.Kafka.Topic.Inbox,
+		h.config.Kafka.GroupId,
+		func(ctx context.Context, key, value []byte) error {
+			envelope, err := unreadCountSchemas.Decode(value)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+			if h.stale(envelope) {
+				return nil
+			}
+
+			var count events.UnreadCount
+			if err := count.Unmarshal(envelope.Payload); err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			return h.publish(ctx, envelope, []string{redis.UserChannel(count.UserId)}, &entity.UnreadCount{
+				Unread: count.Unread,
+			})
+		},
+	))
+
+	// both sides of a conversation are told, so the sender's other devices
+	// show the message too; the body is fetched through the api
+	h.brokerConsumer.RegisterConsumer(eventbus.NewConsumerConfig(
+		h.config.Kafka.Address,
+		h.config.Kafka.Topic.Messages,
+		h.config.Kafka.GroupId,
+		func(ctx context.Context, key, value []byte) error {
+			envelope, err := messageSchemas.Decode(value)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+			if h.stale(envelope) {
+				return nil
+			}
+
+			var (
+				patientId, doctorId string
+				data                any
+			)
+			switch envelope.Type {
+			case EventMessageSent:
+				var sent events.MessageSent
+				if err := sent.Unmarshal(envelope.Payload); err != nil {
+					return eventbus.Permanent(err)
+				}
+				patientId, doctorId = sent.PatientId, sent.DoctorId
+				data = &entity.MessageSent{
+					Id:             sent.Id,
+					ConversationId: sent.ConversationId,
+					AppointmentId:  sent.AppointmentId,
+					SenderRole:     sent.SenderRole,
+					SentAt:         sent.SentAt,
+				}
+			case EventMessageRead:
+				var read events.MessagesRead
+				if err := read.Unmarshal(envelope.Payload); err != nil {
+					return eventbus.Permanent(err)
+				}
+				patientId, doctorId = read.PatientId, read.DoctorId
+				data = &entity.MessagesRead{
+					ConversationId: read.ConversationId,
+					ReaderRole:     read.ReaderRole,
+					ReadAt:         read.ReadAt,
+				}
+			}
+
+			audience, err := h.withPatient(ctx, []string{redis.UserChannel(doctorId)}, patientId)
+			if err != nil {
+				return err
+			}
+
+			return h.publish(ctx, envelope, audience, data)
+		},
+	))
+
+	h.brokerConsumer.Run()
+
+	return nil
+}
+
+// withPatient adds the user a patient record belongs to, when there is one,
+// to an audience.
+func (h *realtimeHandler) withPatient(ctx context.Context, audience []string, patientId string) ([]string, error) {
+	if patientId == "" {
+		return audience, nil
+	}
+
+	patient, err := h.clients.BookingService().PatientService().GetPatient(ctx, &booking_service.PatientFieldValueReq{
+		Field: "id",
+		Value: patientId,
+	})
+	if status.Code(err) == codes.NotFound {
+		return audience, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if patient.UserId != "" {
+		audience = append(audience, redis.UserChannel(patient.UserId))
+	}
+
+	return audience, nil
+}
+
+// stale tells a change consumed too late to be worth streaming, like the
+// backlog of a gateway that was down; clients reload on reconnecting.
+func (h *realtimeHandler) stale(envelope *eventbus.Envelope) bool {
+	occurredAt, err := time.Parse(time.RFC3339Nano, envelope.OccurredAt)
+	return err == nil && time.Since(occurredAt) > h.config.Realtime.MaxAge
+}
+
+func (h *realtimeHandler) publish(ctx context.Context, envelope *eventbus.Envelope, audience []string, data any) error {
+	value, err := json.Marshal(data)
+	if err != nil {
+		return eventbus.Permanent(err)
+	}
+
+	return h.realtime.Publish(ctx, &redis.Event{
+		Id:       envelope.Id,
+		Type:     envelope.Type,
+		Data:     value,
+		Audience: audience,
+	})
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"dennic_api_gateway/genproto/events"
+	"dennic_api_gateway/internal/infrastructure/redis"
+	"dennic_api_gateway/internal/pkg/config"
+	"dennic_api_gateway/internal/usecase/event"
+	"dennic_kafka/eventbus"
+
+	"go.uber.org/zap"
+)
+
+// Events the user service publishes on how a registration went.
+const (
+	EventUserCreated        = "user.created"
+	EventRegistrationFailed = "user.registration_failed"
+)
+
+var (
+	userCreatedSchemas = eventbus.NewSchemas(map[string]eventbus.Schema{
+		EventUserCreated: {Version: 1},
+	}, nil)
+	registrationFailedSchemas = eventbus.NewSchemas(map[string]eventbus.Schema{
+		EventRegistrationFailed: {Version: 1},
+	}, nil)
+)
+
+// registrationHandler keeps the status clients poll in step with the user
+// service. Recording a status twice does no harm, so redeliveries are
+// handled again.
+type registrationHandler struct {
+	config         *config.Config
+	brokerConsumer event.BrokerConsumer
+	logger         *zap.Logger
+	registrations  redis.Registrations
+}
+
+func NewRegistrationHandler(config *config.Config,
+	brokerConsumer event.BrokerConsumer,
+	logger *zap.Logger,
+	registrations redis.Registrations) *registrationHandler {
+	return &registrationHandler{
+		config:         config,
+		brokerConsumer: brokerConsumer,
+		logger:         logger,
+		registrations:  registrations,
+	}
+}
+
+func (h *registrationHandler) HandlerEvents() error {
+	h.brokerConsumer.RegisterConsumer(eventbus.NewConsumerConfig(
+		h.config.Kafka.Address,
+		h.config.Kafka.Topic.UserCreated,
+		h.config.Kafka.GroupId,
+		func(ctx context.Context, key, value []byte) error {
+			envelope, err := userCreatedSchemas.Decode(value)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			var user events.UserCreated
+			if err := user.Unmarshal(envelope.Payload); err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			return h.registrations.Finish(ctx, user.Id, redis.RegistrationCreated, "")
+		},
+	))
+
+	h.brokerConsumer.RegisterConsumer(eventbus.NewConsumerConfig(
+		h.config.Kafka.Address,
+		h.config.Kafka.Topic.RegistrationFailed,
+		h.config.Kafka.GroupId,
+		func(ctx context.Context, key, value []byte) error {
+			envelope, err := registrationFailedSchemas.Decode(value)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			var failed events.RegistrationFailed
+			if err := failed.Unmarshal(envelope.Payload); err != nil {
+				return eventbus.Permanent(err)
+			}
+			h.logger.Info("registration failed", zap.String("user_id", failed.Id), zap.String("reason", failed.Reason))
+
+			return h.registrations.Finish(ctx, failed.Id, redis.RegistrationFailed, failed.Reason)
+		},
+	))
+
+	h.brokerConsumer.Run()
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package entity
+
+// AppointmentChange is an appointment.* event as it is streamed to clients:
+// the appointment after the change.
+type AppointmentChange struct {
+	Id            int64  `json:"id"`
+	PatientId     string `json:"patient_id"`
+	DoctorId      string `json:"doctor_id"`
+	DepartmentId  string `json:"department_id"`
+	BranchId      string `json:"branch_id"`
+	StartsAt      string `json:"starts_at"`
+	Duration      int64  `json:"duration"`
+	Modality      string `json:"modality"`
+	Status        string `json:"status"`
+	PatientStatus bool   `json:"patient_status"`
+	// PreviousStartsAt is set when the appointment was moved.
+	PreviousStartsAt string `json:"previous_starts_at,omitempty"`
+	OccurredAt       string `json:"occurred_at"`
+}
+
+// UnreadCount is how many inbox notifications of the caller are unread.
+type UnreadCount struct {
+	Unread int64 `json:"unread"`
+}
+
+// MessageSent is a message.sent event as it is streamed to clients; the
+// body is fetched with the messages of the conversation.
+type MessageSent struct {
+	Id             string `json:"id"`
+	ConversationId string `json:"conversation_id"`
+	AppointmentId  int64  `json:"appointment_id"`
+	SenderRole     string `json:"sender_role"`
+	SentAt         string `json:"sent_at"`
+}
+
+// MessagesRead is the read receipt of a side of a conversation.
+type MessagesRead struct {
+	ConversationId string `json:"conversation_id"`
+	ReaderRole     string `json:"reader_role"`
+	ReadAt         string `json:"read_at"`
+}
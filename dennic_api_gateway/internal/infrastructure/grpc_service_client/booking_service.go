@@ -12,6 +12,15 @@ type BookingServiceI interface {
 	BookedAppointment() booking_service.BookedAppointmentsServiceClient
 	DoctorTimes() booking_service.DoctorTimeServiceClient
 	DoctorNotes() booking_service.DoctorNotesServiceClient
+	Encounters() booking_service.EncounterServiceClient
+	Prescriptions() booking_service.PrescriptionServiceClient
+	LabOrders() booking_service.LabOrderServiceClient
+	Documents() booking_service.DocumentServiceClient
+	Messages() booking_service.MessageServiceClient
+	Billing() booking_service.BillingServiceClient
+	Payments() booking_service.PaymentServiceClient
+	Insurance() booking_service.InsuranceServiceClient
+	History() booking_service.HistoryServiceClient
 }
 
 type BookingService struct {
@@ -20,6 +29,15 @@ type BookingService struct {
 	bookedAppointment booking_service.BookedAppointmentsServiceClient
 	doctorTimes       booking_service.DoctorTimeServiceClient
 	doctorNotes       booking_service.DoctorNotesServiceClient
+	encounters        booking_service.EncounterServiceClient
+	prescriptions     booking_service.PrescriptionServiceClient
+	labOrders         booking_service.LabOrderServiceClient
+	documents         booking_service.DocumentServiceClient
+	messages          booking_service.MessageServiceClient
+	billing           booking_service.BillingServiceClient
+	payments          booking_service.PaymentServiceClient
+	insurance         booking_service.InsuranceServiceClient
+	history           booking_service.HistoryServiceClient
 }
 
 func NewBookingService(conn *grpc.ClientConn) *BookingService {
@@ -29,6 +47,15 @@ func NewBookingService(conn *grpc.ClientConn) *BookingService {
 		bookedAppointment: booking_service.NewBookedAppointmentsServiceClient(conn),
 		doctorTimes:       booking_service.NewDoctorTimeServiceClient(conn),
 		doctorNotes:       booking_service.NewDoctorNotesServiceClient(conn),
+		encounters:        booking_service.NewEncounterServiceClient(conn),
+		prescriptions:     booking_service.NewPrescriptionServiceClient(conn),
+		labOrders:         booking_service.NewLabOrderServiceClient(conn),
+		documents:         booking_service.NewDocumentServiceClient(conn),
+		messages:          booking_service.NewMessageServiceClient(conn),
+		billing:           booking_service.NewBillingServiceClient(conn),
+		payments:          booking_service.NewPaymentServiceClient(conn),
+		insurance:         booking_service.NewInsuranceServiceClient(conn),
+		history:           booking_service.NewHistoryServiceClient(conn),
 	}
 }
 
@@ -51,3 +78,39 @@ func (s *BookingService) DoctorTimes() booking_service.DoctorTimeServiceClient {
 func (s *BookingService) DoctorNotes() booking_service.DoctorNotesServiceClient {
 	return s.doctorNotes
 }
+
+func (s *BookingService) Encounters() booking_service.EncounterServiceClient {
+	return s.encounters
+}
+
+func (s *BookingService) Prescriptions() booking_service.PrescriptionServiceClient {
+	return s.prescriptions
+}
+
+func (s *BookingService) LabOrders() booking_service.LabOrderServiceClient {
+	return s.labOrders
+}
+
+func (s *BookingService) Documents() booking_service.DocumentServiceClient {
+	return s.documents
+}
+
+func (s *BookingService) Messages() booking_service.MessageServiceClient {
+	return s.messages
+}
+
+func (s *BookingService) Billing() booking_service.BillingServiceClient {
+	return s.billing
+}
+
+func (s *BookingService) Payments() booking_service.PaymentServiceClient {
+	return s.payments
+}
+
+func (s *BookingService) Insurance() booking_service.InsuranceServiceClient {
+	return s.insurance
+}
+
+func (s *BookingService) History() booking_service.HistoryServiceClient {
+	return s.history
+}
@@ -13,6 +13,10 @@ type HealthcareServiceI interface {
 	DoctorWorkingHoursService() healthcare.DoctorWorkingHoursServiceClient
 	SpecializationService() healthcare.SpecializationServiceClient
 	ReasonsService() healthcare.ReasonsServiceClient
+	BranchService() healthcare.BranchServiceClient
+	RoomService() healthcare.RoomServiceClient
+	ResourceService() healthcare.ResourceServiceClient
+	ReviewService() healthcare.ReviewServiceClient
 }
 
 type HealthcareService struct {
@@ -22,6 +26,10 @@ type HealthcareService struct {
 	doctorWorkingHoursService healthcare.DoctorWorkingHoursServiceClient
 	specializationService     healthcare.SpecializationServiceClient
 	reasonsService            healthcare.ReasonsServiceClient
+	branchService             healthcare.BranchServiceClient
+	roomService               healthcare.RoomServiceClient
+	resourceService           healthcare.ResourceServiceClient
+	reviewService             healthcare.ReviewServiceClient
 }
 
 func NewHealthcareService(conn *grpc.ClientConn) *HealthcareService {
@@ -32,6 +40,10 @@ func NewHealthcareService(conn *grpc.ClientConn) *HealthcareService {
 		doctorWorkingHoursService: healthcare.NewDoctorWorkingHoursServiceClient(conn),
 		specializationService:     healthcare.NewSpecializationServiceClient(conn),
 		reasonsService:            healthcare.NewReasonsServiceClient(conn),
+		branchService:             healthcare.NewBranchServiceClient(conn),
+		roomService:               healthcare.NewRoomServiceClient(conn),
+		resourceService:           healthcare.NewResourceServiceClient(conn),
+		reviewService:             healthcare.NewReviewServiceClient(conn),
 	}
 }
 
@@ -58,3 +70,19 @@ func (s *HealthcareService) SpecializationService() healthcare.SpecializationSer
 func (s *HealthcareService) ReasonsService() healthcare.ReasonsServiceClient {
 	return s.reasonsService
 }
+
+func (s *HealthcareService) BranchService() healthcare.BranchServiceClient {
+	return s.branchService
+}
+
+func (s *HealthcareService) RoomService() healthcare.RoomServiceClient {
+	return s.roomService
+}
+
+func (s *HealthcareService) ResourceService() healthcare.ResourceServiceClient {
+	return s.resourceService
+}
+
+func (s *HealthcareService) ReviewService() healthcare.ReviewServiceClient {
+	return s.reviewService
+}
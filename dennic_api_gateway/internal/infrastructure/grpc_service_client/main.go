@@ -14,15 +14,17 @@ type ServiceClient interface {
 	HealthcareService() HealthcareServiceI
 	UserService() UserServiceI
 	SessionService() SessionServiceI
+	NotificationService() NotificationServiceI
 	Close()
 }
 
 type serviceClient struct {
-	bookingService    BookingServiceI
-	healthcareService HealthcareServiceI
-	userService       UserServiceI
-	sessionService    SessionServiceI
-	connections       []*grpc.ClientConn
+	bookingService      BookingServiceI
+	healthcareService   HealthcareServiceI
+	userService         UserServiceI
+	sessionService      SessionServiceI
+	notificationService NotificationServiceI
+	connections         []*grpc.ClientConn
 }
 
 func New(cfg *config.Config) (ServiceClient, error) {
@@ -66,12 +68,23 @@ func New(cfg *config.Config) (ServiceClient, error) {
 		return nil, err
 	}
 
+	connNotificationService, err := grpc.Dial(
+		fmt.Sprintf("%s%s", cfg.NotificationService.Host, cfg.NotificationService.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &serviceClient{
-		bookingService:    NewBookingService(connBookingService),
-		healthcareService: NewHealthcareService(connHealthcareService),
-		userService:       NewUserService(connUserService),
-		sessionService:    NewSessionService(connSessionService),
-		connections:       []*grpc.ClientConn{connBookingService, connHealthcareService, connUserService},
+		bookingService:      NewBookingService(connBookingService),
+		healthcareService:   NewHealthcareService(connHealthcareService),
+		userService:         NewUserService(connUserService),
+		sessionService:      NewSessionService(connSessionService),
+		notificationService: NewNotificationService(connNotificationService),
+		connections:         []*grpc.ClientConn{connBookingService, connHealthcareService, connUserService, connNotificationService},
 	}, nil
 }
 
@@ -91,6 +104,10 @@ func (s *serviceClient) SessionService() SessionServiceI {
 	return s.sessionService
 }
 
+func (s *serviceClient) NotificationService() NotificationServiceI {
+	return s.notificationService
+}
+
 func (s *serviceClient) Close() {
 	for _, conn := range s.connections {
 		if err := conn.Close(); err != nil {
@@ -0,0 +1,32 @@
+package grpc_service_clients
+
+import (
+	notification "dennic_api_gateway/genproto/notification_service"
+
+	"google.golang.org/grpc"
+)
+
+type NotificationServiceI interface {
+	NotificationService() notification.NotificationServiceClient
+	WebhookService() notification.WebhookServiceClient
+}
+
+type NotificationService struct {
+	notificationService notification.NotificationServiceClient
+	webhookService      notification.WebhookServiceClient
+}
+
+func NewNotificationService(conn *grpc.ClientConn) *NotificationService {
+	return &NotificationService{
+		notificationService: notification.NewNotificationServiceClient(conn),
+		webhookService:      notification.NewWebhookServiceClient(conn),
+	}
+}
+
+func (s *NotificationService) NotificationService() notification.NotificationServiceClient {
+	return s.notificationService
+}
+
+func (s *NotificationService) WebhookService() notification.WebhookServiceClient {
+	return s.webhookService
+}
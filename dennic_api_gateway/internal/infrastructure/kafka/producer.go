@@ -0,0 +1,70 @@
+package kafka
+
+import (
+	"context"
+	"dennic_api_gateway/genproto/events"
+	"dennic_api_gateway/internal/pkg/config"
+	"dennic_api_gateway/internal/pkg/otlp"
+	"dennic_kafka/eventbus"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// EventUserCreate asks the user service to register a user.
+const EventUserCreate = "user.create"
+
+// userCreateEventVersion is the version of events.UserCreate published on the
+// user create topic.
+const userCreateEventVersion = 1
+
+type producer struct {
+	logger     *zap.Logger
+	name       string
+	userCreate *kafka.Writer
+}
+
+func NewProducer(config *config.Config, logger *zap.Logger) *producer {
+	return &producer{
+		logger: logger,
+		name:   config.APP,
+		userCreate: &kafka.Writer{
+			Addr:                   kafka.TCP(config.Kafka.Address...),
+			Topic:                  config.Kafka.Topic.UserCreate,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+		},
+	}
+}
+
+// buildMessage carries the trace of ctx in the message headers.
+func (p *producer) buildMessage(ctx context.Context, key string, value []byte) kafka.Message {
+	message := kafka.Message{
+		Key:   []byte(key),
+		Value: value,
+	}
+	eventbus.InjectTraceContext(ctx, &message)
+	return message
+}
+
+// PublishUserCreate keys the event by the phone number, so registrations of
+// one number are handled in the order they were verified.
+func (p *producer) PublishUserCreate(ctx context.Context, user *events.UserCreate) error {
+	ctx, span := otlp.Start(ctx, "kafka producer", "UserCreateProducer")
+	defer span.End()
+
+	value, err := eventbus.NewEnvelope(ctx, p.name, EventUserCreate, userCreateEventVersion, time.Now(), user)
+	if err != nil {
+		return err
+	}
+
+	return p.userCreate.WriteMessages(ctx, p.buildMessage(ctx, user.PhoneNumber, value))
+}
+
+func (p *producer) Close() {
+	if err := p.userCreate.Close(); err != nil {
+		p.logger.Error("error during close writer userCreate", zap.Error(err))
+	}
+}
@@ -15,6 +15,8 @@ type Cache interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
 	Get(ctx context.Context, key string) ([]byte, error)
 	Del(ctx context.Context, key string) error
+	// GetDel gets the value of key and deletes it at once.
+	GetDel(ctx context.Context, key string) ([]byte, error)
 }
 
 func NewCache(rdb *redis.RedisDB) *cache {
@@ -71,3 +73,16 @@ func (c *cache) Del(ctx context.Context, key string) error {
 
 	return nil
 }
+
+func (c *cache) GetDel(ctx context.Context, key string) ([]byte, error) {
+	// tracing
+	ctx, span := otlp_pkg.Start(ctx, "cecheService", "CasheRepoGetDel")
+	defer span.End()
+
+	data, err := c.rdb.Client.GetDel(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(data), nil
+}
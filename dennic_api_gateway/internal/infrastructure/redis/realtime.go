@@ -0,0 +1,195 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+
+	otlp_pkg "dennic_api_gateway/internal/pkg/otlp"
+	"dennic_api_gateway/internal/pkg/redis"
+)
+
+// realtimeChannel is the Redis channel every gateway replica publishes the
+// events for clients on and receives them from.
+const realtimeChannel = "realtime"
+
+// UserChannel is watched by a signed in user: their appointments, as the
+// patient or the doctor, and their unread count.
+func UserChannel(userId string) string {
+	return "user:" + userId
+}
+
+// BranchChannel is watched by the reception desk of a branch: every
+// appointment of the branch.
+func BranchChannel(branchId string) string {
+	return "branch:" + branchId
+}
+
+// Event is a change streamed to the clients watching one of its audience
+// channels; Type names it, like appointment.updated.
+type Event struct {
+	Id       string          `json:"id"`
+	Type     string          `json:"type"`
+	Data     json.RawMessage `json:"data"`
+	Audience []string        `json:"audience"`
+}
+
+// Realtime fans events out to the clients of every gateway replica: an
+// event is published on Redis once and each replica hands it to its own
+// subscribers.
+type Realtime interface {
+	Publish(ctx context.Context, event *Event) error
+	// Subscribe streams the events for channels until cancel is called. A
+	// subscriber that falls behind by more than the buffer has its stream
+	// closed, to reconnect and reload what it missed.
+	Subscribe(channels ...string) (events <-chan *Event, cancel func())
+}
+
+func NewRealtime(rdb *redis.RedisDB, logger *zap.Logger, buffer int) *realtime {
+	return &realtime{
+		rdb:         rdb,
+		logger:      logger,
+		buffer:      buffer,
+		subscribers: make(map[string]map[*subscriber]struct{}),
+	}
+}
+
+type realtime struct {
+	rdb    *redis.RedisDB
+	logger *zap.Logger
+	buffer int
+
+	mu          sync.Mutex
+	subscribers map[string]map[*subscriber]struct{}
+	stopped     bool
+}
+
+type subscriber struct {
+	events   chan *Event
+	channels []string
+	closed   bool
+}
+
+func (r *realtime) Publish(ctx context.Context, event *Event) error {
+	ctx, span := otlp_pkg.Start(ctx, "realtimeService", "RealtimePublish")
+	span.SetAttributes(attribute.Key("type").String(event.Type))
+	defer span.End()
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return r.rdb.Client.Publish(ctx, realtimeChannel, data).Err()
+}
+
+func (r *realtime) Subscribe(channels ...string) (<-chan *Event, func()) {
+	s := &subscriber{
+		events:   make(chan *Event, r.buffer),
+		channels: channels,
+	}
+
+	r.mu.Lock()
+	if r.stopped {
+		r.mu.Unlock()
+		close(s.events)
+		return s.events, func() {}
+	}
+	for _, channel := range channels {
+		if r.subscribers[channel] == nil {
+			r.subscribers[channel] = make(map[*subscriber]struct{})
+		}
+		r.subscribers[channel][s] = struct{}{}
+	}
+	r.mu.Unlock()
+
+	return s.events, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.remove(s)
+	}
+}
+
+// Run hands the events published by every replica to the subscribers of
+// this one until ctx is done, then closes their streams so the server can
+// shut down. The subscription reconnects by itself when Redis goes away;
+// events published meanwhile are lost.
+func (r *realtime) Run(ctx context.Context) {
+	pubsub := r.rdb.Client.Subscribe(ctx, realtimeChannel)
+	defer pubsub.Close()
+	defer r.stop()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			var event Event
+			if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+				r.logger.Error("realtime event", zap.Error(err))
+				continue
+			}
+			r.dispatch(&event)
+		}
+	}
+}
+
+// dispatch hands the event once to every subscriber watching one of its
+// audience channels.
+func (r *realtime) dispatch(event *Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delivered := make(map[*subscriber]struct{})
+	for _, channel := range event.Audience {
+		for s := range r.subscribers[channel] {
+			if _, ok := delivered[s]; ok {
+				continue
+			}
+			delivered[s] = struct{}{}
+
+			select {
+			case s.events <- event:
+			default:
+				r.logger.Warn("realtime subscriber fell behind", zap.Strings("channels", s.channels))
+				r.remove(s)
+			}
+		}
+	}
+}
+
+func (r *realtime) stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stopped = true
+	for _, subscribers := range r.subscribers {
+		for s := range subscribers {
+			r.remove(s)
+		}
+	}
+}
+
+// remove drops the subscriber and closes its stream; r.mu is held.
+func (r *realtime) remove(s *subscriber) {
+	if s.closed {
+		return
+	}
+	s.closed = true
+
+	for _, channel := range s.channels {
+		delete(r.subscribers[channel], s)
+		if len(r.subscribers[channel]) == 0 {
+			delete(r.subscribers, channel)
+		}
+	}
+	close(s.events)
+}
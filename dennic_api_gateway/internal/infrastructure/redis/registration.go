@@ -0,0 +1,151 @@
+package redis
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+
+	"dennic_api_gateway/internal/pkg/redis"
+)
+
+// Registration statuses. A pending registration is published to the user
+// service and not registered yet.
+const (
+	RegistrationPending = "pending"
+	RegistrationCreated = "created"
+	RegistrationFailed  = "failed"
+)
+
+// ErrRegistrationNotFound is a registration never published or expired, or
+// tokens handed out already.
+var ErrRegistrationNotFound = errors.New("registration not found")
+
+// Registration is a user the gateway asked the user service to register.
+// Only the hash of the token polling it is kept.
+type Registration struct {
+	Id          string `json:"id"`
+	TokenHash   string `json:"token_hash"`
+	Status      string `json:"status"`
+	Reason      string `json:"reason"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name"`
+	BirthDate   string `json:"birth_date"`
+	PhoneNumber string `json:"phone_number"`
+	Gender      string `json:"gender"`
+}
+
+// RegistrationTokens are the tokens issued for a registration, handed out
+// once the user is created.
+type RegistrationTokens struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// Registrations keeps the registrations for clients to poll until ttl.
+type Registrations interface {
+	Save(ctx context.Context, registration *Registration, tokens *RegistrationTokens) error
+	Get(ctx context.Context, id string) (*Registration, error)
+	// TakeTokens hands the tokens of a registration out once; later calls
+	// get ErrRegistrationNotFound.
+	TakeTokens(ctx context.Context, id string) (*RegistrationTokens, error)
+	// Finish records the outcome of a registration; one expired meanwhile
+	// is left alone.
+	Finish(ctx context.Context, id, status, reason string) error
+}
+
+// RegistrationTokenHash is the hash a registration keeps of its token.
+func RegistrationTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// ValidToken tells whether token is the one the registration is polled with.
+func (r *Registration) ValidToken(token string) bool {
+	return token != "" && subtle.ConstantTimeCompare([]byte(RegistrationTokenHash(token)), []byte(r.TokenHash)) == 1
+}
+
+func NewRegistrations(rdb *redis.RedisDB, ttl time.Duration) *registrations {
+	return &registrations{
+		cache: NewCache(rdb),
+		ttl:   ttl,
+	}
+}
+
+type registrations struct {
+	cache Cache
+	ttl   time.Duration
+}
+
+func registrationKey(id string) string {
+	return "registration:" + id
+}
+
+func registrationTokensKey(id string) string {
+	return "registration:" + id + ":tokens"
+}
+
+func (r *registrations) Save(ctx context.Context, registration *Registration, tokens *RegistrationTokens) error {
+	if err := r.cache.Set(ctx, registrationTokensKey(registration.Id), tokens, r.ttl); err != nil {
+		return err
+	}
+	return r.save(ctx, registration)
+}
+
+func (r *registrations) save(ctx context.Context, registration *Registration) error {
+	return r.cache.Set(ctx, registrationKey(registration.Id), registration, r.ttl)
+}
+
+func (r *registrations) Get(ctx context.Context, id string) (*Registration, error) {
+	data, err := r.cache.Get(ctx, registrationKey(id))
+	if errors.Is(err, goredis.Nil) {
+		return nil, ErrRegistrationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var registration Registration
+	if err := json.Unmarshal(data, &registration); err != nil {
+		return nil, err
+	}
+
+	return &registration, nil
+}
+
+func (r *registrations) TakeTokens(ctx context.Context, id string) (*RegistrationTokens, error) {
+	data, err := r.cache.GetDel(ctx, registrationTokensKey(id))
+	if errors.Is(err, goredis.Nil) {
+		return nil, ErrRegistrationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens RegistrationTokens
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		return nil, err
+	}
+
+	return &tokens, nil
+}
+
+func (r *registrations) Finish(ctx context.Context, id, status, reason string) error {
+	registration, err := r.Get(ctx, id)
+	if errors.Is(err, ErrRegistrationNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	registration.Status = status
+	registration.Reason = reason
+
+	return r.save(ctx, registration)
+}
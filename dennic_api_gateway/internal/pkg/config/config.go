@@ -21,6 +21,23 @@ type minio struct {
 	Endpoint  string
 	AccessKey string
 	SecretKey string
+	// PublicEndpoint is the host presigned URLs are issued for, the one
+	// clients reach MinIO on
+	PublicEndpoint string
+	Region         string
+	Documents      struct {
+		Bucket  string
+		URLTTL  time.Duration
+		MaxSize int64
+	}
+	// Messages keeps the attachments of patient messages; the bucket expires
+	// them after RetentionDays, matching the retention of the messages
+	Messages struct {
+		Bucket        string
+		URLTTL        time.Duration
+		MaxSize       int64
+		RetentionDays int
+	}
 }
 
 type Config struct {
@@ -66,15 +83,46 @@ type Config struct {
 	}
 	Kafka struct {
 		Address []string
+		GroupId string
 		Topic   struct {
-			InvestmentPaymentTransaction string
+			UserCreate         string
+			UserCreated        string
+			RegistrationFailed string
+			// Appointments, Inbox and Messages are streamed to the clients
+			// watching them, see Realtime.
+			Appointments string
+			Inbox        string
+			Messages     string
+		}
+		// Retry bounds how often a failed message is handled again before
+		// it is parked on its topic with DeadLetterSuffix appended.
+		Retry struct {
+			MaxAttempts string
+			Backoff     string
+			MaxBackoff  string
 		}
+		DeadLetterSuffix string
 	}
-	BookingService    webAddress
-	HealthcareService webAddress
-	UserService       webAddress
-	SessionService    webAddress
-	OTLPCollector     webAddress
+	// Registration is how long clients can poll the status of a
+	// registration published to the user service.
+	Registration struct {
+		TTL time.Duration
+	}
+	// Realtime streams events to clients over server-sent events. Heartbeat
+	// keeps idle streams open through proxies; a client more than Buffer
+	// events behind is disconnected to reconnect and reload. Changes older
+	// than MaxAge, consumed late, are not streamed.
+	Realtime struct {
+		Heartbeat time.Duration
+		Buffer    int
+		MaxAge    time.Duration
+	}
+	BookingService      webAddress
+	HealthcareService   webAddress
+	UserService         webAddress
+	SessionService      webAddress
+	NotificationService webAddress
+	OTLPCollector       webAddress
 
 	MinioService minio
 }
@@ -118,6 +166,8 @@ func NewConfig() (*Config, error) {
 
 	config.SessionService.Host = getEnv("SESSION_SERVICE_GRPC_HOST", "dennic_session_service")
 	config.SessionService.Port = getEnv("SESSION_SERVICE_GRPC_PORT", ":9060")
+	config.NotificationService.Host = getEnv("NOTIFICATION_SERVICE_GRPC_HOST", "dennic_notification_service")
+	config.NotificationService.Port = getEnv("NOTIFICATION_SERVICE_GRPC_PORT", ":9040")
 
 	config.UserService.Host = getEnv("USER_SERVICE_GRPC_HOST", "dennic_user_service")
 	config.UserService.Port = getEnv("USER_SERVICE_GRPC_PORT", ":9070")
@@ -144,12 +194,63 @@ func NewConfig() (*Config, error) {
 
 	// kafka configuration
 	config.Kafka.Address = strings.Split(getEnv("KAFKA_ADDRESS", "localhost:29092"), ",")
-	config.Kafka.Topic.InvestmentPaymentTransaction = getEnv("KAFKA_TOPIC_INVESTMENT_PAYMENT_TRANSACTION", "investment.payment.transaction")
+	config.Kafka.GroupId = getEnv("KAFKA_GROUP_ID", "dennic_api_gateway")
+	config.Kafka.Topic.UserCreate = getEnv("KAFKA_TOPIC_USER_CREATE", "api.user.create")
+	config.Kafka.Topic.UserCreated = getEnv("KAFKA_TOPIC_USER_CREATED", "user.created")
+	config.Kafka.Topic.RegistrationFailed = getEnv("KAFKA_TOPIC_REGISTRATION_FAILED", "user.registration_failed")
+	config.Kafka.Topic.Appointments = getEnv("KAFKA_TOPIC_APPOINTMENTS", "booking.appointments")
+	config.Kafka.Topic.Inbox = getEnv("KAFKA_TOPIC_INBOX", "notification.inbox")
+	config.Kafka.Topic.Messages = getEnv("KAFKA_TOPIC_MESSAGES", "booking.messages")
+	config.Kafka.Retry.MaxAttempts = getEnv("KAFKA_RETRY_MAX_ATTEMPTS", "5")
+	config.Kafka.Retry.Backoff = getEnv("KAFKA_RETRY_BACKOFF", "1s")
+	config.Kafka.Retry.MaxBackoff = getEnv("KAFKA_RETRY_MAX_BACKOFF", "30s")
+	config.Kafka.DeadLetterSuffix = getEnv("KAFKA_DEAD_LETTER_SUFFIX", ".dlq")
+
+	// registration status
+	registrationTTL, err := time.ParseDuration(getEnv("REGISTRATION_TTL", "10m"))
+	if err != nil {
+		return nil, err
+	}
+	config.Registration.TTL = registrationTTL
+
+	// realtime streams
+	realtimeHeartbeat, err := time.ParseDuration(getEnv("REALTIME_HEARTBEAT", "25s"))
+	if err != nil {
+		return nil, err
+	}
+	config.Realtime.Heartbeat = realtimeHeartbeat
+	config.Realtime.Buffer = cast.ToInt(getEnv("REALTIME_BUFFER", "64"))
+	realtimeMaxAge, err := time.ParseDuration(getEnv("REALTIME_MAX_AGE", "1m"))
+	if err != nil {
+		return nil, err
+	}
+	config.Realtime.MaxAge = realtimeMaxAge
 
 	// model_minio configuration
 	config.MinioService.Endpoint = getEnv("MINIO_SERVICE_ENDPOINT", "minio:9000")
 	config.MinioService.AccessKey = getEnv("MINIO_SERVICE_ACCESS_KEY", "dennic")
 	config.MinioService.SecretKey = getEnv("MINIO_SERVICE_SECRET_KEY", "dennic_service")
+	config.MinioService.PublicEndpoint = getEnv("MINIO_SERVICE_PUBLIC_ENDPOINT", "dennic.uz:9000")
+	config.MinioService.Region = getEnv("MINIO_SERVICE_REGION", "us-east-1")
+
+	// patient document vault
+	documentURLTTL, err := time.ParseDuration(getEnv("DOCUMENT_URL_TTL", "5m"))
+	if err != nil {
+		return nil, err
+	}
+	config.MinioService.Documents.Bucket = getEnv("DOCUMENT_BUCKET", "patient-documents")
+	config.MinioService.Documents.URLTTL = documentURLTTL
+	config.MinioService.Documents.MaxSize = cast.ToInt64(getEnv("DOCUMENT_MAX_SIZE", "20971520"))
+
+	// patient message attachments
+	messageURLTTL, err := time.ParseDuration(getEnv("MESSAGE_URL_TTL", "5m"))
+	if err != nil {
+		return nil, err
+	}
+	config.MinioService.Messages.Bucket = getEnv("MESSAGE_BUCKET", "patient-messages")
+	config.MinioService.Messages.URLTTL = messageURLTTL
+	config.MinioService.Messages.MaxSize = cast.ToInt64(getEnv("MESSAGE_MAX_SIZE", "10485760"))
+	config.MinioService.Messages.RetentionDays = cast.ToInt(getEnv("MESSAGE_RETENTION_DAYS", "730"))
 
 	return &config, nil
 }
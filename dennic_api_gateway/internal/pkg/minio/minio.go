@@ -41,7 +41,7 @@ func UploadToMinio(cfg *config.Config, objectName string, content []byte, bucket
 		return "", err
 	}
 
-	objectURL := fmt.Sprintf("http://dennic.uz:9000/%s/%s", bucketName, objectName)
+	objectURL := fmt.Sprintf("http://%s/%s/%s", cfg.MinioService.PublicEndpoint, bucketName, objectName)
 
 	return objectURL, nil
 }
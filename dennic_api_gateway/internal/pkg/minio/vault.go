@@ -0,0 +1,103 @@
+package minio
+
+import (
+	"bytes"
+	"context"
+	"dennic_api_gateway/internal/pkg/config"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+)
+
+// The vault keeps patient documents in private buckets: objects carry no
+// public-read ACL and are only handed out through presigned URLs.
+
+func newClient(cfg *config.Config, endpoint string) (*minio.Client, error) {
+	return minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.MinioService.AccessKey, cfg.MinioService.SecretKey, ""),
+		Secure: false,
+		// a fixed region spares presigning the bucket location lookup
+		Region: cfg.MinioService.Region,
+	})
+}
+
+// PutPrivateObject stores content in a private bucket, creating the bucket
+// on first use.
+func PutPrivateObject(ctx context.Context, cfg *config.Config, bucketName, objectName string, content []byte, contentType string) error {
+	return putObject(ctx, cfg, bucketName, objectName, content, contentType, 0)
+}
+
+// PutExpiringObject stores content in a private bucket whose objects expire
+// after expireDays. The expiry rule is set when the bucket is created on first
+// use.
+func PutExpiringObject(ctx context.Context, cfg *config.Config, bucketName, objectName string, content []byte, contentType string, expireDays int) error {
+	return putObject(ctx, cfg, bucketName, objectName, content, contentType, expireDays)
+}
+
+func putObject(ctx context.Context, cfg *config.Config, bucketName, objectName string, content []byte, contentType string, expireDays int) error {
+	minioClient, err := newClient(cfg, cfg.MinioService.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	found, err := minioClient.BucketExists(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	if !found {
+		err = minioClient.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{Region: cfg.MinioService.Region})
+		if err != nil {
+			return err
+		}
+		if expireDays > 0 {
+			rules := lifecycle.NewConfiguration()
+			rules.Rules = []lifecycle.Rule{{
+				ID:         "expire-objects",
+				Status:     "Enabled",
+				Expiration: lifecycle.Expiration{Days: lifecycle.ExpirationDays(expireDays)},
+			}}
+			if err = minioClient.SetBucketLifecycle(ctx, bucketName, rules); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = minioClient.PutObject(ctx, bucketName, objectName, bytes.NewReader(content), int64(len(content)), minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	return err
+}
+
+// PresignedURL returns a download URL for a private object that expires
+// after ttl. The URL is signed for the public endpoint so clients can use it
+// directly, and makes the browser save the file under fileName.
+func PresignedURL(ctx context.Context, cfg *config.Config, bucketName, objectName, fileName string, ttl time.Duration) (string, error) {
+	minioClient, err := newClient(cfg, cfg.MinioService.PublicEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("response-content-disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+
+	presigned, err := minioClient.PresignedGetObject(ctx, bucketName, objectName, ttl, params)
+	if err != nil {
+		return "", err
+	}
+
+	return presigned.String(), nil
+}
+
+// RemoveObject deletes an object from storage.
+func RemoveObject(ctx context.Context, cfg *config.Config, bucketName, objectName string) error {
+	minioClient, err := newClient(cfg, cfg.MinioService.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	return minioClient.RemoveObject(ctx, bucketName, objectName, minio.RemoveObjectOptions{})
+}
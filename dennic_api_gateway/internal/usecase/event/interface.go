@@ -0,0 +1,25 @@
+package event
+
+import (
+	"context"
+	"dennic_api_gateway/genproto/events"
+	"dennic_kafka/eventbus"
+)
+
+// ConsumerConfig is a topic to consume and the handler of its messages,
+// see eventbus.NewConsumerConfig.
+type ConsumerConfig = eventbus.ConsumerConfig
+
+type BrokerConsumer interface {
+	Run()
+	RegisterConsumer(config ConsumerConfig)
+	Close()
+}
+
+// BrokerProducer publishes the events the gateway hands over to the
+// services. It waits for the broker, so a request fails when its event is
+// not published.
+type BrokerProducer interface {
+	PublishUserCreate(ctx context.Context, user *events.UserCreate) error
+	Close()
+}
@@ -40,6 +40,15 @@ type Appointment struct {
 	CreatedAt            string   `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string   `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	DeletedAt            string   `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	BranchId             string   `protobuf:"bytes,14,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
+	ResourceId           string   `protobuf:"bytes,15,opt,name=resource_id,json=resourceId,proto3" json:"resource_id"`
+	Modality             string   `protobuf:"bytes,16,opt,name=modality,proto3" json:"modality"`
+	DoctorServiceId      string   `protobuf:"bytes,17,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	Price                string   `protobuf:"bytes,18,opt,name=price,proto3" json:"price"`
+	Currency             string   `protobuf:"bytes,19,opt,name=currency,proto3" json:"currency"`
+	VideoRoomId          string   `protobuf:"bytes,20,opt,name=video_room_id,json=videoRoomId,proto3" json:"video_room_id"`
+	VideoProvider        string   `protobuf:"bytes,21,opt,name=video_provider,json=videoProvider,proto3" json:"video_provider"`
+	Status               string   `protobuf:"bytes,22,opt,name=status,proto3" json:"status"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -169,6 +178,69 @@ func (m *Appointment) GetDeletedAt() string {
 	return ""
 }
 
+func (m *Appointment) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
+func (m *Appointment) GetResourceId() string {
+	if m != nil {
+		return m.ResourceId
+	}
+	return ""
+}
+
+func (m *Appointment) GetModality() string {
+	if m != nil {
+		return m.Modality
+	}
+	return ""
+}
+
+func (m *Appointment) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *Appointment) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *Appointment) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *Appointment) GetVideoRoomId() string {
+	if m != nil {
+		return m.VideoRoomId
+	}
+	return ""
+}
+
+func (m *Appointment) GetVideoProvider() string {
+	if m != nil {
+		return m.VideoProvider
+	}
+	return ""
+}
+
+func (m *Appointment) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
 type Appointments struct {
 	Count                int64          `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
 	Appointments         []*Appointment `protobuf:"bytes,2,rep,name=appointments,proto3" json:"appointments"`
@@ -234,6 +306,10 @@ type CreateAppointmentReq struct {
 	Key                  string   `protobuf:"bytes,8,opt,name=key,proto3" json:"key"`
 	ExpiresAt            string   `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at"`
 	PatientStatus        bool     `protobuf:"varint,10,opt,name=patient_status,json=patientStatus,proto3" json:"patient_status"`
+	BranchId             string   `protobuf:"bytes,11,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
+	ResourceId           string   `protobuf:"bytes,12,opt,name=resource_id,json=resourceId,proto3" json:"resource_id"`
+	Modality             string   `protobuf:"bytes,13,opt,name=modality,proto3" json:"modality"`
+	DoctorServiceId      string   `protobuf:"bytes,14,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -335,6 +411,34 @@ func (m *CreateAppointmentReq) GetPatientStatus() bool {
 	return false
 }
 
+func (m *CreateAppointmentReq) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
+func (m *CreateAppointmentReq) GetResourceId() string {
+	if m != nil {
+		return m.ResourceId
+	}
+	return ""
+}
+
+func (m *CreateAppointmentReq) GetModality() string {
+	if m != nil {
+		return m.Modality
+	}
+	return ""
+}
+
+func (m *CreateAppointmentReq) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
 type UpdateAppointmentReq struct {
 	AppointmentDate      string   `protobuf:"bytes,2,opt,name=appointment_date,json=appointmentDate,proto3" json:"appointment_date"`
 	AppointmentTime      string   `protobuf:"bytes,3,opt,name=appointment_time,json=appointmentTime,proto3" json:"appointment_time"`
@@ -549,12 +653,16 @@ func (m *DeleteAppointmentStatus) GetStatus() bool {
 }
 
 type GetAllAppointmentsReq struct {
-	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
-	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
-	IsActive             bool     `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active"`
-	Page                 uint64   `protobuf:"varint,4,opt,name=page,proto3" json:"page"`
-	Limit                uint64   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
-	OrderBy              string   `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	Value    string `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+	IsActive bool   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	Page     uint64 `protobuf:"varint,4,opt,name=page,proto3" json:"page"`
+	Limit    uint64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
+	OrderBy  string `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
+	BranchId string `protobuf:"bytes,7,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
+	// exact filters, e.g. whether a doctor has seen a patient
+	DoctorId             string   `protobuf:"bytes,8,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	PatientId            string   `protobuf:"bytes,9,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -635,6 +743,216 @@ func (m *GetAllAppointmentsReq) GetOrderBy() string {
 	return ""
 }
 
+func (m *GetAllAppointmentsReq) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
+func (m *GetAllAppointmentsReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *GetAllAppointmentsReq) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+type JoinLinkReq struct {
+	AppointmentId        int64    `protobuf:"varint,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
+	ParticipantId        string   `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinLinkReq) Reset()         { *m = JoinLinkReq{} }
+func (m *JoinLinkReq) String() string { return proto.CompactTextString(m) }
+func (*JoinLinkReq) ProtoMessage()    {}
+func (*JoinLinkReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ede99e18a76dc86, []int{7}
+}
+func (m *JoinLinkReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JoinLinkReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JoinLinkReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JoinLinkReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinLinkReq.Merge(m, src)
+}
+func (m *JoinLinkReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *JoinLinkReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinLinkReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinLinkReq proto.InternalMessageInfo
+
+func (m *JoinLinkReq) GetAppointmentId() int64 {
+	if m != nil {
+		return m.AppointmentId
+	}
+	return 0
+}
+
+func (m *JoinLinkReq) GetParticipantId() string {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return ""
+}
+
+type JoinLink struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url"`
+	RoomId               string   `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id"`
+	Provider             string   `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider"`
+	NotBefore            string   `protobuf:"bytes,4,opt,name=not_before,json=notBefore,proto3" json:"not_before"`
+	ExpiresAt            string   `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinLink) Reset()         { *m = JoinLink{} }
+func (m *JoinLink) String() string { return proto.CompactTextString(m) }
+func (*JoinLink) ProtoMessage()    {}
+func (*JoinLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ede99e18a76dc86, []int{8}
+}
+func (m *JoinLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JoinLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JoinLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JoinLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinLink.Merge(m, src)
+}
+func (m *JoinLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *JoinLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinLink proto.InternalMessageInfo
+
+func (m *JoinLink) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *JoinLink) GetRoomId() string {
+	if m != nil {
+		return m.RoomId
+	}
+	return ""
+}
+
+func (m *JoinLink) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *JoinLink) GetNotBefore() string {
+	if m != nil {
+		return m.NotBefore
+	}
+	return ""
+}
+
+func (m *JoinLink) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
+type AppointmentStatusReq struct {
+	AppointmentId        int64    `protobuf:"varint,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AppointmentStatusReq) Reset()         { *m = AppointmentStatusReq{} }
+func (m *AppointmentStatusReq) String() string { return proto.CompactTextString(m) }
+func (*AppointmentStatusReq) ProtoMessage()    {}
+func (*AppointmentStatusReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ede99e18a76dc86, []int{9}
+}
+func (m *AppointmentStatusReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppointmentStatusReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppointmentStatusReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppointmentStatusReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppointmentStatusReq.Merge(m, src)
+}
+func (m *AppointmentStatusReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppointmentStatusReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppointmentStatusReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppointmentStatusReq proto.InternalMessageInfo
+
+func (m *AppointmentStatusReq) GetAppointmentId() int64 {
+	if m != nil {
+		return m.AppointmentId
+	}
+	return 0
+}
+
+func (m *AppointmentStatusReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Appointment)(nil), "booking_service.Appointment")
 	proto.RegisterType((*Appointments)(nil), "booking_service.Appointments")
@@ -643,6 +961,9 @@ func init() {
 	proto.RegisterType((*AppointmentFieldValueReq)(nil), "booking_service.AppointmentFieldValueReq")
 	proto.RegisterType((*DeleteAppointmentStatus)(nil), "booking_service.DeleteAppointmentStatus")
 	proto.RegisterType((*GetAllAppointmentsReq)(nil), "booking_service.GetAllAppointmentsReq")
+	proto.RegisterType((*JoinLinkReq)(nil), "booking_service.JoinLinkReq")
+	proto.RegisterType((*JoinLink)(nil), "booking_service.JoinLink")
+	proto.RegisterType((*AppointmentStatusReq)(nil), "booking_service.AppointmentStatusReq")
 }
 
 func init() {
@@ -650,47 +971,68 @@ func init() {
 }
 
 var fileDescriptor_8ede99e18a76dc86 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0x5d, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0x76, 0x92, 0x3a, 0xd3, 0xf4, 0x6f, 0x15, 0xc0, 0x2d, 0x34, 0x8a, 0x8c, 0x8a, 0xd2,
-	0x97, 0x22, 0xca, 0x05, 0x70, 0xa9, 0x40, 0x7d, 0x75, 0x01, 0x01, 0x2f, 0xd6, 0xc6, 0xbb, 0x2d,
-	0xab, 0x3a, 0xb1, 0xb1, 0x37, 0x11, 0xb9, 0x03, 0x07, 0xe0, 0x02, 0x3d, 0x08, 0x6f, 0x3c, 0x72,
-	0x04, 0x14, 0x2e, 0xc0, 0x11, 0xd0, 0xfe, 0xb4, 0xdd, 0xc6, 0x6e, 0x52, 0x24, 0x1e, 0x79, 0xf3,
-	0x7c, 0xf3, 0xcd, 0x64, 0xe6, 0x9b, 0x99, 0x0d, 0xec, 0xf6, 0xd3, 0xf4, 0x8c, 0x0d, 0x4f, 0xa3,
-	0x82, 0xe6, 0x63, 0x16, 0xd3, 0x27, 0xc2, 0xa6, 0x24, 0xc2, 0x59, 0x96, 0xb2, 0x21, 0x1f, 0xd0,
-	0x21, 0x2f, 0xf6, 0xb2, 0x3c, 0xe5, 0x29, 0x5a, 0x9b, 0xa1, 0xfa, 0xe7, 0x0e, 0x2c, 0x07, 0x57,
-	0x3c, 0xb4, 0x0a, 0x36, 0x23, 0x9e, 0xd5, 0xb5, 0x7a, 0x4e, 0x68, 0x33, 0x82, 0x1e, 0xc1, 0x0a,
-	0xa1, 0x19, 0xce, 0xa5, 0x37, 0x62, 0xc4, 0xb3, 0xbb, 0x56, 0xaf, 0x19, 0xb6, 0xae, 0xc0, 0x23,
-	0x82, 0x1e, 0x40, 0x93, 0xa4, 0x31, 0x4f, 0x73, 0x41, 0x70, 0x24, 0xc1, 0x55, 0xc0, 0x11, 0x41,
-	0xdb, 0x00, 0x19, 0xe6, 0x4c, 0x87, 0xd7, 0xa4, 0xb7, 0xa9, 0x91, 0x23, 0x82, 0x76, 0x61, 0xdd,
-	0xa8, 0x33, 0x22, 0x98, 0x53, 0xaf, 0x2e, 0x49, 0x6b, 0x06, 0x7e, 0x88, 0x39, 0x9d, 0xa5, 0x72,
-	0x36, 0xa0, 0x5e, 0xa3, 0x44, 0x7d, 0xcd, 0x06, 0x14, 0x6d, 0x81, 0x4b, 0x46, 0x39, 0xe6, 0x2c,
-	0x1d, 0x7a, 0x4b, 0xb2, 0x99, 0x4b, 0x1b, 0xad, 0x83, 0x73, 0x46, 0x27, 0x9e, 0x2b, 0x23, 0xc5,
-	0xa7, 0x28, 0x91, 0x7e, 0xce, 0x58, 0x4e, 0x8b, 0x08, 0x73, 0xaf, 0xa9, 0x4a, 0xd4, 0x48, 0xc0,
-	0xd1, 0x0e, 0xac, 0x5e, 0x74, 0x50, 0x70, 0xcc, 0x47, 0x85, 0x07, 0x5d, 0xab, 0xe7, 0x86, 0x2b,
-	0x1a, 0x3d, 0x96, 0xa0, 0xc8, 0x12, 0xe7, 0x14, 0x73, 0xa1, 0x3c, 0xf7, 0x96, 0x55, 0x16, 0x8d,
-	0x04, 0x5c, 0xb8, 0x47, 0x19, 0xb9, 0x70, 0xb7, 0x94, 0x5b, 0x23, 0xca, 0x4d, 0x68, 0x42, 0xb5,
-	0x7b, 0x45, 0xb9, 0x35, 0x12, 0x70, 0xff, 0x04, 0x5a, 0xc6, 0x98, 0x0a, 0xd4, 0x86, 0x7a, 0x9c,
-	0x8e, 0x86, 0x5c, 0x8f, 0x4a, 0x19, 0xe8, 0x39, 0xb4, 0xcc, 0xa1, 0x7b, 0x76, 0xd7, 0xe9, 0x2d,
-	0xef, 0x3f, 0xdc, 0x9b, 0x99, 0xfa, 0x9e, 0x91, 0x2a, 0xbc, 0x16, 0xe1, 0x7f, 0xb3, 0xa1, 0xfd,
-	0x42, 0xd6, 0x6c, 0x72, 0xe8, 0xa7, 0xff, 0x8b, 0x70, 0xeb, 0x45, 0xf0, 0xbf, 0xd8, 0xd0, 0x7e,
-	0x23, 0x07, 0x3b, 0xa3, 0x61, 0x55, 0x8b, 0xf6, 0xed, 0x5b, 0x74, 0x16, 0xb7, 0x58, 0xab, 0x6e,
-	0xb1, 0x7e, 0x53, 0x8b, 0x8d, 0xc5, 0x2d, 0x2e, 0x55, 0xed, 0x7a, 0x1b, 0xea, 0x27, 0x8c, 0x26,
-	0x44, 0x8b, 0xa7, 0x0c, 0x81, 0x8e, 0x71, 0x32, 0xa2, 0x5a, 0x39, 0x65, 0xf8, 0x31, 0x78, 0x86,
-	0x0e, 0x2f, 0x05, 0xf3, 0xad, 0x70, 0x08, 0x45, 0x2e, 0xf3, 0x58, 0x95, 0x79, 0x6c, 0x23, 0x8f,
-	0x58, 0x2e, 0x56, 0x44, 0x38, 0xe6, 0x6c, 0xac, 0xb4, 0x70, 0x43, 0x97, 0x15, 0x81, 0xb4, 0xfd,
-	0xa7, 0x70, 0xff, 0x50, 0x1e, 0x8b, 0xf1, 0x53, 0xba, 0xd6, 0x7b, 0xd0, 0xd0, 0xad, 0x58, 0x32,
-	0x48, 0x5b, 0xfe, 0xb9, 0x05, 0x77, 0x5f, 0x51, 0x1e, 0x24, 0x89, 0x79, 0x59, 0xff, 0xb2, 0x2a,
-	0x84, 0xa0, 0x96, 0xe1, 0x53, 0x2a, 0xc7, 0x52, 0x0b, 0xe5, 0xb7, 0x48, 0x93, 0xb0, 0x01, 0xe3,
-	0x72, 0x28, 0xb5, 0x50, 0x19, 0x68, 0x13, 0xdc, 0x34, 0x27, 0x34, 0x8f, 0xfa, 0x13, 0x3d, 0x94,
-	0x25, 0x69, 0x1f, 0x4c, 0xf6, 0x7f, 0x3b, 0xb0, 0x79, 0x20, 0x5f, 0x74, 0xb3, 0xce, 0x63, 0x75,
-	0xca, 0xe8, 0x1d, 0x6c, 0x94, 0xee, 0x15, 0xed, 0x94, 0x2e, 0xbe, 0xea, 0xa6, 0xb7, 0xe6, 0x3e,
-	0x0c, 0xe8, 0x3d, 0xac, 0x0a, 0x79, 0x0c, 0x64, 0x77, 0x1e, 0xff, 0xda, 0x60, 0x17, 0xa4, 0xfe,
-	0x00, 0x1b, 0x25, 0xe5, 0xd1, 0xe3, 0x52, 0x48, 0xe5, 0x74, 0xb6, 0xb6, 0xe7, 0xa5, 0x2e, 0x84,
-	0x20, 0xa5, 0xe3, 0xab, 0x10, 0xa4, 0xea, 0x40, 0x17, 0x54, 0xfd, 0x11, 0x36, 0x4a, 0x3b, 0xf6,
-	0x37, 0x9a, 0xf4, 0x4a, 0xd4, 0x1b, 0x56, 0xf6, 0x60, 0xfd, 0xfb, 0xb4, 0x63, 0xfd, 0x98, 0x76,
-	0xac, 0x9f, 0xd3, 0x8e, 0xf5, 0xf5, 0x57, 0xe7, 0x4e, 0xbf, 0x21, 0xff, 0xbf, 0x9f, 0xfd, 0x09,
-	0x00, 0x00, 0xff, 0xff, 0x68, 0x64, 0x39, 0x71, 0xec, 0x07, 0x00, 0x00,
+	// 964 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcb, 0x6e, 0x23, 0x45,
+	0x14, 0xa5, 0xfd, 0x6c, 0x5f, 0x3f, 0x62, 0x17, 0x9e, 0x99, 0x8e, 0x61, 0x42, 0xd4, 0x28, 0xc8,
+	0x61, 0x11, 0xc4, 0xf0, 0x03, 0x38, 0x33, 0x9a, 0x91, 0x23, 0x16, 0xc8, 0x61, 0x10, 0x30, 0x42,
+	0xad, 0x76, 0x57, 0x65, 0xa6, 0x14, 0xbb, 0xab, 0xa9, 0x2e, 0x47, 0xf8, 0x1f, 0x90, 0xd8, 0xf2,
+	0x39, 0x2c, 0x59, 0x22, 0xf1, 0x03, 0x28, 0xfc, 0x02, 0x6b, 0x84, 0xea, 0xd1, 0x4e, 0xd9, 0xdd,
+	0xb1, 0x13, 0x09, 0x76, 0xb3, 0xeb, 0x7b, 0xee, 0xad, 0xeb, 0xaa, 0x53, 0xe7, 0x9e, 0x4a, 0xe0,
+	0x78, 0xca, 0xd8, 0x25, 0x8d, 0x5f, 0x07, 0x29, 0xe1, 0x57, 0x34, 0x22, 0x9f, 0xc8, 0x98, 0xe0,
+	0x20, 0x4c, 0x12, 0x46, 0x63, 0x31, 0x27, 0xb1, 0x48, 0x4f, 0x12, 0xce, 0x04, 0x43, 0x7b, 0x1b,
+	0xa5, 0xfe, 0xaf, 0x55, 0x68, 0x8e, 0x6e, 0xea, 0x50, 0x07, 0x4a, 0x14, 0x7b, 0xce, 0xa1, 0x33,
+	0x2c, 0x4f, 0x4a, 0x14, 0xa3, 0x0f, 0xa1, 0x8d, 0x49, 0x12, 0x72, 0x95, 0x0d, 0x28, 0xf6, 0x4a,
+	0x87, 0xce, 0xb0, 0x31, 0x69, 0xdd, 0x80, 0x63, 0x8c, 0xde, 0x83, 0x06, 0x66, 0x91, 0x60, 0x5c,
+	0x16, 0x94, 0x55, 0x81, 0xab, 0x81, 0x31, 0x46, 0x8f, 0x01, 0x92, 0x50, 0x50, 0xb3, 0xbc, 0xa2,
+	0xb2, 0x0d, 0x83, 0x8c, 0x31, 0x3a, 0x86, 0xae, 0xb5, 0xcf, 0x00, 0x87, 0x82, 0x78, 0x55, 0x55,
+	0xb4, 0x67, 0xe1, 0xcf, 0x42, 0x41, 0x36, 0x4b, 0x05, 0x9d, 0x13, 0xaf, 0x96, 0x2b, 0xfd, 0x8a,
+	0xce, 0x09, 0x1a, 0x80, 0x8b, 0x17, 0x3c, 0x14, 0x94, 0xc5, 0x5e, 0x5d, 0x1d, 0x66, 0x15, 0xa3,
+	0x2e, 0x94, 0x2f, 0xc9, 0xd2, 0x73, 0xd5, 0x4a, 0xf9, 0x29, 0xb7, 0x48, 0x7e, 0x4c, 0x28, 0x27,
+	0x69, 0x10, 0x0a, 0xaf, 0xa1, 0xb7, 0x68, 0x90, 0x91, 0x40, 0x47, 0xd0, 0xc9, 0x4e, 0x90, 0x8a,
+	0x50, 0x2c, 0x52, 0x0f, 0x0e, 0x9d, 0xa1, 0x3b, 0x69, 0x1b, 0xf4, 0x5c, 0x81, 0xb2, 0x4b, 0xc4,
+	0x49, 0x28, 0x24, 0xf3, 0xc2, 0x6b, 0xea, 0x2e, 0x06, 0x19, 0x09, 0x99, 0x5e, 0x24, 0x38, 0x4b,
+	0xb7, 0x74, 0xda, 0x20, 0x3a, 0x8d, 0xc9, 0x8c, 0x98, 0x74, 0x5b, 0xa7, 0x0d, 0x32, 0x12, 0x92,
+	0xe2, 0x29, 0x0f, 0xe3, 0xe8, 0x8d, 0x24, 0xb1, 0xa3, 0x29, 0xd6, 0xc0, 0x18, 0xa3, 0x0f, 0xa0,
+	0xc9, 0x49, 0xca, 0x16, 0x3c, 0x22, 0x32, 0xbd, 0xa7, 0xd2, 0x90, 0x41, 0x63, 0x2c, 0xe9, 0x98,
+	0x33, 0x1c, 0xce, 0xa8, 0x58, 0x7a, 0x5d, 0xbd, 0x38, 0x8b, 0xd1, 0xc7, 0xd0, 0x33, 0x97, 0x67,
+	0x34, 0x21, 0x5b, 0xf4, 0x34, 0xad, 0x3a, 0x71, 0xae, 0xf1, 0x31, 0x46, 0x7d, 0xa8, 0x26, 0x9c,
+	0x46, 0xc4, 0x43, 0x2a, 0xaf, 0x03, 0xd9, 0x3d, 0x5a, 0x70, 0x4e, 0xe2, 0x68, 0xe9, 0xbd, 0xab,
+	0xbb, 0x67, 0x31, 0xf2, 0xa1, 0x7d, 0x45, 0x31, 0x61, 0x01, 0x67, 0x6c, 0x2e, 0x3b, 0xf7, 0x55,
+	0x41, 0x53, 0x81, 0x13, 0xc6, 0xe6, 0x63, 0x2c, 0xf9, 0xd5, 0x35, 0x09, 0x67, 0xf2, 0x83, 0x7b,
+	0x0f, 0x54, 0x91, 0x5e, 0xf9, 0xa5, 0x01, 0xd1, 0x43, 0xa8, 0x19, 0xfa, 0x1f, 0xaa, 0xb4, 0x89,
+	0xfc, 0x0b, 0x68, 0x59, 0x0a, 0x4e, 0xe5, 0x26, 0x23, 0xb6, 0x88, 0x85, 0x51, 0xb1, 0x0e, 0xd0,
+	0xe7, 0xd0, 0xb2, 0xe7, 0xc1, 0x2b, 0x1d, 0x96, 0x87, 0xcd, 0x27, 0xef, 0x9f, 0x6c, 0x0c, 0xc4,
+	0x89, 0xd5, 0x6a, 0xb2, 0xb6, 0xc2, 0xff, 0xa3, 0x0c, 0xfd, 0xa7, 0xea, 0x3a, 0xed, 0x1a, 0xf2,
+	0xc3, 0xdb, 0x19, 0xb9, 0xfb, 0x8c, 0xac, 0xc9, 0xb8, 0xb9, 0x5d, 0xc6, 0xad, 0xad, 0x32, 0x6e,
+	0xdf, 0x45, 0xc6, 0x9d, 0x42, 0x19, 0x9f, 0x55, 0xdc, 0xbd, 0x6e, 0xf7, 0xac, 0xe2, 0x76, 0xbb,
+	0x3d, 0xff, 0xa7, 0x12, 0xf4, 0x5f, 0x26, 0x38, 0x7f, 0xab, 0x45, 0xa4, 0x97, 0xee, 0x4e, 0x7a,
+	0x79, 0x37, 0xe9, 0x95, 0x62, 0xd2, 0xab, 0xb7, 0x91, 0x5e, 0xdb, 0x4d, 0x7a, 0xbd, 0x88, 0xf4,
+	0x3e, 0x54, 0x2f, 0x28, 0x99, 0x61, 0x73, 0x9d, 0x3a, 0x90, 0xe8, 0x55, 0x38, 0x5b, 0x10, 0x73,
+	0x97, 0x3a, 0xf0, 0x23, 0xf0, 0x2c, 0x1e, 0x9e, 0xcb, 0xca, 0xaf, 0x65, 0x42, 0x32, 0xb2, 0xea,
+	0xe3, 0x14, 0xf6, 0x29, 0x59, 0x7d, 0xe4, 0x45, 0xd3, 0x34, 0x08, 0x23, 0x41, 0xaf, 0x34, 0x17,
+	0xee, 0xc4, 0xa5, 0xe9, 0x48, 0xc5, 0xfe, 0xa7, 0xf0, 0xe8, 0x99, 0x72, 0x36, 0xeb, 0xa7, 0xcc,
+	0x5e, 0x6f, 0x86, 0xdc, 0x51, 0x8b, 0xb2, 0x21, 0xff, 0xc7, 0x81, 0x07, 0x2f, 0x88, 0x18, 0xcd,
+	0x66, 0xf6, 0xac, 0xff, 0x97, 0xbb, 0x42, 0x08, 0x2a, 0x49, 0xf8, 0x9a, 0xa8, 0x6b, 0xa9, 0x4c,
+	0xd4, 0xb7, 0x6c, 0x33, 0xa3, 0x73, 0x2a, 0xd4, 0xa5, 0x54, 0x26, 0x3a, 0x40, 0xfb, 0xe0, 0x32,
+	0x8e, 0x09, 0x0f, 0xa6, 0x4b, 0x73, 0x29, 0x75, 0x15, 0x9f, 0x2e, 0xd7, 0x05, 0x5e, 0xdf, 0x10,
+	0xf8, 0x9a, 0x07, 0xb8, 0x5b, 0x3d, 0xa0, 0xb1, 0xe1, 0x01, 0xfe, 0x2b, 0x68, 0x9e, 0x31, 0x1a,
+	0x7f, 0x41, 0xe3, 0x4b, 0x79, 0xea, 0x23, 0xe8, 0xd8, 0x92, 0x5b, 0xbd, 0xd9, 0x6d, 0x0b, 0xd5,
+	0xd6, 0x2a, 0x3d, 0x88, 0x46, 0x34, 0x09, 0x6d, 0x6f, 0x6a, 0x5b, 0xe8, 0x18, 0xfb, 0x3f, 0x3b,
+	0xe0, 0x66, 0xdd, 0xa5, 0x0c, 0x17, 0x7c, 0x66, 0xe8, 0x94, 0x9f, 0xe8, 0x11, 0xd4, 0x33, 0xfb,
+	0xd6, 0xcb, 0x6b, 0x5c, 0x3b, 0xf7, 0x00, 0xdc, 0x95, 0x67, 0x1b, 0x4f, 0xcb, 0x62, 0x79, 0x9e,
+	0x98, 0x89, 0x60, 0x4a, 0x2e, 0x18, 0x27, 0x99, 0xa7, 0xc5, 0x4c, 0x9c, 0x2a, 0x60, 0x43, 0xda,
+	0xd5, 0x0d, 0x69, 0xfb, 0x2f, 0xa1, 0x9f, 0x13, 0xc7, 0x3d, 0xce, 0x7d, 0x23, 0xa3, 0x92, 0xfd,
+	0x56, 0x3c, 0xf9, 0xbb, 0x0a, 0xfb, 0xa7, 0xea, 0xaf, 0x23, 0x5b, 0x46, 0xc6, 0x19, 0xd0, 0x37,
+	0xd0, 0xcb, 0x19, 0x3c, 0x3a, 0xca, 0x3d, 0x11, 0x45, 0x8f, 0xc0, 0x60, 0xeb, 0x4b, 0x82, 0xbe,
+	0x85, 0x8e, 0x54, 0xaf, 0x85, 0x1c, 0x6f, 0xab, 0x5f, 0x9b, 0xbb, 0x1d, 0xad, 0xbf, 0x83, 0x5e,
+	0x6e, 0x30, 0xd0, 0x47, 0xb9, 0x25, 0x85, 0xc3, 0x33, 0x78, 0xbc, 0xad, 0x75, 0x2a, 0x09, 0xc9,
+	0x79, 0x63, 0x01, 0x21, 0x45, 0xfe, 0xb9, 0x63, 0xd7, 0x6f, 0xa0, 0x97, 0xb3, 0x80, 0xfb, 0x70,
+	0x32, 0xcc, 0x95, 0xde, 0xe6, 0x28, 0xdf, 0x03, 0x7a, 0xca, 0xe2, 0x0b, 0xca, 0xe7, 0xff, 0x0b,
+	0xfd, 0xcf, 0xa1, 0xf9, 0x82, 0x88, 0xd5, 0xf0, 0xe4, 0x8b, 0xad, 0xa9, 0x1d, 0xec, 0xdf, 0x9a,
+	0x45, 0xaf, 0xa0, 0x7f, 0x4e, 0x44, 0x7e, 0xfb, 0x47, 0xdb, 0x7e, 0x7d, 0x35, 0x17, 0xdb, 0x37,
+	0x79, 0xda, 0xfd, 0xed, 0xfa, 0xc0, 0xf9, 0xfd, 0xfa, 0xc0, 0xf9, 0xf3, 0xfa, 0xc0, 0xf9, 0xe5,
+	0xaf, 0x83, 0x77, 0xa6, 0x35, 0xf5, 0xff, 0xc0, 0x67, 0xff, 0x0e, 0x00, 0x67, 0x6f, 0x2c, 0x3f,
+	0x3c, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -711,6 +1053,11 @@ type BookedAppointmentsServiceClient interface {
 	GetAllAppointment(ctx context.Context, in *GetAllAppointmentsReq, opts ...grpc.CallOption) (*Appointments, error)
 	UpdateAppointment(ctx context.Context, in *UpdateAppointmentReq, opts ...grpc.CallOption) (*Appointment, error)
 	DeleteAppointment(ctx context.Context, in *AppointmentFieldValueReq, opts ...grpc.CallOption) (*DeleteAppointmentStatus, error)
+	// telemedicine
+	ConfirmAppointment(ctx context.Context, in *AppointmentFieldValueReq, opts ...grpc.CallOption) (*Appointment, error)
+	GetJoinLink(ctx context.Context, in *JoinLinkReq, opts ...grpc.CallOption) (*JoinLink, error)
+	// visit outcome
+	SetAppointmentStatus(ctx context.Context, in *AppointmentStatusReq, opts ...grpc.CallOption) (*Appointment, error)
 }
 
 type bookedAppointmentsServiceClient struct {
@@ -766,6 +1113,33 @@ func (c *bookedAppointmentsServiceClient) DeleteAppointment(ctx context.Context,
 	return out, nil
 }
 
+func (c *bookedAppointmentsServiceClient) ConfirmAppointment(ctx context.Context, in *AppointmentFieldValueReq, opts ...grpc.CallOption) (*Appointment, error) {
+	out := new(Appointment)
+	err := c.cc.Invoke(ctx, "/booking_service.BookedAppointmentsService/ConfirmAppointment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookedAppointmentsServiceClient) GetJoinLink(ctx context.Context, in *JoinLinkReq, opts ...grpc.CallOption) (*JoinLink, error) {
+	out := new(JoinLink)
+	err := c.cc.Invoke(ctx, "/booking_service.BookedAppointmentsService/GetJoinLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookedAppointmentsServiceClient) SetAppointmentStatus(ctx context.Context, in *AppointmentStatusReq, opts ...grpc.CallOption) (*Appointment, error) {
+	out := new(Appointment)
+	err := c.cc.Invoke(ctx, "/booking_service.BookedAppointmentsService/SetAppointmentStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookedAppointmentsServiceServer is the server API for BookedAppointmentsService service.
 type BookedAppointmentsServiceServer interface {
 	// bookedAppointments
@@ -774,6 +1148,11 @@ type BookedAppointmentsServiceServer interface {
 	GetAllAppointment(context.Context, *GetAllAppointmentsReq) (*Appointments, error)
 	UpdateAppointment(context.Context, *UpdateAppointmentReq) (*Appointment, error)
 	DeleteAppointment(context.Context, *AppointmentFieldValueReq) (*DeleteAppointmentStatus, error)
+	// telemedicine
+	ConfirmAppointment(context.Context, *AppointmentFieldValueReq) (*Appointment, error)
+	GetJoinLink(context.Context, *JoinLinkReq) (*JoinLink, error)
+	// visit outcome
+	SetAppointmentStatus(context.Context, *AppointmentStatusReq) (*Appointment, error)
 }
 
 // UnimplementedBookedAppointmentsServiceServer can be embedded to have forward compatible implementations.
@@ -795,6 +1174,15 @@ func (*UnimplementedBookedAppointmentsServiceServer) UpdateAppointment(ctx conte
 func (*UnimplementedBookedAppointmentsServiceServer) DeleteAppointment(ctx context.Context, req *AppointmentFieldValueReq) (*DeleteAppointmentStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppointment not implemented")
 }
+func (*UnimplementedBookedAppointmentsServiceServer) ConfirmAppointment(ctx context.Context, req *AppointmentFieldValueReq) (*Appointment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmAppointment not implemented")
+}
+func (*UnimplementedBookedAppointmentsServiceServer) GetJoinLink(ctx context.Context, req *JoinLinkReq) (*JoinLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJoinLink not implemented")
+}
+func (*UnimplementedBookedAppointmentsServiceServer) SetAppointmentStatus(ctx context.Context, req *AppointmentStatusReq) (*Appointment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAppointmentStatus not implemented")
+}
 
 func RegisterBookedAppointmentsServiceServer(s *grpc.Server, srv BookedAppointmentsServiceServer) {
 	s.RegisterService(&_BookedAppointmentsService_serviceDesc, srv)
@@ -890,34 +1278,100 @@ func _BookedAppointmentsService_DeleteAppointment_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
-var _BookedAppointmentsService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "booking_service.BookedAppointmentsService",
-	HandlerType: (*BookedAppointmentsServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateAppointment",
-			Handler:    _BookedAppointmentsService_CreateAppointment_Handler,
-		},
-		{
-			MethodName: "GetAppointment",
-			Handler:    _BookedAppointmentsService_GetAppointment_Handler,
-		},
-		{
-			MethodName: "GetAllAppointment",
-			Handler:    _BookedAppointmentsService_GetAllAppointment_Handler,
-		},
-		{
-			MethodName: "UpdateAppointment",
-			Handler:    _BookedAppointmentsService_UpdateAppointment_Handler,
-		},
-		{
-			MethodName: "DeleteAppointment",
-			Handler:    _BookedAppointmentsService_DeleteAppointment_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "booking_service/booked_appointments.proto",
-}
+func _BookedAppointmentsService_ConfirmAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppointmentFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookedAppointmentsServiceServer).ConfirmAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.BookedAppointmentsService/ConfirmAppointment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookedAppointmentsServiceServer).ConfirmAppointment(ctx, req.(*AppointmentFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookedAppointmentsService_GetJoinLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinLinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookedAppointmentsServiceServer).GetJoinLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.BookedAppointmentsService/GetJoinLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookedAppointmentsServiceServer).GetJoinLink(ctx, req.(*JoinLinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookedAppointmentsService_SetAppointmentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppointmentStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookedAppointmentsServiceServer).SetAppointmentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.BookedAppointmentsService/SetAppointmentStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookedAppointmentsServiceServer).SetAppointmentStatus(ctx, req.(*AppointmentStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BookedAppointmentsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "booking_service.BookedAppointmentsService",
+	HandlerType: (*BookedAppointmentsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAppointment",
+			Handler:    _BookedAppointmentsService_CreateAppointment_Handler,
+		},
+		{
+			MethodName: "GetAppointment",
+			Handler:    _BookedAppointmentsService_GetAppointment_Handler,
+		},
+		{
+			MethodName: "GetAllAppointment",
+			Handler:    _BookedAppointmentsService_GetAllAppointment_Handler,
+		},
+		{
+			MethodName: "UpdateAppointment",
+			Handler:    _BookedAppointmentsService_UpdateAppointment_Handler,
+		},
+		{
+			MethodName: "DeleteAppointment",
+			Handler:    _BookedAppointmentsService_DeleteAppointment_Handler,
+		},
+		{
+			MethodName: "ConfirmAppointment",
+			Handler:    _BookedAppointmentsService_ConfirmAppointment_Handler,
+		},
+		{
+			MethodName: "GetJoinLink",
+			Handler:    _BookedAppointmentsService_GetJoinLink_Handler,
+		},
+		{
+			MethodName: "SetAppointmentStatus",
+			Handler:    _BookedAppointmentsService_SetAppointmentStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking_service/booked_appointments.proto",
+}
 
 func (m *Appointment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -943,6 +1397,83 @@ func (m *Appointment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.VideoProvider) > 0 {
+		i -= len(m.VideoProvider)
+		copy(dAtA[i:], m.VideoProvider)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.VideoProvider)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.VideoRoomId) > 0 {
+		i -= len(m.VideoRoomId)
+		copy(dAtA[i:], m.VideoRoomId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.VideoRoomId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Modality) > 0 {
+		i -= len(m.Modality)
+		copy(dAtA[i:], m.Modality)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.Modality)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.ResourceId) > 0 {
+		i -= len(m.ResourceId)
+		copy(dAtA[i:], m.ResourceId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.ResourceId)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.DeletedAt) > 0 {
 		i -= len(m.DeletedAt)
 		copy(dAtA[i:], m.DeletedAt)
@@ -1106,6 +1637,34 @@ func (m *CreateAppointmentReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Modality) > 0 {
+		i -= len(m.Modality)
+		copy(dAtA[i:], m.Modality)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.Modality)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ResourceId) > 0 {
+		i -= len(m.ResourceId)
+		copy(dAtA[i:], m.ResourceId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.ResourceId)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.PatientStatus {
 		i--
 		if m.PatientStatus {
@@ -1369,6 +1928,27 @@ func (m *GetAllAppointmentsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.OrderBy) > 0 {
 		i -= len(m.OrderBy)
 		copy(dAtA[i:], m.OrderBy)
@@ -1413,6 +1993,146 @@ func (m *GetAllAppointmentsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JoinLinkReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinLinkReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinLinkReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ParticipantId) > 0 {
+		i -= len(m.ParticipantId)
+		copy(dAtA[i:], m.ParticipantId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.ParticipantId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AppointmentId != 0 {
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(m.AppointmentId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JoinLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExpiresAt) > 0 {
+		i -= len(m.ExpiresAt)
+		copy(dAtA[i:], m.ExpiresAt)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.ExpiresAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NotBefore) > 0 {
+		i -= len(m.NotBefore)
+		copy(dAtA[i:], m.NotBefore)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.NotBefore)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RoomId) > 0 {
+		i -= len(m.RoomId)
+		copy(dAtA[i:], m.RoomId)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.RoomId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AppointmentStatusReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppointmentStatusReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppointmentStatusReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AppointmentId != 0 {
+		i = encodeVarintBookedAppointments(dAtA, i, uint64(m.AppointmentId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBookedAppointments(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBookedAppointments(v)
 	base := offset
@@ -1479,6 +2199,42 @@ func (m *Appointment) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBookedAppointments(uint64(l))
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.ResourceId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.Modality)
+	if l > 0 {
+		n += 2 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 2 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 2 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 2 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.VideoRoomId)
+	if l > 0 {
+		n += 2 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.VideoProvider)
+	if l > 0 {
+		n += 2 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 2 + l + sovBookedAppointments(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1546,12 +2302,28 @@ func (m *CreateAppointmentReq) Size() (n int) {
 	if m.PatientStatus {
 		n += 2
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
 	}
-	return n
-}
-
+	l = len(m.ResourceId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.Modality)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *UpdateAppointmentReq) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1659,6 +2431,88 @@ func (m *GetAllAppointmentsReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBookedAppointments(uint64(l))
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JoinLinkReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppointmentId != 0 {
+		n += 1 + sovBookedAppointments(uint64(m.AppointmentId))
+	}
+	l = len(m.ParticipantId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JoinLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.RoomId)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.NotBefore)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	l = len(m.ExpiresAt)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AppointmentStatusReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppointmentId != 0 {
+		n += 1 + sovBookedAppointments(uint64(m.AppointmentId))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovBookedAppointments(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2078,81 +2932,11 @@ func (m *Appointment) Unmarshal(dAtA []byte) error {
 			}
 			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBookedAppointments
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Appointments) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBookedAppointments
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Appointments: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Appointments: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBookedAppointments
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Appointments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBookedAppointments
@@ -2162,80 +2946,27 @@ func (m *Appointments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBookedAppointments
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBookedAppointments
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Appointments = append(m.Appointments, &Appointment{})
-			if err := m.Appointments[len(m.Appointments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBookedAppointments
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateAppointmentReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBookedAppointments
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateAppointmentReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateAppointmentReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2263,11 +2994,11 @@ func (m *CreateAppointmentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepartmentId = string(dAtA[iNdEx:postIndex])
+			m.ResourceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Modality", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2295,11 +3026,11 @@ func (m *CreateAppointmentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			m.Modality = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2327,11 +3058,11 @@ func (m *CreateAppointmentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PatientId = string(dAtA[iNdEx:postIndex])
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2359,11 +3090,11 @@ func (m *CreateAppointmentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppointmentDate = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2391,13 +3122,13 @@ func (m *CreateAppointmentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppointmentTime = string(dAtA[iNdEx:postIndex])
+			m.Currency = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoRoomId", wireType)
 			}
-			m.Duration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBookedAppointments
@@ -2407,14 +3138,27 @@ func (m *CreateAppointmentReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VideoRoomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoProvider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2442,11 +3186,11 @@ func (m *CreateAppointmentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.VideoProvider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2474,13 +3218,64 @@ func (m *CreateAppointmentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Appointments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBookedAppointments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Appointments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Appointments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatientStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBookedAppointments
@@ -2490,12 +3285,45 @@ func (m *CreateAppointmentReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PatientStatus = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appointments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appointments = append(m.Appointments, &Appointment{})
+			if err := m.Appointments[len(m.Appointments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
@@ -2518,7 +3346,7 @@ func (m *CreateAppointmentReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateAppointmentReq) Unmarshal(dAtA []byte) error {
+func (m *CreateAppointmentReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2541,13 +3369,109 @@ func (m *UpdateAppointmentReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateAppointmentReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateAppointmentReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateAppointmentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateAppointmentReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepartmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepartmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentDate", wireType)
 			}
@@ -2579,7 +3503,7 @@ func (m *UpdateAppointmentReq) Unmarshal(dAtA []byte) error {
 			}
 			m.AppointmentDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentTime", wireType)
 			}
@@ -2611,7 +3535,7 @@ func (m *UpdateAppointmentReq) Unmarshal(dAtA []byte) error {
 			}
 			m.AppointmentTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
@@ -2630,9 +3554,882 @@ func (m *UpdateAppointmentReq) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientStatus", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PatientStatus = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modality", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Modality = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateAppointmentReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBookedAppointments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateAppointmentReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateAppointmentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppointmentDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppointmentTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientStatus", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PatientStatus = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppointmentFieldValueReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBookedAppointments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppointmentFieldValueReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppointmentFieldValueReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteAppointmentStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBookedAppointments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteAppointmentStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteAppointmentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllAppointmentsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBookedAppointments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllAppointmentsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllAppointmentsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBookedAppointments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2660,11 +4457,11 @@ func (m *UpdateAppointmentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2692,31 +4489,11 @@ func (m *UpdateAppointmentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
+			m.BranchId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatientStatus", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBookedAppointments
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.PatientStatus = bool(v != 0)
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2744,11 +4521,11 @@ func (m *UpdateAppointmentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2776,7 +4553,7 @@ func (m *UpdateAppointmentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.PatientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2800,7 +4577,7 @@ func (m *UpdateAppointmentReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppointmentFieldValueReq) Unmarshal(dAtA []byte) error {
+func (m *JoinLinkReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2823,17 +4600,17 @@ func (m *AppointmentFieldValueReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppointmentFieldValueReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: JoinLinkReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppointmentFieldValueReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JoinLinkReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
 			}
-			var stringLen uint64
+			m.AppointmentId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBookedAppointments
@@ -2843,27 +4620,14 @@ func (m *AppointmentFieldValueReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AppointmentId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBookedAppointments
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBookedAppointments
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Field = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2891,28 +4655,8 @@ func (m *AppointmentFieldValueReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ParticipantId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBookedAppointments
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsActive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
@@ -2935,7 +4679,7 @@ func (m *AppointmentFieldValueReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteAppointmentStatus) Unmarshal(dAtA []byte) error {
+func (m *JoinLink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2958,17 +4702,17 @@ func (m *DeleteAppointmentStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteAppointmentStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: JoinLink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteAppointmentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JoinLink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBookedAppointments
@@ -2978,66 +4722,27 @@ func (m *DeleteAppointmentStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBookedAppointments
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetAllAppointmentsReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBookedAppointments
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllAppointmentsReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllAppointmentsReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3065,11 +4770,11 @@ func (m *GetAllAppointmentsReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.RoomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3097,13 +4802,13 @@ func (m *GetAllAppointmentsReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBookedAppointments
@@ -3113,17 +4818,29 @@ func (m *GetAllAppointmentsReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsActive = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
 			}
-			m.Page = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotBefore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBookedAppointments
@@ -3133,16 +4850,80 @@ func (m *GetAllAppointmentsReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBookedAppointments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBookedAppointments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppointmentStatusReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBookedAppointments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppointmentStatusReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppointmentStatusReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
 			}
-			m.Limit = 0
+			m.AppointmentId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBookedAppointments
@@ -3152,14 +4933,14 @@ func (m *GetAllAppointmentsReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				m.AppointmentId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3187,7 +4968,7 @@ func (m *GetAllAppointmentsReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,3895 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: booking_service/encounter.proto
+
+package booking_service
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Vitals struct {
+	TemperatureC         float64  `protobuf:"fixed64,1,opt,name=temperature_c,json=temperatureC,proto3" json:"temperature_c"`
+	PulseBpm             int32    `protobuf:"varint,2,opt,name=pulse_bpm,json=pulseBpm,proto3" json:"pulse_bpm"`
+	SystolicMmhg         int32    `protobuf:"varint,3,opt,name=systolic_mmhg,json=systolicMmhg,proto3" json:"systolic_mmhg"`
+	DiastolicMmhg        int32    `protobuf:"varint,4,opt,name=diastolic_mmhg,json=diastolicMmhg,proto3" json:"diastolic_mmhg"`
+	RespiratoryRate      int32    `protobuf:"varint,5,opt,name=respiratory_rate,json=respiratoryRate,proto3" json:"respiratory_rate"`
+	OxygenSaturation     int32    `protobuf:"varint,6,opt,name=oxygen_saturation,json=oxygenSaturation,proto3" json:"oxygen_saturation"`
+	WeightKg             float64  `protobuf:"fixed64,7,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg"`
+	HeightCm             float64  `protobuf:"fixed64,8,opt,name=height_cm,json=heightCm,proto3" json:"height_cm"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Vitals) Reset()         { *m = Vitals{} }
+func (m *Vitals) String() string { return proto.CompactTextString(m) }
+func (*Vitals) ProtoMessage()    {}
+func (*Vitals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_480b96bf5aa9eff4, []int{0}
+}
+func (m *Vitals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vitals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vitals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vitals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vitals.Merge(m, src)
+}
+func (m *Vitals) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vitals) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vitals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vitals proto.InternalMessageInfo
+
+func (m *Vitals) GetTemperatureC() float64 {
+	if m != nil {
+		return m.TemperatureC
+	}
+	return 0
+}
+
+func (m *Vitals) GetPulseBpm() int32 {
+	if m != nil {
+		return m.PulseBpm
+	}
+	return 0
+}
+
+func (m *Vitals) GetSystolicMmhg() int32 {
+	if m != nil {
+		return m.SystolicMmhg
+	}
+	return 0
+}
+
+func (m *Vitals) GetDiastolicMmhg() int32 {
+	if m != nil {
+		return m.DiastolicMmhg
+	}
+	return 0
+}
+
+func (m *Vitals) GetRespiratoryRate() int32 {
+	if m != nil {
+		return m.RespiratoryRate
+	}
+	return 0
+}
+
+func (m *Vitals) GetOxygenSaturation() int32 {
+	if m != nil {
+		return m.OxygenSaturation
+	}
+	return 0
+}
+
+func (m *Vitals) GetWeightKg() float64 {
+	if m != nil {
+		return m.WeightKg
+	}
+	return 0
+}
+
+func (m *Vitals) GetHeightCm() float64 {
+	if m != nil {
+		return m.HeightCm
+	}
+	return 0
+}
+
+type Diagnosis struct {
+	Icd10Code            string   `protobuf:"bytes,1,opt,name=icd10_code,json=icd10Code,proto3" json:"icd10_code"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description"`
+	Primary              bool     `protobuf:"varint,3,opt,name=primary,proto3" json:"primary"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Diagnosis) Reset()         { *m = Diagnosis{} }
+func (m *Diagnosis) String() string { return proto.CompactTextString(m) }
+func (*Diagnosis) ProtoMessage()    {}
+func (*Diagnosis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_480b96bf5aa9eff4, []int{1}
+}
+func (m *Diagnosis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Diagnosis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Diagnosis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Diagnosis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Diagnosis.Merge(m, src)
+}
+func (m *Diagnosis) XXX_Size() int {
+	return m.Size()
+}
+func (m *Diagnosis) XXX_DiscardUnknown() {
+	xxx_messageInfo_Diagnosis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Diagnosis proto.InternalMessageInfo
+
+func (m *Diagnosis) GetIcd10Code() string {
+	if m != nil {
+		return m.Icd10Code
+	}
+	return ""
+}
+
+func (m *Diagnosis) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Diagnosis) GetPrimary() bool {
+	if m != nil {
+		return m.Primary
+	}
+	return false
+}
+
+type Allergy struct {
+	Substance            string   `protobuf:"bytes,1,opt,name=substance,proto3" json:"substance"`
+	Reaction             string   `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction"`
+	Severity             string   `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Allergy) Reset()         { *m = Allergy{} }
+func (m *Allergy) String() string { return proto.CompactTextString(m) }
+func (*Allergy) ProtoMessage()    {}
+func (*Allergy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_480b96bf5aa9eff4, []int{2}
+}
+func (m *Allergy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Allergy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Allergy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Allergy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Allergy.Merge(m, src)
+}
+func (m *Allergy) XXX_Size() int {
+	return m.Size()
+}
+func (m *Allergy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Allergy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Allergy proto.InternalMessageInfo
+
+func (m *Allergy) GetSubstance() string {
+	if m != nil {
+		return m.Substance
+	}
+	return ""
+}
+
+func (m *Allergy) GetReaction() string {
+	if m != nil {
+		return m.Reaction
+	}
+	return ""
+}
+
+func (m *Allergy) GetSeverity() string {
+	if m != nil {
+		return m.Severity
+	}
+	return ""
+}
+
+type ChronicCondition struct {
+	Icd10Code            string   `protobuf:"bytes,1,opt,name=icd10_code,json=icd10Code,proto3" json:"icd10_code"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description"`
+	OnsetDate            string   `protobuf:"bytes,3,opt,name=onset_date,json=onsetDate,proto3" json:"onset_date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChronicCondition) Reset()         { *m = ChronicCondition{} }
+func (m *ChronicCondition) String() string { return proto.CompactTextString(m) }
+func (*ChronicCondition) ProtoMessage()    {}
+func (*ChronicCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_480b96bf5aa9eff4, []int{3}
+}
+func (m *ChronicCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChronicCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChronicCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChronicCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChronicCondition.Merge(m, src)
+}
+func (m *ChronicCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChronicCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChronicCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChronicCondition proto.InternalMessageInfo
+
+func (m *ChronicCondition) GetIcd10Code() string {
+	if m != nil {
+		return m.Icd10Code
+	}
+	return ""
+}
+
+func (m *ChronicCondition) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ChronicCondition) GetOnsetDate() string {
+	if m != nil {
+		return m.OnsetDate
+	}
+	return ""
+}
+
+type Procedure struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Procedure) Reset()         { *m = Procedure{} }
+func (m *Procedure) String() string { return proto.CompactTextString(m) }
+func (*Procedure) ProtoMessage()    {}
+func (*Procedure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_480b96bf5aa9eff4, []int{4}
+}
+func (m *Procedure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Procedure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Procedure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Procedure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Procedure.Merge(m, src)
+}
+func (m *Procedure) XXX_Size() int {
+	return m.Size()
+}
+func (m *Procedure) XXX_DiscardUnknown() {
+	xxx_messageInfo_Procedure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Procedure proto.InternalMessageInfo
+
+func (m *Procedure) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Procedure) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type Encounter struct {
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	AppointmentId int64  `protobuf:"varint,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id"`
+	// patient, doctor and encountered_at are taken from the appointment
+	PatientId            string              `protobuf:"bytes,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	DoctorId             string              `protobuf:"bytes,4,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	EncounteredAt        string              `protobuf:"bytes,5,opt,name=encountered_at,json=encounteredAt,proto3" json:"encountered_at"`
+	ChiefComplaint       string              `protobuf:"bytes,6,opt,name=chief_complaint,json=chiefComplaint,proto3" json:"chief_complaint"`
+	Vitals               *Vitals             `protobuf:"bytes,7,opt,name=vitals,proto3" json:"vitals"`
+	Diagnoses            []*Diagnosis        `protobuf:"bytes,8,rep,name=diagnoses,proto3" json:"diagnoses"`
+	Allergies            []*Allergy          `protobuf:"bytes,9,rep,name=allergies,proto3" json:"allergies"`
+	ChronicConditions    []*ChronicCondition `protobuf:"bytes,10,rep,name=chronic_conditions,json=chronicConditions,proto3" json:"chronic_conditions"`
+	Procedures           []*Procedure        `protobuf:"bytes,11,rep,name=procedures,proto3" json:"procedures"`
+	FollowUpPlan         string              `protobuf:"bytes,12,opt,name=follow_up_plan,json=followUpPlan,proto3" json:"follow_up_plan"`
+	FollowUpDate         string              `protobuf:"bytes,13,opt,name=follow_up_date,json=followUpDate,proto3" json:"follow_up_date"`
+	CreatedAt            string              `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string              `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt            string              `protobuf:"bytes,16,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Encounter) Reset()         { *m = Encounter{} }
+func (m *Encounter) String() string { return proto.CompactTextString(m) }
+func (*Encounter) ProtoMessage()    {}
+func (*Encounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_480b96bf5aa9eff4, []int{5}
+}
+func (m *Encounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Encounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Encounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Encounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Encounter.Merge(m, src)
+}
+func (m *Encounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Encounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Encounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Encounter proto.InternalMessageInfo
+
+func (m *Encounter) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Encounter) GetAppointmentId() int64 {
+	if m != nil {
+		return m.AppointmentId
+	}
+	return 0
+}
+
+func (m *Encounter) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *Encounter) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
+func (m *Encounter) GetEncounteredAt() string {
+	if m != nil {
+		return m.EncounteredAt
+	}
+	return ""
+}
+
+func (m *Encounter) GetChiefComplaint() string {
+	if m != nil {
+		return m.ChiefComplaint
+	}
+	return ""
+}
+
+func (m *Encounter) GetVitals() *Vitals {
+	if m != nil {
+		return m.Vitals
+	}
+	return nil
+}
+
+func (m *Encounter) GetDiagnoses() []*Diagnosis {
+	if m != nil {
+		return m.Diagnoses
+	}
+	return nil
+}
+
+func (m *Encounter) GetAllergies() []*Allergy {
+	if m != nil {
+		return m.Allergies
+	}
+	return nil
+}
+
+func (m *Encounter) GetChronicConditions() []*ChronicCondition {
+	if m != nil {
+		return m.ChronicConditions
+	}
+	return nil
+}
+
+func (m *Encounter) GetProcedures() []*Procedure {
+	if m != nil {
+		return m.Procedures
+	}
+	return nil
+}
+
+func (m *Encounter) GetFollowUpPlan() string {
+	if m != nil {
+		return m.FollowUpPlan
+	}
+	return ""
+}
+
+func (m *Encounter) GetFollowUpDate() string {
+	if m != nil {
+		return m.FollowUpDate
+	}
+	return ""
+}
+
+func (m *Encounter) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *Encounter) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *Encounter) GetDeletedAt() string {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return ""
+}
+
+type EncounterFieldValueReq struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+	IsActive             bool     `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EncounterFieldValueReq) Reset()         { *m = EncounterFieldValueReq{} }
+func (m *EncounterFieldValueReq) String() string { return proto.CompactTextString(m) }
+func (*EncounterFieldValueReq) ProtoMessage()    {}
+func (*EncounterFieldValueReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_480b96bf5aa9eff4, []int{6}
+}
+func (m *EncounterFieldValueReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncounterFieldValueReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncounterFieldValueReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncounterFieldValueReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncounterFieldValueReq.Merge(m, src)
+}
+func (m *EncounterFieldValueReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncounterFieldValueReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncounterFieldValueReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncounterFieldValueReq proto.InternalMessageInfo
+
+func (m *EncounterFieldValueReq) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *EncounterFieldValueReq) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *EncounterFieldValueReq) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+type EncounterStatus struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EncounterStatus) Reset()         { *m = EncounterStatus{} }
+func (m *EncounterStatus) String() string { return proto.CompactTextString(m) }
+func (*EncounterStatus) ProtoMessage()    {}
+func (*EncounterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_480b96bf5aa9eff4, []int{7}
+}
+func (m *EncounterStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncounterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncounterStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncounterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncounterStatus.Merge(m, src)
+}
+func (m *EncounterStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncounterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncounterStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncounterStatus proto.InternalMessageInfo
+
+func (m *EncounterStatus) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+type PatientTimelineReq struct {
+	PatientId string `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
+	Page      uint64 `protobuf:"varint,2,opt,name=page,proto3" json:"page"`
+	Limit     uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit"`
+	// optional bounds on encountered_at, YYYY-MM-DD
+	From                 string   `protobuf:"bytes,4,opt,name=from,proto3" json:"from"`
+	To                   string   `protobuf:"bytes,5,opt,name=to,proto3" json:"to"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PatientTimelineReq) Reset()         { *m = PatientTimelineReq{} }
+func (m *PatientTimelineReq) String() string { return proto.CompactTextString(m) }
+func (*PatientTimelineReq) ProtoMessage()    {}
+func (*PatientTimelineReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_480b96bf5aa9eff4, []int{8}
+}
+func (m *PatientTimelineReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PatientTimelineReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PatientTimelineReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PatientTimelineReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatientTimelineReq.Merge(m, src)
+}
+func (m *PatientTimelineReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PatientTimelineReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatientTimelineReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatientTimelineReq proto.InternalMessageInfo
+
+func (m *PatientTimelineReq) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
+func (m *PatientTimelineReq) GetPage() uint64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *PatientTimelineReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *PatientTimelineReq) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *PatientTimelineReq) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+type PatientTimeline struct {
+	Count                int64        `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Encounters           []*Encounter `protobuf:"bytes,2,rep,name=encounters,proto3" json:"encounters"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PatientTimeline) Reset()         { *m = PatientTimeline{} }
+func (m *PatientTimeline) String() string { return proto.CompactTextString(m) }
+func (*PatientTimeline) ProtoMessage()    {}
+func (*PatientTimeline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_480b96bf5aa9eff4, []int{9}
+}
+func (m *PatientTimeline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PatientTimeline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PatientTimeline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PatientTimeline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatientTimeline.Merge(m, src)
+}
+func (m *PatientTimeline) XXX_Size() int {
+	return m.Size()
+}
+func (m *PatientTimeline) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatientTimeline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatientTimeline proto.InternalMessageInfo
+
+func (m *PatientTimeline) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PatientTimeline) GetEncounters() []*Encounter {
+	if m != nil {
+		return m.Encounters
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Vitals)(nil), "booking_service.Vitals")
+	proto.RegisterType((*Diagnosis)(nil), "booking_service.Diagnosis")
+	proto.RegisterType((*Allergy)(nil), "booking_service.Allergy")
+	proto.RegisterType((*ChronicCondition)(nil), "booking_service.ChronicCondition")
+	proto.RegisterType((*Procedure)(nil), "booking_service.Procedure")
+	proto.RegisterType((*Encounter)(nil), "booking_service.Encounter")
+	proto.RegisterType((*EncounterFieldValueReq)(nil), "booking_service.EncounterFieldValueReq")
+	proto.RegisterType((*EncounterStatus)(nil), "booking_service.EncounterStatus")
+	proto.RegisterType((*PatientTimelineReq)(nil), "booking_service.PatientTimelineReq")
+	proto.RegisterType((*PatientTimeline)(nil), "booking_service.PatientTimeline")
+}
+
+func init() { proto.RegisterFile("booking_service/encounter.proto", fileDescriptor_480b96bf5aa9eff4) }
+
+var fileDescriptor_480b96bf5aa9eff4 = []byte{
+	// 941 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xdf, 0x6e, 0x1b, 0x45,
+	0x14, 0xc6, 0xb1, 0xe3, 0xa4, 0xde, 0x93, 0xf8, 0x4f, 0x47, 0xa8, 0xac, 0x02, 0x0d, 0x66, 0x0b,
+	0x6a, 0x2a, 0xa4, 0x14, 0x82, 0x84, 0x10, 0x77, 0xae, 0x03, 0x95, 0x85, 0x90, 0xa2, 0x09, 0xed,
+	0x0d, 0x48, 0xab, 0xc9, 0xec, 0x78, 0x3d, 0xea, 0xee, 0xce, 0x32, 0x33, 0x76, 0xf1, 0x25, 0x6f,
+	0xc1, 0x23, 0xf4, 0x11, 0x78, 0x04, 0x2e, 0x79, 0x04, 0x14, 0x5e, 0x04, 0xcd, 0x99, 0xf5, 0x66,
+	0x63, 0x2b, 0x01, 0xd1, 0x3b, 0x9f, 0xef, 0x7c, 0x7b, 0xce, 0x99, 0x99, 0xdf, 0x89, 0x02, 0x1f,
+	0x5e, 0x2a, 0xf5, 0x4a, 0x16, 0x69, 0x6c, 0x84, 0x5e, 0x4a, 0x2e, 0x9e, 0x8a, 0x82, 0xab, 0x45,
+	0x61, 0x85, 0x3e, 0x29, 0xb5, 0xb2, 0x8a, 0x0c, 0x36, 0x0c, 0xd1, 0x9b, 0x36, 0xec, 0xbd, 0x94,
+	0x96, 0x65, 0x86, 0x3c, 0x82, 0x9e, 0x15, 0x79, 0x29, 0x34, 0xb3, 0x0b, 0x2d, 0x62, 0x1e, 0xb6,
+	0x46, 0xad, 0xe3, 0x16, 0x3d, 0x68, 0x88, 0x13, 0xf2, 0x3e, 0x04, 0xe5, 0x22, 0x33, 0x22, 0xbe,
+	0x2c, 0xf3, 0xb0, 0x3d, 0x6a, 0x1d, 0xef, 0xd2, 0x2e, 0x0a, 0xcf, 0xca, 0xdc, 0x55, 0x30, 0x2b,
+	0x63, 0x55, 0x26, 0x79, 0x9c, 0xe7, 0xf3, 0x34, 0xdc, 0x41, 0xc3, 0xc1, 0x5a, 0xfc, 0x3e, 0x9f,
+	0xa7, 0xe4, 0x13, 0xe8, 0x27, 0x92, 0x35, 0x5d, 0x1d, 0x74, 0xf5, 0x6a, 0x15, 0x6d, 0x4f, 0x60,
+	0xa8, 0x85, 0x29, 0xa5, 0x66, 0x56, 0xe9, 0x55, 0xac, 0x99, 0x15, 0xe1, 0x2e, 0x1a, 0x07, 0x0d,
+	0x9d, 0x32, 0x2b, 0xc8, 0xa7, 0x70, 0x5f, 0xfd, 0xb2, 0x4a, 0x45, 0x11, 0x1b, 0x37, 0x25, 0xb3,
+	0x52, 0x15, 0xe1, 0x1e, 0x7a, 0x87, 0x3e, 0x71, 0x51, 0xeb, 0xee, 0x00, 0xaf, 0x85, 0x4c, 0xe7,
+	0x36, 0x7e, 0x95, 0x86, 0xf7, 0xf0, 0x84, 0x5d, 0x2f, 0x7c, 0x97, 0xba, 0xe4, 0xdc, 0x27, 0x79,
+	0x1e, 0x76, 0x7d, 0xd2, 0x0b, 0x93, 0x3c, 0x9a, 0x41, 0x70, 0x26, 0x59, 0x5a, 0x28, 0x23, 0x0d,
+	0x79, 0x08, 0x20, 0x79, 0xf2, 0xf9, 0x67, 0x31, 0x57, 0x89, 0xc0, 0x9b, 0x0a, 0x68, 0x80, 0xca,
+	0x44, 0x25, 0x82, 0x8c, 0x60, 0x3f, 0x11, 0x86, 0x6b, 0x59, 0xe2, 0x30, 0x6d, 0xcc, 0x37, 0x25,
+	0x12, 0xc2, 0xbd, 0x52, 0xcb, 0x9c, 0xe9, 0x15, 0xde, 0x52, 0x97, 0xae, 0xc3, 0x28, 0x86, 0x7b,
+	0xe3, 0x2c, 0x13, 0x3a, 0x5d, 0x91, 0x0f, 0x20, 0x30, 0x8b, 0x4b, 0x63, 0x59, 0xc1, 0xeb, 0x26,
+	0xb5, 0x40, 0x0e, 0xa1, 0xab, 0x05, 0xe3, 0x8d, 0x0e, 0x75, 0xec, 0x72, 0x46, 0x2c, 0x85, 0x96,
+	0xd6, 0xd7, 0x0f, 0x68, 0x1d, 0x47, 0x1a, 0x86, 0x93, 0xb9, 0x56, 0x85, 0xe4, 0x13, 0x55, 0x24,
+	0x12, 0xfd, 0x6f, 0x7d, 0x9e, 0x87, 0x00, 0xaa, 0x30, 0xc2, 0xc6, 0x89, 0x7b, 0x29, 0xdf, 0x32,
+	0x40, 0xe5, 0x8c, 0x59, 0x11, 0x8d, 0x21, 0x38, 0xd7, 0x8a, 0x8b, 0x64, 0xa1, 0x05, 0x21, 0xd0,
+	0x69, 0xb4, 0xe9, 0xf0, 0xff, 0xd4, 0x21, 0x7a, 0xb3, 0x0b, 0xc1, 0x37, 0x6b, 0x9e, 0x49, 0x1f,
+	0xda, 0x32, 0xa9, 0x2a, 0xb4, 0x65, 0xe2, 0xb0, 0x62, 0x65, 0xa9, 0x64, 0x61, 0x73, 0x51, 0xd8,
+	0x58, 0x26, 0x58, 0x62, 0x87, 0xf6, 0x1a, 0xea, 0x34, 0x71, 0x63, 0x96, 0xcc, 0xca, 0xca, 0x52,
+	0x8d, 0x59, 0x29, 0xd3, 0xc4, 0x01, 0x90, 0x28, 0x6e, 0x95, 0x76, 0xd9, 0x8e, 0xbf, 0x37, 0x2f,
+	0x4c, 0xb1, 0x45, 0xbd, 0x4f, 0x22, 0x89, 0x99, 0x45, 0x20, 0x03, 0xda, 0x6b, 0xa8, 0x63, 0x4b,
+	0x1e, 0xc3, 0x80, 0xcf, 0xa5, 0x98, 0xc5, 0x5c, 0xe5, 0x65, 0xc6, 0x64, 0x61, 0x11, 0xc6, 0x80,
+	0xf6, 0x51, 0x9e, 0xac, 0x55, 0xf2, 0x14, 0xf6, 0x96, 0xb8, 0x7a, 0xc8, 0xe1, 0xfe, 0xe9, 0x7b,
+	0x27, 0x1b, 0xdb, 0x79, 0xe2, 0x37, 0x93, 0x56, 0x36, 0xf2, 0x15, 0x04, 0x89, 0x27, 0x50, 0x98,
+	0xb0, 0x3b, 0xda, 0x39, 0xde, 0x3f, 0x3d, 0xdc, 0xfa, 0xa6, 0x66, 0x94, 0x5e, 0x9b, 0xc9, 0x97,
+	0x10, 0x30, 0x64, 0x4a, 0x0a, 0x13, 0x06, 0xf8, 0x65, 0xb8, 0xf5, 0x65, 0x45, 0x1d, 0xbd, 0xb6,
+	0x92, 0x73, 0x20, 0xdc, 0xa3, 0x12, 0xf3, 0x35, 0x2b, 0x26, 0x04, 0x2c, 0xf0, 0xd1, 0x56, 0x81,
+	0x4d, 0xaa, 0xe8, 0x7d, 0xbe, 0xa1, 0x18, 0xf2, 0x35, 0x40, 0xb9, 0x06, 0xc1, 0x84, 0xfb, 0xb7,
+	0x1c, 0xa2, 0x66, 0x85, 0x36, 0xdc, 0xe4, 0x63, 0xe8, 0xcf, 0x54, 0x96, 0xa9, 0xd7, 0xf1, 0xa2,
+	0x8c, 0xcb, 0x8c, 0x15, 0xe1, 0x01, 0x5e, 0xec, 0x81, 0x57, 0x5f, 0x94, 0xe7, 0x19, 0x2b, 0x6e,
+	0xba, 0x90, 0xc6, 0xde, 0x4d, 0x97, 0x03, 0xd2, 0x81, 0xc0, 0xb5, 0x60, 0xd6, 0x3f, 0x64, 0xdf,
+	0x83, 0x50, 0x29, 0x63, 0xeb, 0xd2, 0x8b, 0x32, 0x59, 0xa7, 0x07, 0x3e, 0x5d, 0x29, 0x3e, 0x9d,
+	0x88, 0x4c, 0x54, 0xe9, 0xa1, 0x4f, 0x57, 0xca, 0xd8, 0x46, 0x0c, 0x1e, 0xd4, 0xa4, 0x7e, 0x2b,
+	0x45, 0x96, 0xbc, 0x64, 0xd9, 0x42, 0x50, 0xf1, 0x33, 0x79, 0x17, 0x76, 0x67, 0x4e, 0xa8, 0xc8,
+	0xf5, 0x81, 0x53, 0x97, 0xce, 0x51, 0x61, 0xef, 0x03, 0x07, 0xa3, 0x34, 0xb1, 0x5b, 0xe8, 0xa5,
+	0xa8, 0xfe, 0x48, 0x74, 0xa5, 0x19, 0x63, 0x1c, 0x3d, 0x81, 0x41, 0xdd, 0xe2, 0xc2, 0x32, 0xbb,
+	0x30, 0xe4, 0x01, 0xec, 0x19, 0xfc, 0x85, 0xc5, 0xbb, 0xb4, 0x8a, 0xa2, 0x5f, 0x5b, 0x40, 0xce,
+	0x3d, 0xe2, 0x3f, 0xc8, 0x5c, 0x64, 0xb2, 0xc0, 0x51, 0x6e, 0xae, 0x42, 0x6b, 0x73, 0x15, 0x08,
+	0x74, 0x4a, 0x96, 0xfa, 0x91, 0x3a, 0x14, 0x7f, 0xbb, 0x39, 0x33, 0x99, 0x4b, 0x8b, 0xd3, 0x74,
+	0xa8, 0x0f, 0x9c, 0x73, 0xa6, 0x55, 0x5e, 0xed, 0x0b, 0xfe, 0x76, 0xeb, 0x69, 0x55, 0xb5, 0x1f,
+	0x6d, 0xab, 0x22, 0x0e, 0x83, 0x8d, 0x11, 0x5c, 0x31, 0x9c, 0x1f, 0x5b, 0xef, 0x50, 0x1f, 0x38,
+	0x3e, 0xea, 0x75, 0x32, 0x61, 0xfb, 0x16, 0x3e, 0xea, 0xa3, 0xd3, 0x86, 0xfb, 0xf4, 0xf7, 0x1d,
+	0x18, 0x5e, 0x5f, 0x8a, 0xb7, 0x92, 0x29, 0x0c, 0x26, 0xf8, 0xac, 0x75, 0x86, 0xdc, 0x51, 0xef,
+	0xf0, 0x8e, 0x1c, 0xb9, 0x80, 0x83, 0xe7, 0xc2, 0x5e, 0xc7, 0x8f, 0x6f, 0xf7, 0xde, 0x78, 0xf5,
+	0x3b, 0x8b, 0x4e, 0x61, 0xf0, 0x02, 0xb9, 0x7a, 0xfb, 0xf9, 0x7e, 0x82, 0xc1, 0x19, 0x32, 0xf8,
+	0x3f, 0x46, 0x1c, 0xdd, 0x6e, 0xac, 0xf0, 0xfa, 0x11, 0xc8, 0x73, 0x61, 0x37, 0x5f, 0xf1, 0xd1,
+	0xf6, 0xee, 0x6e, 0xa1, 0x76, 0x38, 0xfa, 0x37, 0xd3, 0xb3, 0xe1, 0x1f, 0x57, 0x47, 0xad, 0x3f,
+	0xaf, 0x8e, 0x5a, 0x7f, 0x5d, 0x1d, 0xb5, 0x7e, 0xfb, 0xfb, 0xe8, 0x9d, 0xcb, 0x3d, 0xfc, 0x8f,
+	0xe5, 0x8b, 0x7f, 0x06, 0x00, 0x07, 0x81, 0x3a, 0x7f, 0xd4, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EncounterServiceClient is the client API for EncounterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EncounterServiceClient interface {
+	// encounters
+	CreateEncounter(ctx context.Context, in *Encounter, opts ...grpc.CallOption) (*Encounter, error)
+	GetEncounter(ctx context.Context, in *EncounterFieldValueReq, opts ...grpc.CallOption) (*Encounter, error)
+	UpdateEncounter(ctx context.Context, in *Encounter, opts ...grpc.CallOption) (*Encounter, error)
+	DeleteEncounter(ctx context.Context, in *EncounterFieldValueReq, opts ...grpc.CallOption) (*EncounterStatus, error)
+	GetPatientTimeline(ctx context.Context, in *PatientTimelineReq, opts ...grpc.CallOption) (*PatientTimeline, error)
+}
+
+type encounterServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEncounterServiceClient(cc *grpc.ClientConn) EncounterServiceClient {
+	return &encounterServiceClient{cc}
+}
+
+func (c *encounterServiceClient) CreateEncounter(ctx context.Context, in *Encounter, opts ...grpc.CallOption) (*Encounter, error) {
+	out := new(Encounter)
+	err := c.cc.Invoke(ctx, "/booking_service.EncounterService/CreateEncounter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *encounterServiceClient) GetEncounter(ctx context.Context, in *EncounterFieldValueReq, opts ...grpc.CallOption) (*Encounter, error) {
+	out := new(Encounter)
+	err := c.cc.Invoke(ctx, "/booking_service.EncounterService/GetEncounter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *encounterServiceClient) UpdateEncounter(ctx context.Context, in *Encounter, opts ...grpc.CallOption) (*Encounter, error) {
+	out := new(Encounter)
+	err := c.cc.Invoke(ctx, "/booking_service.EncounterService/UpdateEncounter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *encounterServiceClient) DeleteEncounter(ctx context.Context, in *EncounterFieldValueReq, opts ...grpc.CallOption) (*EncounterStatus, error) {
+	out := new(EncounterStatus)
+	err := c.cc.Invoke(ctx, "/booking_service.EncounterService/DeleteEncounter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *encounterServiceClient) GetPatientTimeline(ctx context.Context, in *PatientTimelineReq, opts ...grpc.CallOption) (*PatientTimeline, error) {
+	out := new(PatientTimeline)
+	err := c.cc.Invoke(ctx, "/booking_service.EncounterService/GetPatientTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EncounterServiceServer is the server API for EncounterService service.
+type EncounterServiceServer interface {
+	// encounters
+	CreateEncounter(context.Context, *Encounter) (*Encounter, error)
+	GetEncounter(context.Context, *EncounterFieldValueReq) (*Encounter, error)
+	UpdateEncounter(context.Context, *Encounter) (*Encounter, error)
+	DeleteEncounter(context.Context, *EncounterFieldValueReq) (*EncounterStatus, error)
+	GetPatientTimeline(context.Context, *PatientTimelineReq) (*PatientTimeline, error)
+}
+
+// UnimplementedEncounterServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedEncounterServiceServer struct {
+}
+
+func (*UnimplementedEncounterServiceServer) CreateEncounter(ctx context.Context, req *Encounter) (*Encounter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEncounter not implemented")
+}
+func (*UnimplementedEncounterServiceServer) GetEncounter(ctx context.Context, req *EncounterFieldValueReq) (*Encounter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEncounter not implemented")
+}
+func (*UnimplementedEncounterServiceServer) UpdateEncounter(ctx context.Context, req *Encounter) (*Encounter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEncounter not implemented")
+}
+func (*UnimplementedEncounterServiceServer) DeleteEncounter(ctx context.Context, req *EncounterFieldValueReq) (*EncounterStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEncounter not implemented")
+}
+func (*UnimplementedEncounterServiceServer) GetPatientTimeline(ctx context.Context, req *PatientTimelineReq) (*PatientTimeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientTimeline not implemented")
+}
+
+func RegisterEncounterServiceServer(s *grpc.Server, srv EncounterServiceServer) {
+	s.RegisterService(&_EncounterService_serviceDesc, srv)
+}
+
+func _EncounterService_CreateEncounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Encounter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EncounterServiceServer).CreateEncounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.EncounterService/CreateEncounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EncounterServiceServer).CreateEncounter(ctx, req.(*Encounter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EncounterService_GetEncounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncounterFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EncounterServiceServer).GetEncounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.EncounterService/GetEncounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EncounterServiceServer).GetEncounter(ctx, req.(*EncounterFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EncounterService_UpdateEncounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Encounter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EncounterServiceServer).UpdateEncounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.EncounterService/UpdateEncounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EncounterServiceServer).UpdateEncounter(ctx, req.(*Encounter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EncounterService_DeleteEncounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncounterFieldValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EncounterServiceServer).DeleteEncounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.EncounterService/DeleteEncounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EncounterServiceServer).DeleteEncounter(ctx, req.(*EncounterFieldValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EncounterService_GetPatientTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatientTimelineReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EncounterServiceServer).GetPatientTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking_service.EncounterService/GetPatientTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EncounterServiceServer).GetPatientTimeline(ctx, req.(*PatientTimelineReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EncounterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "booking_service.EncounterService",
+	HandlerType: (*EncounterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEncounter",
+			Handler:    _EncounterService_CreateEncounter_Handler,
+		},
+		{
+			MethodName: "GetEncounter",
+			Handler:    _EncounterService_GetEncounter_Handler,
+		},
+		{
+			MethodName: "UpdateEncounter",
+			Handler:    _EncounterService_UpdateEncounter_Handler,
+		},
+		{
+			MethodName: "DeleteEncounter",
+			Handler:    _EncounterService_DeleteEncounter_Handler,
+		},
+		{
+			MethodName: "GetPatientTimeline",
+			Handler:    _EncounterService_GetPatientTimeline_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking_service/encounter.proto",
+}
+
+func (m *Vitals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vitals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vitals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HeightCm != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.HeightCm))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.WeightKg != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.WeightKg))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.OxygenSaturation != 0 {
+		i = encodeVarintEncounter(dAtA, i, uint64(m.OxygenSaturation))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RespiratoryRate != 0 {
+		i = encodeVarintEncounter(dAtA, i, uint64(m.RespiratoryRate))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DiastolicMmhg != 0 {
+		i = encodeVarintEncounter(dAtA, i, uint64(m.DiastolicMmhg))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SystolicMmhg != 0 {
+		i = encodeVarintEncounter(dAtA, i, uint64(m.SystolicMmhg))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PulseBpm != 0 {
+		i = encodeVarintEncounter(dAtA, i, uint64(m.PulseBpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TemperatureC != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TemperatureC))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Diagnosis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Diagnosis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Diagnosis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Primary {
+		i--
+		if m.Primary {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Icd10Code) > 0 {
+		i -= len(m.Icd10Code)
+		copy(dAtA[i:], m.Icd10Code)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.Icd10Code)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Allergy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Allergy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Allergy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Severity) > 0 {
+		i -= len(m.Severity)
+		copy(dAtA[i:], m.Severity)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.Severity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reaction) > 0 {
+		i -= len(m.Reaction)
+		copy(dAtA[i:], m.Reaction)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.Reaction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Substance) > 0 {
+		i -= len(m.Substance)
+		copy(dAtA[i:], m.Substance)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.Substance)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChronicCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChronicCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChronicCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OnsetDate) > 0 {
+		i -= len(m.OnsetDate)
+		copy(dAtA[i:], m.OnsetDate)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.OnsetDate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Icd10Code) > 0 {
+		i -= len(m.Icd10Code)
+		copy(dAtA[i:], m.Icd10Code)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.Icd10Code)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Procedure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Procedure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Procedure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Encounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Encounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Encounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.FollowUpDate) > 0 {
+		i -= len(m.FollowUpDate)
+		copy(dAtA[i:], m.FollowUpDate)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.FollowUpDate)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.FollowUpPlan) > 0 {
+		i -= len(m.FollowUpPlan)
+		copy(dAtA[i:], m.FollowUpPlan)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.FollowUpPlan)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Procedures) > 0 {
+		for iNdEx := len(m.Procedures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Procedures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEncounter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ChronicConditions) > 0 {
+		for iNdEx := len(m.ChronicConditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChronicConditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEncounter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Allergies) > 0 {
+		for iNdEx := len(m.Allergies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allergies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEncounter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Diagnoses) > 0 {
+		for iNdEx := len(m.Diagnoses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Diagnoses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEncounter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Vitals != nil {
+		{
+			size, err := m.Vitals.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEncounter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ChiefComplaint) > 0 {
+		i -= len(m.ChiefComplaint)
+		copy(dAtA[i:], m.ChiefComplaint)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.ChiefComplaint)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EncounteredAt) > 0 {
+		i -= len(m.EncounteredAt)
+		copy(dAtA[i:], m.EncounteredAt)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.EncounteredAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppointmentId != 0 {
+		i = encodeVarintEncounter(dAtA, i, uint64(m.AppointmentId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncounterFieldValueReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncounterFieldValueReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncounterFieldValueReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncounterStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncounterStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncounterStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PatientTimelineReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PatientTimelineReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PatientTimelineReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Limit != 0 {
+		i = encodeVarintEncounter(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Page != 0 {
+		i = encodeVarintEncounter(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintEncounter(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PatientTimeline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PatientTimeline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PatientTimeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Encounters) > 0 {
+		for iNdEx := len(m.Encounters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Encounters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEncounter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintEncounter(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEncounter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEncounter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Vitals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TemperatureC != 0 {
+		n += 9
+	}
+	if m.PulseBpm != 0 {
+		n += 1 + sovEncounter(uint64(m.PulseBpm))
+	}
+	if m.SystolicMmhg != 0 {
+		n += 1 + sovEncounter(uint64(m.SystolicMmhg))
+	}
+	if m.DiastolicMmhg != 0 {
+		n += 1 + sovEncounter(uint64(m.DiastolicMmhg))
+	}
+	if m.RespiratoryRate != 0 {
+		n += 1 + sovEncounter(uint64(m.RespiratoryRate))
+	}
+	if m.OxygenSaturation != 0 {
+		n += 1 + sovEncounter(uint64(m.OxygenSaturation))
+	}
+	if m.WeightKg != 0 {
+		n += 9
+	}
+	if m.HeightCm != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Diagnosis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Icd10Code)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	if m.Primary {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Allergy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Substance)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.Reaction)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.Severity)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChronicCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Icd10Code)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.OnsetDate)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Procedure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Encounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	if m.AppointmentId != 0 {
+		n += 1 + sovEncounter(uint64(m.AppointmentId))
+	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.EncounteredAt)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.ChiefComplaint)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	if m.Vitals != nil {
+		l = m.Vitals.Size()
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	if len(m.Diagnoses) > 0 {
+		for _, e := range m.Diagnoses {
+			l = e.Size()
+			n += 1 + l + sovEncounter(uint64(l))
+		}
+	}
+	if len(m.Allergies) > 0 {
+		for _, e := range m.Allergies {
+			l = e.Size()
+			n += 1 + l + sovEncounter(uint64(l))
+		}
+	}
+	if len(m.ChronicConditions) > 0 {
+		for _, e := range m.ChronicConditions {
+			l = e.Size()
+			n += 1 + l + sovEncounter(uint64(l))
+		}
+	}
+	if len(m.Procedures) > 0 {
+		for _, e := range m.Procedures {
+			l = e.Size()
+			n += 1 + l + sovEncounter(uint64(l))
+		}
+	}
+	l = len(m.FollowUpPlan)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.FollowUpDate)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 2 + l + sovEncounter(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EncounterFieldValueReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EncounterStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PatientTimelineReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	if m.Page != 0 {
+		n += 1 + sovEncounter(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEncounter(uint64(m.Limit))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEncounter(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PatientTimeline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovEncounter(uint64(m.Count))
+	}
+	if len(m.Encounters) > 0 {
+		for _, e := range m.Encounters {
+			l = e.Size()
+			n += 1 + l + sovEncounter(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovEncounter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEncounter(x uint64) (n int) {
+	return sovEncounter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Vitals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vitals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vitals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemperatureC", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TemperatureC = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseBpm", wireType)
+			}
+			m.PulseBpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PulseBpm |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystolicMmhg", wireType)
+			}
+			m.SystolicMmhg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SystolicMmhg |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiastolicMmhg", wireType)
+			}
+			m.DiastolicMmhg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiastolicMmhg |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RespiratoryRate", wireType)
+			}
+			m.RespiratoryRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RespiratoryRate |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OxygenSaturation", wireType)
+			}
+			m.OxygenSaturation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OxygenSaturation |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightKg", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.WeightKg = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightCm", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.HeightCm = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Diagnosis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Diagnosis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Diagnosis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Icd10Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Icd10Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Primary = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Allergy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Allergy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Allergy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Substance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Substance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reaction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reaction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Severity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChronicCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChronicCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChronicCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Icd10Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Icd10Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnsetDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnsetDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Procedure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Procedure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Procedure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Encounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Encounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Encounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppointmentId", wireType)
+			}
+			m.AppointmentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppointmentId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncounteredAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncounteredAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChiefComplaint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChiefComplaint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vitals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vitals == nil {
+				m.Vitals = &Vitals{}
+			}
+			if err := m.Vitals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diagnoses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diagnoses = append(m.Diagnoses, &Diagnosis{})
+			if err := m.Diagnoses[len(m.Diagnoses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allergies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allergies = append(m.Allergies, &Allergy{})
+			if err := m.Allergies[len(m.Allergies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChronicConditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChronicConditions = append(m.ChronicConditions, &ChronicCondition{})
+			if err := m.ChronicConditions[len(m.ChronicConditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Procedures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Procedures = append(m.Procedures, &Procedure{})
+			if err := m.Procedures[len(m.Procedures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowUpPlan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FollowUpPlan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowUpDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FollowUpDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncounterFieldValueReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncounterFieldValueReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncounterFieldValueReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncounterStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncounterStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncounterStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PatientTimelineReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PatientTimelineReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PatientTimelineReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PatientTimeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PatientTimeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PatientTimeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encounters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encounters = append(m.Encounters, &Encounter{})
+			if err := m.Encounters[len(m.Encounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEncounter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEncounter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEncounter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEncounter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEncounter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEncounter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEncounter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEncounter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEncounter = fmt.Errorf("proto: unexpected end of group")
+)
@@ -4,13 +4,23 @@ import (
 	pb "booking_service/genproto/booking_service"
 	grpc_server "booking_service/internal/delivery/grpc/server"
 	invest_grpc "booking_service/internal/delivery/grpc/services"
+	"booking_service/internal/infrastructure/claimexport"
+	"booking_service/internal/infrastructure/fhir"
 	"booking_service/internal/infrastructure/grpc_service_clients"
+	"booking_service/internal/infrastructure/kafka"
+	"booking_service/internal/infrastructure/payment"
+	"booking_service/internal/infrastructure/pricing"
+	"booking_service/internal/infrastructure/receipt"
 	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/infrastructure/rxdocument"
+	"booking_service/internal/infrastructure/videoroom"
 	"booking_service/internal/pkg/config"
 	"booking_service/internal/pkg/logger"
 	"booking_service/internal/pkg/otlp"
 	"booking_service/internal/pkg/postgres"
 	"booking_service/internal/usecase"
+	"booking_service/internal/usecase/event"
+	"context"
 	"fmt"
 	"time"
 
@@ -29,7 +39,8 @@ type App struct {
 	GrpcServer     *grpc.Server
 	ShutdownOTLP   func() error
 	ServiceClients grpc_service_clients.ServiceClients
-	//BrokerProducer event.BrokerProducer
+	stopWorkers    context.CancelFunc
+	BrokerProducer event.BrokerProducer
 }
 
 func NewApp(cfg *config.Config) (*App, error) {
@@ -39,7 +50,7 @@ func NewApp(cfg *config.Config) (*App, error) {
 		return nil, err
 	}
 
-	//errkafkaProducer := kafka.NewProducer(cfg, logger)
+	kafkaProducer := kafka.NewProducer(cfg, logger)
 
 	// otlp collector initialization
 	shutdownOTLP, err := otlp.InitOTLPProvider(cfg)
@@ -71,12 +82,12 @@ func NewApp(cfg *config.Config) (*App, error) {
 	)
 
 	return &App{
-		Config:       cfg,
-		Logger:       logger,
-		DB:           db,
-		GrpcServer:   grpcServer,
-		ShutdownOTLP: shutdownOTLP,
-		//BrokerProducer: kafkaProducer,
+		Config:         cfg,
+		Logger:         logger,
+		DB:             db,
+		GrpcServer:     grpcServer,
+		ShutdownOTLP:   shutdownOTLP,
+		BrokerProducer: kafkaProducer,
 	}, nil
 }
 
@@ -103,9 +114,66 @@ func (a *App) Run() error {
 
 	doctorAvailability := repo.NewDoctorAvailability(a.DB)
 
+	bookingEncounters := repo.NewBookingEncounters(a.DB)
+
+	bookingPrescriptions := repo.NewBookingPrescriptions(a.DB)
+
+	bookingLabOrders := repo.NewBookingLabOrders(a.DB)
+	bookingDocuments := repo.NewBookingDocuments(a.DB)
+	bookingMessages := repo.NewBookingMessages(a.DB)
+	bookingBilling := repo.NewBookingBilling(a.DB)
+	bookingPayments := repo.NewBookingPayments(a.DB)
+	bookingInsurance := repo.NewBookingInsurance(a.DB)
+	bookingHistory := repo.NewBookingHistory(a.DB)
+
+	// video room provider initialization
+	videoRooms, err := videoroom.New(a.Config)
+	if err != nil {
+		return fmt.Errorf("error during initialize video room provider: %w", err)
+	}
+	joinWindow, err := time.ParseDuration(a.Config.Video.JoinWindow)
+	if err != nil {
+		return fmt.Errorf("invalid video join window: %w", err)
+	}
+
+	// payment provider initialization
+	paymentProvider, err := payment.New(a.Config)
+	if err != nil {
+		return fmt.Errorf("error during initialize payment provider: %w", err)
+	}
+	holdTTL, err := time.ParseDuration(a.Config.Payment.HoldTTL)
+	if err != nil {
+		return fmt.Errorf("invalid payment hold ttl: %w", err)
+	}
+	sweepInterval, err := time.ParseDuration(a.Config.Payment.SweepInterval)
+	if err != nil {
+		return fmt.Errorf("invalid payment sweep interval: %w", err)
+	}
+
+	// patient messaging initialization; the messaging time zone is the
+	// clinic's, the one appointments are booked in as well
+	messagingLocation, err := time.LoadLocation(a.Config.Messaging.TimeZone)
+	if err != nil {
+		return fmt.Errorf("invalid messaging time zone: %w", err)
+	}
+	messageRetention, err := time.ParseDuration(a.Config.Messaging.Retention)
+	if err != nil {
+		return fmt.Errorf("invalid messaging retention: %w", err)
+	}
+	messageSweepInterval, err := time.ParseDuration(a.Config.Messaging.SweepInterval)
+	if err != nil {
+		return fmt.Errorf("invalid messaging sweep interval: %w", err)
+	}
+
+	// prescription documents initialization
+	prescriptionDocuments, err := rxdocument.New(a.Config)
+	if err != nil {
+		return fmt.Errorf("error during initialize prescription documents: %w", err)
+	}
+
 	// usecase initialization
 
-	appointmentsUseCase := usecase.NewBookedAppointments(bookingAppointment, contextTimeout)
+	appointmentsUseCase := usecase.NewBookedAppointments(bookingAppointment, pricing.NewHealthcare(serviceClients.DoctorsService()), videoRooms, a.BrokerProducer, messagingLocation, joinWindow, contextTimeout)
 
 	patientUseCase := usecase.NewBookedPatient(bookingPatients, contextTimeout)
 
@@ -115,6 +183,19 @@ func (a *App) Run() error {
 
 	doctorAvailabilityUseCase := usecase.NewBookedDoctorAvailability(doctorAvailability, contextTimeout)
 
+	encountersUseCase := usecase.NewBookedEncounters(bookingEncounters, contextTimeout)
+
+	prescriptionsUseCase := usecase.NewBookedPrescriptions(bookingPrescriptions, prescriptionDocuments, a.BrokerProducer, contextTimeout)
+
+	labOrdersUseCase := usecase.NewBookedLabOrders(bookingLabOrders, a.BrokerProducer, contextTimeout)
+	documentsUseCase := usecase.NewBookedDocuments(bookingDocuments, contextTimeout)
+	messagesUseCase := usecase.NewBookedMessages(bookingMessages, a.BrokerProducer, messagingLocation, messageRetention, contextTimeout)
+	billingUseCase := usecase.NewBookedBilling(bookingBilling, receipt.New(), contextTimeout)
+	paymentsUseCase := usecase.NewBookedPayments(bookingPayments, appointmentsUseCase, bookingBilling, paymentProvider, holdTTL, contextTimeout)
+	insuranceUseCase := usecase.NewBookedInsurance(bookingInsurance, bookingBilling, claimexport.New(), contextTimeout)
+	historyUseCase := usecase.NewBookedHistory(bookingPatients, bookingArchive, bookingEncounters, doctorNotes,
+		bookingPrescriptions, bookingLabOrders, bookingHistory, fhir.New(), contextTimeout)
+
 	pb.RegisterBookedAppointmentsServiceServer(a.GrpcServer, invest_grpc.BookingAppointmentsNewRPC(a.Logger, appointmentsUseCase))
 
 	pb.RegisterPatientsServiceServer(a.GrpcServer, invest_grpc.BookingPatientNewRPC(a.Logger, patientUseCase))
@@ -124,6 +205,26 @@ func (a *App) Run() error {
 	pb.RegisterArchiveServiceServer(a.GrpcServer, invest_grpc.BookingArchiveNewRPC(a.Logger, archiveUseCase))
 
 	pb.RegisterDoctorTimeServiceServer(a.GrpcServer, invest_grpc.BookingDoctorAvailabilityNewRPC(a.Logger, doctorAvailabilityUseCase))
+
+	pb.RegisterEncounterServiceServer(a.GrpcServer, invest_grpc.BookingEncountersNewRPC(a.Logger, encountersUseCase))
+
+	pb.RegisterPrescriptionServiceServer(a.GrpcServer, invest_grpc.BookingPrescriptionsNewRPC(a.Logger, prescriptionsUseCase))
+
+	pb.RegisterLabOrderServiceServer(a.GrpcServer, invest_grpc.BookingLabOrdersNewRPC(a.Logger, labOrdersUseCase))
+	pb.RegisterDocumentServiceServer(a.GrpcServer, invest_grpc.BookingDocumentsNewRPC(a.Logger, documentsUseCase))
+	pb.RegisterMessageServiceServer(a.GrpcServer, invest_grpc.BookingMessagesNewRPC(a.Logger, messagesUseCase))
+	pb.RegisterBillingServiceServer(a.GrpcServer, invest_grpc.BookingBillingNewRPC(a.Logger, billingUseCase))
+	pb.RegisterPaymentServiceServer(a.GrpcServer, invest_grpc.BookingPaymentsNewRPC(a.Logger, paymentsUseCase))
+	pb.RegisterInsuranceServiceServer(a.GrpcServer, invest_grpc.BookingInsuranceNewRPC(a.Logger, insuranceUseCase))
+	pb.RegisterHistoryServiceServer(a.GrpcServer, invest_grpc.BookingHistoryNewRPC(a.Logger, historyUseCase))
+
+	// pending payments whose booking hold ran out release their slots
+	workers, stopWorkers := context.WithCancel(context.Background())
+	a.stopWorkers = stopWorkers
+	go a.expirePaymentHolds(workers, paymentsUseCase, sweepInterval)
+	// messages past the retention period are purged
+	go a.purgeMessages(workers, messagesUseCase, messageSweepInterval)
+
 	a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
 
 	if err := grpc_server.Run(a.Config, a.GrpcServer); err != nil {
@@ -133,8 +234,53 @@ func (a *App) Run() error {
 	return nil
 }
 
+func (a *App) expirePaymentHolds(ctx context.Context, payments usecase.Payments, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			released, err := payments.ExpireHolds(ctx)
+			if err != nil {
+				a.Logger.Error("expire payment holds", zap.Error(err))
+			}
+			if released > 0 {
+				a.Logger.Info("released expired booking holds", zap.Int("count", released))
+			}
+		}
+	}
+}
+
+func (a *App) purgeMessages(ctx context.Context, messages usecase.Messages, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := messages.PurgeExpiredMessages(ctx)
+			if err != nil {
+				a.Logger.Error("purge expired messages", zap.Error(err))
+			}
+			if purged > 0 {
+				a.Logger.Info("purged expired messages", zap.Int64("count", purged))
+			}
+		}
+	}
+}
+
 func (a *App) Stop() {
+	// stop background workers
+	if a.stopWorkers != nil {
+		a.stopWorkers()
+	}
 	// close broker producer
+	a.BrokerProducer.Close()
 	// closing client service connections
 	a.ServiceClients.Close()
 	// stop gRPC server
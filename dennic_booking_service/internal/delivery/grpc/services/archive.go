@@ -2,7 +2,9 @@ package services
 
 import (
 	pb "booking_service/genproto/booking_service"
+	"booking_service/internal/delivery/grpc"
 	"booking_service/internal/entity/archive"
+	"booking_service/internal/entity/billing"
 	"booking_service/internal/pkg/otlp"
 	"booking_service/internal/usecase"
 	"context"
@@ -17,6 +19,8 @@ const (
 	spanNameArchiveService = "ArchiveService"
 )
 
+const archiveTimeLayout = "2006-01-02 15:04:05"
+
 type BookingArchive struct {
 	logger               *zap.Logger
 	bookedArchiveUseCase usecase.Archive
@@ -30,51 +34,76 @@ func BookingArchiveNewRPC(logger *zap.Logger, ArchiveUsaCase usecase.Archive) *B
 	}
 }
 
+// timestamp parses a visit time; an empty value is left zero.
+func (p *amountParser) timestamp(field, value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+	t, err := time.ParseInLocation(archiveTimeLayout, value, time.UTC)
+	if err != nil {
+		p.errValidation.Errors[field] = "must be a time like 2006-01-02 15:04:05"
+	}
+	return t
+}
+
+// optional parses an amount that may be left empty.
+func (p *amountParser) optional(field, value string) billing.Amount {
+	if value == "" {
+		return 0
+	}
+	return p.parse(field, value)
+}
+
+func archiveToPb(res *archive.Archive) *pb.Archive {
+	return &pb.Archive{
+		Id:              res.Id,
+		AppointmentId:   res.AppointmentId,
+		PatientId:       res.PatientId,
+		DoctorId:        res.DoctorId,
+		DepartmentId:    res.DepartmentId,
+		BranchId:        res.BranchId,
+		AppointmentDate: res.AppointmentDate.Format("2006-01-02"),
+		StartedAt:       res.StartedAt.Format(archiveTimeLayout),
+		EndedAt:         res.EndedAt.Format(archiveTimeLayout),
+		PatientProblem:  res.PatientProblem,
+		Outcome:         res.Outcome,
+		PaymentType:     res.PaymentType,
+		PaymentAmount:   res.PaymentAmount.String(),
+		Currency:        res.Currency,
+		InvoiceId:       res.InvoiceId,
+		CreatedAt:       res.CreatedAt.Format(archiveTimeLayout),
+		UpdatedAt:       formatOptionalTime(res.UpdatedAt),
+		DeletedAt:       formatOptionalTime(res.DeletedAt),
+	}
+}
+
 func (r *BookingArchive) CreateArchive(ctx context.Context, req *pb.CreateArchiveReq) (*pb.Archive, error) {
 	ctx, span := otlp.Start(ctx, serviceNameArchive, spanNameArchiveService+"Create")
 	span.SetAttributes(
-		attribute.Key("id").Int64(req.DoctorAvailabilityId),
+		attribute.Key("appointment_id").Int64(req.AppointmentId),
 	)
 	defer span.End()
 
-	startTime, err := time.Parse("15:04:05", req.StartTime)
-	if err != nil {
-		return nil, err
+	parser := newAmountParser()
+	create := &archive.CreatedArchive{
+		AppointmentId:  req.AppointmentId,
+		StartedAt:      parser.timestamp("started_at", req.StartedAt),
+		EndedAt:        parser.timestamp("ended_at", req.EndedAt),
+		PatientProblem: req.PatientProblem,
+		Outcome:        req.Outcome,
+		PaymentType:    req.PaymentType,
+		PaymentAmount:  parser.optional("payment_amount", req.PaymentAmount),
 	}
-
-	endTime, err := time.Parse("15:04:05", req.EndTime)
-	if err != nil {
-		return nil, err
+	if err := parser.err(); err != nil {
+		return nil, grpc.Error(ctx, err)
 	}
 
-	res, err := r.bookedArchiveUseCase.CreateArchive(ctx, &archive.CreatedArchive{
-		DoctorAvailabilityId: req.DoctorAvailabilityId,
-		StartTime:            startTime,
-		EndTime:              endTime,
-		PatientProblem:       req.PatientProblem,
-		Status:               req.Status,
-		PaymentType:          req.PaymentType,
-		PaymentAmount:        float64(req.PaymentAmount),
-	})
-
+	res, err := r.bookedArchiveUseCase.CreateArchive(ctx, create)
 	if err != nil {
-		return nil, err
+		return nil, grpc.Error(ctx, err)
 	}
 
-	return &pb.Archive{
-		Id:                   res.Id,
-		DoctorAvailabilityId: res.DoctorAvailabilityId,
-		StartTime:            res.StartTime.Format("15:04:05"),
-		EndTime:              res.EndTime.Format("15:04:05"),
-		PatientProblem:       res.PatientProblem,
-		Status:               res.Status,
-		PaymentType:          res.PaymentType,
-		PaymentAmount:        float32(res.PaymentAmount),
-		CreatedAt:            res.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:            res.UpdatedAt.Format("2006-01-02 15:04:05"),
-		DeletedAt:            res.DeletedAt.Format("2006-01-02 15:04:05"),
-	}, nil
-
+	return archiveToPb(res), nil
 }
 
 func (r *BookingArchive) GetArchive(ctx context.Context, req *pb.ArchiveFieldValueReq) (*pb.Archive, error) {
@@ -90,22 +119,10 @@ func (r *BookingArchive) GetArchive(ctx context.Context, req *pb.ArchiveFieldVal
 		DeleteStatus: req.IsActive,
 	})
 	if err != nil {
-		return nil, err
+		return nil, grpc.Error(ctx, err)
 	}
 
-	return &pb.Archive{
-		Id:                   res.Id,
-		DoctorAvailabilityId: res.DoctorAvailabilityId,
-		StartTime:            res.StartTime.Format("2006-01-02 15:04:05"),
-		EndTime:              res.EndTime.Format("2006-01-02 15:04:05"),
-		PatientProblem:       res.PatientProblem,
-		Status:               res.Status,
-		PaymentType:          res.PaymentType,
-		PaymentAmount:        float32(res.PaymentAmount),
-		CreatedAt:            res.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:            res.UpdatedAt.Format("2006-01-02 15:04:05"),
-		DeletedAt:            res.DeletedAt.Format("2006-01-02 15:04:05"),
-	}, nil
+	return archiveToPb(res), nil
 }
 
 func (r *BookingArchive) GetAllArchives(ctx context.Context, req *pb.GetAllArchivesReq) (*pb.Archives, error) {
@@ -115,32 +132,23 @@ func (r *BookingArchive) GetAllArchives(ctx context.Context, req *pb.GetAllArchi
 	var archives pb.Archives
 
 	archivesRes, err := r.bookedArchiveUseCase.GetAllArchive(ctx, &archive.GetAllArchives{
-		Page:         req.Page,
-		Limit:        req.Limit,
-		DeleteStatus: req.IsActive,
-		Field:        req.Field,
-		Value:        req.Value,
-		OrderBy:      req.OrderBy,
+		Page:          req.Page,
+		Limit:         req.Limit,
+		DeleteStatus:  req.IsActive,
+		Field:         req.Field,
+		Value:         req.Value,
+		OrderBy:       req.OrderBy,
+		PatientId:     req.PatientId,
+		DoctorId:      req.DoctorId,
+		AppointmentId: req.AppointmentId,
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, grpc.Error(ctx, err)
 	}
 
 	for _, archiveRes := range archivesRes.Archives {
-		var archiv pb.Archive
-		archiv.Id = archiveRes.Id
-		archiv.DoctorAvailabilityId = archiveRes.DoctorAvailabilityId
-		archiv.StartTime = archiveRes.StartTime.Format("2006-01-02 15:04:05")
-		archiv.EndTime = archiveRes.EndTime.Format("2006-01-02 15:04:05")
-		archiv.PatientProblem = archiveRes.PatientProblem
-		archiv.Status = archiveRes.Status
-		archiv.PaymentType = archiveRes.PaymentType
-		archiv.PaymentAmount = float32(archiveRes.PaymentAmount)
-		archiv.CreatedAt = archiveRes.CreatedAt.Format("2006-01-02 15:04:05")
-		archiv.UpdatedAt = archiveRes.UpdatedAt.Format("2006-01-02 15:04:05")
-		archiv.DeletedAt = archiveRes.DeletedAt.Format("2006-01-02 15:04:05")
-		archives.Archives = append(archives.Archives, &archiv)
+		archives.Archives = append(archives.Archives, archiveToPb(archiveRes))
 	}
 	archives.Count = archivesRes.Count
 
@@ -154,45 +162,27 @@ func (r *BookingArchive) UpdateArchive(ctx context.Context, req *pb.UpdateArchiv
 	)
 	defer span.End()
 
-	startTime, err := time.Parse("15:04:05", req.StartTime)
-	if err != nil {
-		return nil, err
+	parser := newAmountParser()
+	update := &archive.UpdateArchive{
+		Field:          req.Field,
+		Value:          req.Value,
+		StartedAt:      parser.timestamp("started_at", req.StartedAt),
+		EndedAt:        parser.timestamp("ended_at", req.EndedAt),
+		PatientProblem: req.PatientProblem,
+		Outcome:        req.Outcome,
+		PaymentType:    req.PaymentType,
+		PaymentAmount:  parser.optional("payment_amount", req.PaymentAmount),
 	}
-
-	endTime, err := time.Parse("15:04:05", req.EndTime)
-	if err != nil {
-		return nil, err
+	if err := parser.err(); err != nil {
+		return nil, grpc.Error(ctx, err)
 	}
 
-	res, err := r.bookedArchiveUseCase.UpdateArchive(ctx, &archive.UpdateArchive{
-		Field:                req.Field,
-		Value:                req.Value,
-		DoctorAvailabilityId: req.DoctorAvailabilityId,
-		StartTime:            startTime,
-		EndTime:              endTime,
-		PatientProblem:       req.PatientProblem,
-		Status:               req.Status,
-		PaymentType:          req.PaymentType,
-		PaymentAmount:        float64(req.PaymentAmount),
-	})
-
+	res, err := r.bookedArchiveUseCase.UpdateArchive(ctx, update)
 	if err != nil {
-		return nil, err
+		return nil, grpc.Error(ctx, err)
 	}
 
-	return &pb.Archive{
-		Id:                   res.Id,
-		DoctorAvailabilityId: res.DoctorAvailabilityId,
-		StartTime:            res.StartTime.Format("2006-01-02 15:04:05"),
-		EndTime:              res.EndTime.Format("2006-01-02 15:04:05"),
-		PatientProblem:       res.PatientProblem,
-		Status:               res.Status,
-		PaymentType:          res.PaymentType,
-		PaymentAmount:        float32(res.PaymentAmount),
-		CreatedAt:            res.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:            res.UpdatedAt.Format("2006-01-02 15:04:05"),
-		DeletedAt:            res.DeletedAt.Format("2006-01-02 15:04:05"),
-	}, nil
+	return archiveToPb(res), nil
 }
 
 func (r *BookingArchive) DeleteArchive(ctx context.Context, req *pb.ArchiveFieldValueReq) (*pb.DeleteArchiveStatus, error) {
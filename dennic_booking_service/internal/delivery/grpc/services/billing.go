@@ -0,0 +1,389 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	"booking_service/internal/delivery/grpc"
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/billing"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	serviceNameBilling     = "BillingService"
+	spanNameBillingService = "BillingService"
+)
+
+type BookingBilling struct {
+	logger         *zap.Logger
+	billingUseCase usecase.Billing
+}
+
+func BookingBillingNewRPC(logger *zap.Logger, billingUseCase usecase.Billing) *BookingBilling {
+	return &BookingBilling{
+		logger:         logger,
+		billingUseCase: billingUseCase,
+	}
+}
+
+// amountParser collects the amounts of a request that fail to parse.
+type amountParser struct {
+	errValidation *entity.ErrValidation
+}
+
+func newAmountParser() *amountParser {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid amount")
+	return &amountParser{errValidation: errValidation}
+}
+
+func (p *amountParser) parse(field, value string) billing.Amount {
+	amount, err := billing.ParseAmount(value)
+	if err != nil {
+		p.errValidation.Errors[field] = err.Error()
+	}
+	return amount
+}
+
+func (p *amountParser) err() error {
+	if len(p.errValidation.Errors) > 0 {
+		return p.errValidation
+	}
+	return nil
+}
+
+func (p *amountParser) item(field string, item *pb.InvoiceItem) *billing.Item {
+	if item == nil {
+		return nil
+	}
+	return &billing.Item{
+		Description:     item.Description,
+		DoctorServiceId: item.DoctorServiceId,
+		Quantity:        item.Quantity,
+		UnitPrice:       p.parse(field+".unit_price", item.UnitPrice),
+	}
+}
+
+func (r *BookingBilling) CreateInvoice(ctx context.Context, req *pb.CreateInvoiceReq) (*pb.Invoice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBillingService+"Create")
+	span.SetAttributes(
+		attribute.Key("appointment_id").Int64(req.AppointmentId),
+	)
+	defer span.End()
+
+	amounts := newAmountParser()
+	create := &billing.CreateInvoice{
+		Id:            req.Id,
+		AppointmentId: req.AppointmentId,
+		Discount:      amounts.parse("discount", req.Discount),
+		CreatedBy:     req.CreatedBy,
+	}
+	for _, item := range req.Items {
+		create.Items = append(create.Items, amounts.item("items", item))
+	}
+	if err := amounts.err(); err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res, err := r.billingUseCase.CreateInvoice(ctx, create)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return invoiceToPb(res), nil
+}
+
+func (r *BookingBilling) GetInvoice(ctx context.Context, req *pb.InvoiceFieldValueReq) (*pb.Invoice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBillingService+"Get")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.billingUseCase.GetInvoice(ctx, &billing.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return invoiceToPb(res), nil
+}
+
+func (r *BookingBilling) GetAllInvoices(ctx context.Context, req *pb.GetAllInvoicesReq) (*pb.InvoicesType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBillingService+"List")
+	span.SetAttributes(
+		attribute.Key("patient_id").String(req.PatientId),
+		attribute.Key("branch_id").String(req.BranchId),
+		attribute.Key("status").String(req.Status),
+	)
+	defer span.End()
+
+	res, err := r.billingUseCase.GetAllInvoices(ctx, &billing.GetAllInvoices{
+		Page:          req.Page,
+		Limit:         req.Limit,
+		PatientId:     req.PatientId,
+		BranchId:      req.BranchId,
+		AppointmentId: req.AppointmentId,
+		Status:        req.Status,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	var invoices pb.InvoicesType
+	for _, invoice := range res.Invoices {
+		invoices.Invoices = append(invoices.Invoices, invoiceToPb(invoice))
+	}
+	invoices.Count = res.Count
+
+	return &invoices, nil
+}
+
+func (r *BookingBilling) AddInvoiceItem(ctx context.Context, req *pb.AddInvoiceItemReq) (*pb.Invoice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBillingService+"AddItem")
+	span.SetAttributes(
+		attribute.Key("invoice_id").String(req.InvoiceId),
+	)
+	defer span.End()
+
+	amounts := newAmountParser()
+	item := amounts.item("item", req.Item)
+	if err := amounts.err(); err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res, err := r.billingUseCase.AddInvoiceItem(ctx, &billing.AddItem{
+		InvoiceId: req.InvoiceId,
+		Item:      item,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return invoiceToPb(res), nil
+}
+
+func (r *BookingBilling) SetInvoiceDiscount(ctx context.Context, req *pb.SetInvoiceDiscountReq) (*pb.Invoice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBillingService+"Discount")
+	span.SetAttributes(
+		attribute.Key("invoice_id").String(req.InvoiceId),
+	)
+	defer span.End()
+
+	amounts := newAmountParser()
+	discount := amounts.parse("discount", req.Discount)
+	if err := amounts.err(); err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res, err := r.billingUseCase.SetInvoiceDiscount(ctx, &billing.SetDiscount{
+		InvoiceId: req.InvoiceId,
+		Discount:  discount,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return invoiceToPb(res), nil
+}
+
+func (r *BookingBilling) RecordPayment(ctx context.Context, req *pb.InvoiceTransactionReq) (*pb.Invoice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBillingService+"Payment")
+	span.SetAttributes(
+		attribute.Key("invoice_id").String(req.InvoiceId),
+		attribute.Key("method").String(req.Method),
+	)
+	defer span.End()
+
+	transaction, err := transactionFromPb(req)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res, err := r.billingUseCase.RecordPayment(ctx, transaction)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return invoiceToPb(res), nil
+}
+
+func (r *BookingBilling) RefundPayment(ctx context.Context, req *pb.InvoiceTransactionReq) (*pb.Invoice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBillingService+"Refund")
+	span.SetAttributes(
+		attribute.Key("invoice_id").String(req.InvoiceId),
+		attribute.Key("method").String(req.Method),
+	)
+	defer span.End()
+
+	transaction, err := transactionFromPb(req)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res, err := r.billingUseCase.RefundPayment(ctx, transaction)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return invoiceToPb(res), nil
+}
+
+func (r *BookingBilling) VoidInvoice(ctx context.Context, req *pb.InvoiceFieldValueReq) (*pb.Invoice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBillingService+"Void")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.billingUseCase.VoidInvoice(ctx, &billing.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return invoiceToPb(res), nil
+}
+
+func (r *BookingBilling) RenderReceipt(ctx context.Context, req *pb.InvoiceFieldValueReq) (*pb.InvoiceReceipt, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBillingService+"Receipt")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.billingUseCase.RenderReceipt(ctx, &billing.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.InvoiceReceipt{
+		FileName: res.FileName,
+		Content:  res.Content,
+	}, nil
+}
+
+func (r *BookingBilling) GetCashDeskReport(ctx context.Context, req *pb.CashDeskReportReq) (*pb.CashDeskReport, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBillingService+"CashDesk")
+	span.SetAttributes(
+		attribute.Key("branch_id").String(req.BranchId),
+		attribute.Key("date").String(req.Date),
+	)
+	defer span.End()
+
+	date, err := time.ParseInLocation("2006-01-02", req.Date, time.Local)
+	if err != nil {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("invalid cash desk report")
+		errValidation.Errors["date"] = "must look like 2006-01-02"
+		return nil, grpc.Error(ctx, errValidation)
+	}
+
+	res, err := r.billingUseCase.GetCashDeskReport(ctx, &billing.CashDeskReportReq{
+		BranchId:  req.BranchId,
+		Date:      date,
+		CashierId: req.CashierId,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	report := &pb.CashDeskReport{
+		BranchId: res.BranchId,
+		Date:     res.Date.Format("2006-01-02"),
+	}
+	for _, row := range res.Rows {
+		report.Rows = append(report.Rows, &pb.CashDeskRow{
+			CashierId:    row.CashierId,
+			Method:       row.Method,
+			Currency:     row.Currency,
+			Payments:     row.Payments.String(),
+			Refunds:      row.Refunds.String(),
+			Net:          (row.Payments - row.Refunds).String(),
+			PaymentCount: row.PaymentCount,
+			RefundCount:  row.RefundCount,
+		})
+	}
+	for _, total := range res.Totals {
+		report.Totals = append(report.Totals, &pb.CashDeskTotal{
+			Currency: total.Currency,
+			Payments: total.Payments.String(),
+			Refunds:  total.Refunds.String(),
+			Net:      (total.Payments - total.Refunds).String(),
+		})
+	}
+
+	return report, nil
+}
+
+func transactionFromPb(req *pb.InvoiceTransactionReq) (*billing.Transaction, error) {
+	amounts := newAmountParser()
+	transaction := &billing.Transaction{
+		Id:        req.Id,
+		InvoiceId: req.InvoiceId,
+		Method:    req.Method,
+		Amount:    amounts.parse("amount", req.Amount),
+		CashierId: req.CashierId,
+		Reference: req.Reference,
+	}
+	return transaction, amounts.err()
+}
+
+func invoiceToPb(invoice *billing.Invoice) *pb.Invoice {
+	res := &pb.Invoice{
+		Id:            invoice.Id,
+		Number:        invoice.Number,
+		AppointmentId: invoice.AppointmentId,
+		PatientId:     invoice.PatientId,
+		BranchId:      invoice.BranchId,
+		Currency:      invoice.Currency,
+		Subtotal:      invoice.Subtotal.String(),
+		Discount:      invoice.Discount.String(),
+		Total:         invoice.Total.String(),
+		Paid:          invoice.Paid.String(),
+		Refunded:      invoice.Refunded.String(),
+		Balance:       invoice.Balance().String(),
+		Status:        invoice.Status,
+		CreatedBy:     invoice.CreatedBy,
+		IssuedAt:      invoice.IssuedAt.Format("2006-01-02 15:04:05"),
+		VoidedAt:      formatOptionalTime(invoice.VoidedAt),
+		UpdatedAt:     formatOptionalTime(invoice.UpdatedAt),
+	}
+	for _, item := range invoice.Items {
+		res.Items = append(res.Items, &pb.InvoiceItem{
+			Id:              item.Id,
+			Kind:            item.Kind,
+			Description:     item.Description,
+			DoctorServiceId: item.DoctorServiceId,
+			Quantity:        item.Quantity,
+			UnitPrice:       item.UnitPrice.String(),
+			Amount:          item.Amount.String(),
+		})
+	}
+	for _, payment := range invoice.Payments {
+		res.Payments = append(res.Payments, &pb.InvoicePayment{
+			Id:        payment.Id,
+			Kind:      payment.Kind,
+			Method:    payment.Method,
+			Amount:    payment.Amount.String(),
+			CashierId: payment.CashierId,
+			Reference: payment.Reference,
+			CreatedAt: payment.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res
+}
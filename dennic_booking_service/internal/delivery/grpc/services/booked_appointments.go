@@ -2,7 +2,8 @@ package services
 
 import (
 	pb "booking_service/genproto/booking_service"
-	_ "booking_service/internal/delivery/grpc"
+	"booking_service/internal/delivery/grpc"
+	"booking_service/internal/entity"
 	appointment "booking_service/internal/entity/booked_appointments"
 	"booking_service/internal/pkg/otlp"
 	_ "booking_service/internal/pkg/otlp"
@@ -12,6 +13,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	_ "go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
+	"strconv"
 	"time"
 )
 
@@ -22,10 +24,10 @@ const (
 
 type BookingAppointments struct {
 	logger                   *zap.Logger
-	bookedAppointmentUseCase usecase.BookedAppointments
+	bookedAppointmentUseCase usecase.OnlineAppointments
 }
 
-func BookingAppointmentsNewRPC(logger *zap.Logger, AppointmentUsaCase usecase.BookedAppointments) *BookingAppointments {
+func BookingAppointmentsNewRPC(logger *zap.Logger, AppointmentUsaCase usecase.OnlineAppointments) *BookingAppointments {
 
 	return &BookingAppointments{
 		logger:                   logger,
@@ -55,7 +57,9 @@ func (r *BookingAppointments) CreateAppointment(ctx context.Context, req *pb.Cre
 
 	res, err := r.bookedAppointmentUseCase.CreateAppointment(ctx, &appointment.CreateAppointment{
 		DepartmentId:    req.DepartmentId,
+		BranchId:        req.BranchId,
 		DoctorId:        req.DoctorId,
+		ResourceId:      req.ResourceId,
 		PatientId:       req.PatientId,
 		AppointmentDate: Date,
 		AppointmentTime: Time,
@@ -63,16 +67,20 @@ func (r *BookingAppointments) CreateAppointment(ctx context.Context, req *pb.Cre
 		Key:             req.Key,
 		ExpiresAt:       expTime,
 		PatientStatus:   req.PatientStatus,
+		Modality:        req.Modality,
+		DoctorServiceId: req.DoctorServiceId,
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, grpc.Error(ctx, err)
 	}
 
 	return &pb.Appointment{
 		Id:              res.Id,
 		DepartmentId:    res.DepartmentId,
+		BranchId:        res.BranchId,
 		DoctorId:        res.DoctorId,
+		ResourceId:      res.ResourceId,
 		PatientId:       res.PatientId,
 		AppointmentDate: res.AppointmentDate.String(),
 		AppointmentTime: res.AppointmentTime.Format("15:04:05"),
@@ -80,6 +88,13 @@ func (r *BookingAppointments) CreateAppointment(ctx context.Context, req *pb.Cre
 		Key:             res.Key,
 		ExpiresAt:       res.ExpiresAt.Format("2006-01-02 15:04:05"),
 		PatientStatus:   res.PatientStatus,
+		Modality:        res.Modality,
+		DoctorServiceId: res.DoctorServiceId,
+		Price:           res.Price,
+		Currency:        res.Currency,
+		VideoRoomId:     res.VideoRoomId,
+		VideoProvider:   res.VideoProvider,
+		Status:          res.Status,
 		CreatedAt:       res.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:       res.UpdatedAt.Format("2006-01-02 15:04:05"),
 		DeletedAt:       res.DeletedAt.Format("2006-01-02 15:04:05"),
@@ -106,7 +121,9 @@ func (r *BookingAppointments) GetAppointment(ctx context.Context, req *pb.Appoin
 	return &pb.Appointment{
 		Id:              res.Id,
 		DepartmentId:    res.DepartmentId,
+		BranchId:        res.BranchId,
 		DoctorId:        res.DoctorId,
+		ResourceId:      res.ResourceId,
 		PatientId:       res.PatientId,
 		AppointmentDate: res.AppointmentDate.String(),
 		AppointmentTime: res.AppointmentTime.Format("15:04:05"),
@@ -114,6 +131,13 @@ func (r *BookingAppointments) GetAppointment(ctx context.Context, req *pb.Appoin
 		Key:             res.Key,
 		ExpiresAt:       res.ExpiresAt.Format("2006-01-02 15:04:05"),
 		PatientStatus:   res.PatientStatus,
+		Modality:        res.Modality,
+		DoctorServiceId: res.DoctorServiceId,
+		Price:           res.Price,
+		Currency:        res.Currency,
+		VideoRoomId:     res.VideoRoomId,
+		VideoProvider:   res.VideoProvider,
+		Status:          res.Status,
 		CreatedAt:       res.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:       res.UpdatedAt.Format("2006-01-02 15:04:05"),
 		DeletedAt:       res.DeletedAt.Format("2006-01-02 15:04:05"),
@@ -133,6 +157,9 @@ func (r *BookingAppointments) GetAllAppointment(ctx context.Context, req *pb.Get
 		Field:        req.Field,
 		Value:        req.Value,
 		OrderBy:      req.OrderBy,
+		BranchId:     req.BranchId,
+		DoctorId:     req.DoctorId,
+		PatientId:    req.PatientId,
 	})
 	if err != nil {
 		return nil, err
@@ -142,6 +169,8 @@ func (r *BookingAppointments) GetAllAppointment(ctx context.Context, req *pb.Get
 		var appointmentRes pb.Appointment
 		appointmentRes.Id = appoint.Id
 		appointmentRes.DepartmentId = appoint.DepartmentId
+		appointmentRes.BranchId = appoint.BranchId
+		appointmentRes.ResourceId = appoint.ResourceId
 		appointmentRes.DoctorId = appoint.DoctorId
 		appointmentRes.PatientId = appoint.PatientId
 		appointmentRes.AppointmentDate = appoint.AppointmentDate.String()
@@ -149,6 +178,14 @@ func (r *BookingAppointments) GetAllAppointment(ctx context.Context, req *pb.Get
 		appointmentRes.Duration = appoint.Duration
 		appointmentRes.Key = appoint.Key
 		appointmentRes.ExpiresAt = appoint.ExpiresAt.Format("2006-01-02 15:04:05")
+		appointmentRes.PatientStatus = appoint.PatientStatus
+		appointmentRes.Modality = appoint.Modality
+		appointmentRes.DoctorServiceId = appoint.DoctorServiceId
+		appointmentRes.Price = appoint.Price
+		appointmentRes.Currency = appoint.Currency
+		appointmentRes.VideoRoomId = appoint.VideoRoomId
+		appointmentRes.VideoProvider = appoint.VideoProvider
+		appointmentRes.Status = appoint.Status
 		appointmentRes.CreatedAt = appoint.CreatedAt.Format("2006-01-02 15:04:05")
 		appointmentRes.UpdatedAt = appoint.UpdatedAt.Format("2006-01-02 15:04:05")
 		appointmentRes.DeletedAt = appoint.DeletedAt.Format("2006-01-02 15:04:05")
@@ -192,13 +229,15 @@ func (r *BookingAppointments) UpdateAppointment(ctx context.Context, req *pb.Upd
 		PatientStatus:   req.PatientStatus,
 	})
 	if err != nil {
-		return nil, err
+		return nil, grpc.Error(ctx, err)
 	}
 
 	return &pb.Appointment{
 		Id:              res.Id,
 		DepartmentId:    res.DepartmentId,
+		BranchId:        res.BranchId,
 		DoctorId:        res.DoctorId,
+		ResourceId:      res.ResourceId,
 		PatientId:       res.PatientId,
 		AppointmentDate: res.AppointmentDate.String(),
 		AppointmentTime: res.AppointmentTime.Format("15:04:05"),
@@ -206,6 +245,13 @@ func (r *BookingAppointments) UpdateAppointment(ctx context.Context, req *pb.Upd
 		Key:             res.Key,
 		ExpiresAt:       res.ExpiresAt.Format("2006-01-02 15:04:05"),
 		PatientStatus:   res.PatientStatus,
+		Modality:        res.Modality,
+		DoctorServiceId: res.DoctorServiceId,
+		Price:           res.Price,
+		Currency:        res.Currency,
+		VideoRoomId:     res.VideoRoomId,
+		VideoProvider:   res.VideoProvider,
+		Status:          res.Status,
 		CreatedAt:       res.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:       res.UpdatedAt.Format("2006-01-02 15:04:05"),
 		DeletedAt:       res.DeletedAt.Format("2006-01-02 15:04:05"),
@@ -231,3 +277,117 @@ func (r *BookingAppointments) DeleteAppointment(ctx context.Context, req *pb.App
 
 	return &pb.DeleteAppointmentStatus{Status: res.Status}, err
 }
+
+func (r *BookingAppointments) ConfirmAppointment(ctx context.Context, req *pb.AppointmentFieldValueReq) (*pb.Appointment, error) {
+	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointmentsService+"Confirm")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	if req.Field != "id" {
+		return nil, grpc.Error(ctx, entity.NewErrNoRequiredParameter("id"))
+	}
+	id, err := strconv.ParseInt(req.Value, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.bookedAppointmentUseCase.ConfirmAppointment(ctx, &appointment.ConfirmAppointment{
+		Id: id,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.Appointment{
+		Id:              res.Id,
+		DepartmentId:    res.DepartmentId,
+		BranchId:        res.BranchId,
+		DoctorId:        res.DoctorId,
+		ResourceId:      res.ResourceId,
+		PatientId:       res.PatientId,
+		AppointmentDate: res.AppointmentDate.String(),
+		AppointmentTime: res.AppointmentTime.Format("15:04:05"),
+		Duration:        res.Duration,
+		Key:             res.Key,
+		ExpiresAt:       res.ExpiresAt.Format("2006-01-02 15:04:05"),
+		PatientStatus:   res.PatientStatus,
+		Modality:        res.Modality,
+		DoctorServiceId: res.DoctorServiceId,
+		Price:           res.Price,
+		Currency:        res.Currency,
+		VideoRoomId:     res.VideoRoomId,
+		VideoProvider:   res.VideoProvider,
+		Status:          res.Status,
+		CreatedAt:       res.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       res.UpdatedAt.Format("2006-01-02 15:04:05"),
+		DeletedAt:       res.DeletedAt.Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+func (r *BookingAppointments) SetAppointmentStatus(ctx context.Context, req *pb.AppointmentStatusReq) (*pb.Appointment, error) {
+	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointmentsService+"SetStatus")
+	span.SetAttributes(
+		attribute.Key("appointment_id").Int64(req.AppointmentId),
+		attribute.Key("status").String(req.Status),
+	)
+	defer span.End()
+
+	res, err := r.bookedAppointmentUseCase.SetAppointmentStatus(ctx, &appointment.AppointmentStatusReq{
+		Id:     req.AppointmentId,
+		Status: req.Status,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.Appointment{
+		Id:              res.Id,
+		DepartmentId:    res.DepartmentId,
+		BranchId:        res.BranchId,
+		DoctorId:        res.DoctorId,
+		ResourceId:      res.ResourceId,
+		PatientId:       res.PatientId,
+		AppointmentDate: res.AppointmentDate.String(),
+		AppointmentTime: res.AppointmentTime.Format("15:04:05"),
+		Duration:        res.Duration,
+		Key:             res.Key,
+		ExpiresAt:       res.ExpiresAt.Format("2006-01-02 15:04:05"),
+		PatientStatus:   res.PatientStatus,
+		Modality:        res.Modality,
+		DoctorServiceId: res.DoctorServiceId,
+		Price:           res.Price,
+		Currency:        res.Currency,
+		VideoRoomId:     res.VideoRoomId,
+		VideoProvider:   res.VideoProvider,
+		Status:          res.Status,
+		CreatedAt:       res.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       res.UpdatedAt.Format("2006-01-02 15:04:05"),
+		DeletedAt:       res.DeletedAt.Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+func (r *BookingAppointments) GetJoinLink(ctx context.Context, req *pb.JoinLinkReq) (*pb.JoinLink, error) {
+	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointmentsService+"JoinLink")
+	span.SetAttributes(
+		attribute.Key("appointment_id").Int64(req.AppointmentId),
+	)
+	defer span.End()
+
+	res, err := r.bookedAppointmentUseCase.GetJoinLink(ctx, &appointment.JoinLinkReq{
+		AppointmentId: req.AppointmentId,
+		ParticipantId: req.ParticipantId,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.JoinLink{
+		Url:       res.Url,
+		RoomId:    res.RoomId,
+		Provider:  res.Provider,
+		NotBefore: res.NotBefore.Format("2006-01-02 15:04:05"),
+		ExpiresAt: res.ExpiresAt.Format("2006-01-02 15:04:05"),
+	}, nil
+}
@@ -52,6 +52,7 @@ func (r *BookingDoctorAvailability) CreateDoctorTime(ctx context.Context, req *p
 
 	res, err := r.bookedDoctorAvailabilityUseCase.CreateDoctorAvailability(ctx, &doctor_availability.CreateDoctorAvailability{
 		DepartmentId: req.DepartmentId,
+		BranchId:     req.BranchId,
 		DoctorId:     req.DoctorId,
 		DoctorDate:   reqDate,
 		StartTime:    reqStartTime,
@@ -66,6 +67,7 @@ func (r *BookingDoctorAvailability) CreateDoctorTime(ctx context.Context, req *p
 	return &pb.DoctorTime{
 		Id:           res.Id,
 		DepartmentId: res.DepartmentId,
+		BranchId:     res.BranchId,
 		DoctorId:     res.DoctorId,
 		DoctorDate:   res.DoctorDate.String(),
 		StartTime:    res.StartTime.Format("15:04:05"),
@@ -97,6 +99,7 @@ func (r *BookingDoctorAvailability) GetDoctorTime(ctx context.Context, req *pb.D
 	return &pb.DoctorTime{
 		Id:           res.Id,
 		DepartmentId: res.DepartmentId,
+		BranchId:     res.BranchId,
 		DoctorId:     res.DoctorId,
 		DoctorDate:   res.DoctorDate.String(),
 		StartTime:    res.StartTime.Format("15:04:05"),
@@ -121,6 +124,8 @@ func (r *BookingDoctorAvailability) GetAllDoctorTimes(ctx context.Context, req *
 		Field:        req.Field,
 		Value:        req.Value,
 		OrderBy:      req.OrderBy,
+		BranchId:     req.BranchId,
+		DoctorDate:   req.DoctorDate,
 	})
 
 	if err != nil {
@@ -131,6 +136,7 @@ func (r *BookingDoctorAvailability) GetAllDoctorTimes(ctx context.Context, req *
 		var docAvail pb.DoctorTime
 		docAvail.Id = availability.Id
 		docAvail.DepartmentId = availability.DepartmentId
+		docAvail.BranchId = availability.BranchId
 		docAvail.DoctorId = availability.DoctorId
 		docAvail.DoctorDate = availability.DoctorDate.String()
 		docAvail.StartTime = availability.StartTime.Format("15:04:05")
@@ -171,6 +177,7 @@ func (r *BookingDoctorAvailability) UpdateDoctorTime(ctx context.Context, req *p
 		Field:        req.Field,
 		Value:        req.Value,
 		DepartmentId: req.DepartmentId,
+		BranchId:     req.BranchId,
 		DoctorId:     req.DoctorId,
 		DoctorDate:   reqDate,
 		StartTime:    reqStartTime,
@@ -185,6 +192,7 @@ func (r *BookingDoctorAvailability) UpdateDoctorTime(ctx context.Context, req *p
 	return &pb.DoctorTime{
 		Id:           res.Id,
 		DepartmentId: res.DepartmentId,
+		BranchId:     res.BranchId,
 		DoctorId:     res.DoctorId,
 		DoctorDate:   res.DoctorDate.String(),
 		StartTime:    res.StartTime.Format("15:04:05"),
@@ -0,0 +1,139 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	"booking_service/internal/delivery/grpc"
+	"booking_service/internal/entity/documents"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	serviceNameDocuments     = "DocumentsService"
+	spanNameDocumentsService = "DocumentsService"
+)
+
+type BookingDocuments struct {
+	logger           *zap.Logger
+	documentsUseCase usecase.Documents
+}
+
+func BookingDocumentsNewRPC(logger *zap.Logger, documentsUseCase usecase.Documents) *BookingDocuments {
+	return &BookingDocuments{
+		logger:           logger,
+		documentsUseCase: documentsUseCase,
+	}
+}
+
+func (r *BookingDocuments) CreateDocument(ctx context.Context, req *pb.Document) (*pb.Document, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDocuments, spanNameDocumentsService+"Create")
+	span.SetAttributes(
+		attribute.Key("patient_id").String(req.PatientId),
+		attribute.Key("type").String(req.Type),
+	)
+	defer span.End()
+
+	res, err := r.documentsUseCase.CreateDocument(ctx, &documents.Document{
+		Id:            req.Id,
+		PatientId:     req.PatientId,
+		AppointmentId: req.AppointmentId,
+		Type:          req.Type,
+		Title:         req.Title,
+		FileName:      req.FileName,
+		ContentType:   req.ContentType,
+		SizeBytes:     req.SizeBytes,
+		Bucket:        req.Bucket,
+		ObjectKey:     req.ObjectKey,
+		UploadedBy:    req.UploadedBy,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return documentToPb(res), nil
+}
+
+func (r *BookingDocuments) GetDocument(ctx context.Context, req *pb.DocumentFieldValueReq) (*pb.Document, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDocuments, spanNameDocumentsService+"Get")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.documentsUseCase.GetDocument(ctx, &documents.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return documentToPb(res), nil
+}
+
+func (r *BookingDocuments) GetAllDocuments(ctx context.Context, req *pb.GetAllDocumentsReq) (*pb.DocumentsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDocuments, spanNameDocumentsService+"List")
+	span.SetAttributes(
+		attribute.Key("patient_id").String(req.PatientId),
+		attribute.Key("appointment_id").Int64(req.AppointmentId),
+	)
+	defer span.End()
+
+	res, err := r.documentsUseCase.GetAllDocuments(ctx, &documents.GetAllDocuments{
+		Page:          req.Page,
+		Limit:         req.Limit,
+		PatientId:     req.PatientId,
+		AppointmentId: req.AppointmentId,
+		Type:          req.Type,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	var list pb.DocumentsType
+	for _, document := range res.Documents {
+		list.Documents = append(list.Documents, documentToPb(document))
+	}
+	list.Count = res.Count
+
+	return &list, nil
+}
+
+func (r *BookingDocuments) DeleteDocument(ctx context.Context, req *pb.DocumentFieldValueReq) (*pb.Document, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDocuments, spanNameDocumentsService+"Delete")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.documentsUseCase.DeleteDocument(ctx, &documents.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return documentToPb(res), nil
+}
+
+func documentToPb(document *documents.Document) *pb.Document {
+	return &pb.Document{
+		Id:            document.Id,
+		PatientId:     document.PatientId,
+		AppointmentId: document.AppointmentId,
+		Type:          document.Type,
+		Title:         document.Title,
+		FileName:      document.FileName,
+		ContentType:   document.ContentType,
+		SizeBytes:     document.SizeBytes,
+		Bucket:        document.Bucket,
+		ObjectKey:     document.ObjectKey,
+		UploadedBy:    document.UploadedBy,
+		CreatedAt:     document.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
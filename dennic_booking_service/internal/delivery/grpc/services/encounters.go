@@ -0,0 +1,282 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	"booking_service/internal/delivery/grpc"
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/encounters"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	serviceNameEncounters     = "EncountersService"
+	spanNameEncountersService = "EncountersService"
+)
+
+type BookingEncounters struct {
+	logger            *zap.Logger
+	encountersUseCase usecase.Encounters
+}
+
+func BookingEncountersNewRPC(logger *zap.Logger, encountersUseCase usecase.Encounters) *BookingEncounters {
+	return &BookingEncounters{
+		logger:            logger,
+		encountersUseCase: encountersUseCase,
+	}
+}
+
+func (r *BookingEncounters) CreateEncounter(ctx context.Context, req *pb.Encounter) (*pb.Encounter, error) {
+	ctx, span := otlp.Start(ctx, serviceNameEncounters, spanNameEncountersService+"Create")
+	span.SetAttributes(
+		attribute.Key("appointment_id").Int64(req.AppointmentId),
+	)
+	defer span.End()
+
+	encounter, err := encounterFromPb(req)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res, err := r.encountersUseCase.CreateEncounter(ctx, encounter)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return encounterToPb(res), nil
+}
+
+func (r *BookingEncounters) GetEncounter(ctx context.Context, req *pb.EncounterFieldValueReq) (*pb.Encounter, error) {
+	ctx, span := otlp.Start(ctx, serviceNameEncounters, spanNameEncountersService+"Get")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.encountersUseCase.GetEncounter(ctx, &encounters.FieldValueReq{
+		Field:        req.Field,
+		Value:        req.Value,
+		DeleteStatus: req.IsActive,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return encounterToPb(res), nil
+}
+
+func (r *BookingEncounters) UpdateEncounter(ctx context.Context, req *pb.Encounter) (*pb.Encounter, error) {
+	ctx, span := otlp.Start(ctx, serviceNameEncounters, spanNameEncountersService+"Update")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	encounter, err := encounterFromPb(req)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res, err := r.encountersUseCase.UpdateEncounter(ctx, encounter)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return encounterToPb(res), nil
+}
+
+func (r *BookingEncounters) DeleteEncounter(ctx context.Context, req *pb.EncounterFieldValueReq) (*pb.EncounterStatus, error) {
+	ctx, span := otlp.Start(ctx, serviceNameEncounters, spanNameEncountersService+"Delete")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.encountersUseCase.DeleteEncounter(ctx, &encounters.FieldValueReq{
+		Field:        req.Field,
+		Value:        req.Value,
+		DeleteStatus: req.IsActive,
+	})
+	if err != nil {
+		return &pb.EncounterStatus{Status: false}, grpc.Error(ctx, err)
+	}
+
+	return &pb.EncounterStatus{Status: res.Status}, nil
+}
+
+func (r *BookingEncounters) GetPatientTimeline(ctx context.Context, req *pb.PatientTimelineReq) (*pb.PatientTimeline, error) {
+	ctx, span := otlp.Start(ctx, serviceNameEncounters, spanNameEncountersService+"Timeline")
+	span.SetAttributes(
+		attribute.Key("patient_id").String(req.PatientId),
+	)
+	defer span.End()
+
+	from, err := parseEncounterDate("from", req.From)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+	to, err := parseEncounterDate("to", req.To)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res, err := r.encountersUseCase.GetPatientTimeline(ctx, &encounters.TimelineReq{
+		PatientId: req.PatientId,
+		Page:      req.Page,
+		Limit:     req.Limit,
+		From:      from,
+		To:        to,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	var timeline pb.PatientTimeline
+	for _, encounter := range res.Encounters {
+		timeline.Encounters = append(timeline.Encounters, encounterToPb(encounter))
+	}
+	timeline.Count = res.Count
+
+	return &timeline, nil
+}
+
+// parseEncounterDate reads an optional YYYY-MM-DD value.
+func parseEncounterDate(field, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	day, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = err
+		errValidation.Errors[field] = "must be a date in the YYYY-MM-DD format"
+		return time.Time{}, errValidation
+	}
+	return day, nil
+}
+
+func encounterFromPb(req *pb.Encounter) (*encounters.Encounter, error) {
+	followUp, err := parseEncounterDate("follow_up_date", req.FollowUpDate)
+	if err != nil {
+		return nil, err
+	}
+
+	encounter := &encounters.Encounter{
+		Id:             req.Id,
+		AppointmentId:  req.AppointmentId,
+		ChiefComplaint: req.ChiefComplaint,
+		FollowUpPlan:   req.FollowUpPlan,
+		FollowUpDate:   followUp,
+	}
+	if req.Vitals != nil {
+		encounter.Vitals = encounters.Vitals{
+			TemperatureC:     req.Vitals.TemperatureC,
+			PulseBpm:         req.Vitals.PulseBpm,
+			SystolicMmHg:     req.Vitals.SystolicMmhg,
+			DiastolicMmHg:    req.Vitals.DiastolicMmhg,
+			RespiratoryRate:  req.Vitals.RespiratoryRate,
+			OxygenSaturation: req.Vitals.OxygenSaturation,
+			WeightKg:         req.Vitals.WeightKg,
+			HeightCm:         req.Vitals.HeightCm,
+		}
+	}
+	for _, diagnosis := range req.Diagnoses {
+		encounter.Diagnoses = append(encounter.Diagnoses, &encounters.Diagnosis{
+			Icd10Code:   diagnosis.Icd10Code,
+			Description: diagnosis.Description,
+			Primary:     diagnosis.Primary,
+		})
+	}
+	for _, allergy := range req.Allergies {
+		encounter.Allergies = append(encounter.Allergies, &encounters.Allergy{
+			Substance: allergy.Substance,
+			Reaction:  allergy.Reaction,
+			Severity:  allergy.Severity,
+		})
+	}
+	for _, condition := range req.ChronicConditions {
+		onset, err := parseEncounterDate("chronic_conditions.onset_date", condition.OnsetDate)
+		if err != nil {
+			return nil, err
+		}
+		encounter.ChronicConditions = append(encounter.ChronicConditions, &encounters.ChronicCondition{
+			Icd10Code:   condition.Icd10Code,
+			Description: condition.Description,
+			OnsetDate:   onset,
+		})
+	}
+	for _, procedure := range req.Procedures {
+		encounter.Procedures = append(encounter.Procedures, &encounters.Procedure{
+			Code:        procedure.Code,
+			Description: procedure.Description,
+		})
+	}
+
+	return encounter, nil
+}
+
+func encounterToPb(encounter *encounters.Encounter) *pb.Encounter {
+	res := &pb.Encounter{
+		Id:             encounter.Id,
+		AppointmentId:  encounter.AppointmentId,
+		PatientId:      encounter.PatientId,
+		DoctorId:       encounter.DoctorId,
+		EncounteredAt:  encounter.EncounteredAt.Format("2006-01-02 15:04:05"),
+		ChiefComplaint: encounter.ChiefComplaint,
+		Vitals: &pb.Vitals{
+			TemperatureC:     encounter.Vitals.TemperatureC,
+			PulseBpm:         encounter.Vitals.PulseBpm,
+			SystolicMmhg:     encounter.Vitals.SystolicMmHg,
+			DiastolicMmhg:    encounter.Vitals.DiastolicMmHg,
+			RespiratoryRate:  encounter.Vitals.RespiratoryRate,
+			OxygenSaturation: encounter.Vitals.OxygenSaturation,
+			WeightKg:         encounter.Vitals.WeightKg,
+			HeightCm:         encounter.Vitals.HeightCm,
+		},
+		FollowUpPlan: encounter.FollowUpPlan,
+		CreatedAt:    encounter.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:    encounter.UpdatedAt.Format("2006-01-02 15:04:05"),
+		DeletedAt:    encounter.DeletedAt.Format("2006-01-02 15:04:05"),
+	}
+	if !encounter.FollowUpDate.IsZero() {
+		res.FollowUpDate = encounter.FollowUpDate.Format("2006-01-02")
+	}
+	for _, diagnosis := range encounter.Diagnoses {
+		res.Diagnoses = append(res.Diagnoses, &pb.Diagnosis{
+			Icd10Code:   diagnosis.Icd10Code,
+			Description: diagnosis.Description,
+			Primary:     diagnosis.Primary,
+		})
+	}
+	for _, allergy := range encounter.Allergies {
+		res.Allergies = append(res.Allergies, &pb.Allergy{
+			Substance: allergy.Substance,
+			Reaction:  allergy.Reaction,
+			Severity:  allergy.Severity,
+		})
+	}
+	for _, condition := range encounter.ChronicConditions {
+		var onset string
+		if !condition.OnsetDate.IsZero() {
+			onset = condition.OnsetDate.Format("2006-01-02")
+		}
+		res.ChronicConditions = append(res.ChronicConditions, &pb.ChronicCondition{
+			Icd10Code:   condition.Icd10Code,
+			Description: condition.Description,
+			OnsetDate:   onset,
+		})
+	}
+	for _, procedure := range encounter.Procedures {
+		res.Procedures = append(res.Procedures, &pb.Procedure{
+			Code:        procedure.Code,
+			Description: procedure.Description,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	"booking_service/internal/delivery/grpc"
+	"booking_service/internal/entity/history"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	serviceNameHistory     = "HistoryService"
+	spanNameHistoryService = "HistoryService"
+)
+
+type BookingHistory struct {
+	logger         *zap.Logger
+	historyUseCase usecase.History
+}
+
+func BookingHistoryNewRPC(logger *zap.Logger, historyUseCase usecase.History) *BookingHistory {
+	return &BookingHistory{
+		logger:         logger,
+		historyUseCase: historyUseCase,
+	}
+}
+
+func (r *BookingHistory) ExportPatientHistory(ctx context.Context, req *pb.ExportHistoryReq) (*pb.HistoryFile, error) {
+	ctx, span := otlp.Start(ctx, serviceNameHistory, spanNameHistoryService+"Export")
+	span.SetAttributes(
+		attribute.Key("patient_id").String(req.PatientId),
+		attribute.Key("format").String(req.Format),
+	)
+	defer span.End()
+
+	res, err := r.historyUseCase.ExportHistory(ctx, &history.ExportReq{
+		PatientId: req.PatientId,
+		Format:    req.Format,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.HistoryFile{
+		FileName:    res.FileName,
+		ContentType: res.ContentType,
+		Content:     res.Content,
+	}, nil
+}
+
+func (r *BookingHistory) ImportPatientHistory(ctx context.Context, req *pb.ImportHistoryReq) (*pb.ImportHistoryRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameHistory, spanNameHistoryService+"Import")
+	span.SetAttributes(
+		attribute.Key("format").String(req.Format),
+		attribute.Key("source").String(req.Source),
+	)
+	defer span.End()
+
+	res, err := r.historyUseCase.ImportHistory(ctx, &history.ImportReq{
+		Format:     req.Format,
+		Content:    req.Content,
+		Source:     req.Source,
+		ImportedBy: req.ImportedBy,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	patient := res.Patient
+	return &pb.ImportHistoryRes{
+		Patient: &pb.Patient{
+			Id:             patient.Id,
+			FirstName:      patient.FirstName,
+			LastName:       patient.LastName,
+			BirthDate:      patient.BirthDate.String(),
+			Gender:         patient.Gender,
+			BloodGroup:     patient.BloodGroup,
+			PhoneNumber:    patient.PhoneNumber,
+			City:           patient.City,
+			Country:        patient.Country,
+			Address:        patient.Address,
+			PatientProblem: patient.PatientProblem,
+			CreatedAt:      patient.CreatedAt.Format("2006-01-02 15:04:05"),
+			UpdatedAt:      patient.UpdatedAt.Format("2006-01-02 15:04:05"),
+			DeletedAt:      patient.DeletedAt.Format("2006-01-02 15:04:05"),
+			UserId:         patient.UserId,
+			Relationship:   patient.Relationship,
+			Consent:        patient.Consent,
+			ConsentAt:      formatConsentAt(patient.ConsentAt),
+		},
+		Imported: res.Imported,
+		Skipped:  res.Skipped,
+	}, nil
+}
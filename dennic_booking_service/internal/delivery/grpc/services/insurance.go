@@ -0,0 +1,587 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	"booking_service/internal/delivery/grpc"
+	"booking_service/internal/entity/insurance"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	serviceNameInsurance     = "InsuranceService"
+	spanNameInsuranceService = "InsuranceService"
+	insuranceDateLayout      = "2006-01-02"
+)
+
+type BookingInsurance struct {
+	logger           *zap.Logger
+	insuranceUseCase usecase.Insurance
+}
+
+func BookingInsuranceNewRPC(logger *zap.Logger, insuranceUseCase usecase.Insurance) *BookingInsurance {
+	return &BookingInsurance{
+		logger:           logger,
+		insuranceUseCase: insuranceUseCase,
+	}
+}
+
+// day parses a date of the request; an empty optional one is left zero.
+func (p *amountParser) day(field, value string, required bool) time.Time {
+	if value == "" && !required {
+		return time.Time{}
+	}
+	day, err := time.ParseInLocation(insuranceDateLayout, value, time.UTC)
+	if err != nil {
+		p.errValidation.Errors[field] = "must be a date like 2006-01-02"
+	}
+	return day
+}
+
+func (r *BookingInsurance) CreateInsurer(ctx context.Context, req *pb.Insurer) (*pb.Insurer, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"CreateInsurer")
+	span.SetAttributes(
+		attribute.Key("code").String(req.Code),
+	)
+	defer span.End()
+
+	res, err := r.insuranceUseCase.CreateInsurer(ctx, insurerFromPb(req))
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return insurerToPb(res), nil
+}
+
+func (r *BookingInsurance) GetInsurer(ctx context.Context, req *pb.InsuranceFieldValueReq) (*pb.Insurer, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"GetInsurer")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.insuranceUseCase.GetInsurer(ctx, &insurance.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return insurerToPb(res), nil
+}
+
+func (r *BookingInsurance) GetAllInsurers(ctx context.Context, req *pb.GetAllInsuranceReq) (*pb.InsurersType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"ListInsurers")
+	span.SetAttributes(
+		attribute.Key("value").String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.insuranceUseCase.GetAllInsurers(ctx, getAllInsuranceFromPb(req))
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	var insurers []*pb.Insurer
+	for _, insurer := range res.Insurers {
+		insurers = append(insurers, insurerToPb(insurer))
+	}
+
+	return &pb.InsurersType{
+		Count:    res.Count,
+		Insurers: insurers,
+	}, nil
+}
+
+func (r *BookingInsurance) UpdateInsurer(ctx context.Context, req *pb.Insurer) (*pb.Insurer, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"UpdateInsurer")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	res, err := r.insuranceUseCase.UpdateInsurer(ctx, insurerFromPb(req))
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return insurerToPb(res), nil
+}
+
+func (r *BookingInsurance) DeleteInsurer(ctx context.Context, req *pb.InsuranceFieldValueReq) (*pb.InsuranceStatusRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"DeleteInsurer")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.insuranceUseCase.DeleteInsurer(ctx, &insurance.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.InsuranceStatusRes{Status: res.Status}, nil
+}
+
+func (r *BookingInsurance) CreatePolicy(ctx context.Context, req *pb.InsurancePolicy) (*pb.InsurancePolicy, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"CreatePolicy")
+	span.SetAttributes(
+		attribute.Key("insurer_id").String(req.InsurerId),
+		attribute.Key("patient_id").String(req.PatientId),
+	)
+	defer span.End()
+
+	parser := newAmountParser()
+	policy := &insurance.Policy{
+		Id:           req.Id,
+		InsurerId:    req.InsurerId,
+		PatientId:    req.PatientId,
+		PolicyNumber: req.PolicyNumber,
+		ValidFrom:    parser.day("valid_from", req.ValidFrom, true),
+		ValidTo:      parser.day("valid_to", req.ValidTo, true),
+		Currency:     req.Currency,
+	}
+	if req.AnnualLimit != "" {
+		policy.AnnualLimit = parser.parse("annual_limit", req.AnnualLimit)
+	}
+	if err := parser.err(); err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res, err := r.insuranceUseCase.CreatePolicy(ctx, policy)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return policyToPb(res), nil
+}
+
+func (r *BookingInsurance) GetPolicy(ctx context.Context, req *pb.InsuranceFieldValueReq) (*pb.InsurancePolicy, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"GetPolicy")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.insuranceUseCase.GetPolicy(ctx, &insurance.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return policyToPb(res), nil
+}
+
+func (r *BookingInsurance) GetAllPolicies(ctx context.Context, req *pb.GetAllInsuranceReq) (*pb.InsurancePoliciesType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"ListPolicies")
+	span.SetAttributes(
+		attribute.Key("insurer_id").String(req.InsurerId),
+		attribute.Key("patient_id").String(req.PatientId),
+	)
+	defer span.End()
+
+	res, err := r.insuranceUseCase.GetAllPolicies(ctx, getAllInsuranceFromPb(req))
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	var policies []*pb.InsurancePolicy
+	for _, policy := range res.Policies {
+		policies = append(policies, policyToPb(policy))
+	}
+
+	return &pb.InsurancePoliciesType{
+		Count:    res.Count,
+		Policies: policies,
+	}, nil
+}
+
+func (r *BookingInsurance) CancelPolicy(ctx context.Context, req *pb.InsuranceFieldValueReq) (*pb.InsurancePolicy, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"CancelPolicy")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.insuranceUseCase.CancelPolicy(ctx, &insurance.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return policyToPb(res), nil
+}
+
+func (r *BookingInsurance) SetCoverageRule(ctx context.Context, req *pb.CoverageRule) (*pb.CoverageRule, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"SetRule")
+	span.SetAttributes(
+		attribute.Key("insurer_id").String(req.InsurerId),
+		attribute.Key("doctor_service_id").String(req.DoctorServiceId),
+	)
+	defer span.End()
+
+	parser := newAmountParser()
+	rule := &insurance.CoverageRule{
+		Id:              req.Id,
+		InsurerId:       req.InsurerId,
+		DoctorServiceId: req.DoctorServiceId,
+		Kind:            req.Kind,
+		Percentage:      req.Percentage,
+	}
+	if req.Copay != "" {
+		rule.Copay = parser.parse("copay", req.Copay)
+	}
+	if req.AnnualLimit != "" {
+		rule.AnnualLimit = parser.parse("annual_limit", req.AnnualLimit)
+	}
+	if err := parser.err(); err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res, err := r.insuranceUseCase.SetCoverageRule(ctx, rule)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return coverageRuleToPb(res), nil
+}
+
+func (r *BookingInsurance) GetAllCoverageRules(ctx context.Context, req *pb.GetAllInsuranceReq) (*pb.CoverageRulesType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"ListRules")
+	span.SetAttributes(
+		attribute.Key("insurer_id").String(req.InsurerId),
+	)
+	defer span.End()
+
+	res, err := r.insuranceUseCase.GetAllCoverageRules(ctx, getAllInsuranceFromPb(req))
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	var rules []*pb.CoverageRule
+	for _, rule := range res.Rules {
+		rules = append(rules, coverageRuleToPb(rule))
+	}
+
+	return &pb.CoverageRulesType{
+		Count: res.Count,
+		Rules: rules,
+	}, nil
+}
+
+func (r *BookingInsurance) DeleteCoverageRule(ctx context.Context, req *pb.InsuranceFieldValueReq) (*pb.InsuranceStatusRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"DeleteRule")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.insuranceUseCase.DeleteCoverageRule(ctx, &insurance.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.InsuranceStatusRes{Status: res.Status}, nil
+}
+
+func (r *BookingInsurance) CheckEligibility(ctx context.Context, req *pb.EligibilityReq) (*pb.Eligibility, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"Eligibility")
+	span.SetAttributes(
+		attribute.Key("patient_id").String(req.PatientId),
+		attribute.Key("doctor_service_id").String(req.DoctorServiceId),
+	)
+	defer span.End()
+
+	parser := newAmountParser()
+	check := &insurance.EligibilityReq{
+		PatientId:       req.PatientId,
+		PolicyId:        req.PolicyId,
+		DoctorServiceId: req.DoctorServiceId,
+		Price:           parser.parse("price", req.Price),
+		Currency:        req.Currency,
+		Date:            parser.day("date", req.Date, false),
+	}
+	if err := parser.err(); err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res, err := r.insuranceUseCase.CheckEligibility(ctx, check)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.Eligibility{
+		Eligible:  res.Eligible,
+		Reason:    res.Reason,
+		PolicyId:  res.PolicyId,
+		InsurerId: res.InsurerId,
+		Price:     res.Price.String(),
+		Covered:   res.Covered.String(),
+		Copay:     res.Copay.String(),
+		Currency:  res.Currency,
+	}, nil
+}
+
+func (r *BookingInsurance) CreateClaim(ctx context.Context, req *pb.CreateClaimReq) (*pb.InsuranceClaim, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"CreateClaim")
+	span.SetAttributes(
+		attribute.Key("appointment_id").Int64(req.AppointmentId),
+	)
+	defer span.End()
+
+	res, err := r.insuranceUseCase.CreateClaim(ctx, &insurance.CreateClaim{
+		Id:            req.Id,
+		AppointmentId: req.AppointmentId,
+		PolicyId:      req.PolicyId,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return claimToPb(res), nil
+}
+
+func (r *BookingInsurance) GetClaim(ctx context.Context, req *pb.InsuranceFieldValueReq) (*pb.InsuranceClaim, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"GetClaim")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.insuranceUseCase.GetClaim(ctx, &insurance.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return claimToPb(res), nil
+}
+
+func (r *BookingInsurance) GetAllClaims(ctx context.Context, req *pb.GetAllClaimsReq) (*pb.InsuranceClaimsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"ListClaims")
+	span.SetAttributes(
+		attribute.Key("insurer_id").String(req.InsurerId),
+		attribute.Key("status").String(req.Status),
+	)
+	defer span.End()
+
+	res, err := r.insuranceUseCase.GetAllClaims(ctx, &insurance.GetAllClaims{
+		Page:      req.Page,
+		Limit:     req.Limit,
+		InsurerId: req.InsurerId,
+		PatientId: req.PatientId,
+		BatchId:   req.BatchId,
+		Status:    req.Status,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	var claims []*pb.InsuranceClaim
+	for _, claim := range res.Claims {
+		claims = append(claims, claimToPb(claim))
+	}
+
+	return &pb.InsuranceClaimsType{
+		Count:  res.Count,
+		Claims: claims,
+	}, nil
+}
+
+func (r *BookingInsurance) SetClaimStatus(ctx context.Context, req *pb.ClaimStatusReq) (*pb.InsuranceClaim, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"SetClaimStatus")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+		attribute.Key("status").String(req.Status),
+	)
+	defer span.End()
+
+	parser := newAmountParser()
+	status := &insurance.ClaimStatusReq{
+		Id:     req.Id,
+		Status: req.Status,
+		Reason: req.Reason,
+	}
+	if req.Approved != "" {
+		status.Approved = parser.parse("approved", req.Approved)
+	}
+	if err := parser.err(); err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res, err := r.insuranceUseCase.SetClaimStatus(ctx, status)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return claimToPb(res), nil
+}
+
+func (r *BookingInsurance) ExportClaims(ctx context.Context, req *pb.ExportClaimsReq) (*pb.ClaimExportFile, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"Export")
+	span.SetAttributes(
+		attribute.Key("insurer_id").String(req.InsurerId),
+		attribute.Key("period_from").String(req.PeriodFrom),
+		attribute.Key("period_to").String(req.PeriodTo),
+	)
+	defer span.End()
+
+	parser := newAmountParser()
+	export := &insurance.ExportClaims{
+		Id:         req.Id,
+		InsurerId:  req.InsurerId,
+		PeriodFrom: parser.day("period_from", req.PeriodFrom, true),
+		PeriodTo:   parser.day("period_to", req.PeriodTo, true),
+		Format:     req.Format,
+		CreatedBy:  req.CreatedBy,
+	}
+	if err := parser.err(); err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res, err := r.insuranceUseCase.ExportClaims(ctx, export)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return exportFileToPb(res), nil
+}
+
+func (r *BookingInsurance) DownloadClaimBatch(ctx context.Context, req *pb.InsuranceFieldValueReq) (*pb.ClaimExportFile, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceService+"Download")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.insuranceUseCase.DownloadClaimBatch(ctx, &insurance.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return exportFileToPb(res), nil
+}
+
+func getAllInsuranceFromPb(req *pb.GetAllInsuranceReq) *insurance.GetAllReq {
+	return &insurance.GetAllReq{
+		Page:      req.Page,
+		Limit:     req.Limit,
+		InsurerId: req.InsurerId,
+		PatientId: req.PatientId,
+		Value:     req.Value,
+	}
+}
+
+func insurerFromPb(req *pb.Insurer) *insurance.Insurer {
+	return &insurance.Insurer{
+		Id:          req.Id,
+		Name:        req.Name,
+		Code:        req.Code,
+		Email:       req.Email,
+		PhoneNumber: req.PhoneNumber,
+	}
+}
+
+func insurerToPb(insurer *insurance.Insurer) *pb.Insurer {
+	return &pb.Insurer{
+		Id:          insurer.Id,
+		Name:        insurer.Name,
+		Code:        insurer.Code,
+		Email:       insurer.Email,
+		PhoneNumber: insurer.PhoneNumber,
+		CreatedAt:   formatOptionalTime(insurer.CreatedAt),
+		UpdatedAt:   formatOptionalTime(insurer.UpdatedAt),
+	}
+}
+
+func policyToPb(policy *insurance.Policy) *pb.InsurancePolicy {
+	return &pb.InsurancePolicy{
+		Id:           policy.Id,
+		InsurerId:    policy.InsurerId,
+		PatientId:    policy.PatientId,
+		PolicyNumber: policy.PolicyNumber,
+		ValidFrom:    policy.ValidFrom.Format(insuranceDateLayout),
+		ValidTo:      policy.ValidTo.Format(insuranceDateLayout),
+		AnnualLimit:  policy.AnnualLimit.String(),
+		Currency:     policy.Currency,
+		Status:       policy.Status,
+		CreatedAt:    formatOptionalTime(policy.CreatedAt),
+		UpdatedAt:    formatOptionalTime(policy.UpdatedAt),
+	}
+}
+
+func coverageRuleToPb(rule *insurance.CoverageRule) *pb.CoverageRule {
+	return &pb.CoverageRule{
+		Id:              rule.Id,
+		InsurerId:       rule.InsurerId,
+		DoctorServiceId: rule.DoctorServiceId,
+		Kind:            rule.Kind,
+		Percentage:      rule.Percentage,
+		Copay:           rule.Copay.String(),
+		AnnualLimit:     rule.AnnualLimit.String(),
+		CreatedAt:       formatOptionalTime(rule.CreatedAt),
+		UpdatedAt:       formatOptionalTime(rule.UpdatedAt),
+	}
+}
+
+func claimToPb(claim *insurance.Claim) *pb.InsuranceClaim {
+	return &pb.InsuranceClaim{
+		Id:              claim.Id,
+		InsurerId:       claim.InsurerId,
+		PolicyId:        claim.PolicyId,
+		PatientId:       claim.PatientId,
+		AppointmentId:   claim.AppointmentId,
+		InvoiceId:       claim.InvoiceId,
+		DoctorServiceId: claim.DoctorServiceId,
+		ServiceDate:     claim.ServiceDate.Format(insuranceDateLayout),
+		Billed:          claim.Billed.String(),
+		Covered:         claim.Covered.String(),
+		Copay:           claim.Copay.String(),
+		Approved:        claim.Approved.String(),
+		Currency:        claim.Currency,
+		Status:          claim.Status,
+		StatusReason:    claim.StatusReason,
+		BatchId:         claim.BatchId,
+		CreatedAt:       formatOptionalTime(claim.CreatedAt),
+		UpdatedAt:       formatOptionalTime(claim.UpdatedAt),
+	}
+}
+
+func exportFileToPb(file *insurance.ExportFile) *pb.ClaimExportFile {
+	return &pb.ClaimExportFile{
+		Batch: &pb.ClaimBatch{
+			Id:         file.Batch.Id,
+			InsurerId:  file.Batch.InsurerId,
+			PeriodFrom: file.Batch.PeriodFrom.Format(insuranceDateLayout),
+			PeriodTo:   file.Batch.PeriodTo.Format(insuranceDateLayout),
+			Format:     file.Batch.Format,
+			ClaimCount: file.Batch.ClaimCount,
+			CreatedBy:  file.Batch.CreatedBy,
+			CreatedAt:  formatOptionalTime(file.Batch.CreatedAt),
+		},
+		FileName:    file.FileName,
+		ContentType: file.ContentType,
+		Content:     file.Content,
+	}
+}
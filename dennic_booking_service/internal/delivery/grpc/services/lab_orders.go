@@ -0,0 +1,221 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	"booking_service/internal/delivery/grpc"
+	"booking_service/internal/entity/lab_orders"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	serviceNameLabOrders     = "LabOrdersService"
+	spanNameLabOrdersService = "LabOrdersService"
+)
+
+type BookingLabOrders struct {
+	logger           *zap.Logger
+	labOrdersUseCase usecase.LabOrders
+}
+
+func BookingLabOrdersNewRPC(logger *zap.Logger, labOrdersUseCase usecase.LabOrders) *BookingLabOrders {
+	return &BookingLabOrders{
+		logger:           logger,
+		labOrdersUseCase: labOrdersUseCase,
+	}
+}
+
+func (r *BookingLabOrders) CreateLabOrder(ctx context.Context, req *pb.CreateLabOrderReq) (*pb.LabOrder, error) {
+	ctx, span := otlp.Start(ctx, serviceNameLabOrders, spanNameLabOrdersService+"Create")
+	span.SetAttributes(
+		attribute.Key("appointment_id").Int64(req.AppointmentId),
+	)
+	defer span.End()
+
+	create := &lab_orders.CreateLabOrder{
+		Id:            req.Id,
+		AppointmentId: req.AppointmentId,
+		Notes:         req.Notes,
+	}
+	for _, test := range req.Tests {
+		create.Tests = append(create.Tests, &lab_orders.Test{
+			Code: test.Code,
+			Name: test.Name,
+		})
+	}
+
+	res, err := r.labOrdersUseCase.CreateLabOrder(ctx, create)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return labOrderToPb(res), nil
+}
+
+func (r *BookingLabOrders) GetLabOrder(ctx context.Context, req *pb.LabOrderFieldValueReq) (*pb.LabOrder, error) {
+	ctx, span := otlp.Start(ctx, serviceNameLabOrders, spanNameLabOrdersService+"Get")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.labOrdersUseCase.GetLabOrder(ctx, &lab_orders.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return labOrderToPb(res), nil
+}
+
+func (r *BookingLabOrders) GetAllLabOrders(ctx context.Context, req *pb.GetAllLabOrdersReq) (*pb.LabOrdersType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameLabOrders, spanNameLabOrdersService+"List")
+	span.SetAttributes(
+		attribute.Key("patient_id").String(req.PatientId),
+		attribute.Key("doctor_id").String(req.DoctorId),
+		attribute.Key("status").String(req.Status),
+	)
+	defer span.End()
+
+	res, err := r.labOrdersUseCase.GetAllLabOrders(ctx, &lab_orders.GetAllLabOrders{
+		Page:      req.Page,
+		Limit:     req.Limit,
+		PatientId: req.PatientId,
+		DoctorId:  req.DoctorId,
+		Status:    req.Status,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	var orders pb.LabOrdersType
+	for _, order := range res.LabOrders {
+		orders.LabOrders = append(orders.LabOrders, labOrderToPb(order))
+	}
+	orders.Count = res.Count
+
+	return &orders, nil
+}
+
+func (r *BookingLabOrders) SubmitLabResults(ctx context.Context, req *pb.SubmitLabResultsReq) (*pb.LabOrder, error) {
+	ctx, span := otlp.Start(ctx, serviceNameLabOrders, spanNameLabOrdersService+"Submit")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	submit := &lab_orders.SubmitResults{Id: req.Id}
+	for _, result := range req.Results {
+		submit.Results = append(submit.Results, &lab_orders.Result{
+			TestCode:       result.TestCode,
+			Analyte:        result.Analyte,
+			Value:          result.Value,
+			Unit:           result.Unit,
+			ReferenceRange: result.ReferenceRange,
+		})
+	}
+	for _, attachment := range req.Attachments {
+		submit.Attachments = append(submit.Attachments, &lab_orders.Attachment{
+			FileName: attachment.FileName,
+			Url:      attachment.Url,
+		})
+	}
+
+	res, err := r.labOrdersUseCase.SubmitLabResults(ctx, submit)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return labOrderToPb(res), nil
+}
+
+func (r *BookingLabOrders) ReleaseLabOrder(ctx context.Context, req *pb.LabOrderFieldValueReq) (*pb.LabOrder, error) {
+	ctx, span := otlp.Start(ctx, serviceNameLabOrders, spanNameLabOrdersService+"Release")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.labOrdersUseCase.ReleaseLabOrder(ctx, &lab_orders.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return labOrderToPb(res), nil
+}
+
+func (r *BookingLabOrders) CancelLabOrder(ctx context.Context, req *pb.LabOrderFieldValueReq) (*pb.LabOrder, error) {
+	ctx, span := otlp.Start(ctx, serviceNameLabOrders, spanNameLabOrdersService+"Cancel")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.labOrdersUseCase.CancelLabOrder(ctx, &lab_orders.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return labOrderToPb(res), nil
+}
+
+// formatOptionalTime leaves times of steps not reached yet empty.
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
+
+func labOrderToPb(order *lab_orders.LabOrder) *pb.LabOrder {
+	res := &pb.LabOrder{
+		Id:            order.Id,
+		AppointmentId: order.AppointmentId,
+		PatientId:     order.PatientId,
+		DoctorId:      order.DoctorId,
+		Status:        order.Status,
+		Notes:         order.Notes,
+		OrderedAt:     formatOptionalTime(order.OrderedAt),
+		ResultedAt:    formatOptionalTime(order.ResultedAt),
+		ReleasedAt:    formatOptionalTime(order.ReleasedAt),
+		CancelledAt:   formatOptionalTime(order.CancelledAt),
+		UpdatedAt:     order.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+	for _, test := range order.Tests {
+		res.Tests = append(res.Tests, &pb.LabTest{
+			Code: test.Code,
+			Name: test.Name,
+		})
+	}
+	for _, result := range order.Results {
+		res.Results = append(res.Results, &pb.LabResult{
+			TestCode:       result.TestCode,
+			Analyte:        result.Analyte,
+			Value:          result.Value,
+			Unit:           result.Unit,
+			ReferenceRange: result.ReferenceRange,
+			Flag:           result.Flag,
+		})
+	}
+	for _, attachment := range order.Attachments {
+		res.Attachments = append(res.Attachments, &pb.LabAttachment{
+			FileName: attachment.FileName,
+			Url:      attachment.Url,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,300 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	"booking_service/internal/delivery/grpc"
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/messages"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	serviceNameMessages     = "MessagesService"
+	spanNameMessagesService = "MessagesService"
+)
+
+type BookingMessages struct {
+	logger          *zap.Logger
+	messagesUseCase usecase.Messages
+}
+
+func BookingMessagesNewRPC(logger *zap.Logger, messagesUseCase usecase.Messages) *BookingMessages {
+	return &BookingMessages{
+		logger:          logger,
+		messagesUseCase: messagesUseCase,
+	}
+}
+
+func (r *BookingMessages) StartConversation(ctx context.Context, req *pb.StartConversationReq) (*pb.Conversation, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesService+"Start")
+	span.SetAttributes(
+		attribute.Key("patient_id").String(req.PatientId),
+		attribute.Key("doctor_id").String(req.DoctorId),
+		attribute.Key("appointment_id").Int64(req.AppointmentId),
+	)
+	defer span.End()
+
+	res, err := r.messagesUseCase.StartConversation(ctx, &messages.StartConversation{
+		PatientId:     req.PatientId,
+		DoctorId:      req.DoctorId,
+		AppointmentId: req.AppointmentId,
+		ReaderRole:    req.ReaderRole,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return conversationToPb(res), nil
+}
+
+func (r *BookingMessages) GetConversation(ctx context.Context, req *pb.ConversationReq) (*pb.Conversation, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesService+"GetConversation")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	res, err := r.messagesUseCase.GetConversation(ctx, req.Id, req.ReaderRole)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return conversationToPb(res), nil
+}
+
+func (r *BookingMessages) GetAllConversations(ctx context.Context, req *pb.GetAllConversationsReq) (*pb.ConversationsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesService+"ListConversations")
+	span.SetAttributes(
+		attribute.Key("patient_id").String(req.PatientId),
+		attribute.Key("doctor_id").String(req.DoctorId),
+	)
+	defer span.End()
+
+	res, err := r.messagesUseCase.GetAllConversations(ctx, &messages.GetAllConversations{
+		Page:       req.Page,
+		Limit:      req.Limit,
+		PatientId:  req.PatientId,
+		DoctorId:   req.DoctorId,
+		ReaderRole: req.ReaderRole,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	var list pb.ConversationsType
+	for _, conversation := range res.Conversations {
+		list.Conversations = append(list.Conversations, conversationToPb(conversation))
+	}
+	list.Count = res.Count
+
+	return &list, nil
+}
+
+func (r *BookingMessages) SendMessage(ctx context.Context, req *pb.ConversationMessage) (*pb.ConversationMessage, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesService+"Send")
+	span.SetAttributes(
+		attribute.Key("conversation_id").String(req.ConversationId),
+		attribute.Key("sender_role").String(req.SenderRole),
+	)
+	defer span.End()
+
+	message := &messages.Message{
+		ConversationId: req.ConversationId,
+		SenderId:       req.SenderId,
+		SenderRole:     req.SenderRole,
+		Body:           req.Body,
+	}
+	if attachment := req.Attachment; attachment != nil {
+		message.Attachment = &messages.Attachment{
+			FileName:    attachment.FileName,
+			ContentType: attachment.ContentType,
+			SizeBytes:   attachment.SizeBytes,
+			Bucket:      attachment.Bucket,
+			ObjectKey:   attachment.ObjectKey,
+		}
+	}
+
+	res, err := r.messagesUseCase.SendMessage(ctx, message)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return messageToPb(res), nil
+}
+
+func (r *BookingMessages) GetMessage(ctx context.Context, req *pb.MessageReq) (*pb.ConversationMessage, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesService+"GetMessage")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	res, err := r.messagesUseCase.GetMessage(ctx, req.Id)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return messageToPb(res), nil
+}
+
+func (r *BookingMessages) GetAllMessages(ctx context.Context, req *pb.GetAllMessagesReq) (*pb.MessagesType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesService+"ListMessages")
+	span.SetAttributes(
+		attribute.Key("conversation_id").String(req.ConversationId),
+	)
+	defer span.End()
+
+	res, err := r.messagesUseCase.GetAllMessages(ctx, &messages.GetAllMessages{
+		Page:           req.Page,
+		Limit:          req.Limit,
+		ConversationId: req.ConversationId,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	var list pb.MessagesType
+	for _, message := range res.Messages {
+		list.Messages = append(list.Messages, messageToPb(message))
+	}
+	list.Count = res.Count
+
+	return &list, nil
+}
+
+func (r *BookingMessages) MarkConversationRead(ctx context.Context, req *pb.MarkConversationReadReq) (*pb.ReadReceipt, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesService+"MarkRead")
+	span.SetAttributes(
+		attribute.Key("conversation_id").String(req.ConversationId),
+		attribute.Key("reader_role").String(req.ReaderRole),
+	)
+	defer span.End()
+
+	res, err := r.messagesUseCase.MarkConversationRead(ctx, req.ConversationId, req.ReaderRole)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.ReadReceipt{
+		ConversationId: res.ConversationId,
+		ReaderRole:     res.ReaderRole,
+		Read:           res.Read,
+		ReadAt:         formatOptionalTime(res.ReadAt),
+	}, nil
+}
+
+func (r *BookingMessages) GetReplyHours(ctx context.Context, req *pb.ReplyHoursReq) (*pb.ReplyHours, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesService+"GetReplyHours")
+	span.SetAttributes(
+		attribute.Key("doctor_id").String(req.DoctorId),
+	)
+	defer span.End()
+
+	res, err := r.messagesUseCase.GetReplyHours(ctx, req.DoctorId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return replyHoursToPb(res), nil
+}
+
+func (r *BookingMessages) SetReplyHours(ctx context.Context, req *pb.ReplyHours) (*pb.ReplyHours, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesService+"SetReplyHours")
+	span.SetAttributes(
+		attribute.Key("doctor_id").String(req.DoctorId),
+	)
+	defer span.End()
+
+	hours := &messages.ReplyHours{DoctorId: req.DoctorId}
+	for _, window := range req.Windows {
+		startsAt, err := messages.ParseClock(window.StartsAt)
+		if err != nil {
+			return nil, grpc.Error(ctx, invalidReplyHours(err))
+		}
+		endsAt, err := messages.ParseClock(window.EndsAt)
+		if err != nil {
+			return nil, grpc.Error(ctx, invalidReplyHours(err))
+		}
+		hours.Windows = append(hours.Windows, &messages.ReplyWindow{
+			Weekday:  time.Weekday(window.Weekday),
+			StartsAt: startsAt,
+			EndsAt:   endsAt,
+		})
+	}
+
+	res, err := r.messagesUseCase.SetReplyHours(ctx, hours)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return replyHoursToPb(res), nil
+}
+
+func invalidReplyHours(err error) error {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid reply hours")
+	errValidation.Errors["windows"] = err.Error()
+	return errValidation
+}
+
+func conversationToPb(conversation *messages.Conversation) *pb.Conversation {
+	res := &pb.Conversation{
+		Id:              conversation.Id,
+		PatientId:       conversation.PatientId,
+		DoctorId:        conversation.DoctorId,
+		AppointmentId:   conversation.AppointmentId,
+		LastMessageAt:   conversation.LastMessageAt.Format("2006-01-02 15:04:05"),
+		CreatedAt:       conversation.CreatedAt.Format("2006-01-02 15:04:05"),
+		Unread:          conversation.Unread,
+		DoctorAvailable: conversation.DoctorAvailable,
+	}
+	// the zone of the clinic travels with the reply time
+	if !conversation.RepliesFrom.IsZero() {
+		res.RepliesFrom = conversation.RepliesFrom.Format(time.RFC3339)
+	}
+
+	return res
+}
+
+func messageToPb(message *messages.Message) *pb.ConversationMessage {
+	res := &pb.ConversationMessage{
+		Id:             message.Id,
+		ConversationId: message.ConversationId,
+		SenderId:       message.SenderId,
+		SenderRole:     message.SenderRole,
+		Body:           message.Body,
+		ReadAt:         formatOptionalTime(message.ReadAt),
+		CreatedAt:      message.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if attachment := message.Attachment; attachment != nil {
+		res.Attachment = &pb.MessageAttachment{
+			FileName:    attachment.FileName,
+			ContentType: attachment.ContentType,
+			SizeBytes:   attachment.SizeBytes,
+			Bucket:      attachment.Bucket,
+			ObjectKey:   attachment.ObjectKey,
+		}
+	}
+
+	return res
+}
+
+func replyHoursToPb(hours *messages.ReplyHours) *pb.ReplyHours {
+	res := &pb.ReplyHours{DoctorId: hours.DoctorId}
+	for _, window := range hours.Windows {
+		res.Windows = append(res.Windows, &pb.ReplyWindow{
+			Weekday:  int32(window.Weekday),
+			StartsAt: window.StartsAt.String(),
+			EndsAt:   window.EndsAt.String(),
+		})
+	}
+
+	return res
+}
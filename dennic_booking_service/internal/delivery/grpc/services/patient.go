@@ -2,6 +2,7 @@ package services
 
 import (
 	pb "booking_service/genproto/booking_service"
+	"booking_service/internal/delivery/grpc"
 	"booking_service/internal/entity/patients"
 	"booking_service/internal/pkg/otlp"
 	"booking_service/internal/usecase"
@@ -9,6 +10,7 @@ import (
 	"github.com/rickb777/date"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
+	"time"
 	//"google.golang.org/grpc/codes"
 )
 
@@ -52,10 +54,13 @@ func (r *BookingPatient) CreatePatient(ctx context.Context, req *pb.CreatePatien
 		Country:        req.Country,
 		Address:        req.Address,
 		PatientProblem: req.PatientProblem,
+		UserId:         req.UserId,
+		Relationship:   req.Relationship,
+		Consent:        req.Consent,
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, grpc.Error(ctx, err)
 	}
 
 	return &pb.Patient{
@@ -73,6 +78,10 @@ func (r *BookingPatient) CreatePatient(ctx context.Context, req *pb.CreatePatien
 		CreatedAt:      res.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:      res.UpdatedAt.Format("2006-01-02 15:04:05"),
 		DeletedAt:      res.DeletedAt.Format("2006-01-02 15:04:05"),
+		UserId:         res.UserId,
+		Relationship:   res.Relationship,
+		Consent:        res.Consent,
+		ConsentAt:      formatConsentAt(res.ConsentAt),
 	}, nil
 }
 
@@ -86,9 +95,10 @@ func (r *BookingPatient) GetPatient(ctx context.Context, req *pb.PatientFieldVal
 		Field:        req.Field,
 		Value:        req.Value,
 		DeleteStatus: req.IsActive,
+		UserId:       req.UserId,
 	})
 	if err != nil {
-		return nil, err
+		return nil, grpc.Error(ctx, err)
 	}
 
 	return &pb.Patient{
@@ -106,6 +116,10 @@ func (r *BookingPatient) GetPatient(ctx context.Context, req *pb.PatientFieldVal
 		CreatedAt:      res.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:      res.UpdatedAt.Format("2006-01-02 15:04:05"),
 		DeletedAt:      res.DeletedAt.Format("2006-01-02 15:04:05"),
+		UserId:         res.UserId,
+		Relationship:   res.Relationship,
+		Consent:        res.Consent,
+		ConsentAt:      formatConsentAt(res.ConsentAt),
 	}, nil
 }
 
@@ -121,10 +135,11 @@ func (r *BookingPatient) GetAllPatients(ctx context.Context, req *pb.GetAllPatie
 		Field:        req.Field,
 		Value:        req.Value,
 		OrderBy:      req.OrderBy,
+		UserId:       req.UserId,
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, grpc.Error(ctx, err)
 	}
 
 	for _, patient := range allPatients.Patients {
@@ -143,6 +158,10 @@ func (r *BookingPatient) GetAllPatients(ctx context.Context, req *pb.GetAllPatie
 		patientRes.CreatedAt = patient.CreatedAt.Format("2006-01-02 15:04:05")
 		patientRes.UpdatedAt = patient.UpdatedAt.Format("2006-01-02 15:04:05")
 		patientRes.DeletedAt = patient.DeletedAt.Format("2006-01-02 15:04:05")
+		patientRes.UserId = patient.UserId
+		patientRes.Relationship = patient.Relationship
+		patientRes.Consent = patient.Consent
+		patientRes.ConsentAt = formatConsentAt(patient.ConsentAt)
 		patentsRes.Patients = append(patentsRes.Patients, &patientRes)
 	}
 	patentsRes.Count = allPatients.Count
@@ -173,9 +192,12 @@ func (r *BookingPatient) UpdatePatient(ctx context.Context, req *pb.UpdatePatien
 		Country:        req.Country,
 		Address:        req.Address,
 		PatientProblem: req.PatientProblem,
+		UserId:         req.UserId,
+		Relationship:   req.Relationship,
+		Consent:        req.Consent,
 	})
 	if err != nil {
-		return nil, err
+		return nil, grpc.Error(ctx, err)
 	}
 
 	return &pb.Patient{
@@ -193,6 +215,10 @@ func (r *BookingPatient) UpdatePatient(ctx context.Context, req *pb.UpdatePatien
 		CreatedAt:      res.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:      res.UpdatedAt.Format("2006-01-02 15:04:05"),
 		DeletedAt:      res.DeletedAt.Format("2006-01-02 15:04:05"),
+		UserId:         res.UserId,
+		Relationship:   res.Relationship,
+		Consent:        res.Consent,
+		ConsentAt:      formatConsentAt(res.ConsentAt),
 	}, nil
 }
 
@@ -225,6 +251,7 @@ func (r *BookingPatient) DeletePatient(ctx context.Context, req *pb.PatientField
 		Field:        req.Field,
 		Value:        req.Value,
 		DeleteStatus: req.IsActive,
+		UserId:       req.UserId,
 	})
 
 	if err != nil {
@@ -233,3 +260,11 @@ func (r *BookingPatient) DeletePatient(ctx context.Context, req *pb.PatientField
 
 	return &pb.PatientStatus{Status: res.Status}, nil
 }
+
+// formatConsentAt leaves consent_at empty until consent is given.
+func formatConsentAt(consentAt time.Time) string {
+	if consentAt.IsZero() {
+		return ""
+	}
+	return consentAt.Format("2006-01-02 15:04:05")
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	"booking_service/internal/delivery/grpc"
+	"booking_service/internal/entity/payments"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	serviceNamePayments     = "PaymentsService"
+	spanNamePaymentsService = "PaymentsService"
+)
+
+type BookingPayments struct {
+	logger          *zap.Logger
+	paymentsUseCase usecase.Payments
+}
+
+func BookingPaymentsNewRPC(logger *zap.Logger, paymentsUseCase usecase.Payments) *BookingPayments {
+	return &BookingPayments{
+		logger:          logger,
+		paymentsUseCase: paymentsUseCase,
+	}
+}
+
+func (r *BookingPayments) CreatePaymentIntent(ctx context.Context, req *pb.CreatePaymentIntentReq) (*pb.PaymentIntent, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePayments, spanNamePaymentsService+"Create")
+	span.SetAttributes(
+		attribute.Key("appointment_id").Int64(req.AppointmentId),
+		attribute.Key("patient_id").String(req.PatientId),
+	)
+	defer span.End()
+
+	res, err := r.paymentsUseCase.CreatePaymentIntent(ctx, &payments.CreateIntent{
+		Id:             req.Id,
+		AppointmentId:  req.AppointmentId,
+		PatientId:      req.PatientId,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return paymentIntentToPb(res), nil
+}
+
+func (r *BookingPayments) GetPaymentIntent(ctx context.Context, req *pb.PaymentIntentFieldValueReq) (*pb.PaymentIntent, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePayments, spanNamePaymentsService+"Get")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.paymentsUseCase.GetPaymentIntent(ctx, &payments.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return paymentIntentToPb(res), nil
+}
+
+func (r *BookingPayments) GetAllPaymentIntents(ctx context.Context, req *pb.GetAllPaymentIntentsReq) (*pb.PaymentIntentsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePayments, spanNamePaymentsService+"List")
+	span.SetAttributes(
+		attribute.Key("patient_id").String(req.PatientId),
+		attribute.Key("status").String(req.Status),
+	)
+	defer span.End()
+
+	res, err := r.paymentsUseCase.GetAllPaymentIntents(ctx, &payments.GetAllIntents{
+		Page:          req.Page,
+		Limit:         req.Limit,
+		PatientId:     req.PatientId,
+		AppointmentId: req.AppointmentId,
+		Status:        req.Status,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	intents := &pb.PaymentIntentsType{Count: res.Count}
+	for _, intent := range res.Intents {
+		intents.Intents = append(intents.Intents, paymentIntentToPb(intent))
+	}
+
+	return intents, nil
+}
+
+func (r *BookingPayments) HandlePaymentWebhook(ctx context.Context, req *pb.PaymentWebhookReq) (*pb.PaymentIntent, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePayments, spanNamePaymentsService+"Webhook")
+	span.SetAttributes(
+		attribute.Key("provider").String(req.Provider),
+	)
+	defer span.End()
+
+	res, err := r.paymentsUseCase.HandleWebhook(ctx, &payments.Webhook{
+		Provider:  req.Provider,
+		Payload:   req.Payload,
+		Signature: req.Signature,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return paymentIntentToPb(res), nil
+}
+
+func paymentIntentToPb(intent *payments.Intent) *pb.PaymentIntent {
+	return &pb.PaymentIntent{
+		Id:             intent.Id,
+		AppointmentId:  intent.AppointmentId,
+		PatientId:      intent.PatientId,
+		Amount:         intent.Amount.String(),
+		Currency:       intent.Currency,
+		Provider:       intent.Provider,
+		ProviderRef:    intent.ProviderRef,
+		CheckoutUrl:    intent.CheckoutURL,
+		Status:         intent.Status,
+		FailureReason:  intent.FailureReason,
+		IdempotencyKey: intent.IdempotencyKey,
+		InvoiceId:      intent.InvoiceId,
+		ExpiresAt:      formatOptionalTime(intent.ExpiresAt),
+		CreatedAt:      formatOptionalTime(intent.CreatedAt),
+		UpdatedAt:      formatOptionalTime(intent.UpdatedAt),
+	}
+}
@@ -0,0 +1,347 @@
+package services
+
+import (
+	pb "booking_service/genproto/booking_service"
+	"booking_service/internal/delivery/grpc"
+	"booking_service/internal/entity/prescriptions"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/usecase"
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	serviceNamePrescriptions     = "PrescriptionsService"
+	spanNamePrescriptionsService = "PrescriptionsService"
+)
+
+type BookingPrescriptions struct {
+	logger               *zap.Logger
+	prescriptionsUseCase usecase.Prescriptions
+}
+
+func BookingPrescriptionsNewRPC(logger *zap.Logger, prescriptionsUseCase usecase.Prescriptions) *BookingPrescriptions {
+	return &BookingPrescriptions{
+		logger:               logger,
+		prescriptionsUseCase: prescriptionsUseCase,
+	}
+}
+
+func (r *BookingPrescriptions) CreateMedication(ctx context.Context, req *pb.Medication) (*pb.Medication, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptionsService+"MedicationCreate")
+	span.SetAttributes(
+		attribute.Key("name").String(req.Name),
+	)
+	defer span.End()
+
+	res, err := r.prescriptionsUseCase.CreateMedication(ctx, medicationFromPb(req))
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return medicationToPb(res), nil
+}
+
+func (r *BookingPrescriptions) GetMedication(ctx context.Context, req *pb.PrescriptionFieldValueReq) (*pb.Medication, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptionsService+"MedicationGet")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.prescriptionsUseCase.GetMedication(ctx, &prescriptions.FieldValueReq{
+		Field:        req.Field,
+		Value:        req.Value,
+		DeleteStatus: req.IsActive,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return medicationToPb(res), nil
+}
+
+func (r *BookingPrescriptions) GetAllMedications(ctx context.Context, req *pb.GetAllMedicationsReq) (*pb.MedicationsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptionsService+"MedicationList")
+	span.SetAttributes(
+		attribute.Key("search").String(req.Search),
+	)
+	defer span.End()
+
+	res, err := r.prescriptionsUseCase.GetAllMedications(ctx, &prescriptions.GetAllMedications{
+		Page:   req.Page,
+		Limit:  req.Limit,
+		Search: req.Search,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	var medications pb.MedicationsType
+	for _, medication := range res.Medications {
+		medications.Medications = append(medications.Medications, medicationToPb(medication))
+	}
+	medications.Count = res.Count
+
+	return &medications, nil
+}
+
+func (r *BookingPrescriptions) UpdateMedication(ctx context.Context, req *pb.Medication) (*pb.Medication, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptionsService+"MedicationUpdate")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	res, err := r.prescriptionsUseCase.UpdateMedication(ctx, medicationFromPb(req))
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return medicationToPb(res), nil
+}
+
+func (r *BookingPrescriptions) DeleteMedication(ctx context.Context, req *pb.PrescriptionFieldValueReq) (*pb.PrescriptionStatus, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptionsService+"MedicationDelete")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.prescriptionsUseCase.DeleteMedication(ctx, &prescriptions.FieldValueReq{
+		Field:        req.Field,
+		Value:        req.Value,
+		DeleteStatus: req.IsActive,
+	})
+	if err != nil {
+		return &pb.PrescriptionStatus{Status: false}, grpc.Error(ctx, err)
+	}
+
+	return &pb.PrescriptionStatus{Status: res.Status}, nil
+}
+
+func (r *BookingPrescriptions) CreateInteraction(ctx context.Context, req *pb.Interaction) (*pb.Interaction, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptionsService+"InteractionCreate")
+	span.SetAttributes(
+		attribute.Key("ingredient_a").String(req.IngredientA),
+		attribute.Key("ingredient_b").String(req.IngredientB),
+	)
+	defer span.End()
+
+	res, err := r.prescriptionsUseCase.CreateInteraction(ctx, &prescriptions.Interaction{
+		IngredientA: req.IngredientA,
+		IngredientB: req.IngredientB,
+		Severity:    req.Severity,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.Interaction{
+		IngredientA: res.IngredientA,
+		IngredientB: res.IngredientB,
+		Severity:    res.Severity,
+		Description: res.Description,
+	}, nil
+}
+
+func (r *BookingPrescriptions) CreatePrescription(ctx context.Context, req *pb.CreatePrescriptionReq) (*pb.Prescription, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptionsService+"Create")
+	span.SetAttributes(
+		attribute.Key("appointment_id").Int64(req.AppointmentId),
+	)
+	defer span.End()
+
+	create := &prescriptions.CreatePrescription{AppointmentId: req.AppointmentId}
+	for _, item := range req.Items {
+		create.Items = append(create.Items, &prescriptions.Item{
+			MedicationId: item.MedicationId,
+			Dose:         item.Dose,
+			Frequency:    item.Frequency,
+			DurationDays: item.DurationDays,
+			Route:        item.Route,
+			Instructions: item.Instructions,
+		})
+	}
+
+	res, err := r.prescriptionsUseCase.CreatePrescription(ctx, create)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return prescriptionToPb(res), nil
+}
+
+func (r *BookingPrescriptions) GetPrescription(ctx context.Context, req *pb.PrescriptionFieldValueReq) (*pb.Prescription, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptionsService+"Get")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.prescriptionsUseCase.GetPrescription(ctx, &prescriptions.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return prescriptionToPb(res), nil
+}
+
+func (r *BookingPrescriptions) GetAllPrescriptions(ctx context.Context, req *pb.GetAllPrescriptionsReq) (*pb.PrescriptionsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptionsService+"List")
+	span.SetAttributes(
+		attribute.Key("patient_id").String(req.PatientId),
+		attribute.Key("doctor_id").String(req.DoctorId),
+	)
+	defer span.End()
+
+	res, err := r.prescriptionsUseCase.GetAllPrescriptions(ctx, &prescriptions.GetAllPrescriptions{
+		Page:      req.Page,
+		Limit:     req.Limit,
+		PatientId: req.PatientId,
+		DoctorId:  req.DoctorId,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	var list pb.PrescriptionsType
+	for _, prescription := range res.Prescriptions {
+		list.Prescriptions = append(list.Prescriptions, prescriptionToPb(prescription))
+	}
+	list.Count = res.Count
+
+	return &list, nil
+}
+
+func (r *BookingPrescriptions) RevokePrescription(ctx context.Context, req *pb.PrescriptionFieldValueReq) (*pb.Prescription, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptionsService+"Revoke")
+	span.SetAttributes(
+		attribute.Key(req.Field).String(req.Value),
+	)
+	defer span.End()
+
+	res, err := r.prescriptionsUseCase.RevokePrescription(ctx, &prescriptions.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return prescriptionToPb(res), nil
+}
+
+func (r *BookingPrescriptions) RenderPrescription(ctx context.Context, req *pb.RenderPrescriptionReq) (*pb.PrescriptionDocument, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptionsService+"Render")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	res, err := r.prescriptionsUseCase.RenderPrescription(ctx, req.Id, req.DoctorName)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.PrescriptionDocument{
+		FileName: res.FileName,
+		Content:  res.Content,
+	}, nil
+}
+
+func (r *BookingPrescriptions) VerifyPrescription(ctx context.Context, req *pb.VerifyPrescriptionReq) (*pb.PrescriptionVerification, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptionsService+"Verify")
+	defer span.End()
+
+	res, err := r.prescriptionsUseCase.VerifyPrescription(ctx, req.Code)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	verification := &pb.PrescriptionVerification{
+		Valid:           res.Valid,
+		Revoked:         res.Revoked,
+		IssuedAt:        res.IssuedAt.Format(time.RFC3339),
+		DoctorId:        res.DoctorId,
+		PatientInitials: res.PatientInitials,
+	}
+	for _, item := range res.Items {
+		verification.Items = append(verification.Items, prescriptionItemToPb(item))
+	}
+
+	return verification, nil
+}
+
+func medicationFromPb(req *pb.Medication) *prescriptions.Medication {
+	return &prescriptions.Medication{
+		Id:               req.Id,
+		Name:             req.Name,
+		Form:             req.Form,
+		Strength:         req.Strength,
+		ActiveIngredient: req.ActiveIngredient,
+	}
+}
+
+func medicationToPb(medication *prescriptions.Medication) *pb.Medication {
+	return &pb.Medication{
+		Id:               medication.Id,
+		Name:             medication.Name,
+		Form:             medication.Form,
+		Strength:         medication.Strength,
+		ActiveIngredient: medication.ActiveIngredient,
+		CreatedAt:        medication.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:        medication.UpdatedAt.Format("2006-01-02 15:04:05"),
+		DeletedAt:        medication.DeletedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func prescriptionItemToPb(item *prescriptions.Item) *pb.PrescriptionItem {
+	return &pb.PrescriptionItem{
+		MedicationId:     item.MedicationId,
+		MedicationName:   item.MedicationName,
+		Form:             item.Form,
+		Strength:         item.Strength,
+		ActiveIngredient: item.ActiveIngredient,
+		Dose:             item.Dose,
+		Frequency:        item.Frequency,
+		DurationDays:     item.DurationDays,
+		Route:            item.Route,
+		Instructions:     item.Instructions,
+	}
+}
+
+func prescriptionToPb(prescription *prescriptions.Prescription) *pb.Prescription {
+	res := &pb.Prescription{
+		Id:               prescription.Id,
+		AppointmentId:    prescription.AppointmentId,
+		PatientId:        prescription.PatientId,
+		DoctorId:         prescription.DoctorId,
+		VerificationCode: prescription.VerificationCode,
+		Signature:        prescription.Signature,
+		IssuedAt:         prescription.IssuedAt.Format(time.RFC3339),
+		CreatedAt:        prescription.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if !prescription.RevokedAt.IsZero() {
+		res.RevokedAt = prescription.RevokedAt.Format("2006-01-02 15:04:05")
+	}
+	for _, item := range prescription.Items {
+		res.Items = append(res.Items, prescriptionItemToPb(item))
+	}
+	for _, warning := range prescription.Warnings {
+		res.Warnings = append(res.Warnings, &pb.PrescriptionWarning{
+			Kind:        warning.Kind,
+			Severity:    warning.Severity,
+			Description: warning.Description,
+		})
+	}
+
+	return res
+}
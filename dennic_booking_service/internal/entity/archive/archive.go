@@ -1,19 +1,47 @@
 package archive
 
-import "time"
+import (
+	"booking_service/internal/entity/billing"
+	"time"
+)
 
+// Outcomes of a visit. They are the final statuses of the booked appointment.
+const (
+	OutcomeAttended  = "attended"
+	OutcomeCancelled = "cancelled"
+	OutcomeNoShow    = "no_show"
+)
+
+// Payment types of a visit.
+const (
+	PaymentCash      = "cash"
+	PaymentCard      = "card"
+	PaymentInsurance = "insurance"
+	PaymentOnline    = "online"
+)
+
+// Archive is the closed-out record of a booked appointment. Patient, doctor,
+// department, branch and date are copied from the appointment when it is
+// archived; StartedAt and EndedAt are when the visit actually took place.
 type Archive struct {
-	Id                   int64
-	DoctorAvailabilityId int64
-	StartTime            time.Time
-	EndTime              time.Time
-	PatientProblem       string
-	Status               string
-	PaymentType          string
-	PaymentAmount        float64
-	CreatedAt            time.Time
-	UpdatedAt            time.Time
-	DeletedAt            time.Time
+	Id              int64
+	AppointmentId   int64
+	PatientId       string
+	DoctorId        string
+	DepartmentId    string
+	BranchId        string
+	AppointmentDate time.Time
+	StartedAt       time.Time
+	EndedAt         time.Time
+	PatientProblem  string
+	Outcome         string
+	PaymentType     string
+	PaymentAmount   billing.Amount
+	Currency        string
+	InvoiceId       string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       time.Time
 }
 
 type ArchivesType struct {
@@ -21,35 +49,40 @@ type ArchivesType struct {
 	Archives []*Archive
 }
 
+// CreatedArchive closes out an appointment. Zero StartedAt and EndedAt default
+// to the booked time; with no PaymentType the payment is taken from the
+// appointment's invoice.
 type CreatedArchive struct {
-	DoctorAvailabilityId int64
-	StartTime            time.Time
-	EndTime              time.Time
-	PatientProblem       string
-	Status               string
-	PaymentType          string
-	PaymentAmount        float64
+	AppointmentId  int64
+	StartedAt      time.Time
+	EndedAt        time.Time
+	PatientProblem string
+	Outcome        string
+	PaymentType    string
+	PaymentAmount  billing.Amount
 }
 
 type UpdateArchive struct {
-	Field                string
-	Value                string
-	DoctorAvailabilityId int64
-	StartTime            time.Time
-	EndTime              time.Time
-	PatientProblem       string
-	Status               string
-	PaymentType          string
-	PaymentAmount        float64
+	Field          string
+	Value          string
+	StartedAt      time.Time
+	EndedAt        time.Time
+	PatientProblem string
+	Outcome        string
+	PaymentType    string
+	PaymentAmount  billing.Amount
 }
 
 type GetAllArchives struct {
-	Page         uint64
-	Limit        uint64
-	DeleteStatus bool
-	Field        string
-	Value        string
-	OrderBy      string
+	Page          uint64
+	Limit         uint64
+	DeleteStatus  bool
+	Field         string
+	Value         string
+	OrderBy       string
+	PatientId     string
+	DoctorId      string
+	AppointmentId int64
 }
 
 type FieldValueReq struct {
@@ -0,0 +1,50 @@
+package billing
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Amount is money in minor units (tiyin, cents), so sums stay exact.
+type Amount int64
+
+var errAmount = errors.New("must be a non-negative amount with at most two decimals, e.g. 150000.00")
+
+// ParseAmount reads amounts written as "150000", "150000.5" or "150000.50".
+func ParseAmount(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+
+	whole, fraction, _ := strings.Cut(s, ".")
+	if whole == "" || len(fraction) > 2 || strings.HasPrefix(whole, "-") || strings.HasPrefix(whole, "+") {
+		return 0, errAmount
+	}
+	fraction += strings.Repeat("0", 2-len(fraction))
+
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, errAmount
+	}
+	minor, err := strconv.ParseInt(fraction, 10, 64)
+	if err != nil || minor < 0 {
+		return 0, errAmount
+	}
+	if units > (1<<63-1-minor)/100 {
+		return 0, errAmount
+	}
+
+	return Amount(units*100 + minor), nil
+}
+
+// String formats the amount the way NUMERIC(14, 2) columns hold it.
+func (a Amount) String() string {
+	sign := ""
+	if a < 0 {
+		sign, a = "-", -a
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, a/100, a%100)
+}
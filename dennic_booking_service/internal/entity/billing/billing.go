@@ -0,0 +1,208 @@
+package billing
+
+import (
+	"time"
+)
+
+// Statuses of an invoice. Everything but void follows from the amounts paid
+// and refunded, see Invoice.Recalculate.
+const (
+	StatusIssued        = "issued"
+	StatusPartiallyPaid = "partially_paid"
+	StatusPaid          = "paid"
+	StatusRefunded      = "refunded"
+	StatusVoid          = "void"
+)
+
+// Kinds of invoice lines. The service line is priced from the booked doctor
+// service, procedures are added at the desk.
+const (
+	ItemService   = "service"
+	ItemProcedure = "procedure"
+)
+
+// Kinds of cash desk transactions.
+const (
+	KindPayment = "payment"
+	KindRefund  = "refund"
+)
+
+// Payment methods.
+const (
+	MethodCash      = "cash"
+	MethodCard      = "card"
+	MethodInsurance = "insurance"
+	// MethodOnline is recorded by prepayments through a payment provider,
+	// never at the desk.
+	MethodOnline = "online"
+)
+
+// Methods lists every payment method accepted at the desk.
+var Methods = []string{MethodCash, MethodCard, MethodInsurance}
+
+type Item struct {
+	Id              int64
+	Kind            string
+	Description     string
+	DoctorServiceId string
+	Quantity        int64
+	UnitPrice       Amount
+	Amount          Amount
+}
+
+// Payment is a payment taken or a refund given at the cash desk.
+type Payment struct {
+	Id        string
+	InvoiceId string
+	Kind      string
+	Method    string
+	Amount    Amount
+	CashierId string
+	BranchId  string
+	Reference string
+	CreatedAt time.Time
+}
+
+type Invoice struct {
+	Id            string
+	Number        string
+	AppointmentId int64
+	PatientId     string
+	BranchId      string
+	Currency      string
+	Subtotal      Amount
+	Discount      Amount
+	Total         Amount
+	Paid          Amount
+	Refunded      Amount
+	Status        string
+	CreatedBy     string
+	Items         []*Item
+	Payments      []*Payment
+	IssuedAt      time.Time
+	VoidedAt      time.Time
+	UpdatedAt     time.Time
+}
+
+// Net is what the patient has paid after refunds.
+func (i *Invoice) Net() Amount {
+	return i.Paid - i.Refunded
+}
+
+// Balance is what is still due. Void and fully refunded invoices are closed.
+func (i *Invoice) Balance() Amount {
+	if i.Status == StatusVoid || i.Status == StatusRefunded || i.Net() >= i.Total {
+		return 0
+	}
+	return i.Total - i.Net()
+}
+
+// Recalculate derives the totals from the items and discount and the status
+// from the amounts paid and refunded.
+func (i *Invoice) Recalculate() {
+	i.Subtotal = 0
+	for _, item := range i.Items {
+		item.Amount = item.UnitPrice * Amount(item.Quantity)
+		i.Subtotal += item.Amount
+	}
+	i.Total = i.Subtotal - i.Discount
+	if i.Total < 0 {
+		i.Total = 0
+	}
+
+	switch {
+	case i.Status == StatusVoid:
+	case i.Net() == 0 && i.Refunded > 0:
+		i.Status = StatusRefunded
+	case i.Net() >= i.Total:
+		i.Status = StatusPaid
+	case i.Net() > 0:
+		i.Status = StatusPartiallyPaid
+	default:
+		i.Status = StatusIssued
+	}
+}
+
+type InvoicesType struct {
+	Count    int64
+	Invoices []*Invoice
+}
+
+type CreateInvoice struct {
+	Id            string
+	AppointmentId int64
+	Discount      Amount
+	Items         []*Item
+	CreatedBy     string
+}
+
+type AddItem struct {
+	InvoiceId string
+	Item      *Item
+}
+
+type SetDiscount struct {
+	InvoiceId string
+	Discount  Amount
+}
+
+// Transaction is a payment or refund to record against an invoice.
+type Transaction struct {
+	Id        string
+	InvoiceId string
+	Kind      string
+	Method    string
+	Amount    Amount
+	CashierId string
+	Reference string
+}
+
+type GetAllInvoices struct {
+	Page          uint64
+	Limit         uint64
+	PatientId     string
+	BranchId      string
+	AppointmentId int64
+	Status        string
+}
+
+type FieldValueReq struct {
+	Field string
+	Value string
+}
+
+type CashDeskReportReq struct {
+	BranchId  string
+	Date      time.Time
+	CashierId string
+}
+
+// CashDeskRow sums one cashier's transactions in one method and currency.
+type CashDeskRow struct {
+	CashierId    string
+	Method       string
+	Currency     string
+	Payments     Amount
+	Refunds      Amount
+	PaymentCount int64
+	RefundCount  int64
+}
+
+type CashDeskTotal struct {
+	Currency string
+	Payments Amount
+	Refunds  Amount
+}
+
+type CashDeskReport struct {
+	BranchId string
+	Date     time.Time
+	Rows     []*CashDeskRow
+	Totals   []*CashDeskTotal
+}
+
+// Receipt is a rendered invoice receipt.
+type Receipt struct {
+	FileName string
+	Content  []byte
+}
@@ -0,0 +1,73 @@
+package billing
+
+import (
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	valid := map[string]Amount{
+		"":          0,
+		"0":         0,
+		"150000":    15000000,
+		"150000.5":  15000050,
+		"150000.50": 15000050,
+		"0.07":      7,
+		" 12.30 ":   1230,
+	}
+	for s, want := range valid {
+		got, err := ParseAmount(s)
+		if err != nil || got != want {
+			t.Errorf("ParseAmount(%q) = %d, %v; want %d", s, got, err, want)
+		}
+	}
+
+	for _, s := range []string{"-1", "+1", "1.234", ".5", "1,50", "abc", "1.-5", "99999999999999999999"} {
+		if _, err := ParseAmount(s); err == nil {
+			t.Errorf("ParseAmount(%q) accepted", s)
+		}
+	}
+
+	if s := Amount(15000050).String(); s != "150000.50" {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestRecalculate(t *testing.T) {
+	invoice := &Invoice{
+		Discount: 1000,
+		Items: []*Item{
+			{Kind: ItemService, Quantity: 1, UnitPrice: 10000},
+			{Kind: ItemProcedure, Quantity: 2, UnitPrice: 2500},
+		},
+	}
+	invoice.Recalculate()
+	if invoice.Subtotal != 15000 || invoice.Total != 14000 || invoice.Status != StatusIssued {
+		t.Fatalf("got subtotal %s, total %s, status %s", invoice.Subtotal, invoice.Total, invoice.Status)
+	}
+
+	steps := []struct {
+		paid, refunded Amount
+		status         string
+		balance        Amount
+	}{
+		{4000, 0, StatusPartiallyPaid, 10000},
+		{14000, 0, StatusPaid, 0},
+		{14000, 4000, StatusPartiallyPaid, 4000},
+		{14000, 14000, StatusRefunded, 0},
+	}
+	for _, step := range steps {
+		invoice.Paid, invoice.Refunded = step.paid, step.refunded
+		invoice.Recalculate()
+		if invoice.Status != step.status || invoice.Balance() != step.balance {
+			t.Errorf("paid %s refunded %s: got %s with balance %s, want %s with %s",
+				step.paid, step.refunded, invoice.Status, invoice.Balance(), step.status, step.balance)
+		}
+	}
+
+	invoice.Discount = 20000
+	invoice.Paid, invoice.Refunded = 0, 0
+	invoice.Recalculate()
+	if invoice.Total != 0 || invoice.Status != StatusPaid {
+		t.Errorf("fully discounted invoice: total %s, status %s", invoice.Total, invoice.Status)
+	}
+}
@@ -5,10 +5,25 @@ import (
 	"time"
 )
 
+const (
+	ModalityOffline = "offline"
+	ModalityOnline  = "online"
+)
+
+// Visit outcomes. A review of the doctor is allowed only after StatusAttended.
+const (
+	StatusScheduled = "scheduled"
+	StatusAttended  = "attended"
+	StatusCancelled = "cancelled"
+	StatusNoShow    = "no_show"
+)
+
 type Appointment struct {
 	Id              int64
 	DepartmentId    string
+	BranchId        string
 	DoctorId        string
+	ResourceId      string
 	PatientId       string
 	AppointmentDate date.Date
 	AppointmentTime time.Time
@@ -16,6 +31,13 @@ type Appointment struct {
 	Key             string
 	ExpiresAt       time.Time
 	PatientStatus   bool
+	Modality        string
+	DoctorServiceId string
+	Price           string
+	Currency        string
+	VideoRoomId     string
+	VideoProvider   string
+	Status          string
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	DeletedAt       time.Time
@@ -28,7 +50,9 @@ type AppointmentsType struct {
 
 type CreateAppointment struct {
 	DepartmentId    string
+	BranchId        string
 	DoctorId        string
+	ResourceId      string
 	PatientId       string
 	AppointmentDate date.Date
 	AppointmentTime time.Time
@@ -36,6 +60,10 @@ type CreateAppointment struct {
 	Key             string
 	ExpiresAt       time.Time
 	PatientStatus   bool
+	Modality        string
+	DoctorServiceId string
+	Price           string
+	Currency        string
 }
 
 type UpdateAppointment struct {
@@ -56,6 +84,9 @@ type GetAllAppointment struct {
 	Field        string
 	Value        string
 	OrderBy      string
+	BranchId     string
+	DoctorId     string
+	PatientId    string
 }
 
 type FieldValueReq struct {
@@ -67,3 +98,47 @@ type FieldValueReq struct {
 type StatusRes struct {
 	Status bool
 }
+
+type ConfirmAppointment struct {
+	Id            int64
+	VideoRoomId   string
+	VideoProvider string
+}
+
+type JoinLinkReq struct {
+	AppointmentId int64
+	ParticipantId string
+}
+
+type JoinLink struct {
+	Url       string
+	RoomId    string
+	Provider  string
+	NotBefore time.Time
+	ExpiresAt time.Time
+}
+
+type AppointmentStatusReq struct {
+	Id     int64
+	Status string
+}
+
+// Appointment events, published to other services whenever a booking changes.
+const (
+	EventCreated       = "appointment.created"
+	EventUpdated       = "appointment.updated"
+	EventConfirmed     = "appointment.confirmed"
+	EventStatusChanged = "appointment.status_changed"
+	EventDeleted       = "appointment.deleted"
+)
+
+// Event is a change of an appointment. StartsAt is its start in the server's
+// local time, the zone appointments are booked in; PreviousStartsAt is the
+// start an updated appointment was moved from, zero when it did not move.
+type Event struct {
+	Type             string
+	Appointment      *Appointment
+	StartsAt         time.Time
+	PreviousStartsAt time.Time
+	OccurredAt       time.Time
+}
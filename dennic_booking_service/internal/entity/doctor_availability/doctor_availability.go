@@ -8,6 +8,7 @@ import (
 type DoctorAvailability struct {
 	Id           int64
 	DepartmentId string
+	BranchId     string
 	DoctorId     string
 	DoctorDate   date.Date
 	StartTime    time.Time
@@ -25,6 +26,7 @@ type DoctorAvailabilityType struct {
 
 type CreateDoctorAvailability struct {
 	DepartmentId string
+	BranchId     string
 	DoctorId     string
 	DoctorDate   date.Date
 	StartTime    time.Time
@@ -36,6 +38,7 @@ type UpdateDoctorAvailability struct {
 	Field        string
 	Value        string
 	DepartmentId string
+	BranchId     string
 	DoctorId     string
 	DoctorDate   date.Date
 	StartTime    time.Time
@@ -56,6 +59,8 @@ type GetAllReq struct {
 	Field        string
 	Value        string
 	OrderBy      string
+	BranchId     string
+	DoctorDate   string
 }
 
 type StatusRes struct {
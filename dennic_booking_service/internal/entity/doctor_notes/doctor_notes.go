@@ -43,6 +43,7 @@ type GetAllNotes struct {
 	Field        string
 	Value        string
 	OrderBy      string
+	PatientId    string
 }
 
 type FieldValueReq struct {
@@ -0,0 +1,53 @@
+package documents
+
+import (
+	"time"
+)
+
+// Types of documents kept in a patient's vault.
+const (
+	TypeScan             = "scan"
+	TypeXray             = "xray"
+	TypeDischargeSummary = "discharge_summary"
+	TypeLabReport        = "lab_report"
+	TypeReferral         = "referral"
+	TypeOther            = "other"
+)
+
+// Types lists every document type the vault accepts.
+var Types = []string{TypeScan, TypeXray, TypeDischargeSummary, TypeLabReport, TypeReferral, TypeOther}
+
+// Document is the metadata of a file stored in a private bucket. The file
+// itself is only reachable through a short-lived presigned URL.
+type Document struct {
+	Id            string
+	PatientId     string
+	AppointmentId int64
+	Type          string
+	Title         string
+	FileName      string
+	ContentType   string
+	SizeBytes     int64
+	Bucket        string
+	ObjectKey     string
+	UploadedBy    string
+	CreatedAt     time.Time
+}
+
+type DocumentsType struct {
+	Count     int64
+	Documents []*Document
+}
+
+type GetAllDocuments struct {
+	Page          uint64
+	Limit         uint64
+	PatientId     string
+	AppointmentId int64
+	Type          string
+}
+
+type FieldValueReq struct {
+	Field string
+	Value string
+}
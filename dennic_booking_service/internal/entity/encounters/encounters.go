@@ -0,0 +1,91 @@
+package encounters
+
+import (
+	"time"
+)
+
+// Allergy severities.
+const (
+	SeverityMild     = "mild"
+	SeverityModerate = "moderate"
+	SeveritySevere   = "severe"
+)
+
+// Vitals measured during the visit; a zero value means not measured.
+type Vitals struct {
+	TemperatureC     float64 `json:"temperature_c,omitempty"`
+	PulseBpm         int32   `json:"pulse_bpm,omitempty"`
+	SystolicMmHg     int32   `json:"systolic_mmhg,omitempty"`
+	DiastolicMmHg    int32   `json:"diastolic_mmhg,omitempty"`
+	RespiratoryRate  int32   `json:"respiratory_rate,omitempty"`
+	OxygenSaturation int32   `json:"oxygen_saturation,omitempty"`
+	WeightKg         float64 `json:"weight_kg,omitempty"`
+	HeightCm         float64 `json:"height_cm,omitempty"`
+}
+
+type Diagnosis struct {
+	Icd10Code   string
+	Description string
+	Primary     bool
+}
+
+type Allergy struct {
+	Substance string
+	Reaction  string
+	Severity  string
+}
+
+type ChronicCondition struct {
+	Icd10Code   string
+	Description string
+	OnsetDate   time.Time
+}
+
+type Procedure struct {
+	Code        string
+	Description string
+}
+
+// Encounter is the clinical record of one appointment.
+type Encounter struct {
+	Id                string
+	AppointmentId     int64
+	PatientId         string
+	DoctorId          string
+	EncounteredAt     time.Time
+	ChiefComplaint    string
+	Vitals            Vitals
+	Diagnoses         []*Diagnosis
+	Allergies         []*Allergy
+	ChronicConditions []*ChronicCondition
+	Procedures        []*Procedure
+	FollowUpPlan      string
+	FollowUpDate      time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         time.Time
+}
+
+type FieldValueReq struct {
+	Field        string
+	Value        string
+	DeleteStatus bool
+}
+
+type TimelineReq struct {
+	PatientId string
+	Page      uint64
+	Limit     uint64
+	From      time.Time
+	To        time.Time
+}
+
+// Timeline lists a patient's encounters, the latest first.
+type Timeline struct {
+	Count      int64
+	Encounters []*Encounter
+}
+
+type StatusRes struct {
+	Status bool
+}
@@ -36,6 +36,32 @@ func NewErrConflict(text string) *ErrConflict {
 	return &ErrConflict{text}
 }
 
+// error permission denied
+type ErrPermissionDenied struct {
+	name string
+}
+
+func (e *ErrPermissionDenied) Error() string {
+	return "access to " + e.name + " denied"
+}
+
+func NewErrPermissionDenied(text string) *ErrPermissionDenied {
+	return &ErrPermissionDenied{text}
+}
+
+// error failed precondition
+type ErrFailedPrecondition struct {
+	reason string
+}
+
+func (e *ErrFailedPrecondition) Error() string {
+	return e.reason
+}
+
+func NewErrFailedPrecondition(reason string) *ErrFailedPrecondition {
+	return &ErrFailedPrecondition{reason}
+}
+
 // error validation
 type ErrValidation struct {
 	Err    error
@@ -0,0 +1,76 @@
+package history
+
+import (
+	"booking_service/internal/entity/archive"
+	"booking_service/internal/entity/doctor_notes"
+	"booking_service/internal/entity/encounters"
+	"booking_service/internal/entity/lab_orders"
+	"booking_service/internal/entity/patients"
+	"booking_service/internal/entity/prescriptions"
+	"time"
+)
+
+// Formats of a medical history export.
+const (
+	FormatFHIR = "fhir"
+)
+
+// History is what the clinic holds on a patient. Lab orders are only those
+// released to the patient.
+type History struct {
+	Patient       *patients.Patient
+	Visits        []*archive.Archive
+	Encounters    []*encounters.Encounter
+	Notes         []*doctor_notes.DoctorNote
+	Prescriptions []*prescriptions.Prescription
+	LabOrders     []*lab_orders.LabOrder
+	External      []*ExternalRecord
+}
+
+// ExternalRecord is a resource imported from another clinic's export. It is
+// kept as received, re-pointed at the patient it was imported for.
+type ExternalRecord struct {
+	Id           string
+	PatientId    string
+	Source       string
+	ResourceType string
+	ExternalId   string
+	OccurredAt   time.Time
+	Summary      string
+	Resource     []byte
+	ImportedBy   string
+	ImportedAt   time.Time
+}
+
+type ExportReq struct {
+	PatientId string
+	Format    string
+}
+
+// ExportFile is a rendered medical history.
+type ExportFile struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+type ImportReq struct {
+	Format     string
+	Content    []byte
+	Source     string
+	ImportedBy string
+}
+
+// Imported is what an export of another clinic carries: the patient and the
+// rest of the resources. Skipped counts resources of unsupported types.
+type Imported struct {
+	Patient *patients.CreatedPatient
+	Records []*ExternalRecord
+	Skipped int64
+}
+
+type ImportRes struct {
+	Patient  *patients.Patient
+	Imported int64
+	Skipped  int64
+}
@@ -0,0 +1,269 @@
+package insurance
+
+import (
+	"booking_service/internal/entity/billing"
+	"time"
+)
+
+// Statuses of a policy.
+const (
+	PolicyActive    = "active"
+	PolicyCancelled = "cancelled"
+)
+
+// Kinds of coverage rules. A percentage rule covers that share of the price;
+// a co-pay rule leaves the patient a fixed amount and covers the rest.
+const (
+	CoveragePercentage = "percentage"
+	CoverageCopay      = "copay"
+)
+
+// Statuses of a claim. Pending claims go to the insurer in an export batch
+// and are submitted from then on.
+const (
+	ClaimPending   = "pending"
+	ClaimSubmitted = "submitted"
+	ClaimApproved  = "approved"
+	ClaimRejected  = "rejected"
+	ClaimPaid      = "paid"
+)
+
+// ClaimTransitions lists the statuses a claim may move to from each status.
+var ClaimTransitions = map[string][]string{
+	ClaimPending:   {ClaimRejected},
+	ClaimSubmitted: {ClaimApproved, ClaimRejected},
+	ClaimApproved:  {ClaimPaid, ClaimRejected},
+}
+
+// Formats of a claims export.
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+type Insurer struct {
+	Id          string
+	Name        string
+	Code        string
+	Email       string
+	PhoneNumber string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type InsurersType struct {
+	Count    int64
+	Insurers []*Insurer
+}
+
+// Policy covers a patient with an insurer. AnnualLimit caps what the insurer
+// pays for the patient in a calendar year; zero means no cap.
+type Policy struct {
+	Id           string
+	InsurerId    string
+	PatientId    string
+	PolicyNumber string
+	ValidFrom    time.Time
+	ValidTo      time.Time
+	AnnualLimit  billing.Amount
+	Currency     string
+	Status       string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Covers reports whether the policy is in force on the day.
+func (p *Policy) Covers(day time.Time) bool {
+	return p.Status == PolicyActive && !day.Before(p.ValidFrom) && !day.After(p.ValidTo)
+}
+
+type PoliciesType struct {
+	Count    int64
+	Policies []*Policy
+}
+
+// CoverageRule is what an insurer pays for a doctor service. AnnualLimit caps
+// the insurer's share for the service per policy and calendar year; zero
+// means no cap.
+type CoverageRule struct {
+	Id              string
+	InsurerId       string
+	DoctorServiceId string
+	Kind            string
+	Percentage      int64
+	Copay           billing.Amount
+	AnnualLimit     billing.Amount
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Split divides the price into the insurer's share and the patient's co-pay.
+func (r *CoverageRule) Split(price billing.Amount) (covered, copay billing.Amount) {
+	switch r.Kind {
+	case CoveragePercentage:
+		covered = price * billing.Amount(r.Percentage) / 100
+	case CoverageCopay:
+		covered = price - r.Copay
+	}
+	if covered < 0 {
+		covered = 0
+	}
+	if covered > price {
+		covered = price
+	}
+	return covered, price - covered
+}
+
+type CoverageRulesType struct {
+	Count int64
+	Rules []*CoverageRule
+}
+
+// Usage is what the insurer has already taken on in the year of a service.
+type Usage struct {
+	Policy  billing.Amount
+	Service billing.Amount
+}
+
+// Cover applies the rule and what is left of the annual limits to the price.
+func Cover(policy *Policy, rule *CoverageRule, price billing.Amount, used Usage) (covered, copay billing.Amount) {
+	covered, _ = rule.Split(price)
+	if policy.AnnualLimit > 0 {
+		covered = minAmount(covered, maxAmount(policy.AnnualLimit-used.Policy, 0))
+	}
+	if rule.AnnualLimit > 0 {
+		covered = minAmount(covered, maxAmount(rule.AnnualLimit-used.Service, 0))
+	}
+	return covered, price - covered
+}
+
+func minAmount(a, b billing.Amount) billing.Amount {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxAmount(a, b billing.Amount) billing.Amount {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// EligibilityReq asks whether a patient's insurance pays for a service. With
+// no PolicyId the patient's first policy in force that covers it is used.
+type EligibilityReq struct {
+	PatientId       string
+	PolicyId        string
+	DoctorServiceId string
+	Price           billing.Amount
+	Currency        string
+	Date            time.Time
+}
+
+type Eligibility struct {
+	Eligible  bool
+	Reason    string
+	PolicyId  string
+	InsurerId string
+	Price     billing.Amount
+	Covered   billing.Amount
+	Copay     billing.Amount
+	Currency  string
+}
+
+// Claim asks an insurer to pay its share of an appointment.
+type Claim struct {
+	Id              string
+	InsurerId       string
+	PolicyId        string
+	PatientId       string
+	AppointmentId   int64
+	InvoiceId       string
+	DoctorServiceId string
+	ServiceDate     time.Time
+	Billed          billing.Amount
+	Covered         billing.Amount
+	Copay           billing.Amount
+	Approved        billing.Amount
+	Currency        string
+	Status          string
+	StatusReason    string
+	BatchId         string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type ClaimsType struct {
+	Count  int64
+	Claims []*Claim
+}
+
+type CreateClaim struct {
+	Id            string
+	AppointmentId int64
+	PolicyId      string
+}
+
+type ClaimStatusReq struct {
+	Id       string
+	Status   string
+	Approved billing.Amount
+	Reason   string
+}
+
+type GetAllClaims struct {
+	Page      uint64
+	Limit     uint64
+	InsurerId string
+	PatientId string
+	BatchId   string
+	Status    string
+}
+
+// ClaimBatch is an export of an insurer's pending claims serviced in a period.
+type ClaimBatch struct {
+	Id         string
+	InsurerId  string
+	PeriodFrom time.Time
+	PeriodTo   time.Time
+	Format     string
+	ClaimCount int64
+	CreatedBy  string
+	CreatedAt  time.Time
+}
+
+type ExportClaims struct {
+	Id         string
+	InsurerId  string
+	PeriodFrom time.Time
+	PeriodTo   time.Time
+	Format     string
+	CreatedBy  string
+}
+
+// ExportFile is a rendered claim batch.
+type ExportFile struct {
+	Batch       *ClaimBatch
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+type GetAllReq struct {
+	Page      uint64
+	Limit     uint64
+	InsurerId string
+	PatientId string
+	Value     string
+}
+
+type FieldValueReq struct {
+	Field string
+	Value string
+}
+
+type StatusRes struct {
+	Status bool
+}
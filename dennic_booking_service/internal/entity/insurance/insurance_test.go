@@ -0,0 +1,61 @@
+package insurance
+
+import (
+	"booking_service/internal/entity/billing"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplit(t *testing.T) {
+	percentage := &CoverageRule{Kind: CoveragePercentage, Percentage: 80}
+	covered, copay := percentage.Split(15000050)
+	assert.Equal(t, billing.Amount(12000040), covered)
+	assert.Equal(t, billing.Amount(3000010), copay)
+
+	fixed := &CoverageRule{Kind: CoverageCopay, Copay: 2000000}
+	covered, copay = fixed.Split(15000000)
+	assert.Equal(t, billing.Amount(13000000), covered)
+	assert.Equal(t, billing.Amount(2000000), copay)
+
+	// a co-pay above the price leaves nothing to cover
+	covered, copay = fixed.Split(1000000)
+	assert.Equal(t, billing.Amount(0), covered)
+	assert.Equal(t, billing.Amount(1000000), copay)
+}
+
+func TestCover(t *testing.T) {
+	policy := &Policy{AnnualLimit: 50000000}
+	rule := &CoverageRule{Kind: CoveragePercentage, Percentage: 100, AnnualLimit: 20000000}
+
+	covered, copay := Cover(policy, rule, 15000000, Usage{})
+	assert.Equal(t, billing.Amount(15000000), covered)
+	assert.Equal(t, billing.Amount(0), copay)
+
+	// the service limit runs out first
+	covered, copay = Cover(policy, rule, 15000000, Usage{Policy: 15000000, Service: 15000000})
+	assert.Equal(t, billing.Amount(5000000), covered)
+	assert.Equal(t, billing.Amount(10000000), copay)
+
+	// then the policy limit
+	covered, _ = Cover(policy, rule, 15000000, Usage{Policy: 49000000})
+	assert.Equal(t, billing.Amount(1000000), covered)
+
+	covered, copay = Cover(policy, rule, 15000000, Usage{Policy: 60000000})
+	assert.Equal(t, billing.Amount(0), covered)
+	assert.Equal(t, billing.Amount(15000000), copay)
+}
+
+func TestPolicyCovers(t *testing.T) {
+	from, _ := time.Parse("2006-01-02", "2024-01-01")
+	to, _ := time.Parse("2006-01-02", "2024-12-31")
+	policy := &Policy{Status: PolicyActive, ValidFrom: from, ValidTo: to}
+
+	assert.True(t, policy.Covers(from))
+	assert.True(t, policy.Covers(to))
+	assert.False(t, policy.Covers(to.AddDate(0, 0, 1)))
+
+	policy.Status = PolicyCancelled
+	assert.False(t, policy.Covers(from))
+}
@@ -0,0 +1,104 @@
+package lab_orders
+
+import (
+	"time"
+)
+
+// Statuses of a lab order. Results reach the patient only once released.
+const (
+	StatusOrdered   = "ordered"
+	StatusResulted  = "resulted"
+	StatusReleased  = "released"
+	StatusCancelled = "cancelled"
+)
+
+// EventReleased is published to other services once the results of an order
+// are released to the patient.
+const EventReleased = "lab_order.released"
+
+// Flags of a result against its reference range.
+const (
+	FlagNormal = "normal"
+	FlagLow    = "low"
+	FlagHigh   = "high"
+)
+
+// Test is one test requested in an order.
+type Test struct {
+	Code string
+	Name string
+}
+
+// Result is a measured value. ReferenceLow and ReferenceHigh are parsed from
+// ReferenceRange, a nil bound is open.
+type Result struct {
+	TestCode       string
+	Analyte        string
+	Value          float64
+	Unit           string
+	ReferenceRange string
+	ReferenceLow   *float64
+	ReferenceHigh  *float64
+	Flag           string
+}
+
+// Attachment is a report uploaded by the lab.
+type Attachment struct {
+	FileName string
+	Url      string
+}
+
+type LabOrder struct {
+	Id            string
+	AppointmentId int64
+	PatientId     string
+	DoctorId      string
+	Status        string
+	Notes         string
+	Tests         []*Test
+	Results       []*Result
+	Attachments   []*Attachment
+	OrderedAt     time.Time
+	ResultedAt    time.Time
+	ReleasedAt    time.Time
+	CancelledAt   time.Time
+	UpdatedAt     time.Time
+}
+
+type LabOrdersType struct {
+	Count     int64
+	LabOrders []*LabOrder
+}
+
+type CreateLabOrder struct {
+	Id            string
+	AppointmentId int64
+	Notes         string
+	Tests         []*Test
+}
+
+type SubmitResults struct {
+	Id          string
+	Results     []*Result
+	Attachments []*Attachment
+}
+
+// StatusChange moves an order to Status when it is in one of the From statuses.
+type StatusChange struct {
+	Id     string
+	Status string
+	From   []string
+}
+
+type GetAllLabOrders struct {
+	Page      uint64
+	Limit     uint64
+	PatientId string
+	DoctorId  string
+	Status    string
+}
+
+type FieldValueReq struct {
+	Field string
+	Value string
+}
@@ -0,0 +1,182 @@
+package messages
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Sides of a conversation.
+const (
+	RolePatient = "patient"
+	RoleDoctor  = "doctor"
+)
+
+// Events published to other services as conversations change.
+const (
+	EventSent = "message.sent"
+	EventRead = "message.read"
+)
+
+// MaxBodyLength is the longest text a message may carry.
+const MaxBodyLength = 4000
+
+// Conversation is the thread of a patient and a doctor about one appointment,
+// or about none when AppointmentId is 0. Unread is how many messages of the
+// other side the reader has not read.
+type Conversation struct {
+	Id            string
+	PatientId     string
+	DoctorId      string
+	AppointmentId int64
+	LastMessageAt time.Time
+	CreatedAt     time.Time
+	Unread        int64
+	// DoctorAvailable and RepliesFrom tell the patient when to expect an
+	// answer; RepliesFrom is zero for a doctor without reply windows.
+	DoctorAvailable bool
+	RepliesFrom     time.Time
+}
+
+// Attachment is a file of a message kept in a private bucket, only reachable
+// through a short-lived presigned URL.
+type Attachment struct {
+	FileName    string
+	ContentType string
+	SizeBytes   int64
+	Bucket      string
+	ObjectKey   string
+}
+
+// Message is a text, an attachment or both sent to a conversation. ReadAt is
+// when the other side read it.
+type Message struct {
+	Id             string
+	ConversationId string
+	SenderId       string
+	SenderRole     string
+	Body           string
+	Attachment     *Attachment
+	ReadAt         time.Time
+	CreatedAt      time.Time
+}
+
+// Event is a change of a conversation published to other services: a
+// message sent to it or the messages of one side read by the other.
+type Event struct {
+	Type         string
+	Conversation *Conversation
+	// MessageId and SenderRole are set on message.sent, ReaderRole on
+	// message.read.
+	MessageId  string
+	SenderRole string
+	ReaderRole string
+	OccurredAt time.Time
+}
+
+// StartConversation opens the thread of a patient and a doctor, or finds
+// the one they already have. ReaderRole is the side starting it.
+type StartConversation struct {
+	Id            string
+	PatientId     string
+	DoctorId      string
+	AppointmentId int64
+	ReaderRole    string
+}
+
+type ConversationsType struct {
+	Count         int64
+	Conversations []*Conversation
+}
+
+type GetAllConversations struct {
+	Page       uint64
+	Limit      uint64
+	PatientId  string
+	DoctorId   string
+	ReaderRole string
+}
+
+type MessagesType struct {
+	Count    int64
+	Messages []*Message
+}
+
+type GetAllMessages struct {
+	Page           uint64
+	Limit          uint64
+	ConversationId string
+}
+
+// ReadReceipt tells how many messages of the other side a reader marked read.
+type ReadReceipt struct {
+	ConversationId string
+	ReaderRole     string
+	Read           int64
+	ReadAt         time.Time
+}
+
+// Clock is a time of day in minutes since midnight.
+type Clock int
+
+// ParseClock reads a time of day written as HH:MM.
+func ParseClock(s string) (Clock, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("time of day %q is not HH:MM", s)
+	}
+	return Clock(t.Hour()*60 + t.Minute()), nil
+}
+
+func (c Clock) String() string {
+	return fmt.Sprintf("%02d:%02d", int(c)/60, int(c)%60)
+}
+
+// ReplyWindow is a weekly stretch of time a doctor answers messages in, in
+// the clinic's zone. Windows do not cross midnight.
+type ReplyWindow struct {
+	Weekday  time.Weekday
+	StartsAt Clock
+	EndsAt   Clock
+}
+
+// ReplyHours are the reply windows of a doctor.
+type ReplyHours struct {
+	DoctorId string
+	Windows  []*ReplyWindow
+}
+
+// NextReply is when a doctor answering within windows answers a message sent
+// at now: now itself within a window, the start of the next window otherwise.
+// The windows are read in the zone of now. It is false without windows.
+func NextReply(windows []*ReplyWindow, now time.Time) (time.Time, bool) {
+	if len(windows) == 0 {
+		return time.Time{}, false
+	}
+
+	sorted := make([]*ReplyWindow, len(windows))
+	copy(sorted, windows)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].StartsAt < sorted[j].StartsAt
+	})
+
+	minute := Clock(now.Hour()*60 + now.Minute())
+	for day := 0; day <= 7; day++ {
+		date := now.AddDate(0, 0, day)
+		for _, window := range sorted {
+			if window.Weekday != date.Weekday() {
+				continue
+			}
+			if day == 0 && window.StartsAt <= minute && minute < window.EndsAt {
+				return now, true
+			}
+			if day == 0 && window.StartsAt <= minute {
+				continue
+			}
+			return time.Date(date.Year(), date.Month(), date.Day(),
+				int(window.StartsAt)/60, int(window.StartsAt)%60, 0, 0, now.Location()), true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,64 @@
+package messages
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseClock(t *testing.T) {
+	valid := map[string]Clock{
+		"00:00": 0,
+		"09:30": 570,
+		"23:59": 1439,
+	}
+	for s, want := range valid {
+		got, err := ParseClock(s)
+		if err != nil || got != want {
+			t.Errorf("ParseClock(%q) = %d, %v; want %d", s, got, err, want)
+		}
+		if got.String() != s {
+			t.Errorf("Clock(%d).String() = %q; want %q", got, got.String(), s)
+		}
+	}
+
+	for _, s := range []string{"", "9", "24:00", "12:60", "9am"} {
+		if _, err := ParseClock(s); err == nil {
+			t.Errorf("ParseClock(%q) accepted", s)
+		}
+	}
+}
+
+func TestNextReply(t *testing.T) {
+	tashkent := time.FixedZone("Asia/Tashkent", 5*60*60)
+	windows := []*ReplyWindow{
+		{Weekday: time.Monday, StartsAt: 14 * 60, EndsAt: 18 * 60},
+		{Weekday: time.Monday, StartsAt: 9 * 60, EndsAt: 12 * 60},
+		{Weekday: time.Wednesday, StartsAt: 9 * 60, EndsAt: 12 * 60},
+	}
+	at := func(day, hour, minute int) time.Time {
+		// 2026-10-19 is a Monday
+		return time.Date(2026, 10, day, hour, minute, 0, 0, tashkent)
+	}
+
+	steps := []struct {
+		now, want time.Time
+	}{
+		{now: at(19, 8, 0), want: at(19, 9, 0)},
+		{now: at(19, 9, 0), want: at(19, 9, 0)},
+		{now: at(19, 11, 59), want: at(19, 11, 59)},
+		{now: at(19, 12, 0), want: at(19, 14, 0)},
+		{now: at(19, 18, 0), want: at(21, 9, 0)},
+		{now: at(22, 10, 0), want: at(26, 9, 0)},
+		{now: at(26, 10, 0), want: at(26, 10, 0)},
+	}
+	for _, step := range steps {
+		got, ok := NextReply(windows, step.now)
+		if !ok || !got.Equal(step.want) {
+			t.Errorf("NextReply at %s = %s, %v; want %s", step.now, got, ok, step.want)
+		}
+	}
+
+	if _, ok := NextReply(nil, at(19, 10, 0)); ok {
+		t.Error("NextReply without windows found a reply time")
+	}
+}
@@ -5,6 +5,15 @@ import (
 	"time"
 )
 
+// Relationship of a patient profile to the user account that manages it.
+const (
+	RelationshipSelf   = "self"
+	RelationshipChild  = "child"
+	RelationshipParent = "parent"
+	RelationshipSpouse = "spouse"
+	RelationshipOther  = "other"
+)
+
 // datient
 
 type Patient struct {
@@ -22,6 +31,10 @@ type Patient struct {
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 	DeletedAt      time.Time
+	UserId         string
+	Relationship   string
+	Consent        bool
+	ConsentAt      time.Time
 }
 
 type PatientsType struct {
@@ -41,6 +54,9 @@ type CreatedPatient struct {
 	Country        string
 	Address        string
 	PatientProblem string
+	UserId         string
+	Relationship   string
+	Consent        bool
 }
 
 type UpdatePatient struct {
@@ -55,6 +71,9 @@ type UpdatePatient struct {
 	Country        string
 	Address        string
 	PatientProblem string
+	UserId         string
+	Relationship   string
+	Consent        bool
 }
 
 type UpdatePhoneNumber struct {
@@ -67,6 +86,7 @@ type FieldValueReq struct {
 	Field        string
 	Value        string
 	DeleteStatus bool
+	UserId       string
 }
 
 type GetAllPatients struct {
@@ -76,6 +96,7 @@ type GetAllPatients struct {
 	Field        string
 	Value        string
 	OrderBy      string
+	UserId       string
 }
 
 type StatusRes struct {
@@ -0,0 +1,106 @@
+package payments
+
+import (
+	"booking_service/internal/entity/billing"
+	"errors"
+	"time"
+)
+
+// ErrInvalidSignature is returned for a webhook the provider did not sign.
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Statuses of a payment intent. Only a pending intent moves on; a payment
+// that succeeds after its booking hold was released is left to be refunded.
+const (
+	StatusPending        = "pending"
+	StatusSucceeded      = "succeeded"
+	StatusFailed         = "failed"
+	StatusExpired        = "expired"
+	StatusRefundRequired = "refund_required"
+)
+
+// Outcomes reported by a provider webhook.
+const (
+	EventSucceeded = "succeeded"
+	EventFailed    = "failed"
+)
+
+// Intent is a prepayment of a held appointment through an online provider.
+type Intent struct {
+	Id             string
+	AppointmentId  int64
+	PatientId      string
+	Amount         billing.Amount
+	Currency       string
+	Provider       string
+	ProviderRef    string
+	CheckoutURL    string
+	Status         string
+	FailureReason  string
+	IdempotencyKey string
+	InvoiceId      string
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type IntentsType struct {
+	Count   int64
+	Intents []*Intent
+}
+
+// CreateIntent is keyed by the client's idempotency key, so a retried
+// request returns the intent created the first time.
+type CreateIntent struct {
+	Id             string
+	AppointmentId  int64
+	PatientId      string
+	IdempotencyKey string
+	Provider       string
+	// ExpiresAt bounds the intent when the appointment holds no expiry.
+	ExpiresAt time.Time
+}
+
+// Checkout is the provider's payment session for an intent.
+type Checkout struct {
+	ProviderRef string
+	CheckoutURL string
+}
+
+// Webhook is a provider callback as received by the gateway.
+type Webhook struct {
+	Provider  string
+	Payload   []byte
+	Signature string
+}
+
+// Event is a verified webhook.
+type Event struct {
+	Id          string
+	ProviderRef string
+	Status      string
+	Amount      billing.Amount
+	Currency    string
+	Reason      string
+}
+
+// Outcome finishes a pending intent.
+type Outcome struct {
+	Id            string
+	Status        string
+	FailureReason string
+	InvoiceId     string
+}
+
+type GetAllIntents struct {
+	Page          uint64
+	Limit         uint64
+	PatientId     string
+	AppointmentId int64
+	Status        string
+}
+
+type FieldValueReq struct {
+	Field string
+	Value string
+}
@@ -0,0 +1,147 @@
+package prescriptions
+
+import (
+	"time"
+)
+
+// EventIssued is published to other services once a prescription is issued.
+const EventIssued = "prescription.issued"
+
+// Routes of administration.
+const (
+	RouteOral          = "oral"
+	RouteSublingual    = "sublingual"
+	RouteTopical       = "topical"
+	RouteInhaled       = "inhaled"
+	RouteNasal         = "nasal"
+	RouteOphthalmic    = "ophthalmic"
+	RouteOtic          = "otic"
+	RouteRectal        = "rectal"
+	RouteIntravenous   = "intravenous"
+	RouteIntramuscular = "intramuscular"
+	RouteSubcutaneous  = "subcutaneous"
+)
+
+// Kinds of warnings raised when a prescription is issued.
+const (
+	WarningAllergy     = "allergy"
+	WarningInteraction = "interaction"
+)
+
+// Medication is an entry of the catalogue doctors prescribe from.
+type Medication struct {
+	Id               string
+	Name             string
+	Form             string
+	Strength         string
+	ActiveIngredient string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        time.Time
+}
+
+type MedicationsType struct {
+	Count       int64
+	Medications []*Medication
+}
+
+type GetAllMedications struct {
+	Page   uint64
+	Limit  uint64
+	Search string
+}
+
+// Interaction between two active ingredients.
+type Interaction struct {
+	IngredientA string
+	IngredientB string
+	Severity    string
+	Description string
+}
+
+// Item is one prescribed medication. The catalogue entry is copied, so later
+// catalogue edits do not change an issued prescription.
+type Item struct {
+	MedicationId     string
+	MedicationName   string
+	Form             string
+	Strength         string
+	ActiveIngredient string
+	Dose             string
+	Frequency        string
+	DurationDays     int32
+	Route            string
+	Instructions     string
+}
+
+// Warning is stored with the prescription as JSONB.
+type Warning struct {
+	Kind        string `json:"kind"`
+	Severity    string `json:"severity"`
+	Description string `json:"description"`
+}
+
+type Prescription struct {
+	Id               string
+	AppointmentId    int64
+	PatientId        string
+	PatientName      string
+	PatientBirthDate time.Time
+	DoctorId         string
+	Items            []*Item
+	Warnings         []*Warning
+	VerificationCode string
+	Signature        string
+	IssuedAt         time.Time
+	RevokedAt        time.Time
+	CreatedAt        time.Time
+}
+
+type PrescriptionsType struct {
+	Count         int64
+	Prescriptions []*Prescription
+}
+
+type CreatePrescription struct {
+	AppointmentId int64
+	Items         []*Item
+}
+
+// Parties of the appointment a prescription is issued for.
+type Parties struct {
+	PatientId string
+	DoctorId  string
+}
+
+type GetAllPrescriptions struct {
+	Page      uint64
+	Limit     uint64
+	PatientId string
+	DoctorId  string
+}
+
+type FieldValueReq struct {
+	Field        string
+	Value        string
+	DeleteStatus bool
+}
+
+// Document is the printable form of a prescription.
+type Document struct {
+	FileName string
+	Content  []byte
+}
+
+// Verification is what a pharmacy sees for a verification code.
+type Verification struct {
+	Valid           bool
+	Revoked         bool
+	IssuedAt        time.Time
+	DoctorId        string
+	PatientInitials string
+	Items           []*Item
+}
+
+type StatusRes struct {
+	Status bool
+}
@@ -0,0 +1,118 @@
+// Package claimexport renders claim batches in the formats insurers accept.
+package claimexport
+
+import (
+	"booking_service/internal/entity/insurance"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const dateLayout = "2006-01-02"
+
+// Exporter -.
+type Exporter struct{}
+
+// New -.
+func New() *Exporter {
+	return &Exporter{}
+}
+
+type claimJSON struct {
+	Id              string `json:"id"`
+	PolicyId        string `json:"policy_id"`
+	PatientId       string `json:"patient_id"`
+	AppointmentId   int64  `json:"appointment_id"`
+	DoctorServiceId string `json:"doctor_service_id"`
+	ServiceDate     string `json:"service_date"`
+	Billed          string `json:"billed"`
+	Covered         string `json:"covered"`
+	Copay           string `json:"copay"`
+	Currency        string `json:"currency"`
+}
+
+type batchJSON struct {
+	BatchId    string      `json:"batch_id"`
+	InsurerId  string      `json:"insurer_id"`
+	PeriodFrom string      `json:"period_from"`
+	PeriodTo   string      `json:"period_to"`
+	CreatedAt  string      `json:"created_at"`
+	Claims     []claimJSON `json:"claims"`
+}
+
+var csvHeader = []string{
+	"claim_id", "policy_id", "patient_id", "appointment_id", "doctor_service_id",
+	"service_date", "billed", "covered", "copay", "currency",
+}
+
+// Render writes the batch's claims in the batch's format, one row per claim.
+func (e *Exporter) Render(batch *insurance.ClaimBatch, claims []*insurance.Claim) (*insurance.ExportFile, error) {
+	file := &insurance.ExportFile{
+		Batch:    batch,
+		FileName: fmt.Sprintf("claims-%s-%s.%s", batch.PeriodFrom.Format(dateLayout), batch.Id, batch.Format),
+	}
+
+	switch batch.Format {
+	case insurance.FormatCSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		if err := w.Write(csvHeader); err != nil {
+			return nil, err
+		}
+		for _, claim := range claims {
+			if err := w.Write([]string{
+				claim.Id,
+				claim.PolicyId,
+				claim.PatientId,
+				strconv.FormatInt(claim.AppointmentId, 10),
+				claim.DoctorServiceId,
+				claim.ServiceDate.Format(dateLayout),
+				claim.Billed.String(),
+				claim.Covered.String(),
+				claim.Copay.String(),
+				claim.Currency,
+			}); err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, err
+		}
+		file.ContentType, file.Content = "text/csv", buf.Bytes()
+	case insurance.FormatJSON:
+		doc := batchJSON{
+			BatchId:    batch.Id,
+			InsurerId:  batch.InsurerId,
+			PeriodFrom: batch.PeriodFrom.Format(dateLayout),
+			PeriodTo:   batch.PeriodTo.Format(dateLayout),
+			CreatedAt:  batch.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			Claims:     make([]claimJSON, 0, len(claims)),
+		}
+		for _, claim := range claims {
+			doc.Claims = append(doc.Claims, claimJSON{
+				Id:              claim.Id,
+				PolicyId:        claim.PolicyId,
+				PatientId:       claim.PatientId,
+				AppointmentId:   claim.AppointmentId,
+				DoctorServiceId: claim.DoctorServiceId,
+				ServiceDate:     claim.ServiceDate.Format(dateLayout),
+				Billed:          claim.Billed.String(),
+				Covered:         claim.Covered.String(),
+				Copay:           claim.Copay.String(),
+				Currency:        claim.Currency,
+			})
+		}
+		content, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		file.ContentType, file.Content = "application/json", content
+	default:
+		return nil, fmt.Errorf("unknown claims export format %q", batch.Format)
+	}
+
+	return file, nil
+}
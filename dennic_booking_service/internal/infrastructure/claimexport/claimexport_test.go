@@ -0,0 +1,57 @@
+package claimexport
+
+import (
+	"booking_service/internal/entity/insurance"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestRender(t *testing.T) {
+	batch := &insurance.ClaimBatch{
+		Id:         "b1",
+		InsurerId:  "i1",
+		PeriodFrom: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		PeriodTo:   time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		Format:     insurance.FormatCSV,
+	}
+	claims := []*insurance.Claim{
+		{Id: "c1", PolicyId: "p1", PatientId: "pt1", AppointmentId: 42, DoctorServiceId: "s1",
+			ServiceDate: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), Billed: 15000000, Covered: 12000000, Copay: 3000000, Currency: "UZS"},
+	}
+
+	file, err := New().Render(batch, claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.FileName != "claims-2024-03-01-b1.csv" || file.ContentType != "text/csv" {
+		t.Errorf("file %q %q", file.FileName, file.ContentType)
+	}
+	rows, err := csv.NewReader(bytes.NewReader(file.Content)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 || rows[1][3] != "42" || rows[1][7] != "120000.00" {
+		t.Errorf("rows %v", rows)
+	}
+
+	batch.Format = insurance.FormatJSON
+	file, err = New().Render(batch, claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc batchJSON
+	if err = json.Unmarshal(file.Content, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Claims) != 1 || doc.Claims[0].Copay != "30000.00" {
+		t.Errorf("doc %+v", doc)
+	}
+
+	batch.Format = "xml"
+	if _, err = New().Render(batch, claims); err == nil {
+		t.Error("unknown format rendered")
+	}
+}
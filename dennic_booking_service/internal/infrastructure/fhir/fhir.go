@@ -0,0 +1,456 @@
+// Package fhir converts a patient's medical history to and from FHIR R4
+// collection Bundles in JSON.
+package fhir
+
+import (
+	"booking_service/internal/entity/archive"
+	"booking_service/internal/entity/encounters"
+	"booking_service/internal/entity/history"
+	"booking_service/internal/entity/lab_orders"
+	"booking_service/internal/entity/prescriptions"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	contentType = "application/fhir+json"
+
+	// systemBase prefixes the clinic's own identifier and code systems.
+	systemBase = "urn:dennic"
+
+	systemLOINC          = "http://loinc.org"
+	systemICD10          = "http://hl7.org/fhir/sid/icd-10"
+	systemUCUM           = "http://unitsofmeasure.org"
+	systemActCode        = "http://terminology.hl7.org/CodeSystem/v3-ActCode"
+	systemObsCategory    = "http://terminology.hl7.org/CodeSystem/observation-category"
+	systemCondCategory   = "http://terminology.hl7.org/CodeSystem/condition-category"
+	systemInterpretation = "http://terminology.hl7.org/CodeSystem/v3-ObservationInterpretation"
+
+	// loincBloodGroup is the ABO and Rh group observation.
+	loincBloodGroup = "882-1"
+)
+
+// Codec -.
+type Codec struct{}
+
+// New -.
+func New() *Codec {
+	return &Codec{}
+}
+
+// builder collects the entries of a bundle; the first marshalling error sticks.
+type builder struct {
+	entries []entry
+	err     error
+}
+
+func (b *builder) add(resourceType, id string, resource interface{}) {
+	if b.err != nil {
+		return
+	}
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		b.err = fmt.Errorf("%s/%s: %w", resourceType, id, err)
+		return
+	}
+	b.entries = append(b.entries, entry{FullUrl: resourceType + "/" + id, Resource: raw})
+}
+
+func dateTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func concept(text string, codings ...coding) codeableConcept {
+	return codeableConcept{Coding: codings, Text: text}
+}
+
+func category(system, code string) []codeableConcept {
+	return []codeableConcept{{Coding: []coding{{System: system, Code: code}}}}
+}
+
+// encounterId names the FHIR Encounter of an appointment, so visits, clinical
+// encounters and what was recorded during them point at the same resource.
+func encounterId(appointmentId int64) string {
+	return "appointment-" + strconv.FormatInt(appointmentId, 10)
+}
+
+func encounterRef(appointmentId int64) *reference {
+	if appointmentId == 0 {
+		return nil
+	}
+	return &reference{Reference: "Encounter/" + encounterId(appointmentId)}
+}
+
+// Export renders the history as a collection Bundle generated at the time.
+func (c *Codec) Export(h *history.History, at time.Time) (*history.ExportFile, error) {
+	var (
+		b       builder
+		subject = reference{Reference: "Patient/" + h.Patient.Id}
+	)
+
+	p := h.Patient
+	b.add("Patient", p.Id, patientResource{
+		ResourceType: "Patient",
+		Id:           p.Id,
+		Identifier:   []identifier{{System: systemBase + ":patient", Value: p.Id}},
+		Name:         []humanName{{Family: p.LastName, Given: []string{p.FirstName}}},
+		Telecom:      []contactPoint{{System: "phone", Value: p.PhoneNumber, Use: "mobile"}},
+		Gender:       p.Gender,
+		BirthDate:    p.BirthDate.String(),
+		Address:      []address{{Text: p.Address, City: p.City, Country: p.Country}},
+	})
+	if p.BloodGroup != "" {
+		b.add("Observation", p.Id+"-blood-group", observationResource{
+			ResourceType: "Observation",
+			Id:           p.Id + "-blood-group",
+			Status:       "final",
+			Category:     category(systemObsCategory, "laboratory"),
+			Code:         concept("ABO and Rh group", coding{System: systemLOINC, Code: loincBloodGroup}),
+			Subject:      subject,
+			ValueString:  p.BloodGroup,
+		})
+	}
+
+	visited := make(map[int64]bool)
+	for _, visit := range h.Visits {
+		visited[visit.AppointmentId] = true
+		b.add("Encounter", encounterId(visit.AppointmentId), visitEncounter(visit, subject))
+	}
+	for _, enc := range h.Encounters {
+		if !visited[enc.AppointmentId] {
+			visited[enc.AppointmentId] = true
+			b.add("Encounter", encounterId(enc.AppointmentId), clinicalEncounter(enc, subject))
+		}
+		addEncounterDetails(&b, enc, subject)
+	}
+
+	for _, order := range h.LabOrders {
+		addLabResults(&b, order, subject)
+	}
+
+	for _, rx := range h.Prescriptions {
+		for i, item := range rx.Items {
+			id := fmt.Sprintf("rx-%s-%d", rx.Id, i+1)
+			b.add("MedicationRequest", id, medicationRequest(id, rx, item, subject, at))
+		}
+	}
+
+	for _, note := range h.Notes {
+		id := "note-" + strconv.FormatInt(note.Id, 10)
+		b.add("MedicationRequest", id, medicationRequestResource{
+			ResourceType:              "MedicationRequest",
+			Id:                        id,
+			Status:                    "unknown",
+			Intent:                    "order",
+			MedicationCodeableConcept: concept(note.Prescription),
+			Subject:                   subject,
+			Encounter:                 encounterRef(note.AppointmentId),
+			AuthoredOn:                dateTime(note.CreatedAt),
+			Requester:                 &reference{Reference: "Practitioner/" + note.DoctorId},
+		})
+	}
+
+	for _, record := range h.External {
+		raw, err := externalResource(record)
+		if err != nil {
+			return nil, err
+		}
+		b.entries = append(b.entries, entry{FullUrl: record.ResourceType + "/" + record.Id, Resource: raw})
+	}
+
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	content, err := json.MarshalIndent(bundle{
+		ResourceType: "Bundle",
+		Type:         "collection",
+		Timestamp:    dateTime(at),
+		Entry:        b.entries,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return &history.ExportFile{
+		FileName:    fmt.Sprintf("patient-%s-history.json", p.Id),
+		ContentType: contentType,
+		Content:     content,
+	}, nil
+}
+
+func visitEncounter(visit *archive.Archive, subject reference) encounterResource {
+	status := "finished"
+	if visit.Outcome != archive.OutcomeAttended {
+		status = "cancelled"
+	}
+	res := encounterResource{
+		ResourceType: "Encounter",
+		Id:           encounterId(visit.AppointmentId),
+		Extension:    []extension{{Url: systemBase + ":visit-outcome", ValueCode: visit.Outcome}},
+		Status:       status,
+		Class:        coding{System: systemActCode, Code: "AMB", Display: "ambulatory"},
+		Subject:      subject,
+		Period:       &period{Start: dateTime(visit.StartedAt), End: dateTime(visit.EndedAt)},
+	}
+	if visit.DoctorId != "" {
+		res.Participant = []participant{{Individual: reference{Reference: "Practitioner/" + visit.DoctorId}}}
+	}
+	if visit.PatientProblem != "" {
+		res.ReasonCode = []codeableConcept{concept(visit.PatientProblem)}
+	}
+	return res
+}
+
+func clinicalEncounter(enc *encounters.Encounter, subject reference) encounterResource {
+	res := encounterResource{
+		ResourceType: "Encounter",
+		Id:           encounterId(enc.AppointmentId),
+		Status:       "finished",
+		Class:        coding{System: systemActCode, Code: "AMB", Display: "ambulatory"},
+		Subject:      subject,
+		Participant:  []participant{{Individual: reference{Reference: "Practitioner/" + enc.DoctorId}}},
+		Period:       &period{Start: dateTime(enc.EncounteredAt)},
+	}
+	if enc.ChiefComplaint != "" {
+		res.ReasonCode = []codeableConcept{concept(enc.ChiefComplaint)}
+	}
+	return res
+}
+
+func addEncounterDetails(b *builder, enc *encounters.Encounter, subject reference) {
+	var (
+		base       = encounterId(enc.AppointmentId)
+		ref        = encounterRef(enc.AppointmentId)
+		recordedAt = dateTime(enc.EncounteredAt)
+	)
+
+	for i, dx := range enc.Diagnoses {
+		id := fmt.Sprintf("%s-dx-%d", base, i+1)
+		b.add("Condition", id, conditionResource{
+			ResourceType: "Condition",
+			Id:           id,
+			Category:     category(systemCondCategory, "encounter-diagnosis"),
+			Code:         concept(dx.Description, coding{System: systemICD10, Code: dx.Icd10Code}),
+			Subject:      subject,
+			Encounter:    ref,
+			RecordedDate: recordedAt,
+		})
+	}
+	for i, cc := range enc.ChronicConditions {
+		id := fmt.Sprintf("%s-chronic-%d", base, i+1)
+		res := conditionResource{
+			ResourceType:  "Condition",
+			Id:            id,
+			Category:      category(systemCondCategory, "problem-list-item"),
+			Code:          concept(cc.Description),
+			Subject:       subject,
+			Encounter:     ref,
+			OnsetDateTime: dateTime(cc.OnsetDate),
+			RecordedDate:  recordedAt,
+		}
+		if cc.Icd10Code != "" {
+			res.Code.Coding = []coding{{System: systemICD10, Code: cc.Icd10Code}}
+		}
+		b.add("Condition", id, res)
+	}
+	for i, allergy := range enc.Allergies {
+		id := fmt.Sprintf("%s-allergy-%d", base, i+1)
+		res := allergyResource{
+			ResourceType: "AllergyIntolerance",
+			Id:           id,
+			Code:         concept(allergy.Substance),
+			Patient:      subject,
+			RecordedDate: recordedAt,
+		}
+		if allergy.Reaction != "" || allergy.Severity != "" {
+			res.Reaction = []allergyReaction{{
+				Manifestation: []codeableConcept{concept(allergy.Reaction)},
+				Severity:      allergy.Severity,
+			}}
+		}
+		b.add("AllergyIntolerance", id, res)
+	}
+	for i, proc := range enc.Procedures {
+		id := fmt.Sprintf("%s-procedure-%d", base, i+1)
+		res := procedureResource{
+			ResourceType:      "Procedure",
+			Id:                id,
+			Status:            "completed",
+			Code:              concept(proc.Description),
+			Subject:           subject,
+			Encounter:         ref,
+			PerformedDateTime: recordedAt,
+		}
+		if proc.Code != "" {
+			res.Code.Coding = []coding{{System: systemBase + ":procedure", Code: proc.Code}}
+		}
+		b.add("Procedure", id, res)
+	}
+
+	for _, vital := range vitalSigns(enc.Vitals) {
+		vital.Id = base + "-" + vital.Id
+		vital.ResourceType = "Observation"
+		vital.Status = "final"
+		vital.Category = category(systemObsCategory, "vital-signs")
+		vital.Subject = subject
+		vital.Encounter = ref
+		vital.EffectiveDateTime = recordedAt
+		b.add("Observation", vital.Id, vital)
+	}
+}
+
+// vitalSigns lists the measured vitals as observations coded the way the FHIR
+// vital signs profile asks for.
+func vitalSigns(v encounters.Vitals) []observationResource {
+	var (
+		list    []observationResource
+		measure = func(value float64, unit string) *quantity {
+			return &quantity{Value: value, Unit: unit, System: systemUCUM, Code: unit}
+		}
+		add = func(id, code, display string, value float64, unit string) {
+			if value == 0 {
+				return
+			}
+			list = append(list, observationResource{
+				Id:            id,
+				Code:          concept(display, coding{System: systemLOINC, Code: code, Display: display}),
+				ValueQuantity: measure(value, unit),
+			})
+		}
+	)
+
+	add("temperature", "8310-5", "Body temperature", v.TemperatureC, "Cel")
+	add("pulse", "8867-4", "Heart rate", float64(v.PulseBpm), "/min")
+	add("respiratory-rate", "9279-1", "Respiratory rate", float64(v.RespiratoryRate), "/min")
+	add("oxygen-saturation", "2708-6", "Oxygen saturation in Arterial blood", float64(v.OxygenSaturation), "%")
+	add("weight", "29463-7", "Body weight", v.WeightKg, "kg")
+	add("height", "8302-2", "Body height", v.HeightCm, "cm")
+
+	if v.SystolicMmHg != 0 || v.DiastolicMmHg != 0 {
+		bp := observationResource{
+			Id:   "blood-pressure",
+			Code: concept("Blood pressure panel", coding{System: systemLOINC, Code: "85354-9", Display: "Blood pressure panel"}),
+		}
+		if v.SystolicMmHg != 0 {
+			bp.Component = append(bp.Component, observationComponent{
+				Code:          concept("Systolic blood pressure", coding{System: systemLOINC, Code: "8480-6"}),
+				ValueQuantity: measure(float64(v.SystolicMmHg), "mm[Hg]"),
+			})
+		}
+		if v.DiastolicMmHg != 0 {
+			bp.Component = append(bp.Component, observationComponent{
+				Code:          concept("Diastolic blood pressure", coding{System: systemLOINC, Code: "8462-4"}),
+				ValueQuantity: measure(float64(v.DiastolicMmHg), "mm[Hg]"),
+			})
+		}
+		list = append(list, bp)
+	}
+
+	return list
+}
+
+var interpretations = map[string]string{
+	lab_orders.FlagNormal: "N",
+	lab_orders.FlagLow:    "L",
+	lab_orders.FlagHigh:   "H",
+}
+
+func addLabResults(b *builder, order *lab_orders.LabOrder, subject reference) {
+	for i, result := range order.Results {
+		id := fmt.Sprintf("lab-%s-%d", order.Id, i+1)
+		res := observationResource{
+			ResourceType:      "Observation",
+			Id:                id,
+			Status:            "final",
+			Category:          category(systemObsCategory, "laboratory"),
+			Code:              concept(result.Analyte, coding{System: systemBase + ":lab-test", Code: result.TestCode}),
+			Subject:           subject,
+			Encounter:         encounterRef(order.AppointmentId),
+			EffectiveDateTime: dateTime(order.ResultedAt),
+			ValueQuantity:     &quantity{Value: result.Value, Unit: result.Unit},
+		}
+		if result.ReferenceRange != "" || result.ReferenceLow != nil || result.ReferenceHigh != nil {
+			rng := referenceRange{Text: result.ReferenceRange}
+			if result.ReferenceLow != nil {
+				rng.Low = &quantity{Value: *result.ReferenceLow, Unit: result.Unit}
+			}
+			if result.ReferenceHigh != nil {
+				rng.High = &quantity{Value: *result.ReferenceHigh, Unit: result.Unit}
+			}
+			res.ReferenceRange = []referenceRange{rng}
+		}
+		if code, ok := interpretations[result.Flag]; ok {
+			res.Interpretation = []codeableConcept{{Coding: []coding{{System: systemInterpretation, Code: code}}}}
+		}
+		b.add("Observation", id, res)
+	}
+}
+
+// medicationRequest renders one item of a prescription. A revoked prescription
+// is cancelled; one whose course is over at the export time is completed.
+func medicationRequest(id string, rx *prescriptions.Prescription, item *prescriptions.Item, subject reference, at time.Time) medicationRequestResource {
+	status := "active"
+	switch {
+	case !rx.RevokedAt.IsZero():
+		status = "cancelled"
+	case item.DurationDays > 0 && at.After(rx.IssuedAt.AddDate(0, 0, int(item.DurationDays))):
+		status = "completed"
+	}
+
+	name := strings.Join(nonEmpty(item.MedicationName, item.Strength, item.Form), " ")
+	instruction := strings.Join(nonEmpty(item.Dose, item.Frequency), ", ")
+	if item.DurationDays > 0 {
+		instruction = strings.Join(nonEmpty(instruction, fmt.Sprintf("for %d days", item.DurationDays)), ", ")
+	}
+
+	dose := dosage{Text: instruction, PatientInstruction: item.Instructions}
+	if item.Route != "" {
+		dose.Route = &codeableConcept{Text: item.Route}
+	}
+
+	return medicationRequestResource{
+		ResourceType:              "MedicationRequest",
+		Id:                        id,
+		Status:                    status,
+		Intent:                    "order",
+		MedicationCodeableConcept: concept(name, coding{System: systemBase + ":medication", Code: item.MedicationId}),
+		Subject:                   subject,
+		Encounter:                 encounterRef(rx.AppointmentId),
+		AuthoredOn:                dateTime(rx.IssuedAt),
+		Requester:                 &reference{Reference: "Practitioner/" + rx.DoctorId},
+		DosageInstruction:         []dosage{dose},
+	}
+}
+
+func nonEmpty(values ...string) []string {
+	var list []string
+	for _, value := range values {
+		if value != "" {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+// externalResource gives an imported resource the record's id and notes where
+// it came from.
+func externalResource(record *history.ExternalRecord) (json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(record.Resource, &fields); err != nil {
+		return nil, fmt.Errorf("external record %s: %w", record.Id, err)
+	}
+	id, _ := json.Marshal(record.Id)
+	fields["id"] = id
+	if record.Source != "" {
+		source, _ := json.Marshal(meta{Source: record.Source})
+		fields["meta"] = source
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,141 @@
+package fhir
+
+import (
+	"booking_service/internal/entity/archive"
+	"booking_service/internal/entity/doctor_notes"
+	"booking_service/internal/entity/encounters"
+	"booking_service/internal/entity/history"
+	"booking_service/internal/entity/lab_orders"
+	"booking_service/internal/entity/patients"
+	"booking_service/internal/entity/prescriptions"
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/rickb777/date"
+)
+
+func testHistory() *history.History {
+	visitAt := time.Date(2024, 5, 6, 10, 0, 0, 0, time.UTC)
+	high := 5.5
+	return &history.History{
+		Patient: &patients.Patient{
+			Id: "p1", FirstName: "Husan", LastName: "Gofurov", BirthDate: date.New(1990, 5, 15),
+			Gender: "male", BloodGroup: "A+", PhoneNumber: "+998950230605", City: "Andijon", Country: "Uzbekistan",
+		},
+		Visits: []*archive.Archive{
+			{AppointmentId: 7, DoctorId: "d1", StartedAt: visitAt, EndedAt: visitAt.Add(30 * time.Minute), Outcome: archive.OutcomeAttended, PatientProblem: "Cough"},
+		},
+		Encounters: []*encounters.Encounter{
+			{AppointmentId: 7, DoctorId: "d1", EncounteredAt: visitAt,
+				Vitals:    encounters.Vitals{TemperatureC: 37.8, SystolicMmHg: 120, DiastolicMmHg: 80},
+				Diagnoses: []*encounters.Diagnosis{{Icd10Code: "J20.9", Description: "Acute bronchitis", Primary: true}},
+				Allergies: []*encounters.Allergy{{Substance: "Penicillin", Reaction: "Rash", Severity: encounters.SeverityModerate}}},
+		},
+		Notes: []*doctor_notes.DoctorNote{{Id: 3, AppointmentId: 7, DoctorId: "d1", Prescription: "Plenty of fluids"}},
+		Prescriptions: []*prescriptions.Prescription{
+			{Id: "rx1", AppointmentId: 7, DoctorId: "d1", IssuedAt: visitAt, Items: []*prescriptions.Item{
+				{MedicationId: "m1", MedicationName: "Ambroxol", Strength: "30 mg", Form: "tablet", Dose: "1 tablet", Frequency: "3 times a day", DurationDays: 5, Route: prescriptions.RouteOral},
+			}},
+		},
+		LabOrders: []*lab_orders.LabOrder{
+			{Id: "lo1", AppointmentId: 7, ResultedAt: visitAt.Add(time.Hour), Results: []*lab_orders.Result{
+				{TestCode: "CBC", Analyte: "WBC", Value: 11.2, Unit: "10^9/L", ReferenceRange: "4.0-5.5", ReferenceHigh: &high, Flag: lab_orders.FlagHigh},
+			}},
+		},
+	}
+}
+
+func TestExport(t *testing.T) {
+	file, err := New().Export(testHistory(), time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.FileName != "patient-p1-history.json" || file.ContentType != "application/fhir+json" {
+		t.Errorf("file %q %q", file.FileName, file.ContentType)
+	}
+
+	var b bundle
+	if err = json.Unmarshal(file.Content, &b); err != nil {
+		t.Fatal(err)
+	}
+	if b.ResourceType != "Bundle" || b.Type != "collection" {
+		t.Errorf("bundle %q %q", b.ResourceType, b.Type)
+	}
+
+	counts := make(map[string]int)
+	for _, e := range b.Entry {
+		var probe resourceProbe
+		if err = json.Unmarshal(e.Resource, &probe); err != nil {
+			t.Fatal(err)
+		}
+		counts[probe.ResourceType]++
+	}
+	// blood group, temperature, blood pressure and the lab result
+	want := map[string]int{
+		"Patient": 1, "Encounter": 1, "Condition": 1, "AllergyIntolerance": 1,
+		"Observation": 4, "MedicationRequest": 2,
+	}
+	for resourceType, n := range want {
+		if counts[resourceType] != n {
+			t.Errorf("%s: %d resources, want %d", resourceType, counts[resourceType], n)
+		}
+	}
+	if !bytes.Contains(file.Content, []byte(`"status": "completed"`)) {
+		t.Error("a finished course should be completed")
+	}
+}
+
+func TestImport(t *testing.T) {
+	file, err := New().Export(testHistory(), time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	imported, err := New().Import(file.Content, "new-patient")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := imported.Patient
+	if p.Id != "new-patient" || p.FirstName != "Husan" || p.LastName != "Gofurov" || p.BirthDate != date.New(1990, 5, 15) ||
+		p.Gender != "male" || p.BloodGroup != "A+" || p.PhoneNumber != "+998950230605" || p.City != "Andijon" {
+		t.Errorf("patient %+v", p)
+	}
+	if len(imported.Records) != 9 || imported.Skipped != 0 {
+		t.Fatalf("%d records, %d skipped", len(imported.Records), imported.Skipped)
+	}
+	for _, record := range imported.Records {
+		if bytes.Contains(record.Resource, []byte(`"Patient/p1"`)) {
+			t.Errorf("%s %s still points at the old patient", record.ResourceType, record.ExternalId)
+		}
+	}
+	encounter := imported.Records[1]
+	if encounter.ResourceType != "Encounter" || encounter.Summary != "Cough" || !encounter.OccurredAt.Equal(time.Date(2024, 5, 6, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("encounter %+v", encounter)
+	}
+
+	// the records come back as they were imported
+	h := &history.History{Patient: &patients.Patient{Id: "new-patient"}, External: []*history.ExternalRecord{
+		{Id: "r1", ResourceType: encounter.ResourceType, Source: "Other clinic", Resource: encounter.Resource},
+	}}
+	again, err := New().Export(h, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(again.Content, []byte(`"source": "Other clinic"`)) || !bytes.Contains(again.Content, []byte(`"id": "r1"`)) {
+		t.Errorf("external record not re-identified: %s", again.Content)
+	}
+}
+
+func TestImportRejects(t *testing.T) {
+	for name, content := range map[string]string{
+		"not json":   `{`,
+		"not bundle": `{"resourceType":"Patient"}`,
+		"no patient": `{"resourceType":"Bundle","type":"collection","entry":[{"resource":{"resourceType":"Encounter","id":"e1"}}]}`,
+	} {
+		if _, err := New().Import([]byte(content), "p"); err == nil {
+			t.Errorf("%s: imported", name)
+		}
+	}
+}
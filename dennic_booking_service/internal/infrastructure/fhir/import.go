@@ -0,0 +1,246 @@
+package fhir
+
+import (
+	"booking_service/internal/entity/history"
+	"booking_service/internal/entity/patients"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rickb777/date"
+)
+
+// importedTypes are the resources kept as external records; the patient is
+// created from the Patient resource and anything else is skipped.
+var importedTypes = map[string]bool{
+	"Encounter":           true,
+	"Condition":           true,
+	"Observation":         true,
+	"AllergyIntolerance":  true,
+	"Procedure":           true,
+	"MedicationRequest":   true,
+	"MedicationStatement": true,
+	"Immunization":        true,
+	"DiagnosticReport":    true,
+}
+
+var bloodGroups = map[string]bool{
+	"A+": true, "A-": true, "B+": true, "B-": true,
+	"AB+": true, "AB-": true, "O+": true, "O-": true,
+}
+
+// dateTimeLayouts are the precisions a FHIR dateTime may come in.
+var dateTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02", "2006-01", "2006"}
+
+// Import reads a Bundle holding one patient. The patient gets patientId, and
+// the references of the other resources to the patient are re-pointed to it.
+func (c *Codec) Import(content []byte, patientId string) (*history.Imported, error) {
+	var b bundle
+	if err := json.Unmarshal(content, &b); err != nil {
+		return nil, fmt.Errorf("not a JSON document: %w", err)
+	}
+	if b.ResourceType != "Bundle" {
+		return nil, errors.New("not a FHIR Bundle")
+	}
+
+	var (
+		imported = &history.Imported{}
+		probes   = make([]resourceProbe, len(b.Entry))
+		refs     [][]byte
+	)
+	for i, e := range b.Entry {
+		if err := json.Unmarshal(e.Resource, &probes[i]); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		if probes[i].ResourceType != "Patient" {
+			continue
+		}
+		if imported.Patient != nil {
+			return nil, errors.New("the bundle holds more than one patient")
+		}
+		patient, err := importPatient(e.Resource, patientId)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		imported.Patient = patient
+		if probes[i].Id != "" {
+			refs = append(refs, quoted("Patient/"+probes[i].Id))
+		}
+		if e.FullUrl != "" {
+			refs = append(refs, quoted(e.FullUrl))
+		}
+	}
+	if imported.Patient == nil {
+		return nil, errors.New("the bundle holds no patient")
+	}
+
+	newRef := quoted("Patient/" + patientId)
+	for i, e := range b.Entry {
+		probe := &probes[i]
+		if probe.ResourceType == "Patient" {
+			continue
+		}
+		if !importedTypes[probe.ResourceType] {
+			imported.Skipped++
+			continue
+		}
+		if group := probe.bloodGroup(); group != "" && imported.Patient.BloodGroup == "" {
+			imported.Patient.BloodGroup = group
+		}
+
+		resource := []byte(e.Resource)
+		for _, ref := range refs {
+			resource = bytes.ReplaceAll(resource, ref, newRef)
+		}
+
+		imported.Records = append(imported.Records, &history.ExternalRecord{
+			PatientId:    patientId,
+			ResourceType: probe.ResourceType,
+			ExternalId:   probe.Id,
+			OccurredAt:   probe.occurredAt(),
+			Summary:      probe.summary(),
+			Resource:     resource,
+		})
+	}
+
+	return imported, nil
+}
+
+func quoted(value string) []byte {
+	raw, _ := json.Marshal(value)
+	return raw
+}
+
+func importPatient(raw json.RawMessage, patientId string) (*patients.CreatedPatient, error) {
+	var p patientResource
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return nil, err
+	}
+
+	patient := &patients.CreatedPatient{Id: patientId}
+	if len(p.Name) > 0 {
+		name := p.Name[0]
+		patient.FirstName = strings.Join(name.Given, " ")
+		patient.LastName = name.Family
+		if patient.FirstName == "" && patient.LastName == "" {
+			parts := strings.Fields(name.Text)
+			if len(parts) > 0 {
+				patient.FirstName = parts[0]
+				patient.LastName = strings.Join(parts[1:], " ")
+			}
+		}
+	}
+
+	switch p.Gender {
+	case "male", "female", "other":
+		patient.Gender = p.Gender
+	case "unknown":
+		patient.Gender = "other"
+	}
+
+	if p.BirthDate != "" {
+		birthDate, err := date.AutoParse(p.BirthDate)
+		if err != nil {
+			return nil, fmt.Errorf("birthDate: %w", err)
+		}
+		patient.BirthDate = birthDate
+	}
+
+	for _, telecom := range p.Telecom {
+		if telecom.System == "phone" || telecom.System == "sms" {
+			patient.PhoneNumber = telecom.Value
+			break
+		}
+	}
+
+	if len(p.Address) > 0 {
+		addr := p.Address[0]
+		patient.City = addr.City
+		patient.Country = addr.Country
+		patient.Address = addr.Text
+		if patient.Address == "" {
+			patient.Address = strings.Join(addr.Line, ", ")
+		}
+	}
+
+	return patient, nil
+}
+
+// bloodGroup returns the group of an ABO and Rh group observation.
+func (p *resourceProbe) bloodGroup() string {
+	if p.ResourceType != "Observation" || p.Code == nil {
+		return ""
+	}
+	for _, c := range p.Code.Coding {
+		if c.System != systemLOINC || c.Code != loincBloodGroup {
+			continue
+		}
+		value := p.ValueString
+		if value == "" && p.ValueCodeableConcept != nil {
+			value = p.ValueCodeableConcept.Text
+		}
+		value = strings.ToUpper(strings.ReplaceAll(value, " ", ""))
+		if bloodGroups[value] {
+			return value
+		}
+	}
+	return ""
+}
+
+// summary is a short human readable title of the resource.
+func (p *resourceProbe) summary() string {
+	concepts := []*codeableConcept{p.Code, p.MedicationCodeableConcept, p.VaccineCode}
+	for i := range p.Type {
+		concepts = append(concepts, &p.Type[i])
+	}
+	for i := range p.ReasonCode {
+		concepts = append(concepts, &p.ReasonCode[i])
+	}
+	for _, c := range concepts {
+		if c == nil {
+			continue
+		}
+		if c.Text != "" {
+			return c.Text
+		}
+		for _, code := range c.Coding {
+			if code.Display != "" {
+				return code.Display
+			}
+		}
+	}
+	return ""
+}
+
+// occurredAt is when the resource happened as far as it says.
+func (p *resourceProbe) occurredAt() time.Time {
+	candidates := []string{
+		p.EffectiveDateTime,
+		p.PerformedDateTime,
+		p.OccurrenceDateTime,
+		p.AuthoredOn,
+		p.OnsetDateTime,
+		p.RecordedDate,
+		p.Issued,
+	}
+	if p.Period != nil {
+		candidates = append([]string{p.Period.Start}, candidates...)
+	}
+	if p.EffectivePeriod != nil {
+		candidates = append(candidates, p.EffectivePeriod.Start)
+	}
+	for _, value := range candidates {
+		if value == "" {
+			continue
+		}
+		for _, layout := range dateTimeLayouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				return t.UTC()
+			}
+		}
+	}
+	return time.Time{}
+}
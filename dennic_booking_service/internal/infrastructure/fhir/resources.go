@@ -0,0 +1,214 @@
+package fhir
+
+import "encoding/json"
+
+// Only the parts of the FHIR R4 resources the clinic fills in or reads back.
+
+type bundle struct {
+	ResourceType string  `json:"resourceType"`
+	Id           string  `json:"id,omitempty"`
+	Type         string  `json:"type"`
+	Timestamp    string  `json:"timestamp,omitempty"`
+	Entry        []entry `json:"entry"`
+}
+
+type entry struct {
+	FullUrl  string          `json:"fullUrl,omitempty"`
+	Resource json.RawMessage `json:"resource"`
+}
+
+type meta struct {
+	Source string `json:"source,omitempty"`
+}
+
+type coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+type codeableConcept struct {
+	Coding []coding `json:"coding,omitempty"`
+	Text   string   `json:"text,omitempty"`
+}
+
+type reference struct {
+	Reference string `json:"reference"`
+}
+
+type period struct {
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+}
+
+type quantity struct {
+	Value  float64 `json:"value"`
+	Unit   string  `json:"unit,omitempty"`
+	System string  `json:"system,omitempty"`
+	Code   string  `json:"code,omitempty"`
+}
+
+type extension struct {
+	Url       string `json:"url"`
+	ValueCode string `json:"valueCode,omitempty"`
+}
+
+type annotation struct {
+	Text string `json:"text"`
+}
+
+type identifier struct {
+	System string `json:"system,omitempty"`
+	Value  string `json:"value"`
+}
+
+type humanName struct {
+	Text   string   `json:"text,omitempty"`
+	Family string   `json:"family,omitempty"`
+	Given  []string `json:"given,omitempty"`
+}
+
+type contactPoint struct {
+	System string `json:"system,omitempty"`
+	Value  string `json:"value"`
+	Use    string `json:"use,omitempty"`
+}
+
+type address struct {
+	Text    string   `json:"text,omitempty"`
+	Line    []string `json:"line,omitempty"`
+	City    string   `json:"city,omitempty"`
+	Country string   `json:"country,omitempty"`
+}
+
+type patientResource struct {
+	ResourceType string         `json:"resourceType"`
+	Id           string         `json:"id,omitempty"`
+	Identifier   []identifier   `json:"identifier,omitempty"`
+	Name         []humanName    `json:"name,omitempty"`
+	Telecom      []contactPoint `json:"telecom,omitempty"`
+	Gender       string         `json:"gender,omitempty"`
+	BirthDate    string         `json:"birthDate,omitempty"`
+	Address      []address      `json:"address,omitempty"`
+}
+
+type participant struct {
+	Individual reference `json:"individual"`
+}
+
+type encounterResource struct {
+	ResourceType string            `json:"resourceType"`
+	Id           string            `json:"id"`
+	Extension    []extension       `json:"extension,omitempty"`
+	Status       string            `json:"status"`
+	Class        coding            `json:"class"`
+	Subject      reference         `json:"subject"`
+	Participant  []participant     `json:"participant,omitempty"`
+	Period       *period           `json:"period,omitempty"`
+	ReasonCode   []codeableConcept `json:"reasonCode,omitempty"`
+}
+
+type conditionResource struct {
+	ResourceType  string            `json:"resourceType"`
+	Id            string            `json:"id"`
+	Category      []codeableConcept `json:"category"`
+	Code          codeableConcept   `json:"code"`
+	Subject       reference         `json:"subject"`
+	Encounter     *reference        `json:"encounter,omitempty"`
+	OnsetDateTime string            `json:"onsetDateTime,omitempty"`
+	RecordedDate  string            `json:"recordedDate,omitempty"`
+}
+
+type allergyReaction struct {
+	Manifestation []codeableConcept `json:"manifestation"`
+	Severity      string            `json:"severity,omitempty"`
+}
+
+type allergyResource struct {
+	ResourceType string            `json:"resourceType"`
+	Id           string            `json:"id"`
+	Code         codeableConcept   `json:"code"`
+	Patient      reference         `json:"patient"`
+	RecordedDate string            `json:"recordedDate,omitempty"`
+	Reaction     []allergyReaction `json:"reaction,omitempty"`
+}
+
+type procedureResource struct {
+	ResourceType      string          `json:"resourceType"`
+	Id                string          `json:"id"`
+	Status            string          `json:"status"`
+	Code              codeableConcept `json:"code"`
+	Subject           reference       `json:"subject"`
+	Encounter         *reference      `json:"encounter,omitempty"`
+	PerformedDateTime string          `json:"performedDateTime,omitempty"`
+}
+
+type referenceRange struct {
+	Low  *quantity `json:"low,omitempty"`
+	High *quantity `json:"high,omitempty"`
+	Text string    `json:"text,omitempty"`
+}
+
+type observationComponent struct {
+	Code          codeableConcept `json:"code"`
+	ValueQuantity *quantity       `json:"valueQuantity,omitempty"`
+}
+
+type observationResource struct {
+	ResourceType      string                 `json:"resourceType"`
+	Id                string                 `json:"id"`
+	Status            string                 `json:"status"`
+	Category          []codeableConcept      `json:"category,omitempty"`
+	Code              codeableConcept        `json:"code"`
+	Subject           reference              `json:"subject"`
+	Encounter         *reference             `json:"encounter,omitempty"`
+	EffectiveDateTime string                 `json:"effectiveDateTime,omitempty"`
+	ValueQuantity     *quantity              `json:"valueQuantity,omitempty"`
+	ValueString       string                 `json:"valueString,omitempty"`
+	Interpretation    []codeableConcept      `json:"interpretation,omitempty"`
+	ReferenceRange    []referenceRange       `json:"referenceRange,omitempty"`
+	Component         []observationComponent `json:"component,omitempty"`
+}
+
+type dosage struct {
+	Text               string           `json:"text,omitempty"`
+	PatientInstruction string           `json:"patientInstruction,omitempty"`
+	Route              *codeableConcept `json:"route,omitempty"`
+}
+
+type medicationRequestResource struct {
+	ResourceType              string          `json:"resourceType"`
+	Id                        string          `json:"id"`
+	Status                    string          `json:"status"`
+	Intent                    string          `json:"intent"`
+	MedicationCodeableConcept codeableConcept `json:"medicationCodeableConcept"`
+	Subject                   reference       `json:"subject"`
+	Encounter                 *reference      `json:"encounter,omitempty"`
+	AuthoredOn                string          `json:"authoredOn,omitempty"`
+	Requester                 *reference      `json:"requester,omitempty"`
+	DosageInstruction         []dosage        `json:"dosageInstruction,omitempty"`
+	Note                      []annotation    `json:"note,omitempty"`
+}
+
+// resourceProbe reads what an imported resource is about and when it happened,
+// whatever its type.
+type resourceProbe struct {
+	ResourceType              string            `json:"resourceType"`
+	Id                        string            `json:"id"`
+	Code                      *codeableConcept  `json:"code"`
+	MedicationCodeableConcept *codeableConcept  `json:"medicationCodeableConcept"`
+	VaccineCode               *codeableConcept  `json:"vaccineCode"`
+	Type                      []codeableConcept `json:"type"`
+	ReasonCode                []codeableConcept `json:"reasonCode"`
+	Period                    *period           `json:"period"`
+	EffectiveDateTime         string            `json:"effectiveDateTime"`
+	EffectivePeriod           *period           `json:"effectivePeriod"`
+	AuthoredOn                string            `json:"authoredOn"`
+	RecordedDate              string            `json:"recordedDate"`
+	OnsetDateTime             string            `json:"onsetDateTime"`
+	PerformedDateTime         string            `json:"performedDateTime"`
+	OccurrenceDateTime        string            `json:"occurrenceDateTime"`
+	Issued                    string            `json:"issued"`
+	ValueString               string            `json:"valueString"`
+	ValueCodeableConcept      *codeableConcept  `json:"valueCodeableConcept"`
+}
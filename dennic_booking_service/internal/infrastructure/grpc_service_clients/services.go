@@ -1,28 +1,47 @@
 package grpc_service_clients
 
 import (
+	healthcarepb "booking_service/genproto/healthcare-service"
 	"booking_service/internal/pkg/config"
+	"fmt"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type ServiceClients interface {
-	// SmsService()
+	DoctorsService() healthcarepb.DoctorsServiceClient
 	Close()
 }
 
 type serviceClients struct {
-	services []*grpc.ClientConn
+	doctorsService healthcarepb.DoctorsServiceClient
+	services       []*grpc.ClientConn
 }
 
 func New(config *config.Config) (ServiceClients, error) {
+	connHealthcareService, err := grpc.Dial(
+		fmt.Sprintf("%s%s", config.HealthcareService.Host, config.HealthcareService.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &serviceClients{
-		services: []*grpc.ClientConn{},
+		doctorsService: healthcarepb.NewDoctorsServiceClient(connHealthcareService),
+		services:       []*grpc.ClientConn{connHealthcareService},
 	}, nil
 }
 
+func (s *serviceClients) DoctorsService() healthcarepb.DoctorsServiceClient {
+	return s.doctorsService
+}
+
 func (s *serviceClients) Close() {
-	// closing investment service
 	for _, conn := range s.services {
 		conn.Close()
 	}
@@ -1,76 +1,212 @@
 package kafka
 
-//
-//import (
-//	"content_service/internal/entity"
-//	"content_service/internal/pkg/config"
-//	"content_service/internal/pkg/otlp"
-//	"context"
-//
-//	"github.com/segmentio/kafka-go"
-//	"go.uber.org/zap"
-//)
-//
-//type producer struct {
-//	logger            *zap.Logger
-//	investmentCreated *kafka.Writer
-//}
-//
-//func NewProducer(config *config.Config, logger *zap.Logger) *producer {
-//	return &producer{
-//		logger: logger,
-//		investmentCreated: &kafka.Writer{
-//			Addr:                   kafka.TCP(config.Kafka.Address...),
-//			Topic:                  config.Kafka.Topic.InvestorCreate,
-//			Balancer:               &kafka.Hash{},
-//			RequiredAcks:           kafka.RequireAll,
-//			AllowAutoTopicCreation: true,
-//			Async:                  true, // make the writer asynchronous
-//			Completion: func(messages []kafka.Message, err error) {
-//				if err != nil {
-//					logger.Error("kafka investmentCreated", zap.Error(err))
-//				}
-//				for _, message := range messages {
-//					logger.Sugar().Info(
-//						"kafka investmentCreated message",
-//						zap.Int("partition", message.Partition),
-//						zap.Int64("offset", message.Offset),
-//						zap.String("key", string(message.Key)),
-//						zap.String("value", string(message.Value)),
-//					)
-//				}
-//			},
-//		},
-//	}
-//}
-//
-//func (p *producer) buildMessageWithTracing(key string, value []byte, otlpSpan otlp.Span) kafka.Message {
-//	return kafka.Message{
-//		Key:   []byte(key),
-//		Value: value,
-//		Headers: []kafka.Header{
-//			{
-//				Key:   "trace_id",
-//				Value: []byte(otlpSpan.SpanContext().TraceID().String()),
-//			},
-//			{
-//				Key:   "span_id",
-//				Value: []byte(otlpSpan.SpanContext().SpanID().String()),
-//			},
-//		},
-//	}
-//}
-//
-//func (p *producer) ProduceContent(ctx context.Context, key string, value *entity.ArticleCategories) error {
-//	// tracing
-//	ctx, span := otlp.Start(ctx, "kafka producer", "ContentProducer")
-//	defer span.End()
-//
-//	return nil
-//}
-//
-//func (p *producer) Close() {
-//	if err := p.investmentCreated.Close(); err != nil {
-//		p.logger.Error("error during close writer articleCategoryCreated", zap.Error(err))
-//	}
-//}
+import (
+	"booking_service/genproto/events"
+	appointment "booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/lab_orders"
+	"booking_service/internal/entity/messages"
+	"booking_service/internal/entity/prescriptions"
+	"booking_service/internal/pkg/config"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"dennic_kafka/eventbus"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// Versions of the events published on the appointments, lab orders,
+// prescriptions and messages topics.
+const (
+	appointmentEventVersion  = 1
+	labOrderEventVersion     = 1
+	prescriptionEventVersion = 1
+	messageEventVersion      = 1
+)
+
+type producer struct {
+	logger        *zap.Logger
+	name          string
+	appointments  *kafka.Writer
+	labOrders     *kafka.Writer
+	prescriptions *kafka.Writer
+	messages      *kafka.Writer
+}
+
+func NewProducer(config *config.Config, logger *zap.Logger) *producer {
+	return &producer{
+		logger:        logger,
+		name:          config.APP,
+		appointments:  newWriter(config.Kafka.Address, config.Kafka.Topic.Appointments, "appointments", logger),
+		labOrders:     newWriter(config.Kafka.Address, config.Kafka.Topic.LabOrders, "labOrders", logger),
+		prescriptions: newWriter(config.Kafka.Address, config.Kafka.Topic.Prescriptions, "prescriptions", logger),
+		messages:      newWriter(config.Kafka.Address, config.Kafka.Topic.Messages, "messages", logger),
+	}
+}
+
+// newWriter writes asynchronously, logging the outcome under name.
+func newWriter(address []string, topic, name string, logger *zap.Logger) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:                   kafka.TCP(address...),
+		Topic:                  topic,
+		Balancer:               &kafka.Hash{},
+		RequiredAcks:           kafka.RequireAll,
+		AllowAutoTopicCreation: true,
+		Async:                  true, // make the writer asynchronous
+		Completion: func(messages []kafka.Message, err error) {
+			if err != nil {
+				logger.Error("kafka "+name, zap.Error(err))
+			}
+			for _, message := range messages {
+				logger.Info(
+					"kafka "+name+" message",
+					zap.Int("partition", message.Partition),
+					zap.Int64("offset", message.Offset),
+					zap.String("key", string(message.Key)),
+				)
+			}
+		},
+	}
+}
+
+// buildMessage carries the trace of ctx in the message headers.
+func (p *producer) buildMessage(ctx context.Context, key string, value []byte) kafka.Message {
+	message := kafka.Message{
+		Key:   []byte(key),
+		Value: value,
+	}
+	eventbus.InjectTraceContext(ctx, &message)
+	return message
+}
+
+// PublishAppointment keys the event by the appointment, so the events of one
+// appointment are consumed in the order they happened.
+func (p *producer) PublishAppointment(ctx context.Context, event *appointment.Event) error {
+	ctx, span := otlp.Start(ctx, "kafka producer", "AppointmentProducer")
+	defer span.End()
+
+	a := event.Appointment
+	value, err := eventbus.NewEnvelope(ctx, p.name, event.Type, appointmentEventVersion, event.OccurredAt, &events.AppointmentChanged{
+		Id:               a.Id,
+		PatientId:        a.PatientId,
+		DoctorId:         a.DoctorId,
+		DepartmentId:     a.DepartmentId,
+		BranchId:         a.BranchId,
+		StartsAt:         event.StartsAt.Format(time.RFC3339),
+		Duration:         a.Duration,
+		Modality:         a.Modality,
+		Status:           a.Status,
+		PatientStatus:    a.PatientStatus,
+		PreviousStartsAt: formatTime(event.PreviousStartsAt),
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.appointments.WriteMessages(ctx, p.buildMessage(ctx, strconv.FormatInt(a.Id, 10), value))
+}
+
+// PublishLabOrder keys the event by the order.
+func (p *producer) PublishLabOrder(ctx context.Context, order *lab_orders.LabOrder) error {
+	ctx, span := otlp.Start(ctx, "kafka producer", "LabOrderProducer")
+	defer span.End()
+
+	value, err := eventbus.NewEnvelope(ctx, p.name, lab_orders.EventReleased, labOrderEventVersion, time.Now(), &events.LabResultsReleased{
+		Id:            order.Id,
+		AppointmentId: order.AppointmentId,
+		PatientId:     order.PatientId,
+		DoctorId:      order.DoctorId,
+		ReleasedAt:    formatTime(order.ReleasedAt),
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.labOrders.WriteMessages(ctx, p.buildMessage(ctx, order.Id, value))
+}
+
+// PublishPrescription keys the event by the prescription.
+func (p *producer) PublishPrescription(ctx context.Context, prescription *prescriptions.Prescription) error {
+	ctx, span := otlp.Start(ctx, "kafka producer", "PrescriptionProducer")
+	defer span.End()
+
+	value, err := eventbus.NewEnvelope(ctx, p.name, prescriptions.EventIssued, prescriptionEventVersion, time.Now(), &events.PrescriptionIssued{
+		Id:            prescription.Id,
+		AppointmentId: prescription.AppointmentId,
+		PatientId:     prescription.PatientId,
+		DoctorId:      prescription.DoctorId,
+		IssuedAt:      formatTime(prescription.IssuedAt),
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.prescriptions.WriteMessages(ctx, p.buildMessage(ctx, prescription.Id, value))
+}
+
+// PublishMessage keys the event by the conversation, so the changes of one
+// conversation are consumed in the order they happened.
+func (p *producer) PublishMessage(ctx context.Context, event *messages.Event) error {
+	ctx, span := otlp.Start(ctx, "kafka producer", "MessageProducer")
+	defer span.End()
+
+	var (
+		c       = event.Conversation
+		payload eventbus.Payload
+	)
+	switch event.Type {
+	case messages.EventSent:
+		payload = &events.MessageSent{
+			Id:             event.MessageId,
+			ConversationId: c.Id,
+			PatientId:      c.PatientId,
+			DoctorId:       c.DoctorId,
+			AppointmentId:  c.AppointmentId,
+			SenderRole:     event.SenderRole,
+			SentAt:         formatTime(event.OccurredAt),
+		}
+	case messages.EventRead:
+		payload = &events.MessagesRead{
+			ConversationId: c.Id,
+			PatientId:      c.PatientId,
+			DoctorId:       c.DoctorId,
+			ReaderRole:     event.ReaderRole,
+			ReadAt:         formatTime(event.OccurredAt),
+		}
+	default:
+		return fmt.Errorf("unknown message event %q", event.Type)
+	}
+
+	value, err := eventbus.NewEnvelope(ctx, p.name, event.Type, messageEventVersion, event.OccurredAt, payload)
+	if err != nil {
+		return err
+	}
+
+	return p.messages.WriteMessages(ctx, p.buildMessage(ctx, c.Id, value))
+}
+
+// formatTime writes unset times empty.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func (p *producer) Close() {
+	if err := p.appointments.Close(); err != nil {
+		p.logger.Error("error during close writer appointments", zap.Error(err))
+	}
+	if err := p.labOrders.Close(); err != nil {
+		p.logger.Error("error during close writer labOrders", zap.Error(err))
+	}
+	if err := p.prescriptions.Close(); err != nil {
+		p.logger.Error("error during close writer prescriptions", zap.Error(err))
+	}
+	if err := p.messages.Close(); err != nil {
+		p.logger.Error("error during close writer messages", zap.Error(err))
+	}
+}
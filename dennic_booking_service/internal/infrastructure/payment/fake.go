@@ -0,0 +1,101 @@
+package payment
+
+import (
+	"booking_service/internal/entity/billing"
+	"booking_service/internal/entity/payments"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Fake stands in for a real provider in tests and local setups. Its checkout
+// page is not served by anyone; webhooks are JSON bodies signed with HMAC-SHA256
+// of the body under the shared secret, hex encoded.
+type Fake struct {
+	checkoutURL string
+	secret      []byte
+}
+
+// FakeEvent is the webhook body of the fake provider.
+type FakeEvent struct {
+	EventId   string `json:"event_id"`
+	Reference string `json:"reference"`
+	Status    string `json:"status"`
+	Amount    string `json:"amount"`
+	Currency  string `json:"currency"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// NewFake -.
+func NewFake(checkoutURL, secret string) *Fake {
+	return &Fake{
+		checkoutURL: strings.TrimRight(checkoutURL, "/"),
+		secret:      []byte(secret),
+	}
+}
+
+func (f *Fake) Name() string {
+	return ProviderFake
+}
+
+func (f *Fake) CreateCheckout(ctx context.Context, intent *payments.Intent) (*payments.Checkout, error) {
+	ref := "fake_" + uuid.New().String()
+
+	query := url.Values{}
+	query.Set("amount", intent.Amount.String())
+	query.Set("currency", intent.Currency)
+
+	return &payments.Checkout{
+		ProviderRef: ref,
+		CheckoutURL: f.checkoutURL + "/" + ref + "?" + query.Encode(),
+	}, nil
+}
+
+func (f *Fake) ParseWebhook(payload []byte, signature string) (*payments.Event, error) {
+	if !hmac.Equal([]byte(signature), []byte(f.Sign(payload))) {
+		return nil, payments.ErrInvalidSignature
+	}
+
+	var body FakeEvent
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, err
+	}
+	if body.EventId == "" || body.Reference == "" {
+		return nil, errors.New("event_id and reference are required")
+	}
+
+	event := &payments.Event{
+		Id:          body.EventId,
+		ProviderRef: body.Reference,
+		Currency:    body.Currency,
+		Reason:      body.Reason,
+	}
+	switch body.Status {
+	case payments.EventSucceeded, payments.EventFailed:
+		event.Status = body.Status
+	default:
+		return nil, errors.New("unknown payment status " + body.Status)
+	}
+
+	amount, err := billing.ParseAmount(body.Amount)
+	if err != nil {
+		return nil, err
+	}
+	event.Amount = amount
+
+	return event, nil
+}
+
+// Sign returns the signature the fake provider puts on a webhook payload.
+func (f *Fake) Sign(payload []byte) string {
+	mac := hmac.New(sha256.New, f.secret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
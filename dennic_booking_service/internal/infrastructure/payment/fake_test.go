@@ -0,0 +1,60 @@
+package payment
+
+import (
+	"booking_service/internal/entity/billing"
+	"booking_service/internal/entity/payments"
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeCheckout(t *testing.T) {
+	provider := NewFake("https://pay.dennic.uz/checkout/", "secret")
+
+	checkout, err := provider.CreateCheckout(context.Background(), &payments.Intent{
+		Amount:   billing.Amount(15000050),
+		Currency: "UZS",
+	})
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(checkout.ProviderRef, "fake_"))
+
+	parsed, err := url.Parse(checkout.CheckoutURL)
+	assert.NoError(t, err)
+	assert.Equal(t, "/checkout/"+checkout.ProviderRef, parsed.Path)
+	assert.Equal(t, "150000.50", parsed.Query().Get("amount"))
+}
+
+func TestFakeWebhook(t *testing.T) {
+	provider := NewFake("https://pay.dennic.uz/checkout", "secret")
+
+	payload, err := json.Marshal(FakeEvent{
+		EventId:   "evt_1",
+		Reference: "fake_1",
+		Status:    payments.EventSucceeded,
+		Amount:    "150000.50",
+		Currency:  "UZS",
+	})
+	assert.NoError(t, err)
+
+	event, err := provider.ParseWebhook(payload, provider.Sign(payload))
+	assert.NoError(t, err)
+	assert.Equal(t, "evt_1", event.Id)
+	assert.Equal(t, "fake_1", event.ProviderRef)
+	assert.Equal(t, payments.EventSucceeded, event.Status)
+	assert.Equal(t, billing.Amount(15000050), event.Amount)
+
+	// A payload signed with another secret, or changed after signing, is rejected.
+	_, err = provider.ParseWebhook(payload, NewFake("", "other").Sign(payload))
+	assert.ErrorIs(t, err, payments.ErrInvalidSignature)
+	tampered := []byte(strings.Replace(string(payload), "150000.50", "1.00", 1))
+	_, err = provider.ParseWebhook(tampered, provider.Sign(payload))
+	assert.ErrorIs(t, err, payments.ErrInvalidSignature)
+
+	unknown, _ := json.Marshal(FakeEvent{EventId: "evt_2", Reference: "fake_1", Status: "pending", Amount: "1.00"})
+	_, err = provider.ParseWebhook(unknown, provider.Sign(unknown))
+	assert.Error(t, err)
+}
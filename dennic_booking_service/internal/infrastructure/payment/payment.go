@@ -0,0 +1,37 @@
+// Package payment talks to the online payment providers patients prepay
+// bookings through. Providers are selected by name from the configuration,
+// so Click, Payme or Stripe style vendors can be added next to the fake one
+// without touching the usecase.
+package payment
+
+import (
+	"booking_service/internal/entity/payments"
+	"booking_service/internal/pkg/config"
+	"context"
+	"errors"
+	"fmt"
+)
+
+const ProviderFake = "fake"
+
+// Provider -.
+type Provider interface {
+	Name() string
+	CreateCheckout(ctx context.Context, intent *payments.Intent) (*payments.Checkout, error)
+	ParseWebhook(payload []byte, signature string) (*payments.Event, error)
+}
+
+// New returns the provider configured by PAYMENT_PROVIDER. Webhooks are
+// trusted on their signature alone, so it refuses to start without a secret.
+func New(cfg *config.Config) (Provider, error) {
+	if cfg.Payment.WebhookSecret == "" {
+		return nil, errors.New("PAYMENT_WEBHOOK_SECRET is not set")
+	}
+
+	switch cfg.Payment.Provider {
+	case "", ProviderFake:
+		return NewFake(cfg.Payment.CheckoutURL, cfg.Payment.WebhookSecret), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", cfg.Payment.Provider)
+	}
+}
@@ -0,0 +1,21 @@
+package payment
+
+import (
+	"booking_service/internal/pkg/config"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWithoutSecret(t *testing.T) {
+	cfg := config.New()
+	cfg.Payment.WebhookSecret = ""
+
+	_, err := New(cfg)
+	assert.Error(t, err)
+
+	cfg.Payment.WebhookSecret = "secret"
+	provider, err := New(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, ProviderFake, provider.Name())
+}
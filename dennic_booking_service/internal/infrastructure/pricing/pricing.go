@@ -0,0 +1,40 @@
+// Package pricing looks up the prices of doctor services, so a booking
+// stores the price in force rather than one sent by the caller.
+package pricing
+
+import (
+	healthcarepb "booking_service/genproto/healthcare-service"
+	"booking_service/internal/entity"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Healthcare asks the price lists of the healthcare service.
+type Healthcare struct {
+	doctorsService healthcarepb.DoctorsServiceClient
+}
+
+// NewHealthcare -.
+func NewHealthcare(doctorsService healthcarepb.DoctorsServiceClient) *Healthcare {
+	return &Healthcare{
+		doctorsService: doctorsService,
+	}
+}
+
+// EffectivePrice is the price of the doctor service for the modality in
+// force now.
+func (h *Healthcare) EffectivePrice(ctx context.Context, doctorServiceId, modality string) (string, string, error) {
+	price, err := h.doctorsService.GetEffectivePrice(ctx, &healthcarepb.GetEffectivePriceReq{
+		DoctorServiceId: doctorServiceId,
+		Modality:        modality,
+	})
+	if status.Code(err) == codes.NotFound {
+		return "", "", entity.NewErrNotFound("price of the doctor service")
+	}
+	if err != nil {
+		return "", "", err
+	}
+	return price.Amount, price.Currency, nil
+}
@@ -0,0 +1,44 @@
+package pricing
+
+import (
+	healthcarepb "booking_service/genproto/healthcare-service"
+	"booking_service/internal/entity"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// priceList answers GetEffectivePrice from a map keyed by service and modality.
+type priceList struct {
+	healthcarepb.DoctorsServiceClient
+	prices map[string]*healthcarepb.ServicePrice
+}
+
+func (p *priceList) GetEffectivePrice(ctx context.Context, in *healthcarepb.GetEffectivePriceReq, opts ...grpc.CallOption) (*healthcarepb.ServicePrice, error) {
+	price, ok := p.prices[in.DoctorServiceId+"/"+in.Modality]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "no price")
+	}
+	return price, nil
+}
+
+func TestHealthcareEffectivePrice(t *testing.T) {
+	prices := NewHealthcare(&priceList{prices: map[string]*healthcarepb.ServicePrice{
+		"service-1/offline": {Amount: "150000.00", Currency: "UZS"},
+		"service-1/online":  {Amount: "120000.00", Currency: "UZS"},
+	}})
+	ctx := context.Background()
+
+	amount, currency, err := prices.EffectivePrice(ctx, "service-1", "online")
+	assert.NoError(t, err)
+	assert.Equal(t, "120000.00", amount)
+	assert.Equal(t, "UZS", currency)
+
+	_, _, err = prices.EffectivePrice(ctx, "service-2", "offline")
+	var notFound *entity.ErrNotFound
+	assert.ErrorAs(t, err, &notFound)
+}
@@ -0,0 +1,89 @@
+// Package receipt renders invoice receipts for print.
+package receipt
+
+import (
+	"booking_service/internal/entity/billing"
+	"bytes"
+	"fmt"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// Receipts -.
+type Receipts struct{}
+
+// New -.
+func New() *Receipts {
+	return &Receipts{}
+}
+
+// Render lays the invoice with its lines and cash desk transactions out on an
+// A5 page. The core PDF fonts only cover Latin-1, other characters are
+// replaced.
+func (r *Receipts) Render(invoice *billing.Invoice) (*billing.Receipt, error) {
+	pdf := gofpdf.New("P", "mm", "A5", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.SetTitle("Receipt "+invoice.Number, true)
+	pdf.SetCreator("Dennic", true)
+	pdf.AddPage()
+
+	money := func(amount billing.Amount) string {
+		return amount.String() + " " + invoice.Currency
+	}
+	line := func(label, value string, bold bool) {
+		style := ""
+		if bold {
+			style = "B"
+		}
+		pdf.SetFont("Helvetica", style, 9)
+		pdf.CellFormat(90, 5, tr(label), "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 5, value, "", 1, "R", false, 0, "")
+	}
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, "Receipt "+invoice.Number, "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.CellFormat(0, 5, "Issued: "+invoice.IssuedAt.Format("2006-01-02 15:04"), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 5, fmt.Sprintf("Appointment: %d", invoice.AppointmentId), "", 1, "L", false, 0, "")
+	if invoice.Status == billing.StatusVoid {
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.CellFormat(0, 5, "VOID: "+invoice.VoidedAt.Format("2006-01-02 15:04"), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(3)
+
+	for _, item := range invoice.Items {
+		label := item.Description
+		if item.Quantity > 1 {
+			label = fmt.Sprintf("%s, %d x %s", item.Description, item.Quantity, item.UnitPrice)
+		}
+		line(label, money(item.Amount), false)
+	}
+	pdf.Ln(2)
+	line("Subtotal", money(invoice.Subtotal), false)
+	if invoice.Discount > 0 {
+		line("Discount", "-"+money(invoice.Discount), false)
+	}
+	line("Total", money(invoice.Total), true)
+	pdf.Ln(3)
+
+	for _, payment := range invoice.Payments {
+		label := fmt.Sprintf("%s, %s, %s", payment.CreatedAt.Format("2006-01-02 15:04"), payment.Kind, payment.Method)
+		value := money(payment.Amount)
+		if payment.Kind == billing.KindRefund {
+			value = "-" + value
+		}
+		line(label, value, false)
+	}
+	line("Paid", money(invoice.Net()), true)
+	line("Balance due", money(invoice.Balance()), true)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+
+	return &billing.Receipt{
+		FileName: "receipt-" + invoice.Number + ".pdf",
+		Content:  buf.Bytes(),
+	}, nil
+}
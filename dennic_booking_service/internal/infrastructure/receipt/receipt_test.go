@@ -0,0 +1,38 @@
+package receipt
+
+import (
+	"booking_service/internal/entity/billing"
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestRender(t *testing.T) {
+	invoice := &billing.Invoice{
+		Number:        "INV-000042",
+		AppointmentId: 42,
+		Currency:      "UZS",
+		Discount:      500000,
+		IssuedAt:      time.Date(2024, 3, 5, 9, 30, 0, 0, time.UTC),
+		Items: []*billing.Item{
+			{Kind: billing.ItemService, Description: "Doctor service, offline appointment", Quantity: 1, UnitPrice: 15000000},
+			{Kind: billing.ItemProcedure, Description: "ECG", Quantity: 2, UnitPrice: 2500000},
+		},
+		Paid: 10000000,
+		Payments: []*billing.Payment{
+			{Kind: billing.KindPayment, Method: billing.MethodCash, Amount: 10000000, CreatedAt: time.Date(2024, 3, 5, 9, 45, 0, 0, time.UTC)},
+		},
+	}
+	invoice.Recalculate()
+
+	receipt, err := New().Render(invoice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.FileName != "receipt-INV-000042.pdf" {
+		t.Errorf("file name %q", receipt.FileName)
+	}
+	if !bytes.HasPrefix(receipt.Content, []byte("%PDF-")) {
+		t.Error("content is not a PDF")
+	}
+}
@@ -1,13 +1,17 @@
 package repo
 
 import (
+	"booking_service/internal/entity"
 	"booking_service/internal/entity/archive"
 	"booking_service/internal/pkg/otlp"
 	"booking_service/internal/pkg/postgres"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
+
+	"github.com/jackc/pgx/v4"
 )
 
 const (
@@ -28,62 +32,49 @@ func NewBookingArchive(db *postgres.PostgresDB) *BookingArchive {
 
 func tableColumArchive() string {
 	return `id,
-			doctor_availability_id,
-			start_time,
+			appointment_id,
+			patient_id,
+			COALESCE(doctor_id::text, ''),
+			department_id,
+			COALESCE(branch_id::text, ''),
+			appointment_date,
+			started_at,
+			ended_at,
 			patient_problem,
-			end_time,
-			status,
-			payment_type,
-			payment_amount,
+			outcome,
+			COALESCE(payment_type, ''),
+			payment_amount::text,
+			currency,
+			COALESCE(invoice_id::text, ''),
 			created_at,
 			updated_at,
 			deleted_at`
 }
 
-func (r *BookingArchive) CreateArchive(ctx context.Context, req *archive.CreatedArchive) (*archive.Archive, error) {
+func scanArchive(row pgx.Row) (*archive.Archive, error) {
 	var (
 		archiveRes archive.Archive
+		amount     string
 		upTime     sql.NullTime
 		delTime    sql.NullTime
 	)
 
-	ctx, span := otlp.Start(ctx, serviceNameArchive, spanNameArchiveRepo+"Create")
-	defer span.End()
-
-	toSql, args, err := r.db.Sq.Builder.
-		Insert(tableNameArchive).
-		Columns(`doctor_availability_id,
-			start_time,
-			patient_problem,
-			end_time,
-			status,
-			payment_type,
-			payment_amount`).
-		Values(
-			req.DoctorAvailabilityId,
-			req.StartTime,
-			req.PatientProblem,
-			req.EndTime,
-			req.Status,
-			req.PaymentType,
-			req.PaymentAmount,
-		).
-		Suffix(fmt.Sprintf("RETURNING %s", tableColumArchive())).
-		ToSql()
-
-	if err != nil {
-		return nil, err
-	}
-
-	if err = r.db.QueryRow(ctx, toSql, args...).Scan(
+	if err := row.Scan(
 		&archiveRes.Id,
-		&archiveRes.DoctorAvailabilityId,
-		&archiveRes.StartTime,
+		&archiveRes.AppointmentId,
+		&archiveRes.PatientId,
+		&archiveRes.DoctorId,
+		&archiveRes.DepartmentId,
+		&archiveRes.BranchId,
+		&archiveRes.AppointmentDate,
+		&archiveRes.StartedAt,
+		&archiveRes.EndedAt,
 		&archiveRes.PatientProblem,
-		&archiveRes.EndTime,
-		&archiveRes.Status,
+		&archiveRes.Outcome,
 		&archiveRes.PaymentType,
-		&archiveRes.PaymentAmount,
+		&amount,
+		&archiveRes.Currency,
+		&archiveRes.InvoiceId,
 		&archiveRes.CreatedAt,
 		&upTime,
 		&delTime,
@@ -91,6 +82,10 @@ func (r *BookingArchive) CreateArchive(ctx context.Context, req *archive.Created
 		return nil, err
 	}
 
+	if err := scanAmounts([]string{amount}, &archiveRes.PaymentAmount); err != nil {
+		return nil, err
+	}
+
 	if upTime.Valid {
 		archiveRes.UpdatedAt = upTime.Time
 	}
@@ -102,14 +97,136 @@ func (r *BookingArchive) CreateArchive(ctx context.Context, req *archive.Created
 	return &archiveRes, nil
 }
 
-func (r *BookingArchive) GetArchive(ctx context.Context, req *archive.FieldValueReq) (*archive.Archive, error) {
-	ctx, span := otlp.Start(ctx, serviceNameArchive, spanNameArchiveRepo+"Get")
+// nullPaymentType stores a visit without a payment as NULL.
+func nullPaymentType(paymentType string) sql.NullString {
+	return sql.NullString{String: paymentType, Valid: paymentType != ""}
+}
+
+// CreateArchive closes out an appointment: the visit is recorded with the
+// appointment's details and the appointment takes the outcome as its status.
+func (r *BookingArchive) CreateArchive(ctx context.Context, req *archive.CreatedArchive) (*archive.Archive, error) {
+	ctx, span := otlp.Start(ctx, serviceNameArchive, spanNameArchiveRepo+"Create")
 	defer span.End()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
 	var (
-		archiveRes archive.Archive
-		upTime     sql.NullTime
-		delTime    sql.NullTime
+		patientId       string
+		doctorId        sql.NullString
+		departmentId    string
+		branchId        sql.NullString
+		appointmentDate time.Time
+		bookedFrom      time.Time
+		bookedTo        time.Time
+		currency        string
 	)
+	err = tx.QueryRow(ctx, `SELECT patient_id, doctor_id::text, department_id, branch_id::text, appointment_date,
+			appointment_date + appointment_time,
+			appointment_date + appointment_time + duration * INTERVAL '1 minute',
+			currency
+		FROM booked_appointments
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE`, req.AppointmentId).
+		Scan(&patientId, &doctorId, &departmentId, &branchId, &appointmentDate, &bookedFrom, &bookedTo, &currency)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("appointment")
+	}
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	startedAt, endedAt := req.StartedAt, req.EndedAt
+	if startedAt.IsZero() {
+		startedAt = bookedFrom
+	}
+	if endedAt.IsZero() {
+		endedAt = bookedTo
+	}
+	if endedAt.Before(startedAt) {
+		return nil, entity.NewErrFailedPrecondition("the visit cannot end before it starts")
+	}
+
+	var (
+		invoiceId   sql.NullString
+		paid        string
+		method      sql.NullString
+		paymentType = req.PaymentType
+		amount      = req.PaymentAmount
+	)
+	err = tx.QueryRow(ctx, `SELECT i.id::text, (i.paid - i.refunded)::text, i.currency,
+			(SELECT p.method FROM invoice_payments p
+			 WHERE p.invoice_id = i.id AND p.kind = 'payment'
+			 ORDER BY p.amount DESC, p.created_at LIMIT 1)
+		FROM invoices i
+		WHERE i.appointment_id = $1 AND i.status <> 'void'`, req.AppointmentId).
+		Scan(&invoiceId, &paid, &currency, &method)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, r.db.Error(err)
+	}
+	if paymentType == "" && invoiceId.Valid {
+		if err = scanAmounts([]string{paid}, &amount); err != nil {
+			return nil, err
+		}
+		if amount > 0 {
+			paymentType = method.String
+		}
+	}
+	if paymentType == "" {
+		amount = 0
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s (appointment_id, patient_id, doctor_id, department_id, branch_id, appointment_date,
+			started_at, ended_at, patient_problem, outcome, payment_type, payment_amount, currency, invoice_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		RETURNING %s`, tableNameArchive, tableColumArchive())
+
+	archiveRes, err := scanArchive(tx.QueryRow(ctx, query,
+		req.AppointmentId,
+		patientId,
+		doctorId,
+		departmentId,
+		branchId,
+		appointmentDate,
+		startedAt,
+		endedAt,
+		req.PatientProblem,
+		req.Outcome,
+		nullPaymentType(paymentType),
+		amount.String(),
+		currency,
+		invoiceId,
+	))
+	if err != nil {
+		if errors.Is(r.db.Error(err), entity.ErrorConflict) {
+			return nil, entity.NewErrConflict("archive of the appointment")
+		}
+		return nil, r.db.Error(err)
+	}
+
+	if err = r.setAppointmentOutcome(ctx, tx, req.AppointmentId, req.Outcome); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return archiveRes, nil
+}
+
+func (r *BookingArchive) setAppointmentOutcome(ctx context.Context, tx pgx.Tx, appointmentId int64, outcome string) error {
+	_, err := tx.Exec(ctx, `UPDATE booked_appointments SET status = $1, updated_at = $2 WHERE id = $3`,
+		outcome, time.Now(), appointmentId)
+	return r.db.Error(err)
+}
+
+func (r *BookingArchive) GetArchive(ctx context.Context, req *archive.FieldValueReq) (*archive.Archive, error) {
+	ctx, span := otlp.Start(ctx, serviceNameArchive, spanNameArchiveRepo+"Get")
+	defer span.End()
 
 	toSql := r.db.Sq.Builder.
 		Select(tableColumArchive()).
@@ -125,32 +242,12 @@ func (r *BookingArchive) GetArchive(ctx context.Context, req *archive.FieldValue
 		return nil, err
 	}
 
-	if err = r.db.QueryRow(ctx, toSqls, args...).Scan(
-		&archiveRes.Id,
-		&archiveRes.DoctorAvailabilityId,
-		&archiveRes.StartTime,
-		&archiveRes.PatientProblem,
-		&archiveRes.EndTime,
-		&archiveRes.Status,
-		&archiveRes.PaymentType,
-		&archiveRes.PaymentAmount,
-		&archiveRes.CreatedAt,
-		&upTime,
-		&delTime,
-	); err != nil {
-		return nil, err
-	}
-
-	if upTime.Valid {
-		archiveRes.UpdatedAt = upTime.Time
-	}
-
-	if delTime.Valid {
-		archiveRes.DeletedAt = delTime.Time
+	archiveRes, err := scanArchive(r.db.QueryRow(ctx, toSqls, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
 	}
 
-	return &archiveRes, nil
-
+	return archiveRes, nil
 }
 
 func (r *BookingArchive) GetAllArchive(ctx context.Context, req *archive.GetAllArchives) (*archive.ArchivesType, error) {
@@ -159,8 +256,6 @@ func (r *BookingArchive) GetAllArchive(ctx context.Context, req *archive.GetAllA
 	var (
 		archivesRes archive.ArchivesType
 		count       int64
-		upTime      sql.NullTime
-		delTime     sql.NullTime
 	)
 	toSql := r.db.Sq.Builder.
 		Select(tableColumArchive()).
@@ -168,127 +263,126 @@ func (r *BookingArchive) GetAllArchive(ctx context.Context, req *archive.GetAllA
 
 	countBuilder := r.db.Sq.Builder.Select("count(*)").From(tableNameArchive)
 
+	filters := map[string]interface{}{}
+	if req.PatientId != "" {
+		filters["patient_id"] = req.PatientId
+	}
+	if req.DoctorId != "" {
+		filters["doctor_id"] = req.DoctorId
+	}
+	if req.AppointmentId != 0 {
+		filters["appointment_id"] = req.AppointmentId
+	}
+	if !req.DeleteStatus {
+		filters["deleted_at"] = nil
+	}
+	if len(filters) > 0 {
+		toSql = toSql.Where(r.db.Sq.EqualMany(filters))
+		countBuilder = countBuilder.Where(r.db.Sq.EqualMany(filters))
+	}
+	if req.Value != "" {
+		toSql = toSql.Where(r.db.Sq.ILike(req.Field, req.Value+"%"))
+		countBuilder = countBuilder.Where(r.db.Sq.ILike(req.Field, req.Value+"%"))
+	}
+
 	if req.Page >= 1 && req.Limit >= 1 {
 		toSql = toSql.
 			Limit(req.Limit).
 			Offset(req.Limit * (req.Page - 1))
 	}
-	if req.Value != "" {
-		toSql = toSql.Where(r.db.Sq.ILike(req.Field, req.Value+"%"))
-	}
 	if req.OrderBy != "" {
 		toSql = toSql.OrderBy(req.OrderBy)
+	} else {
+		toSql = toSql.OrderBy("started_at DESC", "id DESC")
 	}
-	if !req.DeleteStatus {
-		toSql = toSql.Where(r.db.Sq.Equal("deleted_at", nil))
-		countBuilder = countBuilder.Where(r.db.Sq.Equal("deleted_at", nil))
-	}
-	toSqls, args, err := toSql.ToSql()
 
+	toSqls, args, err := toSql.ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	queryCount, _, err := countBuilder.ToSql()
-
-	err = r.db.QueryRow(ctx, queryCount).Scan(&count)
-
+	queryCount, countArgs, err := countBuilder.ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count); err != nil {
+		return nil, r.db.Error(err)
+	}
 
+	rows, err := r.db.Query(ctx, toSqls, args...)
 	if err != nil {
-		return nil, err
+		return nil, r.db.Error(err)
 	}
+	defer rows.Close()
 
 	for rows.Next() {
-		var archiveRes archive.Archive
-		if err = rows.Scan(
-			&archiveRes.Id,
-			&archiveRes.DoctorAvailabilityId,
-			&archiveRes.StartTime,
-			&archiveRes.PatientProblem,
-			&archiveRes.EndTime,
-			&archiveRes.Status,
-			&archiveRes.PaymentType,
-			&archiveRes.PaymentAmount,
-			&archiveRes.CreatedAt,
-			&upTime,
-			&delTime,
-		); err != nil {
+		archiveRes, err := scanArchive(rows)
+		if err != nil {
 			return nil, err
 		}
-
-		if upTime.Valid {
-			archiveRes.UpdatedAt = upTime.Time
-		}
-
-		if delTime.Valid {
-			archiveRes.DeletedAt = delTime.Time
-		}
-		archivesRes.Archives = append(archivesRes.Archives, &archiveRes)
+		archivesRes.Archives = append(archivesRes.Archives, archiveRes)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
 	archivesRes.Count = count
 
 	return &archivesRes, nil
 }
 
+// UpdateArchive corrects a closed-out visit; a changed outcome is carried over
+// to the appointment.
 func (r *BookingArchive) UpdateArchive(ctx context.Context, req *archive.UpdateArchive) (*archive.Archive, error) {
 	ctx, span := otlp.Start(ctx, serviceNameArchive, spanNameArchiveRepo+"Update")
 	defer span.End()
 
-	var (
-		archiveRes archive.Archive
-		upTime     sql.NullTime
-		delTime    sql.NullTime
-	)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	amount := req.PaymentAmount
+	if req.PaymentType == "" {
+		amount = 0
+	}
+
 	toSql, args, err := r.db.Sq.Builder.
 		Update(tableNameArchive).
 		SetMap(map[string]interface{}{
-			"doctor_availability_id": req.DoctorAvailabilityId,
-			"start_time":             req.StartTime,
-			"end_time":               req.EndTime,
-			"patient_problem":        req.PatientProblem,
-			"status":                 req.Status,
-			"payment_type":           req.PaymentType,
-			"payment_amount":         req.PaymentAmount,
-			"updated_at":             time.Now(),
+			"started_at":      req.StartedAt,
+			"ended_at":        req.EndedAt,
+			"patient_problem": req.PatientProblem,
+			"outcome":         req.Outcome,
+			"payment_type":    nullPaymentType(req.PaymentType),
+			"payment_amount":  amount.String(),
+			"updated_at":      time.Now(),
 		}).
-		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			req.Field:    req.Value,
+			"deleted_at": nil,
+		})).
 		Suffix(fmt.Sprintf("RETURNING %s", tableColumArchive())).
 		ToSql()
-
 	if err != nil {
 		return nil, err
 	}
 
-	if err = r.db.QueryRow(ctx, toSql, args...).Scan(
-		&archiveRes.Id,
-		&archiveRes.DoctorAvailabilityId,
-		&archiveRes.StartTime,
-		&archiveRes.PatientProblem,
-		&archiveRes.EndTime,
-		&archiveRes.Status,
-		&archiveRes.PaymentType,
-		&archiveRes.PaymentAmount,
-		&archiveRes.CreatedAt,
-		&upTime,
-		&delTime,
-	); err != nil {
-		return nil, err
+	archiveRes, err := scanArchive(tx.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
 	}
 
-	if upTime.Valid {
-		archiveRes.UpdatedAt = upTime.Time
+	if err = r.setAppointmentOutcome(ctx, tx, archiveRes.AppointmentId, archiveRes.Outcome); err != nil {
+		return nil, err
 	}
 
-	if delTime.Valid {
-		archiveRes.DeletedAt = delTime.Time
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
 	}
 
-	return &archiveRes, nil
+	return archiveRes, nil
 }
 
 func (r *BookingArchive) DeleteArchive(ctx context.Context, req *archive.FieldValueReq) (*archive.StatusRes, error) {
@@ -0,0 +1,605 @@
+package repo
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/billing"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNameInvoices  = "invoices"
+	serviceNameBilling = "billingRepo"
+	spanNameBilling    = "billingRepo"
+)
+
+type BookingBilling struct {
+	db *postgres.PostgresDB
+}
+
+func NewBookingBilling(db *postgres.PostgresDB) *BookingBilling {
+	return &BookingBilling{
+		db: db,
+	}
+}
+
+func tableColumInvoices() string {
+	return `id,
+			number,
+			appointment_id,
+			patient_id,
+			branch_id,
+			currency,
+			subtotal::text,
+			discount::text,
+			total::text,
+			paid::text,
+			refunded::text,
+			status,
+			created_by,
+			issued_at,
+			voided_at,
+			updated_at`
+}
+
+// invoiceQuerier is what invoice details are loaded through, the pool or a
+// transaction.
+type invoiceQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// scanAmounts parses NUMERIC columns selected as text.
+func scanAmounts(values []string, amounts ...*billing.Amount) error {
+	for i, value := range values {
+		amount, err := billing.ParseAmount(value)
+		if err != nil {
+			return fmt.Errorf("stored amount %q: %w", value, err)
+		}
+		*amounts[i] = amount
+	}
+	return nil
+}
+
+func scanInvoice(row pgx.Row) (*billing.Invoice, error) {
+	var (
+		invoice  billing.Invoice
+		branchId sql.NullString
+		amounts  = make([]string, 5)
+		voidedAt sql.NullTime
+		upTime   sql.NullTime
+	)
+
+	if err := row.Scan(
+		&invoice.Id,
+		&invoice.Number,
+		&invoice.AppointmentId,
+		&invoice.PatientId,
+		&branchId,
+		&invoice.Currency,
+		&amounts[0],
+		&amounts[1],
+		&amounts[2],
+		&amounts[3],
+		&amounts[4],
+		&invoice.Status,
+		&invoice.CreatedBy,
+		&invoice.IssuedAt,
+		&voidedAt,
+		&upTime,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := scanAmounts(amounts, &invoice.Subtotal, &invoice.Discount, &invoice.Total, &invoice.Paid, &invoice.Refunded); err != nil {
+		return nil, err
+	}
+	invoice.BranchId = branchId.String
+	if voidedAt.Valid {
+		invoice.VoidedAt = voidedAt.Time
+	}
+	if upTime.Valid {
+		invoice.UpdatedAt = upTime.Time
+	}
+
+	return &invoice, nil
+}
+
+// CreateInvoice bills the appointment: its booked doctor service at the price
+// stamped on booking, followed by the extra procedures in the request.
+func (r *BookingBilling) CreateInvoice(ctx context.Context, req *billing.CreateInvoice) (*billing.Invoice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"Create")
+	defer span.End()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		invoice         = &billing.Invoice{Id: req.Id, AppointmentId: req.AppointmentId, Discount: req.Discount, CreatedBy: req.CreatedBy}
+		branchId        sql.NullString
+		doctorServiceId sql.NullString
+		price           sql.NullString
+		modality        string
+	)
+	err = tx.QueryRow(ctx, `SELECT patient_id, branch_id, doctor_service_id, price::text, currency, modality
+		FROM booked_appointments
+		WHERE id = $1 AND deleted_at IS NULL`, req.AppointmentId).
+		Scan(&invoice.PatientId, &branchId, &doctorServiceId, &price, &invoice.Currency, &modality)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("appointment")
+	}
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	if !price.Valid || invoice.Currency == "" {
+		return nil, entity.NewErrFailedPrecondition("the appointment has no booked service price to bill")
+	}
+
+	service := &billing.Item{
+		Kind:            billing.ItemService,
+		Description:     fmt.Sprintf("Doctor service, %s appointment", modality),
+		DoctorServiceId: doctorServiceId.String,
+		Quantity:        1,
+	}
+	if err = scanAmounts([]string{price.String}, &service.UnitPrice); err != nil {
+		return nil, err
+	}
+	invoice.BranchId = branchId.String
+	invoice.Items = append([]*billing.Item{service}, req.Items...)
+	invoice.Recalculate()
+	if invoice.Discount > invoice.Subtotal {
+		return nil, discountError(invoice)
+	}
+
+	err = tx.QueryRow(ctx, fmt.Sprintf(`INSERT INTO %s
+		(id, appointment_id, patient_id, branch_id, currency, subtotal, discount, total, status, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING number, issued_at`, tableNameInvoices),
+		invoice.Id,
+		invoice.AppointmentId,
+		invoice.PatientId,
+		branchId,
+		invoice.Currency,
+		invoice.Subtotal.String(),
+		invoice.Discount.String(),
+		invoice.Total.String(),
+		invoice.Status,
+		invoice.CreatedBy,
+	).Scan(&invoice.Number, &invoice.IssuedAt)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if err = r.insertItems(ctx, tx, invoice.Id, invoice.Items); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return r.GetInvoice(ctx, &billing.FieldValueReq{Field: "id", Value: invoice.Id})
+}
+
+func (r *BookingBilling) GetInvoice(ctx context.Context, req *billing.FieldValueReq) (*billing.Invoice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"Get")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumInvoices()).
+		From(tableNameInvoices).
+		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, err := scanInvoice(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if err = loadInvoiceDetails(ctx, r.db, []*billing.Invoice{invoice}); err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+func (r *BookingBilling) GetAllInvoices(ctx context.Context, req *billing.GetAllInvoices) (*billing.InvoicesType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"List")
+	defer span.End()
+
+	var invoices billing.InvoicesType
+
+	where := r.db.Sq.And()
+	if req.PatientId != "" {
+		where = append(where, r.db.Sq.Equal("patient_id", req.PatientId))
+	}
+	if req.BranchId != "" {
+		where = append(where, r.db.Sq.Equal("branch_id", req.BranchId))
+	}
+	if req.AppointmentId > 0 {
+		where = append(where, r.db.Sq.Equal("appointment_id", req.AppointmentId))
+	}
+	if req.Status != "" {
+		where = append(where, r.db.Sq.Equal("status", req.Status))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumInvoices()).
+		From(tableNameInvoices).
+		Where(where).
+		OrderBy("issued_at DESC")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNameInvoices).Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&invoices.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		invoice, err := scanInvoice(rows)
+		if err != nil {
+			return nil, err
+		}
+		invoices.Invoices = append(invoices.Invoices, invoice)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if err = loadInvoiceDetails(ctx, r.db, invoices.Invoices); err != nil {
+		return nil, err
+	}
+
+	return &invoices, nil
+}
+
+// AddInvoiceItem adds a procedure to an invoice nothing is paid on yet.
+func (r *BookingBilling) AddInvoiceItem(ctx context.Context, req *billing.AddItem) (*billing.Invoice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"AddItem")
+	defer span.End()
+
+	return r.changeInvoice(ctx, req.InvoiceId, func(tx pgx.Tx, invoice *billing.Invoice) error {
+		if err := unpaid(invoice); err != nil {
+			return err
+		}
+		invoice.Items = append(invoice.Items, req.Item)
+		invoice.Recalculate()
+		return r.insertItems(ctx, tx, invoice.Id, []*billing.Item{req.Item})
+	})
+}
+
+// SetInvoiceDiscount replaces the discount of an invoice nothing is paid on yet.
+func (r *BookingBilling) SetInvoiceDiscount(ctx context.Context, req *billing.SetDiscount) (*billing.Invoice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"Discount")
+	defer span.End()
+
+	return r.changeInvoice(ctx, req.InvoiceId, func(tx pgx.Tx, invoice *billing.Invoice) error {
+		if err := unpaid(invoice); err != nil {
+			return err
+		}
+		invoice.Discount = req.Discount
+		invoice.Recalculate()
+		if invoice.Discount > invoice.Subtotal {
+			return discountError(invoice)
+		}
+		return nil
+	})
+}
+
+// RecordTransaction takes a payment of at most the balance, or refunds at
+// most what has been paid.
+func (r *BookingBilling) RecordTransaction(ctx context.Context, req *billing.Transaction) (*billing.Invoice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"Transaction")
+	defer span.End()
+
+	return r.changeInvoice(ctx, req.InvoiceId, func(tx pgx.Tx, invoice *billing.Invoice) error {
+		switch {
+		case invoice.Status == billing.StatusVoid:
+			return entity.NewErrFailedPrecondition("the invoice is void")
+		case req.Kind == billing.KindPayment && req.Amount > invoice.Balance():
+			return entity.NewErrFailedPrecondition(fmt.Sprintf("the payment exceeds the balance of %s %s", invoice.Balance(), invoice.Currency))
+		case req.Kind == billing.KindRefund && req.Amount > invoice.Net():
+			return entity.NewErrFailedPrecondition(fmt.Sprintf("the refund exceeds the %s %s paid", invoice.Net(), invoice.Currency))
+		}
+
+		if req.Kind == billing.KindPayment {
+			invoice.Paid += req.Amount
+		} else {
+			invoice.Refunded += req.Amount
+		}
+		invoice.Recalculate()
+
+		_, err := tx.Exec(ctx, `INSERT INTO invoice_payments
+			(id, invoice_id, kind, method, amount, currency, cashier_id, branch_id, reference)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			req.Id,
+			invoice.Id,
+			req.Kind,
+			req.Method,
+			req.Amount.String(),
+			invoice.Currency,
+			req.CashierId,
+			nullString(invoice.BranchId),
+			req.Reference,
+		)
+		return r.db.Error(err)
+	})
+}
+
+// VoidInvoice cancels an invoice nothing has been paid on.
+func (r *BookingBilling) VoidInvoice(ctx context.Context, req *billing.FieldValueReq) (*billing.Invoice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"Void")
+	defer span.End()
+
+	return r.changeInvoice(ctx, req.Value, func(tx pgx.Tx, invoice *billing.Invoice) error {
+		if invoice.Status == billing.StatusVoid {
+			return entity.NewErrFailedPrecondition("the invoice is already void")
+		}
+		if invoice.Paid > 0 {
+			return entity.NewErrFailedPrecondition("an invoice with payments cannot be voided, refund it instead")
+		}
+		invoice.Status = billing.StatusVoid
+		invoice.VoidedAt = time.Now()
+		return nil
+	})
+}
+
+// GetCashDeskReport sums the branch's payments and refunds of one day per
+// cashier, method and currency.
+func (r *BookingBilling) GetCashDeskReport(ctx context.Context, req *billing.CashDeskReportReq) (*billing.CashDeskReport, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"CashDesk")
+	defer span.End()
+
+	from := time.Date(req.Date.Year(), req.Date.Month(), req.Date.Day(), 0, 0, 0, 0, time.Local)
+	where := r.db.Sq.And(
+		r.db.Sq.Equal("branch_id", req.BranchId),
+		r.db.Sq.Expr("created_at >= ?", from),
+		r.db.Sq.Lt("created_at", from.AddDate(0, 0, 1)),
+	)
+	if req.CashierId != "" {
+		where = append(where, r.db.Sq.Equal("cashier_id", req.CashierId))
+	}
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select("cashier_id, method, currency, kind, sum(amount)::text, count(*)").
+		From("invoice_payments").
+		Where(where).
+		GroupBy("cashier_id", "method", "currency", "kind").
+		OrderBy("cashier_id", "method", "currency").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	report := &billing.CashDeskReport{BranchId: req.BranchId, Date: from}
+	byKey := map[string]*billing.CashDeskRow{}
+	totals := map[string]*billing.CashDeskTotal{}
+	for rows.Next() {
+		var (
+			row   billing.CashDeskRow
+			kind  string
+			sum   string
+			count int64
+		)
+		if err = rows.Scan(&row.CashierId, &row.Method, &row.Currency, &kind, &sum, &count); err != nil {
+			return nil, err
+		}
+		var amount billing.Amount
+		if err = scanAmounts([]string{sum}, &amount); err != nil {
+			return nil, err
+		}
+
+		key := row.CashierId + "\x00" + row.Method + "\x00" + row.Currency
+		if byKey[key] == nil {
+			byKey[key] = &row
+			report.Rows = append(report.Rows, &row)
+		}
+		if totals[row.Currency] == nil {
+			totals[row.Currency] = &billing.CashDeskTotal{Currency: row.Currency}
+			report.Totals = append(report.Totals, totals[row.Currency])
+		}
+		if kind == billing.KindPayment {
+			byKey[key].Payments += amount
+			byKey[key].PaymentCount += count
+			totals[row.Currency].Payments += amount
+		} else {
+			byKey[key].Refunds += amount
+			byKey[key].RefundCount += count
+			totals[row.Currency].Refunds += amount
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// changeInvoice locks the invoice, lets change apply a change to it and
+// stores the recalculated amounts and status.
+func (r *BookingBilling) changeInvoice(ctx context.Context, id string, change func(tx pgx.Tx, invoice *billing.Invoice) error) (*billing.Invoice, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	invoice, err := scanInvoice(tx.QueryRow(ctx, fmt.Sprintf("SELECT %s FROM %s WHERE id = $1 FOR UPDATE", tableColumInvoices(), tableNameInvoices), id))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	if err = loadInvoiceDetails(ctx, tx, []*billing.Invoice{invoice}); err != nil {
+		return nil, err
+	}
+
+	if err = change(tx, invoice); err != nil {
+		return nil, err
+	}
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameInvoices).
+		SetMap(map[string]interface{}{
+			"subtotal":   invoice.Subtotal.String(),
+			"discount":   invoice.Discount.String(),
+			"total":      invoice.Total.String(),
+			"paid":       invoice.Paid.String(),
+			"refunded":   invoice.Refunded.String(),
+			"status":     invoice.Status,
+			"voided_at":  nullDate(invoice.VoidedAt),
+			"updated_at": time.Now(),
+		}).
+		Where(r.db.Sq.Equal("id", invoice.Id)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return r.GetInvoice(ctx, &billing.FieldValueReq{Field: "id", Value: id})
+}
+
+func (r *BookingBilling) insertItems(ctx context.Context, tx pgx.Tx, invoiceId string, items []*billing.Item) error {
+	insert := r.db.Sq.Builder.
+		Insert("invoice_items").
+		Columns("invoice_id, kind, description, doctor_service_id, quantity, unit_price, amount")
+	for _, item := range items {
+		insert = insert.Values(
+			invoiceId,
+			item.Kind,
+			item.Description,
+			nullString(item.DoctorServiceId),
+			item.Quantity,
+			item.UnitPrice.String(),
+			item.Amount.String(),
+		)
+	}
+
+	toSql, args, err := insert.ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, toSql, args...)
+	return r.db.Error(err)
+}
+
+func unpaid(invoice *billing.Invoice) error {
+	if invoice.Status != billing.StatusIssued || invoice.Paid > 0 {
+		return entity.NewErrFailedPrecondition("a " + invoice.Status + " invoice cannot be changed")
+	}
+	return nil
+}
+
+func discountError(invoice *billing.Invoice) error {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid discount")
+	errValidation.Errors["discount"] = fmt.Sprintf("cannot exceed the subtotal of %s %s", invoice.Subtotal, invoice.Currency)
+	return errValidation
+}
+
+// loadInvoiceDetails fills items and payments of the given invoices.
+func loadInvoiceDetails(ctx context.Context, db invoiceQuerier, list []*billing.Invoice) error {
+	if len(list) == 0 {
+		return nil
+	}
+
+	byId := make(map[string]*billing.Invoice, len(list))
+	ids := make([]string, 0, len(list))
+	for _, invoice := range list {
+		invoice.Items, invoice.Payments = nil, nil
+		byId[invoice.Id] = invoice
+		ids = append(ids, invoice.Id)
+	}
+
+	rows, err := db.Query(ctx, `SELECT invoice_id::text, id, kind, description, COALESCE(doctor_service_id::text, ''), quantity, unit_price::text, amount::text
+		FROM invoice_items WHERE invoice_id = ANY($1) ORDER BY id`, ids)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var (
+			invoiceId string
+			item      billing.Item
+			amounts   = make([]string, 2)
+		)
+		if err = rows.Scan(&invoiceId, &item.Id, &item.Kind, &item.Description, &item.DoctorServiceId, &item.Quantity, &amounts[0], &amounts[1]); err != nil {
+			rows.Close()
+			return err
+		}
+		if err = scanAmounts(amounts, &item.UnitPrice, &item.Amount); err != nil {
+			rows.Close()
+			return err
+		}
+		byId[invoiceId].Items = append(byId[invoiceId].Items, &item)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	rows, err = db.Query(ctx, `SELECT invoice_id::text, id::text, kind, method, amount::text, cashier_id, COALESCE(branch_id::text, ''), reference, created_at
+		FROM invoice_payments WHERE invoice_id = ANY($1) ORDER BY created_at, id`, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			payment billing.Payment
+			amount  string
+		)
+		if err = rows.Scan(&payment.InvoiceId, &payment.Id, &payment.Kind, &payment.Method, &amount, &payment.CashierId, &payment.BranchId, &payment.Reference, &payment.CreatedAt); err != nil {
+			return err
+		}
+		if err = scanAmounts([]string{amount}, &payment.Amount); err != nil {
+			return err
+		}
+		byId[payment.InvoiceId].Payments = append(byId[payment.InvoiceId].Payments, &payment)
+	}
+
+	return rows.Err()
+}
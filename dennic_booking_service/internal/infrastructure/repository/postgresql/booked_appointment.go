@@ -1,19 +1,23 @@
 package repo
 
 import (
+	"booking_service/internal/entity"
 	appointment "booking_service/internal/entity/booked_appointments"
 	"booking_service/internal/pkg/otlp"
 	"booking_service/internal/pkg/postgres"
 	"context"
 	"database/sql"
 	"fmt"
+	"github.com/jackc/pgx/v4"
+	"github.com/rickb777/date"
 	"time"
 )
 
 const (
-	tableNameAppointment    = "booked_appointments"
-	serviceNameAppointment  = "appointment"
-	spanNameAppointmentRepo = "appointment"
+	tableNameAppointment         = "booked_appointments"
+	tableNameResourceReservation = "resource_reservations"
+	serviceNameAppointment       = "appointment"
+	spanNameAppointmentRepo      = "appointment"
 )
 
 type BookingAppointment struct {
@@ -29,7 +33,9 @@ func NewBookingAppointment(db *postgres.PostgresDB) *BookingAppointment {
 func tableColums() string {
 	return `id, 
 			department_id, 
+			branch_id, 
 			doctor_id, 
+			resource_id, 
 			patient_id, 
 			appointment_date, 
 			appointment_time, 
@@ -37,6 +43,13 @@ func tableColums() string {
 			key, 
 			expires_at, 
 			patient_status, 
+			modality, 
+			COALESCE(doctor_service_id::text, ''), 
+			COALESCE(price::text, ''), 
+			currency, 
+			video_room_id, 
+			video_provider, 
+			status, 
 			created_at, 
 			updated_at, 
 			deleted_at`
@@ -46,42 +59,99 @@ func (r *BookingAppointment) CreateAppointment(ctx context.Context, req *appoint
 	ctx, span := otlp.Start(ctx, serviceNameAppointment, spanNameAppointmentRepo+"Create")
 	defer span.End()
 	var (
-		response appointment.Appointment
-		upAt     sql.NullTime
-		delAt    sql.NullTime
+		response   appointment.Appointment
+		upAt       sql.NullTime
+		delAt      sql.NullTime
+		branchId   sql.NullString
+		resourceId sql.NullString
 	)
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if req.Modality == "" {
+		req.Modality = appointment.ModalityOffline
+	}
+
+	from, to := appointmentPeriod(req.AppointmentDate, req.AppointmentTime, req.Duration)
+
+	// The doctor and the resource are locked for the rest of the transaction,
+	// so concurrent bookings of either one are checked one after another.
+	if err = lockBookingKey(ctx, tx, "doctor:"+req.DoctorId); err != nil {
+		return nil, err
+	}
+	if req.ResourceId != "" {
+		if err = lockBookingKey(ctx, tx, "resource:"+req.ResourceId); err != nil {
+			return nil, err
+		}
+	}
+
+	busy, err := doctorIsBusy(ctx, tx, req.DoctorId, 0, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if busy {
+		return nil, entity.NewErrConflict("doctor appointment")
+	}
+	if req.ResourceId != "" {
+		busy, err = resourceIsBusy(ctx, tx, req.ResourceId, from, to)
+		if err != nil {
+			return nil, err
+		}
+		if busy {
+			return nil, entity.NewErrConflict("resource reservation")
+		}
+	}
+
 	toSql, args, err := r.db.Sq.Builder.
 		Insert(tableNameAppointment).
 		Columns(` 
 			department_id, 
+			branch_id, 
 			doctor_id, 
+			resource_id, 
 			patient_id, 
 			appointment_date, 
 			appointment_time, 
 			duration, 
 			key, 
 			expires_at, 
-			patient_status`).
+			patient_status, 
+			modality, 
+			doctor_service_id, 
+			price, 
+			currency`).
 		Values(
 			req.DepartmentId,
+			nullString(req.BranchId),
 			req.DoctorId,
+			nullString(req.ResourceId),
 			req.PatientId,
 			req.AppointmentDate.String(),
 			req.AppointmentTime,
 			req.Duration,
 			req.Key,
 			req.ExpiresAt,
-			req.PatientStatus).
+			req.PatientStatus,
+			req.Modality,
+			nullString(req.DoctorServiceId),
+			nullString(req.Price),
+			req.Currency).
 		Suffix(fmt.Sprintf("RETURNING %s", tableColums())).
 		ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	if err = r.db.QueryRow(ctx, toSql, args...).Scan(
+	if err = tx.QueryRow(ctx, toSql, args...).Scan(
 		&response.Id,
 		&response.DepartmentId,
+		&branchId,
 		&response.DoctorId,
+		&resourceId,
 		&response.PatientId,
 		&response.AppointmentDate,
 		&response.AppointmentTime,
@@ -89,11 +159,36 @@ func (r *BookingAppointment) CreateAppointment(ctx context.Context, req *appoint
 		&response.Key,
 		&response.ExpiresAt,
 		&response.PatientStatus,
+		&response.Modality,
+		&response.DoctorServiceId,
+		&response.Price,
+		&response.Currency,
+		&response.VideoRoomId,
+		&response.VideoProvider,
+		&response.Status,
 		&response.CreatedAt,
 		&upAt,
 		&delAt,
 	); err != nil {
-		return nil, err
+		return nil, r.db.Error(err)
+	}
+
+	if req.ResourceId != "" {
+		toSql, args, err = r.db.Sq.Builder.
+			Insert(tableNameResourceReservation).
+			Columns("appointment_id, resource_id, reserved_from, reserved_to").
+			Values(response.Id, req.ResourceId, from, to).
+			ToSql()
+		if err != nil {
+			return nil, err
+		}
+		if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+			return nil, r.db.Error(err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
 	}
 
 	if upAt.Valid {
@@ -104,6 +199,9 @@ func (r *BookingAppointment) CreateAppointment(ctx context.Context, req *appoint
 		response.DeletedAt = delAt.Time
 	}
 
+	response.BranchId = branchId.String
+	response.ResourceId = resourceId.String
+
 	return &response, nil
 }
 
@@ -112,9 +210,11 @@ func (r *BookingAppointment) GetAppointment(ctx context.Context, req *appointmen
 	defer span.End()
 
 	var (
-		response appointment.Appointment
-		upAt     sql.NullTime
-		delAt    sql.NullTime
+		response   appointment.Appointment
+		upAt       sql.NullTime
+		delAt      sql.NullTime
+		branchId   sql.NullString
+		resourceId sql.NullString
 	)
 
 	toSql := r.db.Sq.Builder.
@@ -132,7 +232,9 @@ func (r *BookingAppointment) GetAppointment(ctx context.Context, req *appointmen
 	if err = r.db.QueryRow(ctx, toSqls, args...).Scan(
 		&response.Id,
 		&response.DepartmentId,
+		&branchId,
 		&response.DoctorId,
+		&resourceId,
 		&response.PatientId,
 		&response.AppointmentDate,
 		&response.AppointmentTime,
@@ -140,6 +242,13 @@ func (r *BookingAppointment) GetAppointment(ctx context.Context, req *appointmen
 		&response.Key,
 		&response.ExpiresAt,
 		&response.PatientStatus,
+		&response.Modality,
+		&response.DoctorServiceId,
+		&response.Price,
+		&response.Currency,
+		&response.VideoRoomId,
+		&response.VideoProvider,
+		&response.Status,
 		&response.CreatedAt,
 		&upAt,
 		&delAt,
@@ -155,6 +264,9 @@ func (r *BookingAppointment) GetAppointment(ctx context.Context, req *appointmen
 		response.DeletedAt = delAt.Time
 	}
 
+	response.BranchId = branchId.String
+	response.ResourceId = resourceId.String
+
 	return &response, nil
 }
 
@@ -163,10 +275,12 @@ func (r *BookingAppointment) GetAllAppointment(ctx context.Context, req *appoint
 	defer span.End()
 
 	var (
-		response appointment.AppointmentsType
-		count    int64
-		upAt     sql.NullTime
-		delAt    sql.NullTime
+		response   appointment.AppointmentsType
+		count      int64
+		upAt       sql.NullTime
+		delAt      sql.NullTime
+		branchId   sql.NullString
+		resourceId sql.NullString
 	)
 
 	toSql := r.db.Sq.Builder.
@@ -191,6 +305,18 @@ func (r *BookingAppointment) GetAllAppointment(ctx context.Context, req *appoint
 		toSql = toSql.Where(r.db.Sq.Equal("deleted_at", nil))
 		countBuilder = countBuilder.Where(r.db.Sq.Equal("deleted_at", nil))
 	}
+	if req.BranchId != "" {
+		toSql = toSql.Where(r.db.Sq.Equal("branch_id", req.BranchId))
+		countBuilder = countBuilder.Where(r.db.Sq.Equal("branch_id", req.BranchId))
+	}
+	if req.DoctorId != "" {
+		toSql = toSql.Where(r.db.Sq.Equal("doctor_id", req.DoctorId))
+		countBuilder = countBuilder.Where(r.db.Sq.Equal("doctor_id", req.DoctorId))
+	}
+	if req.PatientId != "" {
+		toSql = toSql.Where(r.db.Sq.Equal("patient_id", req.PatientId))
+		countBuilder = countBuilder.Where(r.db.Sq.Equal("patient_id", req.PatientId))
+	}
 
 	toSqls, args, err := toSql.ToSql()
 
@@ -198,9 +324,12 @@ func (r *BookingAppointment) GetAllAppointment(ctx context.Context, req *appoint
 		return nil, err
 	}
 
-	queryCount, _, err := countBuilder.ToSql()
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
 
-	err = r.db.QueryRow(ctx, queryCount).Scan(&count)
+	err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
 
 	if err != nil {
 		return nil, err
@@ -217,7 +346,9 @@ func (r *BookingAppointment) GetAllAppointment(ctx context.Context, req *appoint
 		if err := rows.Scan(
 			&res.Id,
 			&res.DepartmentId,
+			&branchId,
 			&res.DoctorId,
+			&resourceId,
 			&res.PatientId,
 			&res.AppointmentDate,
 			&res.AppointmentTime,
@@ -225,6 +356,13 @@ func (r *BookingAppointment) GetAllAppointment(ctx context.Context, req *appoint
 			&res.Key,
 			&res.ExpiresAt,
 			&res.PatientStatus,
+			&res.Modality,
+			&res.DoctorServiceId,
+			&res.Price,
+			&res.Currency,
+			&res.VideoRoomId,
+			&res.VideoProvider,
+			&res.Status,
 			&res.CreatedAt,
 			&upAt,
 			&delAt,
@@ -240,6 +378,9 @@ func (r *BookingAppointment) GetAllAppointment(ctx context.Context, req *appoint
 			res.DeletedAt = delAt.Time
 		}
 
+		res.BranchId = branchId.String
+		res.ResourceId = resourceId.String
+
 		response.Appointments = append(response.Appointments, &res)
 	}
 	response.Count = count
@@ -251,11 +392,51 @@ func (r *BookingAppointment) UpdateAppointment(ctx context.Context, req *appoint
 	defer span.End()
 
 	var (
-		response appointment.Appointment
-		upAt     sql.NullTime
-		delAt    sql.NullTime
+		response   appointment.Appointment
+		upAt       sql.NullTime
+		delAt      sql.NullTime
+		branchId   sql.NullString
+		resourceId sql.NullString
+	)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Rescheduling is checked against the other appointments of the doctor
+	// under the same lock as booking, as there is no constraint for it.
+	var (
+		id       int64
+		doctorId sql.NullString
 	)
 	toSql, args, err := r.db.Sq.Builder.
+		Select("id", "doctor_id").
+		From(tableNameAppointment).
+		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.QueryRow(ctx, toSql, args...).Scan(&id, &doctorId); err != nil {
+		return nil, r.db.Error(err)
+	}
+	if doctorId.Valid {
+		if err = lockBookingKey(ctx, tx, "doctor:"+doctorId.String); err != nil {
+			return nil, err
+		}
+		from, to := appointmentPeriod(req.AppointmentDate, req.AppointmentTime, req.Duration)
+		busy, err := doctorIsBusy(ctx, tx, doctorId.String, id, from, to)
+		if err != nil {
+			return nil, err
+		}
+		if busy {
+			return nil, entity.NewErrConflict("doctor appointment")
+		}
+	}
+
+	toSql, args, err = r.db.Sq.Builder.
 		Update(tableNameAppointment).
 		SetMap(map[string]interface{}{
 			"appointment_date": req.AppointmentDate.String(),
@@ -266,17 +447,19 @@ func (r *BookingAppointment) UpdateAppointment(ctx context.Context, req *appoint
 			"patient_status":   req.PatientStatus,
 			"updated_at":       time.Now(),
 		}).
-		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		Where(r.db.Sq.Equal("id", id)).
 		Suffix(fmt.Sprintf("RETURNING %s", tableColums())).
 		ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	if err = r.db.QueryRow(ctx, toSql, args...).Scan(
+	if err = tx.QueryRow(ctx, toSql, args...).Scan(
 		&response.Id,
 		&response.DepartmentId,
+		&branchId,
 		&response.DoctorId,
+		&resourceId,
 		&response.PatientId,
 		&response.AppointmentDate,
 		&response.AppointmentTime,
@@ -284,11 +467,46 @@ func (r *BookingAppointment) UpdateAppointment(ctx context.Context, req *appoint
 		&response.Key,
 		&response.ExpiresAt,
 		&response.PatientStatus,
+		&response.Modality,
+		&response.DoctorServiceId,
+		&response.Price,
+		&response.Currency,
+		&response.VideoRoomId,
+		&response.VideoProvider,
+		&response.Status,
 		&response.CreatedAt,
 		&upAt,
 		&delAt,
 	); err != nil {
-		return nil, err
+		return nil, r.db.Error(err)
+	}
+
+	// Rescheduling moves the resource reservation with the appointment; an
+	// overlap with another reservation is rejected by the exclusion constraint.
+	if resourceId.Valid {
+		from, to := appointmentPeriod(response.AppointmentDate, response.AppointmentTime, response.Duration)
+		toSql, args, err = r.db.Sq.Builder.
+			Update(tableNameResourceReservation).
+			SetMap(map[string]interface{}{
+				"reserved_from": from,
+				"reserved_to":   to,
+				"updated_at":    time.Now(),
+			}).
+			Where(r.db.Sq.EqualMany(map[string]interface{}{
+				"appointment_id": response.Id,
+				"deleted_at":     nil,
+			})).
+			ToSql()
+		if err != nil {
+			return nil, err
+		}
+		if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+			return nil, r.db.Error(err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
 	}
 
 	if upAt.Valid {
@@ -299,6 +517,9 @@ func (r *BookingAppointment) UpdateAppointment(ctx context.Context, req *appoint
 		response.DeletedAt = delAt.Time
 	}
 
+	response.BranchId = branchId.String
+	response.ResourceId = resourceId.String
+
 	return &response, nil
 }
 
@@ -306,7 +527,27 @@ func (r *BookingAppointment) DeleteAppointment(ctx context.Context, req *appoint
 	ctx, span := otlp.Start(ctx, serviceNameAppointment, spanNameAppointmentRepo+"Delete")
 	defer span.End()
 	if !req.DeleteStatus {
+		tx, err := r.db.Begin(ctx)
+		if err != nil {
+			return &appointment.StatusRes{Status: false}, err
+		}
+		defer tx.Rollback(ctx)
+
+		// Release the resources held by the appointments being cancelled.
 		toSql, args, err := r.db.Sq.Builder.
+			Update(tableNameResourceReservation).
+			Set("deleted_at", time.Now()).
+			Where(r.db.Sq.Equal("deleted_at", nil)).
+			Where(fmt.Sprintf("appointment_id IN (SELECT id FROM %s WHERE deleted_at IS NULL AND %s = ?)", tableNameAppointment, req.Field), req.Value).
+			ToSql()
+		if err != nil {
+			return &appointment.StatusRes{Status: false}, err
+		}
+		if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+			return &appointment.StatusRes{Status: false}, err
+		}
+
+		toSql, args, err = r.db.Sq.Builder.
 			Update(tableNameAppointment).
 			Set("deleted_at", time.Now()).
 			Where(r.db.Sq.EqualMany(map[string]interface{}{
@@ -318,11 +559,14 @@ func (r *BookingAppointment) DeleteAppointment(ctx context.Context, req *appoint
 			return &appointment.StatusRes{Status: false}, err
 		}
 
-		resp, err := r.db.Exec(ctx, toSql, args...)
+		resp, err := tx.Exec(ctx, toSql, args...)
 
 		if err != nil {
 			return &appointment.StatusRes{Status: false}, err
 		}
+		if err = tx.Commit(ctx); err != nil {
+			return &appointment.StatusRes{Status: false}, err
+		}
 		if resp.RowsAffected() > 0 {
 			return &appointment.StatusRes{Status: true}, nil
 		}
@@ -349,3 +593,215 @@ func (r *BookingAppointment) DeleteAppointment(ctx context.Context, req *appoint
 		return &appointment.StatusRes{Status: false}, nil
 	}
 }
+
+func (r *BookingAppointment) ConfirmAppointment(ctx context.Context, req *appointment.ConfirmAppointment) (*appointment.Appointment, error) {
+	ctx, span := otlp.Start(ctx, serviceNameAppointment, spanNameAppointmentRepo+"Confirm")
+	defer span.End()
+
+	var (
+		response   appointment.Appointment
+		upAt       sql.NullTime
+		delAt      sql.NullTime
+		branchId   sql.NullString
+		resourceId sql.NullString
+	)
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameAppointment).
+		SetMap(map[string]interface{}{
+			"patient_status": true,
+			"video_room_id":  req.VideoRoomId,
+			"video_provider": req.VideoProvider,
+			"updated_at":     time.Now(),
+		}).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"id":         req.Id,
+			"deleted_at": nil,
+		})).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColums())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, toSql, args...).Scan(
+		&response.Id,
+		&response.DepartmentId,
+		&branchId,
+		&response.DoctorId,
+		&resourceId,
+		&response.PatientId,
+		&response.AppointmentDate,
+		&response.AppointmentTime,
+		&response.Duration,
+		&response.Key,
+		&response.ExpiresAt,
+		&response.PatientStatus,
+		&response.Modality,
+		&response.DoctorServiceId,
+		&response.Price,
+		&response.Currency,
+		&response.VideoRoomId,
+		&response.VideoProvider,
+		&response.Status,
+		&response.CreatedAt,
+		&upAt,
+		&delAt,
+	); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if upAt.Valid {
+		response.UpdatedAt = upAt.Time
+	}
+
+	if delAt.Valid {
+		response.DeletedAt = delAt.Time
+	}
+
+	response.BranchId = branchId.String
+	response.ResourceId = resourceId.String
+
+	return &response, nil
+}
+
+func (r *BookingAppointment) SetAppointmentStatus(ctx context.Context, req *appointment.AppointmentStatusReq) (*appointment.Appointment, error) {
+	ctx, span := otlp.Start(ctx, serviceNameAppointment, spanNameAppointmentRepo+"SetStatus")
+	defer span.End()
+
+	var (
+		response   appointment.Appointment
+		upAt       sql.NullTime
+		delAt      sql.NullTime
+		branchId   sql.NullString
+		resourceId sql.NullString
+	)
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameAppointment).
+		SetMap(map[string]interface{}{
+			"status":     req.Status,
+			"updated_at": time.Now(),
+		}).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"id":         req.Id,
+			"deleted_at": nil,
+		})).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColums())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.QueryRow(ctx, toSql, args...).Scan(
+		&response.Id,
+		&response.DepartmentId,
+		&branchId,
+		&response.DoctorId,
+		&resourceId,
+		&response.PatientId,
+		&response.AppointmentDate,
+		&response.AppointmentTime,
+		&response.Duration,
+		&response.Key,
+		&response.ExpiresAt,
+		&response.PatientStatus,
+		&response.Modality,
+		&response.DoctorServiceId,
+		&response.Price,
+		&response.Currency,
+		&response.VideoRoomId,
+		&response.VideoProvider,
+		&response.Status,
+		&response.CreatedAt,
+		&upAt,
+		&delAt,
+	); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	// A cancelled or missed visit frees the resources it held.
+	if req.Status == appointment.StatusCancelled || req.Status == appointment.StatusNoShow {
+		toSql, args, err = r.db.Sq.Builder.
+			Update(tableNameResourceReservation).
+			Set("deleted_at", time.Now()).
+			Where(r.db.Sq.EqualMany(map[string]interface{}{
+				"appointment_id": response.Id,
+				"deleted_at":     nil,
+			})).
+			ToSql()
+		if err != nil {
+			return nil, err
+		}
+		if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+			return nil, r.db.Error(err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if upAt.Valid {
+		response.UpdatedAt = upAt.Time
+	}
+
+	if delAt.Valid {
+		response.DeletedAt = delAt.Time
+	}
+
+	response.BranchId = branchId.String
+	response.ResourceId = resourceId.String
+
+	return &response, nil
+}
+
+// appointmentPeriod returns the wall-clock interval occupied by an appointment.
+// Duration is stored in minutes.
+func appointmentPeriod(day date.Date, at time.Time, duration int64) (time.Time, time.Time) {
+	from := day.UTC().Add(time.Duration(at.Hour())*time.Hour +
+		time.Duration(at.Minute())*time.Minute +
+		time.Duration(at.Second())*time.Second)
+	return from, from.Add(time.Duration(duration) * time.Minute)
+}
+
+func lockBookingKey(ctx context.Context, tx pgx.Tx, key string) error {
+	_, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", key)
+	return err
+}
+
+// doctorIsBusy tells whether another appointment of the doctor than exceptId
+// overlaps from-to; 0 checks them all. Cancelled and missed visits hold no
+// time.
+func doctorIsBusy(ctx context.Context, tx pgx.Tx, doctorId string, exceptId int64, from, to time.Time) (bool, error) {
+	var busy bool
+	err := tx.QueryRow(ctx, `SELECT EXISTS (
+			SELECT 1 FROM booked_appointments
+			WHERE doctor_id = $1
+			  AND id <> $4
+			  AND deleted_at IS NULL
+			  AND status NOT IN ($5, $6)
+			  AND appointment_date + appointment_time < $3
+			  AND appointment_date + appointment_time + make_interval(mins => duration::int) > $2)`,
+		doctorId, from, to, exceptId, appointment.StatusCancelled, appointment.StatusNoShow).Scan(&busy)
+	return busy, err
+}
+
+func resourceIsBusy(ctx context.Context, tx pgx.Tx, resourceId string, from, to time.Time) (bool, error) {
+	var busy bool
+	err := tx.QueryRow(ctx, `SELECT EXISTS (
+			SELECT 1 FROM resource_reservations rr
+			JOIN booked_appointments ba ON ba.id = rr.appointment_id
+			WHERE rr.resource_id = $1
+			  AND rr.deleted_at IS NULL
+			  AND ba.status NOT IN ($4, $5)
+			  AND tsrange(rr.reserved_from, rr.reserved_to) && tsrange($2, $3))`,
+		resourceId, from, to, appointment.StatusCancelled, appointment.StatusNoShow).Scan(&busy)
+	return busy, err
+}
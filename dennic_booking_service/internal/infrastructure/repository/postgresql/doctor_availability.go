@@ -29,6 +29,7 @@ func NewDoctorAvailability(db *postgres.PostgresDB) *DoctorAvailability {
 func tableColumDoctorAvailability() string {
 	return `id,
 			department_id,
+			branch_id,
 			doctor_id,
 			doctor_date,
 			start_time,
@@ -47,11 +48,13 @@ func (r *DoctorAvailability) CreateDoctorAvailability(ctx context.Context, req *
 		docAvail doctor_availability.DoctorAvailability
 		upAt     sql.NullTime
 		delAt    sql.NullTime
+		branchId sql.NullString
 	)
 
 	toSql, args, err := r.db.Sq.Builder.
 		Insert(tableNameDoctorAvailability).
 		Columns(`department_id,
+			branch_id,
 			doctor_id,
 			doctor_date,
 			start_time,
@@ -59,6 +62,7 @@ func (r *DoctorAvailability) CreateDoctorAvailability(ctx context.Context, req *
 			status`).
 		Values(
 			req.DepartmentId,
+			nullString(req.BranchId),
 			req.DoctorId,
 			req.DoctorDate.String(),
 			req.StartTime,
@@ -75,6 +79,7 @@ func (r *DoctorAvailability) CreateDoctorAvailability(ctx context.Context, req *
 	if err = r.db.QueryRow(ctx, toSql, args...).Scan(
 		&docAvail.Id,
 		&docAvail.DepartmentId,
+		&branchId,
 		&docAvail.DoctorId,
 		&docAvail.DoctorDate,
 		&docAvail.StartTime,
@@ -95,6 +100,8 @@ func (r *DoctorAvailability) CreateDoctorAvailability(ctx context.Context, req *
 		docAvail.DeletedAt = delAt.Time
 	}
 
+	docAvail.BranchId = branchId.String
+
 	return &docAvail, nil
 }
 
@@ -106,6 +113,7 @@ func (r *DoctorAvailability) GetDoctorAvailability(ctx context.Context, req *doc
 		docAvail doctor_availability.DoctorAvailability
 		upAt     sql.NullTime
 		delAt    sql.NullTime
+		branchId sql.NullString
 	)
 
 	toSql := r.db.Sq.Builder.
@@ -126,6 +134,7 @@ func (r *DoctorAvailability) GetDoctorAvailability(ctx context.Context, req *doc
 	if err = r.db.QueryRow(ctx, toSqls, args...).Scan(
 		&docAvail.Id,
 		&docAvail.DepartmentId,
+		&branchId,
 		&docAvail.DoctorId,
 		&docAvail.DoctorDate,
 		&docAvail.StartTime,
@@ -146,6 +155,8 @@ func (r *DoctorAvailability) GetDoctorAvailability(ctx context.Context, req *doc
 		docAvail.DeletedAt = delAt.Time
 	}
 
+	docAvail.BranchId = branchId.String
+
 	return &docAvail, nil
 }
 
@@ -158,6 +169,7 @@ func (r *DoctorAvailability) GetAllDoctorAvailability(ctx context.Context, req *
 		count     int64
 		upAt      sql.NullTime
 		delAt     sql.NullTime
+		branchId  sql.NullString
 	)
 
 	toSql := r.db.Sq.Builder.
@@ -181,15 +193,26 @@ func (r *DoctorAvailability) GetAllDoctorAvailability(ctx context.Context, req *
 		toSql = toSql.Where(r.db.Sq.Equal("deleted_at", nil))
 		countBuilder = countBuilder.Where(r.db.Sq.Equal("deleted_at", nil))
 	}
+	if req.BranchId != "" {
+		toSql = toSql.Where(r.db.Sq.Equal("branch_id", req.BranchId))
+		countBuilder = countBuilder.Where(r.db.Sq.Equal("branch_id", req.BranchId))
+	}
+	if req.DoctorDate != "" {
+		toSql = toSql.Where(r.db.Sq.Equal("doctor_date", req.DoctorDate))
+		countBuilder = countBuilder.Where(r.db.Sq.Equal("doctor_date", req.DoctorDate))
+	}
 	toSqls, args, err := toSql.ToSql()
 
 	if err != nil {
 		return nil, err
 	}
 
-	queryCount, _, err := countBuilder.ToSql()
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
 
-	err = r.db.QueryRow(ctx, queryCount).Scan(&count)
+	err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
 
 	if err != nil {
 		return nil, err
@@ -206,6 +229,7 @@ func (r *DoctorAvailability) GetAllDoctorAvailability(ctx context.Context, req *
 		if err = rows.Scan(
 			&docAvail.Id,
 			&docAvail.DepartmentId,
+			&branchId,
 			&docAvail.DoctorId,
 			&docAvail.DoctorDate,
 			&docAvail.StartTime,
@@ -226,6 +250,8 @@ func (r *DoctorAvailability) GetAllDoctorAvailability(ctx context.Context, req *
 			docAvail.DeletedAt = delAt.Time
 		}
 
+		docAvail.BranchId = branchId.String
+
 		docAvails.DoctorAvailabilitys = append(docAvails.DoctorAvailabilitys, &docAvail)
 
 	}
@@ -241,12 +267,14 @@ func (r *DoctorAvailability) UpdateDoctorAvailability(ctx context.Context, req *
 		docAvail doctor_availability.DoctorAvailability
 		upAt     sql.NullTime
 		delAt    sql.NullTime
+		branchId sql.NullString
 	)
 
 	toSql, args, err := r.db.Sq.Builder.
 		Update(tableNameDoctorAvailability).
 		SetMap(map[string]interface{}{
 			"department_id": req.DepartmentId,
+			"branch_id":     nullString(req.BranchId),
 			"doctor_id":     req.DoctorId,
 			"doctor_date":   req.DoctorDate.String(),
 			"start_time":    req.StartTime,
@@ -261,6 +289,7 @@ func (r *DoctorAvailability) UpdateDoctorAvailability(ctx context.Context, req *
 	if err = r.db.QueryRow(ctx, toSql, args...).Scan(
 		&docAvail.Id,
 		&docAvail.DepartmentId,
+		&branchId,
 		&docAvail.DoctorId,
 		&docAvail.DoctorDate,
 		&docAvail.StartTime,
@@ -281,6 +310,8 @@ func (r *DoctorAvailability) UpdateDoctorAvailability(ctx context.Context, req *
 		docAvail.DeletedAt = delAt.Time
 	}
 
+	docAvail.BranchId = branchId.String
+
 	return &docAvail, nil
 }
 
@@ -332,3 +363,8 @@ func (r *DoctorAvailability) DeleteDoctorAvailability(ctx context.Context, req *
 		return &doctor_availability.StatusRes{Status: false}, nil
 	}
 }
+
+// nullString keeps optional uuid references NULL instead of an invalid empty string.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
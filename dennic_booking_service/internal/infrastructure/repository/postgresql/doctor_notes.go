@@ -163,6 +163,10 @@ func (r *DoctorNotes) GetAllDoctorNotes(ctx context.Context, req *doctor_notes.G
 	if req.Value != "" {
 		toSql = toSql.Where(r.db.Sq.ILike(req.Field, req.Value+"%"))
 	}
+	if req.PatientId != "" {
+		toSql = toSql.Where(r.db.Sq.Equal("patient_id", req.PatientId))
+		countBuilder = countBuilder.Where(r.db.Sq.Equal("patient_id", req.PatientId))
+	}
 	if req.OrderBy != "" {
 		toSql = toSql.OrderBy(req.OrderBy)
 	}
@@ -176,9 +180,9 @@ func (r *DoctorNotes) GetAllDoctorNotes(ctx context.Context, req *doctor_notes.G
 		return nil, err
 	}
 
-	queryCount, _, err := countBuilder.ToSql()
+	queryCount, countArgs, err := countBuilder.ToSql()
 
-	err = r.db.QueryRow(ctx, queryCount).Scan(&count)
+	err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
 
 	if err != nil {
 		return nil, err
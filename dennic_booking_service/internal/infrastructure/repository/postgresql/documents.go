@@ -0,0 +1,222 @@
+package repo
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/documents"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNameDocuments   = "patient_documents"
+	serviceNameDocument  = "documentsRepo"
+	spanNameDocumentRepo = "documentsRepo"
+)
+
+type BookingDocuments struct {
+	db *postgres.PostgresDB
+}
+
+func NewBookingDocuments(db *postgres.PostgresDB) *BookingDocuments {
+	return &BookingDocuments{
+		db: db,
+	}
+}
+
+func tableColumDocuments() string {
+	return `id,
+			patient_id,
+			appointment_id,
+			type,
+			title,
+			file_name,
+			content_type,
+			size_bytes,
+			bucket,
+			object_key,
+			uploaded_by,
+			created_at`
+}
+
+func scanDocument(row pgx.Row) (*documents.Document, error) {
+	var (
+		document      documents.Document
+		appointmentId sql.NullInt64
+	)
+
+	if err := row.Scan(
+		&document.Id,
+		&document.PatientId,
+		&appointmentId,
+		&document.Type,
+		&document.Title,
+		&document.FileName,
+		&document.ContentType,
+		&document.SizeBytes,
+		&document.Bucket,
+		&document.ObjectKey,
+		&document.UploadedBy,
+		&document.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	document.AppointmentId = appointmentId.Int64
+
+	return &document, nil
+}
+
+// CreateDocument stores the metadata of an uploaded file. The patient must
+// exist and a linked appointment must be one of the patient's.
+func (r *BookingDocuments) CreateDocument(ctx context.Context, req *documents.Document) (*documents.Document, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDocument, spanNameDocumentRepo+"Create")
+	defer span.End()
+
+	appointmentId := sql.NullInt64{Int64: req.AppointmentId, Valid: req.AppointmentId > 0}
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, patient_id, appointment_id, type, title, file_name, content_type, size_bytes, bucket, object_key, uploaded_by)
+		SELECT $1, p.id, $3::integer, $4, $5, $6, $7, $8, $9, $10, $11
+		FROM patients p
+		WHERE p.id = $2 AND p.deleted_at IS NULL
+		  AND ($3::integer IS NULL OR EXISTS (
+			SELECT 1 FROM booked_appointments a
+			WHERE a.id = $3::integer AND a.patient_id = p.id AND a.deleted_at IS NULL))
+		RETURNING %s`, tableNameDocuments, tableColumDocuments())
+
+	document, err := scanDocument(r.db.QueryRow(ctx, query,
+		req.Id,
+		req.PatientId,
+		appointmentId,
+		req.Type,
+		req.Title,
+		req.FileName,
+		req.ContentType,
+		req.SizeBytes,
+		req.Bucket,
+		req.ObjectKey,
+		req.UploadedBy,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		if appointmentId.Valid {
+			return nil, entity.NewErrNotFound("patient or appointment")
+		}
+		return nil, entity.NewErrNotFound("patient")
+	}
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return document, nil
+}
+
+func (r *BookingDocuments) GetDocument(ctx context.Context, req *documents.FieldValueReq) (*documents.Document, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDocument, spanNameDocumentRepo+"Get")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumDocuments()).
+		From(tableNameDocuments).
+		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := scanDocument(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return document, nil
+}
+
+func (r *BookingDocuments) GetAllDocuments(ctx context.Context, req *documents.GetAllDocuments) (*documents.DocumentsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDocument, spanNameDocumentRepo+"List")
+	defer span.End()
+
+	var list documents.DocumentsType
+
+	where := r.db.Sq.And()
+	if req.PatientId != "" {
+		where = append(where, r.db.Sq.Equal("patient_id", req.PatientId))
+	}
+	if req.AppointmentId > 0 {
+		where = append(where, r.db.Sq.Equal("appointment_id", req.AppointmentId))
+	}
+	if req.Type != "" {
+		where = append(where, r.db.Sq.Equal("type", req.Type))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumDocuments()).
+		From(tableNameDocuments).
+		Where(where).
+		OrderBy("created_at DESC")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNameDocuments).Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&list.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		document, err := scanDocument(rows)
+		if err != nil {
+			return nil, err
+		}
+		list.Documents = append(list.Documents, document)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// DeleteDocument removes the metadata and returns it, so the caller knows
+// which object to remove from storage.
+func (r *BookingDocuments) DeleteDocument(ctx context.Context, req *documents.FieldValueReq) (*documents.Document, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDocument, spanNameDocumentRepo+"Delete")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Delete(tableNameDocuments).
+		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColumDocuments())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := scanDocument(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return document, nil
+}
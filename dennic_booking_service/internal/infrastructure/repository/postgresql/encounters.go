@@ -0,0 +1,515 @@
+package repo
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/encounters"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNameEncounters   = "encounters"
+	serviceNameEncounter  = "encountersRepo"
+	spanNameEncounterRepo = "encountersRepo"
+)
+
+type BookingEncounters struct {
+	db *postgres.PostgresDB
+}
+
+func NewBookingEncounters(db *postgres.PostgresDB) *BookingEncounters {
+	return &BookingEncounters{
+		db: db,
+	}
+}
+
+func tableColumEncounters() string {
+	return `id,
+			appointment_id,
+			patient_id,
+			doctor_id,
+			encountered_at,
+			chief_complaint,
+			vitals,
+			follow_up_plan,
+			follow_up_date,
+			created_at,
+			updated_at,
+			deleted_at`
+}
+
+// encounterQuerier is satisfied by both the pool and a transaction.
+type encounterQuerier interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+func scanEncounter(row pgx.Row) (*encounters.Encounter, error) {
+	var (
+		encounter encounters.Encounter
+		doctorId  sql.NullString
+		vitals    []byte
+		followUp  sql.NullTime
+		upTime    sql.NullTime
+		delTime   sql.NullTime
+	)
+
+	if err := row.Scan(
+		&encounter.Id,
+		&encounter.AppointmentId,
+		&encounter.PatientId,
+		&doctorId,
+		&encounter.EncounteredAt,
+		&encounter.ChiefComplaint,
+		&vitals,
+		&encounter.FollowUpPlan,
+		&followUp,
+		&encounter.CreatedAt,
+		&upTime,
+		&delTime,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(vitals, &encounter.Vitals); err != nil {
+		return nil, err
+	}
+
+	if doctorId.Valid {
+		encounter.DoctorId = doctorId.String
+	}
+
+	if followUp.Valid {
+		encounter.FollowUpDate = followUp.Time
+	}
+
+	if upTime.Valid {
+		encounter.UpdatedAt = upTime.Time
+	}
+
+	if delTime.Valid {
+		encounter.DeletedAt = delTime.Time
+	}
+
+	return &encounter, nil
+}
+
+func nullDate(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
+
+func (r *BookingEncounters) CreateEncounter(ctx context.Context, req *encounters.Encounter) (*encounters.Encounter, error) {
+	ctx, span := otlp.Start(ctx, serviceNameEncounter, spanNameEncounterRepo+"Create")
+	defer span.End()
+
+	vitals, err := json.Marshal(req.Vitals)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// The patient, the doctor and the time of the visit come from the
+	// appointment, so the record cannot disagree with the booking.
+	query := fmt.Sprintf(`INSERT INTO %s (
+			id, appointment_id, patient_id, doctor_id, encountered_at,
+			chief_complaint, vitals, follow_up_plan, follow_up_date)
+		SELECT $1, a.id, a.patient_id, a.doctor_id, a.appointment_date + a.appointment_time, $3, $4, $5, $6
+		FROM booked_appointments a
+		WHERE a.id = $2 AND a.deleted_at IS NULL
+		RETURNING %s`, tableNameEncounters, tableColumEncounters())
+
+	encounter, err := scanEncounter(tx.QueryRow(ctx, query,
+		req.Id,
+		req.AppointmentId,
+		req.ChiefComplaint,
+		string(vitals),
+		req.FollowUpPlan,
+		nullDate(req.FollowUpDate),
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("appointment")
+	}
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if err = r.insertEncounterDetails(ctx, tx, encounter.Id, req); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	encounter.Diagnoses = req.Diagnoses
+	encounter.Allergies = req.Allergies
+	encounter.ChronicConditions = req.ChronicConditions
+	encounter.Procedures = req.Procedures
+
+	return encounter, nil
+}
+
+func (r *BookingEncounters) GetEncounter(ctx context.Context, req *encounters.FieldValueReq) (*encounters.Encounter, error) {
+	ctx, span := otlp.Start(ctx, serviceNameEncounter, spanNameEncounterRepo+"Get")
+	defer span.End()
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumEncounters()).
+		From(tableNameEncounters).
+		Where(r.db.Sq.Equal(req.Field, req.Value))
+
+	if !req.DeleteStatus {
+		toSql = toSql.Where(r.db.Sq.Equal("deleted_at", nil))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	encounter, err := scanEncounter(r.db.QueryRow(ctx, toSqls, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if err = loadEncounterDetails(ctx, r.db, []*encounters.Encounter{encounter}); err != nil {
+		return nil, err
+	}
+
+	return encounter, nil
+}
+
+func (r *BookingEncounters) UpdateEncounter(ctx context.Context, req *encounters.Encounter) (*encounters.Encounter, error) {
+	ctx, span := otlp.Start(ctx, serviceNameEncounter, spanNameEncounterRepo+"Update")
+	defer span.End()
+
+	vitals, err := json.Marshal(req.Vitals)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameEncounters).
+		SetMap(map[string]interface{}{
+			"chief_complaint": req.ChiefComplaint,
+			"vitals":          string(vitals),
+			"follow_up_plan":  req.FollowUpPlan,
+			"follow_up_date":  nullDate(req.FollowUpDate),
+			"updated_at":      time.Now(),
+		}).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"id":         req.Id,
+			"deleted_at": nil,
+		})).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColumEncounters())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	encounter, err := scanEncounter(tx.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	// The lists are replaced as a whole, the way the doctor edits the record.
+	for _, table := range []string{"encounter_diagnoses", "encounter_allergies", "encounter_conditions", "encounter_procedures"} {
+		if _, err = tx.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE encounter_id = $1", table), encounter.Id); err != nil {
+			return nil, r.db.Error(err)
+		}
+	}
+
+	if err = r.insertEncounterDetails(ctx, tx, encounter.Id, req); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	encounter.Diagnoses = req.Diagnoses
+	encounter.Allergies = req.Allergies
+	encounter.ChronicConditions = req.ChronicConditions
+	encounter.Procedures = req.Procedures
+
+	return encounter, nil
+}
+
+func (r *BookingEncounters) DeleteEncounter(ctx context.Context, req *encounters.FieldValueReq) (*encounters.StatusRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameEncounter, spanNameEncounterRepo+"Delete")
+	defer span.End()
+
+	if !req.DeleteStatus {
+		toSql, args, err := r.db.Sq.Builder.
+			Update(tableNameEncounters).
+			Set("deleted_at", time.Now()).
+			Where(r.db.Sq.EqualMany(map[string]interface{}{
+				"deleted_at": nil,
+				req.Field:    req.Value,
+			})).
+			ToSql()
+		if err != nil {
+			return &encounters.StatusRes{Status: false}, err
+		}
+
+		resp, err := r.db.Exec(ctx, toSql, args...)
+		if err != nil {
+			return &encounters.StatusRes{Status: false}, err
+		}
+		return &encounters.StatusRes{Status: resp.RowsAffected() > 0}, nil
+	}
+
+	// details go with the encounter through ON DELETE CASCADE
+	toSql, args, err := r.db.Sq.Builder.
+		Delete(tableNameEncounters).
+		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		ToSql()
+	if err != nil {
+		return &encounters.StatusRes{Status: false}, err
+	}
+
+	resp, err := r.db.Exec(ctx, toSql, args...)
+	if err != nil {
+		return &encounters.StatusRes{Status: false}, err
+	}
+	return &encounters.StatusRes{Status: resp.RowsAffected() > 0}, nil
+}
+
+func (r *BookingEncounters) GetPatientTimeline(ctx context.Context, req *encounters.TimelineReq) (*encounters.Timeline, error) {
+	ctx, span := otlp.Start(ctx, serviceNameEncounter, spanNameEncounterRepo+"Timeline")
+	defer span.End()
+
+	var timeline encounters.Timeline
+
+	where := r.db.Sq.And(
+		r.db.Sq.Equal("patient_id", req.PatientId),
+		r.db.Sq.Equal("deleted_at", nil),
+	)
+	if !req.From.IsZero() {
+		where = append(where, r.db.Sq.Expr("encountered_at >= ?", req.From))
+	}
+	if !req.To.IsZero() {
+		where = append(where, r.db.Sq.Lt("encountered_at", req.To.AddDate(0, 0, 1)))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumEncounters()).
+		From(tableNameEncounters).
+		Where(where).
+		OrderBy("encountered_at DESC", "created_at DESC")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNameEncounters).Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&timeline.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		encounter, err := scanEncounter(rows)
+		if err != nil {
+			return nil, err
+		}
+		timeline.Encounters = append(timeline.Encounters, encounter)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if err = loadEncounterDetails(ctx, r.db, timeline.Encounters); err != nil {
+		return nil, err
+	}
+
+	return &timeline, nil
+}
+
+func (r *BookingEncounters) insertEncounterDetails(ctx context.Context, tx pgx.Tx, encounterId string, req *encounters.Encounter) error {
+	var inserts []interface {
+		ToSql() (string, []interface{}, error)
+	}
+
+	if len(req.Diagnoses) > 0 {
+		insert := r.db.Sq.Builder.Insert("encounter_diagnoses").Columns("encounter_id, icd10_code, description, is_primary")
+		for _, diagnosis := range req.Diagnoses {
+			insert = insert.Values(encounterId, diagnosis.Icd10Code, diagnosis.Description, diagnosis.Primary)
+		}
+		inserts = append(inserts, insert)
+	}
+	if len(req.Allergies) > 0 {
+		insert := r.db.Sq.Builder.Insert("encounter_allergies").Columns("encounter_id, substance, reaction, severity")
+		for _, allergy := range req.Allergies {
+			insert = insert.Values(encounterId, allergy.Substance, allergy.Reaction, allergy.Severity)
+		}
+		inserts = append(inserts, insert)
+	}
+	if len(req.ChronicConditions) > 0 {
+		insert := r.db.Sq.Builder.Insert("encounter_conditions").Columns("encounter_id, icd10_code, description, onset_date")
+		for _, condition := range req.ChronicConditions {
+			insert = insert.Values(encounterId, condition.Icd10Code, condition.Description, nullDate(condition.OnsetDate))
+		}
+		inserts = append(inserts, insert)
+	}
+	if len(req.Procedures) > 0 {
+		insert := r.db.Sq.Builder.Insert("encounter_procedures").Columns("encounter_id, code, description")
+		for _, procedure := range req.Procedures {
+			insert = insert.Values(encounterId, procedure.Code, procedure.Description)
+		}
+		inserts = append(inserts, insert)
+	}
+
+	for _, insert := range inserts {
+		toSql, args, err := insert.ToSql()
+		if err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadEncounterDetails fills the coded lists of the given encounters with one
+// query per list.
+func loadEncounterDetails(ctx context.Context, db encounterQuerier, list []*encounters.Encounter) error {
+	if len(list) == 0 {
+		return nil
+	}
+
+	byId := make(map[string]*encounters.Encounter, len(list))
+	ids := make([]string, 0, len(list))
+	for _, encounter := range list {
+		byId[encounter.Id] = encounter
+		ids = append(ids, encounter.Id)
+	}
+
+	details := []struct {
+		query string
+		scan  func(rows pgx.Rows) error
+	}{
+		{
+			query: "SELECT encounter_id::text, icd10_code, description, is_primary FROM encounter_diagnoses WHERE encounter_id = ANY($1) ORDER BY id",
+			scan: func(rows pgx.Rows) error {
+				var (
+					encounterId string
+					diagnosis   encounters.Diagnosis
+				)
+				if err := rows.Scan(&encounterId, &diagnosis.Icd10Code, &diagnosis.Description, &diagnosis.Primary); err != nil {
+					return err
+				}
+				encounter := byId[encounterId]
+				encounter.Diagnoses = append(encounter.Diagnoses, &diagnosis)
+				return nil
+			},
+		},
+		{
+			query: "SELECT encounter_id::text, substance, reaction, severity FROM encounter_allergies WHERE encounter_id = ANY($1) ORDER BY id",
+			scan: func(rows pgx.Rows) error {
+				var (
+					encounterId string
+					allergy     encounters.Allergy
+				)
+				if err := rows.Scan(&encounterId, &allergy.Substance, &allergy.Reaction, &allergy.Severity); err != nil {
+					return err
+				}
+				encounter := byId[encounterId]
+				encounter.Allergies = append(encounter.Allergies, &allergy)
+				return nil
+			},
+		},
+		{
+			query: "SELECT encounter_id::text, icd10_code, description, onset_date FROM encounter_conditions WHERE encounter_id = ANY($1) ORDER BY id",
+			scan: func(rows pgx.Rows) error {
+				var (
+					encounterId string
+					condition   encounters.ChronicCondition
+					onset       sql.NullTime
+				)
+				if err := rows.Scan(&encounterId, &condition.Icd10Code, &condition.Description, &onset); err != nil {
+					return err
+				}
+				if onset.Valid {
+					condition.OnsetDate = onset.Time
+				}
+				encounter := byId[encounterId]
+				encounter.ChronicConditions = append(encounter.ChronicConditions, &condition)
+				return nil
+			},
+		},
+		{
+			query: "SELECT encounter_id::text, code, description FROM encounter_procedures WHERE encounter_id = ANY($1) ORDER BY id",
+			scan: func(rows pgx.Rows) error {
+				var (
+					encounterId string
+					procedure   encounters.Procedure
+				)
+				if err := rows.Scan(&encounterId, &procedure.Code, &procedure.Description); err != nil {
+					return err
+				}
+				encounter := byId[encounterId]
+				encounter.Procedures = append(encounter.Procedures, &procedure)
+				return nil
+			},
+		},
+	}
+
+	for _, detail := range details {
+		rows, err := db.Query(ctx, detail.query, ids)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			if err = detail.scan(rows); err != nil {
+				rows.Close()
+				return err
+			}
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
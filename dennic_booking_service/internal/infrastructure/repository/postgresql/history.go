@@ -0,0 +1,165 @@
+package repo
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/history"
+	"booking_service/internal/entity/patients"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNameExternalRecords = "patient_external_records"
+	serviceNameHistory       = "historyRepo"
+	spanNameHistoryRepo      = "historyRepo"
+)
+
+type BookingHistory struct {
+	db *postgres.PostgresDB
+}
+
+func NewBookingHistory(db *postgres.PostgresDB) *BookingHistory {
+	return &BookingHistory{
+		db: db,
+	}
+}
+
+func tableColumExternalRecords() string {
+	return `id,
+			patient_id,
+			source,
+			resource_type,
+			external_id,
+			occurred_at,
+			summary,
+			resource,
+			imported_by,
+			imported_at`
+}
+
+func scanExternalRecord(row pgx.Row) (*history.ExternalRecord, error) {
+	var (
+		record     history.ExternalRecord
+		occurredAt sql.NullTime
+	)
+
+	if err := row.Scan(
+		&record.Id,
+		&record.PatientId,
+		&record.Source,
+		&record.ResourceType,
+		&record.ExternalId,
+		&occurredAt,
+		&record.Summary,
+		&record.Resource,
+		&record.ImportedBy,
+		&record.ImportedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if occurredAt.Valid {
+		record.OccurredAt = occurredAt.Time
+	}
+
+	return &record, nil
+}
+
+// ImportHistory creates the patient and stores the imported records with it
+// in one transaction.
+func (r *BookingHistory) ImportHistory(ctx context.Context, patient *patients.CreatedPatient, records []*history.ExternalRecord) error {
+	ctx, span := otlp.Start(ctx, serviceNameHistory, spanNameHistoryRepo+"Import")
+	defer span.End()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `INSERT INTO patients (id, first_name, last_name, birth_date, gender, blood_group,
+			phone_number, city, country, address, patient_problem)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		patient.Id,
+		patient.FirstName,
+		patient.LastName,
+		patient.BirthDate.String(),
+		patient.Gender,
+		patient.BloodGroup,
+		patient.PhoneNumber,
+		patient.City,
+		patient.Country,
+		patient.Address,
+		patient.PatientProblem,
+	)
+	if err != nil {
+		if errors.Is(r.db.Error(err), entity.ErrorConflict) {
+			return entity.NewErrConflict("patient")
+		}
+		return r.db.Error(err)
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, patient_id, source, resource_type, external_id, occurred_at, summary, resource, imported_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`, tableNameExternalRecords)
+
+	for _, record := range records {
+		if _, err = tx.Exec(ctx, query,
+			record.Id,
+			patient.Id,
+			record.Source,
+			record.ResourceType,
+			record.ExternalId,
+			sql.NullTime{Time: record.OccurredAt, Valid: !record.OccurredAt.IsZero()},
+			record.Summary,
+			string(record.Resource),
+			record.ImportedBy,
+		); err != nil {
+			return r.db.Error(err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return r.db.Error(err)
+	}
+
+	return nil
+}
+
+// GetExternalRecords lists what was imported for a patient, the latest first.
+func (r *BookingHistory) GetExternalRecords(ctx context.Context, patientId string) ([]*history.ExternalRecord, error) {
+	ctx, span := otlp.Start(ctx, serviceNameHistory, spanNameHistoryRepo+"ListExternal")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumExternalRecords()).
+		From(tableNameExternalRecords).
+		Where(r.db.Sq.Equal("patient_id", patientId)).
+		OrderBy("occurred_at DESC NULLS LAST", "imported_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSql, args...)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer rows.Close()
+
+	var records []*history.ExternalRecord
+	for rows.Next() {
+		record, err := scanExternalRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, rows.Err()
+}
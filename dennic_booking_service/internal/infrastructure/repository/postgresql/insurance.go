@@ -0,0 +1,1139 @@
+package repo
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/billing"
+	"booking_service/internal/entity/insurance"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNameInsurers        = "insurers"
+	tableNamePolicies        = "insurance_policies"
+	tableNameCoverageRules   = "coverage_rules"
+	tableNameClaims          = "insurance_claims"
+	tableNameClaimBatches    = "claim_batches"
+	serviceNameInsurance     = "insuranceRepo"
+	spanNameInsuranceRepo    = "insuranceRepo"
+	insuranceClaimDateLayout = "2006-01-02"
+)
+
+type BookingInsurance struct {
+	db *postgres.PostgresDB
+}
+
+func NewBookingInsurance(db *postgres.PostgresDB) *BookingInsurance {
+	return &BookingInsurance{
+		db: db,
+	}
+}
+
+type insuranceQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+func tableColumInsurers() string {
+	return `id,
+			name,
+			code,
+			email,
+			phone_number,
+			created_at,
+			updated_at`
+}
+
+func tableColumPolicies() string {
+	return `id,
+			insurer_id,
+			patient_id,
+			policy_number,
+			valid_from,
+			valid_to,
+			annual_limit::text,
+			currency,
+			status,
+			created_at,
+			updated_at`
+}
+
+func tableColumCoverageRules() string {
+	return `id,
+			insurer_id,
+			doctor_service_id,
+			kind,
+			percentage,
+			copay::text,
+			annual_limit::text,
+			created_at,
+			updated_at`
+}
+
+func tableColumClaims() string {
+	return `id,
+			insurer_id,
+			policy_id,
+			patient_id,
+			appointment_id,
+			COALESCE(invoice_id::text, ''),
+			doctor_service_id,
+			service_date,
+			billed::text,
+			covered::text,
+			copay::text,
+			approved::text,
+			currency,
+			status,
+			status_reason,
+			COALESCE(batch_id::text, ''),
+			created_at,
+			updated_at`
+}
+
+func tableColumClaimBatches() string {
+	return `id,
+			insurer_id,
+			period_from,
+			period_to,
+			format,
+			claim_count,
+			created_by,
+			created_at`
+}
+
+func scanInsurer(row pgx.Row) (*insurance.Insurer, error) {
+	var (
+		insurer insurance.Insurer
+		upTime  sql.NullTime
+	)
+
+	if err := row.Scan(
+		&insurer.Id,
+		&insurer.Name,
+		&insurer.Code,
+		&insurer.Email,
+		&insurer.PhoneNumber,
+		&insurer.CreatedAt,
+		&upTime,
+	); err != nil {
+		return nil, err
+	}
+
+	if upTime.Valid {
+		insurer.UpdatedAt = upTime.Time
+	}
+
+	return &insurer, nil
+}
+
+func scanPolicy(row pgx.Row) (*insurance.Policy, error) {
+	var (
+		policy      insurance.Policy
+		annualLimit string
+		upTime      sql.NullTime
+	)
+
+	if err := row.Scan(
+		&policy.Id,
+		&policy.InsurerId,
+		&policy.PatientId,
+		&policy.PolicyNumber,
+		&policy.ValidFrom,
+		&policy.ValidTo,
+		&annualLimit,
+		&policy.Currency,
+		&policy.Status,
+		&policy.CreatedAt,
+		&upTime,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := scanAmounts([]string{annualLimit}, &policy.AnnualLimit); err != nil {
+		return nil, err
+	}
+	if upTime.Valid {
+		policy.UpdatedAt = upTime.Time
+	}
+
+	return &policy, nil
+}
+
+func scanCoverageRule(row pgx.Row) (*insurance.CoverageRule, error) {
+	var (
+		rule    insurance.CoverageRule
+		amounts = make([]string, 2)
+		upTime  sql.NullTime
+	)
+
+	if err := row.Scan(
+		&rule.Id,
+		&rule.InsurerId,
+		&rule.DoctorServiceId,
+		&rule.Kind,
+		&rule.Percentage,
+		&amounts[0],
+		&amounts[1],
+		&rule.CreatedAt,
+		&upTime,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := scanAmounts(amounts, &rule.Copay, &rule.AnnualLimit); err != nil {
+		return nil, err
+	}
+	if upTime.Valid {
+		rule.UpdatedAt = upTime.Time
+	}
+
+	return &rule, nil
+}
+
+func scanClaim(row pgx.Row) (*insurance.Claim, error) {
+	var (
+		claim   insurance.Claim
+		amounts = make([]string, 4)
+		upTime  sql.NullTime
+	)
+
+	if err := row.Scan(
+		&claim.Id,
+		&claim.InsurerId,
+		&claim.PolicyId,
+		&claim.PatientId,
+		&claim.AppointmentId,
+		&claim.InvoiceId,
+		&claim.DoctorServiceId,
+		&claim.ServiceDate,
+		&amounts[0],
+		&amounts[1],
+		&amounts[2],
+		&amounts[3],
+		&claim.Currency,
+		&claim.Status,
+		&claim.StatusReason,
+		&claim.BatchId,
+		&claim.CreatedAt,
+		&upTime,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := scanAmounts(amounts, &claim.Billed, &claim.Covered, &claim.Copay, &claim.Approved); err != nil {
+		return nil, err
+	}
+	if upTime.Valid {
+		claim.UpdatedAt = upTime.Time
+	}
+
+	return &claim, nil
+}
+
+func scanClaimBatch(row pgx.Row) (*insurance.ClaimBatch, error) {
+	var batch insurance.ClaimBatch
+
+	if err := row.Scan(
+		&batch.Id,
+		&batch.InsurerId,
+		&batch.PeriodFrom,
+		&batch.PeriodTo,
+		&batch.Format,
+		&batch.ClaimCount,
+		&batch.CreatedBy,
+		&batch.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &batch, nil
+}
+
+func (r *BookingInsurance) CreateInsurer(ctx context.Context, req *insurance.Insurer) (*insurance.Insurer, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"CreateInsurer")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Insert(tableNameInsurers).
+		Columns("id, name, code, email, phone_number").
+		Values(req.Id, req.Name, req.Code, req.Email, req.PhoneNumber).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColumInsurers())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	insurer, err := scanInsurer(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return insurer, nil
+}
+
+func (r *BookingInsurance) GetInsurer(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Insurer, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"GetInsurer")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumInsurers()).
+		From(tableNameInsurers).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			req.Field:    req.Value,
+			"deleted_at": nil,
+		})).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	insurer, err := scanInsurer(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return insurer, nil
+}
+
+func (r *BookingInsurance) GetAllInsurers(ctx context.Context, req *insurance.GetAllReq) (*insurance.InsurersType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"ListInsurers")
+	defer span.End()
+
+	var list insurance.InsurersType
+
+	where := r.db.Sq.And(r.db.Sq.Equal("deleted_at", nil))
+	if req.Value != "" {
+		where = append(where, r.db.Sq.ILike("name", req.Value+"%"))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumInsurers()).
+		From(tableNameInsurers).
+		Where(where).
+		OrderBy("name")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNameInsurers).Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&list.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		insurer, err := scanInsurer(rows)
+		if err != nil {
+			return nil, err
+		}
+		list.Insurers = append(list.Insurers, insurer)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+func (r *BookingInsurance) UpdateInsurer(ctx context.Context, req *insurance.Insurer) (*insurance.Insurer, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"UpdateInsurer")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameInsurers).
+		SetMap(map[string]interface{}{
+			"name":         req.Name,
+			"code":         req.Code,
+			"email":        req.Email,
+			"phone_number": req.PhoneNumber,
+			"updated_at":   time.Now(),
+		}).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"id":         req.Id,
+			"deleted_at": nil,
+		})).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColumInsurers())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	insurer, err := scanInsurer(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return insurer, nil
+}
+
+// DeleteInsurer hides the insurer; its policies and claims are kept.
+func (r *BookingInsurance) DeleteInsurer(ctx context.Context, req *insurance.FieldValueReq) (*insurance.StatusRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"DeleteInsurer")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameInsurers).
+		Set("deleted_at", time.Now()).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			req.Field:    req.Value,
+			"deleted_at": nil,
+		})).
+		ToSql()
+	if err != nil {
+		return &insurance.StatusRes{Status: false}, err
+	}
+
+	resp, err := r.db.Exec(ctx, toSql, args...)
+	if err != nil {
+		return &insurance.StatusRes{Status: false}, err
+	}
+
+	return &insurance.StatusRes{Status: resp.RowsAffected() > 0}, nil
+}
+
+// CreatePolicy records a patient's policy with an insurer that is not deleted.
+func (r *BookingInsurance) CreatePolicy(ctx context.Context, req *insurance.Policy) (*insurance.Policy, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"CreatePolicy")
+	defer span.End()
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, insurer_id, patient_id, policy_number, valid_from, valid_to, annual_limit, currency)
+		SELECT $1, i.id, p.id, $4, $5, $6, $7, $8
+		FROM insurers i, patients p
+		WHERE i.id = $2 AND i.deleted_at IS NULL
+		  AND p.id = $3 AND p.deleted_at IS NULL
+		RETURNING %s`, tableNamePolicies, tableColumPolicies())
+
+	policy, err := scanPolicy(r.db.QueryRow(ctx, query,
+		req.Id,
+		req.InsurerId,
+		req.PatientId,
+		req.PolicyNumber,
+		req.ValidFrom,
+		req.ValidTo,
+		req.AnnualLimit.String(),
+		req.Currency,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("insurer or patient")
+	}
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return policy, nil
+}
+
+func (r *BookingInsurance) GetPolicy(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Policy, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"GetPolicy")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumPolicies()).
+		From(tableNamePolicies).
+		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := scanPolicy(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return policy, nil
+}
+
+func (r *BookingInsurance) GetAllPolicies(ctx context.Context, req *insurance.GetAllReq) (*insurance.PoliciesType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"ListPolicies")
+	defer span.End()
+
+	var list insurance.PoliciesType
+
+	where := r.db.Sq.And()
+	if req.PatientId != "" {
+		where = append(where, r.db.Sq.Equal("patient_id", req.PatientId))
+	}
+	if req.InsurerId != "" {
+		where = append(where, r.db.Sq.Equal("insurer_id", req.InsurerId))
+	}
+	if req.Value != "" {
+		where = append(where, r.db.Sq.ILike("policy_number", req.Value+"%"))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumPolicies()).
+		From(tableNamePolicies).
+		Where(where).
+		OrderBy("created_at DESC")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNamePolicies).Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&list.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		policy, err := scanPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		list.Policies = append(list.Policies, policy)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+func (r *BookingInsurance) CancelPolicy(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Policy, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"CancelPolicy")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNamePolicies).
+		SetMap(map[string]interface{}{
+			"status":     insurance.PolicyCancelled,
+			"updated_at": time.Now(),
+		}).
+		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColumPolicies())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := scanPolicy(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return policy, nil
+}
+
+// SetCoverageRule creates the insurer's rule for the doctor service or
+// replaces the one it has.
+func (r *BookingInsurance) SetCoverageRule(ctx context.Context, req *insurance.CoverageRule) (*insurance.CoverageRule, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"SetRule")
+	defer span.End()
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, insurer_id, doctor_service_id, kind, percentage, copay, annual_limit)
+		SELECT $1, i.id, $3, $4, $5, $6, $7
+		FROM insurers i
+		WHERE i.id = $2 AND i.deleted_at IS NULL
+		ON CONFLICT (insurer_id, doctor_service_id) DO UPDATE SET
+			kind = EXCLUDED.kind,
+			percentage = EXCLUDED.percentage,
+			copay = EXCLUDED.copay,
+			annual_limit = EXCLUDED.annual_limit,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING %s`, tableNameCoverageRules, tableColumCoverageRules())
+
+	rule, err := scanCoverageRule(r.db.QueryRow(ctx, query,
+		req.Id,
+		req.InsurerId,
+		req.DoctorServiceId,
+		req.Kind,
+		req.Percentage,
+		req.Copay.String(),
+		req.AnnualLimit.String(),
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("insurer")
+	}
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return rule, nil
+}
+
+func (r *BookingInsurance) GetAllCoverageRules(ctx context.Context, req *insurance.GetAllReq) (*insurance.CoverageRulesType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"ListRules")
+	defer span.End()
+
+	var list insurance.CoverageRulesType
+
+	where := r.db.Sq.And()
+	if req.InsurerId != "" {
+		where = append(where, r.db.Sq.Equal("insurer_id", req.InsurerId))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumCoverageRules()).
+		From(tableNameCoverageRules).
+		Where(where).
+		OrderBy("created_at")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNameCoverageRules).Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&list.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		rule, err := scanCoverageRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		list.Rules = append(list.Rules, rule)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+func (r *BookingInsurance) DeleteCoverageRule(ctx context.Context, req *insurance.FieldValueReq) (*insurance.StatusRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"DeleteRule")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Delete(tableNameCoverageRules).
+		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		ToSql()
+	if err != nil {
+		return &insurance.StatusRes{Status: false}, err
+	}
+
+	resp, err := r.db.Exec(ctx, toSql, args...)
+	if err != nil {
+		return &insurance.StatusRes{Status: false}, err
+	}
+
+	return &insurance.StatusRes{Status: resp.RowsAffected() > 0}, nil
+}
+
+func (r *BookingInsurance) CheckEligibility(ctx context.Context, req *insurance.EligibilityReq) (*insurance.Eligibility, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"Eligibility")
+	defer span.End()
+
+	eligibility, _, err := r.eligibility(ctx, r.db, req, false)
+	return eligibility, err
+}
+
+// eligibility finds the policy that pays for the service and how much of the
+// price it covers after this year's claims. With lock the chosen policies
+// are locked, so concurrent claims cannot overdraw a limit.
+func (r *BookingInsurance) eligibility(ctx context.Context, db insuranceQuerier, req *insurance.EligibilityReq, lock bool) (*insurance.Eligibility, *insurance.Policy, error) {
+	res := &insurance.Eligibility{
+		Price:    req.Price,
+		Copay:    req.Price,
+		Currency: req.Currency,
+		Reason:   "the patient has no insurance policy in force",
+	}
+
+	policies := r.db.Sq.Builder.
+		Select(tableColumPolicies()).
+		From(tableNamePolicies).
+		Where(r.db.Sq.Equal("patient_id", req.PatientId)).
+		OrderBy("created_at")
+	if req.PolicyId != "" {
+		policies = policies.Where(r.db.Sq.Equal("id", req.PolicyId))
+	} else {
+		policies = policies.Where(r.db.Sq.Equal("status", insurance.PolicyActive))
+	}
+	if lock {
+		policies = policies.Suffix("FOR UPDATE")
+	}
+	toSql, args, err := policies.ToSql()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := db.Query(ctx, toSql, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	var list []*insurance.Policy
+	for rows.Next() {
+		policy, err := scanPolicy(rows)
+		if err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		list = append(list, policy)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	if req.PolicyId != "" && len(list) == 0 {
+		return nil, nil, entity.NewErrNotFound("insurance policy")
+	}
+
+	yearFrom := time.Date(req.Date.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	yearTo := yearFrom.AddDate(1, 0, 0)
+
+	for _, policy := range list {
+		res.PolicyId, res.InsurerId = policy.Id, policy.InsurerId
+		if !policy.Covers(req.Date) {
+			res.Reason = "the policy is not in force on " + req.Date.Format(insuranceClaimDateLayout)
+			continue
+		}
+		if policy.Currency != req.Currency {
+			res.Reason = "the policy pays in " + policy.Currency
+			continue
+		}
+
+		toSql, args, err := r.db.Sq.Builder.
+			Select(tableColumCoverageRules()).
+			From(tableNameCoverageRules).
+			Where(r.db.Sq.EqualMany(map[string]interface{}{
+				"insurer_id":        policy.InsurerId,
+				"doctor_service_id": req.DoctorServiceId,
+			})).
+			ToSql()
+		if err != nil {
+			return nil, nil, err
+		}
+		rule, err := scanCoverageRule(db.QueryRow(ctx, toSql, args...))
+		if errors.Is(err, pgx.ErrNoRows) {
+			res.Reason = "the insurer does not cover the service"
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var used = make([]string, 2)
+		err = db.QueryRow(ctx, `SELECT
+				COALESCE(SUM(CASE WHEN status IN ('approved', 'paid') THEN approved ELSE covered END), 0)::text,
+				COALESCE(SUM(CASE WHEN status IN ('approved', 'paid') THEN approved ELSE covered END) FILTER (WHERE doctor_service_id = $2), 0)::text
+			FROM insurance_claims
+			WHERE policy_id = $1 AND status <> 'rejected' AND service_date >= $3 AND service_date < $4`,
+			policy.Id, req.DoctorServiceId, yearFrom, yearTo).Scan(&used[0], &used[1])
+		if err != nil {
+			return nil, nil, err
+		}
+		var usage insurance.Usage
+		if err = scanAmounts(used, &usage.Policy, &usage.Service); err != nil {
+			return nil, nil, err
+		}
+
+		covered, copay := insurance.Cover(policy, rule, req.Price, usage)
+		if covered == 0 {
+			res.Reason = "the annual limit is used up"
+			continue
+		}
+
+		res.Eligible, res.Reason = true, ""
+		res.Covered, res.Copay = covered, copay
+		return res, policy, nil
+	}
+
+	return res, nil, nil
+}
+
+// CreateClaim files the insurer's share of the appointment's booked service,
+// priced as stamped on booking.
+func (r *BookingInsurance) CreateClaim(ctx context.Context, req *insurance.CreateClaim) (*insurance.Claim, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"CreateClaim")
+	defer span.End()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		patientId       string
+		doctorServiceId sql.NullString
+		price           sql.NullString
+		currency        string
+		serviceDate     time.Time
+	)
+	err = tx.QueryRow(ctx, `SELECT patient_id, doctor_service_id, price::text, currency, appointment_date
+		FROM booked_appointments
+		WHERE id = $1 AND deleted_at IS NULL`, req.AppointmentId).
+		Scan(&patientId, &doctorServiceId, &price, &currency, &serviceDate)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("appointment")
+	}
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	if !doctorServiceId.Valid || !price.Valid {
+		return nil, entity.NewErrFailedPrecondition("the appointment has no booked service price to claim")
+	}
+
+	var billed billing.Amount
+	if err = scanAmounts([]string{price.String}, &billed); err != nil {
+		return nil, err
+	}
+
+	eligibility, policy, err := r.eligibility(ctx, tx, &insurance.EligibilityReq{
+		PatientId:       patientId,
+		PolicyId:        req.PolicyId,
+		DoctorServiceId: doctorServiceId.String,
+		Price:           billed,
+		Currency:        currency,
+		Date:            serviceDate,
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	if !eligibility.Eligible {
+		return nil, entity.NewErrFailedPrecondition(eligibility.Reason)
+	}
+
+	var invoiceId sql.NullString
+	err = tx.QueryRow(ctx, `SELECT id::text FROM invoices WHERE appointment_id = $1 AND status <> 'void'`, req.AppointmentId).Scan(&invoiceId)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, insurer_id, policy_id, patient_id, appointment_id, invoice_id, doctor_service_id, service_date, billed, covered, copay, currency)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING %s`, tableNameClaims, tableColumClaims())
+
+	claim, err := scanClaim(tx.QueryRow(ctx, query,
+		req.Id,
+		policy.InsurerId,
+		policy.Id,
+		patientId,
+		req.AppointmentId,
+		invoiceId,
+		doctorServiceId.String,
+		serviceDate,
+		billed.String(),
+		eligibility.Covered.String(),
+		eligibility.Copay.String(),
+		currency,
+	))
+	if err != nil {
+		if errors.Is(r.db.Error(err), entity.ErrorConflict) {
+			return nil, entity.NewErrConflict("claim of the appointment")
+		}
+		return nil, r.db.Error(err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return claim, nil
+}
+
+func (r *BookingInsurance) GetClaim(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Claim, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"GetClaim")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumClaims()).
+		From(tableNameClaims).
+		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	claim, err := scanClaim(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return claim, nil
+}
+
+func (r *BookingInsurance) GetAllClaims(ctx context.Context, req *insurance.GetAllClaims) (*insurance.ClaimsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"ListClaims")
+	defer span.End()
+
+	var list insurance.ClaimsType
+
+	where := r.db.Sq.And()
+	if req.InsurerId != "" {
+		where = append(where, r.db.Sq.Equal("insurer_id", req.InsurerId))
+	}
+	if req.PatientId != "" {
+		where = append(where, r.db.Sq.Equal("patient_id", req.PatientId))
+	}
+	if req.BatchId != "" {
+		where = append(where, r.db.Sq.Equal("batch_id", req.BatchId))
+	}
+	if req.Status != "" {
+		where = append(where, r.db.Sq.Equal("status", req.Status))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumClaims()).
+		From(tableNameClaims).
+		Where(where).
+		OrderBy("service_date", "created_at")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNameClaims).Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&list.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		claim, err := scanClaim(rows)
+		if err != nil {
+			return nil, err
+		}
+		list.Claims = append(list.Claims, claim)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// SetClaimStatus records the insurer's decision. An approval without an
+// amount approves the whole claimed share.
+func (r *BookingInsurance) SetClaimStatus(ctx context.Context, req *insurance.ClaimStatusReq) (*insurance.Claim, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"SetClaimStatus")
+	defer span.End()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	claim, err := scanClaim(tx.QueryRow(ctx, fmt.Sprintf("SELECT %s FROM %s WHERE id = $1 FOR UPDATE", tableColumClaims(), tableNameClaims), req.Id))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	allowed := false
+	for _, next := range insurance.ClaimTransitions[claim.Status] {
+		allowed = allowed || next == req.Status
+	}
+	if !allowed {
+		return nil, entity.NewErrFailedPrecondition(fmt.Sprintf("a %s claim cannot become %s", claim.Status, req.Status))
+	}
+
+	switch req.Status {
+	case insurance.ClaimApproved:
+		claim.Approved = req.Approved
+		if claim.Approved == 0 {
+			claim.Approved = claim.Covered
+		}
+		if claim.Approved > claim.Covered {
+			errValidation := entity.NewErrValidation()
+			errValidation.Err = errors.New("invalid claim approval")
+			errValidation.Errors["approved"] = fmt.Sprintf("cannot exceed the %s %s claimed", claim.Covered, claim.Currency)
+			return nil, errValidation
+		}
+	case insurance.ClaimRejected:
+		claim.Approved = 0
+	}
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameClaims).
+		SetMap(map[string]interface{}{
+			"status":        req.Status,
+			"status_reason": req.Reason,
+			"approved":      claim.Approved.String(),
+			"updated_at":    time.Now(),
+		}).
+		Where(r.db.Sq.Equal("id", claim.Id)).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColumClaims())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	claim, err = scanClaim(tx.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return claim, nil
+}
+
+// ExportClaims puts the insurer's pending claims serviced in the period into
+// a new batch and marks them submitted.
+func (r *BookingInsurance) ExportClaims(ctx context.Context, req *insurance.ExportClaims) (*insurance.ClaimBatch, []*insurance.Claim, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"Export")
+	defer span.End()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, fmt.Sprintf(`SELECT %s FROM %s
+		WHERE insurer_id = $1 AND status = $2 AND service_date BETWEEN $3 AND $4
+		ORDER BY service_date, created_at
+		FOR UPDATE`, tableColumClaims(), tableNameClaims),
+		req.InsurerId, insurance.ClaimPending, req.PeriodFrom, req.PeriodTo)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		claims []*insurance.Claim
+		ids    []string
+	)
+	for rows.Next() {
+		claim, err := scanClaim(rows)
+		if err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		claims = append(claims, claim)
+		ids = append(ids, claim.Id)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	if len(claims) == 0 {
+		return nil, nil, entity.NewErrFailedPrecondition("the insurer has no pending claims in the period")
+	}
+
+	batch, err := scanClaimBatch(tx.QueryRow(ctx, fmt.Sprintf(`INSERT INTO %s (id, insurer_id, period_from, period_to, format, claim_count, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING %s`, tableNameClaimBatches, tableColumClaimBatches()),
+		req.Id,
+		req.InsurerId,
+		req.PeriodFrom,
+		req.PeriodTo,
+		req.Format,
+		len(claims),
+		req.CreatedBy,
+	))
+	if err != nil {
+		return nil, nil, r.db.Error(err)
+	}
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameClaims).
+		SetMap(map[string]interface{}{
+			"status":     insurance.ClaimSubmitted,
+			"batch_id":   batch.Id,
+			"updated_at": time.Now(),
+		}).
+		Where(r.db.Sq.Equal("id", ids)).
+		ToSql()
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+		return nil, nil, r.db.Error(err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, nil, r.db.Error(err)
+	}
+
+	for _, claim := range claims {
+		claim.Status, claim.BatchId = insurance.ClaimSubmitted, batch.Id
+	}
+
+	return batch, claims, nil
+}
+
+func (r *BookingInsurance) GetClaimBatch(ctx context.Context, req *insurance.FieldValueReq) (*insurance.ClaimBatch, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsuranceRepo+"GetBatch")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumClaimBatches()).
+		From(tableNameClaimBatches).
+		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := scanClaimBatch(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return batch, nil
+}
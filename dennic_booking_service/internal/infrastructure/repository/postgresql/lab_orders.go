@@ -0,0 +1,458 @@
+package repo
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/lab_orders"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNameLabOrders   = "lab_orders"
+	serviceNameLabOrder  = "labOrdersRepo"
+	spanNameLabOrderRepo = "labOrdersRepo"
+)
+
+type BookingLabOrders struct {
+	db *postgres.PostgresDB
+}
+
+func NewBookingLabOrders(db *postgres.PostgresDB) *BookingLabOrders {
+	return &BookingLabOrders{
+		db: db,
+	}
+}
+
+func tableColumLabOrders() string {
+	return `id,
+			appointment_id,
+			patient_id,
+			doctor_id,
+			status,
+			notes,
+			ordered_at,
+			resulted_at,
+			released_at,
+			cancelled_at,
+			updated_at`
+}
+
+func scanLabOrder(row pgx.Row) (*lab_orders.LabOrder, error) {
+	var (
+		order       lab_orders.LabOrder
+		doctorId    sql.NullString
+		resultedAt  sql.NullTime
+		releasedAt  sql.NullTime
+		cancelledAt sql.NullTime
+		upTime      sql.NullTime
+	)
+
+	if err := row.Scan(
+		&order.Id,
+		&order.AppointmentId,
+		&order.PatientId,
+		&doctorId,
+		&order.Status,
+		&order.Notes,
+		&order.OrderedAt,
+		&resultedAt,
+		&releasedAt,
+		&cancelledAt,
+		&upTime,
+	); err != nil {
+		return nil, err
+	}
+
+	order.DoctorId = doctorId.String
+	if resultedAt.Valid {
+		order.ResultedAt = resultedAt.Time
+	}
+	if releasedAt.Valid {
+		order.ReleasedAt = releasedAt.Time
+	}
+	if cancelledAt.Valid {
+		order.CancelledAt = cancelledAt.Time
+	}
+	if upTime.Valid {
+		order.UpdatedAt = upTime.Time
+	}
+
+	return &order, nil
+}
+
+func (r *BookingLabOrders) CreateLabOrder(ctx context.Context, req *lab_orders.CreateLabOrder) (*lab_orders.LabOrder, error) {
+	ctx, span := otlp.Start(ctx, serviceNameLabOrder, spanNameLabOrderRepo+"Create")
+	defer span.End()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// the patient and the doctor come from the appointment the order is made in
+	query := fmt.Sprintf(`INSERT INTO %s (id, appointment_id, patient_id, doctor_id, notes)
+		SELECT $1, a.id, a.patient_id, a.doctor_id, $3
+		FROM booked_appointments a
+		WHERE a.id = $2 AND a.deleted_at IS NULL
+		RETURNING %s`, tableNameLabOrders, tableColumLabOrders())
+
+	order, err := scanLabOrder(tx.QueryRow(ctx, query, req.Id, req.AppointmentId, req.Notes))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("appointment")
+	}
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	insert := r.db.Sq.Builder.Insert("lab_order_tests").Columns("order_id, code, name")
+	for _, test := range req.Tests {
+		insert = insert.Values(order.Id, test.Code, test.Name)
+	}
+	toSql, args, err := insert.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	order.Tests = req.Tests
+
+	return order, nil
+}
+
+func (r *BookingLabOrders) GetLabOrder(ctx context.Context, req *lab_orders.FieldValueReq) (*lab_orders.LabOrder, error) {
+	ctx, span := otlp.Start(ctx, serviceNameLabOrder, spanNameLabOrderRepo+"Get")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumLabOrders()).
+		From(tableNameLabOrders).
+		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := scanLabOrder(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if err = loadLabOrderDetails(ctx, r.db, []*lab_orders.LabOrder{order}); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (r *BookingLabOrders) GetAllLabOrders(ctx context.Context, req *lab_orders.GetAllLabOrders) (*lab_orders.LabOrdersType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameLabOrder, spanNameLabOrderRepo+"List")
+	defer span.End()
+
+	var orders lab_orders.LabOrdersType
+
+	where := r.db.Sq.And()
+	if req.PatientId != "" {
+		where = append(where, r.db.Sq.Equal("patient_id", req.PatientId))
+	}
+	if req.DoctorId != "" {
+		where = append(where, r.db.Sq.Equal("doctor_id", req.DoctorId))
+	}
+	if req.Status != "" {
+		where = append(where, r.db.Sq.Equal("status", req.Status))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumLabOrders()).
+		From(tableNameLabOrders).
+		Where(where).
+		OrderBy("ordered_at DESC")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNameLabOrders).Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&orders.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		order, err := scanLabOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		orders.LabOrders = append(orders.LabOrders, order)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if err = loadLabOrderDetails(ctx, r.db, orders.LabOrders); err != nil {
+		return nil, err
+	}
+
+	return &orders, nil
+}
+
+// SubmitLabResults replaces the results and attachments of an order that has
+// not been released or cancelled yet and marks it resulted.
+func (r *BookingLabOrders) SubmitLabResults(ctx context.Context, req *lab_orders.SubmitResults) (*lab_orders.LabOrder, error) {
+	ctx, span := otlp.Start(ctx, serviceNameLabOrder, spanNameLabOrderRepo+"Submit")
+	defer span.End()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var status string
+	err = tx.QueryRow(ctx, fmt.Sprintf("SELECT status FROM %s WHERE id = $1 FOR UPDATE", tableNameLabOrders), req.Id).Scan(&status)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	if status != lab_orders.StatusOrdered && status != lab_orders.StatusResulted {
+		return nil, entity.NewErrFailedPrecondition("results of a " + status + " lab order cannot be changed")
+	}
+
+	for _, table := range []string{"lab_results", "lab_attachments"} {
+		if _, err = tx.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE order_id = $1", table), req.Id); err != nil {
+			return nil, r.db.Error(err)
+		}
+	}
+
+	if len(req.Results) > 0 {
+		insert := r.db.Sq.Builder.
+			Insert("lab_results").
+			Columns("order_id, test_code, analyte, value, unit, reference_range, reference_low, reference_high, flag")
+		for _, result := range req.Results {
+			insert = insert.Values(
+				req.Id,
+				result.TestCode,
+				result.Analyte,
+				result.Value,
+				result.Unit,
+				result.ReferenceRange,
+				result.ReferenceLow,
+				result.ReferenceHigh,
+				result.Flag,
+			)
+		}
+		toSql, args, err := insert.ToSql()
+		if err != nil {
+			return nil, err
+		}
+		if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+			return nil, r.db.Error(err)
+		}
+	}
+
+	if len(req.Attachments) > 0 {
+		insert := r.db.Sq.Builder.Insert("lab_attachments").Columns("order_id, file_name, url")
+		for _, attachment := range req.Attachments {
+			insert = insert.Values(req.Id, attachment.FileName, attachment.Url)
+		}
+		toSql, args, err := insert.ToSql()
+		if err != nil {
+			return nil, err
+		}
+		if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+			return nil, r.db.Error(err)
+		}
+	}
+
+	now := time.Now()
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameLabOrders).
+		SetMap(map[string]interface{}{
+			"status":      lab_orders.StatusResulted,
+			"resulted_at": now,
+			"updated_at":  now,
+		}).
+		Where(r.db.Sq.Equal("id", req.Id)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return r.GetLabOrder(ctx, &lab_orders.FieldValueReq{Field: "id", Value: req.Id})
+}
+
+// SetLabOrderStatus moves an order to a new status, stamping the matching
+// time column, as long as it currently is in one of the allowed statuses.
+func (r *BookingLabOrders) SetLabOrderStatus(ctx context.Context, req *lab_orders.StatusChange) (*lab_orders.LabOrder, error) {
+	ctx, span := otlp.Start(ctx, serviceNameLabOrder, spanNameLabOrderRepo+"Status")
+	defer span.End()
+
+	now := time.Now()
+	set := map[string]interface{}{
+		"status":     req.Status,
+		"updated_at": now,
+	}
+	switch req.Status {
+	case lab_orders.StatusReleased:
+		set["released_at"] = now
+	case lab_orders.StatusCancelled:
+		set["cancelled_at"] = now
+	}
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameLabOrders).
+		SetMap(set).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"id":     req.Id,
+			"status": req.From,
+		})).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.db.Exec(ctx, toSql, args...)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	order, err := r.GetLabOrder(ctx, &lab_orders.FieldValueReq{Field: "id", Value: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	if resp.RowsAffected() == 0 {
+		return nil, entity.NewErrFailedPrecondition("a " + order.Status + " lab order cannot be " + req.Status)
+	}
+
+	return order, nil
+}
+
+// loadLabOrderDetails fills tests, results and attachments of the given orders.
+func loadLabOrderDetails(ctx context.Context, db encounterQuerier, list []*lab_orders.LabOrder) error {
+	if len(list) == 0 {
+		return nil
+	}
+
+	byId := make(map[string]*lab_orders.LabOrder, len(list))
+	ids := make([]string, 0, len(list))
+	for _, order := range list {
+		byId[order.Id] = order
+		ids = append(ids, order.Id)
+	}
+
+	details := []struct {
+		query string
+		scan  func(rows pgx.Rows) error
+	}{
+		{
+			query: "SELECT order_id::text, code, name FROM lab_order_tests WHERE order_id = ANY($1) ORDER BY id",
+			scan: func(rows pgx.Rows) error {
+				var (
+					orderId string
+					test    lab_orders.Test
+				)
+				if err := rows.Scan(&orderId, &test.Code, &test.Name); err != nil {
+					return err
+				}
+				order := byId[orderId]
+				order.Tests = append(order.Tests, &test)
+				return nil
+			},
+		},
+		{
+			query: `SELECT order_id::text, test_code, analyte, value, unit, reference_range, reference_low, reference_high, flag
+				FROM lab_results WHERE order_id = ANY($1) ORDER BY id`,
+			scan: func(rows pgx.Rows) error {
+				var (
+					orderId string
+					result  lab_orders.Result
+				)
+				if err := rows.Scan(
+					&orderId,
+					&result.TestCode,
+					&result.Analyte,
+					&result.Value,
+					&result.Unit,
+					&result.ReferenceRange,
+					&result.ReferenceLow,
+					&result.ReferenceHigh,
+					&result.Flag,
+				); err != nil {
+					return err
+				}
+				order := byId[orderId]
+				order.Results = append(order.Results, &result)
+				return nil
+			},
+		},
+		{
+			query: "SELECT order_id::text, file_name, url FROM lab_attachments WHERE order_id = ANY($1) ORDER BY id",
+			scan: func(rows pgx.Rows) error {
+				var (
+					orderId    string
+					attachment lab_orders.Attachment
+				)
+				if err := rows.Scan(&orderId, &attachment.FileName, &attachment.Url); err != nil {
+					return err
+				}
+				order := byId[orderId]
+				order.Attachments = append(order.Attachments, &attachment)
+				return nil
+			},
+		},
+	}
+
+	for _, detail := range details {
+		rows, err := db.Query(ctx, detail.query, ids)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			if err = detail.scan(rows); err != nil {
+				rows.Close()
+				return err
+			}
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
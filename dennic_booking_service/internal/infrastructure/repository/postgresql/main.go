@@ -10,7 +10,16 @@ type IBookingStorage interface {
 	BookedAppointments() repository.BookedAppointments
 	DoctorAvailability() repository.DoctorAvailability
 	DoctorNotes() repository.DoctorNotes
+	Encounters() repository.Encounters
+	LabOrders() repository.LabOrders
+	Documents() repository.Documents
+	Messages() repository.Messages
+	Billing() repository.Billing
+	Payments() repository.Payments
+	Insurance() repository.Insurance
+	History() repository.History
 	Patients() repository.Patient
+	Prescriptions() repository.Prescriptions
 }
 
 type BookingStoragePg struct {
@@ -18,7 +27,16 @@ type BookingStoragePg struct {
 	bookedAppointments repository.BookedAppointments
 	doctorAvailability repository.DoctorAvailability
 	doctorNotes        repository.DoctorNotes
+	encounters         repository.Encounters
+	labOrders          repository.LabOrders
+	documents          repository.Documents
+	messages           repository.Messages
+	billing            repository.Billing
+	payments           repository.Payments
+	insurance          repository.Insurance
+	history            repository.History
 	patients           repository.Patient
+	prescriptions      repository.Prescriptions
 }
 
 func NewStorage(db *postgres.PostgresDB) IBookingStorage {
@@ -27,7 +45,16 @@ func NewStorage(db *postgres.PostgresDB) IBookingStorage {
 		bookedAppointments: NewBookingAppointment(db),
 		doctorAvailability: NewDoctorAvailability(db),
 		doctorNotes:        NewDoctorNotes(db),
+		encounters:         NewBookingEncounters(db),
+		labOrders:          NewBookingLabOrders(db),
+		documents:          NewBookingDocuments(db),
+		messages:           NewBookingMessages(db),
+		billing:            NewBookingBilling(db),
+		payments:           NewBookingPayments(db),
+		insurance:          NewBookingInsurance(db),
+		history:            NewBookingHistory(db),
 		patients:           NewBookingPatients(db),
+		prescriptions:      NewBookingPrescriptions(db),
 	}
 }
 
@@ -47,6 +74,42 @@ func (s *BookingStoragePg) DoctorNotes() repository.DoctorNotes {
 	return s.doctorNotes
 }
 
+func (s *BookingStoragePg) Encounters() repository.Encounters {
+	return s.encounters
+}
+
+func (s *BookingStoragePg) LabOrders() repository.LabOrders {
+	return s.labOrders
+}
+
 func (s *BookingStoragePg) Patients() repository.Patient {
 	return s.patients
 }
+
+func (s *BookingStoragePg) Prescriptions() repository.Prescriptions {
+	return s.prescriptions
+}
+
+func (s *BookingStoragePg) Documents() repository.Documents {
+	return s.documents
+}
+
+func (s *BookingStoragePg) Messages() repository.Messages {
+	return s.messages
+}
+
+func (s *BookingStoragePg) Billing() repository.Billing {
+	return s.billing
+}
+
+func (s *BookingStoragePg) Payments() repository.Payments {
+	return s.payments
+}
+
+func (s *BookingStoragePg) Insurance() repository.Insurance {
+	return s.insurance
+}
+
+func (s *BookingStoragePg) History() repository.History {
+	return s.history
+}
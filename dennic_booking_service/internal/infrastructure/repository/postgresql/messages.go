@@ -0,0 +1,528 @@
+package repo
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/messages"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNameConversations = "conversations"
+	tableNameMessages      = "conversation_messages"
+	tableNameReplyHours    = "doctor_reply_hours"
+	serviceNameMessages    = "messagesRepo"
+	spanNameMessagesRepo   = "messagesRepo"
+)
+
+type BookingMessages struct {
+	db *postgres.PostgresDB
+}
+
+func NewBookingMessages(db *postgres.PostgresDB) *BookingMessages {
+	return &BookingMessages{
+		db: db,
+	}
+}
+
+func tableColumConversations() string {
+	return `c.id,
+			c.patient_id,
+			c.doctor_id,
+			c.appointment_id,
+			c.last_message_at,
+			c.created_at`
+}
+
+func tableColumMessages() string {
+	return `id,
+			conversation_id,
+			sender_id,
+			sender_role,
+			body,
+			file_name,
+			content_type,
+			size_bytes,
+			bucket,
+			object_key,
+			read_at,
+			created_at`
+}
+
+// selectConversations counts the messages of the other side the reader has
+// not read.
+func (r *BookingMessages) selectConversations(readerRole string) squirrel.SelectBuilder {
+	return r.db.Sq.Builder.
+		Select(tableColumConversations()).
+		Column(r.db.Sq.Expr(fmt.Sprintf(`(SELECT count(*) FROM %s m
+			WHERE m.conversation_id = c.id AND m.read_at IS NULL AND m.sender_role <> ?)`, tableNameMessages), readerRole)).
+		From(tableNameConversations + " c")
+}
+
+func scanConversation(row pgx.Row) (*messages.Conversation, error) {
+	var (
+		conversation  messages.Conversation
+		appointmentId sql.NullInt64
+	)
+
+	if err := row.Scan(
+		&conversation.Id,
+		&conversation.PatientId,
+		&conversation.DoctorId,
+		&appointmentId,
+		&conversation.LastMessageAt,
+		&conversation.CreatedAt,
+		&conversation.Unread,
+	); err != nil {
+		return nil, err
+	}
+
+	conversation.AppointmentId = appointmentId.Int64
+
+	return &conversation, nil
+}
+
+func scanMessage(row pgx.Row) (*messages.Message, error) {
+	var (
+		message     messages.Message
+		fileName    sql.NullString
+		contentType sql.NullString
+		sizeBytes   sql.NullInt64
+		bucket      sql.NullString
+		objectKey   sql.NullString
+		readAt      sql.NullTime
+	)
+
+	if err := row.Scan(
+		&message.Id,
+		&message.ConversationId,
+		&message.SenderId,
+		&message.SenderRole,
+		&message.Body,
+		&fileName,
+		&contentType,
+		&sizeBytes,
+		&bucket,
+		&objectKey,
+		&readAt,
+		&message.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if objectKey.Valid {
+		message.Attachment = &messages.Attachment{
+			FileName:    fileName.String,
+			ContentType: contentType.String,
+			SizeBytes:   sizeBytes.Int64,
+			Bucket:      bucket.String,
+			ObjectKey:   objectKey.String,
+		}
+	}
+	if readAt.Valid {
+		message.ReadAt = readAt.Time
+	}
+
+	return &message, nil
+}
+
+// StartConversation opens the thread of a patient and a doctor, or returns
+// the one they already have. The patient must have had an appointment with
+// the doctor, the linked one when the thread is about an appointment.
+func (r *BookingMessages) StartConversation(ctx context.Context, req *messages.StartConversation) (*messages.Conversation, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesRepo+"Start")
+	defer span.End()
+
+	appointmentId := sql.NullInt64{Int64: req.AppointmentId, Valid: req.AppointmentId > 0}
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, patient_id, doctor_id, appointment_id)
+		SELECT $1, p.id, $3, $4::integer
+		FROM patients p
+		WHERE p.id = $2 AND p.deleted_at IS NULL
+		  AND EXISTS (
+			SELECT 1 FROM booked_appointments a
+			WHERE a.patient_id = p.id AND a.doctor_id = $3 AND a.deleted_at IS NULL
+			  AND ($4::integer IS NULL OR a.id = $4::integer))
+		ON CONFLICT (patient_id, doctor_id, (COALESCE(appointment_id, 0))) DO NOTHING`, tableNameConversations)
+
+	if _, err := r.db.Exec(ctx, query, req.Id, req.PatientId, req.DoctorId, appointmentId); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	toSql, args, err := r.selectConversations(req.ReaderRole).
+		Where(r.db.Sq.Equal("c.patient_id", req.PatientId)).
+		Where(r.db.Sq.Equal("c.doctor_id", req.DoctorId)).
+		Where(r.db.Sq.Expr("COALESCE(c.appointment_id, 0) = ?", req.AppointmentId)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, err := scanConversation(r.db.QueryRow(ctx, toSql, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("appointment of the patient with the doctor")
+	}
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return conversation, nil
+}
+
+func (r *BookingMessages) GetConversation(ctx context.Context, id, readerRole string) (*messages.Conversation, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesRepo+"GetConversation")
+	defer span.End()
+
+	toSql, args, err := r.selectConversations(readerRole).
+		Where(r.db.Sq.Equal("c.id", id)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, err := scanConversation(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return conversation, nil
+}
+
+func (r *BookingMessages) GetAllConversations(ctx context.Context, req *messages.GetAllConversations) (*messages.ConversationsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesRepo+"ListConversations")
+	defer span.End()
+
+	var list messages.ConversationsType
+
+	where := r.db.Sq.And()
+	if req.PatientId != "" {
+		where = append(where, r.db.Sq.Equal("c.patient_id", req.PatientId))
+	}
+	if req.DoctorId != "" {
+		where = append(where, r.db.Sq.Equal("c.doctor_id", req.DoctorId))
+	}
+
+	toSql := r.selectConversations(req.ReaderRole).
+		Where(where).
+		OrderBy("c.last_message_at DESC")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNameConversations + " c").Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&list.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		conversation, err := scanConversation(rows)
+		if err != nil {
+			return nil, err
+		}
+		list.Conversations = append(list.Conversations, conversation)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// CreateMessage stores a message and moves its conversation up the lists.
+func (r *BookingMessages) CreateMessage(ctx context.Context, req *messages.Message) (*messages.Message, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesRepo+"CreateMessage")
+	defer span.End()
+
+	var (
+		fileName, contentType, bucket, objectKey sql.NullString
+		sizeBytes                                sql.NullInt64
+	)
+	if attachment := req.Attachment; attachment != nil {
+		fileName = sql.NullString{String: attachment.FileName, Valid: true}
+		contentType = sql.NullString{String: attachment.ContentType, Valid: true}
+		sizeBytes = sql.NullInt64{Int64: attachment.SizeBytes, Valid: true}
+		bucket = sql.NullString{String: attachment.Bucket, Valid: true}
+		objectKey = sql.NullString{String: attachment.ObjectKey, Valid: true}
+	}
+
+	query := fmt.Sprintf(`WITH message AS (
+			INSERT INTO %s (id, conversation_id, sender_id, sender_role, body, file_name, content_type, size_bytes, bucket, object_key)
+			SELECT $1, c.id, $3, $4, $5, $6, $7, $8, $9, $10
+			FROM %s c
+			WHERE c.id = $2
+			RETURNING %s
+		), touched AS (
+			UPDATE %s SET last_message_at = (SELECT created_at FROM message)
+			WHERE id = (SELECT conversation_id FROM message)
+		)
+		SELECT %s FROM message`,
+		tableNameMessages, tableNameConversations, tableColumMessages(), tableNameConversations, tableColumMessages())
+
+	message, err := scanMessage(r.db.QueryRow(ctx, query,
+		req.Id,
+		req.ConversationId,
+		req.SenderId,
+		req.SenderRole,
+		req.Body,
+		fileName,
+		contentType,
+		sizeBytes,
+		bucket,
+		objectKey,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("conversation")
+	}
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return message, nil
+}
+
+func (r *BookingMessages) GetMessage(ctx context.Context, id string) (*messages.Message, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesRepo+"GetMessage")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumMessages()).
+		From(tableNameMessages).
+		Where(r.db.Sq.Equal("id", id)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := scanMessage(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return message, nil
+}
+
+func (r *BookingMessages) GetAllMessages(ctx context.Context, req *messages.GetAllMessages) (*messages.MessagesType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesRepo+"ListMessages")
+	defer span.End()
+
+	var list messages.MessagesType
+
+	where := r.db.Sq.Equal("conversation_id", req.ConversationId)
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumMessages()).
+		From(tableNameMessages).
+		Where(where).
+		OrderBy("created_at DESC", "id DESC")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNameMessages).Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&list.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		message, err := scanMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		list.Messages = append(list.Messages, message)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// MarkRead marks the unread messages of the other side of a conversation
+// read at readAt and returns how many there were.
+func (r *BookingMessages) MarkRead(ctx context.Context, conversationId, readerRole string, readAt time.Time) (int64, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesRepo+"MarkRead")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameMessages).
+		Set("read_at", readAt).
+		Where(r.db.Sq.Equal("conversation_id", conversationId)).
+		Where(r.db.Sq.NotEqual("sender_role", readerRole)).
+		Where("read_at IS NULL").
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := r.db.Exec(ctx, toSql, args...)
+	if err != nil {
+		return 0, r.db.Error(err)
+	}
+
+	return resp.RowsAffected(), nil
+}
+
+// GetReplyHours returns the windows of a doctor by weekday and start; a
+// doctor who set none has none.
+func (r *BookingMessages) GetReplyHours(ctx context.Context, doctorId string) (*messages.ReplyHours, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesRepo+"GetReplyHours")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select("weekday", "to_char(starts_at, 'HH24:MI')", "to_char(ends_at, 'HH24:MI')").
+		From(tableNameReplyHours).
+		Where(r.db.Sq.Equal("doctor_id", doctorId)).
+		OrderBy("weekday", "starts_at").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hours := &messages.ReplyHours{DoctorId: doctorId}
+	for rows.Next() {
+		var (
+			weekday          int16
+			startsAt, endsAt string
+		)
+		if err := rows.Scan(&weekday, &startsAt, &endsAt); err != nil {
+			return nil, err
+		}
+
+		window := &messages.ReplyWindow{Weekday: time.Weekday(weekday)}
+		if window.StartsAt, err = messages.ParseClock(startsAt); err != nil {
+			return nil, err
+		}
+		if window.EndsAt, err = messages.ParseClock(endsAt); err != nil {
+			return nil, err
+		}
+		hours.Windows = append(hours.Windows, window)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return hours, nil
+}
+
+// SetReplyHours replaces the windows of a doctor.
+func (r *BookingMessages) SetReplyHours(ctx context.Context, req *messages.ReplyHours) (*messages.ReplyHours, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesRepo+"SetReplyHours")
+	defer span.End()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	toSql, args, err := r.db.Sq.Builder.
+		Delete(tableNameReplyHours).
+		Where(r.db.Sq.Equal("doctor_id", req.DoctorId)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if len(req.Windows) > 0 {
+		insert := r.db.Sq.Builder.
+			Insert(tableNameReplyHours).
+			Columns("doctor_id", "weekday", "starts_at", "ends_at")
+		for _, window := range req.Windows {
+			insert = insert.Values(req.DoctorId, int16(window.Weekday), window.StartsAt.String(), window.EndsAt.String())
+		}
+		toSql, args, err = insert.ToSql()
+		if err != nil {
+			return nil, err
+		}
+		if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+			return nil, r.db.Error(err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.GetReplyHours(ctx, req.DoctorId)
+}
+
+// PurgeMessages deletes the messages sent before a moment and the
+// conversations left without any, returning the messages deleted. The
+// attachments of purged messages expire from their bucket on their own.
+func (r *BookingMessages) PurgeMessages(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessagesRepo+"Purge")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Delete(tableNameMessages).
+		Where(r.db.Sq.Lt("created_at", before)).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := r.db.Exec(ctx, toSql, args...)
+	if err != nil {
+		return 0, r.db.Error(err)
+	}
+
+	_, err = r.db.Exec(ctx, fmt.Sprintf(`DELETE FROM %s c
+		WHERE c.last_message_at < $1
+		  AND NOT EXISTS (SELECT 1 FROM %s m WHERE m.conversation_id = c.id)`, tableNameConversations, tableNameMessages), before)
+	if err != nil {
+		return 0, r.db.Error(err)
+	}
+
+	return resp.RowsAffected(), nil
+}
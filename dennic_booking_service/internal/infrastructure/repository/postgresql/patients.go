@@ -40,17 +40,44 @@ func tableColumPatients() string {
 			patient_problem,
 			created_at,
 			updated_at,
-			deleted_at`
+			deleted_at,
+			user_id,
+			relationship,
+			consent,
+			consent_at`
+}
+
+// nullableUserId stores profiles created outside of an account without an owner.
+func nullableUserId(userId string) interface{} {
+	if userId == "" {
+		return nil
+	}
+	return userId
+}
+
+// consentTime records when the account holder gave consent to manage the profile.
+func consentTime(consent bool) interface{} {
+	if !consent {
+		return nil
+	}
+	return time.Now()
 }
 
 func (r *BookingPatients) CreatePatient(ctx context.Context, req *patients.CreatedPatient) (*patients.Patient, error) {
 	ctx, span := otlp.Start(ctx, serviceNamePatient, spanNamePatientRepo+"Create")
 	defer span.End()
 	var (
-		patient patients.Patient
-		upTime  sql.NullTime
-		delTime sql.NullTime
+		patient   patients.Patient
+		upTime    sql.NullTime
+		delTime   sql.NullTime
+		userId    sql.NullString
+		consentAt sql.NullTime
 	)
+	relationship := req.Relationship
+	if relationship == "" {
+		relationship = patients.RelationshipSelf
+	}
+
 	toSql, args, err := r.db.Sq.Builder.
 		Insert(tableNamePatients).
 		Columns(`id,
@@ -63,7 +90,11 @@ func (r *BookingPatients) CreatePatient(ctx context.Context, req *patients.Creat
 							city,
 							country,
 							address,
-							patient_problem`).
+							patient_problem,
+							user_id,
+							relationship,
+							consent,
+							consent_at`).
 		Values(req.Id,
 			req.FirstName,
 			req.LastName,
@@ -74,7 +105,11 @@ func (r *BookingPatients) CreatePatient(ctx context.Context, req *patients.Creat
 			req.City,
 			req.Country,
 			req.Address,
-			req.PatientProblem).
+			req.PatientProblem,
+			nullableUserId(req.UserId),
+			relationship,
+			req.Consent,
+			consentTime(req.Consent)).
 		Suffix(fmt.Sprintf("RETURNING %s", tableColumPatients())).
 		ToSql()
 
@@ -97,8 +132,12 @@ func (r *BookingPatients) CreatePatient(ctx context.Context, req *patients.Creat
 		&patient.CreatedAt,
 		&upTime,
 		&delTime,
+		&userId,
+		&patient.Relationship,
+		&patient.Consent,
+		&consentAt,
 	); err != nil {
-		return nil, err
+		return nil, r.db.Error(err)
 	}
 
 	if upTime.Valid {
@@ -109,6 +148,14 @@ func (r *BookingPatients) CreatePatient(ctx context.Context, req *patients.Creat
 		patient.DeletedAt = delTime.Time
 	}
 
+	if userId.Valid {
+		patient.UserId = userId.String
+	}
+
+	if consentAt.Valid {
+		patient.ConsentAt = consentAt.Time
+	}
+
 	return &patient, nil
 
 }
@@ -118,9 +165,11 @@ func (r *BookingPatients) GetPatient(ctx context.Context, req *patients.FieldVal
 	defer span.End()
 
 	var (
-		patient patients.Patient
-		upTime  sql.NullTime
-		delTime sql.NullTime
+		patient   patients.Patient
+		upTime    sql.NullTime
+		delTime   sql.NullTime
+		userId    sql.NullString
+		consentAt sql.NullTime
 	)
 
 	toSql := r.db.Sq.Builder.
@@ -128,6 +177,10 @@ func (r *BookingPatients) GetPatient(ctx context.Context, req *patients.FieldVal
 		From(tableNamePatients).
 		Where(r.db.Sq.Equal(req.Field, req.Value))
 
+	if req.UserId != "" {
+		toSql = toSql.Where(r.db.Sq.Equal("user_id", req.UserId))
+	}
+
 	if !req.DeleteStatus {
 		toSql = toSql.Where(r.db.Sq.Equal("deleted_at", nil))
 	}
@@ -153,8 +206,12 @@ func (r *BookingPatients) GetPatient(ctx context.Context, req *patients.FieldVal
 		&patient.CreatedAt,
 		&upTime,
 		&delTime,
+		&userId,
+		&patient.Relationship,
+		&patient.Consent,
+		&consentAt,
 	); err != nil {
-		return nil, err
+		return nil, r.db.Error(err)
 	}
 
 	if upTime.Valid {
@@ -165,6 +222,14 @@ func (r *BookingPatients) GetPatient(ctx context.Context, req *patients.FieldVal
 		patient.DeletedAt = delTime.Time
 	}
 
+	if userId.Valid {
+		patient.UserId = userId.String
+	}
+
+	if consentAt.Valid {
+		patient.ConsentAt = consentAt.Time
+	}
+
 	return &patient, nil
 }
 
@@ -177,6 +242,8 @@ func (r *BookingPatients) GetAllPatiens(ctx context.Context, req *patients.GetAl
 		upTime    sql.NullTime
 		count     int64
 		delTime   sql.NullTime
+		userId    sql.NullString
+		consentAt sql.NullTime
 	)
 
 	toSql := r.db.Sq.Builder.
@@ -196,6 +263,10 @@ func (r *BookingPatients) GetAllPatiens(ctx context.Context, req *patients.GetAl
 		toSql = toSql.OrderBy(req.OrderBy)
 		countBuilder = countBuilder.Where(r.db.Sq.Equal("deleted_at", nil))
 	}
+	if req.UserId != "" {
+		toSql = toSql.Where(r.db.Sq.Equal("user_id", req.UserId))
+		countBuilder = countBuilder.Where(r.db.Sq.Equal("user_id", req.UserId))
+	}
 	if !req.DeleteStatus {
 		toSql = toSql.Where(r.db.Sq.Equal("deleted_at", nil))
 	}
@@ -205,9 +276,12 @@ func (r *BookingPatients) GetAllPatiens(ctx context.Context, req *patients.GetAl
 		return nil, err
 	}
 
-	queryCount, _, err := countBuilder.ToSql()
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
 
-	err = r.db.QueryRow(ctx, queryCount).Scan(&count)
+	err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
 
 	if err != nil {
 		return nil, err
@@ -236,9 +310,19 @@ func (r *BookingPatients) GetAllPatiens(ctx context.Context, req *patients.GetAl
 			&res.CreatedAt,
 			&upTime,
 			&delTime,
+			&userId,
+			&res.Relationship,
+			&res.Consent,
+			&consentAt,
 		); err != nil {
 			return nil, err
 		}
+		if userId.Valid {
+			res.UserId = userId.String
+		}
+		if consentAt.Valid {
+			res.ConsentAt = consentAt.Time
+		}
 
 		patientss.Patients = append(patientss.Patients, &res)
 	}
@@ -251,25 +335,42 @@ func (r *BookingPatients) UpdatePatient(ctx context.Context, req *patients.Updat
 	defer span.End()
 
 	var (
-		patient patients.Patient
-		upTime  sql.NullTime
-		delTime sql.NullTime
+		patient   patients.Patient
+		upTime    sql.NullTime
+		delTime   sql.NullTime
+		userId    sql.NullString
+		consentAt sql.NullTime
 	)
+	clauses := map[string]interface{}{
+		"first_name":      req.FirstName,
+		"last_name":       req.LastName,
+		"birth_date":      req.BirthDate.String(),
+		"gender":          req.Gender,
+		"blood_group":     req.BloodGroup,
+		"city":            req.City,
+		"country":         req.Country,
+		"address":         req.Address,
+		"patient_problem": req.PatientProblem,
+		"updated_at":      time.Now(),
+	}
+	if req.Relationship != "" {
+		clauses["relationship"] = req.Relationship
+		clauses["consent"] = req.Consent
+		// keep the moment consent was first given
+		clauses["consent_at"] = r.db.Sq.Expr("CASE WHEN ? THEN COALESCE(consent_at, ?) END", req.Consent, time.Now())
+	}
+	where := map[string]interface{}{
+		req.Field:    req.Value,
+		"deleted_at": nil,
+	}
+	if req.UserId != "" {
+		where["user_id"] = req.UserId
+	}
+
 	toSql, args, err := r.db.Sq.Builder.
 		Update(tableNamePatients).
-		SetMap(map[string]interface{}{
-			"first_name":      req.FirstName,
-			"last_name":       req.LastName,
-			"birth_date":      req.BirthDate.String(),
-			"gender":          req.Gender,
-			"blood_group":     req.BloodGroup,
-			"city":            req.City,
-			"country":         req.Country,
-			"address":         req.Address,
-			"patient_problem": req.PatientProblem,
-			"updated_at":      time.Now(),
-		}).
-		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		SetMap(clauses).
+		Where(r.db.Sq.EqualMany(where)).
 		Suffix(fmt.Sprintf("RETURNING %s", tableColumPatients())).
 		ToSql()
 
@@ -292,8 +393,12 @@ func (r *BookingPatients) UpdatePatient(ctx context.Context, req *patients.Updat
 		&patient.CreatedAt,
 		&upTime,
 		&delTime,
+		&userId,
+		&patient.Relationship,
+		&patient.Consent,
+		&consentAt,
 	); err != nil {
-		return nil, err
+		return nil, r.db.Error(err)
 	}
 
 	if upTime.Valid {
@@ -304,6 +409,14 @@ func (r *BookingPatients) UpdatePatient(ctx context.Context, req *patients.Updat
 		patient.DeletedAt = delTime.Time
 	}
 
+	if userId.Valid {
+		patient.UserId = userId.String
+	}
+
+	if consentAt.Valid {
+		patient.ConsentAt = consentAt.Time
+	}
+
 	return &patient, nil
 
 }
@@ -340,14 +453,19 @@ func (r *BookingPatients) DeletePatient(ctx context.Context, req *patients.Field
 	ctx, span := otlp.Start(ctx, serviceNamePatient, spanNamePatientRepo+"Delete")
 	defer span.End()
 
+	where := map[string]interface{}{
+		req.Field: req.Value,
+	}
+	if req.UserId != "" {
+		where["user_id"] = req.UserId
+	}
+
 	if !req.DeleteStatus {
+		where["deleted_at"] = nil
 		toSql, args, err := r.db.Sq.Builder.
 			Update(tableNamePatients).
 			Set("deleted_at", time.Now()).
-			Where(r.db.Sq.EqualMany(map[string]interface{}{
-				"deleted_at": nil,
-				req.Field:    req.Value,
-			})).
+			Where(r.db.Sq.EqualMany(where)).
 			ToSql()
 		if err != nil {
 			return &patients.StatusRes{Status: false}, err
@@ -366,7 +484,7 @@ func (r *BookingPatients) DeletePatient(ctx context.Context, req *patients.Field
 	} else {
 		toSql, args, err := r.db.Sq.Builder.
 			Delete(tableNamePatients).
-			Where(r.db.Sq.Equal(req.Field, req.Value)).
+			Where(r.db.Sq.EqualMany(where)).
 			ToSql()
 
 		if err != nil {
@@ -0,0 +1,369 @@
+package repo
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/payments"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNamePaymentIntents = "payment_intents"
+	serviceNamePayment      = "paymentsRepo"
+	spanNamePaymentRepo     = "paymentsRepo"
+)
+
+type BookingPayments struct {
+	db *postgres.PostgresDB
+}
+
+func NewBookingPayments(db *postgres.PostgresDB) *BookingPayments {
+	return &BookingPayments{
+		db: db,
+	}
+}
+
+func tableColumPaymentIntents() string {
+	return `id,
+			appointment_id,
+			patient_id,
+			amount::text,
+			currency,
+			provider,
+			provider_ref,
+			checkout_url,
+			status,
+			failure_reason,
+			idempotency_key,
+			COALESCE(invoice_id::text, ''),
+			expires_at,
+			created_at,
+			updated_at`
+}
+
+func scanPaymentIntent(row pgx.Row) (*payments.Intent, error) {
+	var (
+		intent payments.Intent
+		amount string
+		upTime sql.NullTime
+	)
+
+	if err := row.Scan(
+		&intent.Id,
+		&intent.AppointmentId,
+		&intent.PatientId,
+		&amount,
+		&intent.Currency,
+		&intent.Provider,
+		&intent.ProviderRef,
+		&intent.CheckoutURL,
+		&intent.Status,
+		&intent.FailureReason,
+		&intent.IdempotencyKey,
+		&intent.InvoiceId,
+		&intent.ExpiresAt,
+		&intent.CreatedAt,
+		&upTime,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := scanAmounts([]string{amount}, &intent.Amount); err != nil {
+		return nil, err
+	}
+	if upTime.Valid {
+		intent.UpdatedAt = upTime.Time
+	}
+
+	return &intent, nil
+}
+
+// CreateIntent opens a prepayment of a held appointment at the price stamped
+// on booking. A key the patient has used before returns the intent it created.
+func (r *BookingPayments) CreateIntent(ctx context.Context, req *payments.CreateIntent) (*payments.Intent, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePayment, spanNamePaymentRepo+"Create")
+	defer span.End()
+
+	intent, err := r.intentByKey(ctx, req)
+	if err == nil || !errors.Is(err, pgx.ErrNoRows) {
+		return intent, err
+	}
+
+	var (
+		patientId string
+		confirmed bool
+		price     sql.NullString
+		currency  string
+		expiresAt sql.NullTime
+	)
+	err = r.db.QueryRow(ctx, `SELECT patient_id, patient_status, price::text, currency, expires_at
+		FROM booked_appointments
+		WHERE id = $1 AND deleted_at IS NULL`, req.AppointmentId).
+		Scan(&patientId, &confirmed, &price, &currency, &expiresAt)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && patientId != req.PatientId) {
+		return nil, entity.NewErrNotFound("appointment")
+	}
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	switch {
+	case confirmed:
+		return nil, entity.NewErrFailedPrecondition("the appointment is already confirmed")
+	case !price.Valid || currency == "":
+		return nil, entity.NewErrFailedPrecondition("the appointment has no booked service price to pay")
+	case expiresAt.Valid && !expiresAt.Time.After(time.Now()):
+		return nil, entity.NewErrFailedPrecondition("the booking hold has expired")
+	}
+	if expiresAt.Valid {
+		req.ExpiresAt = expiresAt.Time
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, appointment_id, patient_id, amount, currency, provider, idempotency_key, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (patient_id, idempotency_key) DO NOTHING
+		RETURNING %s`, tableNamePaymentIntents, tableColumPaymentIntents())
+
+	intent, err = scanPaymentIntent(r.db.QueryRow(ctx, query,
+		req.Id,
+		req.AppointmentId,
+		req.PatientId,
+		price.String,
+		currency,
+		req.Provider,
+		req.IdempotencyKey,
+		req.ExpiresAt,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		// a concurrent request with the same key won
+		return r.intentByKey(ctx, req)
+	}
+	if err != nil {
+		if errors.Is(r.db.Error(err), entity.ErrorConflict) {
+			return nil, entity.NewErrConflict("payment of the appointment")
+		}
+		return nil, r.db.Error(err)
+	}
+
+	return intent, nil
+}
+
+func (r *BookingPayments) intentByKey(ctx context.Context, req *payments.CreateIntent) (*payments.Intent, error) {
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumPaymentIntents()).
+		From(tableNamePaymentIntents).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"patient_id":      req.PatientId,
+			"idempotency_key": req.IdempotencyKey,
+		})).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	intent, err := scanPaymentIntent(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, err
+	}
+	if intent.AppointmentId != req.AppointmentId {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("invalid payment")
+		errValidation.Errors["idempotency_key"] = "was used for another appointment"
+		return nil, errValidation
+	}
+
+	return intent, nil
+}
+
+func (r *BookingPayments) GetIntent(ctx context.Context, req *payments.FieldValueReq) (*payments.Intent, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePayment, spanNamePaymentRepo+"Get")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumPaymentIntents()).
+		From(tableNamePaymentIntents).
+		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	intent, err := scanPaymentIntent(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return intent, nil
+}
+
+func (r *BookingPayments) GetAllIntents(ctx context.Context, req *payments.GetAllIntents) (*payments.IntentsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePayment, spanNamePaymentRepo+"List")
+	defer span.End()
+
+	var list payments.IntentsType
+
+	where := r.db.Sq.And()
+	if req.PatientId != "" {
+		where = append(where, r.db.Sq.Equal("patient_id", req.PatientId))
+	}
+	if req.AppointmentId > 0 {
+		where = append(where, r.db.Sq.Equal("appointment_id", req.AppointmentId))
+	}
+	if req.Status != "" {
+		where = append(where, r.db.Sq.Equal("status", req.Status))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumPaymentIntents()).
+		From(tableNamePaymentIntents).
+		Where(where).
+		OrderBy("created_at DESC")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNamePaymentIntents).Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&list.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		intent, err := scanPaymentIntent(rows)
+		if err != nil {
+			return nil, err
+		}
+		list.Intents = append(list.Intents, intent)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// SetCheckout stores the provider session of an intent that has none yet.
+// When two retries race, the session stored first is kept and returned.
+func (r *BookingPayments) SetCheckout(ctx context.Context, id string, checkout *payments.Checkout) (*payments.Intent, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePayment, spanNamePaymentRepo+"Checkout")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNamePaymentIntents).
+		SetMap(map[string]interface{}{
+			"provider_ref": checkout.ProviderRef,
+			"checkout_url": checkout.CheckoutURL,
+			"updated_at":   time.Now(),
+		}).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"id":           id,
+			"provider_ref": "",
+		})).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColumPaymentIntents())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	intent, err := scanPaymentIntent(r.db.QueryRow(ctx, toSql, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return r.GetIntent(ctx, &payments.FieldValueReq{Field: "id", Value: id})
+	}
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return intent, nil
+}
+
+// FinishIntent moves an intent that is still in one of the from statuses to
+// the outcome. An intent already moved on is returned unchanged, so repeated
+// webhooks settle it once.
+func (r *BookingPayments) FinishIntent(ctx context.Context, req *payments.Outcome, from ...string) (*payments.Intent, bool, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePayment, spanNamePaymentRepo+"Finish")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNamePaymentIntents).
+		SetMap(map[string]interface{}{
+			"status":         req.Status,
+			"failure_reason": req.FailureReason,
+			"invoice_id":     nullString(req.InvoiceId),
+			"updated_at":     time.Now(),
+		}).
+		Where(r.db.Sq.Equal("id", req.Id)).
+		Where(r.db.Sq.Equal("status", from)).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColumPaymentIntents())).
+		ToSql()
+	if err != nil {
+		return nil, false, err
+	}
+
+	intent, err := scanPaymentIntent(r.db.QueryRow(ctx, toSql, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		intent, err = r.GetIntent(ctx, &payments.FieldValueReq{Field: "id", Value: req.Id})
+		return intent, false, err
+	}
+	if err != nil {
+		return nil, false, r.db.Error(err)
+	}
+
+	return intent, true, nil
+}
+
+// GetExpiredIntents lists pending intents whose booking hold has run out.
+func (r *BookingPayments) GetExpiredIntents(ctx context.Context, now time.Time, limit uint64) ([]*payments.Intent, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePayment, spanNamePaymentRepo+"Expired")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumPaymentIntents()).
+		From(tableNamePaymentIntents).
+		Where(r.db.Sq.Equal("status", payments.StatusPending)).
+		Where(r.db.Sq.Lt("expires_at", now)).
+		OrderBy("expires_at").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*payments.Intent
+	for rows.Next() {
+		intent, err := scanPaymentIntent(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, intent)
+	}
+
+	return list, rows.Err()
+}
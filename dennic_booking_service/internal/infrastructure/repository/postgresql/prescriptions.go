@@ -0,0 +1,706 @@
+package repo
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/encounters"
+	"booking_service/internal/entity/prescriptions"
+	"booking_service/internal/pkg/otlp"
+	"booking_service/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNameMedications      = "medications"
+	tableNameInteractions     = "medication_interactions"
+	tableNamePrescriptions    = "prescriptions"
+	serviceNamePrescription   = "prescriptionsRepo"
+	spanNamePrescriptionRepo  = "prescriptionsRepo"
+	foreignKeyViolationPgCode = "23503"
+)
+
+type BookingPrescriptions struct {
+	db *postgres.PostgresDB
+}
+
+func NewBookingPrescriptions(db *postgres.PostgresDB) *BookingPrescriptions {
+	return &BookingPrescriptions{
+		db: db,
+	}
+}
+
+func tableColumMedications() string {
+	return `id,
+			name,
+			form,
+			strength,
+			active_ingredient,
+			created_at,
+			updated_at,
+			deleted_at`
+}
+
+// tableColumPrescriptions selects a prescription together with the name and
+// birth date of the patient it was issued to.
+func tableColumPrescriptions() string {
+	return `rx.id,
+			rx.appointment_id,
+			rx.patient_id,
+			COALESCE(p.first_name || ' ' || p.last_name, ''),
+			p.birth_date,
+			rx.doctor_id,
+			rx.verification_code,
+			rx.signature,
+			rx.warnings,
+			rx.issued_at,
+			rx.revoked_at,
+			rx.created_at`
+}
+
+func scanMedication(row pgx.Row) (*prescriptions.Medication, error) {
+	var (
+		medication prescriptions.Medication
+		upTime     sql.NullTime
+		delTime    sql.NullTime
+	)
+
+	if err := row.Scan(
+		&medication.Id,
+		&medication.Name,
+		&medication.Form,
+		&medication.Strength,
+		&medication.ActiveIngredient,
+		&medication.CreatedAt,
+		&upTime,
+		&delTime,
+	); err != nil {
+		return nil, err
+	}
+
+	if upTime.Valid {
+		medication.UpdatedAt = upTime.Time
+	}
+
+	if delTime.Valid {
+		medication.DeletedAt = delTime.Time
+	}
+
+	return &medication, nil
+}
+
+func scanPrescription(row pgx.Row) (*prescriptions.Prescription, error) {
+	var (
+		prescription prescriptions.Prescription
+		birthDate    sql.NullTime
+		doctorId     sql.NullString
+		warnings     []byte
+		revTime      sql.NullTime
+	)
+
+	if err := row.Scan(
+		&prescription.Id,
+		&prescription.AppointmentId,
+		&prescription.PatientId,
+		&prescription.PatientName,
+		&birthDate,
+		&doctorId,
+		&prescription.VerificationCode,
+		&prescription.Signature,
+		&warnings,
+		&prescription.IssuedAt,
+		&revTime,
+		&prescription.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(warnings, &prescription.Warnings); err != nil {
+		return nil, err
+	}
+
+	if birthDate.Valid {
+		prescription.PatientBirthDate = birthDate.Time
+	}
+
+	if doctorId.Valid {
+		prescription.DoctorId = doctorId.String
+	}
+
+	if revTime.Valid {
+		prescription.RevokedAt = revTime.Time
+	}
+
+	return &prescription, nil
+}
+
+func (r *BookingPrescriptions) CreateMedication(ctx context.Context, req *prescriptions.Medication) (*prescriptions.Medication, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescription, spanNamePrescriptionRepo+"MedicationCreate")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Insert(tableNameMedications).
+		Columns("id, name, form, strength, active_ingredient").
+		Values(req.Id, req.Name, req.Form, req.Strength, req.ActiveIngredient).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColumMedications())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	medication, err := scanMedication(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return medication, nil
+}
+
+func (r *BookingPrescriptions) GetMedication(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.Medication, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescription, spanNamePrescriptionRepo+"MedicationGet")
+	defer span.End()
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumMedications()).
+		From(tableNameMedications).
+		Where(r.db.Sq.Equal(req.Field, req.Value))
+
+	if !req.DeleteStatus {
+		toSql = toSql.Where(r.db.Sq.Equal("deleted_at", nil))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	medication, err := scanMedication(r.db.QueryRow(ctx, toSqls, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return medication, nil
+}
+
+func (r *BookingPrescriptions) GetAllMedications(ctx context.Context, req *prescriptions.GetAllMedications) (*prescriptions.MedicationsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescription, spanNamePrescriptionRepo+"MedicationList")
+	defer span.End()
+
+	var medications prescriptions.MedicationsType
+
+	where := r.db.Sq.And(r.db.Sq.Equal("deleted_at", nil))
+	if req.Search != "" {
+		where = append(where, r.db.Sq.Or(
+			r.db.Sq.ILike("name", req.Search+"%"),
+			r.db.Sq.ILike("active_ingredient", req.Search+"%"),
+		))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumMedications()).
+		From(tableNameMedications).
+		Where(where).
+		OrderBy("name", "strength")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNameMedications).Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&medications.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		medication, err := scanMedication(rows)
+		if err != nil {
+			return nil, err
+		}
+		medications.Medications = append(medications.Medications, medication)
+	}
+
+	return &medications, rows.Err()
+}
+
+func (r *BookingPrescriptions) UpdateMedication(ctx context.Context, req *prescriptions.Medication) (*prescriptions.Medication, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescription, spanNamePrescriptionRepo+"MedicationUpdate")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameMedications).
+		SetMap(map[string]interface{}{
+			"name":              req.Name,
+			"form":              req.Form,
+			"strength":          req.Strength,
+			"active_ingredient": req.ActiveIngredient,
+			"updated_at":        time.Now(),
+		}).
+		Where(r.db.Sq.EqualMany(map[string]interface{}{
+			"id":         req.Id,
+			"deleted_at": nil,
+		})).
+		Suffix(fmt.Sprintf("RETURNING %s", tableColumMedications())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	medication, err := scanMedication(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return medication, nil
+}
+
+func (r *BookingPrescriptions) DeleteMedication(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.StatusRes, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescription, spanNamePrescriptionRepo+"MedicationDelete")
+	defer span.End()
+
+	if !req.DeleteStatus {
+		toSql, args, err := r.db.Sq.Builder.
+			Update(tableNameMedications).
+			Set("deleted_at", time.Now()).
+			Where(r.db.Sq.EqualMany(map[string]interface{}{
+				"deleted_at": nil,
+				req.Field:    req.Value,
+			})).
+			ToSql()
+		if err != nil {
+			return &prescriptions.StatusRes{Status: false}, err
+		}
+
+		resp, err := r.db.Exec(ctx, toSql, args...)
+		if err != nil {
+			return &prescriptions.StatusRes{Status: false}, err
+		}
+		return &prescriptions.StatusRes{Status: resp.RowsAffected() > 0}, nil
+	}
+
+	toSql, args, err := r.db.Sq.Builder.
+		Delete(tableNameMedications).
+		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		ToSql()
+	if err != nil {
+		return &prescriptions.StatusRes{Status: false}, err
+	}
+
+	resp, err := r.db.Exec(ctx, toSql, args...)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationPgCode {
+		return &prescriptions.StatusRes{Status: false}, entity.NewErrFailedPrecondition("medication has been prescribed, it can only be soft deleted")
+	}
+	if err != nil {
+		return &prescriptions.StatusRes{Status: false}, err
+	}
+	return &prescriptions.StatusRes{Status: resp.RowsAffected() > 0}, nil
+}
+
+// CreateInteraction records an interaction between two active ingredients,
+// replacing the severity and description of a known pair.
+func (r *BookingPrescriptions) CreateInteraction(ctx context.Context, req *prescriptions.Interaction) (*prescriptions.Interaction, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescription, spanNamePrescriptionRepo+"InteractionCreate")
+	defer span.End()
+
+	a, b := strings.ToLower(strings.TrimSpace(req.IngredientA)), strings.ToLower(strings.TrimSpace(req.IngredientB))
+	if a > b {
+		a, b = b, a
+	}
+
+	toSql, args, err := r.db.Sq.Builder.
+		Insert(tableNameInteractions).
+		Columns("ingredient_a, ingredient_b, severity, description").
+		Values(a, b, req.Severity, req.Description).
+		Suffix("ON CONFLICT (ingredient_a, ingredient_b) DO UPDATE SET severity = EXCLUDED.severity, description = EXCLUDED.description").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = r.db.Exec(ctx, toSql, args...); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return &prescriptions.Interaction{
+		IngredientA: a,
+		IngredientB: b,
+		Severity:    req.Severity,
+		Description: req.Description,
+	}, nil
+}
+
+// GetInteractions returns the known interactions among the given ingredients.
+func (r *BookingPrescriptions) GetInteractions(ctx context.Context, ingredients []string) ([]*prescriptions.Interaction, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescription, spanNamePrescriptionRepo+"InteractionList")
+	defer span.End()
+
+	query := fmt.Sprintf(`SELECT ingredient_a, ingredient_b, severity, description
+		FROM %s
+		WHERE ingredient_a = ANY($1) AND ingredient_b = ANY($1)`, tableNameInteractions)
+
+	rows, err := r.db.Query(ctx, query, ingredients)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var interactions []*prescriptions.Interaction
+	for rows.Next() {
+		var interaction prescriptions.Interaction
+		if err = rows.Scan(&interaction.IngredientA, &interaction.IngredientB, &interaction.Severity, &interaction.Description); err != nil {
+			return nil, err
+		}
+		interactions = append(interactions, &interaction)
+	}
+
+	return interactions, rows.Err()
+}
+
+// GetPatientAllergies returns the allergies recorded in the patient's encounters.
+func (r *BookingPrescriptions) GetPatientAllergies(ctx context.Context, patientId string) ([]*encounters.Allergy, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescription, spanNamePrescriptionRepo+"Allergies")
+	defer span.End()
+
+	query := `SELECT DISTINCT a.substance, a.reaction, a.severity
+		FROM encounter_allergies a
+		JOIN encounters e ON e.id = a.encounter_id
+		WHERE e.patient_id = $1 AND e.deleted_at IS NULL`
+
+	rows, err := r.db.Query(ctx, query, patientId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var allergies []*encounters.Allergy
+	for rows.Next() {
+		var allergy encounters.Allergy
+		if err = rows.Scan(&allergy.Substance, &allergy.Reaction, &allergy.Severity); err != nil {
+			return nil, err
+		}
+		allergies = append(allergies, &allergy)
+	}
+
+	return allergies, rows.Err()
+}
+
+// GetActiveIngredients returns the ingredients of the patient's prescriptions
+// that are not revoked and still running at the given time.
+func (r *BookingPrescriptions) GetActiveIngredients(ctx context.Context, patientId string, at time.Time) ([]string, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescription, spanNamePrescriptionRepo+"ActiveIngredients")
+	defer span.End()
+
+	query := `SELECT DISTINCT LOWER(i.active_ingredient)
+		FROM prescription_items i
+		JOIN prescriptions rx ON rx.id = i.prescription_id
+		WHERE rx.patient_id = $1
+		  AND rx.revoked_at IS NULL
+		  AND rx.issued_at + i.duration_days * INTERVAL '1 day' > $2`
+
+	rows, err := r.db.Query(ctx, query, patientId, at)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ingredients []string
+	for rows.Next() {
+		var ingredient string
+		if err = rows.Scan(&ingredient); err != nil {
+			return nil, err
+		}
+		ingredients = append(ingredients, ingredient)
+	}
+
+	return ingredients, rows.Err()
+}
+
+// GetAppointmentParties returns the patient and the doctor of an appointment.
+func (r *BookingPrescriptions) GetAppointmentParties(ctx context.Context, appointmentId int64) (*prescriptions.Parties, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescription, spanNamePrescriptionRepo+"Parties")
+	defer span.End()
+
+	var (
+		parties  prescriptions.Parties
+		doctorId sql.NullString
+	)
+
+	err := r.db.QueryRow(ctx, `SELECT patient_id, doctor_id FROM booked_appointments WHERE id = $1 AND deleted_at IS NULL`, appointmentId).
+		Scan(&parties.PatientId, &doctorId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, entity.NewErrNotFound("appointment")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	parties.DoctorId = doctorId.String
+
+	return &parties, nil
+}
+
+func (r *BookingPrescriptions) CreatePrescription(ctx context.Context, req *prescriptions.Prescription) (*prescriptions.Prescription, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescription, spanNamePrescriptionRepo+"Create")
+	defer span.End()
+
+	warnings, err := json.Marshal(req.Warnings)
+	if err != nil {
+		return nil, err
+	}
+	if req.Warnings == nil {
+		warnings = []byte("[]")
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	toSql, args, err := r.db.Sq.Builder.
+		Insert(tableNamePrescriptions).
+		Columns("id, appointment_id, patient_id, doctor_id, verification_code, signature, warnings, issued_at").
+		Values(
+			req.Id,
+			req.AppointmentId,
+			req.PatientId,
+			nullString(req.DoctorId),
+			req.VerificationCode,
+			req.Signature,
+			string(warnings),
+			req.IssuedAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	insert := r.db.Sq.Builder.
+		Insert("prescription_items").
+		Columns("prescription_id, medication_id, medication_name, form, strength, active_ingredient, dose, frequency, duration_days, route, instructions")
+	for _, item := range req.Items {
+		insert = insert.Values(
+			req.Id,
+			item.MedicationId,
+			item.MedicationName,
+			item.Form,
+			item.Strength,
+			item.ActiveIngredient,
+			item.Dose,
+			item.Frequency,
+			item.DurationDays,
+			item.Route,
+			item.Instructions,
+		)
+	}
+
+	toSql, args, err = insert.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = tx.Exec(ctx, toSql, args...); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return r.GetPrescription(ctx, &prescriptions.FieldValueReq{Field: "id", Value: req.Id})
+}
+
+// GetPrescription looks a prescription up by id or by verification code.
+func (r *BookingPrescriptions) GetPrescription(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.Prescription, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescription, spanNamePrescriptionRepo+"Get")
+	defer span.End()
+
+	toSqls, args, err := r.db.Sq.Builder.
+		Select(tableColumPrescriptions()).
+		From(tableNamePrescriptions + " rx").
+		LeftJoin("patients p ON p.id = rx.patient_id").
+		Where(r.db.Sq.Equal("rx."+req.Field, req.Value)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	prescription, err := scanPrescription(r.db.QueryRow(ctx, toSqls, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	if err = loadPrescriptionItems(ctx, r.db, []*prescriptions.Prescription{prescription}); err != nil {
+		return nil, err
+	}
+
+	return prescription, nil
+}
+
+func (r *BookingPrescriptions) GetAllPrescriptions(ctx context.Context, req *prescriptions.GetAllPrescriptions) (*prescriptions.PrescriptionsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescription, spanNamePrescriptionRepo+"List")
+	defer span.End()
+
+	var list prescriptions.PrescriptionsType
+
+	where := r.db.Sq.And()
+	if req.PatientId != "" {
+		where = append(where, r.db.Sq.Equal("rx.patient_id", req.PatientId))
+	}
+	if req.DoctorId != "" {
+		where = append(where, r.db.Sq.Equal("rx.doctor_id", req.DoctorId))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumPrescriptions()).
+		From(tableNamePrescriptions + " rx").
+		LeftJoin("patients p ON p.id = rx.patient_id").
+		Where(where).
+		OrderBy("rx.issued_at DESC")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNamePrescriptions + " rx").Where(where).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&list.Count); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		prescription, err := scanPrescription(rows)
+		if err != nil {
+			return nil, err
+		}
+		list.Prescriptions = append(list.Prescriptions, prescription)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if err = loadPrescriptionItems(ctx, r.db, list.Prescriptions); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// RevokePrescription marks a prescription as no longer dispensable. Revoking
+// twice keeps the first revocation time.
+func (r *BookingPrescriptions) RevokePrescription(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.Prescription, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescription, spanNamePrescriptionRepo+"Revoke")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNamePrescriptions).
+		Set("revoked_at", r.db.Sq.Expr("COALESCE(revoked_at, ?)", time.Now())).
+		Where(r.db.Sq.Equal(req.Field, req.Value)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.db.Exec(ctx, toSql, args...)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	if resp.RowsAffected() == 0 {
+		return nil, entity.NewErrNotFound("prescription")
+	}
+
+	return r.GetPrescription(ctx, req)
+}
+
+// loadPrescriptionItems fills the items of the given prescriptions with one query.
+func loadPrescriptionItems(ctx context.Context, db encounterQuerier, list []*prescriptions.Prescription) error {
+	if len(list) == 0 {
+		return nil
+	}
+
+	byId := make(map[string]*prescriptions.Prescription, len(list))
+	ids := make([]string, 0, len(list))
+	for _, prescription := range list {
+		byId[prescription.Id] = prescription
+		ids = append(ids, prescription.Id)
+	}
+
+	rows, err := db.Query(ctx, `SELECT prescription_id::text, medication_id, medication_name, form, strength,
+			active_ingredient, dose, frequency, duration_days, route, instructions
+		FROM prescription_items
+		WHERE prescription_id = ANY($1)
+		ORDER BY id`, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			prescriptionId string
+			item           prescriptions.Item
+		)
+		if err = rows.Scan(
+			&prescriptionId,
+			&item.MedicationId,
+			&item.MedicationName,
+			&item.Form,
+			&item.Strength,
+			&item.ActiveIngredient,
+			&item.Dose,
+			&item.Frequency,
+			&item.DurationDays,
+			&item.Route,
+			&item.Instructions,
+		); err != nil {
+			return err
+		}
+		prescription := byId[prescriptionId]
+		prescription.Items = append(prescription.Items, &item)
+	}
+
+	return rows.Err()
+}
@@ -1,8 +1,11 @@
 package suit_tests
 
 import (
+	"booking_service/internal/entity"
 	"booking_service/internal/entity/archive"
-	"booking_service/internal/entity/doctor_availability"
+	"booking_service/internal/entity/billing"
+	"booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/patients"
 	repo "booking_service/internal/infrastructure/repository/postgresql"
 	"booking_service/internal/pkg/config"
 	db "booking_service/internal/pkg/postgres"
@@ -17,15 +20,17 @@ import (
 
 type BookingArchiveTestSite struct {
 	suite.Suite
-	Repository         *repo.BookingArchive
-	DoctorAvailability *repo.DoctorAvailability
-	CleanUpFunc        func()
+	Repository  *repo.BookingArchive
+	Appointment *repo.BookingAppointment
+	Patient     *repo.BookingPatients
+	CleanUpFunc func()
 }
 
 func (s *BookingArchiveTestSite) SetupSuite() {
 	pgPool, _ := db.New(config.New())
 	s.Repository = repo.NewBookingArchive(pgPool)
-	s.DoctorAvailability = repo.NewDoctorAvailability(pgPool)
+	s.Appointment = repo.NewBookingAppointment(pgPool)
+	s.Patient = repo.NewBookingPatients(pgPool)
 	s.CleanUpFunc = pgPool.Close
 }
 
@@ -33,49 +38,65 @@ func (s *BookingArchiveTestSite) TestUserCRUD() {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
 	defer cancel()
 
-	doctorDate, _ := date.AutoParse("1231-02-02")
-	startTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 14:14:14")
-	endTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 11:11:11")
-
-	createReq := &doctor_availability.CreateDoctorAvailability{
-		DepartmentId: uuid.New().String(),
-		DoctorId:     uuid.New().String(),
-		DoctorDate:   doctorDate,
-		StartTime:    startTime,
-		EndTime:      endTime,
-		Status:       "available",
-	}
+	patient, err := s.Patient.CreatePatient(ctx, &patients.CreatedPatient{
+		Id:          uuid.NewString(),
+		FirstName:   "Husan",
+		LastName:    "Gofurov",
+		BirthDate:   date.Today(),
+		Gender:      "male",
+		PhoneNumber: "+998950230605",
+	})
+	s.Suite.NoError(err)
 
-	createRes, err := s.DoctorAvailability.CreateDoctorAvailability(ctx, createReq)
+	appDate, _ := date.AutoParse("2024-05-06")
+	appTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 10:00:00")
+	appointment, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.NewString(),
+		DoctorId:        uuid.NewString(),
+		PatientId:       patient.Id,
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+		Key:             "ABC",
+		ExpiresAt:       appTime,
+		PatientStatus:   true,
+		Modality:        "offline",
+		Currency:        "UZS",
+	})
 	s.Suite.NoError(err)
-	s.Suite.NotNil(createRes)
-	s.Suite.Equal(createRes.DepartmentId, createReq.DepartmentId)
-	s.Suite.Equal(createRes.DoctorId, createReq.DoctorId)
-	s.Suite.Equal(createRes.DoctorDate, createReq.DoctorDate)
-	s.Suite.Equal(createRes.StartTime, createReq.StartTime)
-	s.Suite.Equal(createRes.EndTime, createReq.EndTime)
-	s.Suite.Equal(createRes.Status, createReq.Status)
 
+	// the visit times default to the booked slot
 	createArchiveReq := &archive.CreatedArchive{
-		DoctorAvailabilityId: createRes.Id,
-		StartTime:            startTime,
-		EndTime:              endTime,
-		PatientProblem:       "No Problem",
-		Status:               "attended",
-		PaymentType:          "card",
-		PaymentAmount:        10,
+		AppointmentId:  appointment.Id,
+		PatientProblem: "No Problem",
+		Outcome:        archive.OutcomeAttended,
+		PaymentType:    archive.PaymentCard,
+		PaymentAmount:  1000000,
 	}
 
 	createArchiveRes, err := s.Repository.CreateArchive(ctx, createArchiveReq)
 	s.Suite.NoError(err)
 	s.Suite.NotNil(createArchiveRes)
-	s.Suite.Equal(createArchiveRes.DoctorAvailabilityId, createArchiveReq.DoctorAvailabilityId)
-	s.Suite.Equal(createArchiveRes.StartTime, createArchiveReq.StartTime)
-	s.Suite.Equal(createArchiveRes.EndTime, createArchiveReq.EndTime)
-	s.Suite.Equal(createArchiveRes.PatientProblem, createArchiveReq.PatientProblem)
-	s.Suite.Equal(createArchiveRes.Status, createArchiveReq.Status)
-	s.Suite.Equal(createArchiveRes.PaymentType, createArchiveReq.PaymentType)
-	s.Suite.Equal(createArchiveRes.PaymentAmount, createArchiveReq.PaymentAmount)
+	s.Suite.Equal(createArchiveReq.AppointmentId, createArchiveRes.AppointmentId)
+	s.Suite.Equal(patient.Id, createArchiveRes.PatientId)
+	s.Suite.Equal(appointment.DoctorId, createArchiveRes.DoctorId)
+	s.Suite.Equal(time.Date(2024, 5, 6, 10, 0, 0, 0, time.UTC), createArchiveRes.StartedAt.UTC())
+	s.Suite.Equal(time.Date(2024, 5, 6, 10, 30, 0, 0, time.UTC), createArchiveRes.EndedAt.UTC())
+	s.Suite.Equal(createArchiveReq.PatientProblem, createArchiveRes.PatientProblem)
+	s.Suite.Equal(createArchiveReq.Outcome, createArchiveRes.Outcome)
+	s.Suite.Equal(createArchiveReq.PaymentType, createArchiveRes.PaymentType)
+	s.Suite.Equal(createArchiveReq.PaymentAmount, createArchiveRes.PaymentAmount)
+
+	closed, err := s.Appointment.GetAppointment(ctx, &booked_appointments.FieldValueReq{
+		Field: "id",
+		Value: strconv.Itoa(int(appointment.Id)),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(archive.OutcomeAttended, closed.Status)
+
+	// an appointment is closed out once
+	_, err = s.Repository.CreateArchive(ctx, createArchiveReq)
+	s.Suite.ErrorIs(err, entity.ErrorConflict)
 
 	getRes, err := s.Repository.GetArchive(ctx, &archive.FieldValueReq{
 		Field:        "id",
@@ -84,60 +105,45 @@ func (s *BookingArchiveTestSite) TestUserCRUD() {
 	})
 	s.Suite.NoError(err)
 	s.Suite.NotNil(getRes)
-	s.Suite.Equal(getRes.Id, createArchiveRes.Id)
-	s.Suite.Equal(getRes.DoctorAvailabilityId, createArchiveRes.DoctorAvailabilityId)
-	s.Suite.Equal(getRes.StartTime, createArchiveRes.StartTime)
-	s.Suite.Equal(getRes.EndTime, createArchiveRes.EndTime)
-	s.Suite.Equal(getRes.PatientProblem, createArchiveRes.PatientProblem)
-	s.Suite.Equal(getRes.Status, createArchiveRes.Status)
-	s.Suite.Equal(getRes.PaymentType, createArchiveRes.PaymentType)
-	s.Suite.Equal(getRes.PaymentAmount, createArchiveRes.PaymentAmount)
+	s.Suite.Equal(createArchiveRes.Id, getRes.Id)
+	s.Suite.Equal(createArchiveRes.AppointmentId, getRes.AppointmentId)
+	s.Suite.Equal(createArchiveRes.PaymentAmount, getRes.PaymentAmount)
 
 	getAllRes, err := s.Repository.GetAllArchive(ctx, &archive.GetAllArchives{
-		Page:         1,
-		Limit:        5,
-		DeleteStatus: true,
-		OrderBy:      "status",
+		Page:      1,
+		Limit:     5,
+		PatientId: patient.Id,
 	})
 	s.Suite.NoError(err)
-	s.Suite.NotNil(getAllRes)
-
-	newStartTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 14:14:14")
-	newEndTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 11:11:11")
+	s.Suite.Equal(int64(1), getAllRes.Count)
+	s.Suite.Len(getAllRes.Archives, 1)
 
+	startedAt := time.Date(2024, 5, 6, 10, 5, 0, 0, time.UTC)
 	updateReq := &archive.UpdateArchive{
-		Field:                "id",
-		Value:                strconv.Itoa(int(getRes.Id)),
-		DoctorAvailabilityId: createRes.Id,
-		StartTime:            newStartTime,
-		EndTime:              newEndTime,
-		PatientProblem:       "Yes Problem",
-		Status:               "cancelled",
-		PaymentType:          "cash",
-		PaymentAmount:        12,
+		Field:          "id",
+		Value:          strconv.Itoa(int(getRes.Id)),
+		StartedAt:      startedAt,
+		EndedAt:        startedAt.Add(10 * time.Minute),
+		PatientProblem: "Yes Problem",
+		Outcome:        archive.OutcomeNoShow,
 	}
 	updateRes, err := s.Repository.UpdateArchive(ctx, updateReq)
 	s.Suite.NoError(err)
 	s.Suite.NotNil(updateRes)
-	s.Suite.Equal(updateRes.Id, getRes.Id)
-	s.Suite.Equal(updateRes.DoctorAvailabilityId, updateReq.DoctorAvailabilityId)
-	s.Suite.Equal(updateRes.StartTime, updateReq.StartTime)
-	s.Suite.Equal(updateRes.EndTime, updateReq.EndTime)
-	s.Suite.Equal(updateRes.PatientProblem, updateReq.PatientProblem)
-	s.Suite.Equal(updateRes.Status, updateReq.Status)
-	s.Suite.Equal(updateRes.PaymentType, updateReq.PaymentType)
-	s.Suite.Equal(updateRes.PaymentAmount, updateReq.PaymentAmount)
-
-	//
+	s.Suite.Equal(getRes.Id, updateRes.Id)
+	s.Suite.Equal(updateReq.StartedAt, updateRes.StartedAt.UTC())
+	s.Suite.Equal(updateReq.EndedAt, updateRes.EndedAt.UTC())
+	s.Suite.Equal(updateReq.PatientProblem, updateRes.PatientProblem)
+	s.Suite.Equal(updateReq.Outcome, updateRes.Outcome)
+	s.Suite.Equal("", updateRes.PaymentType)
+	s.Suite.Equal(billing.Amount(0), updateRes.PaymentAmount)
 
 	softDeleteArchiveRes, err := s.Repository.DeleteArchive(ctx, &archive.FieldValueReq{
-		Field:        "id",
-		Value:        strconv.Itoa(int(createArchiveRes.Id)),
-		DeleteStatus: true,
+		Field: "id",
+		Value: strconv.Itoa(int(createArchiveRes.Id)),
 	})
 	s.Suite.NoError(err)
-	s.Suite.NotNil(softDeleteArchiveRes)
-	s.Suite.Equal(softDeleteArchiveRes.Status, true)
+	s.Suite.Equal(true, softDeleteArchiveRes.Status)
 
 	hardDeleteArchiveRes, err := s.Repository.DeleteArchive(ctx, &archive.FieldValueReq{
 		Field:        "id",
@@ -148,13 +154,12 @@ func (s *BookingArchiveTestSite) TestUserCRUD() {
 	s.Suite.NotNil(hardDeleteArchiveRes)
 	s.Suite.Equal(hardDeleteArchiveRes.Status, true)
 
-	hardDelRes, err := s.DoctorAvailability.DeleteDoctorAvailability(ctx, &doctor_availability.FieldValueReq{
-		Field:        "doctor_id",
-		Value:        createReq.DoctorId,
+	hardDelRes, err := s.Appointment.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.Itoa(int(appointment.Id)),
 		DeleteStatus: true,
 	})
 	s.Suite.NoError(err)
-	s.Suite.NotNil(hardDelRes)
 	s.Suite.Equal(hardDelRes.Status, true)
 }
 
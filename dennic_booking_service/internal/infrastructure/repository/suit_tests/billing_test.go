@@ -0,0 +1,120 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/billing"
+	"booking_service/internal/entity/booked_appointments"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type BookingBillingTestSite struct {
+	suite.Suite
+	Repository  *repo.BookingBilling
+	Appointment *repo.BookingAppointment
+	CleanUpFunc func()
+}
+
+func (s *BookingBillingTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewBookingBilling(pgPool)
+	s.Appointment = repo.NewBookingAppointment(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *BookingBillingTestSite) TestInvoiceWorkflow() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	branchId := uuid.NewString()
+	appDate, _ := date.AutoParse("2024-05-02")
+	appTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 11:00:00")
+	appointment, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.NewString(),
+		BranchId:        branchId,
+		DoctorId:        uuid.NewString(),
+		PatientId:       uuid.NewString(),
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+		Key:             "ABC",
+		ExpiresAt:       appTime,
+		PatientStatus:   true,
+		Modality:        "offline",
+		DoctorServiceId: uuid.NewString(),
+		Price:           "150000.00",
+		Currency:        "UZS",
+	})
+	s.Suite.NoError(err)
+
+	invoice, err := s.Repository.CreateInvoice(ctx, &billing.CreateInvoice{
+		Id:            uuid.NewString(),
+		AppointmentId: appointment.Id,
+		Discount:      1000000,
+		Items:         []*billing.Item{{Kind: billing.ItemProcedure, Description: "ECG", Quantity: 1, UnitPrice: 5000000}},
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(invoice.Items, 2)
+	s.Suite.Equal(billing.Amount(20000000), invoice.Subtotal)
+	s.Suite.Equal(billing.Amount(19000000), invoice.Total)
+	s.Suite.Equal(billing.StatusIssued, invoice.Status)
+	s.Suite.NotEmpty(invoice.Number)
+
+	// one active invoice per appointment
+	_, err = s.Repository.CreateInvoice(ctx, &billing.CreateInvoice{Id: uuid.NewString(), AppointmentId: appointment.Id})
+	s.Suite.ErrorIs(err, entity.ErrorConflict)
+
+	cashierId := uuid.NewString()
+	invoice, err = s.Repository.RecordTransaction(ctx, &billing.Transaction{
+		Id: uuid.NewString(), InvoiceId: invoice.Id, Kind: billing.KindPayment, Method: billing.MethodCash, Amount: 9000000, CashierId: cashierId,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(billing.StatusPartiallyPaid, invoice.Status)
+	s.Suite.Equal(billing.Amount(10000000), invoice.Balance())
+
+	var errPrecond *entity.ErrFailedPrecondition
+	_, err = s.Repository.AddInvoiceItem(ctx, &billing.AddItem{InvoiceId: invoice.Id, Item: &billing.Item{Kind: billing.ItemProcedure, Description: "X-ray", Quantity: 1, UnitPrice: 100}})
+	s.Suite.ErrorAs(err, &errPrecond)
+	_, err = s.Repository.RecordTransaction(ctx, &billing.Transaction{
+		Id: uuid.NewString(), InvoiceId: invoice.Id, Kind: billing.KindPayment, Method: billing.MethodCard, Amount: 10000001, CashierId: cashierId,
+	})
+	s.Suite.ErrorAs(err, &errPrecond)
+
+	invoice, err = s.Repository.RecordTransaction(ctx, &billing.Transaction{
+		Id: uuid.NewString(), InvoiceId: invoice.Id, Kind: billing.KindPayment, Method: billing.MethodCard, Amount: 10000000, CashierId: cashierId,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(billing.StatusPaid, invoice.Status)
+
+	invoice, err = s.Repository.RecordTransaction(ctx, &billing.Transaction{
+		Id: uuid.NewString(), InvoiceId: invoice.Id, Kind: billing.KindRefund, Method: billing.MethodCash, Amount: 5000000, CashierId: cashierId, Reference: "ECG not done",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(billing.StatusPartiallyPaid, invoice.Status)
+	s.Suite.Len(invoice.Payments, 3)
+
+	_, err = s.Repository.VoidInvoice(ctx, &billing.FieldValueReq{Field: "id", Value: invoice.Id})
+	s.Suite.ErrorAs(err, &errPrecond)
+
+	report, err := s.Repository.GetCashDeskReport(ctx, &billing.CashDeskReportReq{BranchId: branchId, Date: time.Now(), CashierId: cashierId})
+	s.Suite.NoError(err)
+	s.Suite.Len(report.Rows, 2)
+	s.Suite.Len(report.Totals, 1)
+	s.Suite.Equal(billing.Amount(19000000), report.Totals[0].Payments)
+	s.Suite.Equal(billing.Amount(5000000), report.Totals[0].Refunds)
+}
+
+func (s *BookingBillingTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestBookingBillingTestSuite(t *testing.T) {
+	suite.Run(t, new(BookingBillingTestSite))
+}
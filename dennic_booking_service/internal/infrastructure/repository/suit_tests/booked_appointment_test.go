@@ -1,6 +1,7 @@
 package suit_tests
 
 import (
+	"booking_service/internal/entity"
 	"booking_service/internal/entity/booked_appointments"
 	"booking_service/internal/entity/patients"
 	repo "booking_service/internal/infrastructure/repository/postgresql"
@@ -169,6 +170,195 @@ func (s *BookingAppointmentTestSite) TestUserCRUD() {
 	s.Suite.Equal(hardDeleteRes.Status, true)
 }
 
+func (s *BookingAppointmentTestSite) TestResourceReservation() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patient := &patients.CreatedPatient{
+		Id:          uuid.New().String(),
+		FirstName:   "Husanboy",
+		LastName:    "Gofurov",
+		BirthDate:   date.Today(),
+		Gender:      "male",
+		PhoneNumber: "+998950230606",
+	}
+	patientRes, err := s.Patient.CreatePatient(ctx, patient)
+	s.Suite.NoError(err)
+	s.Suite.NotNil(patientRes)
+
+	appDate, _ := date.AutoParse("1221-12-13")
+	appTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 10:00:00")
+	overlapTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 10:15:00")
+	axpTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 01:01:01")
+	resourceId := uuid.New().String()
+
+	first, err := s.Repository.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		DoctorId:        uuid.New().String(),
+		ResourceId:      resourceId,
+		PatientId:       patientRes.Id,
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+		Key:             "ABC",
+		ExpiresAt:       axpTime,
+	})
+	s.Suite.NoError(err)
+	s.Suite.NotNil(first)
+	s.Suite.Equal(resourceId, first.ResourceId)
+
+	// another doctor cannot take the same resource while it is reserved
+	_, err = s.Repository.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		DoctorId:        uuid.New().String(),
+		ResourceId:      resourceId,
+		PatientId:       patientRes.Id,
+		AppointmentDate: appDate,
+		AppointmentTime: overlapTime,
+		Duration:        30,
+		Key:             "ABD",
+		ExpiresAt:       axpTime,
+	})
+	s.Suite.ErrorAs(err, new(*entity.ErrConflict))
+
+	// cancelling the first appointment frees the resource
+	softDelRes, err := s.Repository.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+		Field: "id",
+		Value: strconv.Itoa(int(first.Id)),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(softDelRes.Status, true)
+
+	second, err := s.Repository.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		DoctorId:        uuid.New().String(),
+		ResourceId:      resourceId,
+		PatientId:       patientRes.Id,
+		AppointmentDate: appDate,
+		AppointmentTime: overlapTime,
+		Duration:        30,
+		Key:             "ABD",
+		ExpiresAt:       axpTime,
+	})
+	s.Suite.NoError(err)
+	s.Suite.NotNil(second)
+
+	// so does marking the second one cancelled, which frees its doctor too
+	_, err = s.Repository.SetAppointmentStatus(ctx, &booked_appointments.AppointmentStatusReq{
+		Id:     second.Id,
+		Status: booked_appointments.StatusCancelled,
+	})
+	s.Suite.NoError(err)
+
+	third, err := s.Repository.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		DoctorId:        second.DoctorId,
+		ResourceId:      resourceId,
+		PatientId:       patientRes.Id,
+		AppointmentDate: appDate,
+		AppointmentTime: overlapTime,
+		Duration:        30,
+		Key:             "ABE",
+		ExpiresAt:       axpTime,
+	})
+	s.Suite.NoError(err)
+	s.Suite.NotNil(third)
+
+	for _, id := range []int64{first.Id, second.Id, third.Id} {
+		_, err = s.Repository.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+			Field:        "id",
+			Value:        strconv.Itoa(int(id)),
+			DeleteStatus: true,
+		})
+		s.Suite.NoError(err)
+	}
+
+	_, err = s.Patient.DeletePatient(ctx, &patients.FieldValueReq{
+		Field:        "id",
+		Value:        patient.Id,
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+}
+
+func (s *BookingAppointmentTestSite) TestOnlineAppointment() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patient := &patients.CreatedPatient{
+		Id:          uuid.New().String(),
+		FirstName:   "Husanboy",
+		LastName:    "Gofurov",
+		BirthDate:   date.Today(),
+		Gender:      "male",
+		PhoneNumber: "+998950230607",
+	}
+	patientRes, err := s.Patient.CreatePatient(ctx, patient)
+	s.Suite.NoError(err)
+	s.Suite.NotNil(patientRes)
+
+	appDate, _ := date.AutoParse("1221-12-14")
+	appTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 10:00:00")
+	axpTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 01:01:01")
+
+	createReq := &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		DoctorId:        uuid.New().String(),
+		PatientId:       patientRes.Id,
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+		Key:             "ONL",
+		ExpiresAt:       axpTime,
+		Modality:        booked_appointments.ModalityOnline,
+		DoctorServiceId: uuid.New().String(),
+		Price:           "150000.00",
+		Currency:        "UZS",
+	}
+	createRes, err := s.Repository.CreateAppointment(ctx, createReq)
+	s.Suite.NoError(err)
+	s.Suite.NotNil(createRes)
+	s.Suite.Equal(createReq.Modality, createRes.Modality)
+	s.Suite.Equal(createReq.DoctorServiceId, createRes.DoctorServiceId)
+	s.Suite.Equal(createReq.Price, createRes.Price)
+	s.Suite.Equal(createReq.Currency, createRes.Currency)
+	s.Suite.False(createRes.PatientStatus)
+	s.Suite.Empty(createRes.VideoRoomId)
+
+	confirmRes, err := s.Repository.ConfirmAppointment(ctx, &booked_appointments.ConfirmAppointment{
+		Id:            createRes.Id,
+		VideoRoomId:   "room-1",
+		VideoProvider: "selfhosted",
+	})
+	s.Suite.NoError(err)
+	s.Suite.NotNil(confirmRes)
+	s.Suite.True(confirmRes.PatientStatus)
+	s.Suite.Equal("room-1", confirmRes.VideoRoomId)
+	s.Suite.Equal("selfhosted", confirmRes.VideoProvider)
+	s.Suite.Equal(booked_appointments.StatusScheduled, confirmRes.Status)
+
+	attendedRes, err := s.Repository.SetAppointmentStatus(ctx, &booked_appointments.AppointmentStatusReq{
+		Id:     createRes.Id,
+		Status: booked_appointments.StatusAttended,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(booked_appointments.StatusAttended, attendedRes.Status)
+
+	_, err = s.Repository.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.Itoa(int(createRes.Id)),
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+
+	_, err = s.Patient.DeletePatient(ctx, &patients.FieldValueReq{
+		Field:        "id",
+		Value:        patient.Id,
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+}
+
 func (s *BookingAppointmentTestSite) TearDownSuite() {
 	s.CleanUpFunc()
 }
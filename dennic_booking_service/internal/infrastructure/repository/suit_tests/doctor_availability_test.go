@@ -36,6 +36,7 @@ func (s *DoctorAvailabilityTestSite) TestUserCRUD() {
 
 	createReq := &doctor_availability.CreateDoctorAvailability{
 		DepartmentId: uuid.New().String(),
+		BranchId:     uuid.New().String(),
 		DoctorId:     uuid.New().String(),
 		DoctorDate:   doctorDate,
 		StartTime:    startTime,
@@ -47,6 +48,7 @@ func (s *DoctorAvailabilityTestSite) TestUserCRUD() {
 	s.Suite.NoError(err)
 	s.Suite.NotNil(createRes)
 	s.Suite.Equal(createRes.DepartmentId, createReq.DepartmentId)
+	s.Suite.Equal(createRes.BranchId, createReq.BranchId)
 	s.Suite.Equal(createRes.DoctorId, createReq.DoctorId)
 	s.Suite.Equal(createRes.DoctorDate, createReq.DoctorDate)
 	s.Suite.Equal(createRes.StartTime, createReq.StartTime)
@@ -76,6 +78,16 @@ func (s *DoctorAvailabilityTestSite) TestUserCRUD() {
 	s.Suite.NoError(err)
 	s.Suite.NotNil(getAllRes)
 
+	branchRes, err := s.Repository.GetAllDoctorAvailability(ctx, &doctor_availability.GetAllReq{
+		Page:         1,
+		Limit:        5,
+		DeleteStatus: true,
+		BranchId:     createReq.BranchId,
+		DoctorDate:   createReq.DoctorDate.String(),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), branchRes.Count)
+
 	newDoctorDate, _ := date.AutoParse("1231-02-02")
 	newStartTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 14:14:14")
 	newEndTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 11:11:11")
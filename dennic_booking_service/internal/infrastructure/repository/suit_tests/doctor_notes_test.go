@@ -1,8 +1,7 @@
 package suit_tests
 
 import (
-	"booking_service/internal/entity/archive"
-	"booking_service/internal/entity/doctor_availability"
+	"booking_service/internal/entity/booked_appointments"
 	"booking_service/internal/entity/doctor_notes"
 	"booking_service/internal/entity/patients"
 	repo "booking_service/internal/infrastructure/repository/postgresql"
@@ -19,18 +18,16 @@ import (
 
 type DoctorNotesTestSite struct {
 	suite.Suite
-	Repository         *repo.DoctorNotes
-	DoctorAvailability *repo.DoctorAvailability
-	Archive            *repo.BookingArchive
-	Patient            *repo.BookingPatients
-	CleanUpFunc        func()
+	Repository  *repo.DoctorNotes
+	Appointment *repo.BookingAppointment
+	Patient     *repo.BookingPatients
+	CleanUpFunc func()
 }
 
 func (s *DoctorNotesTestSite) SetupSuite() {
 	pgPool, _ := db.New(config.New())
 	s.Repository = repo.NewDoctorNotes(pgPool)
-	s.DoctorAvailability = repo.NewDoctorAvailability(pgPool)
-	s.Archive = repo.NewBookingArchive(pgPool)
+	s.Appointment = repo.NewBookingAppointment(pgPool)
 	s.Patient = repo.NewBookingPatients(pgPool)
 	s.CleanUpFunc = pgPool.Close
 }
@@ -69,52 +66,25 @@ func (s *DoctorNotesTestSite) TestUserCRUD() {
 	s.Suite.Equal(createPatientRes.Address, patient.Address)
 	s.Suite.Equal(createPatientRes.PatientProblem, patient.PatientProblem)
 
-	doctorDate, _ := date.AutoParse("1231-02-02")
-	startTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 14:14:14")
-	endTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 11:11:11")
-
-	createReq := &doctor_availability.CreateDoctorAvailability{
-		DepartmentId: uuid.New().String(),
-		DoctorId:     uuid.New().String(),
-		DoctorDate:   doctorDate,
-		StartTime:    startTime,
-		EndTime:      endTime,
-		Status:       "available",
-	}
-
-	createRes, err := s.DoctorAvailability.CreateDoctorAvailability(ctx, createReq)
-	s.Suite.NoError(err)
-	s.Suite.NotNil(createRes)
-	s.Suite.Equal(createRes.DepartmentId, createReq.DepartmentId)
-	s.Suite.Equal(createRes.DoctorId, createReq.DoctorId)
-	s.Suite.Equal(createRes.DoctorDate, createReq.DoctorDate)
-	s.Suite.Equal(createRes.StartTime, createReq.StartTime)
-	s.Suite.Equal(createRes.EndTime, createReq.EndTime)
-	s.Suite.Equal(createRes.Status, createReq.Status)
-
-	createArchiveReq := &archive.CreatedArchive{
-		DoctorAvailabilityId: createRes.Id,
-		StartTime:            startTime,
-		EndTime:              endTime,
-		PatientProblem:       "No Problem",
-		Status:               "attended",
-		PaymentType:          "card",
-		PaymentAmount:        10,
-	}
-
-	createArchiveRes, err := s.Archive.CreateArchive(ctx, createArchiveReq)
+	appDate, _ := date.AutoParse("2024-05-06")
+	appTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 10:00:00")
+	appointment, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.New().String(),
+		DoctorId:        uuid.New().String(),
+		PatientId:       createPatientRes.Id,
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+		Key:             "ABC",
+		ExpiresAt:       appTime,
+		PatientStatus:   true,
+		Modality:        "offline",
+	})
 	s.Suite.NoError(err)
-	s.Suite.NotNil(createArchiveRes)
-	s.Suite.Equal(createArchiveRes.DoctorAvailabilityId, createArchiveReq.DoctorAvailabilityId)
-	s.Suite.Equal(createArchiveRes.StartTime, createArchiveReq.StartTime)
-	s.Suite.Equal(createArchiveRes.EndTime, createArchiveReq.EndTime)
-	s.Suite.Equal(createArchiveRes.PatientProblem, createArchiveReq.PatientProblem)
-	s.Suite.Equal(createArchiveRes.Status, createArchiveReq.Status)
-	s.Suite.Equal(createArchiveRes.PaymentType, createArchiveReq.PaymentType)
-	s.Suite.Equal(createArchiveRes.PaymentAmount, createArchiveReq.PaymentAmount)
+	s.Suite.NotNil(appointment)
 
 	createNoteReq := &doctor_notes.CreatedDoctorNote{
-		AppointmentId: createArchiveRes.Id,
+		AppointmentId: appointment.Id,
 		DoctorId:      uuid.New().String(),
 		PatientId:     createPatientRes.Id,
 		Prescription:  "Test Text",
@@ -153,7 +123,7 @@ func (s *DoctorNotesTestSite) TestUserCRUD() {
 	updateReq := &doctor_notes.UpdateDoctorNoteReq{
 		Field:         "id",
 		Value:         strconv.Itoa(int(getRes.Id)),
-		AppointmentId: createArchiveRes.Id,
+		AppointmentId: appointment.Id,
 		DoctorId:      uuid.New().String(),
 		PatientId:     createPatientRes.Id,
 		Prescription:  "Update Text",
@@ -188,32 +158,23 @@ func (s *DoctorNotesTestSite) TestUserCRUD() {
 	s.Suite.NotNil(hardDeleteNote)
 	s.Suite.Equal(hardDeleteNote.Status, true)
 
-	hardDeleteRes, err := s.Patient.DeletePatient(ctx, &patients.FieldValueReq{
+	hardDelAppointment, err := s.Appointment.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
 		Field:        "id",
-		Value:        createPatientRes.Id,
+		Value:        strconv.Itoa(int(appointment.Id)),
 		DeleteStatus: true,
 	})
 	s.Suite.NoError(err)
-	s.Suite.NotNil(hardDeleteRes)
-	s.Suite.Equal(hardDeleteRes.Status, true)
+	s.Suite.NotNil(hardDelAppointment)
+	s.Suite.Equal(hardDelAppointment.Status, true)
 
-	hardDeleteArchiveRes, err := s.Archive.DeleteArchive(ctx, &archive.FieldValueReq{
+	hardDeleteRes, err := s.Patient.DeletePatient(ctx, &patients.FieldValueReq{
 		Field:        "id",
-		Value:        strconv.Itoa(int(createArchiveRes.Id)),
-		DeleteStatus: true,
-	})
-	s.Suite.NoError(err)
-	s.Suite.NotNil(hardDeleteArchiveRes)
-	s.Suite.Equal(hardDeleteArchiveRes.Status, true)
-
-	hardDelRes, err := s.DoctorAvailability.DeleteDoctorAvailability(ctx, &doctor_availability.FieldValueReq{
-		Field:        "doctor_id",
-		Value:        createReq.DoctorId,
+		Value:        createPatientRes.Id,
 		DeleteStatus: true,
 	})
 	s.Suite.NoError(err)
-	s.Suite.NotNil(hardDelRes)
-	s.Suite.Equal(hardDelRes.Status, true)
+	s.Suite.NotNil(hardDeleteRes)
+	s.Suite.Equal(hardDeleteRes.Status, true)
 }
 
 func (s *DoctorNotesTestSite) TearDownSuite() {
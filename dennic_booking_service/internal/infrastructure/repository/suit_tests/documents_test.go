@@ -0,0 +1,99 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/documents"
+	"booking_service/internal/entity/patients"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type BookingDocumentsTestSite struct {
+	suite.Suite
+	Repository  *repo.BookingDocuments
+	Patients    *repo.BookingPatients
+	CleanUpFunc func()
+}
+
+func (s *BookingDocumentsTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewBookingDocuments(pgPool)
+	s.Patients = repo.NewBookingPatients(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *BookingDocumentsTestSite) TestDocumentCRUD() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patient, err := s.Patients.CreatePatient(ctx, &patients.CreatedPatient{
+		Id:          uuid.NewString(),
+		FirstName:   "Husan",
+		LastName:    "Gofurov",
+		BirthDate:   date.Today(),
+		Gender:      "male",
+		PhoneNumber: "+998950230605",
+	})
+	s.Suite.NoError(err)
+
+	// an appointment of someone else cannot be linked
+	_, err = s.Repository.CreateDocument(ctx, &documents.Document{
+		Id:            uuid.NewString(),
+		PatientId:     patient.Id,
+		AppointmentId: -1,
+		Type:          documents.TypeXray,
+		Title:         "Chest X-ray",
+		FileName:      "chest.png",
+		ContentType:   "image/png",
+		SizeBytes:     2048,
+		Bucket:        "patient-documents",
+		ObjectKey:     patient.Id + "/" + uuid.NewString() + ".png",
+	})
+	var errNotFound *entity.ErrNotFound
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	document, err := s.Repository.CreateDocument(ctx, &documents.Document{
+		Id:          uuid.NewString(),
+		PatientId:   patient.Id,
+		Type:        documents.TypeDischargeSummary,
+		Title:       "Discharge summary",
+		FileName:    "summary.pdf",
+		ContentType: "application/pdf",
+		SizeBytes:   4096,
+		Bucket:      "patient-documents",
+		ObjectKey:   patient.Id + "/" + uuid.NewString() + ".pdf",
+		UploadedBy:  uuid.NewString(),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(0), document.AppointmentId)
+
+	list, err := s.Repository.GetAllDocuments(ctx, &documents.GetAllDocuments{PatientId: patient.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), list.Count)
+	s.Suite.Equal(document.ObjectKey, list.Documents[0].ObjectKey)
+
+	deleted, err := s.Repository.DeleteDocument(ctx, &documents.FieldValueReq{Field: "id", Value: document.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(document.ObjectKey, deleted.ObjectKey)
+
+	_, err = s.Repository.GetDocument(ctx, &documents.FieldValueReq{Field: "id", Value: document.Id})
+	s.Suite.ErrorIs(err, entity.ErrorNotFound)
+
+	_, err = s.Patients.DeletePatient(ctx, &patients.FieldValueReq{Field: "id", Value: patient.Id})
+	s.Suite.NoError(err)
+}
+
+func (s *BookingDocumentsTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestBookingDocumentsTestSuite(t *testing.T) {
+	suite.Run(t, new(BookingDocumentsTestSite))
+}
@@ -0,0 +1,136 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/encounters"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type BookingEncountersTestSite struct {
+	suite.Suite
+	Repository  *repo.BookingEncounters
+	Appointment *repo.BookingAppointment
+	CleanUpFunc func()
+}
+
+func (s *BookingEncountersTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewBookingEncounters(pgPool)
+	s.Appointment = repo.NewBookingAppointment(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *BookingEncountersTestSite) TestEncounterTimeline() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patientId := uuid.NewString()
+	appTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 09:00:00")
+	var appointments []*booked_appointments.Appointment
+	for _, day := range []string{"2024-01-10", "2024-03-05"} {
+		appDate, _ := date.AutoParse(day)
+		appointment, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+			DepartmentId:    uuid.NewString(),
+			DoctorId:        uuid.NewString(),
+			PatientId:       patientId,
+			AppointmentDate: appDate,
+			AppointmentTime: appTime,
+			Duration:        30,
+			Key:             "ABC",
+			ExpiresAt:       appTime,
+			PatientStatus:   true,
+		})
+		s.Suite.NoError(err)
+		appointments = append(appointments, appointment)
+	}
+
+	first, err := s.Repository.CreateEncounter(ctx, &encounters.Encounter{
+		Id:             uuid.NewString(),
+		AppointmentId:  appointments[0].Id,
+		ChiefComplaint: "Cough for a week",
+		Vitals:         encounters.Vitals{TemperatureC: 37.8, PulseBpm: 88},
+		Diagnoses:      []*encounters.Diagnosis{{Icd10Code: "J06.9", Description: "Acute URI", Primary: true}},
+		Allergies:      []*encounters.Allergy{{Substance: "Penicillin", Reaction: "Rash", Severity: encounters.SeverityModerate}},
+		FollowUpPlan:   "Come back if fever persists",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(patientId, first.PatientId)
+	s.Suite.Equal(appointments[0].DoctorId, first.DoctorId)
+
+	_, err = s.Repository.CreateEncounter(ctx, &encounters.Encounter{
+		Id:             uuid.NewString(),
+		AppointmentId:  appointments[0].Id,
+		ChiefComplaint: "Duplicate",
+	})
+	s.Suite.ErrorIs(err, entity.ErrorConflict)
+
+	second, err := s.Repository.CreateEncounter(ctx, &encounters.Encounter{
+		Id:                uuid.NewString(),
+		AppointmentId:     appointments[1].Id,
+		ChiefComplaint:    "Thirst",
+		ChronicConditions: []*encounters.ChronicCondition{{Icd10Code: "E11.9", Description: "Type 2 diabetes"}},
+		Procedures:        []*encounters.Procedure{{Code: "82947", Description: "Glucose test"}},
+	})
+	s.Suite.NoError(err)
+
+	got, err := s.Repository.GetEncounter(ctx, &encounters.FieldValueReq{Field: "id", Value: first.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(37.8, got.Vitals.TemperatureC)
+	s.Suite.Len(got.Diagnoses, 1)
+	s.Suite.Equal("J06.9", got.Diagnoses[0].Icd10Code)
+	s.Suite.Len(got.Allergies, 1)
+
+	updated, err := s.Repository.UpdateEncounter(ctx, &encounters.Encounter{
+		Id:             first.Id,
+		ChiefComplaint: "Cough for a week",
+		Diagnoses:      []*encounters.Diagnosis{{Icd10Code: "J20.9", Primary: true}},
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(updated.Diagnoses, 1)
+
+	timeline, err := s.Repository.GetPatientTimeline(ctx, &encounters.TimelineReq{PatientId: patientId})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), timeline.Count)
+	s.Suite.Equal(second.Id, timeline.Encounters[0].Id)
+	s.Suite.Equal(first.Id, timeline.Encounters[1].Id)
+	s.Suite.Equal("J20.9", timeline.Encounters[1].Diagnoses[0].Icd10Code)
+	s.Suite.Empty(timeline.Encounters[1].Allergies)
+	s.Suite.Len(timeline.Encounters[0].ChronicConditions, 1)
+
+	from, _ := time.Parse("2006-01-02", "2024-02-01")
+	timeline, err = s.Repository.GetPatientTimeline(ctx, &encounters.TimelineReq{PatientId: patientId, From: from})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), timeline.Count)
+
+	for _, id := range []string{first.Id, second.Id} {
+		deleted, err := s.Repository.DeleteEncounter(ctx, &encounters.FieldValueReq{Field: "id", Value: id, DeleteStatus: true})
+		s.Suite.NoError(err)
+		s.Suite.True(deleted.Status)
+	}
+	for _, appointment := range appointments {
+		_, err = s.Appointment.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+			Field:        "id",
+			Value:        strconv.FormatInt(appointment.Id, 10),
+			DeleteStatus: true,
+		})
+		s.Suite.NoError(err)
+	}
+}
+
+func (s *BookingEncountersTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestBookingEncountersTestSuite(t *testing.T) {
+	suite.Run(t, new(BookingEncountersTestSite))
+}
@@ -0,0 +1,82 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/history"
+	"booking_service/internal/entity/patients"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type BookingHistoryTestSite struct {
+	suite.Suite
+	Repository  *repo.BookingHistory
+	Patients    *repo.BookingPatients
+	CleanUpFunc func()
+}
+
+func (s *BookingHistoryTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewBookingHistory(pgPool)
+	s.Patients = repo.NewBookingPatients(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *BookingHistoryTestSite) TestImportHistory() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patient := &patients.CreatedPatient{
+		Id:          uuid.NewString(),
+		FirstName:   "Husan",
+		LastName:    "Gofurov",
+		BirthDate:   date.New(1990, 5, 15),
+		Gender:      "male",
+		BloodGroup:  "A+",
+		PhoneNumber: "+998950230605",
+	}
+	visitAt := time.Date(2024, 5, 6, 10, 0, 0, 0, time.UTC)
+	records := []*history.ExternalRecord{
+		{Id: uuid.NewString(), Source: "Other clinic", ResourceType: "Condition", ExternalId: "c1",
+			Summary: "Acute bronchitis", Resource: []byte(`{"resourceType":"Condition","id":"c1"}`)},
+		{Id: uuid.NewString(), Source: "Other clinic", ResourceType: "Encounter", ExternalId: "e1", OccurredAt: visitAt,
+			Summary: "Cough", Resource: []byte(`{"resourceType":"Encounter","id":"e1"}`)},
+	}
+	s.Suite.NoError(s.Repository.ImportHistory(ctx, patient, records))
+
+	created, err := s.Patients.GetPatient(ctx, &patients.FieldValueReq{Field: "id", Value: patient.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal("A+", created.BloodGroup)
+
+	list, err := s.Repository.GetExternalRecords(ctx, patient.Id)
+	s.Suite.NoError(err)
+	s.Suite.Len(list, 2)
+	// dated records come first
+	s.Suite.Equal("e1", list[0].ExternalId)
+	s.Suite.True(list[0].OccurredAt.Equal(visitAt))
+	s.Suite.True(list[1].OccurredAt.IsZero())
+	s.Suite.JSONEq(`{"resourceType":"Condition","id":"c1"}`, string(list[1].Resource))
+
+	// the same patient cannot be imported twice
+	err = s.Repository.ImportHistory(ctx, patient, nil)
+	var errConflict *entity.ErrConflict
+	s.Suite.ErrorAs(err, &errConflict)
+
+	_, err = s.Patients.DeletePatient(ctx, &patients.FieldValueReq{Field: "id", Value: patient.Id, DeleteStatus: true})
+	s.Suite.NoError(err)
+}
+
+func (s *BookingHistoryTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestBookingHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(BookingHistoryTestSite))
+}
@@ -0,0 +1,170 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/billing"
+	"booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/insurance"
+	"booking_service/internal/entity/patients"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type BookingInsuranceTestSite struct {
+	suite.Suite
+	Repository  *repo.BookingInsurance
+	Appointment *repo.BookingAppointment
+	Patient     *repo.BookingPatients
+	CleanUpFunc func()
+}
+
+func (s *BookingInsuranceTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewBookingInsurance(pgPool)
+	s.Appointment = repo.NewBookingAppointment(pgPool)
+	s.Patient = repo.NewBookingPatients(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *BookingInsuranceTestSite) TestClaimWorkflow() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patient, err := s.Patient.CreatePatient(ctx, &patients.CreatedPatient{
+		Id:          uuid.NewString(),
+		FirstName:   "Husan",
+		LastName:    "Gofurov",
+		BirthDate:   date.Today(),
+		Gender:      "male",
+		PhoneNumber: "+998950230605",
+	})
+	s.Suite.NoError(err)
+
+	insurer, err := s.Repository.CreateInsurer(ctx, &insurance.Insurer{Id: uuid.NewString(), Name: "Kafolat", Code: uuid.NewString()[:8]})
+	s.Suite.NoError(err)
+
+	serviceDay := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+	policy, err := s.Repository.CreatePolicy(ctx, &insurance.Policy{
+		Id:           uuid.NewString(),
+		InsurerId:    insurer.Id,
+		PatientId:    patient.Id,
+		PolicyNumber: "KF-" + uuid.NewString()[:8],
+		ValidFrom:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ValidTo:      time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		AnnualLimit:  20000000,
+		Currency:     "UZS",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(insurance.PolicyActive, policy.Status)
+
+	serviceId := uuid.NewString()
+	_, err = s.Repository.SetCoverageRule(ctx, &insurance.CoverageRule{
+		Id: uuid.NewString(), InsurerId: insurer.Id, DoctorServiceId: serviceId, Kind: insurance.CoveragePercentage, Percentage: 50,
+	})
+	s.Suite.NoError(err)
+	rule, err := s.Repository.SetCoverageRule(ctx, &insurance.CoverageRule{
+		Id: uuid.NewString(), InsurerId: insurer.Id, DoctorServiceId: serviceId, Kind: insurance.CoveragePercentage, Percentage: 80,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(80), rule.Percentage)
+
+	eligibility, err := s.Repository.CheckEligibility(ctx, &insurance.EligibilityReq{
+		PatientId: patient.Id, DoctorServiceId: serviceId, Price: 15000000, Currency: "UZS", Date: serviceDay,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(eligibility.Eligible)
+	s.Suite.Equal(billing.Amount(12000000), eligibility.Covered)
+	s.Suite.Equal(billing.Amount(3000000), eligibility.Copay)
+
+	eligibility, err = s.Repository.CheckEligibility(ctx, &insurance.EligibilityReq{
+		PatientId: patient.Id, DoctorServiceId: uuid.NewString(), Price: 15000000, Currency: "UZS", Date: serviceDay,
+	})
+	s.Suite.NoError(err)
+	s.Suite.False(eligibility.Eligible)
+	s.Suite.NotEmpty(eligibility.Reason)
+
+	book := func() int64 {
+		appDate, _ := date.AutoParse("2024-05-06")
+		appTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 10:00:00")
+		appointment, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+			DepartmentId:    uuid.NewString(),
+			DoctorId:        uuid.NewString(),
+			PatientId:       patient.Id,
+			AppointmentDate: appDate,
+			AppointmentTime: appTime,
+			Duration:        30,
+			Key:             "ABC",
+			ExpiresAt:       appTime,
+			PatientStatus:   true,
+			Modality:        "offline",
+			DoctorServiceId: serviceId,
+			Price:           "150000.00",
+			Currency:        "UZS",
+		})
+		s.Suite.NoError(err)
+		return appointment.Id
+	}
+
+	first := book()
+	claim, err := s.Repository.CreateClaim(ctx, &insurance.CreateClaim{Id: uuid.NewString(), AppointmentId: first})
+	s.Suite.NoError(err)
+	s.Suite.Equal(billing.Amount(12000000), claim.Covered)
+	s.Suite.Equal(insurance.ClaimPending, claim.Status)
+
+	// one claim per appointment
+	_, err = s.Repository.CreateClaim(ctx, &insurance.CreateClaim{Id: uuid.NewString(), AppointmentId: first})
+	s.Suite.ErrorIs(err, entity.ErrorConflict)
+
+	// the annual limit leaves 80000.00 for the second visit
+	second, err := s.Repository.CreateClaim(ctx, &insurance.CreateClaim{Id: uuid.NewString(), AppointmentId: book()})
+	s.Suite.NoError(err)
+	s.Suite.Equal(billing.Amount(8000000), second.Covered)
+	s.Suite.Equal(billing.Amount(7000000), second.Copay)
+
+	var errPrecond *entity.ErrFailedPrecondition
+	_, err = s.Repository.CreateClaim(ctx, &insurance.CreateClaim{Id: uuid.NewString(), AppointmentId: book()})
+	s.Suite.ErrorAs(err, &errPrecond)
+
+	_, err = s.Repository.SetClaimStatus(ctx, &insurance.ClaimStatusReq{Id: claim.Id, Status: insurance.ClaimApproved})
+	s.Suite.ErrorAs(err, &errPrecond)
+
+	batch, claims, err := s.Repository.ExportClaims(ctx, &insurance.ExportClaims{
+		Id: uuid.NewString(), InsurerId: insurer.Id, PeriodFrom: serviceDay, PeriodTo: serviceDay, Format: insurance.FormatCSV, CreatedBy: uuid.NewString(),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), batch.ClaimCount)
+	s.Suite.Len(claims, 2)
+
+	_, _, err = s.Repository.ExportClaims(ctx, &insurance.ExportClaims{
+		Id: uuid.NewString(), InsurerId: insurer.Id, PeriodFrom: serviceDay, PeriodTo: serviceDay, Format: insurance.FormatCSV, CreatedBy: uuid.NewString(),
+	})
+	s.Suite.ErrorAs(err, &errPrecond)
+
+	claim, err = s.Repository.SetClaimStatus(ctx, &insurance.ClaimStatusReq{Id: claim.Id, Status: insurance.ClaimApproved, Approved: 10000000})
+	s.Suite.NoError(err)
+	s.Suite.Equal(billing.Amount(10000000), claim.Approved)
+	s.Suite.Equal(batch.Id, claim.BatchId)
+
+	claim, err = s.Repository.SetClaimStatus(ctx, &insurance.ClaimStatusReq{Id: claim.Id, Status: insurance.ClaimPaid})
+	s.Suite.NoError(err)
+	s.Suite.Equal(insurance.ClaimPaid, claim.Status)
+
+	list, err := s.Repository.GetAllClaims(ctx, &insurance.GetAllClaims{BatchId: batch.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), list.Count)
+}
+
+func (s *BookingInsuranceTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestBookingInsuranceTestSuite(t *testing.T) {
+	suite.Run(t, new(BookingInsuranceTestSite))
+}
@@ -0,0 +1,107 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/lab_orders"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type BookingLabOrdersTestSite struct {
+	suite.Suite
+	Repository  *repo.BookingLabOrders
+	Appointment *repo.BookingAppointment
+	CleanUpFunc func()
+}
+
+func (s *BookingLabOrdersTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewBookingLabOrders(pgPool)
+	s.Appointment = repo.NewBookingAppointment(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *BookingLabOrdersTestSite) TestLabOrderWorkflow() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	appDate, _ := date.AutoParse("2024-05-02")
+	appTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 11:00:00")
+	appointment, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.NewString(),
+		DoctorId:        uuid.NewString(),
+		PatientId:       uuid.NewString(),
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+		Key:             "ABC",
+		ExpiresAt:       appTime,
+		PatientStatus:   true,
+	})
+	s.Suite.NoError(err)
+
+	order, err := s.Repository.CreateLabOrder(ctx, &lab_orders.CreateLabOrder{
+		Id:            uuid.NewString(),
+		AppointmentId: appointment.Id,
+		Notes:         "Fasting",
+		Tests:         []*lab_orders.Test{{Code: "CBC", Name: "Complete blood count"}, {Code: "GLU", Name: "Glucose"}},
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(lab_orders.StatusOrdered, order.Status)
+	s.Suite.Equal(appointment.PatientId, order.PatientId)
+
+	// nothing to release before the lab reports
+	_, err = s.Repository.SetLabOrderStatus(ctx, &lab_orders.StatusChange{Id: order.Id, Status: lab_orders.StatusReleased, From: []string{lab_orders.StatusResulted}})
+	var errPrecond *entity.ErrFailedPrecondition
+	s.Suite.ErrorAs(err, &errPrecond)
+
+	low, high := 3.9, 5.5
+	resulted, err := s.Repository.SubmitLabResults(ctx, &lab_orders.SubmitResults{
+		Id: order.Id,
+		Results: []*lab_orders.Result{{
+			TestCode:       "GLU",
+			Analyte:        "Glucose",
+			Value:          7.2,
+			Unit:           "mmol/L",
+			ReferenceRange: "3.9-5.5",
+			ReferenceLow:   &low,
+			ReferenceHigh:  &high,
+			Flag:           lab_orders.FlagHigh,
+		}},
+		Attachments: []*lab_orders.Attachment{{FileName: "report.pdf", Url: "http://dennic.uz:9000/lab/report.pdf"}},
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(lab_orders.StatusResulted, resulted.Status)
+	s.Suite.Len(resulted.Tests, 2)
+	s.Suite.Len(resulted.Results, 1)
+	s.Suite.Equal(high, *resulted.Results[0].ReferenceHigh)
+	s.Suite.Len(resulted.Attachments, 1)
+
+	released, err := s.Repository.SetLabOrderStatus(ctx, &lab_orders.StatusChange{Id: order.Id, Status: lab_orders.StatusReleased, From: []string{lab_orders.StatusResulted}})
+	s.Suite.NoError(err)
+	s.Suite.False(released.ReleasedAt.IsZero())
+
+	_, err = s.Repository.SubmitLabResults(ctx, &lab_orders.SubmitResults{Id: order.Id})
+	s.Suite.ErrorAs(err, &errPrecond)
+
+	list, err := s.Repository.GetAllLabOrders(ctx, &lab_orders.GetAllLabOrders{PatientId: appointment.PatientId, Status: lab_orders.StatusReleased})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), list.Count)
+	s.Suite.Equal(lab_orders.FlagHigh, list.LabOrders[0].Results[0].Flag)
+}
+
+func (s *BookingLabOrdersTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestBookingLabOrdersTestSuite(t *testing.T) {
+	suite.Run(t, new(BookingLabOrdersTestSite))
+}
@@ -0,0 +1,184 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/messages"
+	"booking_service/internal/entity/patients"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type BookingMessagesTestSite struct {
+	suite.Suite
+	Repository  *repo.BookingMessages
+	Patients    *repo.BookingPatients
+	Appointment *repo.BookingAppointment
+	CleanUpFunc func()
+}
+
+func (s *BookingMessagesTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewBookingMessages(pgPool)
+	s.Patients = repo.NewBookingPatients(pgPool)
+	s.Appointment = repo.NewBookingAppointment(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *BookingMessagesTestSite) TestConversation() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patient, err := s.Patients.CreatePatient(ctx, &patients.CreatedPatient{
+		Id:          uuid.NewString(),
+		FirstName:   "Husan",
+		LastName:    "Gofurov",
+		BirthDate:   date.Today(),
+		Gender:      "male",
+		PhoneNumber: "+998950230605",
+	})
+	s.Suite.NoError(err)
+
+	appDate, _ := date.AutoParse("2024-05-02")
+	appTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 11:00:00")
+	doctorId := uuid.NewString()
+	appointment, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.NewString(),
+		DoctorId:        doctorId,
+		PatientId:       patient.Id,
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+		Key:             "ABC",
+		ExpiresAt:       appTime,
+		PatientStatus:   true,
+	})
+	s.Suite.NoError(err)
+
+	// a doctor the patient never saw cannot be messaged
+	_, err = s.Repository.StartConversation(ctx, &messages.StartConversation{
+		Id:         uuid.NewString(),
+		PatientId:  patient.Id,
+		DoctorId:   uuid.NewString(),
+		ReaderRole: messages.RolePatient,
+	})
+	var errNotFound *entity.ErrNotFound
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	conversation, err := s.Repository.StartConversation(ctx, &messages.StartConversation{
+		Id:            uuid.NewString(),
+		PatientId:     patient.Id,
+		DoctorId:      doctorId,
+		AppointmentId: appointment.Id,
+		ReaderRole:    messages.RolePatient,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(appointment.Id, conversation.AppointmentId)
+
+	// starting it again finds the same thread
+	again, err := s.Repository.StartConversation(ctx, &messages.StartConversation{
+		Id:            uuid.NewString(),
+		PatientId:     patient.Id,
+		DoctorId:      doctorId,
+		AppointmentId: appointment.Id,
+		ReaderRole:    messages.RoleDoctor,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(conversation.Id, again.Id)
+
+	text, err := s.Repository.CreateMessage(ctx, &messages.Message{
+		Id:             uuid.NewString(),
+		ConversationId: conversation.Id,
+		SenderId:       uuid.NewString(),
+		SenderRole:     messages.RolePatient,
+		Body:           "The rash is gone, should I keep the cream?",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Nil(text.Attachment)
+
+	photo, err := s.Repository.CreateMessage(ctx, &messages.Message{
+		Id:             uuid.NewString(),
+		ConversationId: conversation.Id,
+		SenderId:       uuid.NewString(),
+		SenderRole:     messages.RolePatient,
+		Attachment: &messages.Attachment{
+			FileName:    "arm.jpg",
+			ContentType: "image/jpeg",
+			SizeBytes:   2048,
+			Bucket:      "patient-messages",
+			ObjectKey:   conversation.Id + "/" + uuid.NewString() + ".jpg",
+		},
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal("arm.jpg", photo.Attachment.FileName)
+
+	doctorView, err := s.Repository.GetConversation(ctx, conversation.Id, messages.RoleDoctor)
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), doctorView.Unread)
+
+	list, err := s.Repository.GetAllConversations(ctx, &messages.GetAllConversations{
+		DoctorId:   doctorId,
+		ReaderRole: messages.RolePatient,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), list.Count)
+	s.Suite.Equal(int64(0), list.Conversations[0].Unread)
+
+	read, err := s.Repository.MarkRead(ctx, conversation.Id, messages.RoleDoctor, time.Now())
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), read)
+
+	thread, err := s.Repository.GetAllMessages(ctx, &messages.GetAllMessages{ConversationId: conversation.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), thread.Count)
+	s.Suite.False(thread.Messages[0].ReadAt.IsZero())
+
+	hours, err := s.Repository.SetReplyHours(ctx, &messages.ReplyHours{
+		DoctorId: doctorId,
+		Windows: []*messages.ReplyWindow{
+			{Weekday: time.Monday, StartsAt: 9 * 60, EndsAt: 12 * 60},
+			{Weekday: time.Friday, StartsAt: 14 * 60, EndsAt: 17*60 + 30},
+		},
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(hours.Windows, 2)
+	s.Suite.Equal(messages.Clock(17*60+30), hours.Windows[1].EndsAt)
+
+	hours, err = s.Repository.SetReplyHours(ctx, &messages.ReplyHours{DoctorId: doctorId})
+	s.Suite.NoError(err)
+	s.Suite.Empty(hours.Windows)
+
+	// past the retention period the thread is gone
+	_, err = s.Repository.PurgeMessages(ctx, time.Now().Add(time.Hour))
+	s.Suite.NoError(err)
+	_, err = s.Repository.GetMessage(ctx, text.Id)
+	s.Suite.ErrorIs(err, entity.ErrorNotFound)
+	_, err = s.Repository.GetConversation(ctx, conversation.Id, messages.RolePatient)
+	s.Suite.ErrorIs(err, entity.ErrorNotFound)
+
+	_, err = s.Appointment.DeleteAppointment(ctx, &booked_appointments.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.Itoa(int(appointment.Id)),
+		DeleteStatus: true,
+	})
+	s.Suite.NoError(err)
+
+	_, err = s.Patients.DeletePatient(ctx, &patients.FieldValueReq{Field: "id", Value: patient.Id})
+	s.Suite.NoError(err)
+}
+
+func (s *BookingMessagesTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestBookingMessagesTestSuite(t *testing.T) {
+	suite.Run(t, new(BookingMessagesTestSite))
+}
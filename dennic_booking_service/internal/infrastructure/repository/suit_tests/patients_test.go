@@ -1,6 +1,7 @@
 package suit_tests
 
 import (
+	"booking_service/internal/entity"
 	"booking_service/internal/entity/patients"
 	repo "booking_service/internal/infrastructure/repository/postgresql"
 	"booking_service/internal/pkg/config"
@@ -144,6 +145,82 @@ func (s *BookingPatientsTestSite) TestUserCRUD() {
 	s.Suite.Equal(hardDeleteRes.Status, true)
 }
 
+func (s *BookingPatientsTestSite) TestAccountProfiles() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	userId := uuid.NewString()
+	birthDate, _ := date.AutoParse("1990-05-15")
+	profile := func(relationship string, consent bool) *patients.CreatedPatient {
+		return &patients.CreatedPatient{
+			Id:             uuid.NewString(),
+			FirstName:      "Husan",
+			LastName:       "Gofurov",
+			BirthDate:      birthDate,
+			Gender:         "male",
+			BloodGroup:     "A+",
+			PhoneNumber:    "+998950230605",
+			City:           "Andijon",
+			Country:        "Uzbekistan",
+			Address:        "Shahrixon",
+			PatientProblem: "Now Problem",
+			UserId:         userId,
+			Relationship:   relationship,
+			Consent:        consent,
+		}
+	}
+
+	self, err := s.Repository.CreatePatient(ctx, profile(patients.RelationshipSelf, false))
+	s.Suite.NoError(err)
+	s.Suite.Equal(userId, self.UserId)
+	s.Suite.Equal(patients.RelationshipSelf, self.Relationship)
+	s.Suite.True(self.ConsentAt.IsZero())
+
+	child, err := s.Repository.CreatePatient(ctx, profile(patients.RelationshipChild, true))
+	s.Suite.NoError(err)
+	s.Suite.Equal(patients.RelationshipChild, child.Relationship)
+	s.Suite.True(child.Consent)
+	s.Suite.False(child.ConsentAt.IsZero())
+
+	_, err = s.Repository.CreatePatient(ctx, profile(patients.RelationshipSelf, false))
+	s.Suite.ErrorIs(err, entity.ErrorConflict)
+
+	owned, err := s.Repository.GetAllPatiens(ctx, &patients.GetAllPatients{UserId: userId})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), owned.Count)
+	s.Suite.Len(owned.Patients, 2)
+
+	_, err = s.Repository.GetPatient(ctx, &patients.FieldValueReq{
+		Field:  "id",
+		Value:  child.Id,
+		UserId: uuid.NewString(),
+	})
+	s.Suite.ErrorIs(err, entity.ErrorNotFound)
+
+	_, err = s.Repository.UpdatePatient(ctx, &patients.UpdatePatient{
+		Field:      "id",
+		Value:      child.Id,
+		UserId:     uuid.NewString(),
+		FirstName:  "Sherzod",
+		LastName:   "Erkinov",
+		BirthDate:  birthDate,
+		Gender:     "male",
+		BloodGroup: "A+",
+	})
+	s.Suite.ErrorIs(err, entity.ErrorNotFound)
+
+	for _, id := range []string{self.Id, child.Id} {
+		deleted, err := s.Repository.DeletePatient(ctx, &patients.FieldValueReq{
+			Field:        "id",
+			Value:        id,
+			DeleteStatus: true,
+			UserId:       userId,
+		})
+		s.Suite.NoError(err)
+		s.Suite.True(deleted.Status)
+	}
+}
+
 func (s *BookingPatientsTestSite) TearDownSuite() {
 	s.CleanUpFunc()
 }
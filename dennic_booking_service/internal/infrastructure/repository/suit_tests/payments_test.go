@@ -0,0 +1,102 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/billing"
+	"booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/payments"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type BookingPaymentsTestSite struct {
+	suite.Suite
+	Repository  *repo.BookingPayments
+	Appointment *repo.BookingAppointment
+	CleanUpFunc func()
+}
+
+func (s *BookingPaymentsTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewBookingPayments(pgPool)
+	s.Appointment = repo.NewBookingAppointment(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *BookingPaymentsTestSite) TestPaymentIntent() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	patientId := uuid.NewString()
+	appDate, _ := date.AutoParse("2024-05-03")
+	appTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 12:00:00")
+	appointment, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.NewString(),
+		DoctorId:        uuid.NewString(),
+		PatientId:       patientId,
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+		Key:             "ABC",
+		ExpiresAt:       time.Now().Add(time.Hour),
+		Modality:        "offline",
+		DoctorServiceId: uuid.NewString(),
+		Price:           "150000.00",
+		Currency:        "UZS",
+	})
+	s.Suite.NoError(err)
+
+	req := &payments.CreateIntent{
+		Id:             uuid.NewString(),
+		AppointmentId:  appointment.Id,
+		PatientId:      patientId,
+		IdempotencyKey: uuid.NewString(),
+		Provider:       "fake",
+		ExpiresAt:      time.Now().Add(time.Minute),
+	}
+	intent, err := s.Repository.CreateIntent(ctx, req)
+	s.Suite.NoError(err)
+	s.Suite.Equal(billing.Amount(15000000), intent.Amount)
+	s.Suite.Equal(payments.StatusPending, intent.Status)
+
+	// the same key returns the same intent, another key is refused while it is pending
+	again, err := s.Repository.CreateIntent(ctx, &payments.CreateIntent{Id: uuid.NewString(), AppointmentId: appointment.Id, PatientId: patientId, IdempotencyKey: req.IdempotencyKey, Provider: "fake"})
+	s.Suite.NoError(err)
+	s.Suite.Equal(intent.Id, again.Id)
+	_, err = s.Repository.CreateIntent(ctx, &payments.CreateIntent{Id: uuid.NewString(), AppointmentId: appointment.Id, PatientId: patientId, IdempotencyKey: uuid.NewString(), Provider: "fake"})
+	var errConflict *entity.ErrConflict
+	s.Suite.ErrorAs(err, &errConflict)
+
+	intent, err = s.Repository.SetCheckout(ctx, intent.Id, &payments.Checkout{ProviderRef: "fake_" + intent.Id, CheckoutURL: "https://pay.test/" + intent.Id})
+	s.Suite.NoError(err)
+	kept, err := s.Repository.SetCheckout(ctx, intent.Id, &payments.Checkout{ProviderRef: "fake_other", CheckoutURL: "https://pay.test/other"})
+	s.Suite.NoError(err)
+	s.Suite.Equal(intent.ProviderRef, kept.ProviderRef)
+
+	intent, changed, err := s.Repository.FinishIntent(ctx, &payments.Outcome{Id: intent.Id, Status: payments.StatusFailed, FailureReason: "declined"}, payments.StatusPending)
+	s.Suite.NoError(err)
+	s.Suite.True(changed)
+	s.Suite.Equal(payments.StatusFailed, intent.Status)
+	_, changed, err = s.Repository.FinishIntent(ctx, &payments.Outcome{Id: intent.Id, Status: payments.StatusSucceeded}, payments.StatusPending)
+	s.Suite.NoError(err)
+	s.Suite.False(changed)
+
+	found, err := s.Repository.GetIntent(ctx, &payments.FieldValueReq{Field: "provider_ref", Value: intent.ProviderRef})
+	s.Suite.NoError(err)
+	s.Suite.Equal("declined", found.FailureReason)
+}
+
+func (s *BookingPaymentsTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestBookingPaymentsTestSuite(t *testing.T) {
+	suite.Run(t, new(BookingPaymentsTestSite))
+}
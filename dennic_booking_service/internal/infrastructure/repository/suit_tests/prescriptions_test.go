@@ -0,0 +1,157 @@
+package suit_tests
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/encounters"
+	"booking_service/internal/entity/prescriptions"
+	repo "booking_service/internal/infrastructure/repository/postgresql"
+	"booking_service/internal/pkg/config"
+	db "booking_service/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/rickb777/date"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type BookingPrescriptionsTestSite struct {
+	suite.Suite
+	Repository  *repo.BookingPrescriptions
+	Encounters  *repo.BookingEncounters
+	Appointment *repo.BookingAppointment
+	CleanUpFunc func()
+}
+
+func (s *BookingPrescriptionsTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewBookingPrescriptions(pgPool)
+	s.Encounters = repo.NewBookingEncounters(pgPool)
+	s.Appointment = repo.NewBookingAppointment(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *BookingPrescriptionsTestSite) TestPrescriptionLifecycle() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	appDate, _ := date.AutoParse("2024-04-01")
+	appTime, _ := time.Parse("2006-01-02 15:04:05", "2000-01-01 10:00:00")
+	appointment, err := s.Appointment.CreateAppointment(ctx, &booked_appointments.CreateAppointment{
+		DepartmentId:    uuid.NewString(),
+		DoctorId:        uuid.NewString(),
+		PatientId:       uuid.NewString(),
+		AppointmentDate: appDate,
+		AppointmentTime: appTime,
+		Duration:        30,
+		Key:             "ABC",
+		ExpiresAt:       appTime,
+		PatientStatus:   true,
+	})
+	s.Suite.NoError(err)
+
+	parties, err := s.Repository.GetAppointmentParties(ctx, appointment.Id)
+	s.Suite.NoError(err)
+	s.Suite.Equal(appointment.PatientId, parties.PatientId)
+	s.Suite.Equal(appointment.DoctorId, parties.DoctorId)
+
+	encounter, err := s.Encounters.CreateEncounter(ctx, &encounters.Encounter{
+		Id:             uuid.NewString(),
+		AppointmentId:  appointment.Id,
+		ChiefComplaint: "Sore throat",
+		Allergies:      []*encounters.Allergy{{Substance: "Penicillin", Reaction: "Rash", Severity: encounters.SeveritySevere}},
+	})
+	s.Suite.NoError(err)
+
+	allergies, err := s.Repository.GetPatientAllergies(ctx, parties.PatientId)
+	s.Suite.NoError(err)
+	s.Suite.Len(allergies, 1)
+
+	medication, err := s.Repository.CreateMedication(ctx, &prescriptions.Medication{
+		Id:               uuid.NewString(),
+		Name:             "Test Ibuprofen " + uuid.NewString()[:8],
+		Form:             "tablet",
+		Strength:         "400 mg",
+		ActiveIngredient: "ibuprofen",
+	})
+	s.Suite.NoError(err)
+
+	interaction, err := s.Repository.CreateInteraction(ctx, &prescriptions.Interaction{
+		IngredientA: "Warfarin",
+		IngredientB: "Ibuprofen",
+		Severity:    encounters.SeveritySevere,
+		Description: "Bleeding risk",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal("ibuprofen", interaction.IngredientA)
+
+	interactions, err := s.Repository.GetInteractions(ctx, []string{"ibuprofen", "warfarin"})
+	s.Suite.NoError(err)
+	s.Suite.Len(interactions, 1)
+
+	issuedAt := time.Now().UTC().Truncate(time.Second)
+	created, err := s.Repository.CreatePrescription(ctx, &prescriptions.Prescription{
+		Id:            uuid.NewString(),
+		AppointmentId: appointment.Id,
+		PatientId:     parties.PatientId,
+		DoctorId:      parties.DoctorId,
+		Items: []*prescriptions.Item{{
+			MedicationId:     medication.Id,
+			MedicationName:   medication.Name,
+			Form:             medication.Form,
+			Strength:         medication.Strength,
+			ActiveIngredient: medication.ActiveIngredient,
+			Dose:             "1 tablet",
+			Frequency:        "3 times a day",
+			DurationDays:     5,
+			Route:            prescriptions.RouteOral,
+		}},
+		Warnings:         []*prescriptions.Warning{{Kind: prescriptions.WarningInteraction, Severity: encounters.SeveritySevere}},
+		VerificationCode: uuid.NewString()[:10],
+		Signature:        "signature",
+		IssuedAt:         issuedAt,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(issuedAt.Equal(created.IssuedAt))
+	s.Suite.Len(created.Items, 1)
+	s.Suite.Len(created.Warnings, 1)
+
+	active, err := s.Repository.GetActiveIngredients(ctx, parties.PatientId, issuedAt)
+	s.Suite.NoError(err)
+	s.Suite.Equal([]string{"ibuprofen"}, active)
+	active, err = s.Repository.GetActiveIngredients(ctx, parties.PatientId, issuedAt.AddDate(0, 0, 6))
+	s.Suite.NoError(err)
+	s.Suite.Empty(active)
+
+	byCode, err := s.Repository.GetPrescription(ctx, &prescriptions.FieldValueReq{Field: "verification_code", Value: created.VerificationCode})
+	s.Suite.NoError(err)
+	s.Suite.Equal(created.Id, byCode.Id)
+
+	list, err := s.Repository.GetAllPrescriptions(ctx, &prescriptions.GetAllPrescriptions{PatientId: parties.PatientId})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), list.Count)
+
+	revoked, err := s.Repository.RevokePrescription(ctx, &prescriptions.FieldValueReq{Field: "id", Value: created.Id})
+	s.Suite.NoError(err)
+	s.Suite.False(revoked.RevokedAt.IsZero())
+
+	_, err = s.Repository.DeleteMedication(ctx, &prescriptions.FieldValueReq{Field: "id", Value: medication.Id, DeleteStatus: true})
+	var errPrecond *entity.ErrFailedPrecondition
+	s.Suite.ErrorAs(err, &errPrecond)
+
+	// clean up
+	_, err = s.Repository.DeleteMedication(ctx, &prescriptions.FieldValueReq{Field: "id", Value: medication.Id})
+	s.Suite.NoError(err)
+	_, err = s.Encounters.DeleteEncounter(ctx, &encounters.FieldValueReq{Field: "id", Value: encounter.Id, DeleteStatus: true})
+	s.Suite.NoError(err)
+	// issued prescriptions are kept, and so is the appointment they reference
+}
+
+func (s *BookingPrescriptionsTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestBookingPrescriptionsTestSuite(t *testing.T) {
+	suite.Run(t, new(BookingPrescriptionsTestSite))
+}
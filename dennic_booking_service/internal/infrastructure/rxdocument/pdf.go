@@ -0,0 +1,85 @@
+package rxdocument
+
+import (
+	"booking_service/internal/entity/prescriptions"
+	"bytes"
+	"fmt"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/skip2/go-qrcode"
+)
+
+// Render lays the prescription out on an A5 page. The core PDF fonts only
+// cover Latin-1, other characters are replaced.
+func (d *Documents) Render(prescription *prescriptions.Prescription, doctorName string) (*prescriptions.Document, error) {
+	qr, err := qrcode.Encode(d.VerifyURL(prescription.VerificationCode), qrcode.Medium, 256)
+	if err != nil {
+		return nil, err
+	}
+
+	pdf := gofpdf.New("P", "mm", "A5", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.SetTitle("Prescription "+prescription.VerificationCode, true)
+	pdf.SetCreator("Dennic", true)
+	pdf.SetAutoPageBreak(true, 40)
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, "Prescription", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.CellFormat(0, 5, "Issued: "+prescription.IssuedAt.Format("2006-01-02 15:04"), "", 1, "L", false, 0, "")
+	if !prescription.RevokedAt.IsZero() {
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.CellFormat(0, 5, "REVOKED: "+prescription.RevokedAt.Format("2006-01-02 15:04"), "", 1, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 9)
+	}
+	pdf.Ln(2)
+
+	patient := prescription.PatientName
+	if !prescription.PatientBirthDate.IsZero() {
+		patient += ", born " + prescription.PatientBirthDate.Format("2006-01-02")
+	}
+	if doctorName == "" {
+		doctorName = prescription.DoctorId
+	}
+	pdf.CellFormat(0, 5, tr("Patient: "+patient), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 5, tr("Doctor: "+doctorName), "", 1, "L", false, 0, "")
+	pdf.Ln(3)
+
+	for i, item := range prescription.Items {
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.MultiCell(0, 5, tr(fmt.Sprintf("%d. %s %s, %s", i+1, item.MedicationName, item.Strength, item.Form)), "", "L", false)
+		pdf.SetFont("Helvetica", "", 9)
+		pdf.MultiCell(0, 5, tr(fmt.Sprintf("%s, %s, %s, for %d days", item.Dose, item.Frequency, item.Route, item.DurationDays)), "", "L", false)
+		if item.Instructions != "" {
+			pdf.MultiCell(0, 5, tr(item.Instructions), "", "L", false)
+		}
+		pdf.Ln(2)
+	}
+
+	// verification block at the bottom of the page
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.RegisterImageOptionsReader("qr", gofpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(qr))
+	pdf.ImageOptions("qr", 10, 170, 30, 30, false, gofpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+	pdf.SetXY(44, 175)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(0, 5, "Verification code: "+prescription.VerificationCode, "", 2, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 7)
+	pdf.CellFormat(0, 4, "Check at "+d.VerifyURL(prescription.VerificationCode), "", 2, "L", false, 0, "")
+	signature := prescription.Signature
+	if len(signature) > 32 {
+		pdf.CellFormat(0, 4, "Signature: "+signature[:32], "", 2, "L", false, 0, "")
+		signature = signature[32:]
+	}
+	pdf.CellFormat(0, 4, signature, "", 2, "L", false, 0, "")
+
+	var buf bytes.Buffer
+	if err = pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+
+	return &prescriptions.Document{
+		FileName: "prescription-" + prescription.VerificationCode + ".pdf",
+		Content:  buf.Bytes(),
+	}, nil
+}
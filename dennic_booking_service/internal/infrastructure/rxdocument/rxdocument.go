@@ -0,0 +1,98 @@
+// Package rxdocument signs issued prescriptions and renders them for print.
+// A printed prescription carries its verification code and a QR code that
+// points pharmacies to the public verification endpoint.
+package rxdocument
+
+import (
+	"booking_service/internal/entity/prescriptions"
+	"booking_service/internal/pkg/config"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// codeAlphabet leaves out characters that are easy to misread on paper.
+const (
+	codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	codeLength   = 10
+)
+
+// Documents -.
+type Documents struct {
+	secret    []byte
+	verifyURL string
+}
+
+// New refuses to sign without PRESCRIPTION_SIGNING_SECRET: a known key would
+// let anyone forge a prescription pharmacies accept.
+func New(cfg *config.Config) (*Documents, error) {
+	if cfg.Prescription.SigningSecret == "" {
+		return nil, errors.New("PRESCRIPTION_SIGNING_SECRET is not set")
+	}
+	return &Documents{
+		secret:    []byte(cfg.Prescription.SigningSecret),
+		verifyURL: cfg.Prescription.VerifyURL,
+	}, nil
+}
+
+// NewCode returns a random verification code.
+func (d *Documents) NewCode() (string, error) {
+	var code strings.Builder
+	max := big.NewInt(int64(len(codeAlphabet)))
+	for i := 0; i < codeLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code.WriteByte(codeAlphabet[n.Int64()])
+	}
+	return code.String(), nil
+}
+
+// Sign returns the signature of everything a pharmacy dispenses by.
+func (d *Documents) Sign(prescription *prescriptions.Prescription) string {
+	mac := hmac.New(sha256.New, d.secret)
+	mac.Write([]byte(payload(prescription)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether the stored prescription still matches its signature.
+func (d *Documents) Verify(prescription *prescriptions.Prescription) bool {
+	return hmac.Equal([]byte(prescription.Signature), []byte(d.Sign(prescription)))
+}
+
+// VerifyURL is the address encoded in the QR code.
+func (d *Documents) VerifyURL(code string) string {
+	return d.verifyURL + "?code=" + url.QueryEscape(code)
+}
+
+func payload(prescription *prescriptions.Prescription) string {
+	lines := []string{
+		prescription.Id,
+		strconv.FormatInt(prescription.AppointmentId, 10),
+		prescription.PatientId,
+		prescription.DoctorId,
+		strconv.FormatInt(prescription.IssuedAt.Unix(), 10),
+		prescription.VerificationCode,
+	}
+	for _, item := range prescription.Items {
+		lines = append(lines, strings.Join([]string{
+			item.MedicationId,
+			item.MedicationName,
+			item.Form,
+			item.Strength,
+			item.Dose,
+			item.Frequency,
+			strconv.Itoa(int(item.DurationDays)),
+			item.Route,
+			item.Instructions,
+		}, "|"))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,101 @@
+package rxdocument
+
+import (
+	"booking_service/internal/entity/prescriptions"
+	"booking_service/internal/pkg/config"
+	"bytes"
+	"testing"
+	"time"
+)
+
+func testPrescription(t *testing.T, d *Documents) *prescriptions.Prescription {
+	code, err := d.NewCode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prescription := &prescriptions.Prescription{
+		Id:               "9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d",
+		AppointmentId:    42,
+		PatientId:        "1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed",
+		PatientName:      "Husan Gofurov",
+		DoctorId:         "6ec0bd7f-11c0-43da-975e-2a8ad9ebae0b",
+		VerificationCode: code,
+		IssuedAt:         time.Date(2024, 3, 5, 9, 30, 0, 0, time.UTC),
+		Items: []*prescriptions.Item{{
+			MedicationId:   "a1",
+			MedicationName: "Amoxicillin",
+			Form:           "capsule",
+			Strength:       "500 mg",
+			Dose:           "1 capsule",
+			Frequency:      "3 times a day",
+			DurationDays:   7,
+			Route:          prescriptions.RouteOral,
+			Instructions:   "After meals",
+		}},
+	}
+	prescription.Signature = d.Sign(prescription)
+	return prescription
+}
+
+// testDocuments signs with a test key; New refuses to start without one.
+func testDocuments(t *testing.T, cfg *config.Config) *Documents {
+	d, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func testConfig() *config.Config {
+	cfg := config.New()
+	cfg.Prescription.SigningSecret = "test secret"
+	return cfg
+}
+
+func TestNewWithoutSecret(t *testing.T) {
+	cfg := config.New()
+	cfg.Prescription.SigningSecret = ""
+	if _, err := New(cfg); err == nil {
+		t.Fatal("documents created without a signing secret")
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	cfg := testConfig()
+	d := testDocuments(t, cfg)
+	prescription := testPrescription(t, d)
+
+	if len(prescription.VerificationCode) != codeLength {
+		t.Fatalf("code %q has wrong length", prescription.VerificationCode)
+	}
+	if !d.Verify(prescription) {
+		t.Fatal("genuine prescription rejected")
+	}
+
+	prescription.Items[0].Dose = "2 capsules"
+	if d.Verify(prescription) {
+		t.Fatal("altered dose accepted")
+	}
+
+	cfg.Prescription.SigningSecret = "another secret"
+	prescription.Items[0].Dose = "1 capsule"
+	if testDocuments(t, cfg).Verify(prescription) {
+		t.Fatal("signature from another key accepted")
+	}
+}
+
+func TestRender(t *testing.T) {
+	d := testDocuments(t, testConfig())
+	prescription := testPrescription(t, d)
+
+	document, err := d.Render(prescription, "Dr. Karimova")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(document.Content, []byte("%PDF-")) {
+		t.Fatal("output is not a PDF")
+	}
+	if document.FileName != "prescription-"+prescription.VerificationCode+".pdf" {
+		t.Fatalf("unexpected file name %q", document.FileName)
+	}
+}
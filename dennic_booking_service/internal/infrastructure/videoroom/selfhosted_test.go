@@ -0,0 +1,39 @@
+package videoroom
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelfHostedJoinURL(t *testing.T) {
+	provider := NewSelfHosted("https://meet.dennic.uz/", "secret")
+	ctx := context.Background()
+
+	roomId, err := provider.CreateRoom(ctx, 1, time.Now(), time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, roomId)
+
+	expiresAt := time.Now().Add(time.Hour)
+	link, err := provider.JoinURL(ctx, roomId, "patient-1", expiresAt)
+	assert.NoError(t, err)
+
+	parsed, err := url.Parse(link)
+	assert.NoError(t, err)
+	assert.Equal(t, "meet.dennic.uz", parsed.Host)
+	assert.Equal(t, roomId, path.Base(parsed.Path))
+
+	query := parsed.Query()
+	assert.Equal(t, "patient-1", query.Get("participant"))
+	assert.True(t, provider.Verify(roomId, "patient-1", query.Get("expires"), query.Get("token"), time.Now()))
+	// The token is bound to the participant and expires with the link.
+	assert.False(t, provider.Verify(roomId, "doctor-1", query.Get("expires"), query.Get("token"), time.Now()))
+	assert.False(t, provider.Verify(roomId, "patient-1", query.Get("expires"), query.Get("token"), expiresAt.Add(time.Minute)))
+
+	_, err = provider.JoinURL(ctx, roomId, "", expiresAt)
+	assert.Error(t, err)
+}
@@ -0,0 +1,30 @@
+// Package videoroom creates the virtual rooms used by online appointments.
+// Providers are selected by name from the configuration, so a hosted video
+// vendor can be added next to the self-hosted one without touching the usecase.
+package videoroom
+
+import (
+	"booking_service/internal/pkg/config"
+	"context"
+	"fmt"
+	"time"
+)
+
+const ProviderSelfHosted = "selfhosted"
+
+// Provider -.
+type Provider interface {
+	Name() string
+	CreateRoom(ctx context.Context, appointmentId int64, startsAt, endsAt time.Time) (string, error)
+	JoinURL(ctx context.Context, roomId, participantId string, expiresAt time.Time) (string, error)
+}
+
+// New returns the provider configured by VIDEO_PROVIDER.
+func New(cfg *config.Config) (Provider, error) {
+	switch cfg.Video.Provider {
+	case "", ProviderSelfHosted:
+		return NewSelfHosted(cfg.Video.BaseURL, cfg.Video.Secret), nil
+	default:
+		return nil, fmt.Errorf("unknown video provider %q", cfg.Video.Provider)
+	}
+}
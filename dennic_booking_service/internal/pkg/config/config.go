@@ -29,10 +29,45 @@ type Config struct {
 		Port string
 	}
 
+	HealthcareService struct {
+		Host string
+		Port string
+	}
+
+	Video struct {
+		Provider   string
+		BaseURL    string
+		Secret     string
+		JoinWindow string
+	}
+
+	Prescription struct {
+		SigningSecret string
+		VerifyURL     string
+	}
+
+	Payment struct {
+		Provider      string
+		CheckoutURL   string
+		WebhookSecret string
+		HoldTTL       string
+		SweepInterval string
+	}
+
+	Messaging struct {
+		TimeZone      string
+		Retention     string
+		SweepInterval string
+	}
+
 	Kafka struct {
 		Address []string
 		Topic   struct {
 			InvestorCreate string
+			Appointments   string
+			LabOrders      string
+			Prescriptions  string
+			Messages       string
 		}
 	}
 }
@@ -59,9 +94,39 @@ func New() *Config {
 	config.OTLPCollector.Host = getEnv("OTLP_COLLECTOR_HOST", "otlp-collector")
 	config.OTLPCollector.Port = getEnv("OTLP_COLLECTOR_PORT", ":4317")
 
+	// healthcare service configuration
+	config.HealthcareService.Host = getEnv("HEALTHCARE_SERVICE_GRPC_HOST", "dennic_healthcare_service")
+	config.HealthcareService.Port = getEnv("HEALTHCARE_SERVICE_GRPC_PORT", ":9080")
+
+	// video room configuration
+	config.Video.Provider = getEnv("VIDEO_PROVIDER", "selfhosted")
+	config.Video.BaseURL = getEnv("VIDEO_BASE_URL", "https://meet.dennic.uz")
+	config.Video.Secret = getEnv("VIDEO_SECRET", "dennic-video-secret")
+	config.Video.JoinWindow = getEnv("VIDEO_JOIN_WINDOW", "15m")
+
+	// prescription configuration
+	config.Prescription.SigningSecret = getEnv("PRESCRIPTION_SIGNING_SECRET", "")
+	config.Prescription.VerifyURL = getEnv("PRESCRIPTION_VERIFY_URL", "https://api.dennic.uz/v1/prescription/verify")
+
+	// online payment configuration
+	config.Payment.Provider = getEnv("PAYMENT_PROVIDER", "fake")
+	config.Payment.CheckoutURL = getEnv("PAYMENT_CHECKOUT_URL", "https://pay.dennic.uz/checkout")
+	config.Payment.WebhookSecret = getEnv("PAYMENT_WEBHOOK_SECRET", "")
+	config.Payment.HoldTTL = getEnv("PAYMENT_HOLD_TTL", "15m")
+	config.Payment.SweepInterval = getEnv("PAYMENT_SWEEP_INTERVAL", "1m")
+
+	// patient messaging configuration
+	config.Messaging.TimeZone = getEnv("MESSAGING_TIME_ZONE", "Asia/Tashkent")
+	config.Messaging.Retention = getEnv("MESSAGING_RETENTION", "17520h")
+	config.Messaging.SweepInterval = getEnv("MESSAGING_SWEEP_INTERVAL", "1h")
+
 	// kafka configuration
 	config.Kafka.Address = strings.Split(getEnv("KAFKA_ADDRESS", "localhost:29092"), ",")
 	config.Kafka.Topic.InvestorCreate = getEnv("KAFKA_TOPIC_INVESTOR_CREATE", "investor.created")
+	config.Kafka.Topic.Appointments = getEnv("KAFKA_TOPIC_APPOINTMENTS", "booking.appointments")
+	config.Kafka.Topic.LabOrders = getEnv("KAFKA_TOPIC_LAB_ORDERS", "booking.lab_orders")
+	config.Kafka.Topic.Prescriptions = getEnv("KAFKA_TOPIC_PRESCRIPTIONS", "booking.prescriptions")
+	config.Kafka.Topic.Messages = getEnv("KAFKA_TOPIC_MESSAGES", "booking.messages")
 
 	return &config
 }
@@ -85,6 +85,9 @@ func (p *PostgresDB) Error(err error) error {
 		switch pgErr.Code {
 		case "23505":
 			return entity.ErrorConflict
+		// exclusion_violation: overlapping resource reservation
+		case "23P01":
+			return entity.ErrorConflict
 		}
 	}
 
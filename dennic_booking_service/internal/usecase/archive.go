@@ -1,9 +1,11 @@
 package usecase
 
 import (
+	"booking_service/internal/entity"
 	"booking_service/internal/entity/archive"
 	"booking_service/internal/pkg/otlp"
 	"context"
+	"errors"
 	"time"
 )
 
@@ -33,6 +35,10 @@ func (r *BookedArchiveUseCase) CreateArchive(ctx context.Context, req *archive.C
 	ctx, span := otlp.Start(ctx, serviceNameArchive, spanNameArchive+"Create")
 	defer span.End()
 
+	if err := validateArchive(req.AppointmentId, req.StartedAt, req.EndedAt, req.Outcome, req.PaymentType, req.PaymentAmount < 0, false); err != nil {
+		return nil, err
+	}
+
 	return r.Repo.CreateArchive(ctx, req)
 }
 
@@ -63,6 +69,10 @@ func (r *BookedArchiveUseCase) UpdateArchive(ctx context.Context, req *archive.U
 	ctx, span := otlp.Start(ctx, serviceNameArchive, spanNameArchive+"Update")
 	defer span.End()
 
+	if err := validateArchive(0, req.StartedAt, req.EndedAt, req.Outcome, req.PaymentType, req.PaymentAmount < 0, true); err != nil {
+		return nil, err
+	}
+
 	return r.Repo.UpdateArchive(ctx, req)
 }
 
@@ -75,3 +85,39 @@ func (r *BookedArchiveUseCase) DeleteArchive(ctx context.Context, req *archive.F
 
 	return r.Repo.DeleteArchive(ctx, req)
 }
+
+// validateArchive checks a closed-out visit. On update the visit times are
+// required; on create they default to the booked time.
+func validateArchive(appointmentId int64, startedAt, endedAt time.Time, outcome, paymentType string, negativeAmount, update bool) error {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid archive")
+	if !update && appointmentId <= 0 {
+		errValidation.Errors["appointment_id"] = "is required"
+	}
+	if update && startedAt.IsZero() {
+		errValidation.Errors["started_at"] = "is required"
+	}
+	if update && endedAt.IsZero() {
+		errValidation.Errors["ended_at"] = "is required"
+	}
+	if !startedAt.IsZero() && !endedAt.IsZero() && endedAt.Before(startedAt) {
+		errValidation.Errors["ended_at"] = "must not be before started_at"
+	}
+	switch outcome {
+	case archive.OutcomeAttended, archive.OutcomeCancelled, archive.OutcomeNoShow:
+	default:
+		errValidation.Errors["outcome"] = "must be one of attended, cancelled, no_show"
+	}
+	switch paymentType {
+	case "", archive.PaymentCash, archive.PaymentCard, archive.PaymentInsurance, archive.PaymentOnline:
+	default:
+		errValidation.Errors["payment_type"] = "must be one of cash, card, insurance, online"
+	}
+	if negativeAmount {
+		errValidation.Errors["payment_amount"] = "must not be negative"
+	}
+	if len(errValidation.Errors) > 0 {
+		return errValidation
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/billing"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	serviceNameBilling = "BillingService"
+	spanNameBilling    = "BillingUsecase"
+)
+
+// BookedBillingUseCase -.
+type BookedBillingUseCase struct {
+	Repo       BillingRepo
+	Receipts   BillingReceipts
+	ctxTimeout time.Duration
+}
+
+// NewBookedBilling -.
+func NewBookedBilling(r BillingRepo, receipts BillingReceipts, ctxTimeout time.Duration) *BookedBillingUseCase {
+	return &BookedBillingUseCase{
+		Repo:       r,
+		Receipts:   receipts,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+func (r *BookedBillingUseCase) CreateInvoice(ctx context.Context, req *billing.CreateInvoice) (*billing.Invoice, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"Create")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid invoice")
+	if req.AppointmentId <= 0 {
+		errValidation.Errors["appointment_id"] = "is required"
+	}
+	for i, item := range req.Items {
+		validateProcedure(errValidation, fmt.Sprintf("items[%d]", i), item)
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	return r.Repo.CreateInvoice(ctx, req)
+}
+
+func (r *BookedBillingUseCase) GetInvoice(ctx context.Context, req *billing.FieldValueReq) (*billing.Invoice, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"Get")
+	span.End()
+
+	return r.Repo.GetInvoice(ctx, req)
+}
+
+func (r *BookedBillingUseCase) GetAllInvoices(ctx context.Context, req *billing.GetAllInvoices) (*billing.InvoicesType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"List")
+	span.End()
+
+	return r.Repo.GetAllInvoices(ctx, req)
+}
+
+func (r *BookedBillingUseCase) AddInvoiceItem(ctx context.Context, req *billing.AddItem) (*billing.Invoice, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"AddItem")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid invoice item")
+	if req.Item == nil {
+		req.Item = &billing.Item{}
+	}
+	validateProcedure(errValidation, "item", req.Item)
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	return r.Repo.AddInvoiceItem(ctx, req)
+}
+
+func (r *BookedBillingUseCase) SetInvoiceDiscount(ctx context.Context, req *billing.SetDiscount) (*billing.Invoice, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"Discount")
+	span.End()
+
+	return r.Repo.SetInvoiceDiscount(ctx, req)
+}
+
+func (r *BookedBillingUseCase) RecordPayment(ctx context.Context, req *billing.Transaction) (*billing.Invoice, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"Payment")
+	span.End()
+
+	req.Kind = billing.KindPayment
+	if err := validateTransaction(req); err != nil {
+		return nil, err
+	}
+
+	return r.Repo.RecordTransaction(ctx, req)
+}
+
+func (r *BookedBillingUseCase) RefundPayment(ctx context.Context, req *billing.Transaction) (*billing.Invoice, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"Refund")
+	span.End()
+
+	req.Kind = billing.KindRefund
+	if err := validateTransaction(req); err != nil {
+		return nil, err
+	}
+
+	return r.Repo.RecordTransaction(ctx, req)
+}
+
+func (r *BookedBillingUseCase) VoidInvoice(ctx context.Context, req *billing.FieldValueReq) (*billing.Invoice, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"Void")
+	span.End()
+
+	return r.Repo.VoidInvoice(ctx, req)
+}
+
+func (r *BookedBillingUseCase) RenderReceipt(ctx context.Context, req *billing.FieldValueReq) (*billing.Receipt, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"Receipt")
+	span.End()
+
+	invoice, err := r.Repo.GetInvoice(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Receipts.Render(invoice)
+}
+
+func (r *BookedBillingUseCase) GetCashDeskReport(ctx context.Context, req *billing.CashDeskReportReq) (*billing.CashDeskReport, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBilling, spanNameBilling+"CashDesk")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid cash desk report")
+	if req.BranchId == "" {
+		errValidation.Errors["branch_id"] = "is required"
+	}
+	if req.Date.IsZero() {
+		errValidation.Errors["date"] = "is required"
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	return r.Repo.GetCashDeskReport(ctx, req)
+}
+
+// validateProcedure checks a line added at the desk; only the booked service
+// line is priced by the system.
+func validateProcedure(errValidation *entity.ErrValidation, field string, item *billing.Item) {
+	item.Kind = billing.ItemProcedure
+	item.Description = strings.TrimSpace(item.Description)
+	if item.Description == "" {
+		errValidation.Errors[field+".description"] = "is required"
+	}
+	if item.Quantity == 0 {
+		item.Quantity = 1
+	}
+	if item.Quantity < 0 {
+		errValidation.Errors[field+".quantity"] = "must be positive"
+	}
+}
+
+func validateTransaction(req *billing.Transaction) error {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid " + req.Kind)
+	if req.InvoiceId == "" {
+		errValidation.Errors["invoice_id"] = "is required"
+	}
+	if !validMethod(req.Method) {
+		errValidation.Errors["method"] = "must be one of " + strings.Join(billing.Methods, ", ")
+	}
+	if req.Amount <= 0 {
+		errValidation.Errors["amount"] = "must be positive"
+	}
+	if req.CashierId == "" {
+		errValidation.Errors["cashier_id"] = "is required"
+	}
+	if len(errValidation.Errors) > 0 {
+		return errValidation
+	}
+	return nil
+}
+
+func validMethod(method string) bool {
+	for _, known := range billing.Methods {
+		if method == known {
+			return true
+		}
+	}
+	return false
+}
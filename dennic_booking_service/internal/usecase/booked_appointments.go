@@ -2,9 +2,13 @@ package usecase
 
 import (
 	_ "booking_service/genproto/booking_service"
+	"booking_service/internal/entity"
 	appointment "booking_service/internal/entity/booked_appointments"
 	"booking_service/internal/pkg/otlp"
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -16,13 +20,26 @@ const (
 // BookedAppointmentsUseCase -.
 type BookedAppointmentsUseCase struct {
 	repo       BookedAppointments
+	prices     ServicePrices
+	rooms      VideoRooms
+	events     AppointmentEvents
+	location   *time.Location
+	joinWindow time.Duration
 	ctxTimeout time.Duration
 }
 
 // NewBookedAppointments -.
-func NewBookedAppointments(r BookedAppointments, ctxTimeout time.Duration) *BookedAppointmentsUseCase {
+// location is the clinic's time zone, the one appointment dates and times are
+// entered in. joinWindow is how long before the start of an online
+// appointment the participants may fetch their join links.
+func NewBookedAppointments(r BookedAppointments, prices ServicePrices, rooms VideoRooms, events AppointmentEvents, location *time.Location, joinWindow, ctxTimeout time.Duration) *BookedAppointmentsUseCase {
 	return &BookedAppointmentsUseCase{
 		repo:       r,
+		prices:     prices,
+		rooms:      rooms,
+		events:     events,
+		location:   location,
+		joinWindow: joinWindow,
 		ctxTimeout: ctxTimeout,
 	}
 }
@@ -34,7 +51,35 @@ func (r *BookedAppointmentsUseCase) CreateAppointment(ctx context.Context, req *
 	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointments+"Create")
 	span.End()
 
-	return r.repo.CreateAppointment(ctx, req)
+	if req.Modality == "" {
+		req.Modality = appointment.ModalityOffline
+	}
+	switch req.Modality {
+	case appointment.ModalityOffline, appointment.ModalityOnline:
+	default:
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = fmt.Errorf("unknown modality %q", req.Modality)
+		errValidation.Errors["modality"] = "must be online or offline"
+		return nil, errValidation
+	}
+
+	// The price is the one in force for the doctor service and the modality.
+	if req.DoctorServiceId != "" {
+		price, currency, err := r.prices.EffectivePrice(ctx, req.DoctorServiceId, req.Modality)
+		if err != nil {
+			return nil, err
+		}
+		req.Price, req.Currency = price, currency
+	}
+
+	res, err := r.repo.CreateAppointment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, appointment.EventCreated, res)
+
+	return res, nil
 }
 
 func (r *BookedAppointmentsUseCase) GetAppointment(ctx context.Context, req *appointment.FieldValueReq) (*appointment.Appointment, error) {
@@ -64,7 +109,22 @@ func (r *BookedAppointmentsUseCase) UpdateAppointment(ctx context.Context, req *
 	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointments+"Update")
 	span.End()
 
-	return r.repo.UpdateAppointment(ctx, req)
+	previous, err := r.repo.GetAppointment(ctx, &appointment.FieldValueReq{
+		Field: req.Field,
+		Value: req.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.repo.UpdateAppointment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publishMoved(ctx, res, previous)
+
+	return res, nil
 }
 
 func (r *BookedAppointmentsUseCase) DeleteAppointment(ctx context.Context, req *appointment.FieldValueReq) (*appointment.StatusRes, error) {
@@ -74,5 +134,187 @@ func (r *BookedAppointmentsUseCase) DeleteAppointment(ctx context.Context, req *
 	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointments+"Delete")
 	span.End()
 
-	return r.repo.DeleteAppointment(ctx, req)
+	deleted, err := r.repo.GetAppointment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.repo.DeleteAppointment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, appointment.EventDeleted, deleted)
+
+	return res, nil
+}
+
+// ConfirmAppointment confirms the appointment and, for an online one, opens
+// its video room. Confirming again keeps the room already issued.
+func (r *BookedAppointmentsUseCase) ConfirmAppointment(ctx context.Context, req *appointment.ConfirmAppointment) (*appointment.Appointment, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointments+"Confirm")
+	span.End()
+
+	res, err := r.repo.GetAppointment(ctx, &appointment.FieldValueReq{
+		Field: "id",
+		Value: strconv.FormatInt(req.Id, 10),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req.VideoRoomId = res.VideoRoomId
+	req.VideoProvider = res.VideoProvider
+	if res.Modality == appointment.ModalityOnline && res.VideoRoomId == "" {
+		startsAt, endsAt := r.appointmentStartEnd(res)
+		req.VideoRoomId, err = r.rooms.CreateRoom(ctx, res.Id, startsAt, endsAt)
+		if err != nil {
+			return nil, err
+		}
+		req.VideoProvider = r.rooms.Name()
+	}
+
+	confirmed, err := r.repo.ConfirmAppointment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, appointment.EventConfirmed, confirmed)
+
+	return confirmed, nil
+}
+
+// SetAppointmentStatus records the outcome of the visit.
+func (r *BookedAppointmentsUseCase) SetAppointmentStatus(ctx context.Context, req *appointment.AppointmentStatusReq) (*appointment.Appointment, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointments+"SetStatus")
+	span.End()
+
+	switch req.Status {
+	case appointment.StatusScheduled, appointment.StatusAttended, appointment.StatusCancelled, appointment.StatusNoShow:
+	default:
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = fmt.Errorf("unknown appointment status %q", req.Status)
+		errValidation.Errors["status"] = "must be scheduled, attended, cancelled or no_show"
+		return nil, errValidation
+	}
+
+	res, err := r.repo.SetAppointmentStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, appointment.EventStatusChanged, res)
+
+	return res, nil
+}
+
+// GetJoinLink issues a personal link to the video room of an online
+// appointment. Only its patient and doctor get one, named by the patient
+// profile and the doctor id, and only from joinWindow before the start until
+// the appointment ends.
+func (r *BookedAppointmentsUseCase) GetJoinLink(ctx context.Context, req *appointment.JoinLinkReq) (*appointment.JoinLink, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointments+"JoinLink")
+	span.End()
+
+	if req.ParticipantId == "" {
+		return nil, entity.NewErrNoRequiredParameter("participant_id")
+	}
+
+	res, err := r.repo.GetAppointment(ctx, &appointment.FieldValueReq{
+		Field: "id",
+		Value: strconv.FormatInt(req.AppointmentId, 10),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ParticipantId != res.PatientId && req.ParticipantId != res.DoctorId {
+		return nil, entity.NewErrPermissionDenied("appointment video room")
+	}
+	if res.Modality != appointment.ModalityOnline {
+		return nil, entity.NewErrFailedPrecondition("appointment is not online")
+	}
+	if !res.PatientStatus || res.VideoRoomId == "" {
+		return nil, entity.NewErrFailedPrecondition("appointment is not confirmed")
+	}
+
+	startsAt, endsAt := r.appointmentStartEnd(res)
+	notBefore := startsAt.Add(-r.joinWindow)
+	now := time.Now()
+	if now.Before(notBefore) {
+		return nil, entity.NewErrFailedPrecondition(fmt.Sprintf("join link is available from %s", notBefore.Format("2006-01-02 15:04:05")))
+	}
+	if !now.Before(endsAt) {
+		return nil, entity.NewErrFailedPrecondition("appointment has ended")
+	}
+	if res.VideoProvider != r.rooms.Name() {
+		return nil, errors.New("video room was opened by provider " + res.VideoProvider)
+	}
+
+	url, err := r.rooms.JoinURL(ctx, res.VideoRoomId, req.ParticipantId, endsAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appointment.JoinLink{
+		Url:       url,
+		RoomId:    res.VideoRoomId,
+		Provider:  res.VideoProvider,
+		NotBefore: notBefore,
+		ExpiresAt: endsAt,
+	}, nil
+}
+
+// publish tells other services about a stored change. The change stands
+// when the event cannot be handed over; the failure is recorded on the span.
+func (r *BookedAppointmentsUseCase) publish(ctx context.Context, eventType string, a *appointment.Appointment) {
+	startsAt, _ := r.appointmentStartEnd(a)
+	r.publishEvent(ctx, &appointment.Event{
+		Type:        eventType,
+		Appointment: a,
+		StartsAt:    startsAt,
+		OccurredAt:  time.Now(),
+	})
+}
+
+// publishMoved publishes an update, telling whether it moved the appointment
+// away from where previous had it.
+func (r *BookedAppointmentsUseCase) publishMoved(ctx context.Context, a, previous *appointment.Appointment) {
+	startsAt, _ := r.appointmentStartEnd(a)
+	event := &appointment.Event{
+		Type:        appointment.EventUpdated,
+		Appointment: a,
+		StartsAt:    startsAt,
+		OccurredAt:  time.Now(),
+	}
+	if previousStartsAt, _ := r.appointmentStartEnd(previous); !previousStartsAt.Equal(startsAt) {
+		event.PreviousStartsAt = previousStartsAt
+	}
+	r.publishEvent(ctx, event)
+}
+
+func (r *BookedAppointmentsUseCase) publishEvent(ctx context.Context, event *appointment.Event) {
+	ctx, span := otlp.Start(ctx, serviceNameAppointments, spanNameAppointments+"Publish")
+	defer span.End()
+
+	if err := r.events.PublishAppointment(ctx, event); err != nil {
+		span.Error(err)
+	}
+}
+
+// appointmentStartEnd returns the appointment interval in the clinic's time
+// zone, the one the appointment date and time are entered in.
+func (r *BookedAppointmentsUseCase) appointmentStartEnd(a *appointment.Appointment) (time.Time, time.Time) {
+	startsAt := time.Date(a.AppointmentDate.Year(), a.AppointmentDate.Month(), a.AppointmentDate.Day(),
+		a.AppointmentTime.Hour(), a.AppointmentTime.Minute(), a.AppointmentTime.Second(), 0, r.location)
+	return startsAt, startsAt.Add(time.Duration(a.Duration) * time.Minute)
 }
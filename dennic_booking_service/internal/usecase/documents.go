@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/documents"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	serviceNameDocuments = "DocumentsService"
+	spanNameDocuments    = "DocumentsUsecase"
+)
+
+// BookedDocumentsUseCase -.
+type BookedDocumentsUseCase struct {
+	Repo       Documents
+	ctxTimeout time.Duration
+}
+
+// NewBookedDocuments -.
+func NewBookedDocuments(r Documents, ctxTimeout time.Duration) *BookedDocumentsUseCase {
+	return &BookedDocumentsUseCase{
+		Repo:       r,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+func (r *BookedDocumentsUseCase) CreateDocument(ctx context.Context, req *documents.Document) (*documents.Document, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDocuments, spanNameDocuments+"Create")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid document")
+	if req.PatientId == "" {
+		errValidation.Errors["patient_id"] = "is required"
+	}
+	if !validDocumentType(req.Type) {
+		errValidation.Errors["type"] = "must be one of " + strings.Join(documents.Types, ", ")
+	}
+	req.Title = strings.TrimSpace(req.Title)
+	if req.Title == "" {
+		req.Title = req.FileName
+	}
+	if req.Title == "" {
+		errValidation.Errors["title"] = "is required"
+	}
+	if req.Bucket == "" || req.ObjectKey == "" {
+		errValidation.Errors["object_key"] = "is required"
+	}
+	if req.SizeBytes <= 0 {
+		errValidation.Errors["size_bytes"] = "must be positive"
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	return r.Repo.CreateDocument(ctx, req)
+}
+
+func (r *BookedDocumentsUseCase) GetDocument(ctx context.Context, req *documents.FieldValueReq) (*documents.Document, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDocuments, spanNameDocuments+"Get")
+	span.End()
+
+	return r.Repo.GetDocument(ctx, req)
+}
+
+func (r *BookedDocumentsUseCase) GetAllDocuments(ctx context.Context, req *documents.GetAllDocuments) (*documents.DocumentsType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDocuments, spanNameDocuments+"List")
+	span.End()
+
+	return r.Repo.GetAllDocuments(ctx, req)
+}
+
+func (r *BookedDocumentsUseCase) DeleteDocument(ctx context.Context, req *documents.FieldValueReq) (*documents.Document, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDocuments, spanNameDocuments+"Delete")
+	span.End()
+
+	return r.Repo.DeleteDocument(ctx, req)
+}
+
+func validDocumentType(t string) bool {
+	for _, known := range documents.Types {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
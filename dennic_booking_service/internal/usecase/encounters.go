@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/encounters"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	serviceNameEncounters = "EncountersService"
+	spanNameEncounters    = "EncountersUsecase"
+)
+
+// icd10Code matches an ICD-10 category with an optional subcategory, e.g. J06 or E11.65.
+var icd10Code = regexp.MustCompile(`^[A-TV-Z][0-9][0-9AB](\.[0-9A-TV-Z]{1,4})?$`)
+
+// BookedEncountersUseCase -.
+type BookedEncountersUseCase struct {
+	Repo       Encounters
+	ctxTimeout time.Duration
+}
+
+// NewBookedEncounters -.
+func NewBookedEncounters(r Encounters, ctxTimeout time.Duration) *BookedEncountersUseCase {
+	return &BookedEncountersUseCase{
+		Repo:       r,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+func (r *BookedEncountersUseCase) CreateEncounter(ctx context.Context, req *encounters.Encounter) (*encounters.Encounter, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameEncounters, spanNameEncounters+"Create")
+	span.End()
+
+	if err := validateEncounter(req); err != nil {
+		return nil, err
+	}
+
+	return r.Repo.CreateEncounter(ctx, req)
+}
+
+func (r *BookedEncountersUseCase) GetEncounter(ctx context.Context, req *encounters.FieldValueReq) (*encounters.Encounter, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameEncounters, spanNameEncounters+"Get")
+	span.End()
+
+	return r.Repo.GetEncounter(ctx, req)
+}
+
+func (r *BookedEncountersUseCase) UpdateEncounter(ctx context.Context, req *encounters.Encounter) (*encounters.Encounter, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameEncounters, spanNameEncounters+"Update")
+	span.End()
+
+	if err := validateEncounter(req); err != nil {
+		return nil, err
+	}
+
+	return r.Repo.UpdateEncounter(ctx, req)
+}
+
+func (r *BookedEncountersUseCase) DeleteEncounter(ctx context.Context, req *encounters.FieldValueReq) (*encounters.StatusRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameEncounters, spanNameEncounters+"Delete")
+	span.End()
+
+	return r.Repo.DeleteEncounter(ctx, req)
+}
+
+func (r *BookedEncountersUseCase) GetPatientTimeline(ctx context.Context, req *encounters.TimelineReq) (*encounters.Timeline, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameEncounters, spanNameEncounters+"Timeline")
+	span.End()
+
+	if req.PatientId == "" {
+		return nil, entity.NewErrNoRequiredParameter("patient_id")
+	}
+
+	return r.Repo.GetPatientTimeline(ctx, req)
+}
+
+// validateEncounter checks the coded parts of the record. ICD-10 codes are
+// stored upper-cased.
+func validateEncounter(req *encounters.Encounter) error {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid encounter")
+
+	if strings.TrimSpace(req.ChiefComplaint) == "" {
+		errValidation.Errors["chief_complaint"] = "is required"
+	}
+
+	vitals := req.Vitals
+	if vitals.TemperatureC != 0 && (vitals.TemperatureC < 25 || vitals.TemperatureC > 45) {
+		errValidation.Errors["vitals.temperature_c"] = "must be between 25 and 45"
+	}
+	if vitals.PulseBpm < 0 || vitals.PulseBpm > 300 {
+		errValidation.Errors["vitals.pulse_bpm"] = "must be between 0 and 300"
+	}
+	if vitals.SystolicMmHg < 0 || vitals.DiastolicMmHg < 0 || (vitals.DiastolicMmHg > 0 && vitals.DiastolicMmHg >= vitals.SystolicMmHg) {
+		errValidation.Errors["vitals.blood_pressure"] = "systolic must be above diastolic"
+	}
+	if vitals.RespiratoryRate < 0 || vitals.RespiratoryRate > 100 {
+		errValidation.Errors["vitals.respiratory_rate"] = "must be between 0 and 100"
+	}
+	if vitals.OxygenSaturation < 0 || vitals.OxygenSaturation > 100 {
+		errValidation.Errors["vitals.oxygen_saturation"] = "must be a percentage"
+	}
+	if vitals.WeightKg < 0 || vitals.HeightCm < 0 {
+		errValidation.Errors["vitals.weight_height"] = "must not be negative"
+	}
+
+	primary := 0
+	for i, diagnosis := range req.Diagnoses {
+		diagnosis.Icd10Code = strings.ToUpper(strings.TrimSpace(diagnosis.Icd10Code))
+		if !icd10Code.MatchString(diagnosis.Icd10Code) {
+			errValidation.Errors[fmt.Sprintf("diagnoses[%d].icd10_code", i)] = "is not an ICD-10 code"
+		}
+		if diagnosis.Primary {
+			primary++
+		}
+	}
+	if primary > 1 {
+		errValidation.Errors["diagnoses"] = "only one diagnosis can be primary"
+	}
+
+	for i, allergy := range req.Allergies {
+		if strings.TrimSpace(allergy.Substance) == "" {
+			errValidation.Errors[fmt.Sprintf("allergies[%d].substance", i)] = "is required"
+		}
+		switch allergy.Severity {
+		case encounters.SeverityMild, encounters.SeverityModerate, encounters.SeveritySevere:
+		default:
+			errValidation.Errors[fmt.Sprintf("allergies[%d].severity", i)] = "must be mild, moderate or severe"
+		}
+	}
+
+	for i, condition := range req.ChronicConditions {
+		condition.Icd10Code = strings.ToUpper(strings.TrimSpace(condition.Icd10Code))
+		if !icd10Code.MatchString(condition.Icd10Code) {
+			errValidation.Errors[fmt.Sprintf("chronic_conditions[%d].icd10_code", i)] = "is not an ICD-10 code"
+		}
+	}
+
+	for i, procedure := range req.Procedures {
+		if strings.TrimSpace(procedure.Code) == "" {
+			errValidation.Errors[fmt.Sprintf("procedures[%d].code", i)] = "is required"
+		}
+	}
+
+	if len(errValidation.Errors) > 0 {
+		return errValidation
+	}
+	return nil
+}
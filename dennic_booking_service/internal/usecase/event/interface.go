@@ -0,0 +1,17 @@
+package event
+
+import (
+	appointment "booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/lab_orders"
+	"booking_service/internal/entity/messages"
+	"booking_service/internal/entity/prescriptions"
+	"context"
+)
+
+type BrokerProducer interface {
+	PublishAppointment(ctx context.Context, event *appointment.Event) error
+	PublishLabOrder(ctx context.Context, order *lab_orders.LabOrder) error
+	PublishPrescription(ctx context.Context, prescription *prescriptions.Prescription) error
+	PublishMessage(ctx context.Context, event *messages.Event) error
+	Close()
+}
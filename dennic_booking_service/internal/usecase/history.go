@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/archive"
+	"booking_service/internal/entity/doctor_notes"
+	"booking_service/internal/entity/encounters"
+	"booking_service/internal/entity/history"
+	"booking_service/internal/entity/lab_orders"
+	"booking_service/internal/entity/patients"
+	"booking_service/internal/entity/prescriptions"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	serviceNameHistory = "HistoryService"
+	spanNameHistory    = "HistoryUsecase"
+)
+
+// BookedHistoryUseCase -.
+type BookedHistoryUseCase struct {
+	Patients      Patient
+	Archive       Archive
+	Encounters    Encounters
+	Notes         DoctorNotes
+	Prescriptions PrescriptionsRepo
+	LabOrders     LabOrdersRepo
+	Repo          HistoryRepo
+	Codec         HistoryCodec
+	ctxTimeout    time.Duration
+}
+
+// NewBookedHistory -.
+func NewBookedHistory(patients Patient, archive Archive, encounters Encounters, notes DoctorNotes, prescriptions PrescriptionsRepo,
+	labOrders LabOrdersRepo, r HistoryRepo, codec HistoryCodec, ctxTimeout time.Duration) *BookedHistoryUseCase {
+	return &BookedHistoryUseCase{
+		Patients:      patients,
+		Archive:       archive,
+		Encounters:    encounters,
+		Notes:         notes,
+		Prescriptions: prescriptions,
+		LabOrders:     labOrders,
+		Repo:          r,
+		Codec:         codec,
+		ctxTimeout:    ctxTimeout,
+	}
+}
+
+// ExportHistory bundles everything the clinic holds on a patient.
+func (r *BookedHistoryUseCase) ExportHistory(ctx context.Context, req *history.ExportReq) (*history.ExportFile, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameHistory, spanNameHistory+"Export")
+	defer span.End()
+
+	if err := validateHistoryFormat(req.Format); err != nil {
+		return nil, err
+	}
+
+	patient, err := r.Patients.GetPatient(ctx, &patients.FieldValueReq{Field: "id", Value: req.PatientId})
+	if err != nil {
+		return nil, err
+	}
+
+	visits, err := r.Archive.GetAllArchive(ctx, &archive.GetAllArchives{PatientId: req.PatientId})
+	if err != nil {
+		return nil, err
+	}
+	timeline, err := r.Encounters.GetPatientTimeline(ctx, &encounters.TimelineReq{PatientId: req.PatientId})
+	if err != nil {
+		return nil, err
+	}
+	notes, err := r.Notes.GetAllDoctorNotes(ctx, &doctor_notes.GetAllNotes{PatientId: req.PatientId})
+	if err != nil {
+		return nil, err
+	}
+	issued, err := r.Prescriptions.GetAllPrescriptions(ctx, &prescriptions.GetAllPrescriptions{PatientId: req.PatientId})
+	if err != nil {
+		return nil, err
+	}
+	labOrders, err := r.LabOrders.GetAllLabOrders(ctx, &lab_orders.GetAllLabOrders{PatientId: req.PatientId, Status: lab_orders.StatusReleased})
+	if err != nil {
+		return nil, err
+	}
+	external, err := r.Repo.GetExternalRecords(ctx, req.PatientId)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Codec.Export(&history.History{
+		Patient:       patient,
+		Visits:        visits.Archives,
+		Encounters:    timeline.Encounters,
+		Notes:         notes.DoctorNotes,
+		Prescriptions: issued.Prescriptions,
+		LabOrders:     labOrders.LabOrders,
+		External:      external,
+	}, time.Now())
+}
+
+// ImportHistory creates a patient from another clinic's export and keeps the
+// rest of its resources as the patient's external records.
+func (r *BookedHistoryUseCase) ImportHistory(ctx context.Context, req *history.ImportReq) (*history.ImportRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameHistory, spanNameHistory+"Import")
+	defer span.End()
+
+	if err := validateHistoryFormat(req.Format); err != nil {
+		return nil, err
+	}
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid history import")
+	if strings.TrimSpace(req.Source) == "" {
+		errValidation.Errors["source"] = "is required"
+	}
+	if len(req.Content) == 0 {
+		errValidation.Errors["content"] = "is required"
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	imported, err := r.Codec.Import(req.Content, uuid.NewString())
+	if err != nil {
+		errValidation.Errors["content"] = err.Error()
+		return nil, errValidation
+	}
+
+	patient := imported.Patient
+	errValidation = validatePatient(patient.FirstName, patient.LastName, patient.Gender, patient.BloodGroup, patient.BirthDate)
+	if strings.TrimSpace(patient.PhoneNumber) == "" {
+		errValidation.Errors["phone_number"] = "is required"
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	for _, record := range imported.Records {
+		record.Id = uuid.NewString()
+		record.Source = req.Source
+		record.ImportedBy = req.ImportedBy
+	}
+
+	if err = r.Repo.ImportHistory(ctx, patient, imported.Records); err != nil {
+		return nil, err
+	}
+
+	created, err := r.Patients.GetPatient(ctx, &patients.FieldValueReq{Field: "id", Value: patient.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &history.ImportRes{
+		Patient:  created,
+		Imported: int64(len(imported.Records)),
+		Skipped:  imported.Skipped,
+	}, nil
+}
+
+func validateHistoryFormat(format string) error {
+	switch format {
+	case "", history.FormatFHIR:
+		return nil
+	}
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid history format")
+	errValidation.Errors["format"] = "must be fhir"
+	return errValidation
+}
@@ -0,0 +1,434 @@
+package usecase
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/billing"
+	"booking_service/internal/entity/insurance"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	serviceNameInsurance = "InsuranceService"
+	spanNameInsurance    = "InsuranceUsecase"
+)
+
+// BookedInsuranceUseCase -.
+type BookedInsuranceUseCase struct {
+	Repo       InsuranceRepo
+	Invoices   BillingRepo
+	Exporter   ClaimExporter
+	ctxTimeout time.Duration
+}
+
+// NewBookedInsurance -.
+func NewBookedInsurance(r InsuranceRepo, invoices BillingRepo, exporter ClaimExporter, ctxTimeout time.Duration) *BookedInsuranceUseCase {
+	return &BookedInsuranceUseCase{
+		Repo:       r,
+		Invoices:   invoices,
+		Exporter:   exporter,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+func (r *BookedInsuranceUseCase) CreateInsurer(ctx context.Context, req *insurance.Insurer) (*insurance.Insurer, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"CreateInsurer")
+	span.End()
+
+	if err := validateInsurer(req); err != nil {
+		return nil, err
+	}
+
+	return r.Repo.CreateInsurer(ctx, req)
+}
+
+func (r *BookedInsuranceUseCase) GetInsurer(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Insurer, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"GetInsurer")
+	span.End()
+
+	return r.Repo.GetInsurer(ctx, req)
+}
+
+func (r *BookedInsuranceUseCase) GetAllInsurers(ctx context.Context, req *insurance.GetAllReq) (*insurance.InsurersType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"ListInsurers")
+	span.End()
+
+	return r.Repo.GetAllInsurers(ctx, req)
+}
+
+func (r *BookedInsuranceUseCase) UpdateInsurer(ctx context.Context, req *insurance.Insurer) (*insurance.Insurer, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"UpdateInsurer")
+	span.End()
+
+	if err := validateInsurer(req); err != nil {
+		return nil, err
+	}
+
+	return r.Repo.UpdateInsurer(ctx, req)
+}
+
+func (r *BookedInsuranceUseCase) DeleteInsurer(ctx context.Context, req *insurance.FieldValueReq) (*insurance.StatusRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"DeleteInsurer")
+	span.End()
+
+	return r.Repo.DeleteInsurer(ctx, req)
+}
+
+func (r *BookedInsuranceUseCase) CreatePolicy(ctx context.Context, req *insurance.Policy) (*insurance.Policy, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"CreatePolicy")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid insurance policy")
+	if req.InsurerId == "" {
+		errValidation.Errors["insurer_id"] = "is required"
+	}
+	if req.PatientId == "" {
+		errValidation.Errors["patient_id"] = "is required"
+	}
+	if req.PolicyNumber == "" {
+		errValidation.Errors["policy_number"] = "is required"
+	}
+	if req.ValidFrom.IsZero() || req.ValidTo.IsZero() {
+		errValidation.Errors["valid_to"] = "the validity period is required"
+	} else if req.ValidTo.Before(req.ValidFrom) {
+		errValidation.Errors["valid_to"] = "must not be before valid_from"
+	}
+	if req.AnnualLimit < 0 {
+		errValidation.Errors["annual_limit"] = "must not be negative"
+	}
+	if len(req.Currency) != 3 {
+		errValidation.Errors["currency"] = "must be a 3-letter code"
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	return r.Repo.CreatePolicy(ctx, req)
+}
+
+func (r *BookedInsuranceUseCase) GetPolicy(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Policy, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"GetPolicy")
+	span.End()
+
+	return r.Repo.GetPolicy(ctx, req)
+}
+
+func (r *BookedInsuranceUseCase) GetAllPolicies(ctx context.Context, req *insurance.GetAllReq) (*insurance.PoliciesType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"ListPolicies")
+	span.End()
+
+	return r.Repo.GetAllPolicies(ctx, req)
+}
+
+func (r *BookedInsuranceUseCase) CancelPolicy(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Policy, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"CancelPolicy")
+	span.End()
+
+	return r.Repo.CancelPolicy(ctx, req)
+}
+
+func (r *BookedInsuranceUseCase) SetCoverageRule(ctx context.Context, req *insurance.CoverageRule) (*insurance.CoverageRule, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"SetRule")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid coverage rule")
+	if req.InsurerId == "" {
+		errValidation.Errors["insurer_id"] = "is required"
+	}
+	if req.DoctorServiceId == "" {
+		errValidation.Errors["doctor_service_id"] = "is required"
+	}
+	switch req.Kind {
+	case insurance.CoveragePercentage:
+		if req.Percentage <= 0 || req.Percentage > 100 {
+			errValidation.Errors["percentage"] = "must be between 1 and 100"
+		}
+		req.Copay = 0
+	case insurance.CoverageCopay:
+		if req.Copay < 0 {
+			errValidation.Errors["copay"] = "must not be negative"
+		}
+		req.Percentage = 0
+	default:
+		errValidation.Errors["kind"] = "must be percentage or copay"
+	}
+	if req.AnnualLimit < 0 {
+		errValidation.Errors["annual_limit"] = "must not be negative"
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	return r.Repo.SetCoverageRule(ctx, req)
+}
+
+func (r *BookedInsuranceUseCase) GetAllCoverageRules(ctx context.Context, req *insurance.GetAllReq) (*insurance.CoverageRulesType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"ListRules")
+	span.End()
+
+	return r.Repo.GetAllCoverageRules(ctx, req)
+}
+
+func (r *BookedInsuranceUseCase) DeleteCoverageRule(ctx context.Context, req *insurance.FieldValueReq) (*insurance.StatusRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"DeleteRule")
+	span.End()
+
+	return r.Repo.DeleteCoverageRule(ctx, req)
+}
+
+// CheckEligibility tells how much of a service's price the patient's
+// insurance would pay on the day, so the desk can quote the co-pay when
+// booking.
+func (r *BookedInsuranceUseCase) CheckEligibility(ctx context.Context, req *insurance.EligibilityReq) (*insurance.Eligibility, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"Eligibility")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid eligibility check")
+	if req.PatientId == "" {
+		errValidation.Errors["patient_id"] = "is required"
+	}
+	if req.DoctorServiceId == "" {
+		errValidation.Errors["doctor_service_id"] = "is required"
+	}
+	if req.Price <= 0 {
+		errValidation.Errors["price"] = "must be positive"
+	}
+	if req.Currency == "" {
+		errValidation.Errors["currency"] = "is required"
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+	if req.Date.IsZero() {
+		req.Date = time.Now()
+	}
+
+	return r.Repo.CheckEligibility(ctx, req)
+}
+
+func (r *BookedInsuranceUseCase) CreateClaim(ctx context.Context, req *insurance.CreateClaim) (*insurance.Claim, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"CreateClaim")
+	span.End()
+
+	if req.AppointmentId <= 0 {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("invalid claim")
+		errValidation.Errors["appointment_id"] = "is required"
+		return nil, errValidation
+	}
+
+	return r.Repo.CreateClaim(ctx, req)
+}
+
+func (r *BookedInsuranceUseCase) GetClaim(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Claim, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"GetClaim")
+	span.End()
+
+	return r.Repo.GetClaim(ctx, req)
+}
+
+func (r *BookedInsuranceUseCase) GetAllClaims(ctx context.Context, req *insurance.GetAllClaims) (*insurance.ClaimsType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"ListClaims")
+	span.End()
+
+	return r.Repo.GetAllClaims(ctx, req)
+}
+
+// SetClaimStatus records the insurer's decision on a claim. A paid claim
+// credits the approved amount to the appointment's invoice as an insurance
+// payment first; the payment takes the claim id, so a retry after a failure
+// does not record it twice.
+func (r *BookedInsuranceUseCase) SetClaimStatus(ctx context.Context, req *insurance.ClaimStatusReq) (*insurance.Claim, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"SetClaimStatus")
+	span.End()
+
+	if req.Approved < 0 {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("invalid claim status")
+		errValidation.Errors["approved"] = "must not be negative"
+		return nil, errValidation
+	}
+
+	if req.Status == insurance.ClaimPaid {
+		claim, err := r.Repo.GetClaim(ctx, &insurance.FieldValueReq{Field: "id", Value: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		if claim.Status == insurance.ClaimApproved {
+			if err = r.credit(ctx, claim); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return r.Repo.SetClaimStatus(ctx, req)
+}
+
+// ExportClaims batches the insurer's pending claims serviced in the period
+// and renders the batch for sending.
+func (r *BookedInsuranceUseCase) ExportClaims(ctx context.Context, req *insurance.ExportClaims) (*insurance.ExportFile, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"Export")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid claims export")
+	if req.InsurerId == "" {
+		errValidation.Errors["insurer_id"] = "is required"
+	}
+	if req.PeriodFrom.IsZero() || req.PeriodTo.IsZero() {
+		errValidation.Errors["period_to"] = "the period is required"
+	} else if req.PeriodTo.Before(req.PeriodFrom) {
+		errValidation.Errors["period_to"] = "must not be before period_from"
+	}
+	if req.Format == "" {
+		req.Format = insurance.FormatCSV
+	}
+	if req.Format != insurance.FormatCSV && req.Format != insurance.FormatJSON {
+		errValidation.Errors["format"] = "must be csv or json"
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	batch, claims, err := r.Repo.ExportClaims(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Exporter.Render(batch, claims)
+}
+
+// DownloadClaimBatch renders an earlier export again.
+func (r *BookedInsuranceUseCase) DownloadClaimBatch(ctx context.Context, req *insurance.FieldValueReq) (*insurance.ExportFile, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInsurance, spanNameInsurance+"Download")
+	span.End()
+
+	batch, err := r.Repo.GetClaimBatch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := r.Repo.GetAllClaims(ctx, &insurance.GetAllClaims{BatchId: batch.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Exporter.Render(batch, list.Claims)
+}
+
+// credit records the insurer's payment of the claim on its invoice.
+func (r *BookedInsuranceUseCase) credit(ctx context.Context, claim *insurance.Claim) error {
+	var (
+		invoice *billing.Invoice
+		err     error
+	)
+	if claim.InvoiceId != "" {
+		invoice, err = r.Invoices.GetInvoice(ctx, &billing.FieldValueReq{Field: "id", Value: claim.InvoiceId})
+		if err == nil && invoice.Status == billing.StatusVoid {
+			invoice = nil
+		}
+	}
+	if invoice == nil && err == nil {
+		invoice, err = openInvoice(ctx, r.Invoices, claim.AppointmentId)
+	}
+	if err != nil {
+		return err
+	}
+	if invoice == nil {
+		return entity.NewErrFailedPrecondition("the appointment has no invoice to credit the claim to")
+	}
+
+	for _, paid := range invoice.Payments {
+		if paid.Id == claim.Id {
+			return nil
+		}
+	}
+
+	_, err = r.Invoices.RecordTransaction(ctx, &billing.Transaction{
+		Id:        claim.Id,
+		InvoiceId: invoice.Id,
+		Kind:      billing.KindPayment,
+		Method:    billing.MethodInsurance,
+		Amount:    claim.Approved,
+		CashierId: claim.InsurerId,
+		Reference: "insurance claim " + claim.Id,
+	})
+	return err
+}
+
+func validateInsurer(req *insurance.Insurer) error {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid insurer")
+	if req.Name == "" {
+		errValidation.Errors["name"] = "is required"
+	}
+	if req.Code == "" {
+		errValidation.Errors["code"] = "is required"
+	}
+	if len(errValidation.Errors) > 0 {
+		return errValidation
+	}
+	return nil
+}
@@ -3,11 +3,21 @@ package usecase
 
 import (
 	"booking_service/internal/entity/archive"
+	"booking_service/internal/entity/billing"
 	appointment "booking_service/internal/entity/booked_appointments"
 	"booking_service/internal/entity/doctor_availability"
 	"booking_service/internal/entity/doctor_notes"
+	"booking_service/internal/entity/documents"
+	"booking_service/internal/entity/encounters"
+	"booking_service/internal/entity/history"
+	"booking_service/internal/entity/insurance"
+	"booking_service/internal/entity/lab_orders"
+	"booking_service/internal/entity/messages"
 	"booking_service/internal/entity/patients"
+	"booking_service/internal/entity/payments"
+	"booking_service/internal/entity/prescriptions"
 	"context"
+	"time"
 )
 
 //go:generate mockgen -source=interfaces.go -destination=./mocks_test.go -package=usecase_test
@@ -30,7 +40,32 @@ type (
 		GetAllAppointment(ctx context.Context, req *appointment.GetAllAppointment) (*appointment.AppointmentsType, error)
 		UpdateAppointment(ctx context.Context, req *appointment.UpdateAppointment) (*appointment.Appointment, error)
 		DeleteAppointment(ctx context.Context, req *appointment.FieldValueReq) (*appointment.StatusRes, error)
+		ConfirmAppointment(ctx context.Context, req *appointment.ConfirmAppointment) (*appointment.Appointment, error)
+		SetAppointmentStatus(ctx context.Context, req *appointment.AppointmentStatusReq) (*appointment.Appointment, error)
 	}
+	// OnlineAppointments -.
+	OnlineAppointments interface {
+		BookedAppointments
+		GetJoinLink(ctx context.Context, req *appointment.JoinLinkReq) (*appointment.JoinLink, error)
+	}
+
+	// AppointmentEvents tells other services about changes of appointments.
+	AppointmentEvents interface {
+		PublishAppointment(ctx context.Context, event *appointment.Event) error
+	}
+
+	// ServicePrices looks up the price in force of a doctor service.
+	ServicePrices interface {
+		EffectivePrice(ctx context.Context, doctorServiceId, modality string) (amount, currency string, err error)
+	}
+
+	// VideoRooms -.
+	VideoRooms interface {
+		Name() string
+		CreateRoom(ctx context.Context, appointmentId int64, startsAt, endsAt time.Time) (string, error)
+		JoinURL(ctx context.Context, roomId, participantId string, expiresAt time.Time) (string, error)
+	}
+
 	// DoctorNotes -.
 	DoctorNotes interface {
 		CreateDoctorNotes(ctx context.Context, req *doctor_notes.CreatedDoctorNote) (*doctor_notes.DoctorNote, error)
@@ -57,4 +92,251 @@ type (
 		UpdateDoctorAvailability(ctx context.Context, req *doctor_availability.UpdateDoctorAvailability) (*doctor_availability.DoctorAvailability, error)
 		DeleteDoctorAvailability(ctx context.Context, req *doctor_availability.FieldValueReq) (*doctor_availability.StatusRes, error)
 	}
+
+	// Encounters -.
+	Encounters interface {
+		CreateEncounter(ctx context.Context, req *encounters.Encounter) (*encounters.Encounter, error)
+		GetEncounter(ctx context.Context, req *encounters.FieldValueReq) (*encounters.Encounter, error)
+		UpdateEncounter(ctx context.Context, req *encounters.Encounter) (*encounters.Encounter, error)
+		DeleteEncounter(ctx context.Context, req *encounters.FieldValueReq) (*encounters.StatusRes, error)
+		GetPatientTimeline(ctx context.Context, req *encounters.TimelineReq) (*encounters.Timeline, error)
+	}
+
+	// Prescriptions -.
+	Prescriptions interface {
+		CreateMedication(ctx context.Context, req *prescriptions.Medication) (*prescriptions.Medication, error)
+		GetMedication(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.Medication, error)
+		GetAllMedications(ctx context.Context, req *prescriptions.GetAllMedications) (*prescriptions.MedicationsType, error)
+		UpdateMedication(ctx context.Context, req *prescriptions.Medication) (*prescriptions.Medication, error)
+		DeleteMedication(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.StatusRes, error)
+		CreateInteraction(ctx context.Context, req *prescriptions.Interaction) (*prescriptions.Interaction, error)
+		CreatePrescription(ctx context.Context, req *prescriptions.CreatePrescription) (*prescriptions.Prescription, error)
+		GetPrescription(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.Prescription, error)
+		GetAllPrescriptions(ctx context.Context, req *prescriptions.GetAllPrescriptions) (*prescriptions.PrescriptionsType, error)
+		RevokePrescription(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.Prescription, error)
+		RenderPrescription(ctx context.Context, id, doctorName string) (*prescriptions.Document, error)
+		VerifyPrescription(ctx context.Context, code string) (*prescriptions.Verification, error)
+	}
+
+	// PrescriptionEvents tells other services about issued prescriptions.
+	PrescriptionEvents interface {
+		PublishPrescription(ctx context.Context, prescription *prescriptions.Prescription) error
+	}
+
+	// PrescriptionsRepo is the storage the prescriptions use case works with.
+	PrescriptionsRepo interface {
+		CreateMedication(ctx context.Context, req *prescriptions.Medication) (*prescriptions.Medication, error)
+		GetMedication(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.Medication, error)
+		GetAllMedications(ctx context.Context, req *prescriptions.GetAllMedications) (*prescriptions.MedicationsType, error)
+		UpdateMedication(ctx context.Context, req *prescriptions.Medication) (*prescriptions.Medication, error)
+		DeleteMedication(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.StatusRes, error)
+		CreateInteraction(ctx context.Context, req *prescriptions.Interaction) (*prescriptions.Interaction, error)
+		GetInteractions(ctx context.Context, ingredients []string) ([]*prescriptions.Interaction, error)
+		GetPatientAllergies(ctx context.Context, patientId string) ([]*encounters.Allergy, error)
+		GetActiveIngredients(ctx context.Context, patientId string, at time.Time) ([]string, error)
+		GetAppointmentParties(ctx context.Context, appointmentId int64) (*prescriptions.Parties, error)
+		CreatePrescription(ctx context.Context, req *prescriptions.Prescription) (*prescriptions.Prescription, error)
+		GetPrescription(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.Prescription, error)
+		GetAllPrescriptions(ctx context.Context, req *prescriptions.GetAllPrescriptions) (*prescriptions.PrescriptionsType, error)
+		RevokePrescription(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.Prescription, error)
+	}
+
+	// PrescriptionDocuments signs and renders issued prescriptions.
+	PrescriptionDocuments interface {
+		NewCode() (string, error)
+		Sign(prescription *prescriptions.Prescription) string
+		Verify(prescription *prescriptions.Prescription) bool
+		Render(prescription *prescriptions.Prescription, doctorName string) (*prescriptions.Document, error)
+	}
+
+	// LabOrders -.
+	LabOrders interface {
+		CreateLabOrder(ctx context.Context, req *lab_orders.CreateLabOrder) (*lab_orders.LabOrder, error)
+		GetLabOrder(ctx context.Context, req *lab_orders.FieldValueReq) (*lab_orders.LabOrder, error)
+		GetAllLabOrders(ctx context.Context, req *lab_orders.GetAllLabOrders) (*lab_orders.LabOrdersType, error)
+		SubmitLabResults(ctx context.Context, req *lab_orders.SubmitResults) (*lab_orders.LabOrder, error)
+		ReleaseLabOrder(ctx context.Context, req *lab_orders.FieldValueReq) (*lab_orders.LabOrder, error)
+		CancelLabOrder(ctx context.Context, req *lab_orders.FieldValueReq) (*lab_orders.LabOrder, error)
+	}
+
+	// LabOrderEvents tells other services about released lab results.
+	LabOrderEvents interface {
+		PublishLabOrder(ctx context.Context, order *lab_orders.LabOrder) error
+	}
+
+	// LabOrdersRepo is the storage the lab orders use case works with.
+	LabOrdersRepo interface {
+		CreateLabOrder(ctx context.Context, req *lab_orders.CreateLabOrder) (*lab_orders.LabOrder, error)
+		GetLabOrder(ctx context.Context, req *lab_orders.FieldValueReq) (*lab_orders.LabOrder, error)
+		GetAllLabOrders(ctx context.Context, req *lab_orders.GetAllLabOrders) (*lab_orders.LabOrdersType, error)
+		SubmitLabResults(ctx context.Context, req *lab_orders.SubmitResults) (*lab_orders.LabOrder, error)
+		SetLabOrderStatus(ctx context.Context, req *lab_orders.StatusChange) (*lab_orders.LabOrder, error)
+	}
+
+	// Documents -.
+	Documents interface {
+		CreateDocument(ctx context.Context, req *documents.Document) (*documents.Document, error)
+		GetDocument(ctx context.Context, req *documents.FieldValueReq) (*documents.Document, error)
+		GetAllDocuments(ctx context.Context, req *documents.GetAllDocuments) (*documents.DocumentsType, error)
+		DeleteDocument(ctx context.Context, req *documents.FieldValueReq) (*documents.Document, error)
+	}
+
+	// Messages -.
+	Messages interface {
+		StartConversation(ctx context.Context, req *messages.StartConversation) (*messages.Conversation, error)
+		GetConversation(ctx context.Context, id, readerRole string) (*messages.Conversation, error)
+		GetAllConversations(ctx context.Context, req *messages.GetAllConversations) (*messages.ConversationsType, error)
+		SendMessage(ctx context.Context, req *messages.Message) (*messages.Message, error)
+		GetMessage(ctx context.Context, id string) (*messages.Message, error)
+		GetAllMessages(ctx context.Context, req *messages.GetAllMessages) (*messages.MessagesType, error)
+		MarkConversationRead(ctx context.Context, conversationId, readerRole string) (*messages.ReadReceipt, error)
+		GetReplyHours(ctx context.Context, doctorId string) (*messages.ReplyHours, error)
+		SetReplyHours(ctx context.Context, req *messages.ReplyHours) (*messages.ReplyHours, error)
+		PurgeExpiredMessages(ctx context.Context) (int64, error)
+	}
+
+	// MessageEvents tells other services about sent and read messages.
+	MessageEvents interface {
+		PublishMessage(ctx context.Context, event *messages.Event) error
+	}
+
+	// MessagesRepo is the storage the messages use case works with.
+	MessagesRepo interface {
+		StartConversation(ctx context.Context, req *messages.StartConversation) (*messages.Conversation, error)
+		GetConversation(ctx context.Context, id, readerRole string) (*messages.Conversation, error)
+		GetAllConversations(ctx context.Context, req *messages.GetAllConversations) (*messages.ConversationsType, error)
+		CreateMessage(ctx context.Context, req *messages.Message) (*messages.Message, error)
+		GetMessage(ctx context.Context, id string) (*messages.Message, error)
+		GetAllMessages(ctx context.Context, req *messages.GetAllMessages) (*messages.MessagesType, error)
+		MarkRead(ctx context.Context, conversationId, readerRole string, readAt time.Time) (int64, error)
+		GetReplyHours(ctx context.Context, doctorId string) (*messages.ReplyHours, error)
+		SetReplyHours(ctx context.Context, req *messages.ReplyHours) (*messages.ReplyHours, error)
+		PurgeMessages(ctx context.Context, before time.Time) (int64, error)
+	}
+
+	// Billing -.
+	Billing interface {
+		CreateInvoice(ctx context.Context, req *billing.CreateInvoice) (*billing.Invoice, error)
+		GetInvoice(ctx context.Context, req *billing.FieldValueReq) (*billing.Invoice, error)
+		GetAllInvoices(ctx context.Context, req *billing.GetAllInvoices) (*billing.InvoicesType, error)
+		AddInvoiceItem(ctx context.Context, req *billing.AddItem) (*billing.Invoice, error)
+		SetInvoiceDiscount(ctx context.Context, req *billing.SetDiscount) (*billing.Invoice, error)
+		RecordPayment(ctx context.Context, req *billing.Transaction) (*billing.Invoice, error)
+		RefundPayment(ctx context.Context, req *billing.Transaction) (*billing.Invoice, error)
+		VoidInvoice(ctx context.Context, req *billing.FieldValueReq) (*billing.Invoice, error)
+		RenderReceipt(ctx context.Context, req *billing.FieldValueReq) (*billing.Receipt, error)
+		GetCashDeskReport(ctx context.Context, req *billing.CashDeskReportReq) (*billing.CashDeskReport, error)
+	}
+
+	// BillingRepo is the storage the billing use case works with.
+	BillingRepo interface {
+		CreateInvoice(ctx context.Context, req *billing.CreateInvoice) (*billing.Invoice, error)
+		GetInvoice(ctx context.Context, req *billing.FieldValueReq) (*billing.Invoice, error)
+		GetAllInvoices(ctx context.Context, req *billing.GetAllInvoices) (*billing.InvoicesType, error)
+		AddInvoiceItem(ctx context.Context, req *billing.AddItem) (*billing.Invoice, error)
+		SetInvoiceDiscount(ctx context.Context, req *billing.SetDiscount) (*billing.Invoice, error)
+		RecordTransaction(ctx context.Context, req *billing.Transaction) (*billing.Invoice, error)
+		VoidInvoice(ctx context.Context, req *billing.FieldValueReq) (*billing.Invoice, error)
+		GetCashDeskReport(ctx context.Context, req *billing.CashDeskReportReq) (*billing.CashDeskReport, error)
+	}
+
+	// BillingReceipts renders invoice receipts.
+	BillingReceipts interface {
+		Render(invoice *billing.Invoice) (*billing.Receipt, error)
+	}
+
+	// Payments -.
+	Payments interface {
+		CreatePaymentIntent(ctx context.Context, req *payments.CreateIntent) (*payments.Intent, error)
+		GetPaymentIntent(ctx context.Context, req *payments.FieldValueReq) (*payments.Intent, error)
+		GetAllPaymentIntents(ctx context.Context, req *payments.GetAllIntents) (*payments.IntentsType, error)
+		HandleWebhook(ctx context.Context, req *payments.Webhook) (*payments.Intent, error)
+		ExpireHolds(ctx context.Context) (int, error)
+	}
+
+	// PaymentRepo is the storage the payments use case works with.
+	PaymentRepo interface {
+		CreateIntent(ctx context.Context, req *payments.CreateIntent) (*payments.Intent, error)
+		GetIntent(ctx context.Context, req *payments.FieldValueReq) (*payments.Intent, error)
+		GetAllIntents(ctx context.Context, req *payments.GetAllIntents) (*payments.IntentsType, error)
+		SetCheckout(ctx context.Context, id string, checkout *payments.Checkout) (*payments.Intent, error)
+		FinishIntent(ctx context.Context, req *payments.Outcome, from ...string) (*payments.Intent, bool, error)
+		GetExpiredIntents(ctx context.Context, now time.Time, limit uint64) ([]*payments.Intent, error)
+	}
+
+	// PaymentProvider -.
+	PaymentProvider interface {
+		Name() string
+		CreateCheckout(ctx context.Context, intent *payments.Intent) (*payments.Checkout, error)
+		ParseWebhook(payload []byte, signature string) (*payments.Event, error)
+	}
+
+	// InsuranceRepo is the storage the insurance use case works with.
+	InsuranceRepo interface {
+		CreateInsurer(ctx context.Context, req *insurance.Insurer) (*insurance.Insurer, error)
+		GetInsurer(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Insurer, error)
+		GetAllInsurers(ctx context.Context, req *insurance.GetAllReq) (*insurance.InsurersType, error)
+		UpdateInsurer(ctx context.Context, req *insurance.Insurer) (*insurance.Insurer, error)
+		DeleteInsurer(ctx context.Context, req *insurance.FieldValueReq) (*insurance.StatusRes, error)
+		CreatePolicy(ctx context.Context, req *insurance.Policy) (*insurance.Policy, error)
+		GetPolicy(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Policy, error)
+		GetAllPolicies(ctx context.Context, req *insurance.GetAllReq) (*insurance.PoliciesType, error)
+		CancelPolicy(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Policy, error)
+		SetCoverageRule(ctx context.Context, req *insurance.CoverageRule) (*insurance.CoverageRule, error)
+		GetAllCoverageRules(ctx context.Context, req *insurance.GetAllReq) (*insurance.CoverageRulesType, error)
+		DeleteCoverageRule(ctx context.Context, req *insurance.FieldValueReq) (*insurance.StatusRes, error)
+		CheckEligibility(ctx context.Context, req *insurance.EligibilityReq) (*insurance.Eligibility, error)
+		CreateClaim(ctx context.Context, req *insurance.CreateClaim) (*insurance.Claim, error)
+		GetClaim(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Claim, error)
+		GetAllClaims(ctx context.Context, req *insurance.GetAllClaims) (*insurance.ClaimsType, error)
+		SetClaimStatus(ctx context.Context, req *insurance.ClaimStatusReq) (*insurance.Claim, error)
+		ExportClaims(ctx context.Context, req *insurance.ExportClaims) (*insurance.ClaimBatch, []*insurance.Claim, error)
+		GetClaimBatch(ctx context.Context, req *insurance.FieldValueReq) (*insurance.ClaimBatch, error)
+	}
+
+	// ClaimExporter renders claim batches for insurers.
+	ClaimExporter interface {
+		Render(batch *insurance.ClaimBatch, claims []*insurance.Claim) (*insurance.ExportFile, error)
+	}
+
+	// Insurance -.
+	Insurance interface {
+		CreateInsurer(ctx context.Context, req *insurance.Insurer) (*insurance.Insurer, error)
+		GetInsurer(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Insurer, error)
+		GetAllInsurers(ctx context.Context, req *insurance.GetAllReq) (*insurance.InsurersType, error)
+		UpdateInsurer(ctx context.Context, req *insurance.Insurer) (*insurance.Insurer, error)
+		DeleteInsurer(ctx context.Context, req *insurance.FieldValueReq) (*insurance.StatusRes, error)
+		CreatePolicy(ctx context.Context, req *insurance.Policy) (*insurance.Policy, error)
+		GetPolicy(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Policy, error)
+		GetAllPolicies(ctx context.Context, req *insurance.GetAllReq) (*insurance.PoliciesType, error)
+		CancelPolicy(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Policy, error)
+		SetCoverageRule(ctx context.Context, req *insurance.CoverageRule) (*insurance.CoverageRule, error)
+		GetAllCoverageRules(ctx context.Context, req *insurance.GetAllReq) (*insurance.CoverageRulesType, error)
+		DeleteCoverageRule(ctx context.Context, req *insurance.FieldValueReq) (*insurance.StatusRes, error)
+		CheckEligibility(ctx context.Context, req *insurance.EligibilityReq) (*insurance.Eligibility, error)
+		CreateClaim(ctx context.Context, req *insurance.CreateClaim) (*insurance.Claim, error)
+		GetClaim(ctx context.Context, req *insurance.FieldValueReq) (*insurance.Claim, error)
+		GetAllClaims(ctx context.Context, req *insurance.GetAllClaims) (*insurance.ClaimsType, error)
+		SetClaimStatus(ctx context.Context, req *insurance.ClaimStatusReq) (*insurance.Claim, error)
+		ExportClaims(ctx context.Context, req *insurance.ExportClaims) (*insurance.ExportFile, error)
+		DownloadClaimBatch(ctx context.Context, req *insurance.FieldValueReq) (*insurance.ExportFile, error)
+	}
+
+	// HistoryRepo -.
+	HistoryRepo interface {
+		ImportHistory(ctx context.Context, patient *patients.CreatedPatient, records []*history.ExternalRecord) error
+		GetExternalRecords(ctx context.Context, patientId string) ([]*history.ExternalRecord, error)
+	}
+
+	// HistoryCodec converts medical histories to and from an interchange format.
+	HistoryCodec interface {
+		Export(h *history.History, at time.Time) (*history.ExportFile, error)
+		Import(content []byte, patientId string) (*history.Imported, error)
+	}
+
+	// History -.
+	History interface {
+		ExportHistory(ctx context.Context, req *history.ExportReq) (*history.ExportFile, error)
+		ImportHistory(ctx context.Context, req *history.ImportReq) (*history.ImportRes, error)
+	}
 )
@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/lab_orders"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	serviceNameLabOrders = "LabOrdersService"
+	spanNameLabOrders    = "LabOrdersUsecase"
+)
+
+// BookedLabOrdersUseCase -.
+type BookedLabOrdersUseCase struct {
+	Repo       LabOrdersRepo
+	events     LabOrderEvents
+	ctxTimeout time.Duration
+}
+
+// NewBookedLabOrders -.
+func NewBookedLabOrders(r LabOrdersRepo, events LabOrderEvents, ctxTimeout time.Duration) *BookedLabOrdersUseCase {
+	return &BookedLabOrdersUseCase{
+		Repo:       r,
+		events:     events,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+func (r *BookedLabOrdersUseCase) CreateLabOrder(ctx context.Context, req *lab_orders.CreateLabOrder) (*lab_orders.LabOrder, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameLabOrders, spanNameLabOrders+"Create")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid lab order")
+	if req.AppointmentId <= 0 {
+		errValidation.Errors["appointment_id"] = "is required"
+	}
+	if len(req.Tests) == 0 {
+		errValidation.Errors["tests"] = "at least one test is required"
+	}
+	for i, test := range req.Tests {
+		test.Code = strings.ToUpper(strings.TrimSpace(test.Code))
+		if test.Code == "" {
+			errValidation.Errors[fmt.Sprintf("tests[%d].code", i)] = "is required"
+		}
+		if strings.TrimSpace(test.Name) == "" {
+			errValidation.Errors[fmt.Sprintf("tests[%d].name", i)] = "is required"
+		}
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	return r.Repo.CreateLabOrder(ctx, req)
+}
+
+func (r *BookedLabOrdersUseCase) GetLabOrder(ctx context.Context, req *lab_orders.FieldValueReq) (*lab_orders.LabOrder, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameLabOrders, spanNameLabOrders+"Get")
+	span.End()
+
+	return r.Repo.GetLabOrder(ctx, req)
+}
+
+func (r *BookedLabOrdersUseCase) GetAllLabOrders(ctx context.Context, req *lab_orders.GetAllLabOrders) (*lab_orders.LabOrdersType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameLabOrders, spanNameLabOrders+"List")
+	span.End()
+
+	return r.Repo.GetAllLabOrders(ctx, req)
+}
+
+// SubmitLabResults stores the lab's results, flagging every value against
+// its reference range.
+func (r *BookedLabOrdersUseCase) SubmitLabResults(ctx context.Context, req *lab_orders.SubmitResults) (*lab_orders.LabOrder, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameLabOrders, spanNameLabOrders+"Submit")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid lab results")
+	if len(req.Results) == 0 && len(req.Attachments) == 0 {
+		errValidation.Errors["results"] = "results or attachments are required"
+	}
+	for i, result := range req.Results {
+		result.TestCode = strings.ToUpper(strings.TrimSpace(result.TestCode))
+		if result.TestCode == "" {
+			errValidation.Errors[fmt.Sprintf("results[%d].test_code", i)] = "is required"
+		}
+		if strings.TrimSpace(result.Analyte) == "" {
+			errValidation.Errors[fmt.Sprintf("results[%d].analyte", i)] = "is required"
+		}
+		low, high, err := parseReferenceRange(result.ReferenceRange)
+		if err != nil {
+			errValidation.Errors[fmt.Sprintf("results[%d].reference_range", i)] = err.Error()
+			continue
+		}
+		result.ReferenceLow, result.ReferenceHigh = low, high
+		result.Flag = flagResult(result.Value, low, high)
+	}
+	for i, attachment := range req.Attachments {
+		if strings.TrimSpace(attachment.Url) == "" {
+			errValidation.Errors[fmt.Sprintf("attachments[%d].url", i)] = "is required"
+		}
+		if strings.TrimSpace(attachment.FileName) == "" {
+			errValidation.Errors[fmt.Sprintf("attachments[%d].file_name", i)] = "is required"
+		}
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	return r.Repo.SubmitLabResults(ctx, req)
+}
+
+// ReleaseLabOrder makes resulted lab results visible to the patient, who is
+// told they are ready.
+func (r *BookedLabOrdersUseCase) ReleaseLabOrder(ctx context.Context, req *lab_orders.FieldValueReq) (*lab_orders.LabOrder, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameLabOrders, spanNameLabOrders+"Release")
+	span.End()
+
+	res, err := r.Repo.SetLabOrderStatus(ctx, &lab_orders.StatusChange{
+		Id:     req.Value,
+		Status: lab_orders.StatusReleased,
+		From:   []string{lab_orders.StatusResulted},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, res)
+
+	return res, nil
+}
+
+// publish tells other services about a released order. The release stands
+// when the event cannot be handed over; the failure is recorded on the span.
+func (r *BookedLabOrdersUseCase) publish(ctx context.Context, order *lab_orders.LabOrder) {
+	ctx, span := otlp.Start(ctx, serviceNameLabOrders, spanNameLabOrders+"Publish")
+	defer span.End()
+
+	if err := r.events.PublishLabOrder(ctx, order); err != nil {
+		span.Error(err)
+	}
+}
+
+// CancelLabOrder cancels an order the lab has not reported on yet.
+func (r *BookedLabOrdersUseCase) CancelLabOrder(ctx context.Context, req *lab_orders.FieldValueReq) (*lab_orders.LabOrder, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameLabOrders, spanNameLabOrders+"Cancel")
+	span.End()
+
+	return r.Repo.SetLabOrderStatus(ctx, &lab_orders.StatusChange{
+		Id:     req.Value,
+		Status: lab_orders.StatusCancelled,
+		From:   []string{lab_orders.StatusOrdered},
+	})
+}
+
+// parseReferenceRange reads the ranges labs print: "3.5-5.1", "<5.2", "<=5.2",
+// ">60" or ">=60". An empty range has no bounds.
+func parseReferenceRange(reference string) (low, high *float64, err error) {
+	reference = strings.ReplaceAll(strings.TrimSpace(reference), ",", ".")
+	if reference == "" {
+		return nil, nil, nil
+	}
+
+	parse := func(s string) (*float64, error) {
+		v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return nil, errors.New("must look like 3.5-5.1, <5.2 or >60")
+		}
+		return &v, nil
+	}
+
+	switch {
+	case strings.HasPrefix(reference, "<="), strings.HasPrefix(reference, ">="):
+		v, err := parse(reference[2:])
+		if err != nil {
+			return nil, nil, err
+		}
+		if reference[0] == '<' {
+			return nil, v, nil
+		}
+		return v, nil, nil
+	case strings.HasPrefix(reference, "<"), strings.HasPrefix(reference, ">"):
+		v, err := parse(reference[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		if reference[0] == '<' {
+			return nil, v, nil
+		}
+		return v, nil, nil
+	}
+
+	// the separator is the first dash after the first character, so a
+	// negative lower bound still parses
+	i := strings.Index(reference[1:], "-")
+	if i < 0 {
+		return nil, nil, errors.New("must look like 3.5-5.1, <5.2 or >60")
+	}
+	if low, err = parse(reference[:i+1]); err != nil {
+		return nil, nil, err
+	}
+	if high, err = parse(reference[i+2:]); err != nil {
+		return nil, nil, err
+	}
+	if *low > *high {
+		return nil, nil, errors.New("lower bound is above the upper bound")
+	}
+	return low, high, nil
+}
+
+func flagResult(value float64, low, high *float64) string {
+	switch {
+	case low == nil && high == nil:
+		return ""
+	case low != nil && value < *low:
+		return lab_orders.FlagLow
+	case high != nil && value > *high:
+		return lab_orders.FlagHigh
+	}
+	return lab_orders.FlagNormal
+}
@@ -0,0 +1,358 @@
+package usecase
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/messages"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	serviceNameMessages = "MessagesService"
+	spanNameMessages    = "MessagesUsecase"
+)
+
+// BookedMessagesUseCase keeps the conversations of patients and doctors.
+// Reply windows are read in location; messages older than retention are
+// purged.
+type BookedMessagesUseCase struct {
+	Repo       MessagesRepo
+	events     MessageEvents
+	location   *time.Location
+	retention  time.Duration
+	ctxTimeout time.Duration
+}
+
+// NewBookedMessages -.
+func NewBookedMessages(r MessagesRepo, events MessageEvents, location *time.Location, retention, ctxTimeout time.Duration) *BookedMessagesUseCase {
+	return &BookedMessagesUseCase{
+		Repo:       r,
+		events:     events,
+		location:   location,
+		retention:  retention,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+func (r *BookedMessagesUseCase) StartConversation(ctx context.Context, req *messages.StartConversation) (*messages.Conversation, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessages+"Start")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid conversation")
+	if _, err := uuid.Parse(req.PatientId); err != nil {
+		errValidation.Errors["patient_id"] = "must be a uuid"
+	}
+	if _, err := uuid.Parse(req.DoctorId); err != nil {
+		errValidation.Errors["doctor_id"] = "must be a uuid"
+	}
+	if req.AppointmentId < 0 {
+		errValidation.Errors["appointment_id"] = "must not be negative"
+	}
+	if !validMessageRole(req.ReaderRole) {
+		errValidation.Errors["reader_role"] = "must be patient or doctor"
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	req.Id = uuid.NewString()
+	conversation, err := r.Repo.StartConversation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withReplyHours(ctx, conversation, nil)
+}
+
+func (r *BookedMessagesUseCase) GetConversation(ctx context.Context, id, readerRole string) (*messages.Conversation, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessages+"GetConversation")
+	span.End()
+
+	if err := validateReader(id, readerRole); err != nil {
+		return nil, err
+	}
+
+	conversation, err := r.Repo.GetConversation(ctx, id, readerRole)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withReplyHours(ctx, conversation, nil)
+}
+
+func (r *BookedMessagesUseCase) GetAllConversations(ctx context.Context, req *messages.GetAllConversations) (*messages.ConversationsType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessages+"ListConversations")
+	span.End()
+
+	if !validMessageRole(req.ReaderRole) {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("invalid conversations")
+		errValidation.Errors["reader_role"] = "must be patient or doctor"
+		return nil, errValidation
+	}
+
+	list, err := r.Repo.GetAllConversations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	hours := map[string]*messages.ReplyHours{}
+	for _, conversation := range list.Conversations {
+		if _, err := r.withReplyHours(ctx, conversation, hours); err != nil {
+			return nil, err
+		}
+	}
+
+	return list, nil
+}
+
+// withReplyHours tells when the doctor of a conversation answers, reading
+// their windows once per doctor into known when given.
+func (r *BookedMessagesUseCase) withReplyHours(ctx context.Context, conversation *messages.Conversation,
+	known map[string]*messages.ReplyHours) (*messages.Conversation, error) {
+	hours, ok := known[conversation.DoctorId]
+	if !ok {
+		var err error
+		if hours, err = r.Repo.GetReplyHours(ctx, conversation.DoctorId); err != nil {
+			return nil, err
+		}
+		if known != nil {
+			known[conversation.DoctorId] = hours
+		}
+	}
+
+	now := time.Now().In(r.location)
+	if from, ok := messages.NextReply(hours.Windows, now); ok {
+		conversation.RepliesFrom = from
+		conversation.DoctorAvailable = from.Equal(now)
+	}
+
+	return conversation, nil
+}
+
+// SendMessage adds a message of the patient or the doctor of a conversation
+// to it and tells the other side.
+func (r *BookedMessagesUseCase) SendMessage(ctx context.Context, req *messages.Message) (*messages.Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessages+"Send")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid message")
+	if _, err := uuid.Parse(req.ConversationId); err != nil {
+		errValidation.Errors["conversation_id"] = "must be a uuid"
+	}
+	if req.SenderId == "" {
+		errValidation.Errors["sender_id"] = "is required"
+	}
+	if !validMessageRole(req.SenderRole) {
+		errValidation.Errors["sender_role"] = "must be patient or doctor"
+	}
+	req.Body = strings.TrimSpace(req.Body)
+	if req.Body == "" && req.Attachment == nil {
+		errValidation.Errors["body"] = "is required without an attachment"
+	}
+	if utf8.RuneCountInString(req.Body) > messages.MaxBodyLength {
+		errValidation.Errors["body"] = "is too long"
+	}
+	if attachment := req.Attachment; attachment != nil {
+		if attachment.Bucket == "" || attachment.ObjectKey == "" {
+			errValidation.Errors["attachment"] = "object_key is required"
+		}
+		if attachment.SizeBytes <= 0 {
+			errValidation.Errors["attachment"] = "size_bytes must be positive"
+		}
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	conversation, err := r.Repo.GetConversation(ctx, req.ConversationId, req.SenderRole)
+	if err != nil {
+		return nil, err
+	}
+	if req.SenderRole == messages.RoleDoctor && req.SenderId != conversation.DoctorId {
+		return nil, entity.NewErrFailedPrecondition("the doctor of the conversation sends its doctor messages")
+	}
+
+	req.Id = uuid.NewString()
+	message, err := r.Repo.CreateMessage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, &messages.Event{
+		Type:         messages.EventSent,
+		Conversation: conversation,
+		MessageId:    message.Id,
+		SenderRole:   message.SenderRole,
+		OccurredAt:   time.Now(),
+	})
+
+	return message, nil
+}
+
+func (r *BookedMessagesUseCase) GetMessage(ctx context.Context, id string) (*messages.Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessages+"GetMessage")
+	span.End()
+
+	return r.Repo.GetMessage(ctx, id)
+}
+
+func (r *BookedMessagesUseCase) GetAllMessages(ctx context.Context, req *messages.GetAllMessages) (*messages.MessagesType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessages+"ListMessages")
+	span.End()
+
+	return r.Repo.GetAllMessages(ctx, req)
+}
+
+// MarkConversationRead marks the messages of the other side read and, when
+// there were any, tells their sender.
+func (r *BookedMessagesUseCase) MarkConversationRead(ctx context.Context, conversationId, readerRole string) (*messages.ReadReceipt, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessages+"MarkRead")
+	span.End()
+
+	if err := validateReader(conversationId, readerRole); err != nil {
+		return nil, err
+	}
+
+	conversation, err := r.Repo.GetConversation(ctx, conversationId, readerRole)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt := &messages.ReadReceipt{
+		ConversationId: conversationId,
+		ReaderRole:     readerRole,
+		ReadAt:         time.Now(),
+	}
+	if receipt.Read, err = r.Repo.MarkRead(ctx, conversationId, readerRole, receipt.ReadAt); err != nil {
+		return nil, err
+	}
+
+	if receipt.Read > 0 {
+		r.publish(ctx, &messages.Event{
+			Type:         messages.EventRead,
+			Conversation: conversation,
+			ReaderRole:   readerRole,
+			OccurredAt:   receipt.ReadAt,
+		})
+	}
+
+	return receipt, nil
+}
+
+func (r *BookedMessagesUseCase) GetReplyHours(ctx context.Context, doctorId string) (*messages.ReplyHours, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessages+"GetReplyHours")
+	span.End()
+
+	return r.Repo.GetReplyHours(ctx, doctorId)
+}
+
+// SetReplyHours replaces the reply windows of a doctor. Windows of one day
+// must not overlap.
+func (r *BookedMessagesUseCase) SetReplyHours(ctx context.Context, req *messages.ReplyHours) (*messages.ReplyHours, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessages+"SetReplyHours")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid reply hours")
+	if _, err := uuid.Parse(req.DoctorId); err != nil {
+		errValidation.Errors["doctor_id"] = "must be a uuid"
+	}
+	for i, window := range req.Windows {
+		if window.Weekday < time.Sunday || window.Weekday > time.Saturday {
+			errValidation.Errors["windows"] = "weekday must be from 0 to 6"
+			continue
+		}
+		if window.EndsAt <= window.StartsAt {
+			errValidation.Errors["windows"] = "must end after they start"
+			continue
+		}
+		for _, other := range req.Windows[:i] {
+			if other.Weekday == window.Weekday && other.StartsAt < window.EndsAt && window.StartsAt < other.EndsAt {
+				errValidation.Errors["windows"] = "must not overlap"
+			}
+		}
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	return r.Repo.SetReplyHours(ctx, req)
+}
+
+// PurgeExpiredMessages deletes the messages older than the retention period.
+func (r *BookedMessagesUseCase) PurgeExpiredMessages(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessages+"Purge")
+	defer span.End()
+
+	return r.Repo.PurgeMessages(ctx, time.Now().Add(-r.retention))
+}
+
+// publish tells other services about a conversation change. The change
+// stands when the event cannot be handed over; the failure is recorded on
+// the span.
+func (r *BookedMessagesUseCase) publish(ctx context.Context, event *messages.Event) {
+	ctx, span := otlp.Start(ctx, serviceNameMessages, spanNameMessages+"Publish")
+	defer span.End()
+
+	if err := r.events.PublishMessage(ctx, event); err != nil {
+		span.Error(err)
+	}
+}
+
+func validateReader(conversationId, readerRole string) error {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid conversation")
+	if _, err := uuid.Parse(conversationId); err != nil {
+		errValidation.Errors["conversation_id"] = "must be a uuid"
+	}
+	if !validMessageRole(readerRole) {
+		errValidation.Errors["reader_role"] = "must be patient or doctor"
+	}
+	if len(errValidation.Errors) > 0 {
+		return errValidation
+	}
+	return nil
+}
+
+func validMessageRole(role string) bool {
+	return role == messages.RolePatient || role == messages.RoleDoctor
+}
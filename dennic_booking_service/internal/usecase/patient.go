@@ -1,10 +1,15 @@
 package usecase
 
 import (
+	"booking_service/internal/entity"
 	"booking_service/internal/entity/patients"
 	"booking_service/internal/pkg/otlp"
 	"context"
+	"errors"
+	"strings"
 	"time"
+
+	"github.com/rickb777/date"
 )
 
 const (
@@ -33,6 +38,19 @@ func (r *BookedPatientUseCase) CreatePatient(ctx context.Context, req *patients.
 	ctx, span := otlp.Start(ctx, serviceNamePatient, spanNamePatient+"Create")
 	defer span.End()
 
+	if req.Relationship == "" {
+		req.Relationship = patients.RelationshipSelf
+	}
+
+	errValidation := validatePatient(req.FirstName, req.LastName, req.Gender, req.BloodGroup, req.BirthDate)
+	if strings.TrimSpace(req.PhoneNumber) == "" {
+		errValidation.Errors["phone_number"] = "is required"
+	}
+	validateRelationship(errValidation, req.Relationship, req.Consent)
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
 	return r.Repo.CreatePatient(ctx, req)
 }
 
@@ -63,6 +81,14 @@ func (r *BookedPatientUseCase) UpdatePatient(ctx context.Context, req *patients.
 	ctx, span := otlp.Start(ctx, serviceNamePatient, spanNamePatient+"Update")
 	span.End()
 
+	errValidation := validatePatient(req.FirstName, req.LastName, req.Gender, req.BloodGroup, req.BirthDate)
+	if req.Relationship != "" {
+		validateRelationship(errValidation, req.Relationship, req.Consent)
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
 	return r.Repo.UpdatePatient(ctx, req)
 }
 
@@ -85,3 +111,45 @@ func (r *BookedPatientUseCase) DeletePatient(ctx context.Context, req *patients.
 
 	return r.Repo.DeletePatient(ctx, req)
 }
+
+// validatePatient checks the fields a patient profile cannot be stored without.
+func validatePatient(firstName, lastName, gender, bloodGroup string, birthDate date.Date) *entity.ErrValidation {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid patient profile")
+
+	if strings.TrimSpace(firstName) == "" {
+		errValidation.Errors["first_name"] = "is required"
+	}
+	if strings.TrimSpace(lastName) == "" {
+		errValidation.Errors["last_name"] = "is required"
+	}
+	switch gender {
+	case "male", "female", "other":
+	default:
+		errValidation.Errors["gender"] = "must be male, female or other"
+	}
+	switch bloodGroup {
+	case "A+", "A-", "B+", "B-", "AB+", "AB-", "O+", "O-":
+	default:
+		errValidation.Errors["blood_group"] = "must be one of A+, A-, B+, B-, AB+, AB-, O+, O-"
+	}
+	if birthDate.IsZero() || birthDate.After(date.Today()) {
+		errValidation.Errors["birth_date"] = "must be a date in the past"
+	}
+
+	return errValidation
+}
+
+// validateRelationship requires the account holder's consent before they
+// manage somebody else's profile.
+func validateRelationship(errValidation *entity.ErrValidation, relationship string, consent bool) {
+	switch relationship {
+	case patients.RelationshipSelf:
+	case patients.RelationshipChild, patients.RelationshipParent, patients.RelationshipSpouse, patients.RelationshipOther:
+		if !consent {
+			errValidation.Errors["consent"] = "is required to manage a dependent's profile"
+		}
+	default:
+		errValidation.Errors["relationship"] = "must be self, child, parent, spouse or other"
+	}
+}
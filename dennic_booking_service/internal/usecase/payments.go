@@ -0,0 +1,334 @@
+package usecase
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/billing"
+	appointment "booking_service/internal/entity/booked_appointments"
+	"booking_service/internal/entity/payments"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	serviceNamePayments = "PaymentService"
+	spanNamePayments    = "PaymentUsecase"
+
+	// expireBatch bounds the holds released by one sweep.
+	expireBatch = 100
+)
+
+// BookedPaymentsUseCase -.
+type BookedPaymentsUseCase struct {
+	Repo         PaymentRepo
+	Appointments BookedAppointments
+	Invoices     BillingRepo
+	Provider     PaymentProvider
+	holdTTL      time.Duration
+	ctxTimeout   time.Duration
+}
+
+// NewBookedPayments -.
+func NewBookedPayments(r PaymentRepo, appointments BookedAppointments, invoices BillingRepo, provider PaymentProvider, holdTTL, ctxTimeout time.Duration) *BookedPaymentsUseCase {
+	return &BookedPaymentsUseCase{
+		Repo:         r,
+		Appointments: appointments,
+		Invoices:     invoices,
+		Provider:     provider,
+		holdTTL:      holdTTL,
+		ctxTimeout:   ctxTimeout,
+	}
+}
+
+// CreatePaymentIntent starts the prepayment of a held appointment and opens
+// a checkout with the provider. A retry with the same idempotency key returns
+// the first intent, opening its checkout if the provider failed before.
+func (r *BookedPaymentsUseCase) CreatePaymentIntent(ctx context.Context, req *payments.CreateIntent) (*payments.Intent, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePayments, spanNamePayments+"Create")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid payment")
+	if req.AppointmentId <= 0 {
+		errValidation.Errors["appointment_id"] = "is required"
+	}
+	if req.PatientId == "" {
+		errValidation.Errors["patient_id"] = "is required"
+	}
+	if req.IdempotencyKey == "" {
+		errValidation.Errors["idempotency_key"] = "is required"
+	}
+	if len(req.IdempotencyKey) > 255 {
+		errValidation.Errors["idempotency_key"] = "must be at most 255 characters"
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	req.Provider = r.Provider.Name()
+	req.ExpiresAt = time.Now().Add(r.holdTTL)
+
+	intent, err := r.Repo.CreateIntent(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if intent.ProviderRef != "" || intent.Status != payments.StatusPending {
+		return intent, nil
+	}
+
+	checkout, err := r.Provider.CreateCheckout(ctx, intent)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Repo.SetCheckout(ctx, intent.Id, checkout)
+}
+
+func (r *BookedPaymentsUseCase) GetPaymentIntent(ctx context.Context, req *payments.FieldValueReq) (*payments.Intent, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePayments, spanNamePayments+"Get")
+	span.End()
+
+	return r.Repo.GetIntent(ctx, req)
+}
+
+func (r *BookedPaymentsUseCase) GetAllPaymentIntents(ctx context.Context, req *payments.GetAllIntents) (*payments.IntentsType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePayments, spanNamePayments+"List")
+	span.End()
+
+	return r.Repo.GetAllIntents(ctx, req)
+}
+
+// HandleWebhook applies a provider callback: a successful payment confirms
+// the booking and is recorded on its invoice, a failed one releases the hold.
+// Providers redeliver until they get an answer, so every step tolerates
+// having run before.
+func (r *BookedPaymentsUseCase) HandleWebhook(ctx context.Context, req *payments.Webhook) (*payments.Intent, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePayments, spanNamePayments+"Webhook")
+	span.End()
+
+	if req.Provider != r.Provider.Name() {
+		return nil, entity.NewErrNotFound("payment provider " + req.Provider)
+	}
+
+	event, err := r.Provider.ParseWebhook(req.Payload, req.Signature)
+	if errors.Is(err, payments.ErrInvalidSignature) {
+		return nil, entity.NewErrPermissionDenied("payment webhook")
+	}
+	if err != nil {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("invalid payment webhook")
+		errValidation.Errors["payload"] = err.Error()
+		return nil, errValidation
+	}
+
+	intent, err := r.Repo.GetIntent(ctx, &payments.FieldValueReq{Field: "provider_ref", Value: event.ProviderRef})
+	if err != nil {
+		return nil, err
+	}
+
+	if intent.Status != payments.StatusPending {
+		// Money arriving for a hold that was already given up has to go back.
+		if event.Status == payments.EventSucceeded && (intent.Status == payments.StatusFailed || intent.Status == payments.StatusExpired) {
+			intent, _, err = r.Repo.FinishIntent(ctx, &payments.Outcome{
+				Id:            intent.Id,
+				Status:        payments.StatusRefundRequired,
+				FailureReason: "paid after the booking hold was released",
+			}, intent.Status)
+		}
+		return intent, err
+	}
+
+	if event.Status == payments.EventFailed {
+		if err = r.release(ctx, intent); err != nil {
+			return nil, err
+		}
+		return r.finish(ctx, intent, payments.StatusFailed, event.Reason, "")
+	}
+
+	if event.Amount != intent.Amount || (event.Currency != "" && event.Currency != intent.Currency) {
+		if err = r.release(ctx, intent); err != nil {
+			return nil, err
+		}
+		return r.finish(ctx, intent, payments.StatusRefundRequired, "the paid amount differs from the booking price", "")
+	}
+
+	booked, err := r.Appointments.GetAppointment(ctx, &appointment.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.FormatInt(intent.AppointmentId, 10),
+		DeleteStatus: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !booked.DeletedAt.IsZero() {
+		return r.finish(ctx, intent, payments.StatusRefundRequired, "the booking was cancelled before the payment", "")
+	}
+
+	if _, err = r.Appointments.ConfirmAppointment(ctx, &appointment.ConfirmAppointment{Id: intent.AppointmentId}); err != nil {
+		return nil, err
+	}
+
+	invoice, err := r.settle(ctx, intent)
+	var errPrecond *entity.ErrFailedPrecondition
+	if errors.As(err, &errPrecond) {
+		// The booking stays confirmed; the desk sorts out the invoice.
+		return r.finish(ctx, intent, payments.StatusRefundRequired, err.Error(), "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r.finish(ctx, intent, payments.StatusSucceeded, "", invoice.Id)
+}
+
+// ExpireHolds gives up pending payments whose hold ran out and releases
+// their bookings. It returns the number of holds released.
+func (r *BookedPaymentsUseCase) ExpireHolds(ctx context.Context) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePayments, spanNamePayments+"Expire")
+	span.End()
+
+	expired, err := r.Repo.GetExpiredIntents(ctx, time.Now(), expireBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for _, intent := range expired {
+		// The intent is given up first, so a payment completing meanwhile
+		// is turned into a refund instead of confirming a released slot.
+		_, changed, err := r.Repo.FinishIntent(ctx, &payments.Outcome{
+			Id:            intent.Id,
+			Status:        payments.StatusExpired,
+			FailureReason: "the booking hold expired",
+		}, payments.StatusPending)
+		if err != nil {
+			return released, err
+		}
+		if !changed {
+			continue
+		}
+		if err = r.release(ctx, intent); err != nil {
+			return released, err
+		}
+		released++
+	}
+
+	return released, nil
+}
+
+func (r *BookedPaymentsUseCase) finish(ctx context.Context, intent *payments.Intent, status, reason, invoiceId string) (*payments.Intent, error) {
+	finished, changed, err := r.Repo.FinishIntent(ctx, &payments.Outcome{
+		Id:            intent.Id,
+		Status:        status,
+		FailureReason: reason,
+		InvoiceId:     invoiceId,
+	}, payments.StatusPending)
+	if err != nil {
+		return nil, err
+	}
+	if !changed && status == payments.StatusSucceeded &&
+		(finished.Status == payments.StatusExpired || finished.Status == payments.StatusFailed) {
+		// the hold expired while the payment was being recorded
+		finished, _, err = r.Repo.FinishIntent(ctx, &payments.Outcome{
+			Id:            intent.Id,
+			Status:        payments.StatusRefundRequired,
+			FailureReason: "paid after the booking hold was released",
+			InvoiceId:     invoiceId,
+		}, finished.Status)
+	}
+	return finished, err
+}
+
+// release cancels the booking of an intent unless it has been confirmed
+// some other way, freeing the doctor and any reserved resource.
+func (r *BookedPaymentsUseCase) release(ctx context.Context, intent *payments.Intent) error {
+	booked, err := r.Appointments.GetAppointment(ctx, &appointment.FieldValueReq{
+		Field:        "id",
+		Value:        strconv.FormatInt(intent.AppointmentId, 10),
+		DeleteStatus: true,
+	})
+	if err != nil {
+		return err
+	}
+	if booked.PatientStatus || !booked.DeletedAt.IsZero() {
+		return nil
+	}
+
+	_, err = r.Appointments.DeleteAppointment(ctx, &appointment.FieldValueReq{
+		Field: "id",
+		Value: strconv.FormatInt(intent.AppointmentId, 10),
+	})
+	return err
+}
+
+// settle records the payment on the appointment's invoice, issuing the
+// invoice first if the desk has not. The payment takes the intent id, so a
+// redelivered webhook does not record it twice.
+func (r *BookedPaymentsUseCase) settle(ctx context.Context, intent *payments.Intent) (*billing.Invoice, error) {
+	invoice, err := openInvoice(ctx, r.Invoices, intent.AppointmentId)
+	if err != nil {
+		return nil, err
+	}
+	if invoice == nil {
+		invoice, err = r.Invoices.CreateInvoice(ctx, &billing.CreateInvoice{
+			Id:            uuid.New().String(),
+			AppointmentId: intent.AppointmentId,
+			CreatedBy:     intent.Provider,
+		})
+		if errors.Is(err, entity.ErrorConflict) {
+			invoice, err = openInvoice(ctx, r.Invoices, intent.AppointmentId)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, paid := range invoice.Payments {
+		if paid.Id == intent.Id {
+			return invoice, nil
+		}
+	}
+
+	return r.Invoices.RecordTransaction(ctx, &billing.Transaction{
+		Id:        intent.Id,
+		InvoiceId: invoice.Id,
+		Kind:      billing.KindPayment,
+		Method:    billing.MethodOnline,
+		Amount:    intent.Amount,
+		CashierId: intent.Provider,
+		Reference: intent.ProviderRef,
+	})
+}
+
+// openInvoice returns the invoice of the appointment that is not void, if any.
+func openInvoice(ctx context.Context, invoices BillingRepo, appointmentId int64) (*billing.Invoice, error) {
+	list, err := invoices.GetAllInvoices(ctx, &billing.GetAllInvoices{AppointmentId: appointmentId})
+	if err != nil {
+		return nil, err
+	}
+	for _, invoice := range list.Invoices {
+		if invoice.Status != billing.StatusVoid {
+			return invoice, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,432 @@
+package usecase
+
+import (
+	"booking_service/internal/entity"
+	"booking_service/internal/entity/encounters"
+	"booking_service/internal/entity/prescriptions"
+	"booking_service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	serviceNamePrescriptions = "PrescriptionsService"
+	spanNamePrescriptions    = "PrescriptionsUsecase"
+)
+
+var prescriptionRoutes = map[string]bool{
+	prescriptions.RouteOral:          true,
+	prescriptions.RouteSublingual:    true,
+	prescriptions.RouteTopical:       true,
+	prescriptions.RouteInhaled:       true,
+	prescriptions.RouteNasal:         true,
+	prescriptions.RouteOphthalmic:    true,
+	prescriptions.RouteOtic:          true,
+	prescriptions.RouteRectal:        true,
+	prescriptions.RouteIntravenous:   true,
+	prescriptions.RouteIntramuscular: true,
+	prescriptions.RouteSubcutaneous:  true,
+}
+
+// BookedPrescriptionsUseCase -.
+type BookedPrescriptionsUseCase struct {
+	Repo       PrescriptionsRepo
+	documents  PrescriptionDocuments
+	events     PrescriptionEvents
+	ctxTimeout time.Duration
+}
+
+// NewBookedPrescriptions -.
+func NewBookedPrescriptions(r PrescriptionsRepo, documents PrescriptionDocuments, events PrescriptionEvents, ctxTimeout time.Duration) *BookedPrescriptionsUseCase {
+	return &BookedPrescriptionsUseCase{
+		Repo:       r,
+		documents:  documents,
+		events:     events,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+func (r *BookedPrescriptionsUseCase) CreateMedication(ctx context.Context, req *prescriptions.Medication) (*prescriptions.Medication, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptions+"MedicationCreate")
+	span.End()
+
+	if err := validateMedication(req); err != nil {
+		return nil, err
+	}
+
+	return r.Repo.CreateMedication(ctx, req)
+}
+
+func (r *BookedPrescriptionsUseCase) GetMedication(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.Medication, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptions+"MedicationGet")
+	span.End()
+
+	return r.Repo.GetMedication(ctx, req)
+}
+
+func (r *BookedPrescriptionsUseCase) GetAllMedications(ctx context.Context, req *prescriptions.GetAllMedications) (*prescriptions.MedicationsType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptions+"MedicationList")
+	span.End()
+
+	return r.Repo.GetAllMedications(ctx, req)
+}
+
+func (r *BookedPrescriptionsUseCase) UpdateMedication(ctx context.Context, req *prescriptions.Medication) (*prescriptions.Medication, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptions+"MedicationUpdate")
+	span.End()
+
+	if err := validateMedication(req); err != nil {
+		return nil, err
+	}
+
+	return r.Repo.UpdateMedication(ctx, req)
+}
+
+func (r *BookedPrescriptionsUseCase) DeleteMedication(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.StatusRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptions+"MedicationDelete")
+	span.End()
+
+	return r.Repo.DeleteMedication(ctx, req)
+}
+
+func (r *BookedPrescriptionsUseCase) CreateInteraction(ctx context.Context, req *prescriptions.Interaction) (*prescriptions.Interaction, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptions+"InteractionCreate")
+	span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid interaction")
+	a, b := strings.ToLower(strings.TrimSpace(req.IngredientA)), strings.ToLower(strings.TrimSpace(req.IngredientB))
+	if a == "" || b == "" {
+		errValidation.Errors["ingredients"] = "both ingredients are required"
+	} else if a == b {
+		errValidation.Errors["ingredients"] = "must be two different ingredients"
+	}
+	if !validSeverity(req.Severity) {
+		errValidation.Errors["severity"] = "must be mild, moderate or severe"
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	return r.Repo.CreateInteraction(ctx, req)
+}
+
+// CreatePrescription issues a prescription for an appointment. Items are
+// filled in from the catalogue, and allergies of the patient and interactions
+// with the new and still running medications are attached as warnings; they
+// are shown to the doctor but do not block issuing.
+func (r *BookedPrescriptionsUseCase) CreatePrescription(ctx context.Context, req *prescriptions.CreatePrescription) (*prescriptions.Prescription, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptions+"Create")
+	span.End()
+
+	if err := validatePrescription(req); err != nil {
+		return nil, err
+	}
+
+	parties, err := r.Repo.GetAppointmentParties(ctx, req.AppointmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, item := range req.Items {
+		medication, err := r.Repo.GetMedication(ctx, &prescriptions.FieldValueReq{Field: "id", Value: item.MedicationId})
+		if errors.Is(err, entity.ErrorNotFound) {
+			errValidation := entity.NewErrValidation()
+			errValidation.Err = err
+			errValidation.Errors[fmt.Sprintf("items[%d].medication_id", i)] = "is not in the catalogue"
+			return nil, errValidation
+		}
+		if err != nil {
+			return nil, err
+		}
+		item.MedicationName = medication.Name
+		item.Form = medication.Form
+		item.Strength = medication.Strength
+		item.ActiveIngredient = medication.ActiveIngredient
+	}
+
+	// stored without time zone, so keep it in UTC for the signature to survive the round trip
+	issuedAt := time.Now().UTC().Truncate(time.Second)
+
+	warnings, err := r.warnings(ctx, parties.PatientId, req.Items, issuedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := r.documents.NewCode()
+	if err != nil {
+		return nil, err
+	}
+
+	prescription := &prescriptions.Prescription{
+		Id:               uuid.NewString(),
+		AppointmentId:    req.AppointmentId,
+		PatientId:        parties.PatientId,
+		DoctorId:         parties.DoctorId,
+		Items:            req.Items,
+		Warnings:         warnings,
+		VerificationCode: code,
+		IssuedAt:         issuedAt,
+	}
+	prescription.Signature = r.documents.Sign(prescription)
+
+	res, err := r.Repo.CreatePrescription(ctx, prescription)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, res)
+
+	return res, nil
+}
+
+// publish tells other services about an issued prescription. The
+// prescription stands when the event cannot be handed over; the failure is
+// recorded on the span.
+func (r *BookedPrescriptionsUseCase) publish(ctx context.Context, prescription *prescriptions.Prescription) {
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptions+"Publish")
+	defer span.End()
+
+	if err := r.events.PublishPrescription(ctx, prescription); err != nil {
+		span.Error(err)
+	}
+}
+
+func (r *BookedPrescriptionsUseCase) GetPrescription(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.Prescription, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptions+"Get")
+	span.End()
+
+	return r.Repo.GetPrescription(ctx, req)
+}
+
+func (r *BookedPrescriptionsUseCase) GetAllPrescriptions(ctx context.Context, req *prescriptions.GetAllPrescriptions) (*prescriptions.PrescriptionsType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptions+"List")
+	span.End()
+
+	return r.Repo.GetAllPrescriptions(ctx, req)
+}
+
+func (r *BookedPrescriptionsUseCase) RevokePrescription(ctx context.Context, req *prescriptions.FieldValueReq) (*prescriptions.Prescription, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptions+"Revoke")
+	span.End()
+
+	return r.Repo.RevokePrescription(ctx, req)
+}
+
+func (r *BookedPrescriptionsUseCase) RenderPrescription(ctx context.Context, id, doctorName string) (*prescriptions.Document, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptions+"Render")
+	span.End()
+
+	prescription, err := r.Repo.GetPrescription(ctx, &prescriptions.FieldValueReq{Field: "id", Value: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.documents.Render(prescription, doctorName)
+}
+
+// VerifyPrescription is what a pharmacy calls with the printed code. It only
+// discloses the patient's initials.
+func (r *BookedPrescriptionsUseCase) VerifyPrescription(ctx context.Context, code string) (*prescriptions.Verification, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNamePrescriptions, spanNamePrescriptions+"Verify")
+	span.End()
+
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return nil, entity.NewErrNoRequiredParameter("code")
+	}
+
+	prescription, err := r.Repo.GetPrescription(ctx, &prescriptions.FieldValueReq{Field: "verification_code", Value: code})
+	if err != nil {
+		return nil, err
+	}
+
+	verification := &prescriptions.Verification{
+		Valid:           r.documents.Verify(prescription),
+		Revoked:         !prescription.RevokedAt.IsZero(),
+		IssuedAt:        prescription.IssuedAt,
+		DoctorId:        prescription.DoctorId,
+		PatientInitials: initials(prescription.PatientName),
+	}
+	if verification.Valid {
+		verification.Items = prescription.Items
+	}
+
+	return verification, nil
+}
+
+func (r *BookedPrescriptionsUseCase) warnings(ctx context.Context, patientId string, items []*prescriptions.Item, at time.Time) ([]*prescriptions.Warning, error) {
+	var warnings []*prescriptions.Warning
+
+	allergies, err := r.Repo.GetPatientAllergies(ctx, patientId)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		for _, allergy := range allergies {
+			if allergyMatches(allergy, item) {
+				warnings = append(warnings, &prescriptions.Warning{
+					Kind:        prescriptions.WarningAllergy,
+					Severity:    allergy.Severity,
+					Description: fmt.Sprintf("%s: patient is allergic to %s (%s)", item.MedicationName, allergy.Substance, allergy.Reaction),
+				})
+			}
+		}
+	}
+
+	prescribed := make(map[string]bool)
+	for _, item := range items {
+		prescribed[strings.ToLower(strings.TrimSpace(item.ActiveIngredient))] = true
+	}
+	ingredients := make([]string, 0, len(prescribed))
+	for ingredient := range prescribed {
+		ingredients = append(ingredients, ingredient)
+	}
+
+	active, err := r.Repo.GetActiveIngredients(ctx, patientId, at)
+	if err != nil {
+		return nil, err
+	}
+	ingredients = append(ingredients, active...)
+
+	interactions, err := r.Repo.GetInteractions(ctx, ingredients)
+	if err != nil {
+		return nil, err
+	}
+	for _, interaction := range interactions {
+		// interactions among the running medications were reported when they were issued
+		if !prescribed[interaction.IngredientA] && !prescribed[interaction.IngredientB] {
+			continue
+		}
+		warnings = append(warnings, &prescriptions.Warning{
+			Kind:        prescriptions.WarningInteraction,
+			Severity:    interaction.Severity,
+			Description: fmt.Sprintf("%s interacts with %s: %s", interaction.IngredientA, interaction.IngredientB, interaction.Description),
+		})
+	}
+
+	return warnings, nil
+}
+
+func allergyMatches(allergy *encounters.Allergy, item *prescriptions.Item) bool {
+	substance := strings.ToLower(strings.TrimSpace(allergy.Substance))
+	if substance == "" {
+		return false
+	}
+	return strings.Contains(strings.ToLower(item.ActiveIngredient), substance) ||
+		strings.Contains(strings.ToLower(item.MedicationName), substance)
+}
+
+func initials(name string) string {
+	var res strings.Builder
+	for _, part := range strings.Fields(name) {
+		res.WriteString(strings.ToUpper(string([]rune(part)[:1])) + ".")
+	}
+	return res.String()
+}
+
+func validSeverity(severity string) bool {
+	switch severity {
+	case encounters.SeverityMild, encounters.SeverityModerate, encounters.SeveritySevere:
+		return true
+	}
+	return false
+}
+
+func validateMedication(req *prescriptions.Medication) error {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid medication")
+
+	for field, value := range map[string]string{
+		"name":              req.Name,
+		"form":              req.Form,
+		"strength":          req.Strength,
+		"active_ingredient": req.ActiveIngredient,
+	} {
+		if strings.TrimSpace(value) == "" {
+			errValidation.Errors[field] = "is required"
+		}
+	}
+
+	if len(errValidation.Errors) > 0 {
+		return errValidation
+	}
+	return nil
+}
+
+func validatePrescription(req *prescriptions.CreatePrescription) error {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid prescription")
+
+	if req.AppointmentId <= 0 {
+		errValidation.Errors["appointment_id"] = "is required"
+	}
+	if len(req.Items) == 0 {
+		errValidation.Errors["items"] = "at least one medication is required"
+	}
+
+	for i, item := range req.Items {
+		if item.MedicationId == "" {
+			errValidation.Errors[fmt.Sprintf("items[%d].medication_id", i)] = "is required"
+		}
+		if strings.TrimSpace(item.Dose) == "" {
+			errValidation.Errors[fmt.Sprintf("items[%d].dose", i)] = "is required"
+		}
+		if strings.TrimSpace(item.Frequency) == "" {
+			errValidation.Errors[fmt.Sprintf("items[%d].frequency", i)] = "is required"
+		}
+		if item.DurationDays <= 0 || item.DurationDays > 365 {
+			errValidation.Errors[fmt.Sprintf("items[%d].duration_days", i)] = "must be between 1 and 365"
+		}
+		item.Route = strings.ToLower(strings.TrimSpace(item.Route))
+		if !prescriptionRoutes[item.Route] {
+			errValidation.Errors[fmt.Sprintf("items[%d].route", i)] = "is not a known route of administration"
+		}
+	}
+
+	if len(errValidation.Errors) > 0 {
+		return errValidation
+	}
+	return nil
+}
@@ -0,0 +1,2 @@
+ALTER TABLE "booked_appointments" DROP COLUMN "branch_id";
+ALTER TABLE "doctor_availability" DROP COLUMN "branch_id";
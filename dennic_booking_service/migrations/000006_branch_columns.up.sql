@@ -0,0 +1,5 @@
+ALTER TABLE "doctor_availability" ADD COLUMN "branch_id" UUID;
+ALTER TABLE "booked_appointments" ADD COLUMN "branch_id" UUID;
+
+CREATE INDEX "doctor_availability_branch_id_index" ON "doctor_availability"("branch_id", "doctor_date");
+CREATE INDEX "booked_appointments_branch_id_index" ON "booked_appointments"("branch_id");
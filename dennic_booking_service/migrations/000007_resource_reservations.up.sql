@@ -0,0 +1,19 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE "booked_appointments" ADD COLUMN "resource_id" UUID;
+
+CREATE TABLE "resource_reservations"(
+                                        "id" SERIAL PRIMARY KEY NOT NULL,
+                                        "appointment_id" INTEGER NOT NULL REFERENCES "booked_appointments"("id") ON DELETE CASCADE,
+                                        "resource_id" UUID NOT NULL,
+                                        "reserved_from" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+                                        "reserved_to" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+                                        "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                        "updated_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                                        "deleted_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                                        CONSTRAINT "resource_reservations_no_overlap"
+                                            EXCLUDE USING gist ("resource_id" WITH =, tsrange("reserved_from", "reserved_to") WITH &&)
+                                            WHERE ("deleted_at" IS NULL)
+);
+
+CREATE INDEX "resource_reservations_appointment_id_index" ON "resource_reservations"("appointment_id");
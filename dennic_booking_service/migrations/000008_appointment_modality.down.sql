@@ -0,0 +1,7 @@
+ALTER TABLE "booked_appointments" DROP CONSTRAINT IF EXISTS "booked_appointments_modality_check";
+ALTER TABLE "booked_appointments" DROP COLUMN "video_provider";
+ALTER TABLE "booked_appointments" DROP COLUMN "video_room_id";
+ALTER TABLE "booked_appointments" DROP COLUMN "currency";
+ALTER TABLE "booked_appointments" DROP COLUMN "price";
+ALTER TABLE "booked_appointments" DROP COLUMN "doctor_service_id";
+ALTER TABLE "booked_appointments" DROP COLUMN "modality";
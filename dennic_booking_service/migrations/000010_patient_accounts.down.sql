@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS "patients_user_self_idx";
+DROP INDEX IF EXISTS "patients_user_id_idx";
+ALTER TABLE "patients" DROP CONSTRAINT IF EXISTS "patients_relationship_check";
+ALTER TABLE "patients" DROP COLUMN IF EXISTS "consent_at";
+ALTER TABLE "patients" DROP COLUMN IF EXISTS "consent";
+ALTER TABLE "patients" DROP COLUMN IF EXISTS "relationship";
+ALTER TABLE "patients" DROP COLUMN IF EXISTS "user_id";
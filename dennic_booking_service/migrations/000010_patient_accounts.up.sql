@@ -0,0 +1,9 @@
+ALTER TABLE "patients" ADD COLUMN "user_id" UUID;
+ALTER TABLE "patients" ADD COLUMN "relationship" VARCHAR(20) NOT NULL DEFAULT 'self';
+ALTER TABLE "patients" ADD COLUMN "consent" BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE "patients" ADD COLUMN "consent_at" TIMESTAMP(0) WITHOUT TIME ZONE;
+ALTER TABLE "patients" ADD CONSTRAINT "patients_relationship_check" CHECK ("relationship" IN ('self', 'child', 'parent', 'spouse', 'other'));
+
+CREATE INDEX "patients_user_id_idx" ON "patients" ("user_id") WHERE "deleted_at" IS NULL;
+-- an account has at most one profile of its own
+CREATE UNIQUE INDEX "patients_user_self_idx" ON "patients" ("user_id") WHERE "relationship" = 'self' AND "deleted_at" IS NULL;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "lab_attachments";
+DROP TABLE IF EXISTS "lab_results";
+DROP TABLE IF EXISTS "lab_order_tests";
+DROP TABLE IF EXISTS "lab_orders";
@@ -0,0 +1,47 @@
+CREATE TABLE "lab_orders"(
+                             "id" UUID PRIMARY KEY NOT NULL,
+                             "appointment_id" INTEGER NOT NULL,
+                             "patient_id" UUID NOT NULL,
+                             "doctor_id" UUID NULL,
+                             "status" VARCHAR(20) NOT NULL DEFAULT 'ordered' CHECK ("status" IN ('ordered', 'resulted', 'released', 'cancelled')),
+                             "notes" TEXT NOT NULL DEFAULT '',
+                             "ordered_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                             "resulted_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                             "released_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                             "cancelled_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                             "updated_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                             CONSTRAINT "lab_orders_appointment_id_foreign" FOREIGN KEY("appointment_id") REFERENCES "booked_appointments"("id")
+);
+CREATE INDEX "lab_orders_patient_id_idx" ON "lab_orders" ("patient_id", "ordered_at" DESC);
+CREATE INDEX "lab_orders_doctor_id_idx" ON "lab_orders" ("doctor_id", "ordered_at" DESC);
+CREATE INDEX "lab_orders_status_idx" ON "lab_orders" ("status");
+
+CREATE TABLE "lab_order_tests"(
+                                  "id" SERIAL PRIMARY KEY NOT NULL,
+                                  "order_id" UUID NOT NULL REFERENCES "lab_orders"("id") ON DELETE CASCADE,
+                                  "code" VARCHAR(20) NOT NULL,
+                                  "name" VARCHAR(200) NOT NULL
+);
+CREATE INDEX "lab_order_tests_order_id_idx" ON "lab_order_tests" ("order_id");
+
+CREATE TABLE "lab_results"(
+                              "id" SERIAL PRIMARY KEY NOT NULL,
+                              "order_id" UUID NOT NULL REFERENCES "lab_orders"("id") ON DELETE CASCADE,
+                              "test_code" VARCHAR(20) NOT NULL,
+                              "analyte" VARCHAR(200) NOT NULL,
+                              "value" DOUBLE PRECISION NOT NULL,
+                              "unit" VARCHAR(30) NOT NULL DEFAULT '',
+                              "reference_range" VARCHAR(50) NOT NULL DEFAULT '',
+                              "reference_low" DOUBLE PRECISION,
+                              "reference_high" DOUBLE PRECISION,
+                              "flag" VARCHAR(10) NOT NULL DEFAULT '' CHECK ("flag" IN ('', 'normal', 'low', 'high'))
+);
+CREATE INDEX "lab_results_order_id_idx" ON "lab_results" ("order_id");
+
+CREATE TABLE "lab_attachments"(
+                                  "id" SERIAL PRIMARY KEY NOT NULL,
+                                  "order_id" UUID NOT NULL REFERENCES "lab_orders"("id") ON DELETE CASCADE,
+                                  "file_name" VARCHAR(255) NOT NULL,
+                                  "url" TEXT NOT NULL
+);
+CREATE INDEX "lab_attachments_order_id_idx" ON "lab_attachments" ("order_id");
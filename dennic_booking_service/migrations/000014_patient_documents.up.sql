@@ -0,0 +1,19 @@
+CREATE TABLE "patient_documents"(
+                                    "id" UUID PRIMARY KEY NOT NULL,
+                                    "patient_id" UUID NOT NULL,
+                                    "appointment_id" INTEGER NULL,
+                                    "type" VARCHAR(30) NOT NULL CHECK ("type" IN ('scan', 'xray', 'discharge_summary', 'lab_report', 'referral', 'other')),
+                                    "title" VARCHAR(255) NOT NULL,
+                                    "file_name" VARCHAR(255) NOT NULL,
+                                    "content_type" VARCHAR(100) NOT NULL,
+                                    "size_bytes" BIGINT NOT NULL CHECK ("size_bytes" > 0),
+                                    "bucket" VARCHAR(63) NOT NULL,
+                                    "object_key" VARCHAR(255) NOT NULL,
+                                    "uploaded_by" VARCHAR(64) NOT NULL DEFAULT '',
+                                    "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                    CONSTRAINT "patient_documents_patient_id_foreign" FOREIGN KEY("patient_id") REFERENCES "patients"("id"),
+                                    CONSTRAINT "patient_documents_appointment_id_foreign" FOREIGN KEY("appointment_id") REFERENCES "booked_appointments"("id"),
+                                    CONSTRAINT "patient_documents_object_unique" UNIQUE ("bucket", "object_key")
+);
+CREATE INDEX "patient_documents_patient_id_idx" ON "patient_documents" ("patient_id", "created_at" DESC);
+CREATE INDEX "patient_documents_appointment_id_idx" ON "patient_documents" ("appointment_id");
@@ -0,0 +1,52 @@
+CREATE SEQUENCE "invoice_number_seq";
+
+CREATE TABLE "invoices"(
+                           "id" UUID PRIMARY KEY NOT NULL,
+                           "number" VARCHAR(20) NOT NULL UNIQUE DEFAULT 'INV-' || lpad(nextval('invoice_number_seq')::text, 6, '0'),
+                           "appointment_id" INTEGER NOT NULL,
+                           "patient_id" UUID NOT NULL,
+                           "branch_id" UUID NULL,
+                           "currency" VARCHAR(3) NOT NULL,
+                           "subtotal" NUMERIC(14, 2) NOT NULL CHECK ("subtotal" >= 0),
+                           "discount" NUMERIC(14, 2) NOT NULL DEFAULT 0 CHECK ("discount" >= 0),
+                           "total" NUMERIC(14, 2) NOT NULL CHECK ("total" >= 0),
+                           "paid" NUMERIC(14, 2) NOT NULL DEFAULT 0 CHECK ("paid" >= 0),
+                           "refunded" NUMERIC(14, 2) NOT NULL DEFAULT 0 CHECK ("refunded" >= 0 AND "refunded" <= "paid"),
+                           "status" VARCHAR(20) NOT NULL DEFAULT 'issued' CHECK ("status" IN ('issued', 'partially_paid', 'paid', 'refunded', 'void')),
+                           "created_by" VARCHAR(64) NOT NULL DEFAULT '',
+                           "issued_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                           "voided_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                           "updated_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                           CONSTRAINT "invoices_appointment_id_foreign" FOREIGN KEY("appointment_id") REFERENCES "booked_appointments"("id")
+);
+-- an appointment is billed once; a void invoice can be reissued
+CREATE UNIQUE INDEX "invoices_appointment_id_active_idx" ON "invoices" ("appointment_id") WHERE "status" <> 'void';
+CREATE INDEX "invoices_patient_id_idx" ON "invoices" ("patient_id", "issued_at" DESC);
+CREATE INDEX "invoices_branch_id_idx" ON "invoices" ("branch_id", "issued_at" DESC);
+
+CREATE TABLE "invoice_items"(
+                                "id" SERIAL PRIMARY KEY NOT NULL,
+                                "invoice_id" UUID NOT NULL REFERENCES "invoices"("id") ON DELETE CASCADE,
+                                "kind" VARCHAR(20) NOT NULL CHECK ("kind" IN ('service', 'procedure')),
+                                "description" VARCHAR(255) NOT NULL,
+                                "doctor_service_id" UUID NULL,
+                                "quantity" INTEGER NOT NULL CHECK ("quantity" > 0),
+                                "unit_price" NUMERIC(14, 2) NOT NULL CHECK ("unit_price" >= 0),
+                                "amount" NUMERIC(14, 2) NOT NULL CHECK ("amount" >= 0)
+);
+CREATE INDEX "invoice_items_invoice_id_idx" ON "invoice_items" ("invoice_id");
+
+CREATE TABLE "invoice_payments"(
+                                   "id" UUID PRIMARY KEY NOT NULL,
+                                   "invoice_id" UUID NOT NULL REFERENCES "invoices"("id"),
+                                   "kind" VARCHAR(10) NOT NULL CHECK ("kind" IN ('payment', 'refund')),
+                                   "method" VARCHAR(20) NOT NULL CHECK ("method" IN ('cash', 'card', 'insurance')),
+                                   "amount" NUMERIC(14, 2) NOT NULL CHECK ("amount" > 0),
+                                   "currency" VARCHAR(3) NOT NULL,
+                                   "cashier_id" VARCHAR(64) NOT NULL,
+                                   "branch_id" UUID NULL,
+                                   "reference" VARCHAR(255) NOT NULL DEFAULT '',
+                                   "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX "invoice_payments_invoice_id_idx" ON "invoice_payments" ("invoice_id", "created_at");
+CREATE INDEX "invoice_payments_branch_day_idx" ON "invoice_payments" ("branch_id", "created_at");
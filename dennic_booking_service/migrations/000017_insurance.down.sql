@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "insurance_claims";
+DROP TABLE IF EXISTS "claim_batches";
+DROP TABLE IF EXISTS "coverage_rules";
+DROP TABLE IF EXISTS "insurance_policies";
+DROP TABLE IF EXISTS "insurers";
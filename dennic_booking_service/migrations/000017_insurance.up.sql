@@ -0,0 +1,79 @@
+CREATE TABLE "insurers"(
+                           "id" UUID PRIMARY KEY NOT NULL,
+                           "name" VARCHAR(255) NOT NULL,
+                           "code" VARCHAR(50) NOT NULL,
+                           "email" VARCHAR(255) NOT NULL DEFAULT '',
+                           "phone_number" VARCHAR(30) NOT NULL DEFAULT '',
+                           "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                           "updated_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                           "deleted_at" TIMESTAMP(0) WITHOUT TIME ZONE
+);
+CREATE UNIQUE INDEX "insurers_code_idx" ON "insurers" ("code") WHERE "deleted_at" IS NULL;
+
+CREATE TABLE "insurance_policies"(
+                                     "id" UUID PRIMARY KEY NOT NULL,
+                                     "insurer_id" UUID NOT NULL REFERENCES "insurers"("id"),
+                                     "patient_id" UUID NOT NULL REFERENCES "patients"("id"),
+                                     "policy_number" VARCHAR(100) NOT NULL,
+                                     "valid_from" DATE NOT NULL,
+                                     "valid_to" DATE NOT NULL,
+                                     "annual_limit" NUMERIC(14, 2) NOT NULL DEFAULT 0 CHECK ("annual_limit" >= 0),
+                                     "currency" VARCHAR(3) NOT NULL,
+                                     "status" VARCHAR(20) NOT NULL DEFAULT 'active' CHECK ("status" IN ('active', 'cancelled')),
+                                     "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                     "updated_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                                     CONSTRAINT "insurance_policies_period_check" CHECK ("valid_from" <= "valid_to"),
+                                     CONSTRAINT "insurance_policies_number_unique" UNIQUE ("insurer_id", "policy_number")
+);
+CREATE INDEX "insurance_policies_patient_id_idx" ON "insurance_policies" ("patient_id");
+
+CREATE TABLE "coverage_rules"(
+                                 "id" UUID PRIMARY KEY NOT NULL,
+                                 "insurer_id" UUID NOT NULL REFERENCES "insurers"("id"),
+                                 "doctor_service_id" UUID NOT NULL,
+                                 "kind" VARCHAR(20) NOT NULL CHECK ("kind" IN ('percentage', 'copay')),
+                                 "percentage" INTEGER NOT NULL DEFAULT 0 CHECK ("percentage" BETWEEN 0 AND 100),
+                                 "copay" NUMERIC(14, 2) NOT NULL DEFAULT 0 CHECK ("copay" >= 0),
+                                 "annual_limit" NUMERIC(14, 2) NOT NULL DEFAULT 0 CHECK ("annual_limit" >= 0),
+                                 "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                 "updated_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                                 CONSTRAINT "coverage_rules_service_unique" UNIQUE ("insurer_id", "doctor_service_id")
+);
+
+CREATE TABLE "claim_batches"(
+                                "id" UUID PRIMARY KEY NOT NULL,
+                                "insurer_id" UUID NOT NULL REFERENCES "insurers"("id"),
+                                "period_from" DATE NOT NULL,
+                                "period_to" DATE NOT NULL,
+                                "format" VARCHAR(10) NOT NULL CHECK ("format" IN ('csv', 'json')),
+                                "claim_count" INTEGER NOT NULL,
+                                "created_by" VARCHAR(64) NOT NULL DEFAULT '',
+                                "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX "claim_batches_insurer_id_idx" ON "claim_batches" ("insurer_id", "created_at" DESC);
+
+CREATE TABLE "insurance_claims"(
+                                   "id" UUID PRIMARY KEY NOT NULL,
+                                   "insurer_id" UUID NOT NULL REFERENCES "insurers"("id"),
+                                   "policy_id" UUID NOT NULL REFERENCES "insurance_policies"("id"),
+                                   "patient_id" UUID NOT NULL,
+                                   "appointment_id" INTEGER NOT NULL REFERENCES "booked_appointments"("id"),
+                                   "invoice_id" UUID NULL REFERENCES "invoices"("id"),
+                                   "doctor_service_id" UUID NOT NULL,
+                                   "service_date" DATE NOT NULL,
+                                   "billed" NUMERIC(14, 2) NOT NULL CHECK ("billed" >= 0),
+                                   "covered" NUMERIC(14, 2) NOT NULL CHECK ("covered" > 0),
+                                   "copay" NUMERIC(14, 2) NOT NULL CHECK ("copay" >= 0),
+                                   "approved" NUMERIC(14, 2) NOT NULL DEFAULT 0 CHECK ("approved" >= 0 AND "approved" <= "covered"),
+                                   "currency" VARCHAR(3) NOT NULL,
+                                   "status" VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK ("status" IN ('pending', 'submitted', 'approved', 'rejected', 'paid')),
+                                   "status_reason" VARCHAR(255) NOT NULL DEFAULT '',
+                                   "batch_id" UUID NULL REFERENCES "claim_batches"("id"),
+                                   "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                   "updated_at" TIMESTAMP(0) WITHOUT TIME ZONE
+);
+-- an appointment is claimed once; a rejected claim can be filed again
+CREATE UNIQUE INDEX "insurance_claims_appointment_id_active_idx" ON "insurance_claims" ("appointment_id") WHERE "status" <> 'rejected';
+CREATE INDEX "insurance_claims_insurer_id_idx" ON "insurance_claims" ("insurer_id", "status", "service_date");
+CREATE INDEX "insurance_claims_policy_id_idx" ON "insurance_claims" ("policy_id", "service_date");
+CREATE INDEX "insurance_claims_batch_id_idx" ON "insurance_claims" ("batch_id");
@@ -0,0 +1,91 @@
+-- The archive becomes the closed-out record of a booked appointment. The old
+-- rows only pointed at a doctor's availability slot; they are kept in
+-- "archive_legacy" and copied over where a booking of the same doctor, day
+-- and start time exists.
+ALTER TABLE "doctor_notes" DROP CONSTRAINT "doctor_notes_appointment_id_foreign";
+ALTER TABLE "archive" DROP CONSTRAINT IF EXISTS "archive_doctor_availability_id_foreign";
+
+ALTER TABLE "archive" RENAME TO "archive_legacy";
+ALTER INDEX "archive_pkey" RENAME TO "archive_legacy_pkey";
+ALTER SEQUENCE "archive_id_seq" RENAME TO "archive_legacy_id_seq";
+ALTER TABLE "archive_legacy" ADD COLUMN "appointment_id" INTEGER;
+
+UPDATE "archive_legacy" l SET "appointment_id" = (
+    SELECT ba."id"
+    FROM "doctor_availability" da
+    JOIN "booked_appointments" ba ON ba."doctor_id" = da."doctor_id"
+                                 AND ba."appointment_date" = da."doctor_date"
+                                 AND ba."appointment_time" = l."start_time"
+    WHERE da."id" = l."doctor_availability_id"
+    ORDER BY ba."deleted_at" NULLS FIRST, ba."id"
+    LIMIT 1
+);
+
+CREATE TABLE "archive"(
+                          "id" SERIAL PRIMARY KEY NOT NULL,
+                          "appointment_id" INTEGER NOT NULL,
+                          "patient_id" UUID NOT NULL,
+                          "doctor_id" UUID NULL,
+                          "department_id" UUID NOT NULL,
+                          "branch_id" UUID NULL,
+                          "appointment_date" DATE NOT NULL,
+                          "started_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+                          "ended_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+                          "patient_problem" TEXT NOT NULL DEFAULT '',
+                          "outcome" VARCHAR(20) NOT NULL CHECK ("outcome" IN ('attended', 'cancelled', 'no_show')),
+                          "payment_type" VARCHAR(20) NULL CHECK ("payment_type" IN ('cash', 'card', 'insurance', 'online')),
+                          "payment_amount" NUMERIC(14, 2) NOT NULL DEFAULT 0 CHECK ("payment_amount" >= 0),
+                          "currency" VARCHAR(3) NOT NULL DEFAULT 'UZS',
+                          "invoice_id" UUID NULL REFERENCES "invoices"("id"),
+                          "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                          "updated_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                          "deleted_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                          CONSTRAINT "archive_appointment_id_foreign" FOREIGN KEY("appointment_id") REFERENCES "booked_appointments"("id"),
+                          CONSTRAINT "archive_ended_at_check" CHECK ("ended_at" >= "started_at")
+);
+-- an appointment is closed out once
+CREATE UNIQUE INDEX "archive_appointment_id_idx" ON "archive" ("appointment_id") WHERE "deleted_at" IS NULL;
+CREATE INDEX "archive_patient_id_idx" ON "archive" ("patient_id", "appointment_date" DESC);
+CREATE INDEX "archive_doctor_id_idx" ON "archive" ("doctor_id", "appointment_date" DESC);
+
+INSERT INTO "archive" ("appointment_id", "patient_id", "doctor_id", "department_id", "branch_id", "appointment_date",
+                       "started_at", "ended_at", "patient_problem", "outcome", "payment_type", "payment_amount",
+                       "currency", "created_at", "updated_at", "deleted_at")
+SELECT DISTINCT ON (l."appointment_id")
+       l."appointment_id", ba."patient_id", ba."doctor_id", ba."department_id", ba."branch_id", ba."appointment_date",
+       ba."appointment_date" + l."start_time",
+       ba."appointment_date" + GREATEST(l."start_time", l."end_time"),
+       l."patient_problem", l."status", l."payment_type", round(l."payment_amount"::numeric, 2),
+       ba."currency", l."created_at", l."updated_at", l."deleted_at"
+FROM "archive_legacy" l
+JOIN "booked_appointments" ba ON ba."id" = l."appointment_id"
+ORDER BY l."appointment_id", l."deleted_at" NULLS FIRST, l."id";
+
+-- Notes follow their archive row to its booking; the others are matched to the
+-- doctor's latest booking of the patient before the note. Notes left without
+-- a booking are kept in "doctor_notes_unmatched".
+ALTER TABLE "doctor_notes" ADD COLUMN "booked_appointment_id" INTEGER;
+
+UPDATE "doctor_notes" n SET "booked_appointment_id" = l."appointment_id"
+FROM "archive_legacy" l
+WHERE l."id" = n."appointment_id";
+
+UPDATE "doctor_notes" n SET "booked_appointment_id" = (
+    SELECT ba."id"
+    FROM "booked_appointments" ba
+    WHERE ba."doctor_id" = n."doctor_id"
+      AND ba."patient_id" = n."patient_id"
+      AND ba."appointment_date" <= n."created_at"::date
+    ORDER BY ba."appointment_date" DESC, ba."id" DESC
+    LIMIT 1
+)
+WHERE n."booked_appointment_id" IS NULL;
+
+CREATE TABLE "doctor_notes_unmatched" AS SELECT * FROM "doctor_notes" WHERE "booked_appointment_id" IS NULL;
+DELETE FROM "doctor_notes" WHERE "booked_appointment_id" IS NULL;
+
+UPDATE "doctor_notes" SET "appointment_id" = "booked_appointment_id";
+ALTER TABLE "doctor_notes" DROP COLUMN "booked_appointment_id";
+ALTER TABLE "doctor_notes_unmatched" DROP COLUMN "booked_appointment_id";
+ALTER TABLE "doctor_notes" ADD CONSTRAINT "doctor_notes_appointment_id_foreign" FOREIGN KEY("appointment_id") REFERENCES "booked_appointments"("id");
+CREATE INDEX "doctor_notes_appointment_id_idx" ON "doctor_notes" ("appointment_id");
@@ -0,0 +1,16 @@
+-- Records imported from another clinic's FHIR export. They have no booked
+-- appointment here, so each resource is kept as received.
+CREATE TABLE "patient_external_records"(
+                                           "id" UUID PRIMARY KEY NOT NULL,
+                                           "patient_id" UUID NOT NULL,
+                                           "source" VARCHAR(255) NOT NULL DEFAULT '',
+                                           "resource_type" VARCHAR(50) NOT NULL,
+                                           "external_id" VARCHAR(255) NOT NULL DEFAULT '',
+                                           "occurred_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                                           "summary" TEXT NOT NULL DEFAULT '',
+                                           "resource" JSONB NOT NULL,
+                                           "imported_by" VARCHAR(64) NOT NULL DEFAULT '',
+                                           "imported_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                           CONSTRAINT "patient_external_records_patient_id_foreign" FOREIGN KEY("patient_id") REFERENCES "patients"("id") ON DELETE CASCADE
+);
+CREATE INDEX "patient_external_records_patient_id_idx" ON "patient_external_records" ("patient_id", "occurred_at" DESC);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "doctor_reply_hours";
+DROP TABLE IF EXISTS "conversation_messages";
+DROP TABLE IF EXISTS "conversations";
@@ -0,0 +1,2 @@
+-- the booking service's migrations never added the constraint; a shared
+-- database gets it back from the shared migrations.
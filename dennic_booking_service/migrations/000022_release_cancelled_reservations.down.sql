@@ -0,0 +1,2 @@
+-- released reservations are not taken back: the slot may have been booked
+-- again since.
@@ -0,0 +1,5 @@
+-- Cancelled and missed visits no longer hold their resources; release the
+-- reservations they kept so the exclusion constraint lets the slot be booked.
+UPDATE "resource_reservations" SET "deleted_at" = CURRENT_TIMESTAMP
+WHERE "deleted_at" IS NULL
+  AND "appointment_id" IN (SELECT "id" FROM "booked_appointments" WHERE "status" IN ('cancelled', 'no_show'));
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"Healthcare_Evrone/internal/pkg/config"
+	"Healthcare_Evrone/internal/pkg/logger"
+	"context"
+	"dennic_kafka/eventbus"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// replay re-drives the messages parked on a dead-letter topic to the topics
+// they failed on, e.g. once the bug or outage that failed them is fixed.
+func main() {
+	// initialization config
+	config := config.New()
+
+	var (
+		topic = flag.String("topic", config.Kafka.Topic.Healthcare+config.Kafka.DeadLetterSuffix, "dead letter topic to replay")
+		group = flag.String("group", "", "consumer group that tracks replayed dead letters (default <topic>.replay)")
+		limit = flag.Int("limit", 0, "number of dead letters to replay, 0 for all")
+		idle  = flag.Duration("idle", 10*time.Second, "stop once no dead letter arrives for this long")
+	)
+	flag.Parse()
+	if *group == "" {
+		*group = *topic + ".replay"
+	}
+
+	logger, err := logger.New(config.LogLevel, config.Environment, config.APP+"_replay.log")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer logger.Sync()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	consumerConfig := eventbus.Config{
+		Brokers:          config.Kafka.Address,
+		MaxAttempts:      config.Kafka.Retry.MaxAttempts,
+		Backoff:          config.Kafka.Retry.Backoff,
+		MaxBackoff:       config.Kafka.Retry.MaxBackoff,
+		DeadLetterSuffix: config.Kafka.DeadLetterSuffix,
+	}
+	replayed, err := eventbus.Replay(ctx, consumerConfig, logger, *topic, *group, *limit, *idle)
+	if err != nil {
+		logger.Error("replay dead letters", zap.String("topic", *topic), zap.Int("replayed", replayed), zap.Error(err))
+		os.Exit(1)
+	}
+
+	logger.Info("replayed dead letters", zap.String("topic", *topic), zap.Int("replayed", replayed))
+}
@@ -37,6 +37,7 @@ type DoctorTime struct {
 	CreatedAt            string   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string   `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	DeletedAt            string   `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	BranchId             string   `protobuf:"bytes,11,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -145,6 +146,13 @@ func (m *DoctorTime) GetDeletedAt() string {
 	return ""
 }
 
+func (m *DoctorTime) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
 type DoctorTimes struct {
 	Count                int64         `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
 	DoctorTimes          []*DoctorTime `protobuf:"bytes,2,rep,name=doctor_times,json=doctorTimes,proto3" json:"doctor_times"`
@@ -207,6 +215,7 @@ type CreateDoctorTimeReq struct {
 	StartTime            string   `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time"`
 	EndTime              string   `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time"`
 	Status               string   `protobuf:"bytes,6,opt,name=status,proto3" json:"status"`
+	BranchId             string   `protobuf:"bytes,7,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -287,6 +296,13 @@ func (m *CreateDoctorTimeReq) GetStatus() string {
 	return ""
 }
 
+func (m *CreateDoctorTimeReq) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
 type UpdateDoctorTimeReq struct {
 	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
@@ -296,6 +312,7 @@ type UpdateDoctorTimeReq struct {
 	StartTime            string   `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time"`
 	EndTime              string   `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time"`
 	Status               string   `protobuf:"bytes,8,opt,name=status,proto3" json:"status"`
+	BranchId             string   `protobuf:"bytes,9,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -390,6 +407,13 @@ func (m *UpdateDoctorTimeReq) GetStatus() string {
 	return ""
 }
 
+func (m *UpdateDoctorTimeReq) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
 type DoctorTimeFieldValueReq struct {
 	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
@@ -507,6 +531,8 @@ type GetAllDoctorTimesReq struct {
 	Page                 uint64   `protobuf:"varint,4,opt,name=page,proto3" json:"page"`
 	Limit                uint64   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
 	OrderBy              string   `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
+	BranchId             string   `protobuf:"bytes,7,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
+	DoctorDate           string   `protobuf:"bytes,8,opt,name=doctor_date,json=doctorDate,proto3" json:"doctor_date"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -587,6 +613,20 @@ func (m *GetAllDoctorTimesReq) GetOrderBy() string {
 	return ""
 }
 
+func (m *GetAllDoctorTimesReq) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
+func (m *GetAllDoctorTimesReq) GetDoctorDate() string {
+	if m != nil {
+		return m.DoctorDate
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DoctorTime)(nil), "booking_service.DoctorTime")
 	proto.RegisterType((*DoctorTimes)(nil), "booking_service.DoctorTimes")
@@ -602,44 +642,46 @@ func init() {
 }
 
 var fileDescriptor_a87a3b7fa39be7cd = []byte{
-	// 586 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xfe, 0xad, 0xed, 0x24, 0xce, 0xa4, 0xfd, 0x91, 0x6e, 0xa3, 0x62, 0x1a, 0x08, 0x91, 0x01,
-	0x91, 0x53, 0x40, 0xe5, 0x8e, 0x94, 0x12, 0x51, 0xf5, 0xea, 0xd2, 0x8a, 0x9b, 0xe5, 0x78, 0x87,
-	0x6a, 0x85, 0x13, 0x07, 0x7b, 0x13, 0xa9, 0x6f, 0xc2, 0x03, 0x70, 0xe6, 0x39, 0x10, 0x27, 0xce,
-	0x9c, 0x50, 0x78, 0x07, 0xce, 0xc8, 0xbb, 0x8e, 0xec, 0xfc, 0xe9, 0x46, 0x20, 0x6e, 0x99, 0xf9,
-	0xc6, 0xb3, 0xf3, 0x7d, 0xdf, 0x8c, 0x02, 0xee, 0x28, 0x8e, 0xdf, 0xf3, 0xc9, 0xb5, 0x9f, 0x62,
-	0x32, 0xe7, 0x21, 0x3e, 0x63, 0x71, 0x28, 0xe2, 0xc4, 0x17, 0x7c, 0x8c, 0x69, 0x7f, 0x9a, 0xc4,
-	0x22, 0xa6, 0x77, 0xd6, 0x6a, 0xdc, 0xcf, 0x06, 0xc0, 0x50, 0xd6, 0xbd, 0xe1, 0x63, 0xa4, 0xff,
-	0x83, 0xc1, 0x99, 0x43, 0xba, 0xa4, 0x67, 0x7a, 0x06, 0x67, 0xf4, 0x11, 0xec, 0x33, 0x9c, 0x06,
-	0x89, 0x18, 0xe3, 0x44, 0xf8, 0x9c, 0x39, 0x46, 0x97, 0xf4, 0xea, 0xde, 0x5e, 0x91, 0x3c, 0x67,
-	0xb4, 0x0d, 0xf5, 0xfc, 0x29, 0xce, 0x1c, 0x53, 0x16, 0xd8, 0x2a, 0x71, 0xce, 0xe8, 0x43, 0x68,
-	0xe4, 0x20, 0x0b, 0x04, 0x3a, 0x96, 0x84, 0x41, 0xa5, 0x86, 0x81, 0x40, 0xfa, 0x00, 0x20, 0x15,
-	0x41, 0x22, 0xe4, 0x9c, 0x4e, 0x45, 0xe2, 0x75, 0x99, 0x91, 0x13, 0xdd, 0x03, 0x1b, 0x27, 0x4c,
-	0x81, 0x55, 0x09, 0xd6, 0x70, 0xc2, 0x24, 0x74, 0x04, 0xd5, 0x54, 0x04, 0x62, 0x96, 0x3a, 0x35,
-	0x09, 0xe4, 0x51, 0xd6, 0x31, 0x4c, 0x30, 0x10, 0xc8, 0xfc, 0x40, 0x38, 0xb6, 0xea, 0x98, 0x67,
-	0x06, 0x22, 0x83, 0x67, 0x53, 0xb6, 0x84, 0xeb, 0x0a, 0xce, 0x33, 0x0a, 0x66, 0x18, 0x61, 0x0e,
-	0x83, 0x82, 0xf3, 0xcc, 0x40, 0xb8, 0x21, 0x34, 0x0a, 0xbd, 0x52, 0xda, 0x82, 0x4a, 0x18, 0xcf,
-	0x26, 0x22, 0xd7, 0x4c, 0x05, 0xf4, 0x25, 0xec, 0x95, 0xc5, 0x77, 0x8c, 0xae, 0xd9, 0x6b, 0x9c,
-	0xb4, 0xfb, 0x6b, 0xea, 0xf7, 0x8b, 0x4e, 0x5e, 0xae, 0x92, 0xec, 0xea, 0x7e, 0x25, 0x70, 0xf8,
-	0x4a, 0x0e, 0x5c, 0xaa, 0xc0, 0x0f, 0x9b, 0x76, 0x90, 0x5d, 0x76, 0x18, 0x7a, 0x3b, 0xcc, 0x1d,
-	0x76, 0x58, 0x3a, 0x3b, 0x2a, 0xb7, 0xd9, 0x51, 0x2d, 0xdb, 0xe1, 0xfe, 0x22, 0x70, 0x78, 0x29,
-	0xe5, 0x5d, 0x25, 0xd3, 0x82, 0xca, 0x3b, 0x8e, 0xd1, 0x92, 0x84, 0x0a, 0xb2, 0xec, 0x3c, 0x88,
-	0x66, 0x98, 0x4f, 0xae, 0x82, 0x4d, 0xe2, 0xe6, 0x2e, 0xe2, 0x96, 0x9e, 0x78, 0x65, 0x07, 0xf1,
-	0xaa, 0x8e, 0x78, 0xed, 0x36, 0xe2, 0xf6, 0x0a, 0xf1, 0x11, 0xdc, 0x2d, 0x18, 0xbf, 0xce, 0xd8,
-	0x5d, 0x65, 0x64, 0xfe, 0x94, 0x7b, 0x1b, 0xea, 0x3c, 0xf5, 0x83, 0x50, 0xf0, 0xb9, 0x32, 0xcc,
-	0xf6, 0x6c, 0x9e, 0x0e, 0x64, 0xec, 0x3e, 0x87, 0xa3, 0xe2, 0x8d, 0xa1, 0xdc, 0xd2, 0x0b, 0x75,
-	0x05, 0xc5, 0x54, 0x44, 0x7e, 0xb3, 0x9c, 0xea, 0x13, 0x81, 0xd6, 0x19, 0x8a, 0x41, 0x14, 0x95,
-	0xf6, 0xf8, 0x5f, 0xce, 0x44, 0x29, 0x58, 0xd3, 0xe0, 0x5a, 0x2d, 0x8f, 0xe5, 0xc9, 0xdf, 0x59,
-	0x9b, 0x88, 0x8f, 0xb9, 0x90, 0xc2, 0x5b, 0x9e, 0x0a, 0x32, 0x51, 0xe3, 0x84, 0x61, 0xe2, 0x8f,
-	0x6e, 0x96, 0xc7, 0x2d, 0xe3, 0xd3, 0x9b, 0x93, 0xef, 0x26, 0x1c, 0x14, 0x03, 0x5e, 0xa8, 0x83,
-	0xa1, 0x97, 0xd0, 0x5c, 0xbf, 0x0b, 0xfa, 0x78, 0xe3, 0xac, 0xb6, 0x9c, 0xce, 0xb1, 0xee, 0xf8,
-	0xe8, 0x15, 0xec, 0x9f, 0xa1, 0x28, 0x25, 0x7a, 0x9a, 0xea, 0x15, 0x27, 0xf5, 0x7d, 0xdf, 0xc2,
-	0xc1, 0x86, 0xd4, 0xf4, 0xc9, 0xc6, 0x17, 0xdb, 0xec, 0x38, 0xbe, 0xaf, 0x69, 0x9c, 0x66, 0x42,
-	0xac, 0xdf, 0xd4, 0x16, 0x21, 0xb6, 0x9c, 0x9d, 0x7e, 0x60, 0x84, 0xa6, 0x5a, 0xa2, 0xbf, 0xd2,
-	0xe2, 0xa9, 0xa6, 0xb2, 0xbc, 0x9b, 0xa7, 0xcd, 0x2f, 0x8b, 0x0e, 0xf9, 0xb6, 0xe8, 0x90, 0x1f,
-	0x8b, 0x0e, 0xf9, 0xf8, 0xb3, 0xf3, 0xdf, 0xa8, 0x2a, 0xff, 0x9f, 0x5e, 0xfc, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0xc0, 0x6c, 0x43, 0xa0, 0xc5, 0x06, 0x00, 0x00,
+	// 611 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xc5, 0x1f, 0x49, 0xec, 0x49, 0x0b, 0xed, 0x36, 0x2a, 0xa6, 0x81, 0x10, 0x19, 0x10, 0x39,
+	0x05, 0x54, 0xee, 0x48, 0x29, 0x11, 0x55, 0xae, 0x2e, 0xad, 0xb8, 0x59, 0x8e, 0x77, 0x29, 0x2b,
+	0x1c, 0x3b, 0x78, 0x37, 0x91, 0xfa, 0x3b, 0xb8, 0xf0, 0x93, 0x90, 0xb8, 0x70, 0xe6, 0x84, 0xc2,
+	0x81, 0xbf, 0x81, 0xbc, 0xeb, 0xe0, 0xc4, 0x4e, 0x36, 0x02, 0x71, 0xf3, 0xcc, 0x1b, 0x8f, 0xe7,
+	0xbd, 0x37, 0x93, 0x80, 0x3b, 0x4e, 0x92, 0x0f, 0x34, 0xbe, 0xf6, 0x19, 0x49, 0xe7, 0x34, 0x24,
+	0xcf, 0x70, 0x12, 0xf2, 0x24, 0xf5, 0x39, 0x9d, 0x10, 0xd6, 0x9f, 0xa6, 0x09, 0x4f, 0xd0, 0x9d,
+	0x52, 0x8d, 0xfb, 0x55, 0x07, 0x18, 0x8a, 0xba, 0x37, 0x74, 0x42, 0xd0, 0x6d, 0xd0, 0x29, 0x76,
+	0xb4, 0xae, 0xd6, 0x33, 0x3c, 0x9d, 0x62, 0xf4, 0x08, 0xf6, 0x31, 0x99, 0x06, 0x29, 0x9f, 0x90,
+	0x98, 0xfb, 0x14, 0x3b, 0x7a, 0x57, 0xeb, 0xd9, 0xde, 0x5e, 0x91, 0x1c, 0x61, 0xd4, 0x06, 0x3b,
+	0xff, 0x14, 0xc5, 0x8e, 0x21, 0x0a, 0x2c, 0x99, 0x18, 0x61, 0xf4, 0x10, 0x9a, 0x39, 0x88, 0x03,
+	0x4e, 0x1c, 0x53, 0xc0, 0x20, 0x53, 0xc3, 0x80, 0x13, 0xf4, 0x00, 0x80, 0xf1, 0x20, 0xe5, 0x62,
+	0x4e, 0xa7, 0x26, 0x70, 0x5b, 0x64, 0xc4, 0x44, 0xf7, 0xc0, 0x22, 0x31, 0x96, 0x60, 0x5d, 0x80,
+	0x0d, 0x12, 0x63, 0x01, 0x1d, 0x43, 0x9d, 0xf1, 0x80, 0xcf, 0x98, 0xd3, 0x10, 0x40, 0x1e, 0x65,
+	0x1d, 0xc3, 0x94, 0x04, 0x9c, 0x60, 0x3f, 0xe0, 0x8e, 0x25, 0x3b, 0xe6, 0x99, 0x01, 0xcf, 0xe0,
+	0xd9, 0x14, 0x2f, 0x61, 0x5b, 0xc2, 0x79, 0x46, 0xc2, 0x98, 0x44, 0x24, 0x87, 0x41, 0xc2, 0x79,
+	0x66, 0xc0, 0x33, 0xb2, 0xe3, 0x34, 0x88, 0xc3, 0xf7, 0x19, 0xd9, 0xa6, 0x24, 0x2b, 0x13, 0x23,
+	0xec, 0x86, 0xd0, 0x2c, 0xc4, 0x64, 0xa8, 0x05, 0xb5, 0x30, 0x99, 0xc5, 0x3c, 0x17, 0x54, 0x06,
+	0xe8, 0x25, 0xec, 0xad, 0x3a, 0xe3, 0xe8, 0x5d, 0xa3, 0xd7, 0x3c, 0x6d, 0xf7, 0x4b, 0xd6, 0xf4,
+	0x8b, 0x4e, 0x5e, 0x13, 0xff, 0x79, 0x66, 0xee, 0x2f, 0x0d, 0x8e, 0x5e, 0x09, 0x36, 0x2b, 0x15,
+	0xe4, 0x63, 0xd5, 0x2b, 0x6d, 0x97, 0x57, 0xba, 0xda, 0x2b, 0x63, 0x87, 0x57, 0xa6, 0xca, 0xab,
+	0xda, 0x36, 0xaf, 0xea, 0x6b, 0x5e, 0xad, 0xc9, 0xd9, 0x28, 0xc9, 0xf9, 0x49, 0x87, 0xa3, 0xcb,
+	0x29, 0xae, 0x30, 0x6d, 0x41, 0xed, 0x1d, 0x25, 0xd1, 0x92, 0xa1, 0x0c, 0xb2, 0xec, 0x3c, 0x88,
+	0x66, 0x24, 0xa7, 0x25, 0x83, 0xaa, 0x2a, 0xc6, 0x2e, 0x55, 0x4c, 0xb5, 0x2a, 0xb5, 0x1d, 0xaa,
+	0xd4, 0x55, 0xaa, 0x34, 0xb6, 0xa9, 0x62, 0x6d, 0x57, 0xc5, 0x2e, 0xa9, 0x32, 0x86, 0xbb, 0x85,
+	0x1c, 0xaf, 0x33, 0xea, 0x57, 0x19, 0xd3, 0xbf, 0x15, 0xa6, 0x0d, 0x36, 0x65, 0x7e, 0x10, 0x72,
+	0x3a, 0x97, 0x56, 0x5b, 0x9e, 0x45, 0xd9, 0x40, 0xc4, 0xee, 0x73, 0x38, 0x2e, 0xbe, 0x31, 0x14,
+	0xcb, 0x7f, 0x21, 0x47, 0x2b, 0x46, 0xd6, 0xc4, 0x3b, 0x79, 0xe4, 0x2e, 0x34, 0x68, 0x9d, 0x13,
+	0x3e, 0x88, 0xa2, 0xe2, 0x45, 0xf6, 0x3f, 0x67, 0x42, 0x08, 0xcc, 0x69, 0x70, 0x2d, 0xd7, 0xce,
+	0xf4, 0xc4, 0x73, 0xd6, 0x26, 0xa2, 0x13, 0xca, 0x85, 0x2b, 0xa6, 0x27, 0x83, 0x4c, 0xf1, 0x24,
+	0xc5, 0x24, 0xf5, 0xc7, 0x37, 0xcb, 0xdf, 0x0c, 0x11, 0x9f, 0xdd, 0x28, 0xf7, 0xad, 0xec, 0xb4,
+	0x55, 0x76, 0xfa, 0xf4, 0xbb, 0x01, 0x87, 0x05, 0xbd, 0x0b, 0x79, 0xa8, 0xe8, 0x12, 0x0e, 0xca,
+	0xf7, 0x88, 0x1e, 0x57, 0xce, 0x79, 0xc3, 0xc9, 0x9e, 0xa8, 0x8e, 0x1e, 0x5d, 0xc1, 0xfe, 0x39,
+	0xe1, 0x2b, 0x89, 0x9e, 0xa2, 0x7a, 0x6d, 0x0f, 0xd4, 0x7d, 0xdf, 0xc2, 0x61, 0xc5, 0x28, 0xf4,
+	0xa4, 0xf2, 0xc6, 0x26, 0x33, 0x4f, 0xee, 0x2b, 0x1a, 0xb3, 0x4c, 0x88, 0xf2, 0xb9, 0x6e, 0x10,
+	0x62, 0xc3, 0x45, 0xab, 0x07, 0x26, 0x70, 0x20, 0x57, 0xf0, 0x9f, 0xb4, 0x78, 0xaa, 0xa8, 0x5c,
+	0xdd, 0xec, 0xb3, 0x83, 0x2f, 0x8b, 0x8e, 0xf6, 0x6d, 0xd1, 0xd1, 0x7e, 0x2c, 0x3a, 0xda, 0xe7,
+	0x9f, 0x9d, 0x5b, 0xe3, 0xba, 0xf8, 0xd3, 0x7c, 0xf1, 0x7b, 0x00, 0x2f, 0x25, 0xc5, 0x6b, 0x5a,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -892,6 +934,13 @@ func (m *DoctorTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDoctorTimes(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.DeletedAt) > 0 {
 		i -= len(m.DeletedAt)
 		copy(dAtA[i:], m.DeletedAt)
@@ -1033,6 +1082,13 @@ func (m *CreateDoctorTimeReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDoctorTimes(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Status) > 0 {
 		i -= len(m.Status)
 		copy(dAtA[i:], m.Status)
@@ -1102,6 +1158,13 @@ func (m *UpdateDoctorTimeReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDoctorTimes(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Status) > 0 {
 		i -= len(m.Status)
 		copy(dAtA[i:], m.Status)
@@ -1273,6 +1336,20 @@ func (m *GetAllDoctorTimesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DoctorDate) > 0 {
+		i -= len(m.DoctorDate)
+		copy(dAtA[i:], m.DoctorDate)
+		i = encodeVarintDoctorTimes(dAtA, i, uint64(len(m.DoctorDate)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDoctorTimes(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.OrderBy) > 0 {
 		i -= len(m.OrderBy)
 		copy(dAtA[i:], m.OrderBy)
@@ -1373,6 +1450,10 @@ func (m *DoctorTime) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDoctorTimes(uint64(l))
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1430,6 +1511,10 @@ func (m *CreateDoctorTimeReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDoctorTimes(uint64(l))
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1474,6 +1559,10 @@ func (m *UpdateDoctorTimeReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDoctorTimes(uint64(l))
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1545,6 +1634,14 @@ func (m *GetAllDoctorTimesReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDoctorTimes(uint64(l))
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
+	l = len(m.DoctorDate)
+	if l > 0 {
+		n += 1 + l + sovDoctorTimes(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1893,6 +1990,38 @@ func (m *DoctorTime) Unmarshal(dAtA []byte) error {
 			}
 			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorTimes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctorTimes(dAtA[iNdEx:])
@@ -2240,6 +2369,38 @@ func (m *CreateDoctorTimeReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorTimes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctorTimes(dAtA[iNdEx:])
@@ -2547,6 +2708,38 @@ func (m *UpdateDoctorTimeReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorTimes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctorTimes(dAtA[iNdEx:])
@@ -2958,6 +3151,70 @@ func (m *GetAllDoctorTimesReq) Unmarshal(dAtA []byte) error {
 			}
 			m.OrderBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorTimes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorTimes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorTimes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctorTimes(dAtA[iNdEx:])
@@ -33,6 +33,7 @@ type GetAllDepartment struct {
 	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value"`
 	OrderBy              string   `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
 	IsActive             bool     `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	BranchId             string   `protobuf:"bytes,7,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -113,6 +114,13 @@ func (m *GetAllDepartment) GetIsActive() bool {
 	return false
 }
 
+func (m *GetAllDepartment) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
 type ListDepartments struct {
 	Count                int64         `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
 	Departments          []*Department `protobuf:"bytes,2,rep,name=departments,proto3" json:"departments"`
@@ -226,6 +234,7 @@ type Department struct {
 	CreatedAt            string   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string   `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	DeletedAt            string   `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	BranchId             string   `protobuf:"bytes,11,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -334,6 +343,13 @@ func (m *Department) GetDeletedAt() string {
 	return ""
 }
 
+func (m *Department) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
 type GetReqStrDepartment struct {
 	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
@@ -410,41 +426,42 @@ func init() {
 }
 
 var fileDescriptor_28b27ef028e04df4 = []byte{
-	// 536 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xfe, 0xed, 0x34, 0xa9, 0x33, 0xf9, 0x05, 0xce, 0x82, 0x2a, 0xd3, 0x42, 0x08, 0xe1, 0x12,
-	0x81, 0x08, 0x52, 0xb9, 0x70, 0x4d, 0x88, 0x14, 0x21, 0x55, 0x95, 0x70, 0xd4, 0x2b, 0xd6, 0xc6,
-	0x9e, 0x36, 0x2b, 0x6d, 0xe2, 0xb0, 0xbb, 0x8e, 0x94, 0x77, 0xe0, 0x01, 0x38, 0xf2, 0x0c, 0x3c,
-	0x05, 0x47, 0x1e, 0x01, 0x85, 0x17, 0x41, 0x1e, 0x9b, 0x7a, 0x13, 0xa5, 0xe2, 0xc0, 0xcd, 0xf3,
-	0x7d, 0xa3, 0xd9, 0xf9, 0xbe, 0xfd, 0xd6, 0xf0, 0x7c, 0x8e, 0x5c, 0x9a, 0x79, 0xcc, 0x15, 0xbe,
-	0xd2, 0xa8, 0xd6, 0x22, 0xc6, 0xd7, 0x09, 0xae, 0xb8, 0x32, 0x0b, 0x5c, 0x9a, 0xc1, 0x4a, 0xa5,
-	0x26, 0x65, 0x50, 0x35, 0xf5, 0xbe, 0x3a, 0xe0, 0x4f, 0xd0, 0x0c, 0xa5, 0x1c, 0xdf, 0xb6, 0x31,
-	0x06, 0x47, 0x2b, 0x7e, 0x83, 0x81, 0xd3, 0x75, 0xfa, 0xb5, 0x90, 0xbe, 0xd9, 0x43, 0xa8, 0x4b,
-	0xb1, 0x10, 0x26, 0x70, 0x09, 0x2c, 0x8a, 0x1c, 0xbd, 0x16, 0x28, 0x93, 0xa0, 0xd6, 0x75, 0xfa,
-	0xcd, 0xb0, 0x28, 0x72, 0x74, 0xcd, 0x65, 0x86, 0xc1, 0x51, 0x81, 0x52, 0xc1, 0x1e, 0x81, 0x97,
-	0xaa, 0x04, 0x55, 0x34, 0xdb, 0x04, 0x75, 0x22, 0x8e, 0xa9, 0x1e, 0x6d, 0xd8, 0x19, 0x34, 0x85,
-	0x8e, 0x78, 0x6c, 0xc4, 0x1a, 0x83, 0x46, 0xd7, 0xe9, 0x7b, 0xa1, 0x27, 0xf4, 0x90, 0xea, 0x1e,
-	0x87, 0xfb, 0x17, 0x42, 0x9b, 0x6a, 0x3f, 0x9d, 0x1f, 0x10, 0xa7, 0xd9, 0xd2, 0x94, 0x1b, 0x16,
-	0x05, 0x7b, 0x0b, 0xad, 0x4a, 0xab, 0x0e, 0xdc, 0x6e, 0xad, 0xdf, 0x3a, 0x3f, 0x19, 0x54, 0x6a,
-	0x07, 0xd5, 0x8c, 0xd0, 0x6e, 0xed, 0xbd, 0x00, 0x7f, 0x6a, 0xb8, 0xc9, 0xb4, 0x65, 0xc2, 0x09,
-	0x34, 0x34, 0x61, 0x74, 0x88, 0x17, 0x96, 0x55, 0xef, 0x9b, 0x0b, 0x60, 0xb5, 0xdd, 0x03, 0x57,
-	0x24, 0xd4, 0xd2, 0x0c, 0x5d, 0x41, 0xda, 0x49, 0x15, 0xf9, 0x54, 0x0f, 0x8b, 0x22, 0x77, 0x74,
-	0xc9, 0x17, 0x58, 0xda, 0x44, 0xdf, 0xac, 0x9b, 0xaf, 0xab, 0x63, 0x25, 0x56, 0x46, 0xa4, 0xcb,
-	0xd2, 0x2b, 0x1b, 0x22, 0x5b, 0x16, 0xfc, 0x06, 0xa3, 0x4c, 0xc9, 0xd2, 0x32, 0x8f, 0x80, 0x2b,
-	0x25, 0xd9, 0x33, 0xf8, 0xff, 0x5a, 0xa6, 0xa9, 0x8a, 0x96, 0xd9, 0x62, 0x86, 0x8a, 0x6c, 0xab,
-	0x87, 0x2d, 0xc2, 0x2e, 0x09, 0x62, 0x2f, 0xa1, 0xad, 0xe7, 0xa9, 0x32, 0x91, 0x7d, 0xce, 0x31,
-	0xcd, 0xf1, 0x89, 0x18, 0x5b, 0x87, 0x3d, 0x01, 0x88, 0x15, 0x72, 0x83, 0x49, 0xc4, 0x4d, 0xe0,
-	0x51, 0x57, 0xb3, 0x44, 0x86, 0x26, 0xa7, 0xb3, 0x55, 0xf2, 0x87, 0x6e, 0x16, 0x74, 0x89, 0x14,
-	0x74, 0x82, 0x12, 0x4b, 0x1a, 0x0a, 0xba, 0x44, 0x86, 0xa6, 0xf7, 0x11, 0x1e, 0x4c, 0xd0, 0x84,
-	0xf8, 0x69, 0x6a, 0x94, 0x65, 0xde, 0x6d, 0x7c, 0x9c, 0x83, 0xf1, 0x71, 0xed, 0xf8, 0xec, 0x64,
-	0xa4, 0xb6, 0x9b, 0x91, 0xf3, 0xcf, 0x35, 0x68, 0x57, 0x73, 0xa7, 0x45, 0xf2, 0xd9, 0x08, 0xfc,
-	0x77, 0x24, 0xc0, 0xbe, 0xd6, 0xc3, 0x79, 0x38, 0xbd, 0x03, 0x67, 0x17, 0xd0, 0x9e, 0xa0, 0x15,
-	0xbe, 0xd1, 0xe6, 0x7d, 0xc2, 0x9e, 0xda, 0xcd, 0x07, 0x84, 0xdd, 0x39, 0xed, 0x92, 0xa6, 0xed,
-	0xbc, 0x36, 0xcd, 0x1e, 0xef, 0x4d, 0xdb, 0xa1, 0x4f, 0xcf, 0x6c, 0x76, 0xff, 0x21, 0x8c, 0xc0,
-	0xbf, 0xa2, 0x3b, 0xf8, 0x07, 0x85, 0x1f, 0xc0, 0x1f, 0xd3, 0x45, 0x59, 0xd8, 0x5f, 0x05, 0xee,
-	0xec, 0xbc, 0xff, 0x76, 0x46, 0xfe, 0xf7, 0x6d, 0xc7, 0xf9, 0xb1, 0xed, 0x38, 0x3f, 0xb7, 0x1d,
-	0xe7, 0xcb, 0xaf, 0xce, 0x7f, 0xb3, 0x06, 0xfd, 0x7a, 0xde, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff,
-	0xfe, 0xd9, 0x4f, 0x2d, 0xa1, 0x04, 0x00, 0x00,
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xc5, 0x4e, 0xd3, 0x3a, 0x13, 0x04, 0xce, 0x82, 0x2a, 0xd3, 0x42, 0x08, 0xe1, 0x12, 0x81,
+	0x28, 0x52, 0xb9, 0x70, 0x4d, 0xa8, 0x14, 0x55, 0xaa, 0x2a, 0xe1, 0xa8, 0x57, 0xac, 0x8d, 0x3d,
+	0x6d, 0x56, 0x72, 0xec, 0xb0, 0xbb, 0x8e, 0x94, 0x7f, 0xe0, 0x03, 0xf8, 0x19, 0xee, 0xdc, 0xe0,
+	0x13, 0x50, 0xf8, 0x11, 0xe4, 0x59, 0x53, 0x6f, 0xa2, 0x54, 0x1c, 0xb8, 0xf9, 0xbd, 0x37, 0xd9,
+	0x99, 0x79, 0x33, 0x13, 0x78, 0x39, 0x43, 0x9e, 0xea, 0x59, 0xcc, 0x25, 0xbe, 0x51, 0x28, 0x97,
+	0x22, 0xc6, 0xb7, 0x09, 0x2e, 0xb8, 0xd4, 0x73, 0xcc, 0xf4, 0xc9, 0x42, 0xe6, 0x3a, 0x67, 0x50,
+	0x07, 0xf5, 0xbf, 0x39, 0xe0, 0x8f, 0x51, 0x0f, 0xd3, 0xf4, 0xec, 0x36, 0x8c, 0x31, 0xd8, 0x5b,
+	0xf0, 0x1b, 0x0c, 0x9c, 0x9e, 0x33, 0x68, 0x84, 0xf4, 0xcd, 0x1e, 0x43, 0x33, 0x15, 0x73, 0xa1,
+	0x03, 0x97, 0x48, 0x03, 0x4a, 0xf6, 0x5a, 0x60, 0x9a, 0x04, 0x8d, 0x9e, 0x33, 0x68, 0x85, 0x06,
+	0x94, 0xec, 0x92, 0xa7, 0x05, 0x06, 0x7b, 0x86, 0x25, 0xc0, 0x9e, 0x80, 0x97, 0xcb, 0x04, 0x65,
+	0x34, 0x5d, 0x05, 0x4d, 0x12, 0x0e, 0x08, 0x8f, 0x56, 0xec, 0x18, 0x5a, 0x42, 0x45, 0x3c, 0xd6,
+	0x62, 0x89, 0xc1, 0x7e, 0xcf, 0x19, 0x78, 0xa1, 0x27, 0xd4, 0x90, 0x70, 0x29, 0x4e, 0x25, 0xcf,
+	0xe2, 0x59, 0x24, 0x92, 0xe0, 0x80, 0x7e, 0xe8, 0x19, 0xe2, 0x3c, 0xe9, 0x73, 0x78, 0x78, 0x21,
+	0x94, 0xae, 0x8b, 0x57, 0x65, 0xf6, 0x38, 0x2f, 0x32, 0x5d, 0x95, 0x6f, 0x00, 0x7b, 0x0f, 0xed,
+	0xda, 0x08, 0x15, 0xb8, 0xbd, 0xc6, 0xa0, 0x7d, 0x7a, 0x78, 0x52, 0x5b, 0x71, 0x52, 0xbf, 0x11,
+	0xda, 0xa1, 0xfd, 0x57, 0xe0, 0x4f, 0x34, 0xd7, 0x85, 0xb2, 0x1c, 0x3a, 0x84, 0x7d, 0x45, 0x1c,
+	0x25, 0xf1, 0xc2, 0x0a, 0xf5, 0x7f, 0xb8, 0x00, 0x56, 0xd8, 0x03, 0x70, 0x45, 0x42, 0x21, 0xad,
+	0xd0, 0x15, 0x64, 0x0c, 0xb5, 0x4c, 0x26, 0x36, 0x43, 0x03, 0x4a, 0xbb, 0x33, 0x3e, 0xc7, 0xca,
+	0x43, 0xfa, 0x66, 0xbd, 0xb2, 0x5c, 0x15, 0x4b, 0xb1, 0xd0, 0x22, 0xcf, 0x2a, 0x23, 0x6d, 0x8a,
+	0x3c, 0x9b, 0xf3, 0x1b, 0x8c, 0x0a, 0x99, 0x56, 0x7e, 0x7a, 0x44, 0x5c, 0xc9, 0x94, 0xbd, 0x80,
+	0xfb, 0xd7, 0x69, 0x9e, 0xcb, 0x28, 0x2b, 0xe6, 0x53, 0x94, 0xe4, 0x69, 0x33, 0x6c, 0x13, 0x77,
+	0x49, 0x14, 0x7b, 0x0d, 0x1d, 0x35, 0xcb, 0xa5, 0x8e, 0xec, 0x3c, 0xc6, 0x5e, 0x9f, 0x84, 0x33,
+	0x2b, 0xd9, 0x33, 0x80, 0x58, 0x22, 0xd7, 0x98, 0x44, 0x5c, 0x07, 0x1e, 0x45, 0xb5, 0x2a, 0x66,
+	0xa8, 0x4b, 0xb9, 0x58, 0x24, 0x7f, 0xe5, 0x96, 0x91, 0x2b, 0xc6, 0xc8, 0x09, 0xa6, 0x58, 0xc9,
+	0x60, 0xe4, 0x8a, 0x19, 0xea, 0xcd, 0x01, 0xb7, 0xb7, 0x06, 0xfc, 0x09, 0x1e, 0x8d, 0x51, 0x87,
+	0xf8, 0x79, 0xa2, 0xa5, 0xe5, 0xec, 0xed, 0xe2, 0x39, 0x3b, 0x17, 0xcf, 0xb5, 0x17, 0x6f, 0x63,
+	0xbb, 0x1a, 0x9b, 0xdb, 0x75, 0xfa, 0xa5, 0x01, 0x9d, 0xfa, 0xdd, 0x89, 0xb9, 0x19, 0x36, 0x02,
+	0xff, 0x03, 0x75, 0x67, 0xcf, 0x7c, 0xf7, 0xb2, 0x1c, 0xdd, 0xc1, 0xb3, 0x0b, 0xe8, 0x8c, 0xd1,
+	0xda, 0xcc, 0xd1, 0xea, 0x3c, 0x61, 0xcf, 0xed, 0xe0, 0x1d, 0x8d, 0xdd, 0xf9, 0xda, 0x25, 0x74,
+	0xb6, 0xef, 0x54, 0xb1, 0xa7, 0x5b, 0xaf, 0x6d, 0xc8, 0x47, 0xc7, 0xb6, 0xba, 0x7d, 0x25, 0x23,
+	0xf0, 0xaf, 0x68, 0x40, 0xff, 0xd1, 0xe1, 0x47, 0xf0, 0xcf, 0x68, 0x8a, 0x16, 0xf7, 0xcf, 0x06,
+	0x37, 0x6a, 0xde, 0x3e, 0xac, 0x91, 0xff, 0x7d, 0xdd, 0x75, 0x7e, 0xae, 0xbb, 0xce, 0xaf, 0x75,
+	0xd7, 0xf9, 0xfa, 0xbb, 0x7b, 0x6f, 0xba, 0x4f, 0x7f, 0x5a, 0xef, 0xfe, 0x0c, 0x00, 0xa7, 0xaf,
+	0x0b, 0xd1, 0xdb, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -695,6 +712,13 @@ func (m *GetAllDepartment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDepartment(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.IsActive {
 		i--
 		if m.IsActive {
@@ -846,6 +870,13 @@ func (m *Department) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDepartment(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.DeletedAt) > 0 {
 		i -= len(m.DeletedAt)
 		copy(dAtA[i:], m.DeletedAt)
@@ -1004,6 +1035,10 @@ func (m *GetAllDepartment) Size() (n int) {
 	if m.IsActive {
 		n += 2
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovDepartment(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1090,6 +1125,10 @@ func (m *Department) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDepartment(uint64(l))
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovDepartment(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1308,6 +1347,38 @@ func (m *GetAllDepartment) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsActive = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepartment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDepartment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDepartment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDepartment(dAtA[iNdEx:])
@@ -1828,6 +1899,38 @@ func (m *Department) Unmarshal(dAtA []byte) error {
 			}
 			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepartment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDepartment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDepartment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDepartment(dAtA[iNdEx:])
@@ -98,6 +98,7 @@ type GetReqStrDep struct {
 	Field                string   `protobuf:"bytes,5,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,6,opt,name=value,proto3" json:"value"`
 	OrderBy              string   `protobuf:"bytes,7,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
+	BranchId             string   `protobuf:"bytes,8,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -185,6 +186,13 @@ func (m *GetReqStrDep) GetOrderBy() string {
 	return ""
 }
 
+func (m *GetReqStrDep) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
 type GetReqStrSpec struct {
 	SpecializationId     string   `protobuf:"bytes,1,opt,name=specialization_id,json=specializationId,proto3" json:"specialization_id"`
 	IsActive             bool     `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active"`
@@ -193,6 +201,7 @@ type GetReqStrSpec struct {
 	Field                string   `protobuf:"bytes,5,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,6,opt,name=value,proto3" json:"value"`
 	OrderBy              string   `protobuf:"bytes,7,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
+	BranchId             string   `protobuf:"bytes,8,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -280,6 +289,13 @@ func (m *GetReqStrSpec) GetOrderBy() string {
 	return ""
 }
 
+func (m *GetReqStrSpec) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
 type StatusDoctor struct {
 	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -334,6 +350,7 @@ type GetAllDoctorS struct {
 	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value"`
 	OrderBy              string   `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
 	IsActive             bool     `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	BranchId             string   `protobuf:"bytes,7,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -414,6 +431,13 @@ func (m *GetAllDoctorS) GetIsActive() bool {
 	return false
 }
 
+func (m *GetAllDoctorS) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
 type ListDoctors struct {
 	Count                int64     `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
 	Doctors              []*Doctor `protobuf:"bytes,2,rep,name=doctors,proto3" json:"doctors"`
@@ -551,6 +575,8 @@ type DoctorAndDoctorHours struct {
 	CreatedAt            string   `protobuf:"bytes,24,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string   `protobuf:"bytes,25,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	DeletedAt            string   `protobuf:"bytes,26,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	Rating               float32  `protobuf:"fixed32,27,opt,name=rating,proto3" json:"rating"`
+	ReviewCount          int32    `protobuf:"varint,28,opt,name=review_count,json=reviewCount,proto3" json:"review_count"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -771,6 +797,20 @@ func (m *DoctorAndDoctorHours) GetDeletedAt() string {
 	return ""
 }
 
+func (m *DoctorAndDoctorHours) GetRating() float32 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+func (m *DoctorAndDoctorHours) GetReviewCount() int32 {
+	if m != nil {
+		return m.ReviewCount
+	}
+	return 0
+}
+
 type Doctor struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Order                int32    `protobuf:"varint,2,opt,name=order,proto3" json:"order"`
@@ -1009,67 +1049,70 @@ func init() {
 func init() { proto.RegisterFile("healthcare-service/doctor.proto", fileDescriptor_ce53f37ef6317b16) }
 
 var fileDescriptor_ce53f37ef6317b16 = []byte{
-	// 951 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0xc6, 0x4e, 0xec, 0xd8, 0x65, 0x7b, 0x93, 0xed, 0x64, 0x93, 0xb6, 0x21, 0x8e, 0x31, 0xd2,
-	0x2a, 0x12, 0x10, 0xa4, 0x45, 0xda, 0xbb, 0xbd, 0x41, 0x80, 0x40, 0x41, 0x8c, 0x59, 0xad, 0xe0,
-	0x32, 0x6a, 0x7b, 0xca, 0x71, 0x2b, 0xf3, 0x47, 0x4f, 0x3b, 0xd1, 0x70, 0xe3, 0xc4, 0x03, 0x70,
-	0xe1, 0xc2, 0xc3, 0x70, 0x43, 0x9c, 0x78, 0x04, 0x14, 0x5e, 0x04, 0x75, 0x75, 0x3b, 0xfe, 0x59,
-	0x27, 0x86, 0x0b, 0xe2, 0xc0, 0xad, 0xbf, 0xaf, 0xca, 0x5f, 0x57, 0x55, 0x7f, 0x9e, 0x6e, 0x38,
-	0x99, 0xa0, 0x08, 0xf5, 0x64, 0x24, 0x14, 0xbe, 0x9f, 0xa1, 0xba, 0x96, 0x23, 0xfc, 0x20, 0x48,
-	0x46, 0x3a, 0x51, 0x67, 0xa9, 0x4a, 0x74, 0xc2, 0x60, 0x9e, 0xd0, 0xfd, 0x06, 0x76, 0x3f, 0x46,
-	0xed, 0xe1, 0xb7, 0x03, 0xad, 0xce, 0x29, 0x89, 0x1d, 0x40, 0x69, 0x2c, 0x31, 0x0c, 0x78, 0xa1,
-	0x53, 0x38, 0xad, 0x7a, 0x16, 0x18, 0xf6, 0x5a, 0x84, 0x53, 0xe4, 0x45, 0xcb, 0x12, 0x60, 0x6f,
-	0x42, 0x55, 0x66, 0xbe, 0x18, 0x69, 0x79, 0x8d, 0x7c, 0xab, 0x53, 0x38, 0xad, 0x78, 0x15, 0x99,
-	0xf5, 0x08, 0x77, 0x7f, 0x29, 0x40, 0x7d, 0x2e, 0x8e, 0x29, 0x7b, 0x07, 0x1a, 0x01, 0xa6, 0x42,
-	0xe9, 0x08, 0x63, 0xed, 0xcb, 0xd9, 0x0e, 0xf5, 0x39, 0xf9, 0x69, 0xb0, 0x2c, 0x59, 0x5c, 0x96,
-	0x64, 0x0c, 0xb6, 0x53, 0x71, 0x69, 0xb7, 0x2a, 0x79, 0xb4, 0x36, 0x95, 0x85, 0x32, 0x92, 0x9a,
-	0x6f, 0x13, 0x69, 0xc1, 0xbc, 0x8b, 0xd2, 0xda, 0x2e, 0xca, 0x8b, 0x5d, 0x34, 0xa1, 0x92, 0xa8,
-	0x00, 0x95, 0x3f, 0xcc, 0xf9, 0x0e, 0x05, 0x76, 0x08, 0xf7, 0xf3, 0xee, 0x6f, 0x05, 0x68, 0xdc,
-	0xf5, 0x30, 0x48, 0x71, 0xc4, 0xde, 0x85, 0xc7, 0x59, 0x8a, 0x23, 0x29, 0x42, 0xf9, 0x9d, 0xd0,
-	0x32, 0x89, 0xe7, 0x8d, 0xec, 0x2d, 0x07, 0xfe, 0x73, 0xcd, 0x3c, 0x85, 0xfa, 0x40, 0x0b, 0x3d,
-	0xcd, 0xdc, 0x49, 0x1f, 0x42, 0x39, 0x23, 0x4c, 0xf5, 0x57, 0x3c, 0x87, 0xba, 0x3f, 0xdb, 0xa6,
-	0x7b, 0x61, 0x68, 0x13, 0x07, 0x77, 0xa5, 0x9a, 0xbc, 0xad, 0xd5, 0x52, 0x8b, 0x44, 0xae, 0x96,
-	0xba, 0xb5, 0xb6, 0xd4, 0xed, 0xfb, 0x4a, 0x2d, 0x2d, 0x95, 0xba, 0x3c, 0xb8, 0xf2, 0x8a, 0xb1,
-	0xbe, 0x84, 0xda, 0xe7, 0x32, 0xd3, 0xb6, 0xb8, 0xcc, 0x88, 0x8f, 0x92, 0x69, 0xac, 0x5d, 0x75,
-	0x16, 0xb0, 0xf7, 0x60, 0xc7, 0xba, 0x3e, 0xe3, 0xc5, 0xce, 0xd6, 0x69, 0xed, 0x19, 0x3b, 0x9b,
-	0xfb, 0xfe, 0xcc, 0xfe, 0xd6, 0x9b, 0xa5, 0x74, 0x53, 0xd8, 0x5f, 0x90, 0xec, 0xc5, 0xc1, 0x27,
-	0xc9, 0xf4, 0x5e, 0xe9, 0x17, 0x50, 0xb7, 0xbf, 0xf3, 0x27, 0x26, 0xcb, 0xe9, 0x77, 0x5e, 0xd7,
-	0xef, 0xc5, 0x81, 0x5d, 0x90, 0x9a, 0x57, 0x0b, 0xe6, 0xa0, 0xfb, 0x7d, 0x19, 0x0e, 0xd6, 0x65,
-	0xb1, 0x47, 0x50, 0xbc, 0x73, 0x54, 0x51, 0xd2, 0xec, 0x68, 0x2a, 0x34, 0xe7, 0x92, 0x67, 0x01,
-	0x3b, 0x06, 0x18, 0x4b, 0x95, 0x69, 0x3f, 0x16, 0x11, 0xba, 0x61, 0x57, 0x89, 0xb9, 0x10, 0x11,
-	0xfd, 0x31, 0x43, 0x31, 0x8b, 0xda, 0xa1, 0x57, 0x0c, 0x31, 0x0b, 0xca, 0x48, 0x5c, 0xa2, 0x3f,
-	0x55, 0xa1, 0x1b, 0x7c, 0x85, 0x88, 0x97, 0x2a, 0x34, 0xa6, 0xb8, 0xc4, 0xd8, 0xec, 0x67, 0x6d,
-	0xe5, 0x90, 0xd9, 0x70, 0x28, 0x95, 0x9e, 0xf8, 0x81, 0xd0, 0xe8, 0x9c, 0x55, 0x25, 0xe6, 0x5c,
-	0x68, 0x64, 0x6f, 0x43, 0x3d, 0x9d, 0x24, 0x31, 0xfa, 0xf1, 0x34, 0x1a, 0xa2, 0xe2, 0x15, 0x4a,
-	0xa8, 0x11, 0x77, 0x41, 0x94, 0x69, 0x04, 0x23, 0x21, 0x43, 0x5e, 0xb5, 0x26, 0x20, 0xc0, 0x5a,
-	0x50, 0x49, 0x45, 0x96, 0xdd, 0x24, 0x2a, 0xe0, 0x60, 0x6b, 0x99, 0x61, 0xc6, 0x61, 0x47, 0x04,
-	0x81, 0xc2, 0x2c, 0xe3, 0x35, 0xeb, 0x0f, 0x07, 0x8d, 0x21, 0x47, 0x52, 0xe7, 0xbc, 0x4e, 0x34,
-	0xad, 0x4d, 0x36, 0x9d, 0x8f, 0xca, 0x79, 0xc3, 0x66, 0x3b, 0x48, 0x46, 0x17, 0xa1, 0x50, 0x39,
-	0x7f, 0xd4, 0x29, 0x9c, 0x16, 0x3d, 0x87, 0x4c, 0x4f, 0x99, 0x16, 0x4a, 0xfb, 0x5a, 0x46, 0xc8,
-	0x77, 0x6d, 0x4f, 0xc4, 0x7c, 0x25, 0x23, 0x64, 0x27, 0x50, 0x1b, 0xcb, 0x58, 0x66, 0x13, 0x1b,
-	0xdf, 0xa3, 0x38, 0x58, 0x8a, 0x12, 0xda, 0x50, 0x0b, 0x44, 0xee, 0x27, 0x63, 0xff, 0x06, 0xf1,
-	0x8a, 0x3f, 0xb6, 0x02, 0x81, 0xc8, 0xbf, 0x18, 0xbf, 0x42, 0xbc, 0x62, 0x7b, 0xb0, 0x35, 0x94,
-	0x09, 0x67, 0xc4, 0x9b, 0x25, 0x7b, 0x0a, 0xbb, 0x76, 0xc7, 0x9b, 0x44, 0x5d, 0xd9, 0x51, 0xee,
-	0x53, 0xb4, 0x41, 0xf4, 0xab, 0x44, 0x5d, 0xd1, 0x38, 0xbb, 0xd0, 0xc0, 0x38, 0x58, 0xc8, 0x3a,
-	0xb0, 0xf3, 0xc4, 0x38, 0xb8, 0xcb, 0x39, 0x06, 0xa0, 0x78, 0x8e, 0x42, 0x65, 0xfc, 0x09, 0xb9,
-	0xa3, 0x6a, 0x98, 0xaf, 0x0d, 0xf1, 0xfa, 0xd7, 0xf6, 0x70, 0xcd, 0xd7, 0xf6, 0x04, 0x6a, 0x2a,
-	0x49, 0xa2, 0xd9, 0xa9, 0x1d, 0x91, 0x08, 0x18, 0xca, 0x1d, 0xda, 0x31, 0xc0, 0x48, 0xa1, 0xd0,
-	0x18, 0xf8, 0x42, 0x73, 0x6e, 0x3b, 0x74, 0x4c, 0x4f, 0x9b, 0xf0, 0x34, 0x0d, 0x66, 0xe1, 0xa6,
-	0x0d, 0x3b, 0xc6, 0x86, 0x03, 0x0c, 0xd1, 0x85, 0x5b, 0x6e, 0x3e, 0x96, 0xe9, 0xe9, 0xee, 0x8f,
-	0x25, 0x28, 0xbb, 0x6f, 0xd1, 0xff, 0xae, 0xff, 0xd7, 0x5c, 0xef, 0x5c, 0xb9, 0xfb, 0xa0, 0x2b,
-	0xf7, 0xfe, 0x96, 0x2b, 0x1f, 0x6f, 0x72, 0x25, 0xdb, 0xe8, 0xca, 0xfd, 0xcd, 0xae, 0x3c, 0xd8,
-	0xe0, 0xca, 0x27, 0x0f, 0xbb, 0xf2, 0xf0, 0x61, 0x57, 0x1e, 0xad, 0xb8, 0xf2, 0xd9, 0x0f, 0xdb,
-	0xd0, 0x70, 0x17, 0x9f, 0x7d, 0x3e, 0xb1, 0xe7, 0x50, 0x7f, 0x41, 0xe2, 0xce, 0xac, 0x6b, 0xae,
-	0x92, 0xd6, 0x1a, 0x8e, 0x5d, 0xd0, 0x3d, 0x6a, 0x41, 0x3f, 0x37, 0xef, 0x81, 0xc5, 0xa4, 0x95,
-	0x87, 0x57, 0x6b, 0xe3, 0x05, 0xc2, 0x3e, 0x5b, 0xbe, 0x97, 0x33, 0xd6, 0x5c, 0xd1, 0x9b, 0x5f,
-	0xd9, 0xad, 0x93, 0xc5, 0xd0, 0xba, 0xbb, 0xed, 0x39, 0xd4, 0x5f, 0xd2, 0x48, 0xfe, 0x61, 0x53,
-	0x1f, 0x41, 0xfd, 0x9c, 0x66, 0xe5, 0xf0, 0x83, 0x3d, 0xf1, 0xc5, 0xe0, 0xd2, 0xe3, 0xe3, 0x02,
-	0x9a, 0x0b, 0x55, 0xf5, 0xf3, 0xf3, 0x45, 0x03, 0xf0, 0xf5, 0x9a, 0x98, 0xb6, 0x8e, 0xee, 0x69,
-	0x8b, 0x79, 0xf0, 0xd6, 0x1c, 0xf6, 0xf3, 0xc1, 0xea, 0x53, 0xac, 0xb9, 0x56, 0xd2, 0xa4, 0xdd,
-	0xab, 0xd9, 0xdf, 0xfb, 0xf5, 0xb6, 0x5d, 0xf8, 0xfd, 0xb6, 0x5d, 0xf8, 0xe3, 0xb6, 0x5d, 0xf8,
-	0xe9, 0xcf, 0xf6, 0x1b, 0xc3, 0x32, 0x3d, 0xa1, 0x3f, 0xfc, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x26,
-	0x4e, 0xb9, 0x9e, 0x65, 0x0b, 0x00, 0x00,
+	// 1000 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xdf, 0x6e, 0x63, 0xb5,
+	0x13, 0xfe, 0x25, 0x6d, 0xd2, 0x64, 0x92, 0x6c, 0x5b, 0xb7, 0xdb, 0xba, 0xd9, 0xed, 0x9f, 0x5f,
+	0x90, 0x56, 0x95, 0x80, 0x22, 0x2d, 0xd2, 0xde, 0xa7, 0x2d, 0x82, 0x15, 0xa8, 0x88, 0x94, 0xd5,
+	0x0a, 0x6e, 0x8e, 0x9c, 0x78, 0xda, 0x58, 0x3d, 0xff, 0xf0, 0x71, 0x5a, 0x1d, 0x5e, 0x80, 0x07,
+	0xe0, 0x86, 0x67, 0x41, 0x3c, 0x00, 0x97, 0x3c, 0x01, 0x42, 0xe5, 0x82, 0xd7, 0x40, 0x1e, 0x3b,
+	0x3d, 0x49, 0x9a, 0x36, 0x70, 0x83, 0x84, 0xc4, 0xdd, 0xf9, 0xbe, 0x99, 0x8c, 0xfd, 0xcd, 0x7c,
+	0x8e, 0x0d, 0xfb, 0x43, 0x14, 0xa1, 0x19, 0x0e, 0x84, 0xc6, 0xf7, 0x33, 0xd4, 0xd7, 0x6a, 0x80,
+	0x1f, 0xc8, 0x64, 0x60, 0x12, 0x7d, 0x94, 0xea, 0xc4, 0x24, 0x0c, 0x8a, 0x84, 0xce, 0xd7, 0xb0,
+	0xfa, 0x31, 0x9a, 0x1e, 0x7e, 0x73, 0x6e, 0xf4, 0x29, 0x25, 0xb1, 0x4d, 0xa8, 0x5c, 0x28, 0x0c,
+	0x25, 0x2f, 0x1d, 0x94, 0x0e, 0xeb, 0x3d, 0x07, 0x2c, 0x7b, 0x2d, 0xc2, 0x11, 0xf2, 0xb2, 0x63,
+	0x09, 0xb0, 0x67, 0x50, 0x57, 0x59, 0x20, 0x06, 0x46, 0x5d, 0x23, 0x5f, 0x3a, 0x28, 0x1d, 0xd6,
+	0x7a, 0x35, 0x95, 0x75, 0x09, 0x77, 0x7e, 0x2d, 0x41, 0xb3, 0x28, 0x8e, 0x29, 0x7b, 0x07, 0x5a,
+	0x12, 0x53, 0xa1, 0x4d, 0x84, 0xb1, 0x09, 0xd4, 0x78, 0x85, 0x66, 0x41, 0xbe, 0x96, 0xd3, 0x25,
+	0xcb, 0xd3, 0x25, 0x19, 0x83, 0xe5, 0x54, 0x5c, 0xba, 0xa5, 0x2a, 0x3d, 0xfa, 0xb6, 0x3b, 0x0b,
+	0x55, 0xa4, 0x0c, 0x5f, 0x26, 0xd2, 0x81, 0x42, 0x45, 0x65, 0xae, 0x8a, 0xea, 0xa4, 0x8a, 0x1d,
+	0xa8, 0x25, 0x5a, 0xa2, 0x0e, 0xfa, 0x39, 0x5f, 0xa1, 0xc0, 0x0a, 0xe1, 0xe3, 0xdc, 0xee, 0xa6,
+	0xaf, 0x45, 0x3c, 0x18, 0xda, 0xed, 0xd6, 0x28, 0x56, 0x73, 0xc4, 0x6b, 0xd9, 0xf9, 0xa3, 0x04,
+	0xad, 0x3b, 0x81, 0xe7, 0x29, 0x0e, 0xd8, 0xbb, 0xb0, 0x9e, 0xa5, 0x38, 0x50, 0x22, 0x54, 0xdf,
+	0x0a, 0xa3, 0x92, 0xb8, 0x50, 0xb9, 0x36, 0x1d, 0xf8, 0x77, 0x29, 0x7d, 0x01, 0xcd, 0x73, 0x23,
+	0xcc, 0x28, 0xf3, 0x1e, 0xd9, 0x82, 0x6a, 0x46, 0x98, 0xc4, 0xd5, 0x7a, 0x1e, 0x75, 0x7e, 0x74,
+	0x1d, 0xe9, 0x86, 0xa1, 0x4b, 0x3c, 0xbf, 0xd3, 0x61, 0xf3, 0x96, 0x66, 0x75, 0x94, 0x89, 0x9c,
+	0xd5, 0xb1, 0x34, 0x57, 0xc7, 0xf2, 0x43, 0x3a, 0x2a, 0xf7, 0x74, 0x14, 0x5d, 0xad, 0xce, 0x74,
+	0x75, 0x4a, 0xe4, 0xca, 0x8c, 0xc8, 0x2f, 0xa0, 0xf1, 0x99, 0xca, 0x8c, 0xdb, 0x79, 0x66, 0x57,
+	0x1e, 0x24, 0xa3, 0xd8, 0xf8, 0xad, 0x3b, 0xc0, 0xde, 0x83, 0x15, 0x77, 0x98, 0x32, 0x5e, 0x3e,
+	0x58, 0x3a, 0x6c, 0xbc, 0x64, 0x47, 0xc5, 0x71, 0x3a, 0x72, 0xbf, 0xed, 0x8d, 0x53, 0x3a, 0x29,
+	0x6c, 0x4c, 0x94, 0xec, 0xc6, 0xf2, 0x93, 0x64, 0xf4, 0x60, 0xe9, 0x13, 0x68, 0xba, 0xdf, 0x05,
+	0xc3, 0x64, 0x74, 0x57, 0xff, 0xe0, 0x7e, 0xfd, 0x6e, 0x2c, 0xdd, 0x07, 0x55, 0xeb, 0x35, 0x64,
+	0x01, 0x3a, 0x3f, 0x55, 0x61, 0x73, 0x5e, 0x16, 0x7b, 0x02, 0xe5, 0x3b, 0x2f, 0x96, 0x15, 0x35,
+	0x96, 0x5a, 0x46, 0x43, 0xa8, 0xf4, 0x1c, 0x60, 0xbb, 0x00, 0x17, 0x4a, 0x67, 0x26, 0x88, 0x45,
+	0x84, 0x7e, 0x12, 0x75, 0x62, 0xce, 0x44, 0x44, 0xfd, 0x0b, 0xc5, 0x38, 0xea, 0x26, 0x52, 0x0b,
+	0x45, 0x11, 0x54, 0x91, 0xb8, 0xc4, 0x60, 0xa4, 0x43, 0x3f, 0x95, 0x1a, 0x11, 0x6f, 0x74, 0x68,
+	0x1d, 0x73, 0x89, 0xb1, 0x5d, 0xcf, 0x19, 0xd2, 0x23, 0xbb, 0x60, 0x5f, 0x69, 0x33, 0x0c, 0xa4,
+	0x30, 0xe8, 0x47, 0x52, 0x27, 0xe6, 0x54, 0x18, 0x64, 0xff, 0x87, 0x66, 0x3a, 0x4c, 0x62, 0x0c,
+	0xe2, 0x51, 0xd4, 0x47, 0xed, 0x8d, 0xd9, 0x20, 0xee, 0x8c, 0x28, 0x2b, 0x04, 0x23, 0xa1, 0x42,
+	0x5e, 0x77, 0x0e, 0x21, 0xc0, 0xda, 0x50, 0x4b, 0x45, 0x96, 0xdd, 0x24, 0x5a, 0x72, 0x70, 0x7b,
+	0x19, 0x63, 0xc6, 0x61, 0x45, 0x48, 0xa9, 0x31, 0xcb, 0x78, 0xc3, 0x99, 0xc7, 0x43, 0xeb, 0xd6,
+	0x81, 0x32, 0x39, 0x6f, 0x12, 0x4d, 0xdf, 0x36, 0x9b, 0xe6, 0xa3, 0x73, 0xde, 0x72, 0xd9, 0x1e,
+	0xd2, 0x29, 0x10, 0xa1, 0xd0, 0x39, 0x7f, 0x72, 0x50, 0x3a, 0x2c, 0xf7, 0x3c, 0xb2, 0x9a, 0x32,
+	0x23, 0xb4, 0x09, 0x8c, 0x8a, 0x90, 0xaf, 0x3a, 0x4d, 0xc4, 0x7c, 0xa9, 0x22, 0x64, 0xfb, 0xd0,
+	0xb8, 0x50, 0xb1, 0xca, 0x86, 0x2e, 0xbe, 0x46, 0x71, 0x70, 0x14, 0x25, 0xec, 0x41, 0x43, 0x8a,
+	0x3c, 0x48, 0x2e, 0x82, 0x1b, 0xc4, 0x2b, 0xbe, 0xee, 0x0a, 0x48, 0x91, 0x7f, 0x7e, 0xf1, 0x16,
+	0xf1, 0x8a, 0xad, 0xc1, 0x52, 0x5f, 0x25, 0x9c, 0x11, 0x6f, 0x3f, 0xd9, 0x0b, 0x58, 0x75, 0x2b,
+	0xde, 0x24, 0xfa, 0xca, 0xb5, 0x72, 0x83, 0xa2, 0x2d, 0xa2, 0xdf, 0x26, 0xfa, 0x8a, 0xda, 0xd9,
+	0x81, 0x16, 0xc6, 0x72, 0x22, 0x6b, 0xd3, 0xf5, 0x13, 0x63, 0x79, 0x97, 0xb3, 0x0b, 0x40, 0xf1,
+	0x1c, 0x85, 0xce, 0xf8, 0x53, 0x72, 0x47, 0xdd, 0x32, 0x5f, 0x59, 0xe2, 0xfe, 0x9f, 0xf8, 0xd6,
+	0x9c, 0x3f, 0xf1, 0x7d, 0x68, 0xe8, 0x24, 0x89, 0xc6, 0x53, 0xdb, 0xa6, 0x22, 0x60, 0x29, 0x3f,
+	0xb4, 0x5d, 0x80, 0x81, 0x46, 0x61, 0x50, 0x06, 0xc2, 0x70, 0xee, 0x14, 0x7a, 0xa6, 0x6b, 0x6c,
+	0x78, 0x94, 0xca, 0x71, 0x78, 0xc7, 0x85, 0x3d, 0xe3, 0xc2, 0x12, 0x43, 0xf4, 0xe1, 0xb6, 0xef,
+	0x8f, 0x63, 0xba, 0xc6, 0xce, 0x45, 0x0b, 0xa3, 0xe2, 0x4b, 0xfe, 0xcc, 0xcd, 0xc5, 0x21, 0x6b,
+	0x26, 0x8d, 0xd7, 0x0a, 0x6f, 0x02, 0x77, 0xfa, 0x9e, 0xd3, 0xb6, 0x1a, 0x8e, 0x3b, 0xb1, 0x54,
+	0xe7, 0xfb, 0x0a, 0x54, 0xfd, 0x7f, 0xdc, 0x7f, 0x07, 0xe6, 0x1f, 0x3b, 0x30, 0xde, 0xd0, 0xab,
+	0x8f, 0x1a, 0x7a, 0xed, 0x2f, 0x19, 0x7a, 0x7d, 0x91, 0xa1, 0xd9, 0x42, 0x43, 0x6f, 0x2c, 0x36,
+	0xf4, 0xe6, 0x02, 0x43, 0x3f, 0x7d, 0xdc, 0xd0, 0x5b, 0x8f, 0x1b, 0x7a, 0x7b, 0xc6, 0xd0, 0x2f,
+	0xbf, 0x5b, 0x86, 0x96, 0xbf, 0x50, 0xdd, 0x83, 0x8e, 0xbd, 0x82, 0xe6, 0x09, 0x15, 0x77, 0x34,
+	0x9b, 0x73, 0x0b, 0xb5, 0xe7, 0x70, 0xec, 0x8c, 0xee, 0x67, 0x07, 0x8e, 0x73, 0xfb, 0x08, 0x99,
+	0x4c, 0x9a, 0x79, 0x0a, 0xb6, 0x17, 0xde, 0x3d, 0xec, 0xd3, 0xe9, 0xfb, 0x3e, 0x63, 0x3b, 0x33,
+	0xf5, 0x8a, 0xa7, 0x40, 0x7b, 0x7f, 0x32, 0x34, 0xef, 0x5a, 0x7c, 0x05, 0xcd, 0x37, 0xd4, 0x92,
+	0xbf, 0x29, 0xea, 0x23, 0x68, 0x9e, 0x52, 0xaf, 0x3c, 0x7e, 0x54, 0x13, 0x9f, 0x0c, 0x4e, 0x3d,
+	0x6a, 0xce, 0x60, 0x67, 0x62, 0x57, 0xc7, 0xf9, 0xe9, 0xa4, 0x01, 0xf8, 0xfc, 0x9a, 0x98, 0xb6,
+	0xb7, 0x1f, 0x90, 0xc5, 0x7a, 0xf0, 0xbc, 0x80, 0xc7, 0xf9, 0xf9, 0xec, 0xfb, 0x6f, 0x67, 0x6e,
+	0x49, 0x9b, 0xf6, 0x60, 0xcd, 0xe3, 0xb5, 0x9f, 0x6f, 0xf7, 0x4a, 0xbf, 0xdc, 0xee, 0x95, 0x7e,
+	0xbb, 0xdd, 0x2b, 0xfd, 0xf0, 0xfb, 0xde, 0xff, 0xfa, 0x55, 0x7a, 0xd4, 0x7f, 0xf8, 0xe7, 0x00,
+	0x12, 0xe0, 0x09, 0xba, 0xf7, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1443,6 +1486,13 @@ func (m *GetReqStrDep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.OrderBy) > 0 {
 		i -= len(m.OrderBy)
 		copy(dAtA[i:], m.OrderBy)
@@ -1518,6 +1568,13 @@ func (m *GetReqStrSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.OrderBy) > 0 {
 		i -= len(m.OrderBy)
 		copy(dAtA[i:], m.OrderBy)
@@ -1630,6 +1687,13 @@ func (m *GetAllDoctorS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.IsActive {
 		i--
 		if m.IsActive {
@@ -1790,6 +1854,21 @@ func (m *DoctorAndDoctorHours) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ReviewCount != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.ReviewCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.Rating != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xdd
+	}
 	if len(m.DeletedAt) > 0 {
 		i -= len(m.DeletedAt)
 		copy(dAtA[i:], m.DeletedAt)
@@ -2252,6 +2331,10 @@ func (m *GetReqStrDep) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDoctor(uint64(l))
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2289,6 +2372,10 @@ func (m *GetReqStrSpec) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDoctor(uint64(l))
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2337,6 +2424,10 @@ func (m *GetAllDoctorS) Size() (n int) {
 	if m.IsActive {
 		n += 2
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2491,6 +2582,12 @@ func (m *DoctorAndDoctorHours) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovDoctor(uint64(l))
 	}
+	if m.Rating != 0 {
+		n += 6
+	}
+	if m.ReviewCount != 0 {
+		n += 2 + sovDoctor(uint64(m.ReviewCount))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2953,6 +3050,38 @@ func (m *GetReqStrDep) Unmarshal(dAtA []byte) error {
 			}
 			m.OrderBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctor(dAtA[iNdEx:])
@@ -3190,6 +3319,38 @@ func (m *GetReqStrSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.OrderBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctor(dAtA[iNdEx:])
@@ -3466,6 +3627,38 @@ func (m *GetAllDoctorS) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsActive = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctor(dAtA[iNdEx:])
@@ -4497,6 +4690,36 @@ func (m *DoctorAndDoctorHours) Unmarshal(dAtA []byte) error {
 			}
 			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 27:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rating = float32(math.Float32frombits(v))
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewCount", wireType)
+			}
+			m.ReviewCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReviewCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctor(dAtA[iNdEx:])
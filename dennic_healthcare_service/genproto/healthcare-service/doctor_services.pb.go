@@ -5,7 +5,6 @@ package healthcare
 
 import (
 	context "context"
-	encoding_binary "encoding/binary"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -28,17 +27,21 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type DoctorServices struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	DoctorServiceOrder   int32    `protobuf:"varint,2,opt,name=doctor_service_order,json=doctorServiceOrder,proto3" json:"doctor_service_order"`
-	DoctorId             string   `protobuf:"bytes,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
-	SpecializationId     string   `protobuf:"bytes,4,opt,name=specialization_id,json=specializationId,proto3" json:"specialization_id"`
-	OnlinePrice          float32  `protobuf:"fixed32,5,opt,name=online_price,json=onlinePrice,proto3" json:"online_price"`
-	OfflinePrice         float32  `protobuf:"fixed32,6,opt,name=offline_price,json=offlinePrice,proto3" json:"offline_price"`
-	Name                 string   `protobuf:"bytes,7,opt,name=name,proto3" json:"name"`
-	Duration             string   `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration"`
-	CreatedAt            string   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	DeletedAt            string   `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	Id                   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	DoctorServiceOrder   int32  `protobuf:"varint,2,opt,name=doctor_service_order,json=doctorServiceOrder,proto3" json:"doctor_service_order"`
+	DoctorId             string `protobuf:"bytes,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	SpecializationId     string `protobuf:"bytes,4,opt,name=specialization_id,json=specializationId,proto3" json:"specialization_id"`
+	Name                 string `protobuf:"bytes,7,opt,name=name,proto3" json:"name"`
+	CreatedAt            string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt            string `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	RequiredResourceType string `protobuf:"bytes,12,opt,name=required_resource_type,json=requiredResourceType,proto3" json:"required_resource_type"`
+	// decimal amounts, e.g. "150000.00"
+	OnlinePrice          string   `protobuf:"bytes,13,opt,name=online_price,json=onlinePrice,proto3" json:"online_price"`
+	OfflinePrice         string   `protobuf:"bytes,14,opt,name=offline_price,json=offlinePrice,proto3" json:"offline_price"`
+	Currency             string   `protobuf:"bytes,15,opt,name=currency,proto3" json:"currency"`
+	DurationMinutes      int32    `protobuf:"varint,16,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes"`
+	PrepInstructions     string   `protobuf:"bytes,17,opt,name=prep_instructions,json=prepInstructions,proto3" json:"prep_instructions"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -105,55 +108,394 @@ func (m *DoctorServices) GetSpecializationId() string {
 	return ""
 }
 
-func (m *DoctorServices) GetOnlinePrice() float32 {
+func (m *DoctorServices) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DoctorServices) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *DoctorServices) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *DoctorServices) GetDeletedAt() string {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return ""
+}
+
+func (m *DoctorServices) GetRequiredResourceType() string {
+	if m != nil {
+		return m.RequiredResourceType
+	}
+	return ""
+}
+
+func (m *DoctorServices) GetOnlinePrice() string {
 	if m != nil {
 		return m.OnlinePrice
 	}
-	return 0
+	return ""
 }
 
-func (m *DoctorServices) GetOfflinePrice() float32 {
+func (m *DoctorServices) GetOfflinePrice() string {
 	if m != nil {
 		return m.OfflinePrice
 	}
+	return ""
+}
+
+func (m *DoctorServices) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *DoctorServices) GetDurationMinutes() int32 {
+	if m != nil {
+		return m.DurationMinutes
+	}
 	return 0
 }
 
-func (m *DoctorServices) GetName() string {
+func (m *DoctorServices) GetPrepInstructions() string {
 	if m != nil {
-		return m.Name
+		return m.PrepInstructions
+	}
+	return ""
+}
+
+type ServicePrice struct {
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	DoctorServiceId string `protobuf:"bytes,2,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	// online | offline
+	Modality             string   `protobuf:"bytes,3,opt,name=modality,proto3" json:"modality"`
+	Amount               string   `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	Currency             string   `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency"`
+	EffectiveFrom        string   `protobuf:"bytes,6,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from"`
+	EffectiveTo          string   `protobuf:"bytes,7,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to"`
+	IsPromo              bool     `protobuf:"varint,8,opt,name=is_promo,json=isPromo,proto3" json:"is_promo"`
+	PromoName            string   `protobuf:"bytes,9,opt,name=promo_name,json=promoName,proto3" json:"promo_name"`
+	CreatedAt            string   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	DeletedAt            string   `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServicePrice) Reset()         { *m = ServicePrice{} }
+func (m *ServicePrice) String() string { return proto.CompactTextString(m) }
+func (*ServicePrice) ProtoMessage()    {}
+func (*ServicePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05a1dacb2d8172e2, []int{1}
+}
+func (m *ServicePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServicePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServicePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServicePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServicePrice.Merge(m, src)
+}
+func (m *ServicePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServicePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServicePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServicePrice proto.InternalMessageInfo
+
+func (m *ServicePrice) GetId() string {
+	if m != nil {
+		return m.Id
 	}
 	return ""
 }
 
-func (m *DoctorServices) GetDuration() string {
+func (m *ServicePrice) GetDoctorServiceId() string {
 	if m != nil {
-		return m.Duration
+		return m.DoctorServiceId
 	}
 	return ""
 }
 
-func (m *DoctorServices) GetCreatedAt() string {
+func (m *ServicePrice) GetModality() string {
 	if m != nil {
-		return m.CreatedAt
+		return m.Modality
 	}
 	return ""
 }
 
-func (m *DoctorServices) GetUpdatedAt() string {
+func (m *ServicePrice) GetAmount() string {
 	if m != nil {
-		return m.UpdatedAt
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *DoctorServices) GetDeletedAt() string {
+func (m *ServicePrice) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *ServicePrice) GetEffectiveFrom() string {
+	if m != nil {
+		return m.EffectiveFrom
+	}
+	return ""
+}
+
+func (m *ServicePrice) GetEffectiveTo() string {
+	if m != nil {
+		return m.EffectiveTo
+	}
+	return ""
+}
+
+func (m *ServicePrice) GetIsPromo() bool {
+	if m != nil {
+		return m.IsPromo
+	}
+	return false
+}
+
+func (m *ServicePrice) GetPromoName() string {
+	if m != nil {
+		return m.PromoName
+	}
+	return ""
+}
+
+func (m *ServicePrice) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *ServicePrice) GetDeletedAt() string {
 	if m != nil {
 		return m.DeletedAt
 	}
 	return ""
 }
 
+type ListServicePrices struct {
+	Count                int64           `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Prices               []*ServicePrice `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListServicePrices) Reset()         { *m = ListServicePrices{} }
+func (m *ListServicePrices) String() string { return proto.CompactTextString(m) }
+func (*ListServicePrices) ProtoMessage()    {}
+func (*ListServicePrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05a1dacb2d8172e2, []int{2}
+}
+func (m *ListServicePrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListServicePrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListServicePrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListServicePrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServicePrices.Merge(m, src)
+}
+func (m *ListServicePrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListServicePrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServicePrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListServicePrices proto.InternalMessageInfo
+
+func (m *ListServicePrices) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ListServicePrices) GetPrices() []*ServicePrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+type GetServicePrices struct {
+	DoctorServiceId      string   `protobuf:"bytes,1,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	Modality             string   `protobuf:"bytes,2,opt,name=modality,proto3" json:"modality"`
+	Page                 int64    `protobuf:"varint,3,opt,name=page,proto3" json:"page"`
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetServicePrices) Reset()         { *m = GetServicePrices{} }
+func (m *GetServicePrices) String() string { return proto.CompactTextString(m) }
+func (*GetServicePrices) ProtoMessage()    {}
+func (*GetServicePrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05a1dacb2d8172e2, []int{3}
+}
+func (m *GetServicePrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetServicePrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetServicePrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetServicePrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServicePrices.Merge(m, src)
+}
+func (m *GetServicePrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetServicePrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServicePrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetServicePrices proto.InternalMessageInfo
+
+func (m *GetServicePrices) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *GetServicePrices) GetModality() string {
+	if m != nil {
+		return m.Modality
+	}
+	return ""
+}
+
+func (m *GetServicePrices) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *GetServicePrices) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetEffectivePriceReq struct {
+	DoctorServiceId string `protobuf:"bytes,1,opt,name=doctor_service_id,json=doctorServiceId,proto3" json:"doctor_service_id"`
+	Modality        string `protobuf:"bytes,2,opt,name=modality,proto3" json:"modality"`
+	// "2006-01-02 15:04:05"; empty means now
+	At                   string   `protobuf:"bytes,3,opt,name=at,proto3" json:"at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEffectivePriceReq) Reset()         { *m = GetEffectivePriceReq{} }
+func (m *GetEffectivePriceReq) String() string { return proto.CompactTextString(m) }
+func (*GetEffectivePriceReq) ProtoMessage()    {}
+func (*GetEffectivePriceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05a1dacb2d8172e2, []int{4}
+}
+func (m *GetEffectivePriceReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEffectivePriceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEffectivePriceReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetEffectivePriceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEffectivePriceReq.Merge(m, src)
+}
+func (m *GetEffectivePriceReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEffectivePriceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEffectivePriceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEffectivePriceReq proto.InternalMessageInfo
+
+func (m *GetEffectivePriceReq) GetDoctorServiceId() string {
+	if m != nil {
+		return m.DoctorServiceId
+	}
+	return ""
+}
+
+func (m *GetEffectivePriceReq) GetModality() string {
+	if m != nil {
+		return m.Modality
+	}
+	return ""
+}
+
+func (m *GetEffectivePriceReq) GetAt() string {
+	if m != nil {
+		return m.At
+	}
+	return ""
+}
+
 type ListDoctorServices struct {
 	DoctorServices       []*DoctorServices `protobuf:"bytes,1,rep,name=doctorServices,proto3" json:"doctorServices"`
 	Count                int32             `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
@@ -166,7 +508,7 @@ func (m *ListDoctorServices) Reset()         { *m = ListDoctorServices{} }
 func (m *ListDoctorServices) String() string { return proto.CompactTextString(m) }
 func (*ListDoctorServices) ProtoMessage()    {}
 func (*ListDoctorServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05a1dacb2d8172e2, []int{1}
+	return fileDescriptor_05a1dacb2d8172e2, []int{5}
 }
 func (m *ListDoctorServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,7 +564,7 @@ func (m *GetReqStr) Reset()         { *m = GetReqStr{} }
 func (m *GetReqStr) String() string { return proto.CompactTextString(m) }
 func (*GetReqStr) ProtoMessage()    {}
 func (*GetReqStr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05a1dacb2d8172e2, []int{2}
+	return fileDescriptor_05a1dacb2d8172e2, []int{6}
 }
 func (m *GetReqStr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,6 +621,7 @@ type GetAllDoctorServiceS struct {
 	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value"`
 	OrderBy              string   `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
 	IsActive             bool     `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	BranchId             string   `protobuf:"bytes,7,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -288,7 +631,7 @@ func (m *GetAllDoctorServiceS) Reset()         { *m = GetAllDoctorServiceS{} }
 func (m *GetAllDoctorServiceS) String() string { return proto.CompactTextString(m) }
 func (*GetAllDoctorServiceS) ProtoMessage()    {}
 func (*GetAllDoctorServiceS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05a1dacb2d8172e2, []int{3}
+	return fileDescriptor_05a1dacb2d8172e2, []int{7}
 }
 func (m *GetAllDoctorServiceS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,6 +702,13 @@ func (m *GetAllDoctorServiceS) GetIsActive() bool {
 	return false
 }
 
+func (m *GetAllDoctorServiceS) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
 type Status struct {
 	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -370,7 +720,7 @@ func (m *Status) Reset()         { *m = Status{} }
 func (m *Status) String() string { return proto.CompactTextString(m) }
 func (*Status) ProtoMessage()    {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05a1dacb2d8172e2, []int{4}
+	return fileDescriptor_05a1dacb2d8172e2, []int{8}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,6 +758,10 @@ func (m *Status) GetStatus() bool {
 
 func init() {
 	proto.RegisterType((*DoctorServices)(nil), "healthcare.DoctorServices")
+	proto.RegisterType((*ServicePrice)(nil), "healthcare.ServicePrice")
+	proto.RegisterType((*ListServicePrices)(nil), "healthcare.ListServicePrices")
+	proto.RegisterType((*GetServicePrices)(nil), "healthcare.GetServicePrices")
+	proto.RegisterType((*GetEffectivePriceReq)(nil), "healthcare.GetEffectivePriceReq")
 	proto.RegisterType((*ListDoctorServices)(nil), "healthcare.ListDoctorServices")
 	proto.RegisterType((*GetReqStr)(nil), "healthcare.GetReqStr")
 	proto.RegisterType((*GetAllDoctorServiceS)(nil), "healthcare.GetAllDoctorServiceS")
@@ -419,42 +773,65 @@ func init() {
 }
 
 var fileDescriptor_05a1dacb2d8172e2 = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x51, 0x8e, 0xd3, 0x30,
-	0x10, 0x25, 0xcd, 0xb6, 0x9b, 0x4c, 0x97, 0x6a, 0x31, 0x05, 0x85, 0x22, 0xaa, 0x50, 0x7e, 0x2a,
-	0x21, 0x0a, 0x5a, 0x2e, 0x40, 0x4b, 0xa5, 0x55, 0x25, 0xb4, 0x20, 0x17, 0x24, 0xfe, 0x22, 0x6f,
-	0xec, 0xb2, 0x96, 0xb2, 0x49, 0x89, 0xdd, 0x4a, 0xe5, 0x22, 0x70, 0x00, 0x0e, 0xc3, 0x27, 0x47,
-	0x40, 0xe5, 0x04, 0xdc, 0x00, 0x65, 0xec, 0xd2, 0xa4, 0x94, 0x7e, 0xf1, 0xe7, 0x79, 0xef, 0xcd,
-	0x78, 0x3c, 0x6f, 0x12, 0xe8, 0x5f, 0x09, 0x96, 0xe8, 0xab, 0x98, 0xe5, 0xe2, 0x89, 0x12, 0xf9,
-	0x52, 0xc6, 0xe2, 0x29, 0xcf, 0x62, 0x9d, 0xe5, 0x91, 0x0d, 0xd5, 0x60, 0x9e, 0x67, 0x3a, 0x23,
-	0xb0, 0x55, 0xf6, 0x7e, 0xd5, 0xa0, 0x35, 0x46, 0xd5, 0xd4, 0x8a, 0x48, 0x0b, 0x6a, 0x92, 0x07,
-	0x4e, 0xe8, 0xf4, 0x7d, 0x5a, 0x93, 0x9c, 0x3c, 0x83, 0x76, 0xb5, 0x4e, 0x94, 0xe5, 0x5c, 0xe4,
-	0x41, 0x2d, 0x74, 0xfa, 0x75, 0x4a, 0x78, 0x39, 0xfb, 0x75, 0xc1, 0x90, 0xfb, 0xe0, 0xdb, 0x0c,
-	0xc9, 0x03, 0x17, 0x0b, 0x79, 0x06, 0x98, 0x70, 0xf2, 0x18, 0x6e, 0xa9, 0xb9, 0x88, 0x25, 0x4b,
-	0xe4, 0x27, 0xa6, 0x65, 0x96, 0x16, 0xa2, 0x23, 0x14, 0x9d, 0x56, 0x89, 0x09, 0x27, 0x0f, 0xe1,
-	0x24, 0x4b, 0x13, 0x99, 0x8a, 0x68, 0x9e, 0xcb, 0x58, 0x04, 0xf5, 0xd0, 0xe9, 0xd7, 0x68, 0xd3,
-	0x60, 0x6f, 0x0a, 0x88, 0x3c, 0x82, 0x9b, 0xd9, 0x6c, 0x56, 0xd2, 0x34, 0x50, 0x73, 0x62, 0x41,
-	0x23, 0x22, 0x70, 0x94, 0xb2, 0x6b, 0x11, 0x1c, 0xe3, 0x3d, 0x78, 0x26, 0x1d, 0xf0, 0xf8, 0x22,
-	0xc7, 0x9b, 0x02, 0xcf, 0x36, 0x69, 0x63, 0xf2, 0x00, 0x20, 0xce, 0x05, 0xd3, 0x82, 0x47, 0x4c,
-	0x07, 0x3e, 0xb2, 0xbe, 0x45, 0x86, 0xba, 0xa0, 0x17, 0x73, 0xbe, 0xa1, 0xc1, 0xd0, 0x16, 0x31,
-	0x34, 0x17, 0x89, 0xb0, 0x74, 0xd3, 0xd0, 0x16, 0x19, 0xea, 0x5e, 0x0a, 0xe4, 0x95, 0x54, 0x7a,
-	0x67, 0xec, 0x23, 0x68, 0x55, 0x46, 0xa9, 0x02, 0x27, 0x74, 0xfb, 0xcd, 0xb3, 0xce, 0x60, 0x6b,
-	0xd7, 0xa0, 0x9a, 0x43, 0x77, 0x32, 0x48, 0x1b, 0xea, 0x71, 0xb6, 0x48, 0xb5, 0xf5, 0xc6, 0x04,
-	0xbd, 0xb7, 0xe0, 0x9f, 0x0b, 0x4d, 0xc5, 0xc7, 0xa9, 0xce, 0x0b, 0xc9, 0x4c, 0x8a, 0x64, 0x63,
-	0xb0, 0x09, 0x0a, 0x74, 0xc9, 0x92, 0x85, 0xc0, 0x44, 0x9f, 0x9a, 0xa0, 0xf0, 0x51, 0xaa, 0x88,
-	0xc5, 0x5a, 0x2e, 0x05, 0xfa, 0xe8, 0x51, 0x4f, 0xaa, 0x21, 0xc6, 0xbd, 0xaf, 0x0e, 0xb4, 0xcf,
-	0x85, 0x1e, 0x26, 0x49, 0xa5, 0xa9, 0x69, 0x31, 0xeb, 0x39, 0xfb, 0x20, 0xf0, 0x02, 0x97, 0xe2,
-	0xb9, 0xa8, 0x9f, 0xc8, 0x6b, 0x69, 0x1a, 0x73, 0xa9, 0x09, 0xb6, 0xbd, 0xb8, 0x7b, 0x7b, 0x39,
-	0x2a, 0xf7, 0x72, 0x0f, 0x3c, 0x5c, 0xbb, 0xe8, 0x72, 0x85, 0x5b, 0xe0, 0xd3, 0x63, 0x8c, 0x47,
-	0xab, 0x6a, 0x9b, 0x8d, 0x9d, 0x36, 0x43, 0x68, 0x4c, 0x35, 0xd3, 0x0b, 0x45, 0xee, 0x42, 0x43,
-	0xe1, 0x09, 0x3b, 0xf3, 0xa8, 0x8d, 0xce, 0x3e, 0xbb, 0x9b, 0x4f, 0x40, 0xd9, 0x37, 0x90, 0x0b,
-	0x68, 0xbf, 0x44, 0xb3, 0x77, 0x3c, 0x3a, 0xe0, 0x45, 0xe7, 0x00, 0x47, 0x26, 0x38, 0xaa, 0x0a,
-	0x38, 0x5a, 0x4d, 0xc6, 0xe4, 0x4e, 0x39, 0xe7, 0x8f, 0x47, 0x07, 0x4b, 0xbd, 0xdf, 0x3b, 0x75,
-	0x45, 0xc2, 0x9d, 0x52, 0x7f, 0xf9, 0xd2, 0xe9, 0x96, 0x15, 0x7b, 0x16, 0xf0, 0x02, 0xda, 0xef,
-	0x70, 0x85, 0xff, 0xd3, 0xa3, 0x5f, 0xc0, 0xed, 0x31, 0xee, 0x7c, 0x05, 0xff, 0xd7, 0x9b, 0x49,
-	0x19, 0x36, 0x8e, 0x8d, 0x4e, 0xbf, 0xad, 0xbb, 0xce, 0xf7, 0x75, 0xd7, 0xf9, 0xb1, 0xee, 0x3a,
-	0x5f, 0x7e, 0x76, 0x6f, 0x5c, 0x36, 0xf0, 0x0f, 0xf6, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x3b, 0xf4, 0x47, 0x6e, 0xed, 0x04, 0x00, 0x00,
+	// 922 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6e, 0xeb, 0xc4,
+	0x17, 0xfe, 0xd9, 0xf9, 0x53, 0xe7, 0xb4, 0x4d, 0x93, 0xf9, 0x85, 0xca, 0x37, 0x70, 0xa3, 0x10,
+	0x84, 0x14, 0x40, 0x94, 0xab, 0x0b, 0x7b, 0x68, 0x29, 0xf4, 0xa6, 0x82, 0xde, 0x2b, 0xb7, 0x57,
+	0x42, 0x62, 0x61, 0xb9, 0x9e, 0x09, 0x1d, 0xc9, 0xf1, 0xb8, 0x33, 0xe3, 0x4a, 0x61, 0xcd, 0x0b,
+	0xb0, 0xe3, 0x75, 0xd8, 0x21, 0x56, 0x3c, 0x00, 0x0b, 0x54, 0x5e, 0x04, 0xcd, 0x1f, 0x37, 0xb6,
+	0x93, 0x06, 0x16, 0x77, 0xe7, 0xf3, 0x7d, 0x67, 0x4e, 0xcf, 0x7c, 0xdf, 0x99, 0x93, 0xc2, 0xf4,
+	0x86, 0x44, 0x89, 0xbc, 0x89, 0x23, 0x4e, 0x3e, 0x16, 0x84, 0xdf, 0xd1, 0x98, 0x7c, 0x82, 0x59,
+	0x2c, 0x19, 0x0f, 0x6d, 0x28, 0x8e, 0x32, 0xce, 0x24, 0x43, 0xb0, 0xca, 0x9c, 0xfc, 0xdc, 0x84,
+	0xee, 0xa9, 0xce, 0xba, 0xb4, 0x49, 0xa8, 0x0b, 0x2e, 0xc5, 0xbe, 0x33, 0x76, 0xa6, 0x9d, 0xc0,
+	0xa5, 0x18, 0x3d, 0x83, 0x41, 0xb5, 0x4e, 0xc8, 0x38, 0x26, 0xdc, 0x77, 0xc7, 0xce, 0xb4, 0x15,
+	0x20, 0x5c, 0x3e, 0xfd, 0x52, 0x31, 0xe8, 0x6d, 0xe8, 0xd8, 0x13, 0x14, 0xfb, 0x0d, 0x5d, 0xc8,
+	0x33, 0xc0, 0x0c, 0xa3, 0x8f, 0xa0, 0x2f, 0x32, 0x12, 0xd3, 0x28, 0xa1, 0x3f, 0x46, 0x92, 0xb2,
+	0x54, 0x25, 0x35, 0x75, 0x52, 0xaf, 0x4a, 0xcc, 0x30, 0x42, 0xd0, 0x4c, 0xa3, 0x05, 0xf1, 0x77,
+	0x34, 0xaf, 0xbf, 0xd1, 0x53, 0x80, 0x98, 0x93, 0x48, 0x12, 0x1c, 0x46, 0xd2, 0xef, 0x68, 0xa6,
+	0x63, 0x91, 0x63, 0xa9, 0xe8, 0x3c, 0xc3, 0x05, 0x0d, 0x86, 0xb6, 0x88, 0xa1, 0x31, 0x49, 0x88,
+	0xa5, 0x77, 0x0d, 0x6d, 0x91, 0x63, 0x89, 0x3e, 0x83, 0x43, 0x4e, 0x6e, 0x73, 0xca, 0x09, 0x0e,
+	0x39, 0x11, 0x2c, 0xe7, 0x31, 0x09, 0xe5, 0x32, 0x23, 0xfe, 0x9e, 0x4e, 0x1d, 0x14, 0x6c, 0x60,
+	0xc9, 0xab, 0x65, 0x46, 0xd0, 0xbb, 0xb0, 0xc7, 0xd2, 0x84, 0xa6, 0x24, 0xcc, 0x38, 0x8d, 0x89,
+	0xbf, 0xaf, 0x73, 0x77, 0x0d, 0xf6, 0x4a, 0x41, 0xe8, 0x3d, 0xd8, 0x67, 0xf3, 0x79, 0x29, 0xa7,
+	0xab, 0x73, 0xf6, 0x2c, 0x68, 0x92, 0x86, 0xe0, 0xc5, 0x39, 0xe7, 0x24, 0x8d, 0x97, 0xfe, 0x81,
+	0xd1, 0xad, 0x88, 0xd1, 0x07, 0xd0, 0xc3, 0x39, 0x37, 0x8a, 0x2d, 0x68, 0x9a, 0x4b, 0x22, 0xfc,
+	0x9e, 0xb6, 0xe0, 0xa0, 0xc0, 0xbf, 0x35, 0xb0, 0x92, 0x38, 0xe3, 0x24, 0x0b, 0x69, 0x2a, 0x24,
+	0xcf, 0x63, 0x45, 0x09, 0xbf, 0x6f, 0x24, 0x56, 0xc4, 0xac, 0x84, 0x9f, 0x37, 0xbd, 0x56, 0xaf,
+	0x7d, 0xde, 0xf4, 0xda, 0xbd, 0x9d, 0xf3, 0xa6, 0xe7, 0xf5, 0x3a, 0x93, 0x3f, 0x5d, 0xd8, 0xb3,
+	0x7e, 0x9a, 0xb6, 0xea, 0x13, 0xf1, 0x21, 0xf4, 0x6b, 0x13, 0x41, 0xb1, 0x1e, 0x87, 0x4e, 0x70,
+	0x50, 0x19, 0x87, 0x19, 0x56, 0x57, 0x5a, 0x30, 0x1c, 0x25, 0x54, 0x2e, 0x8b, 0x51, 0x28, 0x62,
+	0x74, 0x08, 0xed, 0x68, 0xc1, 0xf2, 0x54, 0x5a, 0xff, 0x6d, 0x54, 0x91, 0xa1, 0x55, 0x93, 0xe1,
+	0x7d, 0xe8, 0x92, 0xf9, 0x9c, 0xc4, 0x92, 0xde, 0x91, 0x70, 0xce, 0xd9, 0xc2, 0x6f, 0xeb, 0x8c,
+	0xfd, 0x07, 0xf4, 0x6b, 0xce, 0x16, 0xca, 0x91, 0x55, 0x9a, 0x64, 0x76, 0x80, 0x76, 0x1f, 0xb0,
+	0x2b, 0x86, 0x9e, 0x80, 0x47, 0x45, 0x98, 0x71, 0xb6, 0x60, 0xbe, 0x37, 0x76, 0xa6, 0x5e, 0xb0,
+	0x43, 0xc5, 0x2b, 0x15, 0xaa, 0x21, 0xd1, 0x78, 0xa8, 0x87, 0xcf, 0x8e, 0x98, 0x46, 0x2e, 0xd6,
+	0x27, 0x10, 0x36, 0x4c, 0xe0, 0x96, 0x11, 0x9b, 0x7c, 0x0f, 0xfd, 0x6f, 0xa8, 0x90, 0x65, 0x85,
+	0x05, 0x1a, 0x40, 0x2b, 0xd6, 0x4a, 0x28, 0x95, 0x1b, 0x81, 0x09, 0xd0, 0x33, 0x68, 0xeb, 0x61,
+	0x11, 0xbe, 0x3b, 0x6e, 0x4c, 0x77, 0x9f, 0xfb, 0x47, 0xab, 0xa7, 0x7b, 0x54, 0x2e, 0x10, 0xd8,
+	0xbc, 0xc9, 0x4f, 0x0e, 0xf4, 0xce, 0x48, 0xad, 0xf8, 0x46, 0xbf, 0x9c, 0x7f, 0xf7, 0xcb, 0xad,
+	0xf9, 0x85, 0xa0, 0x99, 0x45, 0x3f, 0x10, 0xed, 0x63, 0x23, 0xd0, 0xdf, 0xaa, 0xf1, 0x84, 0x2e,
+	0xa8, 0xb1, 0xb0, 0x11, 0x98, 0x60, 0x92, 0xc2, 0xe0, 0x8c, 0xc8, 0xaf, 0x0a, 0xb5, 0x4d, 0x8f,
+	0xe4, 0xf6, 0x8d, 0x75, 0xd2, 0x05, 0x37, 0x92, 0x76, 0x9e, 0xdc, 0x48, 0xfd, 0x3d, 0xa4, 0x34,
+	0xad, 0x6d, 0xb2, 0x13, 0xe8, 0x56, 0x8a, 0x0a, 0xdf, 0xd1, 0x32, 0x0e, 0xcb, 0x32, 0x56, 0xcf,
+	0x04, 0xb5, 0x13, 0x2b, 0x63, 0xcc, 0xba, 0x33, 0xc1, 0xe4, 0x0a, 0x3a, 0x67, 0x44, 0x06, 0xe4,
+	0xf6, 0x52, 0x72, 0x95, 0x32, 0xa7, 0x24, 0x29, 0x2e, 0x62, 0x02, 0x85, 0xde, 0x45, 0x49, 0x4e,
+	0x6c, 0xef, 0x26, 0x50, 0xab, 0x91, 0x8a, 0x30, 0xd2, 0xaa, 0xe8, 0xfe, 0xbd, 0xc0, 0xa3, 0xe2,
+	0x58, 0xc7, 0x93, 0x5f, 0x1d, 0x2d, 0xdb, 0x71, 0x92, 0x54, 0x9a, 0xba, 0x7c, 0x10, 0xde, 0xd9,
+	0x24, 0xbc, 0x5b, 0x12, 0x7e, 0xd5, 0x4b, 0x63, 0x63, 0x2f, 0xcd, 0x72, 0x2f, 0x4f, 0xc0, 0xd3,
+	0x9b, 0x3c, 0xbc, 0x2e, 0x9e, 0xd9, 0x8e, 0x8e, 0x4f, 0x96, 0xd5, 0x36, 0xdb, 0xd5, 0x36, 0x15,
+	0x79, 0xcd, 0xa3, 0x34, 0xbe, 0x51, 0xe6, 0x99, 0x87, 0xe5, 0x19, 0x60, 0x86, 0x27, 0x63, 0x68,
+	0x5f, 0xca, 0x48, 0xe6, 0x42, 0xbd, 0x6e, 0xa1, 0xbf, 0x74, 0xdb, 0x5e, 0x60, 0xa3, 0xe7, 0xbf,
+	0xb7, 0x8a, 0x9f, 0x1c, 0x61, 0x2f, 0x88, 0x2e, 0x60, 0xf0, 0xa5, 0x7e, 0x3e, 0x35, 0x03, 0xb7,
+	0x18, 0x35, 0xdc, 0xc2, 0xa1, 0x99, 0xd6, 0xb1, 0x02, 0x9e, 0x2c, 0x67, 0xa7, 0xe8, 0xad, 0xf2,
+	0x99, 0x07, 0x03, 0xb7, 0x96, 0xfa, 0x6e, 0xa3, 0x25, 0x02, 0x8d, 0x6b, 0xa5, 0xd6, 0x4c, 0x1b,
+	0x8e, 0xca, 0x19, 0x1b, 0xa6, 0xf3, 0x02, 0x06, 0xaf, 0xf5, 0xcf, 0xd2, 0x1b, 0xba, 0xf4, 0x17,
+	0xf0, 0xff, 0x53, 0xbd, 0x64, 0x2a, 0xf8, 0x63, 0x77, 0x46, 0x65, 0xd8, 0x3a, 0xf6, 0x02, 0x90,
+	0xb1, 0xa1, 0xb2, 0xfd, 0x1f, 0x5d, 0x3a, 0xc3, 0x47, 0x19, 0xf4, 0x1a, 0x0e, 0x6b, 0x5b, 0xe8,
+	0x05, 0x15, 0x92, 0xf1, 0x25, 0x7a, 0xa7, 0xd6, 0x4e, 0x65, 0x53, 0x0d, 0x9f, 0xd6, 0x35, 0xab,
+	0xd0, 0xe8, 0x25, 0xf4, 0xd7, 0xd6, 0xca, 0x9a, 0x13, 0x6b, 0x5b, 0x67, 0x4b, 0x9f, 0x9f, 0x03,
+	0x32, 0x9a, 0x55, 0xd0, 0xff, 0x2e, 0xd9, 0x49, 0xef, 0xb7, 0xfb, 0x91, 0xf3, 0xc7, 0xfd, 0xc8,
+	0xf9, 0xeb, 0x7e, 0xe4, 0xfc, 0xf2, 0xf7, 0xe8, 0x7f, 0xd7, 0x6d, 0xfd, 0x4f, 0xd6, 0xa7, 0xff,
+	0x0c, 0x00, 0xf8, 0x57, 0xa8, 0xa1, 0x90, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -474,6 +851,11 @@ type DoctorsServiceClient interface {
 	GetAllDoctorServices(ctx context.Context, in *GetAllDoctorServiceS, opts ...grpc.CallOption) (*ListDoctorServices, error)
 	UpdateDoctorServices(ctx context.Context, in *DoctorServices, opts ...grpc.CallOption) (*DoctorServices, error)
 	DeleteDoctorService(ctx context.Context, in *GetReqStr, opts ...grpc.CallOption) (*Status, error)
+	// price lists
+	CreateServicePrice(ctx context.Context, in *ServicePrice, opts ...grpc.CallOption) (*ServicePrice, error)
+	GetServicePriceHistory(ctx context.Context, in *GetServicePrices, opts ...grpc.CallOption) (*ListServicePrices, error)
+	GetEffectivePrice(ctx context.Context, in *GetEffectivePriceReq, opts ...grpc.CallOption) (*ServicePrice, error)
+	DeleteServicePrice(ctx context.Context, in *GetReqStr, opts ...grpc.CallOption) (*Status, error)
 }
 
 type doctorsServiceClient struct {
@@ -529,13 +911,54 @@ func (c *doctorsServiceClient) DeleteDoctorService(ctx context.Context, in *GetR
 	return out, nil
 }
 
-// DoctorsServiceServer is the server API for DoctorsService service.
-type DoctorsServiceServer interface {
-	CreateDoctorServices(context.Context, *DoctorServices) (*DoctorServices, error)
-	GetDoctorServiceByID(context.Context, *GetReqStr) (*DoctorServices, error)
-	GetAllDoctorServices(context.Context, *GetAllDoctorServiceS) (*ListDoctorServices, error)
-	UpdateDoctorServices(context.Context, *DoctorServices) (*DoctorServices, error)
-	DeleteDoctorService(context.Context, *GetReqStr) (*Status, error)
+func (c *doctorsServiceClient) CreateServicePrice(ctx context.Context, in *ServicePrice, opts ...grpc.CallOption) (*ServicePrice, error) {
+	out := new(ServicePrice)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorsService/CreateServicePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorsServiceClient) GetServicePriceHistory(ctx context.Context, in *GetServicePrices, opts ...grpc.CallOption) (*ListServicePrices, error) {
+	out := new(ListServicePrices)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorsService/GetServicePriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorsServiceClient) GetEffectivePrice(ctx context.Context, in *GetEffectivePriceReq, opts ...grpc.CallOption) (*ServicePrice, error) {
+	out := new(ServicePrice)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorsService/GetEffectivePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorsServiceClient) DeleteServicePrice(ctx context.Context, in *GetReqStr, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/healthcare.DoctorsService/DeleteServicePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DoctorsServiceServer is the server API for DoctorsService service.
+type DoctorsServiceServer interface {
+	CreateDoctorServices(context.Context, *DoctorServices) (*DoctorServices, error)
+	GetDoctorServiceByID(context.Context, *GetReqStr) (*DoctorServices, error)
+	GetAllDoctorServices(context.Context, *GetAllDoctorServiceS) (*ListDoctorServices, error)
+	UpdateDoctorServices(context.Context, *DoctorServices) (*DoctorServices, error)
+	DeleteDoctorService(context.Context, *GetReqStr) (*Status, error)
+	// price lists
+	CreateServicePrice(context.Context, *ServicePrice) (*ServicePrice, error)
+	GetServicePriceHistory(context.Context, *GetServicePrices) (*ListServicePrices, error)
+	GetEffectivePrice(context.Context, *GetEffectivePriceReq) (*ServicePrice, error)
+	DeleteServicePrice(context.Context, *GetReqStr) (*Status, error)
 }
 
 // UnimplementedDoctorsServiceServer can be embedded to have forward compatible implementations.
@@ -557,6 +980,18 @@ func (*UnimplementedDoctorsServiceServer) UpdateDoctorServices(ctx context.Conte
 func (*UnimplementedDoctorsServiceServer) DeleteDoctorService(ctx context.Context, req *GetReqStr) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDoctorService not implemented")
 }
+func (*UnimplementedDoctorsServiceServer) CreateServicePrice(ctx context.Context, req *ServicePrice) (*ServicePrice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServicePrice not implemented")
+}
+func (*UnimplementedDoctorsServiceServer) GetServicePriceHistory(ctx context.Context, req *GetServicePrices) (*ListServicePrices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServicePriceHistory not implemented")
+}
+func (*UnimplementedDoctorsServiceServer) GetEffectivePrice(ctx context.Context, req *GetEffectivePriceReq) (*ServicePrice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectivePrice not implemented")
+}
+func (*UnimplementedDoctorsServiceServer) DeleteServicePrice(ctx context.Context, req *GetReqStr) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServicePrice not implemented")
+}
 
 func RegisterDoctorsServiceServer(s *grpc.Server, srv DoctorsServiceServer) {
 	s.RegisterService(&_DoctorsService_serviceDesc, srv)
@@ -652,6 +1087,78 @@ func _DoctorsService_DeleteDoctorService_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DoctorsService_CreateServicePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServicePrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorsServiceServer).CreateServicePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorsService/CreateServicePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorsServiceServer).CreateServicePrice(ctx, req.(*ServicePrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorsService_GetServicePriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServicePrices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorsServiceServer).GetServicePriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorsService/GetServicePriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorsServiceServer).GetServicePriceHistory(ctx, req.(*GetServicePrices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorsService_GetEffectivePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectivePriceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorsServiceServer).GetEffectivePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorsService/GetEffectivePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorsServiceServer).GetEffectivePrice(ctx, req.(*GetEffectivePriceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorsService_DeleteServicePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReqStr)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorsServiceServer).DeleteServicePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/healthcare.DoctorsService/DeleteServicePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorsServiceServer).DeleteServicePrice(ctx, req.(*GetReqStr))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DoctorsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "healthcare.DoctorsService",
 	HandlerType: (*DoctorsServiceServer)(nil),
@@ -676,6 +1183,22 @@ var _DoctorsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDoctorService",
 			Handler:    _DoctorsService_DeleteDoctorService_Handler,
 		},
+		{
+			MethodName: "CreateServicePrice",
+			Handler:    _DoctorsService_CreateServicePrice_Handler,
+		},
+		{
+			MethodName: "GetServicePriceHistory",
+			Handler:    _DoctorsService_GetServicePriceHistory_Handler,
+		},
+		{
+			MethodName: "GetEffectivePrice",
+			Handler:    _DoctorsService_GetEffectivePrice_Handler,
+		},
+		{
+			MethodName: "DeleteServicePrice",
+			Handler:    _DoctorsService_DeleteServicePrice_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "healthcare-service/doctor_services.proto",
@@ -705,6 +1228,50 @@ func (m *DoctorServices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PrepInstructions) > 0 {
+		i -= len(m.PrepInstructions)
+		copy(dAtA[i:], m.PrepInstructions)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.PrepInstructions)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.DurationMinutes != 0 {
+		i = encodeVarintDoctorServices(dAtA, i, uint64(m.DurationMinutes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.OfflinePrice) > 0 {
+		i -= len(m.OfflinePrice)
+		copy(dAtA[i:], m.OfflinePrice)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.OfflinePrice)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.OnlinePrice) > 0 {
+		i -= len(m.OnlinePrice)
+		copy(dAtA[i:], m.OnlinePrice)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.OnlinePrice)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RequiredResourceType) > 0 {
+		i -= len(m.RequiredResourceType)
+		copy(dAtA[i:], m.RequiredResourceType)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.RequiredResourceType)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.DeletedAt) > 0 {
 		i -= len(m.DeletedAt)
 		copy(dAtA[i:], m.DeletedAt)
@@ -726,13 +1293,6 @@ func (m *DoctorServices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x4a
 	}
-	if len(m.Duration) > 0 {
-		i -= len(m.Duration)
-		copy(dAtA[i:], m.Duration)
-		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Duration)))
-		i--
-		dAtA[i] = 0x42
-	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -740,18 +1300,6 @@ func (m *DoctorServices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	if m.OfflinePrice != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OfflinePrice))))
-		i--
-		dAtA[i] = 0x35
-	}
-	if m.OnlinePrice != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OnlinePrice))))
-		i--
-		dAtA[i] = 0x2d
-	}
 	if len(m.SpecializationId) > 0 {
 		i -= len(m.SpecializationId)
 		copy(dAtA[i:], m.SpecializationId)
@@ -781,7 +1329,7 @@ func (m *DoctorServices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ListDoctorServices) Marshal() (dAtA []byte, err error) {
+func (m *ServicePrice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -791,12 +1339,12 @@ func (m *ListDoctorServices) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListDoctorServices) MarshalTo(dAtA []byte) (int, error) {
+func (m *ServicePrice) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListDoctorServices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ServicePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -805,29 +1353,90 @@ func (m *ListDoctorServices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Count != 0 {
-		i = encodeVarintDoctorServices(dAtA, i, uint64(m.Count))
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.DeletedAt)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x5a
 	}
-	if len(m.DoctorServices) > 0 {
-		for iNdEx := len(m.DoctorServices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DoctorServices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDoctorServices(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.PromoName) > 0 {
+		i -= len(m.PromoName)
+		copy(dAtA[i:], m.PromoName)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.PromoName)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.IsPromo {
+		i--
+		if m.IsPromo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.EffectiveTo) > 0 {
+		i -= len(m.EffectiveTo)
+		copy(dAtA[i:], m.EffectiveTo)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.EffectiveTo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EffectiveFrom) > 0 {
+		i -= len(m.EffectiveFrom)
+		copy(dAtA[i:], m.EffectiveFrom)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.EffectiveFrom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Modality) > 0 {
+		i -= len(m.Modality)
+		copy(dAtA[i:], m.Modality)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Modality)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetReqStr) Marshal() (dAtA []byte, err error) {
+func (m *ListServicePrices) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -837,12 +1446,12 @@ func (m *GetReqStr) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetReqStr) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListServicePrices) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetReqStr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListServicePrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -851,34 +1460,29 @@ func (m *GetReqStr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IsActive {
-		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctorServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Field)))
+	if m.Count != 0 {
+		i = encodeVarintDoctorServices(dAtA, i, uint64(m.Count))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetAllDoctorServiceS) Marshal() (dAtA []byte, err error) {
+func (m *GetServicePrices) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -888,12 +1492,12 @@ func (m *GetAllDoctorServiceS) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetAllDoctorServiceS) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetServicePrices) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetAllDoctorServiceS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetServicePrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -902,51 +1506,34 @@ func (m *GetAllDoctorServiceS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IsActive {
-		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.OrderBy) > 0 {
-		i -= len(m.OrderBy)
-		copy(dAtA[i:], m.OrderBy)
-		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.OrderBy)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if m.Limit != 0 {
 		i = encodeVarintDoctorServices(dAtA, i, uint64(m.Limit))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
 	if m.Page != 0 {
 		i = encodeVarintDoctorServices(dAtA, i, uint64(m.Page))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
+	}
+	if len(m.Modality) > 0 {
+		i -= len(m.Modality)
+		copy(dAtA[i:], m.Modality)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Modality)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Status) Marshal() (dAtA []byte, err error) {
+func (m *GetEffectivePriceReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -956,12 +1543,12 @@ func (m *Status) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetEffectivePriceReq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetEffectivePriceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -970,7 +1557,227 @@ func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Status {
+	if len(m.At) > 0 {
+		i -= len(m.At)
+		copy(dAtA[i:], m.At)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.At)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Modality) > 0 {
+		i -= len(m.Modality)
+		copy(dAtA[i:], m.Modality)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Modality)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorServiceId) > 0 {
+		i -= len(m.DoctorServiceId)
+		copy(dAtA[i:], m.DoctorServiceId)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.DoctorServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDoctorServices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDoctorServices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDoctorServices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctorServices(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DoctorServices) > 0 {
+		for iNdEx := len(m.DoctorServices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DoctorServices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctorServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReqStr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReqStr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReqStr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllDoctorServiceS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllDoctorServiceS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllDoctorServiceS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintDoctorServices(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDoctorServices(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintDoctorServices(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
 		i--
 		if m.Status {
 			dAtA[i] = 1
@@ -1015,74 +1822,213 @@ func (m *DoctorServices) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDoctorServices(uint64(l))
 	}
-	if m.OnlinePrice != 0 {
-		n += 5
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
 	}
-	if m.OfflinePrice != 0 {
-		n += 5
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
 	}
-	l = len(m.Name)
+	l = len(m.DeletedAt)
 	if l > 0 {
 		n += 1 + l + sovDoctorServices(uint64(l))
 	}
-	l = len(m.Duration)
+	l = len(m.RequiredResourceType)
 	if l > 0 {
 		n += 1 + l + sovDoctorServices(uint64(l))
 	}
-	l = len(m.CreatedAt)
+	l = len(m.OnlinePrice)
 	if l > 0 {
 		n += 1 + l + sovDoctorServices(uint64(l))
 	}
-	l = len(m.UpdatedAt)
+	l = len(m.OfflinePrice)
 	if l > 0 {
 		n += 1 + l + sovDoctorServices(uint64(l))
 	}
-	l = len(m.DeletedAt)
+	l = len(m.Currency)
 	if l > 0 {
 		n += 1 + l + sovDoctorServices(uint64(l))
 	}
+	if m.DurationMinutes != 0 {
+		n += 2 + sovDoctorServices(uint64(m.DurationMinutes))
+	}
+	l = len(m.PrepInstructions)
+	if l > 0 {
+		n += 2 + l + sovDoctorServices(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ListDoctorServices) Size() (n int) {
+func (m *ServicePrice) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.DoctorServices) > 0 {
-		for _, e := range m.DoctorServices {
-			l = e.Size()
-			n += 1 + l + sovDoctorServices(uint64(l))
-		}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
 	}
-	if m.Count != 0 {
-		n += 1 + sovDoctorServices(uint64(m.Count))
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.Modality)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
 	}
-	return n
-}
-
-func (m *GetReqStr) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
 	}
-	var l int
-	_ = l
-	l = len(m.Field)
+	l = len(m.Currency)
 	if l > 0 {
 		n += 1 + l + sovDoctorServices(uint64(l))
 	}
-	l = len(m.Value)
+	l = len(m.EffectiveFrom)
 	if l > 0 {
 		n += 1 + l + sovDoctorServices(uint64(l))
 	}
-	if m.IsActive {
+	l = len(m.EffectiveTo)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
+	if m.IsPromo {
+		n += 2
+	}
+	l = len(m.PromoName)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListServicePrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovDoctorServices(uint64(m.Count))
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovDoctorServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetServicePrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
+	l = len(m.Modality)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
+	if m.Page != 0 {
+		n += 1 + sovDoctorServices(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDoctorServices(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetEffectivePriceReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorServiceId)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
+	l = len(m.Modality)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
+	l = len(m.At)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDoctorServices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DoctorServices) > 0 {
+		for _, e := range m.DoctorServices {
+			l = e.Size()
+			n += 1 + l + sovDoctorServices(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctorServices(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReqStr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
+	if m.IsActive {
 		n += 2
 	}
 	if m.XXX_unrecognized != nil {
@@ -1118,6 +2064,10 @@ func (m *GetAllDoctorServiceS) Size() (n int) {
 	if m.IsActive {
 		n += 2
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovDoctorServices(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1136,16 +2086,880 @@ func (m *Status) Size() (n int) {
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
+	return n
+}
+
+func sovDoctorServices(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDoctorServices(x uint64) (n int) {
+	return sovDoctorServices(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DoctorServices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorServices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorServices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceOrder", wireType)
+			}
+			m.DoctorServiceOrder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DoctorServiceOrder |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecializationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecializationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredResourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredResourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlinePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnlinePrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfflinePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfflinePrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMinutes", wireType)
+			}
+			m.DurationMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationMinutes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepInstructions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrepInstructions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServicePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServicePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServicePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modality", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Modality = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPromo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPromo = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromoName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovDoctorServices(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDoctorServices(x uint64) (n int) {
-	return sovDoctorServices(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *DoctorServices) Unmarshal(dAtA []byte) error {
+func (m *ListServicePrices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1168,17 +2982,17 @@ func (m *DoctorServices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoctorServices: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListServicePrices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoctorServices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListServicePrices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctorServices
@@ -1188,29 +3002,16 @@ func (m *DoctorServices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctorServices
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDoctorServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceOrder", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
-			m.DoctorServiceOrder = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctorServices
@@ -1220,14 +3021,80 @@ func (m *DoctorServices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DoctorServiceOrder |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, &ServicePrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetServicePrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetServicePrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetServicePrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1255,11 +3122,11 @@ func (m *DoctorServices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecializationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Modality", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1287,35 +3154,13 @@ func (m *DoctorServices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecializationId = string(dAtA[iNdEx:postIndex])
+			m.Modality = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnlinePrice", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.OnlinePrice = float32(math.Float32frombits(v))
-		case 6:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfflinePrice", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.OfflinePrice = float32(math.Float32frombits(v))
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var stringLen uint64
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctorServices
@@ -1325,29 +3170,16 @@ func (m *DoctorServices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Page |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctorServices
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDoctorServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctorServices
@@ -1357,27 +3189,65 @@ func (m *DoctorServices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctorServices
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctorServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctorServices
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEffectivePriceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctorServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEffectivePriceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEffectivePriceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorServiceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1405,11 +3275,11 @@ func (m *DoctorServices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.DoctorServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Modality", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1437,11 +3307,11 @@ func (m *DoctorServices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.Modality = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1469,7 +3339,7 @@ func (m *DoctorServices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			m.At = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1915,6 +3785,38 @@ func (m *GetAllDoctorServiceS) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsActive = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctorServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctorServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctorServices(dAtA[iNdEx:])
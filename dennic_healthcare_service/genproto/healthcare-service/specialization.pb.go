@@ -310,6 +310,7 @@ type GetAllSpecialization struct {
 	Value                string   `protobuf:"bytes,5,opt,name=value,proto3" json:"value"`
 	OrderBy              string   `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
 	DepartmentId         string   `protobuf:"bytes,7,opt,name=department_id,json=departmentId,proto3" json:"department_id"`
+	BranchId             string   `protobuf:"bytes,8,opt,name=branch_id,json=branchId,proto3" json:"branch_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -397,6 +398,13 @@ func (m *GetAllSpecialization) GetDepartmentId() string {
 	return ""
 }
 
+func (m *GetAllSpecialization) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Specializations)(nil), "healthcare.Specializations")
 	proto.RegisterType((*GetReqStrSpecialization)(nil), "healthcare.GetReqStrSpecialization")
@@ -410,40 +418,41 @@ func init() {
 }
 
 var fileDescriptor_8df9db489869b4c3 = []byte{
-	// 527 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xd1, 0x8e, 0xd2, 0x4c,
-	0x14, 0xfe, 0x0b, 0x14, 0xca, 0xd9, 0x5f, 0xd7, 0x8c, 0x5d, 0xad, 0xbb, 0x11, 0x09, 0x7b, 0x21,
-	0x37, 0x62, 0xb2, 0x3e, 0x01, 0xa8, 0x21, 0x9b, 0x78, 0xb1, 0x29, 0xd9, 0x0b, 0x4d, 0x0c, 0x19,
-	0x3a, 0xc7, 0xdd, 0x49, 0x06, 0xa8, 0x33, 0x53, 0x12, 0x7c, 0x06, 0x1f, 0xc0, 0x47, 0xd2, 0x3b,
-	0x7d, 0x03, 0x83, 0x2f, 0x62, 0x7a, 0x5a, 0x02, 0x2d, 0x48, 0xbc, 0xf0, 0xae, 0xdf, 0xf7, 0x9d,
-	0x39, 0xcc, 0xf9, 0xce, 0x37, 0xc0, 0xd3, 0x5b, 0xe4, 0xca, 0xde, 0x46, 0x5c, 0xe3, 0x33, 0x83,
-	0x7a, 0x21, 0x23, 0x7c, 0x6e, 0x62, 0x8c, 0x24, 0x57, 0xf2, 0x13, 0xb7, 0x72, 0x3e, 0xeb, 0xc5,
-	0x7a, 0x6e, 0xe7, 0x0c, 0x36, 0x85, 0x9d, 0xcf, 0x15, 0x38, 0x1e, 0x15, 0x8a, 0x0c, 0xbb, 0x0b,
-	0x15, 0x29, 0x02, 0xa7, 0xed, 0x74, 0x9b, 0x61, 0x45, 0x0a, 0xe6, 0x83, 0x3b, 0xd7, 0x02, 0x75,
-	0x50, 0x69, 0x3b, 0x5d, 0x37, 0xcc, 0x00, 0x63, 0x50, 0x9b, 0xf1, 0x29, 0x06, 0x55, 0xaa, 0xa3,
-	0x6f, 0xd6, 0x86, 0x23, 0x81, 0x26, 0xd2, 0x32, 0x4e, 0x3b, 0x05, 0x35, 0x92, 0xb6, 0x29, 0x76,
-	0x0e, 0x77, 0x04, 0xc6, 0x5c, 0xdb, 0x29, 0xce, 0xec, 0x58, 0x8a, 0xc0, 0xa5, 0x9a, 0xff, 0x37,
-	0xe4, 0xa5, 0x60, 0x67, 0xd0, 0x94, 0x53, 0x7e, 0x83, 0xe3, 0x44, 0xab, 0xa0, 0x4e, 0x05, 0x1e,
-	0x11, 0xd7, 0x5a, 0xb1, 0xc7, 0x00, 0x91, 0x46, 0x6e, 0x51, 0x8c, 0xb9, 0x0d, 0x1a, 0xa4, 0x36,
-	0x73, 0xa6, 0x6f, 0x53, 0x39, 0x89, 0xc5, 0x5a, 0xf6, 0x32, 0x39, 0x67, 0x32, 0x59, 0xa0, 0xc2,
-	0x5c, 0x6e, 0x66, 0x72, 0xce, 0xf4, 0x6d, 0x67, 0x02, 0x0f, 0x87, 0x68, 0x43, 0xfc, 0x38, 0xb2,
-	0xba, 0x68, 0x4b, 0xea, 0xc2, 0x07, 0x89, 0x6a, 0x6d, 0x4c, 0x06, 0x52, 0x76, 0xc1, 0x55, 0x82,
-	0xe4, 0x4d, 0x33, 0xcc, 0x00, 0x0d, 0x60, 0xc6, 0x3c, 0xb2, 0x72, 0x91, 0x19, 0xe4, 0x85, 0x9e,
-	0x34, 0x7d, 0xc2, 0x1d, 0x0d, 0xf7, 0xdf, 0x48, 0x63, 0xcb, 0xae, 0xfb, 0xe0, 0x46, 0xf3, 0x64,
-	0x66, 0xa9, 0xbf, 0x1b, 0x66, 0x80, 0xbd, 0x86, 0xe3, 0xe2, 0x0e, 0x4d, 0x50, 0x69, 0x57, 0xbb,
-	0x47, 0x17, 0x67, 0xbd, 0xcd, 0x16, 0x7b, 0xa5, 0x5e, 0x61, 0xf9, 0x4c, 0xa7, 0x07, 0xfe, 0xc8,
-	0x72, 0x9b, 0x98, 0xd2, 0x50, 0x0f, 0xa0, 0x6e, 0x88, 0xa7, 0x5f, 0xf5, 0xc2, 0x1c, 0x75, 0xbe,
-	0x39, 0xe0, 0x0f, 0xd1, 0xf6, 0x95, 0x2a, 0x1d, 0x60, 0x50, 0x8b, 0xf9, 0x0d, 0xe6, 0x97, 0xa4,
-	0xef, 0xf4, 0xe6, 0x4a, 0x4e, 0xa5, 0x5d, 0xe7, 0x83, 0xc0, 0x41, 0x0f, 0x36, 0x66, 0xd6, 0xf6,
-	0x9a, 0xe9, 0x6e, 0x9b, 0xf9, 0x08, 0x3c, 0x4a, 0xdc, 0x78, 0xb2, 0xcc, 0xc3, 0xd0, 0x20, 0x3c,
-	0x58, 0xee, 0xa6, 0xa9, 0xb1, 0x9b, 0xa6, 0x8b, 0x1f, 0x55, 0x38, 0x29, 0x4e, 0x31, 0xca, 0x5e,
-	0x07, 0xbb, 0x02, 0xff, 0x25, 0x05, 0xa7, 0x34, 0xe4, 0x21, 0x6f, 0x4f, 0x0f, 0x89, 0xec, 0x2d,
-	0x9c, 0x0c, 0xb1, 0xb4, 0xda, 0xc1, 0xf2, 0x52, 0xb0, 0xf3, 0xed, 0x53, 0x7f, 0x88, 0xd8, 0xe1,
-	0xd6, 0xef, 0xa8, 0xf5, 0xce, 0x46, 0x0c, 0x6b, 0x97, 0x5a, 0xef, 0x94, 0x9c, 0x3e, 0xd9, 0xae,
-	0xd8, 0x97, 0xbd, 0x2b, 0xf0, 0xaf, 0xe9, 0x89, 0xfc, 0x33, 0x23, 0xde, 0x83, 0xff, 0x8a, 0x5e,
-	0x55, 0xa9, 0xe3, 0x5f, 0xf9, 0x50, 0x98, 0x68, 0x5f, 0x6e, 0x07, 0xf7, 0xbe, 0xae, 0x5a, 0xce,
-	0xf7, 0x55, 0xcb, 0xf9, 0xb9, 0x6a, 0x39, 0x5f, 0x7e, 0xb5, 0xfe, 0x9b, 0xd4, 0xe9, 0xbf, 0xed,
-	0xc5, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x07, 0xce, 0x44, 0xdc, 0x06, 0x05, 0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdf, 0x8e, 0xd2, 0x4e,
+	0x14, 0xfe, 0x15, 0x28, 0x94, 0xb3, 0x3f, 0x5d, 0x33, 0x16, 0xad, 0xbb, 0x11, 0x09, 0x7b, 0x21,
+	0x37, 0x62, 0xb2, 0x3e, 0x01, 0xa8, 0x21, 0x24, 0x5e, 0x6c, 0x4a, 0xf6, 0x42, 0x13, 0x43, 0x86,
+	0xce, 0x71, 0x99, 0xa4, 0x40, 0x9d, 0x19, 0x48, 0xf0, 0x19, 0x7c, 0x00, 0x1f, 0xc9, 0x4b, 0x7d,
+	0x03, 0x83, 0x89, 0xcf, 0x61, 0x7a, 0xa6, 0x04, 0xe8, 0x56, 0xe2, 0x85, 0x77, 0xfd, 0xfe, 0xcc,
+	0x61, 0xe6, 0x9b, 0x6f, 0x80, 0xa7, 0x53, 0xe4, 0xb1, 0x99, 0x46, 0x5c, 0xe1, 0x33, 0x8d, 0x6a,
+	0x25, 0x23, 0x7c, 0xae, 0x13, 0x8c, 0x24, 0x8f, 0xe5, 0x27, 0x6e, 0xe4, 0x62, 0xde, 0x4d, 0xd4,
+	0xc2, 0x2c, 0x18, 0xec, 0x8c, 0xed, 0xcf, 0x25, 0x38, 0x1d, 0x1d, 0x98, 0x34, 0xbb, 0x0b, 0x25,
+	0x29, 0x02, 0xa7, 0xe5, 0x74, 0xea, 0x61, 0x49, 0x0a, 0xe6, 0x83, 0xbb, 0x50, 0x02, 0x55, 0x50,
+	0x6a, 0x39, 0x1d, 0x37, 0xb4, 0x80, 0x31, 0xa8, 0xcc, 0xf9, 0x0c, 0x83, 0x32, 0xf9, 0xe8, 0x9b,
+	0xb5, 0xe0, 0x44, 0xa0, 0x8e, 0x94, 0x4c, 0xd2, 0x49, 0x41, 0x85, 0xa4, 0x7d, 0x8a, 0x5d, 0xc0,
+	0x1d, 0x81, 0x09, 0x57, 0x66, 0x86, 0x73, 0x33, 0x96, 0x22, 0x70, 0xc9, 0xf3, 0xff, 0x8e, 0x1c,
+	0x0a, 0x76, 0x0e, 0x75, 0x39, 0xe3, 0x37, 0x38, 0x5e, 0xaa, 0x38, 0xa8, 0x92, 0xc1, 0x23, 0xe2,
+	0x5a, 0xc5, 0xec, 0x31, 0x40, 0xa4, 0x90, 0x1b, 0x14, 0x63, 0x6e, 0x82, 0x1a, 0xa9, 0xf5, 0x8c,
+	0xe9, 0x99, 0x54, 0x5e, 0x26, 0x62, 0x2b, 0x7b, 0x56, 0xce, 0x18, 0x2b, 0x0b, 0x8c, 0x31, 0x93,
+	0xeb, 0x56, 0xce, 0x98, 0x9e, 0x69, 0x4f, 0xe0, 0xe1, 0x00, 0x4d, 0x88, 0x1f, 0x47, 0x46, 0x1d,
+	0xc6, 0x92, 0xa6, 0xf0, 0x41, 0x62, 0xbc, 0x0d, 0xc6, 0x82, 0x94, 0x5d, 0xf1, 0x78, 0x89, 0x94,
+	0x4d, 0x3d, 0xb4, 0x80, 0x0e, 0xa0, 0xc7, 0x3c, 0x32, 0x72, 0x65, 0x03, 0xf2, 0x42, 0x4f, 0xea,
+	0x1e, 0xe1, 0xb6, 0x82, 0xfb, 0x6f, 0xa4, 0x36, 0xf9, 0xd4, 0x7d, 0x70, 0xa3, 0xc5, 0x72, 0x6e,
+	0x68, 0xbe, 0x1b, 0x5a, 0xc0, 0x5e, 0xc3, 0xe9, 0xe1, 0x1d, 0xea, 0xa0, 0xd4, 0x2a, 0x77, 0x4e,
+	0x2e, 0xcf, 0xbb, 0xbb, 0x5b, 0xec, 0xe6, 0x66, 0x85, 0xf9, 0x35, 0xed, 0x2e, 0xf8, 0x23, 0xc3,
+	0xcd, 0x52, 0xe7, 0x0e, 0xf5, 0x00, 0xaa, 0x9a, 0x78, 0xfa, 0x55, 0x2f, 0xcc, 0x50, 0xfb, 0x97,
+	0x03, 0xfe, 0x00, 0x4d, 0x2f, 0x8e, 0x73, 0x0b, 0x18, 0x54, 0x12, 0x7e, 0x83, 0xd9, 0x26, 0xe9,
+	0x3b, 0xdd, 0x79, 0x2c, 0x67, 0xd2, 0x6c, 0xfb, 0x41, 0xe0, 0x68, 0x06, 0xbb, 0x30, 0x2b, 0x85,
+	0x61, 0xba, 0xfb, 0x61, 0x3e, 0x02, 0x8f, 0x1a, 0x37, 0x9e, 0xac, 0xb3, 0x32, 0xd4, 0x08, 0xf7,
+	0xd7, 0xb7, 0xdb, 0x54, 0x2b, 0x6e, 0xd3, 0x44, 0xf1, 0x79, 0x34, 0x4d, 0x0d, 0xb6, 0x10, 0x9e,
+	0x25, 0x86, 0xe2, 0xf2, 0x7b, 0x19, 0x1a, 0x87, 0x47, 0x1c, 0xd9, 0xa7, 0xc3, 0xae, 0xc0, 0x7f,
+	0x49, 0xad, 0xca, 0x25, 0x70, 0x2c, 0xf8, 0xb3, 0x63, 0x22, 0x7b, 0x0b, 0x8d, 0x01, 0xe6, 0xee,
+	0xbd, 0xbf, 0x1e, 0x0a, 0x76, 0xb1, 0xbf, 0xea, 0x0f, 0xfd, 0x3b, 0x3e, 0xfa, 0x1d, 0x34, 0x8a,
+	0xae, 0x4b, 0xb3, 0x56, 0x6e, 0xf4, 0x2d, 0xcb, 0xd9, 0x93, 0x7d, 0x47, 0x51, 0x31, 0xaf, 0xc0,
+	0xbf, 0xa6, 0xf7, 0xf3, 0xcf, 0x82, 0x78, 0x0f, 0xfe, 0x2b, 0x7a, 0x72, 0xb9, 0x89, 0x7f, 0x95,
+	0xc3, 0xc1, 0x89, 0x8a, 0x4a, 0xdd, 0xbf, 0xf7, 0x75, 0xd3, 0x74, 0xbe, 0x6d, 0x9a, 0xce, 0x8f,
+	0x4d, 0xd3, 0xf9, 0xf2, 0xb3, 0xf9, 0xdf, 0xa4, 0x4a, 0x7f, 0x7c, 0x2f, 0x7e, 0x0f, 0x00, 0x47,
+	0xa0, 0xeb, 0x28, 0x23, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -916,6 +925,13 @@ func (m *GetAllSpecialization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintSpecialization(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.DepartmentId) > 0 {
 		i -= len(m.DepartmentId)
 		copy(dAtA[i:], m.DepartmentId)
@@ -1115,6 +1131,10 @@ func (m *GetAllSpecialization) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSpecialization(uint64(l))
 	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovSpecialization(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1978,6 +1998,38 @@ func (m *GetAllSpecialization) Unmarshal(dAtA []byte) error {
 			}
 			m.DepartmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecialization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecialization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecialization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecialization(dAtA[iNdEx:])
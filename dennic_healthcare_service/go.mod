@@ -3,6 +3,7 @@ module Healthcare_Evrone
 go 1.22
 
 require (
+	dennic_kafka v0.0.0
 	github.com/Masterminds/squirrel v1.5.4
 	github.com/golang/protobuf v1.5.4
 	github.com/google/uuid v1.6.0
@@ -51,3 +52,5 @@ require (
 	google.golang.org/protobuf v1.33.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace dennic_kafka => ../dennic_kafka
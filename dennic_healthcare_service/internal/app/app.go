@@ -103,7 +103,12 @@ func (a *App) Run() error {
 	specialization := repo.NewSpecializationRepo(a.DB)
 	dwh := repo.NewDoctorWorkingHoursRepo(a.DB)
 	ds := repo.NewDoctorServicesRepo(a.DB)
+	prices := repo.NewServicePricesRepo(a.DB)
 	reas := repo.NewReasonsRepo(a.DB)
+	branch := repo.NewBranchRepo(a.DB)
+	room := repo.NewRoomRepo(a.DB)
+	resource := repo.NewResourceRepo(a.DB)
+	review := repo.NewReviewRepo(a.DB)
 
 	// usecase initialization
 	doctorWorkingHours := usecase.NewDoctorWorkingHoursService(contextTimeout, dwh)
@@ -118,12 +123,24 @@ func (a *App) Run() error {
 	specializationUsecase := usecase.NewSpecializationService(contextTimeout, specialization)
 	pb.RegisterSpecializationServiceServer(a.GrpcServer, invest_grpc.SpecializationRPC(a.Logger, specializationUsecase, a.BrokerProducer))
 
-	doctorsServicesUsecase := usecase.NewDoctorServices(contextTimeout, ds)
+	doctorsServicesUsecase := usecase.NewDoctorServices(contextTimeout, ds, prices)
 	pb.RegisterDoctorsServiceServer(a.GrpcServer, invest_grpc.DoctorsServiceRPC(a.Logger, doctorsServicesUsecase))
 
 	reasonsUsecase := usecase.NewReasons(contextTimeout, reas)
 	pb.RegisterReasonsServiceServer(a.GrpcServer, invest_grpc.ReasonsServiceRPC(a.Logger, reasonsUsecase))
 
+	branchUsecase := usecase.NewBranchService(contextTimeout, branch)
+	pb.RegisterBranchServiceServer(a.GrpcServer, invest_grpc.BranchRPC(a.Logger, branchUsecase))
+
+	roomUsecase := usecase.NewRoomService(contextTimeout, room)
+	pb.RegisterRoomServiceServer(a.GrpcServer, invest_grpc.RoomRPC(a.Logger, roomUsecase))
+
+	resourceUsecase := usecase.NewResourceService(contextTimeout, resource)
+	pb.RegisterResourceServiceServer(a.GrpcServer, invest_grpc.ResourceRPC(a.Logger, resourceUsecase))
+
+	reviewUsecase := usecase.NewReviewService(contextTimeout, review)
+	pb.RegisterReviewServiceServer(a.GrpcServer, invest_grpc.ReviewRPC(a.Logger, reviewUsecase))
+
 	a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
 	if err := grpc_server.Run(a.Config, a.GrpcServer); err != nil {
 		return fmt.Errorf("gRPC fatal to serve grpc server over %s %w", a.Config.RPCPort, err)
@@ -0,0 +1,146 @@
+package services
+
+import (
+	pb "Healthcare_Evrone/genproto/healthcare-service"
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/usecase"
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type branchRPC struct {
+	logger *zap.Logger
+	branch usecase.BranchUsecase
+}
+
+const (
+	serviceNameBranchDelivery           = "BranchDelivery"
+	serviceNameBranchDeliveryRepoPrefix = "BranchDelivery"
+)
+
+func BranchRPC(logger *zap.Logger, branchUsecase usecase.BranchUsecase) pb.BranchServiceServer {
+	return &branchRPC{
+		logger,
+		branchUsecase,
+	}
+}
+
+func branchToEntity(branch *pb.Branch) *entity.Branch {
+	req := entity.Branch{
+		Id:          branch.Id,
+		Order:       branch.Order,
+		Name:        branch.Name,
+		Address:     branch.Address,
+		Latitude:    branch.Latitude,
+		Longitude:   branch.Longitude,
+		Timezone:    branch.Timezone,
+		PhoneNumber: branch.PhoneNumber,
+	}
+	for _, hours := range branch.OpeningHours {
+		req.OpeningHours = append(req.OpeningHours, entity.BranchOpeningHours{
+			DayOfWeek: hours.DayOfWeek,
+			OpenTime:  hours.OpenTime,
+			CloseTime: hours.CloseTime,
+		})
+	}
+	return &req
+}
+
+func branchToPb(branch *entity.Branch) *pb.Branch {
+	resp := pb.Branch{
+		Id:          branch.Id,
+		Order:       branch.Order,
+		Name:        branch.Name,
+		Address:     branch.Address,
+		Latitude:    branch.Latitude,
+		Longitude:   branch.Longitude,
+		Timezone:    branch.Timezone,
+		PhoneNumber: branch.PhoneNumber,
+		CreatedAt:   branch.CreatedAt.String(),
+		UpdatedAt:   branch.UpdatedAt.String(),
+		DeletedAt:   branch.DeletedAt.String(),
+	}
+	for _, hours := range branch.OpeningHours {
+		resp.OpeningHours = append(resp.OpeningHours, &pb.BranchOpeningHours{
+			DayOfWeek: hours.DayOfWeek,
+			OpenTime:  hours.OpenTime,
+			CloseTime: hours.CloseTime,
+		})
+	}
+	return &resp
+}
+
+func (r branchRPC) CreateBranch(ctx context.Context, branch *pb.Branch) (*pb.Branch, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBranchDelivery, serviceNameBranchDeliveryRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateBranch").String(branch.Id))
+	defer span.End()
+
+	resp, err := r.branch.CreateBranch(ctx, branchToEntity(branch))
+	if err != nil {
+		return nil, err
+	}
+	return branchToPb(resp), nil
+}
+
+func (r branchRPC) GetBranchById(ctx context.Context, get *pb.GetReqStrBranch) (*pb.Branch, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBranchDelivery, serviceNameBranchDeliveryRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetBranchById").String(get.Value))
+	defer span.End()
+
+	resp, err := r.branch.GetBranchById(ctx, &entity.GetReqStr{Field: get.Field, Value: get.Value, IsActive: get.IsActive})
+	if err != nil {
+		return nil, err
+	}
+	return branchToPb(resp), nil
+}
+
+func (r branchRPC) GetAllBranches(ctx context.Context, all *pb.GetAllBranch) (*pb.ListBranches, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBranchDelivery, serviceNameBranchDeliveryRepoPrefix+"Get all")
+	span.SetAttributes(attribute.Key("GetAllBranches").String(all.Value))
+	defer span.End()
+
+	resp, err := r.branch.GetAllBranches(ctx, &entity.GetAll{
+		Page:     all.Page,
+		Limit:    all.Limit,
+		Field:    all.Field,
+		Value:    all.Value,
+		OrderBy:  all.OrderBy,
+		IsActive: all.IsActive,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var branches pb.ListBranches
+	for i := range resp.Branches {
+		branches.Branches = append(branches.Branches, branchToPb(&resp.Branches[i]))
+	}
+	branches.Count = resp.Count
+	return &branches, nil
+}
+
+func (r branchRPC) UpdateBranch(ctx context.Context, branch *pb.Branch) (*pb.Branch, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBranchDelivery, serviceNameBranchDeliveryRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateBranch").String(branch.Id))
+	defer span.End()
+
+	resp, err := r.branch.UpdateBranch(ctx, branchToEntity(branch))
+	if err != nil {
+		return nil, err
+	}
+	return branchToPb(resp), nil
+}
+
+func (r branchRPC) DeleteBranch(ctx context.Context, del *pb.GetReqStrBranch) (*pb.StatusBranch, error) {
+	ctx, span := otlp.Start(ctx, serviceNameBranchDelivery, serviceNameBranchDeliveryRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteBranch").String(del.Value))
+	defer span.End()
+
+	status, err := r.branch.DeleteBranch(ctx, &entity.GetReqStr{Field: del.Field, Value: del.Value, IsActive: del.IsActive})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StatusBranch{Status: status}, nil
+}
@@ -50,6 +50,7 @@ func (r departmentRPC) CreateDepartment(ctx context.Context, dep *pb.Department)
 		ImageUrl:         reqImageUrl,
 		FloorNumber:      dep.FloorNumber,
 		ShortDescription: dep.ShortDescription,
+		BranchId:         dep.BranchId,
 	}
 
 	resp, err := r.department.CreateDepartment(ctx, &req)
@@ -67,6 +68,7 @@ func (r departmentRPC) CreateDepartment(ctx context.Context, dep *pb.Department)
 		ImageUrl:         respImageUrl,
 		FloorNumber:      resp.FloorNumber,
 		ShortDescription: resp.ShortDescription,
+		BranchId:         resp.BranchId,
 		CreatedAt:        resp.CreatedAt.String(),
 		UpdatedAt:        resp.UpdatedAt.String(),
 		DeletedAt:        resp.DeletedAt.String(),
@@ -91,6 +93,7 @@ func (r departmentRPC) GetDepartmentById(ctx context.Context, get *pb.GetReqStrD
 		ImageUrl:         respImageUrl,
 		FloorNumber:      resp.FloorNumber,
 		ShortDescription: resp.ShortDescription,
+		BranchId:         resp.BranchId,
 		CreatedAt:        resp.CreatedAt.String(),
 		UpdatedAt:        resp.UpdatedAt.String(),
 		DeletedAt:        resp.DeletedAt.String(),
@@ -104,11 +107,12 @@ func (r departmentRPC) GetAllDepartments(ctx context.Context, get *pb.GetAllDepa
 	defer span.End()
 
 	resp, err := r.department.GetAllDepartments(ctx, &entity.GetAll{
-		Page:    get.Page,
-		Limit:   get.Limit,
-		Field:   get.Field,
-		Value:   get.Value,
-		OrderBy: get.OrderBy,
+		Page:     get.Page,
+		Limit:    get.Limit,
+		Field:    get.Field,
+		Value:    get.Value,
+		OrderBy:  get.OrderBy,
+		BranchId: get.BranchId,
 	})
 	if err != nil {
 		return nil, err
@@ -125,6 +129,7 @@ func (r departmentRPC) GetAllDepartments(ctx context.Context, get *pb.GetAllDepa
 			ImageUrl:         respImageUrl,
 			FloorNumber:      dep.FloorNumber,
 			ShortDescription: dep.ShortDescription,
+			BranchId:         dep.BranchId,
 			CreatedAt:        dep.CreatedAt.String(),
 			UpdatedAt:        dep.UpdatedAt.String(),
 			DeletedAt:        dep.DeletedAt.String(),
@@ -148,6 +153,7 @@ func (r departmentRPC) UpdateDepartment(ctx context.Context, update *pb.Departme
 		ImageUrl:         reqImageUrl,
 		FloorNumber:      update.FloorNumber,
 		ShortDescription: update.ShortDescription,
+		BranchId:         update.BranchId,
 		CreatedAt:        time.Time{},
 		UpdatedAt:        time.Time{},
 		DeletedAt:        time.Time{},
@@ -165,6 +171,7 @@ func (r departmentRPC) UpdateDepartment(ctx context.Context, update *pb.Departme
 		ImageUrl:         respImageUrl,
 		FloorNumber:      resp.FloorNumber,
 		ShortDescription: resp.ShortDescription,
+		BranchId:         resp.BranchId,
 		CreatedAt:        resp.CreatedAt.String(),
 		UpdatedAt:        resp.UpdatedAt.String(),
 		DeletedAt:        resp.DeletedAt.String(),
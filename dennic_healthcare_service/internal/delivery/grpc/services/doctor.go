@@ -133,6 +133,8 @@ func (r doctorRPC) GetDoctorById(ctx context.Context, str *pb.GetReqStrDoctor) (
 		CreatedAt:     doctor.CreatedAt.String(),
 		UpdatedAt:     doctor.UpdatedAt.String(),
 		DeletedAt:     doctor.DeletedAt.String(),
+		Rating:        doctor.Rating,
+		ReviewCount:   doctor.ReviewCount,
 	}, nil
 }
 
@@ -148,6 +150,7 @@ func (r doctorRPC) GetAllDoctors(ctx context.Context, all *pb.GetAllDoctorS) (*p
 		Value:    all.Value,
 		OrderBy:  all.OrderBy,
 		IsActive: all.IsActive,
+		BranchId: all.BranchId,
 	})
 	if err != nil {
 		r.logger.Error("Failed to get all doctors", zap.Error(err))
@@ -184,6 +187,8 @@ func (r doctorRPC) GetAllDoctors(ctx context.Context, all *pb.GetAllDoctorS) (*p
 			CreatedAt:     doctor.CreatedAt.String(),
 			UpdatedAt:     doctor.UpdatedAt.String(),
 			DeletedAt:     doctor.DeletedAt.String(),
+			Rating:        doctor.Rating,
+			ReviewCount:   doctor.ReviewCount,
 		})
 	}
 	doctors.Count = resp.Count
@@ -279,6 +284,7 @@ func (r doctorRPC) ListDoctorsByDepartmentId(ctx context.Context, dep *pb.GetReq
 		Field:        dep.Field,
 		Value:        dep.Value,
 		OrderBy:      dep.OrderBy,
+		BranchId:     dep.BranchId,
 	})
 	if err != nil {
 		r.logger.Error("Failed to get all doctors", zap.Error(err))
@@ -331,6 +337,7 @@ func (r doctorRPC) ListDoctorBySpecializationId(ctx context.Context, spec *pb.Ge
 		Field:            spec.Field,
 		Value:            spec.Value,
 		OrderBy:          spec.OrderBy,
+		BranchId:         spec.BranchId,
 	})
 	if err != nil {
 		r.logger.Error("Failed to get all doctors", zap.Error(err))
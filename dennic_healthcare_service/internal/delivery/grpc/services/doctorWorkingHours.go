@@ -38,6 +38,8 @@ func (r doctorWorkingHoursServiceRPC) CreateDoctorWorkingHours(ctx context.Conte
 		DayOfWeek:  hours.DayOfWeek,
 		StartTime:  hours.StartTime,
 		FinishTime: hours.FinishTime,
+		BranchId:   hours.BranchId,
+		RoomId:     hours.RoomId,
 	}
 	resp, err := r.doctorWorkingHours.CreateDoctorWorkingHours(ctx, &req)
 	if err != nil {
@@ -50,6 +52,8 @@ func (r doctorWorkingHoursServiceRPC) CreateDoctorWorkingHours(ctx context.Conte
 		DayOfWeek:  resp.DayOfWeek,
 		StartTime:  resp.StartTime,
 		FinishTime: resp.FinishTime,
+		BranchId:   resp.BranchId,
+		RoomId:     resp.RoomId,
 		CreatedAt:  resp.CreatedAt.String(),
 		UpdatedAt:  resp.UpdatedAt.String(),
 		DeletedAt:  resp.DeletedAt.String(),
@@ -74,6 +78,8 @@ func (r doctorWorkingHoursServiceRPC) GetDoctorWorkingHoursById(ctx context.Cont
 		DayOfWeek:  dwh.DayOfWeek,
 		StartTime:  dwh.StartTime,
 		FinishTime: dwh.FinishTime,
+		BranchId:   dwh.BranchId,
+		RoomId:     dwh.RoomId,
 		CreatedAt:  dwh.CreatedAt.String(),
 		UpdatedAt:  dwh.UpdatedAt.String(),
 		DeletedAt:  dwh.DeletedAt.String(),
@@ -91,6 +97,7 @@ func (r doctorWorkingHoursServiceRPC) GetAllDoctorWorkingHours(ctx context.Conte
 		Value:    all.Value,
 		OrderBy:  all.OrderBy,
 		IsActive: all.IsActive,
+		BranchId: all.BranchId,
 	})
 	if err != nil {
 		return nil, err
@@ -103,6 +110,8 @@ func (r doctorWorkingHoursServiceRPC) GetAllDoctorWorkingHours(ctx context.Conte
 			DayOfWeek:  d.DayOfWeek,
 			StartTime:  d.StartTime,
 			FinishTime: d.FinishTime,
+			BranchId:   d.BranchId,
+			RoomId:     d.RoomId,
 			CreatedAt:  d.CreatedAt.String(),
 			UpdatedAt:  d.UpdatedAt.String(),
 			DeletedAt:  d.DeletedAt.String(),
@@ -122,6 +131,8 @@ func (r doctorWorkingHoursServiceRPC) UpdateDoctorWorkingHours(ctx context.Conte
 		DayOfWeek:  hours.DayOfWeek,
 		StartTime:  hours.StartTime,
 		FinishTime: hours.FinishTime,
+		BranchId:   hours.BranchId,
+		RoomId:     hours.RoomId,
 		CreatedAt:  time.Time{},
 		UpdatedAt:  time.Time{},
 		DeletedAt:  time.Time{},
@@ -135,6 +146,8 @@ func (r doctorWorkingHoursServiceRPC) UpdateDoctorWorkingHours(ctx context.Conte
 		DayOfWeek:  resp.DayOfWeek,
 		StartTime:  resp.StartTime,
 		FinishTime: resp.FinishTime,
+		BranchId:   resp.BranchId,
+		RoomId:     resp.RoomId,
 		CreatedAt:  resp.CreatedAt.String(),
 		UpdatedAt:  resp.UpdatedAt.String(),
 		DeletedAt:  resp.DeletedAt.String(),
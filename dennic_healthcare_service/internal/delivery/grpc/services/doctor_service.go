@@ -34,19 +34,18 @@ func (r doctorServiceRPC) CreateDoctorServices(ctx context.Context, in *pb.Docto
 	span.SetAttributes(attribute.Key("CreateDoctorServices").String(in.Id))
 	defer span.End()
 
-	tm, err := time.Parse("15:04", in.Duration)
-	if err != nil {
-		return nil, err
-	}
 	req := entity.DoctorServices{
-		Id:               in.Id,
-		Order:            in.DoctorServiceOrder,
-		DoctorId:         in.DoctorId,
-		SpecializationId: in.SpecializationId,
-		OnlinePrice:      in.OnlinePrice,
-		OfflinePrice:     in.OfflinePrice,
-		Name:             in.Name,
-		Duration:         tm,
+		Id:                   in.Id,
+		Order:                in.DoctorServiceOrder,
+		DoctorId:             in.DoctorId,
+		SpecializationId:     in.SpecializationId,
+		OnlinePrice:          in.OnlinePrice,
+		OfflinePrice:         in.OfflinePrice,
+		Name:                 in.Name,
+		Currency:             in.Currency,
+		DurationMinutes:      in.DurationMinutes,
+		PrepInstructions:     in.PrepInstructions,
+		RequiredResourceType: in.RequiredResourceType,
 	}
 	resp, err := r.doctorServices.CreateDoctorServices(ctx, &req)
 	if err != nil {
@@ -54,17 +53,20 @@ func (r doctorServiceRPC) CreateDoctorServices(ctx context.Context, in *pb.Docto
 	}
 
 	return &pb.DoctorServices{
-		Id:                 resp.Id,
-		DoctorServiceOrder: resp.Order,
-		DoctorId:           resp.DoctorId,
-		SpecializationId:   resp.SpecializationId,
-		OnlinePrice:        resp.OnlinePrice,
-		OfflinePrice:       resp.OfflinePrice,
-		Name:               resp.Name,
-		Duration:           resp.Duration.Format("15:04"),
-		CreatedAt:          resp.CreatedAt.String(),
-		UpdatedAt:          resp.UpdatedAt.String(),
-		DeletedAt:          resp.DeletedAt.String(),
+		Id:                   resp.Id,
+		DoctorServiceOrder:   resp.Order,
+		DoctorId:             resp.DoctorId,
+		SpecializationId:     resp.SpecializationId,
+		OnlinePrice:          resp.OnlinePrice,
+		OfflinePrice:         resp.OfflinePrice,
+		Name:                 resp.Name,
+		Currency:             resp.Currency,
+		DurationMinutes:      resp.DurationMinutes,
+		PrepInstructions:     resp.PrepInstructions,
+		RequiredResourceType: resp.RequiredResourceType,
+		CreatedAt:            resp.CreatedAt.String(),
+		UpdatedAt:            resp.UpdatedAt.String(),
+		DeletedAt:            resp.DeletedAt.String(),
 	}, nil
 }
 
@@ -81,17 +83,20 @@ func (r doctorServiceRPC) GetDoctorServiceByID(ctx context.Context, str *pb.GetR
 		return nil, err
 	}
 	return &pb.DoctorServices{
-		Id:                 ds.Id,
-		DoctorServiceOrder: ds.Order,
-		DoctorId:           ds.DoctorId,
-		SpecializationId:   ds.SpecializationId,
-		OnlinePrice:        ds.OnlinePrice,
-		OfflinePrice:       ds.OfflinePrice,
-		Name:               ds.Name,
-		Duration:           ds.Duration.Format("15:04"),
-		CreatedAt:          ds.CreatedAt.String(),
-		UpdatedAt:          ds.UpdatedAt.String(),
-		DeletedAt:          ds.DeletedAt.String(),
+		Id:                   ds.Id,
+		DoctorServiceOrder:   ds.Order,
+		DoctorId:             ds.DoctorId,
+		SpecializationId:     ds.SpecializationId,
+		OnlinePrice:          ds.OnlinePrice,
+		OfflinePrice:         ds.OfflinePrice,
+		Name:                 ds.Name,
+		Currency:             ds.Currency,
+		DurationMinutes:      ds.DurationMinutes,
+		PrepInstructions:     ds.PrepInstructions,
+		RequiredResourceType: ds.RequiredResourceType,
+		CreatedAt:            ds.CreatedAt.String(),
+		UpdatedAt:            ds.UpdatedAt.String(),
+		DeletedAt:            ds.DeletedAt.String(),
 	}, nil
 }
 
@@ -106,6 +111,7 @@ func (r doctorServiceRPC) GetAllDoctorServices(ctx context.Context, all *pb.GetA
 		Value:    all.Value,
 		OrderBy:  all.OrderBy,
 		IsActive: all.IsActive,
+		BranchId: all.BranchId,
 	})
 	if err != nil {
 		return nil, err
@@ -113,17 +119,20 @@ func (r doctorServiceRPC) GetAllDoctorServices(ctx context.Context, all *pb.GetA
 	var listDoctorServices pb.ListDoctorServices
 	for _, d := range doctorService.DoctorServices {
 		listDoctorServices.DoctorServices = append(listDoctorServices.DoctorServices, &pb.DoctorServices{
-			Id:                 d.Id,
-			DoctorServiceOrder: d.Order,
-			DoctorId:           d.DoctorId,
-			SpecializationId:   d.SpecializationId,
-			OnlinePrice:        d.OnlinePrice,
-			OfflinePrice:       d.OfflinePrice,
-			Name:               d.Name,
-			Duration:           d.Duration.Format("15:04"),
-			CreatedAt:          d.CreatedAt.String(),
-			UpdatedAt:          d.UpdatedAt.String(),
-			DeletedAt:          d.DeletedAt.String(),
+			Id:                   d.Id,
+			DoctorServiceOrder:   d.Order,
+			DoctorId:             d.DoctorId,
+			SpecializationId:     d.SpecializationId,
+			OnlinePrice:          d.OnlinePrice,
+			OfflinePrice:         d.OfflinePrice,
+			Name:                 d.Name,
+			Currency:             d.Currency,
+			DurationMinutes:      d.DurationMinutes,
+			PrepInstructions:     d.PrepInstructions,
+			RequiredResourceType: d.RequiredResourceType,
+			CreatedAt:            d.CreatedAt.String(),
+			UpdatedAt:            d.UpdatedAt.String(),
+			DeletedAt:            d.DeletedAt.String(),
 		})
 	}
 	listDoctorServices.Count = int32(doctorService.Count)
@@ -135,37 +144,38 @@ func (r doctorServiceRPC) UpdateDoctorServices(ctx context.Context, services *pb
 	span.SetAttributes(attribute.Key("UpdateDoctorServices").String(services.Id))
 	defer span.End()
 
-	tm, err := time.Parse("15:04", services.Duration)
-	if err != nil {
-		return nil, err
-	}
-
 	resp, err := r.doctorServices.UpdateDoctorServices(ctx, &entity.DoctorServices{
-		Id:               services.Id,
-		Order:            services.DoctorServiceOrder,
-		DoctorId:         services.DoctorId,
-		SpecializationId: services.SpecializationId,
-		OnlinePrice:      services.OnlinePrice,
-		OfflinePrice:     services.OfflinePrice,
-		Name:             services.Name,
-		Duration:         tm,
+		Id:                   services.Id,
+		Order:                services.DoctorServiceOrder,
+		DoctorId:             services.DoctorId,
+		SpecializationId:     services.SpecializationId,
+		OnlinePrice:          services.OnlinePrice,
+		OfflinePrice:         services.OfflinePrice,
+		Name:                 services.Name,
+		Currency:             services.Currency,
+		DurationMinutes:      services.DurationMinutes,
+		PrepInstructions:     services.PrepInstructions,
+		RequiredResourceType: services.RequiredResourceType,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.DoctorServices{
-		Id:                 resp.Id,
-		DoctorServiceOrder: resp.Order,
-		DoctorId:           resp.DoctorId,
-		SpecializationId:   resp.SpecializationId,
-		OnlinePrice:        resp.OnlinePrice,
-		OfflinePrice:       resp.OfflinePrice,
-		Name:               resp.Name,
-		Duration:           resp.Duration.Format("15:04:05"),
-		CreatedAt:          resp.CreatedAt.String(),
-		UpdatedAt:          resp.UpdatedAt.String(),
-		DeletedAt:          resp.DeletedAt.String(),
+		Id:                   resp.Id,
+		DoctorServiceOrder:   resp.Order,
+		DoctorId:             resp.DoctorId,
+		SpecializationId:     resp.SpecializationId,
+		OnlinePrice:          resp.OnlinePrice,
+		OfflinePrice:         resp.OfflinePrice,
+		Name:                 resp.Name,
+		Currency:             resp.Currency,
+		DurationMinutes:      resp.DurationMinutes,
+		PrepInstructions:     resp.PrepInstructions,
+		RequiredResourceType: resp.RequiredResourceType,
+		CreatedAt:            resp.CreatedAt.String(),
+		UpdatedAt:            resp.UpdatedAt.String(),
+		DeletedAt:            resp.DeletedAt.String(),
 	}, nil
 }
 
@@ -180,3 +190,126 @@ func (r doctorServiceRPC) DeleteDoctorService(ctx context.Context, str *pb.GetRe
 	}
 	return &pb.Status{Status: status}, nil
 }
+
+func servicePriceToPb(price *entity.ServicePrice) *pb.ServicePrice {
+	res := &pb.ServicePrice{
+		Id:              price.Id,
+		DoctorServiceId: price.DoctorServiceId,
+		Modality:        price.Modality,
+		Amount:          price.Amount,
+		Currency:        price.Currency,
+		IsPromo:         price.IsPromo,
+		PromoName:       price.PromoName,
+	}
+	if !price.EffectiveFrom.IsZero() {
+		res.EffectiveFrom = price.EffectiveFrom.Format("2006-01-02 15:04:05")
+	}
+	if !price.EffectiveTo.IsZero() {
+		res.EffectiveTo = price.EffectiveTo.Format("2006-01-02 15:04:05")
+	}
+	if !price.CreatedAt.IsZero() {
+		res.CreatedAt = price.CreatedAt.String()
+	}
+	if !price.DeletedAt.IsZero() {
+		res.DeletedAt = price.DeletedAt.String()
+	}
+	return res
+}
+
+// parseOptionalTime parses "2006-01-02 15:04:05" (or a bare date), leaving
+// the zero time for an empty string.
+func parseOptionalTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02 15:04:05", value)
+}
+
+func (r doctorServiceRPC) CreateServicePrice(ctx context.Context, in *pb.ServicePrice) (*pb.ServicePrice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorServiceDelivery, serviceNameDoctorServiceDeliveryRepoPrefix+"CreatePrice")
+	span.SetAttributes(attribute.Key("CreateServicePrice").String(in.DoctorServiceId))
+	defer span.End()
+
+	effectiveFrom, err := parseOptionalTime(in.EffectiveFrom)
+	if err != nil {
+		return nil, err
+	}
+	effectiveTo, err := parseOptionalTime(in.EffectiveTo)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.doctorServices.CreateServicePrice(ctx, &entity.ServicePrice{
+		Id:              in.Id,
+		DoctorServiceId: in.DoctorServiceId,
+		Modality:        in.Modality,
+		Amount:          in.Amount,
+		Currency:        in.Currency,
+		EffectiveFrom:   effectiveFrom,
+		EffectiveTo:     effectiveTo,
+		IsPromo:         in.IsPromo,
+		PromoName:       in.PromoName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return servicePriceToPb(resp), nil
+}
+
+func (r doctorServiceRPC) GetServicePriceHistory(ctx context.Context, in *pb.GetServicePrices) (*pb.ListServicePrices, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorServiceDelivery, serviceNameDoctorServiceDeliveryRepoPrefix+"PriceHistory")
+	span.SetAttributes(attribute.Key("GetServicePriceHistory").String(in.DoctorServiceId))
+	defer span.End()
+
+	resp, err := r.doctorServices.GetServicePriceHistory(ctx, &entity.GetServicePrices{
+		DoctorServiceId: in.DoctorServiceId,
+		Modality:        in.Modality,
+		Page:            in.Page,
+		Limit:           in.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var prices pb.ListServicePrices
+	for i := range resp.Prices {
+		prices.Prices = append(prices.Prices, servicePriceToPb(&resp.Prices[i]))
+	}
+	prices.Count = resp.Count
+	return &prices, nil
+}
+
+func (r doctorServiceRPC) GetEffectivePrice(ctx context.Context, in *pb.GetEffectivePriceReq) (*pb.ServicePrice, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorServiceDelivery, serviceNameDoctorServiceDeliveryRepoPrefix+"EffectivePrice")
+	span.SetAttributes(attribute.Key("GetEffectivePrice").String(in.DoctorServiceId))
+	defer span.End()
+
+	at, err := parseOptionalTime(in.At)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.doctorServices.GetEffectivePrice(ctx, &entity.GetEffectivePrice{
+		DoctorServiceId: in.DoctorServiceId,
+		Modality:        in.Modality,
+		At:              at,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return servicePriceToPb(resp), nil
+}
+
+func (r doctorServiceRPC) DeleteServicePrice(ctx context.Context, str *pb.GetReqStr) (*pb.Status, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDoctorServiceDelivery, serviceNameDoctorServiceDeliveryRepoPrefix+"DeletePrice")
+	span.SetAttributes(attribute.Key("DeleteServicePrice").String(str.Value))
+	defer span.End()
+
+	status, err := r.doctorServices.DeleteServicePrice(ctx, &entity.GetReqStr{Field: str.Field, Value: str.Value})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Status{Status: status}, nil
+}
@@ -95,6 +95,7 @@ func (r reasonsServiceRPC) GetAllReasons(ctx context.Context, all *pb.GetAllReas
 		Field:    all.Field,
 		Value:    all.Value,
 		OrderBy:  all.OrderBy,
+		BranchId: all.BranchId,
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,130 @@
+package services
+
+import (
+	pb "Healthcare_Evrone/genproto/healthcare-service"
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/usecase"
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type resourceRPC struct {
+	logger   *zap.Logger
+	resource usecase.ResourceUsecase
+}
+
+const (
+	serviceNameResourceDelivery           = "ResourceDelivery"
+	serviceNameResourceDeliveryRepoPrefix = "ResourceDelivery"
+)
+
+func ResourceRPC(logger *zap.Logger, resourceUsecase usecase.ResourceUsecase) pb.ResourceServiceServer {
+	return &resourceRPC{
+		logger,
+		resourceUsecase,
+	}
+}
+
+func resourceToPb(resource *entity.Resource) *pb.Resource {
+	return &pb.Resource{
+		Id:           resource.Id,
+		Order:        resource.Order,
+		BranchId:     resource.BranchId,
+		RoomId:       resource.RoomId,
+		ResourceType: resource.ResourceType,
+		Name:         resource.Name,
+		CreatedAt:    resource.CreatedAt.String(),
+		UpdatedAt:    resource.UpdatedAt.String(),
+		DeletedAt:    resource.DeletedAt.String(),
+	}
+}
+
+func (r resourceRPC) CreateResource(ctx context.Context, resource *pb.Resource) (*pb.Resource, error) {
+	ctx, span := otlp.Start(ctx, serviceNameResourceDelivery, serviceNameResourceDeliveryRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateResource").String(resource.Id))
+	defer span.End()
+
+	resp, err := r.resource.CreateResource(ctx, &entity.Resource{
+		Id:           resource.Id,
+		BranchId:     resource.BranchId,
+		RoomId:       resource.RoomId,
+		ResourceType: resource.ResourceType,
+		Name:         resource.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resourceToPb(resp), nil
+}
+
+func (r resourceRPC) GetResourceById(ctx context.Context, get *pb.GetReqStrResource) (*pb.Resource, error) {
+	ctx, span := otlp.Start(ctx, serviceNameResourceDelivery, serviceNameResourceDeliveryRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetResourceById").String(get.Value))
+	defer span.End()
+
+	resp, err := r.resource.GetResourceById(ctx, &entity.GetReqStr{Field: get.Field, Value: get.Value, IsActive: get.IsActive})
+	if err != nil {
+		return nil, err
+	}
+	return resourceToPb(resp), nil
+}
+
+func (r resourceRPC) GetAllResources(ctx context.Context, all *pb.GetAllResource) (*pb.ListResources, error) {
+	ctx, span := otlp.Start(ctx, serviceNameResourceDelivery, serviceNameResourceDeliveryRepoPrefix+"Get all")
+	span.SetAttributes(attribute.Key("GetAllResources").String(all.Value))
+	defer span.End()
+
+	resp, err := r.resource.GetAllResources(ctx, &entity.GetAllResources{
+		Page:         all.Page,
+		Limit:        all.Limit,
+		Field:        all.Field,
+		Value:        all.Value,
+		OrderBy:      all.OrderBy,
+		IsActive:     all.IsActive,
+		BranchId:     all.BranchId,
+		RoomId:       all.RoomId,
+		ResourceType: all.ResourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resources pb.ListResources
+	for i := range resp.Resources {
+		resources.Resources = append(resources.Resources, resourceToPb(&resp.Resources[i]))
+	}
+	resources.Count = resp.Count
+	return &resources, nil
+}
+
+func (r resourceRPC) UpdateResource(ctx context.Context, resource *pb.Resource) (*pb.Resource, error) {
+	ctx, span := otlp.Start(ctx, serviceNameResourceDelivery, serviceNameResourceDeliveryRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateResource").String(resource.Id))
+	defer span.End()
+
+	resp, err := r.resource.UpdateResource(ctx, &entity.Resource{
+		Id:           resource.Id,
+		BranchId:     resource.BranchId,
+		RoomId:       resource.RoomId,
+		ResourceType: resource.ResourceType,
+		Name:         resource.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resourceToPb(resp), nil
+}
+
+func (r resourceRPC) DeleteResource(ctx context.Context, del *pb.GetReqStrResource) (*pb.StatusResource, error) {
+	ctx, span := otlp.Start(ctx, serviceNameResourceDelivery, serviceNameResourceDeliveryRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteResource").String(del.Value))
+	defer span.End()
+
+	status, err := r.resource.DeleteResource(ctx, &entity.GetReqStr{Field: del.Field, Value: del.Value, IsActive: del.IsActive})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StatusResource{Status: status}, nil
+}
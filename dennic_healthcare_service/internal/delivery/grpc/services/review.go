@@ -0,0 +1,152 @@
+package services
+
+import (
+	pb "Healthcare_Evrone/genproto/healthcare-service"
+	"Healthcare_Evrone/internal/delivery/grpc"
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/usecase"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type reviewRPC struct {
+	logger *zap.Logger
+	review usecase.ReviewUsecase
+}
+
+const (
+	serviceNameReviewDelivery           = "ReviewDelivery"
+	serviceNameReviewDeliveryRepoPrefix = "ReviewDelivery"
+)
+
+func ReviewRPC(logger *zap.Logger, reviewUsecase usecase.ReviewUsecase) pb.ReviewServiceServer {
+	return &reviewRPC{
+		logger,
+		reviewUsecase,
+	}
+}
+
+func reviewToPb(review *entity.Review) *pb.Review {
+	res := &pb.Review{
+		Id:             review.Id,
+		DoctorId:       review.DoctorId,
+		PatientId:      review.PatientId,
+		AppointmentId:  review.AppointmentId,
+		Rating:         review.Rating,
+		Comment:        review.Comment,
+		Status:         review.Status,
+		ModerationNote: review.ModerationNote,
+		Reply:          review.Reply,
+		CreatedAt:      review.CreatedAt.String(),
+		UpdatedAt:      review.UpdatedAt.String(),
+		DeletedAt:      review.DeletedAt.String(),
+	}
+	if !review.RepliedAt.IsZero() {
+		res.RepliedAt = review.RepliedAt.String()
+	}
+	return res
+}
+
+func (r reviewRPC) CreateReview(ctx context.Context, review *pb.Review) (*pb.Review, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReviewDelivery, serviceNameReviewDeliveryRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateReview").String(review.Id))
+	defer span.End()
+
+	resp, err := r.review.CreateReview(ctx, &entity.Review{
+		Id:            review.Id,
+		DoctorId:      review.DoctorId,
+		PatientId:     review.PatientId,
+		AppointmentId: review.AppointmentId,
+		Rating:        review.Rating,
+		Comment:       review.Comment,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+	return reviewToPb(resp), nil
+}
+
+func (r reviewRPC) GetReviewById(ctx context.Context, get *pb.GetReqStrReview) (*pb.Review, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReviewDelivery, serviceNameReviewDeliveryRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetReviewById").String(get.Value))
+	defer span.End()
+
+	resp, err := r.review.GetReviewById(ctx, &entity.GetReqStr{Field: get.Field, Value: get.Value, IsActive: get.IsActive})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+	return reviewToPb(resp), nil
+}
+
+func (r reviewRPC) GetAllReviews(ctx context.Context, all *pb.GetAllReview) (*pb.ListReviews, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReviewDelivery, serviceNameReviewDeliveryRepoPrefix+"Get all")
+	span.SetAttributes(attribute.Key("GetAllReviews").String(all.DoctorId))
+	defer span.End()
+
+	resp, err := r.review.GetAllReviews(ctx, &entity.GetAllReviews{
+		Page:      all.Page,
+		Limit:     all.Limit,
+		OrderBy:   all.OrderBy,
+		IsActive:  all.IsActive,
+		DoctorId:  all.DoctorId,
+		PatientId: all.PatientId,
+		Status:    all.Status,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	var reviews pb.ListReviews
+	for i := range resp.Reviews {
+		reviews.Reviews = append(reviews.Reviews, reviewToPb(&resp.Reviews[i]))
+	}
+	reviews.Count = resp.Count
+	return &reviews, nil
+}
+
+func (r reviewRPC) ModerateReview(ctx context.Context, in *pb.ModerateReviewReq) (*pb.Review, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReviewDelivery, serviceNameReviewDeliveryRepoPrefix+"Moderate")
+	span.SetAttributes(attribute.Key("ModerateReview").String(in.Id))
+	defer span.End()
+
+	resp, err := r.review.ModerateReview(ctx, &entity.ModerateReview{
+		Id:             in.Id,
+		Status:         in.Status,
+		ModerationNote: in.ModerationNote,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+	return reviewToPb(resp), nil
+}
+
+func (r reviewRPC) ReplyToReview(ctx context.Context, in *pb.ReplyReviewReq) (*pb.Review, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReviewDelivery, serviceNameReviewDeliveryRepoPrefix+"Reply")
+	span.SetAttributes(attribute.Key("ReplyToReview").String(in.Id))
+	defer span.End()
+
+	resp, err := r.review.ReplyToReview(ctx, &entity.ReplyReview{
+		Id:       in.Id,
+		DoctorId: in.DoctorId,
+		Reply:    in.Reply,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+	return reviewToPb(resp), nil
+}
+
+func (r reviewRPC) DeleteReview(ctx context.Context, del *pb.GetReqStrReview) (*pb.StatusReview, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReviewDelivery, serviceNameReviewDeliveryRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteReview").String(del.Value))
+	defer span.End()
+
+	status, err := r.review.DeleteReview(ctx, &entity.GetReqStr{Field: del.Field, Value: del.Value, IsActive: del.IsActive})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+	return &pb.StatusReview{Status: status}, nil
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	pb "Healthcare_Evrone/genproto/healthcare-service"
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/usecase"
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type roomRPC struct {
+	logger *zap.Logger
+	room   usecase.RoomUsecase
+}
+
+const (
+	serviceNameRoomDelivery           = "RoomDelivery"
+	serviceNameRoomDeliveryRepoPrefix = "RoomDelivery"
+)
+
+func RoomRPC(logger *zap.Logger, roomUsecase usecase.RoomUsecase) pb.RoomServiceServer {
+	return &roomRPC{
+		logger,
+		roomUsecase,
+	}
+}
+
+func roomToPb(room *entity.Room) *pb.Room {
+	return &pb.Room{
+		Id:           room.Id,
+		Order:        room.Order,
+		BranchId:     room.BranchId,
+		DepartmentId: room.DepartmentId,
+		RoomNumber:   room.RoomNumber,
+		FloorNumber:  room.FloorNumber,
+		Name:         room.Name,
+		CreatedAt:    room.CreatedAt.String(),
+		UpdatedAt:    room.UpdatedAt.String(),
+		DeletedAt:    room.DeletedAt.String(),
+	}
+}
+
+func (r roomRPC) CreateRoom(ctx context.Context, room *pb.Room) (*pb.Room, error) {
+	ctx, span := otlp.Start(ctx, serviceNameRoomDelivery, serviceNameRoomDeliveryRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateRoom").String(room.Id))
+	defer span.End()
+
+	resp, err := r.room.CreateRoom(ctx, &entity.Room{
+		Id:           room.Id,
+		BranchId:     room.BranchId,
+		DepartmentId: room.DepartmentId,
+		RoomNumber:   room.RoomNumber,
+		FloorNumber:  room.FloorNumber,
+		Name:         room.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roomToPb(resp), nil
+}
+
+func (r roomRPC) GetRoomById(ctx context.Context, get *pb.GetReqStrRoom) (*pb.Room, error) {
+	ctx, span := otlp.Start(ctx, serviceNameRoomDelivery, serviceNameRoomDeliveryRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key("GetRoomById").String(get.Value))
+	defer span.End()
+
+	resp, err := r.room.GetRoomById(ctx, &entity.GetReqStr{Field: get.Field, Value: get.Value, IsActive: get.IsActive})
+	if err != nil {
+		return nil, err
+	}
+	return roomToPb(resp), nil
+}
+
+func (r roomRPC) GetAllRooms(ctx context.Context, all *pb.GetAllRoom) (*pb.ListRooms, error) {
+	ctx, span := otlp.Start(ctx, serviceNameRoomDelivery, serviceNameRoomDeliveryRepoPrefix+"Get all")
+	span.SetAttributes(attribute.Key("GetAllRooms").String(all.Value))
+	defer span.End()
+
+	resp, err := r.room.GetAllRooms(ctx, &entity.GetAllRooms{
+		Page:         all.Page,
+		Limit:        all.Limit,
+		Field:        all.Field,
+		Value:        all.Value,
+		OrderBy:      all.OrderBy,
+		IsActive:     all.IsActive,
+		BranchId:     all.BranchId,
+		DepartmentId: all.DepartmentId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var rooms pb.ListRooms
+	for i := range resp.Rooms {
+		rooms.Rooms = append(rooms.Rooms, roomToPb(&resp.Rooms[i]))
+	}
+	rooms.Count = resp.Count
+	return &rooms, nil
+}
+
+func (r roomRPC) UpdateRoom(ctx context.Context, room *pb.Room) (*pb.Room, error) {
+	ctx, span := otlp.Start(ctx, serviceNameRoomDelivery, serviceNameRoomDeliveryRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateRoom").String(room.Id))
+	defer span.End()
+
+	resp, err := r.room.UpdateRoom(ctx, &entity.Room{
+		Id:           room.Id,
+		BranchId:     room.BranchId,
+		DepartmentId: room.DepartmentId,
+		RoomNumber:   room.RoomNumber,
+		FloorNumber:  room.FloorNumber,
+		Name:         room.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roomToPb(resp), nil
+}
+
+func (r roomRPC) DeleteRoom(ctx context.Context, del *pb.GetReqStrRoom) (*pb.StatusRoom, error) {
+	ctx, span := otlp.Start(ctx, serviceNameRoomDelivery, serviceNameRoomDeliveryRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteRoom").String(del.Value))
+	defer span.End()
+
+	status, err := r.room.DeleteRoom(ctx, &entity.GetReqStr{Field: del.Field, Value: del.Value, IsActive: del.IsActive})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StatusRoom{Status: status}, nil
+}
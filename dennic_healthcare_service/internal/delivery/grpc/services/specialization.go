@@ -105,6 +105,7 @@ func (r specializationRPC) GetAllSpecializations(ctx context.Context, all *pb.Ge
 		OrderBy:      all.OrderBy,
 		IsActive:     all.IsActive,
 		DepartmentId: all.DepartmentId,
+		BranchId:     all.BranchId,
 	})
 	if err != nil {
 		return nil, err
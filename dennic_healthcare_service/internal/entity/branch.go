@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+type BranchOpeningHours struct {
+	DayOfWeek string `json:"day_of_week"`
+	OpenTime  string `json:"open_time"`
+	CloseTime string `json:"close_time"`
+}
+
+type Branch struct {
+	Id           string
+	Order        int32
+	Name         string
+	Address      string
+	Latitude     float64
+	Longitude    float64
+	Timezone     string
+	PhoneNumber  string
+	OpeningHours []BranchOpeningHours
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    time.Time
+}
+
+type ListBranches struct {
+	Count    int64
+	Branches []Branch
+}
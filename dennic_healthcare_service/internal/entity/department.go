@@ -10,6 +10,7 @@ type Department struct {
 	ImageUrl         string
 	FloorNumber      int32
 	ShortDescription string
+	BranchId         string
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 	DeletedAt        time.Time
@@ -22,6 +23,7 @@ type GetAll struct {
 	Value    string
 	OrderBy  string
 	IsActive bool
+	BranchId string
 }
 
 type ListDepartments struct {
@@ -44,6 +44,9 @@ type Doctor struct {
 	DeletedAt     time.Time
 }
 
+// EventDoctorCreated tells other services about a doctor added to the clinic.
+const EventDoctorCreated = "doctor.created"
+
 type DoctorAndDoctorHours struct {
 	Id            string
 	Order         int32
@@ -71,6 +74,8 @@ type DoctorAndDoctorHours struct {
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 	DeletedAt     time.Time
+	Rating        float32
+	ReviewCount   int32
 }
 
 type ListDoctorsAndHours struct {
@@ -86,6 +91,7 @@ type GetReqStrDep struct {
 	Field        string
 	Value        string
 	OrderBy      string
+	BranchId     string
 }
 
 type GetReqStrSpec struct {
@@ -96,4 +102,5 @@ type GetReqStrSpec struct {
 	Field            string
 	Value            string
 	OrderBy          string
+	BranchId         string
 }
@@ -8,6 +8,8 @@ type DoctorWorkingHours struct {
 	DayOfWeek  string
 	StartTime  string
 	FinishTime string
+	BranchId   string
+	RoomId     string
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 	DeletedAt  time.Time
@@ -7,16 +7,60 @@ type DoctorServices struct {
 	Order            int32
 	DoctorId         string
 	SpecializationId string
-	OnlinePrice      float32
-	OfflinePrice     float32
-	Name             string
-	Duration         time.Time
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
-	DeletedAt        time.Time
+	// OnlinePrice and OfflinePrice are base prices kept as decimal strings
+	// (NUMERIC in the database); price list entries take precedence over them.
+	OnlinePrice          string
+	OfflinePrice         string
+	Currency             string
+	Name                 string
+	DurationMinutes      int32
+	PrepInstructions     string
+	RequiredResourceType string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	DeletedAt            time.Time
 }
 
 type ListDoctorServices struct {
 	DoctorServices []DoctorServices
 	Count          int64
 }
+
+// ServicePrice is a price list entry of a doctor service. Entries are never
+// edited, so the list ordered by EffectiveFrom doubles as the price history.
+type ServicePrice struct {
+	Id              string
+	DoctorServiceId string
+	Modality        string
+	Amount          string
+	Currency        string
+	EffectiveFrom   time.Time
+	EffectiveTo     time.Time
+	IsPromo         bool
+	PromoName       string
+	CreatedAt       time.Time
+	DeletedAt       time.Time
+}
+
+type ListServicePrices struct {
+	Count  int64
+	Prices []ServicePrice
+}
+
+type GetServicePrices struct {
+	DoctorServiceId string
+	Modality        string
+	Page            int64
+	Limit           int64
+}
+
+type GetEffectivePrice struct {
+	DoctorServiceId string
+	Modality        string
+	At              time.Time
+}
+
+const (
+	ModalityOnline  = "online"
+	ModalityOffline = "offline"
+)
@@ -36,6 +36,19 @@ func NewErrConflict(text string) *ErrConflict {
 	return &ErrConflict{text}
 }
 
+// error permission denied
+type ErrPermissionDenied struct {
+	name string
+}
+
+func (e *ErrPermissionDenied) Error() string {
+	return "access to " + e.name + " denied"
+}
+
+func NewErrPermissionDenied(text string) *ErrPermissionDenied {
+	return &ErrPermissionDenied{text}
+}
+
 // error validation
 type ErrValidation struct {
 	Err    error
@@ -33,4 +33,5 @@ type GetAllReas struct {
 	Field    string
 	Value    string
 	OrderBy  string
+	BranchId string
 }
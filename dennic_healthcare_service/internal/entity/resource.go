@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+// Resource is a schedulable piece of equipment (ultrasound, X-ray, ...) that
+// lives in a branch and, optionally, in a particular room.
+type Resource struct {
+	Id           string
+	Order        int32
+	BranchId     string
+	RoomId       string
+	ResourceType string
+	Name         string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    time.Time
+}
+
+type ListResources struct {
+	Count     int64
+	Resources []Resource
+}
+
+type GetAllResources struct {
+	Page         int64
+	Limit        int64
+	Field        string
+	Value        string
+	OrderBy      string
+	IsActive     bool
+	BranchId     string
+	RoomId       string
+	ResourceType string
+}
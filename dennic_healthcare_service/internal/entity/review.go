@@ -0,0 +1,55 @@
+package entity
+
+import "time"
+
+// Review moderation states. Only approved reviews are shown publicly and
+// counted in the doctor's rating.
+const (
+	ReviewPending  = "pending"
+	ReviewApproved = "approved"
+	ReviewRejected = "rejected"
+)
+
+// Review is a patient's rating of a doctor after an attended appointment.
+type Review struct {
+	Id             string
+	DoctorId       string
+	PatientId      string
+	AppointmentId  int64
+	Rating         int32
+	Comment        string
+	Status         string
+	ModerationNote string
+	Reply          string
+	RepliedAt      time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      time.Time
+}
+
+type ListReviews struct {
+	Count   int64
+	Reviews []Review
+}
+
+type GetAllReviews struct {
+	Page      int64
+	Limit     int64
+	OrderBy   string
+	IsActive  bool
+	DoctorId  string
+	PatientId string
+	Status    string
+}
+
+type ModerateReview struct {
+	Id             string
+	Status         string
+	ModerationNote string
+}
+
+type ReplyReview struct {
+	Id       string
+	DoctorId string
+	Reply    string
+}
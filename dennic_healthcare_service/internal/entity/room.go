@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+type Room struct {
+	Id           string
+	Order        int32
+	BranchId     string
+	DepartmentId string
+	RoomNumber   string
+	FloorNumber  int32
+	Name         string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    time.Time
+}
+
+type ListRooms struct {
+	Count int64
+	Rooms []Room
+}
+
+type GetAllRooms struct {
+	Page         int64
+	Limit        int64
+	Field        string
+	Value        string
+	OrderBy      string
+	IsActive     bool
+	BranchId     string
+	DepartmentId string
+}
@@ -27,4 +27,5 @@ type GetAllSpecializations struct {
 	OrderBy      string
 	DepartmentId string
 	IsActive     bool
+	BranchId     string
 }
@@ -1,25 +1,32 @@
 package kafka
 
 import (
+	"Healthcare_Evrone/genproto/events"
 	"Healthcare_Evrone/internal/entity"
 	"Healthcare_Evrone/internal/pkg/config"
-	"encoding/json"
-	// "Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/otlp"
 	"context"
+	"dennic_kafka/eventbus"
+	"time"
 
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
 )
 
+// doctorEventVersion is the version of events.DoctorCreated.
+const doctorEventVersion = 1
+
 type producer struct {
-	logger     *zap.Logger
-	userCreate *kafka.Writer
+	logger        *zap.Logger
+	name          string
+	doctorCreated *kafka.Writer
 }
 
 func NewProducer(config *config.Config, logger *zap.Logger) *producer {
 	return &producer{
 		logger: logger,
-		userCreate: &kafka.Writer{
+		name:   config.APP,
+		doctorCreated: &kafka.Writer{
 			Addr:                   kafka.TCP(config.Kafka.Address...),
 			Topic:                  config.Kafka.Topic.Healthcare,
 			Balancer:               &kafka.Hash{},
@@ -28,11 +35,11 @@ func NewProducer(config *config.Config, logger *zap.Logger) *producer {
 			Async:                  true, // make the writer asynchronous
 			Completion: func(messages []kafka.Message, err error) {
 				if err != nil {
-					logger.Error("kafka userCreate", zap.Error(err))
+					logger.Error("kafka doctorCreated", zap.Error(err))
 				}
 				for _, message := range messages {
 					logger.Sugar().Info(
-						"kafka userCreate message",
+						"kafka doctorCreated message",
 						zap.Int("partition", message.Partition),
 						zap.Int64("offset", message.Offset),
 						zap.String("key", string(message.Key)),
@@ -44,25 +51,42 @@ func NewProducer(config *config.Config, logger *zap.Logger) *producer {
 	}
 }
 
-func (p *producer) buildMessage(key string, value []byte) kafka.Message {
-	return kafka.Message{
+// buildMessage carries the trace of ctx in the message headers.
+func (p *producer) buildMessage(ctx context.Context, key string, value []byte) kafka.Message {
+	message := kafka.Message{
 		Key:   []byte(key),
 		Value: value,
 	}
+	eventbus.InjectTraceContext(ctx, &message)
+	return message
 }
 
-func (p *producer) ProduceUserToCreate(ctx context.Context, key string, value *entity.Doctor) error {
+// PublishDoctorCreated keys the event by the doctor.
+func (p *producer) PublishDoctorCreated(ctx context.Context, doctor *entity.Doctor) error {
+	ctx, span := otlp.Start(ctx, "kafka producer", "DoctorCreatedProducer")
+	defer span.End()
 
-	byteValue, err := json.Marshal(&value)
+	payload := &events.DoctorCreated{
+		Id:           doctor.Id,
+		FirstName:    doctor.FirstName,
+		LastName:     doctor.LastName,
+		ImageUrl:     doctor.ImageUrl,
+		Gender:       doctor.Gender,
+		BirthDate:    doctor.BirthDate,
+		PhoneNumber:  doctor.PhoneNumber,
+		Email:        doctor.Email,
+		DepartmentId: doctor.DepartmentId,
+		RoomNumber:   doctor.RoomNumber,
+	}
+	value, err := eventbus.NewEnvelope(ctx, p.name, entity.EventDoctorCreated, doctorEventVersion, time.Now(), payload)
 	if err != nil {
 		return err
 	}
-	message := p.buildMessage(key, byteValue)
-	return p.userCreate.WriteMessages(ctx, message)
+	return p.doctorCreated.WriteMessages(ctx, p.buildMessage(ctx, doctor.Id, value))
 }
 
 func (p *producer) Close() {
-	if err := p.userCreate.Close(); err != nil {
-		p.logger.Error("error during close writer articleCategoryCreated", zap.Error(err))
+	if err := p.doctorCreated.Close(); err != nil {
+		p.logger.Error("error during close writer doctorCreated", zap.Error(err))
 	}
 }
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+)
+
+type BranchRepository interface {
+	CreateBranch(ctx context.Context, branch *entity.Branch) (*entity.Branch, error)
+	GetBranchById(ctx context.Context, get *entity.GetReqStr) (*entity.Branch, error)
+	GetAllBranches(ctx context.Context, all *entity.GetAll) (*entity.ListBranches, error)
+	UpdateBranch(ctx context.Context, branch *entity.Branch) (*entity.Branch, error)
+	DeleteBranch(ctx context.Context, get *entity.GetReqStr) (bool, error)
+}
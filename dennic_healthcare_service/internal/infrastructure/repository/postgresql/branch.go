@@ -0,0 +1,270 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"time"
+)
+
+const (
+	branchTableName             = "branches"
+	serviceNameBranch           = "branch"
+	serviceNameBranchRepoPrefix = "branch"
+)
+
+type Branch struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewBranchRepo(db *postgres.PostgresDB) *Branch {
+	return &Branch{
+		tableName: branchTableName,
+		db:        db,
+	}
+}
+
+func (p *Branch) branchSelectQueryPrefix() string {
+	return `id,
+			branch_order,
+			name,
+			address,
+			latitude,
+			longitude,
+			timezone,
+			phone_number,
+			opening_hours,
+			created_at,
+			updated_at,
+			deleted_at`
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func (p *Branch) scanBranch(row rowScanner) (*entity.Branch, error) {
+	var (
+		branch               entity.Branch
+		openingHours         []byte
+		updatedAt, deletedAt sql.NullTime
+	)
+	err := row.Scan(
+		&branch.Id,
+		&branch.Order,
+		&branch.Name,
+		&branch.Address,
+		&branch.Latitude,
+		&branch.Longitude,
+		&branch.Timezone,
+		&branch.PhoneNumber,
+		&openingHours,
+		&branch.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(openingHours) > 0 {
+		if err := json.Unmarshal(openingHours, &branch.OpeningHours); err != nil {
+			return nil, err
+		}
+	}
+	if updatedAt.Valid {
+		branch.UpdatedAt = updatedAt.Time
+	}
+	if deletedAt.Valid {
+		branch.DeletedAt = deletedAt.Time
+	}
+	return &branch, nil
+}
+
+func (p *Branch) CreateBranch(ctx context.Context, branch *entity.Branch) (*entity.Branch, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameBranch, serviceNameBranchRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateBranch").String(branch.Id))
+	defer span.End()
+
+	openingHours, err := json.Marshal(branch.OpeningHours)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]any{
+		"id":            branch.Id,
+		"name":          branch.Name,
+		"address":       branch.Address,
+		"latitude":      branch.Latitude,
+		"longitude":     branch.Longitude,
+		"timezone":      branch.Timezone,
+		"phone_number":  branch.PhoneNumber,
+		"opening_hours": string(openingHours),
+	}
+	query, args, err := p.db.Sq.Builder.
+		Insert(p.tableName).
+		SetMap(data).
+		Suffix(fmt.Sprintf("RETURNING %s", p.branchSelectQueryPrefix())).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, " create"))
+	}
+
+	resp, err := p.scanBranch(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	return resp, nil
+}
+
+func (p *Branch) GetBranchById(ctx context.Context, get *entity.GetReqStr) (*entity.Branch, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameBranch, serviceNameBranchRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key(get.Field).String(get.Value))
+	defer span.End()
+
+	queryBuilder := p.db.Sq.Builder.Select(p.branchSelectQueryPrefix()).From(p.tableName)
+	if !get.IsActive {
+		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
+	}
+	queryBuilder = queryBuilder.Where(p.db.Sq.Equal(get.Field, get.Value))
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" get")
+	}
+
+	branch, err := p.scanBranch(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	return branch, nil
+}
+
+func (p *Branch) GetAllBranches(ctx context.Context, all *entity.GetAll) (*entity.ListBranches, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameBranch, serviceNameBranchRepoPrefix+"Get All")
+	span.SetAttributes(attribute.Key(all.Field).String(all.Value))
+	defer span.End()
+
+	offset := all.Limit * (all.Page - 1)
+
+	queryBuilder := p.db.Sq.Builder.Select(p.branchSelectQueryPrefix()).From(p.tableName)
+	countBuilder := p.db.Sq.Builder.Select("count(*)").From(p.tableName)
+	if all.Field != "" {
+		queryBuilder = queryBuilder.Where(fmt.Sprintf(`%s ILIKE '%s'`, all.Field, all.Value+"%"))
+		countBuilder = countBuilder.Where(fmt.Sprintf(`%s ILIKE '%s'`, all.Field, all.Value+"%"))
+	}
+	if all.OrderBy != "" {
+		queryBuilder = queryBuilder.OrderBy(all.OrderBy)
+	}
+	if !all.IsActive {
+		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
+		countBuilder = countBuilder.Where("deleted_at IS NULL")
+	}
+	queryBuilder = queryBuilder.Limit(uint64(all.Limit)).Offset(uint64(offset))
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" list")
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var branches entity.ListBranches
+	for rows.Next() {
+		branch, err := p.scanBranch(rows)
+		if err != nil {
+			return nil, err
+		}
+		branches.Branches = append(branches.Branches, *branch)
+	}
+
+	var count int64
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" count")
+	}
+	err = p.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	branches.Count = count
+	return &branches, nil
+}
+
+func (p *Branch) UpdateBranch(ctx context.Context, up *entity.Branch) (*entity.Branch, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameBranch, serviceNameBranchRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateBranch").String(up.Id))
+	defer span.End()
+
+	openingHours, err := json.Marshal(up.OpeningHours)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]any{
+		"name":          up.Name,
+		"address":       up.Address,
+		"latitude":      up.Latitude,
+		"longitude":     up.Longitude,
+		"timezone":      up.Timezone,
+		"phone_number":  up.PhoneNumber,
+		"opening_hours": string(openingHours),
+		"updated_at":    time.Now().Add(time.Hour * 5),
+	}
+	query, args, err := p.db.Sq.Builder.Update(p.tableName).SetMap(data).
+		Where(p.db.Sq.And(p.db.Sq.Equal("id", up.Id), p.db.Sq.Equal("deleted_at", nil))).
+		Suffix(fmt.Sprintf("RETURNING %s", p.branchSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" update")
+	}
+
+	resp, err := p.scanBranch(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	return resp, nil
+}
+
+func (p *Branch) DeleteBranch(ctx context.Context, get *entity.GetReqStr) (bool, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameBranch, serviceNameBranchRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteBranch").String(get.Value))
+	defer span.End()
+
+	data := map[string]any{
+		"deleted_at": time.Now().Add(time.Hour * 5),
+	}
+	var args []interface{}
+	var query string
+	var err error
+	if get.IsActive {
+		query, args, err = p.db.Sq.Builder.Delete(p.tableName).From(p.tableName).
+			Where(p.db.Sq.Equal(get.Field, get.Value)).ToSql()
+		if err != nil {
+			return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
+		}
+	} else {
+		query, args, err = p.db.Sq.Builder.Update(p.tableName).SetMap(data).
+			Where(p.db.Sq.And(p.db.Sq.Equal(get.Field, get.Value), p.db.Sq.Equal("deleted_at", nil))).ToSql()
+		if err != nil {
+			return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
+		}
+	}
+	resp, err := p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, p.db.Error(err)
+	}
+	if resp.RowsAffected() > 0 {
+		return true, nil
+	}
+	return false, nil
+}
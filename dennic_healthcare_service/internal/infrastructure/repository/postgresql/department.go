@@ -37,6 +37,7 @@ func (h *DepartMent) departmentSelectQueryPrefix() string {
 			image_url,
 			floor_number,
 			short_description,
+			branch_id,
 			created_at,
 			updated_at,
 			deleted_at`
@@ -55,6 +56,7 @@ func (h *DepartMent) CreateDepartment(ctx context.Context, dep *entity.Departmen
 		"image_url":         dep.ImageUrl,
 		"floor_number":      dep.FloorNumber,
 		"short_description": dep.ShortDescription,
+		"branch_id":         nullString(dep.BranchId),
 	}
 	query, args, err := h.db.Sq.Builder.
 		Insert(h.tableName).
@@ -66,6 +68,7 @@ func (h *DepartMent) CreateDepartment(ctx context.Context, dep *entity.Departmen
 		return nil, h.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", h.tableName, " create"))
 	}
 	var updatedAt, deletedAt sql.NullTime
+	var branchId sql.NullString
 	var resp entity.Department
 	err = h.db.QueryRow(ctx, query, args...).Scan(
 		&resp.Id,
@@ -75,6 +78,7 @@ func (h *DepartMent) CreateDepartment(ctx context.Context, dep *entity.Departmen
 		&resp.ImageUrl,
 		&resp.FloorNumber,
 		&resp.ShortDescription,
+		&branchId,
 		&resp.CreatedAt,
 		&updatedAt,
 		&deletedAt,
@@ -88,6 +92,9 @@ func (h *DepartMent) CreateDepartment(ctx context.Context, dep *entity.Departmen
 	if deletedAt.Valid {
 		resp.DeletedAt = deletedAt.Time
 	}
+	if branchId.Valid {
+		resp.BranchId = branchId.String
+	}
 
 	return &resp, nil
 }
@@ -109,6 +116,7 @@ func (p *DepartMent) GetDepartmentById(ctx context.Context, get *entity.GetReqSt
 		return nil, err
 	}
 	var updatedAt, deletedAt sql.NullTime
+	var branchId sql.NullString
 	err = p.db.QueryRow(ctx, query, args...).Scan(
 		&dep.Id,
 		&dep.Order,
@@ -117,6 +125,7 @@ func (p *DepartMent) GetDepartmentById(ctx context.Context, get *entity.GetReqSt
 		&dep.ImageUrl,
 		&dep.FloorNumber,
 		&dep.ShortDescription,
+		&branchId,
 		&dep.CreatedAt,
 		&updatedAt,
 		&deletedAt,
@@ -127,6 +136,9 @@ func (p *DepartMent) GetDepartmentById(ctx context.Context, get *entity.GetReqSt
 	if deletedAt.Valid {
 		dep.DeletedAt = deletedAt.Time
 	}
+	if branchId.Valid {
+		dep.BranchId = branchId.String
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -153,6 +165,10 @@ func (p *DepartMent) GetAllDepartments(ctx context.Context, all *entity.GetAll)
 		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
 		countBuilder = countBuilder.Where("deleted_at IS NULL")
 	}
+	if all.BranchId != "" {
+		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("branch_id", all.BranchId))
+		countBuilder = countBuilder.Where(p.db.Sq.Equal("branch_id", all.BranchId))
+	}
 	queryBuilder = queryBuilder.Limit(uint64(all.Limit)).Offset(uint64(offset))
 	query, args, err := queryBuilder.ToSql()
 
@@ -168,6 +184,7 @@ func (p *DepartMent) GetAllDepartments(ctx context.Context, all *entity.GetAll)
 	for rows.Next() {
 		var dep entity.Department
 		var updatedAt, deletedAt sql.NullTime
+		var branchId sql.NullString
 		err = rows.Scan(
 			&dep.Id,
 			&dep.Order,
@@ -176,6 +193,7 @@ func (p *DepartMent) GetAllDepartments(ctx context.Context, all *entity.GetAll)
 			&dep.ImageUrl,
 			&dep.FloorNumber,
 			&dep.ShortDescription,
+			&branchId,
 			&dep.CreatedAt,
 			&updatedAt,
 			&deletedAt,
@@ -189,11 +207,17 @@ func (p *DepartMent) GetAllDepartments(ctx context.Context, all *entity.GetAll)
 		if deletedAt.Valid {
 			dep.DeletedAt = deletedAt.Time
 		}
+		if branchId.Valid {
+			dep.BranchId = branchId.String
+		}
 		departments.Departments = append(departments.Departments, dep)
 	}
 	var count int64
-	queryCount, _, err := countBuilder.ToSql()
-	err = p.db.QueryRow(ctx, queryCount).Scan(&count)
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, departmentTableName+" count")
+	}
+	err = p.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
@@ -213,6 +237,7 @@ func (p *DepartMent) UpdateDepartment(ctx context.Context, up *entity.Department
 		"image_url":         up.ImageUrl,
 		"floor_number":      up.FloorNumber,
 		"short_description": up.ShortDescription,
+		"branch_id":         nullString(up.BranchId),
 		"updated_at":        time.Now().Add(time.Hour * 5),
 	}
 	query, args, err := p.db.Sq.Builder.Update(p.tableName).SetMap(data).Where(p.db.Sq.Equal("id", up.Id)).Suffix(fmt.Sprintf("RETURNING %s", p.departmentSelectQueryPrefix())).ToSql()
@@ -221,6 +246,7 @@ func (p *DepartMent) UpdateDepartment(ctx context.Context, up *entity.Department
 		return nil, p.db.ErrSQLBuild(err, p.tableName+" update")
 	}
 	var deletedAt sql.NullTime
+	var branchId sql.NullString
 	err = p.db.QueryRow(ctx, query, args...).Scan(
 		&up.Id,
 		&up.Order,
@@ -229,6 +255,7 @@ func (p *DepartMent) UpdateDepartment(ctx context.Context, up *entity.Department
 		&up.ImageUrl,
 		&up.FloorNumber,
 		&up.ShortDescription,
+		&branchId,
 		&up.CreatedAt,
 		&up.UpdatedAt,
 		&deletedAt,
@@ -236,6 +263,9 @@ func (p *DepartMent) UpdateDepartment(ctx context.Context, up *entity.Department
 	if deletedAt.Valid {
 		up.DeletedAt = deletedAt.Time
 	}
+	if branchId.Valid {
+		up.BranchId = branchId.String
+	}
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
@@ -276,3 +306,8 @@ func (p *DepartMent) DeleteDepartment(ctx context.Context, get *entity.GetReqStr
 	}
 	return false, nil
 }
+
+// departmentInBranch limits rows to departments that belong to the given branch.
+func departmentInBranch(departmentIdColumn string) string {
+	return fmt.Sprintf("%s IN (SELECT dep.id FROM departments dep WHERE dep.branch_id = ?)", departmentIdColumn)
+}
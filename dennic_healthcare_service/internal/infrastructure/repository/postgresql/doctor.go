@@ -82,7 +82,9 @@ func (p *DocTor) getDocTorSelectQueryPrefix() string {
                         d.department_id,
                         d.room_number,
                         d.created_at,
-                        d.updated_at
+                        d.updated_at,
+                        d.rating,
+                        d.review_count
 						
 `
 }
@@ -205,6 +207,8 @@ func (h *DocTor) GetDoctorById(ctx context.Context, get *entity.GetReqStr) (*ent
 		&doctor.RoomNumber,
 		&doctor.CreatedAt,
 		&updatedAt,
+		&doctor.Rating,
+		&doctor.ReviewCount,
 	)
 	if updatedAt.Valid {
 		doctor.UpdatedAt = updatedAt.Time
@@ -251,6 +255,10 @@ func (h *DocTor) GetAllDoctors(ctx context.Context, all *entity.GetAll) (*entity
 		queryBuilder = queryBuilder.Where("d.deleted_at IS NULL")
 		countBuilder = countBuilder.Where("deleted_at IS NULL")
 	}
+	if all.BranchId != "" {
+		queryBuilder = queryBuilder.Where(h.db.Sq.Equal("dwh.branch_id", all.BranchId))
+		countBuilder = countBuilder.Where(doctorWorksAtBranch(h.tableName+".id"), all.BranchId)
+	}
 	queryBuilder = queryBuilder.Limit(uint64(all.Limit)).Offset(uint64(offset))
 	query, args, err := queryBuilder.ToSql()
 
@@ -291,6 +299,8 @@ func (h *DocTor) GetAllDoctors(ctx context.Context, all *entity.GetAll) (*entity
 			&doctor.RoomNumber,
 			&doctor.CreatedAt,
 			&updatedAt,
+			&doctor.Rating,
+			&doctor.ReviewCount,
 		)
 		if err != nil {
 			return nil, err
@@ -313,8 +323,11 @@ func (h *DocTor) GetAllDoctors(ctx context.Context, all *entity.GetAll) (*entity
 		doctors.Doctors = append(doctors.Doctors, doctor)
 	}
 	var count int64
-	queryCount, _, err := countBuilder.ToSql()
-	err = h.db.QueryRow(ctx, queryCount).Scan(&count)
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, h.db.ErrSQLBuild(err, h.tableName+" count")
+	}
+	err = h.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
 	if err != nil {
 		return nil, h.db.Error(err)
 	}
@@ -449,11 +462,15 @@ func (h *DocTor) ListDoctorsByDepartmentId(ctx context.Context, in *entity.GetRe
 	if in.OrderBy != "" {
 		queryBuilder = queryBuilder.OrderBy(in.OrderBy)
 	}
-	countBuilder := h.db.Sq.Builder.Select("count(*)").From(h.tableName)
+	countBuilder := h.db.Sq.Builder.Select("count(*)").From(h.tableName).Where(h.db.Sq.Equal("department_id", in.DepartmentId))
 	if !in.IsActive {
 		countBuilder = countBuilder.Where("deleted_at IS NULL")
 		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
 	}
+	if in.BranchId != "" {
+		queryBuilder = queryBuilder.Where(doctorWorksAtBranch(h.tableName+".id"), in.BranchId)
+		countBuilder = countBuilder.Where(doctorWorksAtBranch(h.tableName+".id"), in.BranchId)
+	}
 
 	queryBuilder = queryBuilder.Where(h.db.Sq.Equal("department_id", in.DepartmentId)).Limit(uint64(in.Limit)).Offset(uint64(offset))
 	query, args, err := queryBuilder.ToSql()
@@ -509,8 +526,11 @@ func (h *DocTor) ListDoctorsByDepartmentId(ctx context.Context, in *entity.GetRe
 		doctors.Doctors = append(doctors.Doctors, doctor)
 	}
 	var count int64
-	queryCount, _, err := countBuilder.ToSql()
-	err = h.db.QueryRow(ctx, queryCount).Scan(&count)
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, h.db.ErrSQLBuild(err, h.tableName+" count")
+	}
+	err = h.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
 	if err != nil {
 		return nil, h.db.Error(err)
 	}
@@ -542,6 +562,10 @@ func (h *DocTor) ListDoctorBySpecializationId(ctx context.Context, in *entity.Ge
 		countBuilder = countBuilder.Where("d.deleted_at IS NULL")
 		queryBuilder = queryBuilder.Where("d.deleted_at IS NULL")
 	}
+	if in.BranchId != "" {
+		queryBuilder = queryBuilder.Where(doctorWorksAtBranch("d.id"), in.BranchId)
+		countBuilder = countBuilder.Where(doctorWorksAtBranch("d.id"), in.BranchId)
+	}
 
 	queryBuilder = queryBuilder.Where(h.db.Sq.Equal("ds.specialization_id", in.SpecializationId)).
 		Limit(uint64(in.Limit)).Offset(uint64(offset))
@@ -595,10 +619,11 @@ func (h *DocTor) ListDoctorBySpecializationId(ctx context.Context, in *entity.Ge
 		doctors.Doctors = append(doctors.Doctors, doctor)
 	}
 	var count int64
-	queryCount, _, err := countBuilder.ToSql()
-	fmt.Println(queryCount)
-
-	err = h.db.QueryRow(ctx, queryCount).Scan(&count)
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, h.db.ErrSQLBuild(err, h.tableName+" count")
+	}
+	err = h.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
 	if err != nil {
 		return nil, h.db.Error(err)
 	}
@@ -606,3 +631,9 @@ func (h *DocTor) ListDoctorBySpecializationId(ctx context.Context, in *entity.Ge
 	doctors.Count = count
 	return &doctors, nil
 }
+
+// doctorWorksAtBranch limits doctors to those having working hours at the given branch.
+func doctorWorksAtBranch(doctorIdColumn string) string {
+	return fmt.Sprintf(`EXISTS (SELECT 1 FROM doctor_working_hours bwh
+		WHERE bwh.doctor_id = %s AND bwh.branch_id = ? AND bwh.deleted_at IS NULL)`, doctorIdColumn)
+}
@@ -35,6 +35,8 @@ func (p *Dwh) doctorWorkingHoursSelectQueryPrefix() string {
 			day_of_week,
 			start_time,
 			finish_time,
+			branch_id,
+			room_id,
 			created_at,
 			updated_at,
 			deleted_at
@@ -50,6 +52,8 @@ func (p Dwh) CreateDoctorWorkingHours(ctx context.Context, in *entity.DoctorWork
 		"day_of_week": in.DayOfWeek,
 		"start_time":  in.StartTime,
 		"finish_time": in.FinishTime,
+		"branch_id":   nullString(in.BranchId),
+		"room_id":     nullString(in.RoomId),
 	}
 	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).
 		Suffix(fmt.Sprintf("RETURNING %s", p.doctorWorkingHoursSelectQueryPrefix())).ToSql()
@@ -58,12 +62,15 @@ func (p Dwh) CreateDoctorWorkingHours(ctx context.Context, in *entity.DoctorWork
 		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
 	}
 	var updatedAt, deletedAt, startTime, finishTime sql.NullTime
+	var branchId, roomId sql.NullString
 	err = p.db.QueryRow(ctx, query, args...).Scan(
 		&in.Id,
 		&in.DoctorId,
 		&in.DayOfWeek,
 		&startTime,
 		&finishTime,
+		&branchId,
+		&roomId,
 		&in.CreatedAt,
 		&updatedAt,
 		&deletedAt,
@@ -73,6 +80,8 @@ func (p Dwh) CreateDoctorWorkingHours(ctx context.Context, in *entity.DoctorWork
 	}
 	in.StartTime = startTime.Time.Format("15:04:05")
 	in.FinishTime = finishTime.Time.Format("15:04:05")
+	in.BranchId = branchId.String
+	in.RoomId = roomId.String
 
 	if updatedAt.Valid {
 		in.UpdatedAt = updatedAt.Time
@@ -101,18 +110,23 @@ func (p Dwh) GetDoctorWorkingHoursById(ctx context.Context, in *entity.GetReqStr
 		return nil, err
 	}
 	var updatedAt, deletedAt, startTime, finishTime sql.NullTime
+	var branchId, roomId sql.NullString
 	err = p.db.QueryRow(ctx, query, args...).Scan(
 		&doctorWorkingHours.Id,
 		&doctorWorkingHours.DoctorId,
 		&doctorWorkingHours.DayOfWeek,
 		&startTime,
 		&finishTime,
+		&branchId,
+		&roomId,
 		&doctorWorkingHours.CreatedAt,
 		&updatedAt,
 		&deletedAt,
 	)
 	doctorWorkingHours.StartTime = startTime.Time.Format("15:04:05")
 	doctorWorkingHours.FinishTime = finishTime.Time.Format("15:04:05")
+	doctorWorkingHours.BranchId = branchId.String
+	doctorWorkingHours.RoomId = roomId.String
 
 	if updatedAt.Valid {
 		doctorWorkingHours.UpdatedAt = updatedAt.Time
@@ -147,6 +161,10 @@ func (p Dwh) GetAllDoctorWorkingHours(ctx context.Context, all *entity.GetAll) (
 		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
 		countBuilder = countBuilder.Where("deleted_at IS NULL")
 	}
+	if all.BranchId != "" {
+		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("branch_id", all.BranchId))
+		countBuilder = countBuilder.Where(p.db.Sq.Equal("branch_id", all.BranchId))
+	}
 	queryBuilder = queryBuilder.Limit(uint64(all.Limit)).Offset(uint64(offset))
 	query, args, err := queryBuilder.ToSql()
 	if err != nil {
@@ -160,12 +178,15 @@ func (p Dwh) GetAllDoctorWorkingHours(ctx context.Context, all *entity.GetAll) (
 	for rows.Next() {
 		var Dwhour entity.DoctorWorkingHours
 		var deletedAt, updatedAt, startTime, finishTime sql.NullTime
+		var branchId, roomId sql.NullString
 		err = rows.Scan(
 			&Dwhour.Id,
 			&Dwhour.DoctorId,
 			&Dwhour.DayOfWeek,
 			&startTime,
 			&finishTime,
+			&branchId,
+			&roomId,
 			&Dwhour.CreatedAt,
 			&updatedAt,
 			&deletedAt,
@@ -173,6 +194,8 @@ func (p Dwh) GetAllDoctorWorkingHours(ctx context.Context, all *entity.GetAll) (
 
 		Dwhour.StartTime = startTime.Time.Format("15:04:05")
 		Dwhour.FinishTime = finishTime.Time.Format("15:04:05")
+		Dwhour.BranchId = branchId.String
+		Dwhour.RoomId = roomId.String
 		if updatedAt.Valid {
 			Dwhour.UpdatedAt = updatedAt.Time
 		}
@@ -185,8 +208,11 @@ func (p Dwh) GetAllDoctorWorkingHours(ctx context.Context, all *entity.GetAll) (
 		doctorWorkHour.DoctorWhs = append(doctorWorkHour.DoctorWhs, Dwhour)
 	}
 	var count int32
-	queryCount, _, err := countBuilder.ToSql()
-	err = p.db.QueryRow(ctx, queryCount).Scan(&count)
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" count")
+	}
+	err = p.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
@@ -207,6 +233,8 @@ func (p Dwh) UpdateDoctorWorkingHours(ctx context.Context, in *entity.DoctorWork
 		"day_of_week": in.DayOfWeek,
 		"start_time":  in.StartTime,
 		"finish_time": in.FinishTime,
+		"branch_id":   nullString(in.BranchId),
+		"room_id":     nullString(in.RoomId),
 		"updated_at":  time.Now().Add(time.Hour * 5),
 	}
 	query, args, err := p.db.Sq.Builder.Update(p.tableName).
@@ -216,12 +244,15 @@ func (p Dwh) UpdateDoctorWorkingHours(ctx context.Context, in *entity.DoctorWork
 		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
 	}
 	var updatedAt, deletedAt, startTime, finishTime sql.NullTime
+	var branchId, roomId sql.NullString
 	err = p.db.QueryRow(ctx, query, args...).Scan(
 		&in.Id,
 		&in.DoctorId,
 		&in.DayOfWeek,
 		&startTime,
 		&finishTime,
+		&branchId,
+		&roomId,
 		&in.CreatedAt,
 		&updatedAt,
 		&deletedAt,
@@ -231,6 +262,8 @@ func (p Dwh) UpdateDoctorWorkingHours(ctx context.Context, in *entity.DoctorWork
 	}
 	in.StartTime = startTime.Time.Format("15:04:05")
 	in.FinishTime = finishTime.Time.Format("15:04:05")
+	in.BranchId = branchId.String
+	in.RoomId = roomId.String
 
 	if updatedAt.Valid {
 		in.UpdatedAt = updatedAt.Time
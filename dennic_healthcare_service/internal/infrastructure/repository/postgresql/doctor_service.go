@@ -35,10 +35,13 @@ func (d Ds) doctorServicesSelectQueryPrefix() string {
 			doctor_service_order,
 			doctor_id,
 			specialization_id,
-			online_price,
-			offline_price,
+			online_price::text,
+			offline_price::text,
+			currency,
 			name,
-			duration,
+			duration_minutes,
+			prep_instructions,
+			required_resource_type,
 			created_at,
 			updated_at,
 			deleted_at
@@ -52,13 +55,16 @@ func (d Ds) CreateDoctorServices(ctx context.Context, in *entity.DoctorServices)
 	defer span.End()
 
 	data := map[string]any{
-		"id":                in.Id,
-		"doctor_id":         in.DoctorId,
-		"specialization_id": in.SpecializationId,
-		"online_price":      in.OnlinePrice,
-		"offline_price":     in.OfflinePrice,
-		"name":              in.Name,
-		"duration":          in.Duration,
+		"id":                     in.Id,
+		"doctor_id":              in.DoctorId,
+		"specialization_id":      in.SpecializationId,
+		"online_price":           in.OnlinePrice,
+		"offline_price":          in.OfflinePrice,
+		"currency":               in.Currency,
+		"name":                   in.Name,
+		"duration_minutes":       in.DurationMinutes,
+		"prep_instructions":      in.PrepInstructions,
+		"required_resource_type": in.RequiredResourceType,
 	}
 	query, args, err := d.db.Sq.Builder.Insert(d.tableName).
 		SetMap(data).Suffix(fmt.Sprintf("RETURNING %s", d.doctorServicesSelectQueryPrefix())).ToSql()
@@ -74,8 +80,11 @@ func (d Ds) CreateDoctorServices(ctx context.Context, in *entity.DoctorServices)
 		&in.SpecializationId,
 		&in.OnlinePrice,
 		&in.OfflinePrice,
+		&in.Currency,
 		&in.Name,
-		&in.Duration,
+		&in.DurationMinutes,
+		&in.PrepInstructions,
+		&in.RequiredResourceType,
 		&in.CreatedAt,
 		&updatedAt,
 		&deletedAt,
@@ -117,8 +126,11 @@ func (d Ds) GetDoctorServiceByID(ctx context.Context, in *entity.GetReqStr) (*en
 		&doctorService.SpecializationId,
 		&doctorService.OnlinePrice,
 		&doctorService.OfflinePrice,
+		&doctorService.Currency,
 		&doctorService.Name,
-		&doctorService.Duration,
+		&doctorService.DurationMinutes,
+		&doctorService.PrepInstructions,
+		&doctorService.RequiredResourceType,
 		&doctorService.CreatedAt,
 		&updatedAt,
 		&deletedAt,
@@ -155,6 +167,10 @@ func (d Ds) GetAllDoctorServices(ctx context.Context, all *entity.GetAll) (*enti
 		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
 		countBuilder = countBuilder.Where("deleted_at IS NULL")
 	}
+	if all.BranchId != "" {
+		queryBuilder = queryBuilder.Where(doctorWorksAtBranch(d.tableName+".doctor_id"), all.BranchId)
+		countBuilder = countBuilder.Where(doctorWorksAtBranch(d.tableName+".doctor_id"), all.BranchId)
+	}
 	queryBuilder = queryBuilder.Limit(uint64(all.Limit)).Offset(uint64(offset))
 	query, args, err := queryBuilder.ToSql()
 	if err != nil {
@@ -175,8 +191,11 @@ func (d Ds) GetAllDoctorServices(ctx context.Context, all *entity.GetAll) (*enti
 			&dService.SpecializationId,
 			&dService.OnlinePrice,
 			&dService.OfflinePrice,
+			&dService.Currency,
 			&dService.Name,
-			&dService.Duration,
+			&dService.DurationMinutes,
+			&dService.PrepInstructions,
+			&dService.RequiredResourceType,
 			&dService.CreatedAt,
 			&updatedAt,
 			&deletedAt,
@@ -193,8 +212,11 @@ func (d Ds) GetAllDoctorServices(ctx context.Context, all *entity.GetAll) (*enti
 		doctorServices.DoctorServices = append(doctorServices.DoctorServices, dService)
 	}
 	var count int64
-	queryCount, _, err := countBuilder.ToSql()
-	err = d.db.QueryRow(ctx, queryCount).Scan(&count)
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, d.db.ErrSQLBuild(err, d.tableName+" count")
+	}
+	err = d.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
 	if err != nil {
 		return nil, d.db.Error(err)
 	}
@@ -209,13 +231,16 @@ func (d Ds) UpdateDoctorServices(ctx context.Context, services *entity.DoctorSer
 	defer span.End()
 
 	data := map[string]any{
-		"doctor_id":         services.DoctorId,
-		"specialization_id": services.SpecializationId,
-		"online_price":      services.OnlinePrice,
-		"offline_price":     services.OfflinePrice,
-		"name":              services.Name,
-		"duration":          services.Duration,
-		"updated_at":        time.Now().Add(time.Hour * 5),
+		"doctor_id":              services.DoctorId,
+		"specialization_id":      services.SpecializationId,
+		"online_price":           services.OnlinePrice,
+		"offline_price":          services.OfflinePrice,
+		"currency":               services.Currency,
+		"name":                   services.Name,
+		"duration_minutes":       services.DurationMinutes,
+		"prep_instructions":      services.PrepInstructions,
+		"required_resource_type": services.RequiredResourceType,
+		"updated_at":             time.Now().Add(time.Hour * 5),
 	}
 	query, args, err := d.db.Sq.Builder.Update(d.tableName).
 		SetMap(data).Where(d.db.Sq.Equal("id", services.Id)).
@@ -232,8 +257,11 @@ func (d Ds) UpdateDoctorServices(ctx context.Context, services *entity.DoctorSer
 		&services.SpecializationId,
 		&services.OnlinePrice,
 		&services.OfflinePrice,
+		&services.Currency,
 		&services.Name,
-		&services.Duration,
+		&services.DurationMinutes,
+		&services.PrepInstructions,
+		&services.RequiredResourceType,
 		&services.CreatedAt,
 		&updatedAt,
 		&deletedAt,
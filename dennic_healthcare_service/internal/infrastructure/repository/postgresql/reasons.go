@@ -127,6 +127,11 @@ func (r *Reasons) GetAllReasons(ctx context.Context, reas *entity.GetAllReas) (*
 		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
 		countBuilder = countBuilder.Where("deleted_at IS NULL")
 	}
+	if reas.BranchId != "" {
+		branchFilter := fmt.Sprintf("specialization_id IN (SELECT s.id FROM specializations s WHERE %s)", departmentInBranch("s.department_id"))
+		queryBuilder = queryBuilder.Where(branchFilter, reas.BranchId)
+		countBuilder = countBuilder.Where(branchFilter, reas.BranchId)
+	}
 	if reas.OrderBy != "" {
 		queryBuilder = queryBuilder.OrderBy(reas.OrderBy)
 	}
@@ -168,8 +173,11 @@ func (r *Reasons) GetAllReasons(ctx context.Context, reas *entity.GetAllReas) (*
 		reasList.Reasons = append(reasList.Reasons, reasons)
 	}
 	var count int32
-	queryCount, _, err := countBuilder.ToSql()
-	err = r.db.QueryRow(ctx, queryCount).Scan(&count)
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, r.tableName+" count")
+	}
+	err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
 	if err != nil {
 		return nil, r.db.Error(err)
 	}
@@ -0,0 +1,255 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"time"
+)
+
+const (
+	resourceTableName             = "resources"
+	serviceNameResource           = "resource"
+	serviceNameResourceRepoPrefix = "resource"
+)
+
+type Resource struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewResourceRepo(db *postgres.PostgresDB) *Resource {
+	return &Resource{
+		tableName: resourceTableName,
+		db:        db,
+	}
+}
+
+func (p *Resource) resourceSelectQueryPrefix() string {
+	return `id,
+			resource_order,
+			branch_id,
+			room_id,
+			resource_type,
+			name,
+			created_at,
+			updated_at,
+			deleted_at`
+}
+
+func (p *Resource) scanResource(row rowScanner) (*entity.Resource, error) {
+	var (
+		resource             entity.Resource
+		roomId               sql.NullString
+		updatedAt, deletedAt sql.NullTime
+	)
+	err := row.Scan(
+		&resource.Id,
+		&resource.Order,
+		&resource.BranchId,
+		&roomId,
+		&resource.ResourceType,
+		&resource.Name,
+		&resource.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if roomId.Valid {
+		resource.RoomId = roomId.String
+	}
+	if updatedAt.Valid {
+		resource.UpdatedAt = updatedAt.Time
+	}
+	if deletedAt.Valid {
+		resource.DeletedAt = deletedAt.Time
+	}
+	return &resource, nil
+}
+
+func (p *Resource) CreateResource(ctx context.Context, resource *entity.Resource) (*entity.Resource, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameResource, serviceNameResourceRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateResource").String(resource.Id))
+	defer span.End()
+
+	data := map[string]any{
+		"id":            resource.Id,
+		"branch_id":     resource.BranchId,
+		"room_id":       nullString(resource.RoomId),
+		"resource_type": resource.ResourceType,
+		"name":          resource.Name,
+	}
+	query, args, err := p.db.Sq.Builder.
+		Insert(p.tableName).
+		SetMap(data).
+		Suffix(fmt.Sprintf("RETURNING %s", p.resourceSelectQueryPrefix())).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, " create"))
+	}
+
+	resp, err := p.scanResource(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	return resp, nil
+}
+
+func (p *Resource) GetResourceById(ctx context.Context, get *entity.GetReqStr) (*entity.Resource, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameResource, serviceNameResourceRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key(get.Field).String(get.Value))
+	defer span.End()
+
+	queryBuilder := p.db.Sq.Builder.Select(p.resourceSelectQueryPrefix()).From(p.tableName)
+	if !get.IsActive {
+		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
+	}
+	queryBuilder = queryBuilder.Where(p.db.Sq.Equal(get.Field, get.Value))
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" get")
+	}
+
+	resource, err := p.scanResource(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	return resource, nil
+}
+
+func (p *Resource) GetAllResources(ctx context.Context, all *entity.GetAllResources) (*entity.ListResources, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameResource, serviceNameResourceRepoPrefix+"Get All")
+	span.SetAttributes(attribute.Key(all.Field).String(all.Value))
+	defer span.End()
+
+	offset := all.Limit * (all.Page - 1)
+
+	queryBuilder := p.db.Sq.Builder.Select(p.resourceSelectQueryPrefix()).From(p.tableName)
+	countBuilder := p.db.Sq.Builder.Select("count(*)").From(p.tableName)
+	if all.Field != "" {
+		queryBuilder = queryBuilder.Where(fmt.Sprintf(`%s ILIKE '%s'`, all.Field, all.Value+"%"))
+		countBuilder = countBuilder.Where(fmt.Sprintf(`%s ILIKE '%s'`, all.Field, all.Value+"%"))
+	}
+	if all.BranchId != "" {
+		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("branch_id", all.BranchId))
+		countBuilder = countBuilder.Where(p.db.Sq.Equal("branch_id", all.BranchId))
+	}
+	if all.RoomId != "" {
+		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("room_id", all.RoomId))
+		countBuilder = countBuilder.Where(p.db.Sq.Equal("room_id", all.RoomId))
+	}
+	if all.ResourceType != "" {
+		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("resource_type", all.ResourceType))
+		countBuilder = countBuilder.Where(p.db.Sq.Equal("resource_type", all.ResourceType))
+	}
+	if all.OrderBy != "" {
+		queryBuilder = queryBuilder.OrderBy(all.OrderBy)
+	}
+	if !all.IsActive {
+		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
+		countBuilder = countBuilder.Where("deleted_at IS NULL")
+	}
+	queryBuilder = queryBuilder.Limit(uint64(all.Limit)).Offset(uint64(offset))
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" list")
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var resources entity.ListResources
+	for rows.Next() {
+		resource, err := p.scanResource(rows)
+		if err != nil {
+			return nil, err
+		}
+		resources.Resources = append(resources.Resources, *resource)
+	}
+
+	var count int64
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" count")
+	}
+	err = p.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	resources.Count = count
+	return &resources, nil
+}
+
+func (p *Resource) UpdateResource(ctx context.Context, up *entity.Resource) (*entity.Resource, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameResource, serviceNameResourceRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateResource").String(up.Id))
+	defer span.End()
+
+	data := map[string]any{
+		"branch_id":     up.BranchId,
+		"room_id":       nullString(up.RoomId),
+		"resource_type": up.ResourceType,
+		"name":          up.Name,
+		"updated_at":    time.Now().Add(time.Hour * 5),
+	}
+	query, args, err := p.db.Sq.Builder.Update(p.tableName).SetMap(data).
+		Where(p.db.Sq.And(p.db.Sq.Equal("id", up.Id), p.db.Sq.Equal("deleted_at", nil))).
+		Suffix(fmt.Sprintf("RETURNING %s", p.resourceSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" update")
+	}
+
+	resp, err := p.scanResource(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	return resp, nil
+}
+
+func (p *Resource) DeleteResource(ctx context.Context, get *entity.GetReqStr) (bool, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameResource, serviceNameResourceRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteResource").String(get.Value))
+	defer span.End()
+
+	data := map[string]any{
+		"deleted_at": time.Now().Add(time.Hour * 5),
+	}
+	var args []interface{}
+	var query string
+	var err error
+	if get.IsActive {
+		query, args, err = p.db.Sq.Builder.Delete(p.tableName).From(p.tableName).
+			Where(p.db.Sq.Equal(get.Field, get.Value)).ToSql()
+		if err != nil {
+			return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
+		}
+	} else {
+		query, args, err = p.db.Sq.Builder.Update(p.tableName).SetMap(data).
+			Where(p.db.Sq.And(p.db.Sq.Equal(get.Field, get.Value), p.db.Sq.Equal("deleted_at", nil))).ToSql()
+		if err != nil {
+			return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
+		}
+	}
+	resp, err := p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, p.db.Error(err)
+	}
+	if resp.RowsAffected() > 0 {
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,341 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	reviewTableName             = "doctor_reviews"
+	serviceNameReview           = "review"
+	serviceNameReviewRepoPrefix = "review"
+)
+
+type Review struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewReviewRepo(db *postgres.PostgresDB) *Review {
+	return &Review{
+		tableName: reviewTableName,
+		db:        db,
+	}
+}
+
+func (p *Review) reviewSelectQueryPrefix() string {
+	return `id,
+			doctor_id,
+			patient_id,
+			appointment_id,
+			rating,
+			comment,
+			status,
+			moderation_note,
+			reply,
+			replied_at,
+			created_at,
+			updated_at,
+			deleted_at`
+}
+
+func (p *Review) scanReview(row rowScanner) (*entity.Review, error) {
+	var (
+		review                          entity.Review
+		repliedAt, updatedAt, deletedAt sql.NullTime
+	)
+	err := row.Scan(
+		&review.Id,
+		&review.DoctorId,
+		&review.PatientId,
+		&review.AppointmentId,
+		&review.Rating,
+		&review.Comment,
+		&review.Status,
+		&review.ModerationNote,
+		&review.Reply,
+		&repliedAt,
+		&review.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if repliedAt.Valid {
+		review.RepliedAt = repliedAt.Time
+	}
+	if updatedAt.Valid {
+		review.UpdatedAt = updatedAt.Time
+	}
+	if deletedAt.Valid {
+		review.DeletedAt = deletedAt.Time
+	}
+	return &review, nil
+}
+
+func (p *Review) CreateReview(ctx context.Context, review *entity.Review) (*entity.Review, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameReview, serviceNameReviewRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateReview").String(review.Id))
+	defer span.End()
+
+	data := map[string]any{
+		"id":             review.Id,
+		"doctor_id":      review.DoctorId,
+		"patient_id":     review.PatientId,
+		"appointment_id": review.AppointmentId,
+		"rating":         review.Rating,
+		"comment":        review.Comment,
+		"status":         review.Status,
+	}
+	query, args, err := p.db.Sq.Builder.
+		Insert(p.tableName).
+		SetMap(data).
+		Suffix(fmt.Sprintf("RETURNING %s", p.reviewSelectQueryPrefix())).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
+	}
+
+	resp, err := p.scanReview(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	return resp, nil
+}
+
+func (p *Review) GetReviewById(ctx context.Context, get *entity.GetReqStr) (*entity.Review, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameReview, serviceNameReviewRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key(get.Field).String(get.Value))
+	defer span.End()
+
+	queryBuilder := p.db.Sq.Builder.Select(p.reviewSelectQueryPrefix()).From(p.tableName)
+	if !get.IsActive {
+		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
+	}
+	queryBuilder = queryBuilder.Where(p.db.Sq.Equal(get.Field, get.Value))
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" get")
+	}
+
+	review, err := p.scanReview(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	return review, nil
+}
+
+func (p *Review) GetAllReviews(ctx context.Context, all *entity.GetAllReviews) (*entity.ListReviews, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameReview, serviceNameReviewRepoPrefix+"Get All")
+	span.SetAttributes(attribute.Key("doctor_id").String(all.DoctorId))
+	defer span.End()
+
+	offset := all.Limit * (all.Page - 1)
+
+	queryBuilder := p.db.Sq.Builder.Select(p.reviewSelectQueryPrefix()).From(p.tableName)
+	countBuilder := p.db.Sq.Builder.Select("count(*)").From(p.tableName)
+	if all.DoctorId != "" {
+		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("doctor_id", all.DoctorId))
+		countBuilder = countBuilder.Where(p.db.Sq.Equal("doctor_id", all.DoctorId))
+	}
+	if all.PatientId != "" {
+		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("patient_id", all.PatientId))
+		countBuilder = countBuilder.Where(p.db.Sq.Equal("patient_id", all.PatientId))
+	}
+	if all.Status != "" {
+		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("status", all.Status))
+		countBuilder = countBuilder.Where(p.db.Sq.Equal("status", all.Status))
+	}
+	if all.OrderBy != "" {
+		queryBuilder = queryBuilder.OrderBy(all.OrderBy)
+	} else {
+		queryBuilder = queryBuilder.OrderBy("created_at DESC")
+	}
+	if !all.IsActive {
+		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
+		countBuilder = countBuilder.Where("deleted_at IS NULL")
+	}
+	queryBuilder = queryBuilder.Limit(uint64(all.Limit)).Offset(uint64(offset))
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" list")
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var reviews entity.ListReviews
+	for rows.Next() {
+		review, err := p.scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews.Reviews = append(reviews.Reviews, *review)
+	}
+
+	var count int64
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" count")
+	}
+	err = p.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	reviews.Count = count
+	return &reviews, nil
+}
+
+// ModerateReview changes the moderation status and refreshes the doctor's
+// rating in the same transaction, so the aggregate never disagrees with the
+// approved reviews.
+func (p *Review) ModerateReview(ctx context.Context, in *entity.ModerateReview) (*entity.Review, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameReview, serviceNameReviewRepoPrefix+"Moderate")
+	span.SetAttributes(attribute.Key("ModerateReview").String(in.Id))
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	data := map[string]any{
+		"status":          in.Status,
+		"moderation_note": in.ModerationNote,
+		"updated_at":      time.Now().Add(time.Hour * 5),
+	}
+	query, args, err := p.db.Sq.Builder.Update(p.tableName).SetMap(data).
+		Where(p.db.Sq.And(p.db.Sq.Equal("id", in.Id), p.db.Sq.Equal("deleted_at", nil))).
+		Suffix(fmt.Sprintf("RETURNING %s", p.reviewSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" moderate")
+	}
+
+	resp, err := p.scanReview(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	if err = refreshDoctorRating(ctx, tx, resp.DoctorId); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
+	return resp, nil
+}
+
+func (p *Review) ReplyToReview(ctx context.Context, in *entity.ReplyReview) (*entity.Review, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameReview, serviceNameReviewRepoPrefix+"Reply")
+	span.SetAttributes(attribute.Key("ReplyToReview").String(in.Id))
+	defer span.End()
+
+	now := time.Now().Add(time.Hour * 5)
+	data := map[string]any{
+		"reply":      in.Reply,
+		"replied_at": now,
+		"updated_at": now,
+	}
+	query, args, err := p.db.Sq.Builder.Update(p.tableName).SetMap(data).
+		Where(p.db.Sq.EqualMany(map[string]any{
+			"id":         in.Id,
+			"doctor_id":  in.DoctorId,
+			"deleted_at": nil,
+		})).
+		Suffix(fmt.Sprintf("RETURNING %s", p.reviewSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" reply")
+	}
+
+	resp, err := p.scanReview(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	return resp, nil
+}
+
+func (p *Review) DeleteReview(ctx context.Context, get *entity.GetReqStr) (bool, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameReview, serviceNameReviewRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteReview").String(get.Value))
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return false, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	var query string
+	var args []interface{}
+	if get.IsActive {
+		query, args, err = p.db.Sq.Builder.Delete(p.tableName).
+			Where(p.db.Sq.Equal(get.Field, get.Value)).
+			Suffix("RETURNING doctor_id").ToSql()
+	} else {
+		query, args, err = p.db.Sq.Builder.Update(p.tableName).
+			Set("deleted_at", time.Now().Add(time.Hour*5)).
+			Where(p.db.Sq.And(p.db.Sq.Equal(get.Field, get.Value), p.db.Sq.Equal("deleted_at", nil))).
+			Suffix("RETURNING doctor_id").ToSql()
+	}
+	if err != nil {
+		return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return false, p.db.Error(err)
+	}
+	var doctorIds []string
+	for rows.Next() {
+		var doctorId string
+		if err = rows.Scan(&doctorId); err != nil {
+			rows.Close()
+			return false, err
+		}
+		doctorIds = append(doctorIds, doctorId)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return false, p.db.Error(err)
+	}
+
+	for _, doctorId := range doctorIds {
+		if err = refreshDoctorRating(ctx, tx, doctorId); err != nil {
+			return false, p.db.Error(err)
+		}
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return false, p.db.Error(err)
+	}
+	return len(doctorIds) > 0, nil
+}
+
+// refreshDoctorRating recomputes the rating and review count stored on the
+// doctor from the approved reviews.
+func refreshDoctorRating(ctx context.Context, tx pgx.Tx, doctorId string) error {
+	_, err := tx.Exec(ctx, `UPDATE doctors d
+		SET rating = r.rating, review_count = r.review_count
+		FROM (SELECT COALESCE(AVG(dr.rating), 0)::real AS rating, count(*) AS review_count
+			FROM doctor_reviews dr
+			WHERE dr.doctor_id = $1 AND dr.status = 'approved' AND dr.deleted_at IS NULL) r
+		WHERE d.id = $1`, doctorId)
+	return err
+}
@@ -0,0 +1,260 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"time"
+)
+
+const (
+	roomTableName             = "rooms"
+	serviceNameRoom           = "room"
+	serviceNameRoomRepoPrefix = "room"
+)
+
+type Room struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewRoomRepo(db *postgres.PostgresDB) *Room {
+	return &Room{
+		tableName: roomTableName,
+		db:        db,
+	}
+}
+
+func (p *Room) roomSelectQueryPrefix() string {
+	return `id,
+			room_order,
+			branch_id,
+			department_id,
+			room_number,
+			floor_number,
+			name,
+			created_at,
+			updated_at,
+			deleted_at`
+}
+
+func (p *Room) scanRoom(row rowScanner) (*entity.Room, error) {
+	var (
+		room                 entity.Room
+		departmentId         sql.NullString
+		updatedAt, deletedAt sql.NullTime
+	)
+	err := row.Scan(
+		&room.Id,
+		&room.Order,
+		&room.BranchId,
+		&departmentId,
+		&room.RoomNumber,
+		&room.FloorNumber,
+		&room.Name,
+		&room.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if departmentId.Valid {
+		room.DepartmentId = departmentId.String
+	}
+	if updatedAt.Valid {
+		room.UpdatedAt = updatedAt.Time
+	}
+	if deletedAt.Valid {
+		room.DeletedAt = deletedAt.Time
+	}
+	return &room, nil
+}
+
+func (p *Room) CreateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameRoom, serviceNameRoomRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateRoom").String(room.Id))
+	defer span.End()
+
+	data := map[string]any{
+		"id":            room.Id,
+		"branch_id":     room.BranchId,
+		"department_id": nullString(room.DepartmentId),
+		"room_number":   room.RoomNumber,
+		"floor_number":  room.FloorNumber,
+		"name":          room.Name,
+	}
+	query, args, err := p.db.Sq.Builder.
+		Insert(p.tableName).
+		SetMap(data).
+		Suffix(fmt.Sprintf("RETURNING %s", p.roomSelectQueryPrefix())).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, " create"))
+	}
+
+	resp, err := p.scanRoom(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	return resp, nil
+}
+
+func (p *Room) GetRoomById(ctx context.Context, get *entity.GetReqStr) (*entity.Room, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameRoom, serviceNameRoomRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key(get.Field).String(get.Value))
+	defer span.End()
+
+	queryBuilder := p.db.Sq.Builder.Select(p.roomSelectQueryPrefix()).From(p.tableName)
+	if !get.IsActive {
+		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
+	}
+	queryBuilder = queryBuilder.Where(p.db.Sq.Equal(get.Field, get.Value))
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" get")
+	}
+
+	room, err := p.scanRoom(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	return room, nil
+}
+
+func (p *Room) GetAllRooms(ctx context.Context, all *entity.GetAllRooms) (*entity.ListRooms, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameRoom, serviceNameRoomRepoPrefix+"Get All")
+	span.SetAttributes(attribute.Key(all.Field).String(all.Value))
+	defer span.End()
+
+	offset := all.Limit * (all.Page - 1)
+
+	queryBuilder := p.db.Sq.Builder.Select(p.roomSelectQueryPrefix()).From(p.tableName)
+	countBuilder := p.db.Sq.Builder.Select("count(*)").From(p.tableName)
+	if all.Field != "" {
+		queryBuilder = queryBuilder.Where(fmt.Sprintf(`%s ILIKE '%s'`, all.Field, all.Value+"%"))
+		countBuilder = countBuilder.Where(fmt.Sprintf(`%s ILIKE '%s'`, all.Field, all.Value+"%"))
+	}
+	if all.BranchId != "" {
+		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("branch_id", all.BranchId))
+		countBuilder = countBuilder.Where(p.db.Sq.Equal("branch_id", all.BranchId))
+	}
+	if all.DepartmentId != "" {
+		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("department_id", all.DepartmentId))
+		countBuilder = countBuilder.Where(p.db.Sq.Equal("department_id", all.DepartmentId))
+	}
+	if all.OrderBy != "" {
+		queryBuilder = queryBuilder.OrderBy(all.OrderBy)
+	}
+	if !all.IsActive {
+		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
+		countBuilder = countBuilder.Where("deleted_at IS NULL")
+	}
+	queryBuilder = queryBuilder.Limit(uint64(all.Limit)).Offset(uint64(offset))
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" list")
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var rooms entity.ListRooms
+	for rows.Next() {
+		room, err := p.scanRoom(rows)
+		if err != nil {
+			return nil, err
+		}
+		rooms.Rooms = append(rooms.Rooms, *room)
+	}
+
+	var count int64
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" count")
+	}
+	err = p.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	rooms.Count = count
+	return &rooms, nil
+}
+
+func (p *Room) UpdateRoom(ctx context.Context, up *entity.Room) (*entity.Room, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameRoom, serviceNameRoomRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateRoom").String(up.Id))
+	defer span.End()
+
+	data := map[string]any{
+		"branch_id":     up.BranchId,
+		"department_id": nullString(up.DepartmentId),
+		"room_number":   up.RoomNumber,
+		"floor_number":  up.FloorNumber,
+		"name":          up.Name,
+		"updated_at":    time.Now().Add(time.Hour * 5),
+	}
+	query, args, err := p.db.Sq.Builder.Update(p.tableName).SetMap(data).
+		Where(p.db.Sq.And(p.db.Sq.Equal("id", up.Id), p.db.Sq.Equal("deleted_at", nil))).
+		Suffix(fmt.Sprintf("RETURNING %s", p.roomSelectQueryPrefix())).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" update")
+	}
+
+	resp, err := p.scanRoom(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	return resp, nil
+}
+
+func (p *Room) DeleteRoom(ctx context.Context, get *entity.GetReqStr) (bool, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameRoom, serviceNameRoomRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteRoom").String(get.Value))
+	defer span.End()
+
+	data := map[string]any{
+		"deleted_at": time.Now().Add(time.Hour * 5),
+	}
+	var args []interface{}
+	var query string
+	var err error
+	if get.IsActive {
+		query, args, err = p.db.Sq.Builder.Delete(p.tableName).From(p.tableName).
+			Where(p.db.Sq.Equal(get.Field, get.Value)).ToSql()
+		if err != nil {
+			return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
+		}
+	} else {
+		query, args, err = p.db.Sq.Builder.Update(p.tableName).SetMap(data).
+			Where(p.db.Sq.And(p.db.Sq.Equal(get.Field, get.Value), p.db.Sq.Equal("deleted_at", nil))).ToSql()
+		if err != nil {
+			return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
+		}
+	}
+	resp, err := p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, p.db.Error(err)
+	}
+	if resp.RowsAffected() > 0 {
+		return true, nil
+	}
+	return false, nil
+}
+
+// nullString stores empty optional references as NULL so foreign keys stay valid.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,242 @@
+package postgresql
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"time"
+)
+
+const (
+	servicePriceTableName             = "doctor_service_prices"
+	serviceNameServicePrice           = "service_price"
+	serviceNameServicePriceRepoPrefix = "service_price"
+)
+
+type ServicePrice struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewServicePricesRepo(db *postgres.PostgresDB) *ServicePrice {
+	return &ServicePrice{
+		tableName: servicePriceTableName,
+		db:        db,
+	}
+}
+
+func (p *ServicePrice) servicePriceSelectQueryPrefix() string {
+	return `id,
+			doctor_service_id,
+			modality,
+			amount::text,
+			currency,
+			effective_from,
+			effective_to,
+			is_promo,
+			promo_name,
+			created_at,
+			deleted_at`
+}
+
+func (p *ServicePrice) scanServicePrice(row rowScanner) (*entity.ServicePrice, error) {
+	var (
+		price                  entity.ServicePrice
+		effectiveTo, deletedAt sql.NullTime
+	)
+	err := row.Scan(
+		&price.Id,
+		&price.DoctorServiceId,
+		&price.Modality,
+		&price.Amount,
+		&price.Currency,
+		&price.EffectiveFrom,
+		&effectiveTo,
+		&price.IsPromo,
+		&price.PromoName,
+		&price.CreatedAt,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if effectiveTo.Valid {
+		price.EffectiveTo = effectiveTo.Time
+	}
+	if deletedAt.Valid {
+		price.DeletedAt = deletedAt.Time
+	}
+	return &price, nil
+}
+
+// CreateServicePrice adds a price list entry. A regular (non-promo) price ends
+// the regular price that was open at its start, so at most one regular price
+// is effective at any moment; promos simply overlay it for their period.
+func (p *ServicePrice) CreateServicePrice(ctx context.Context, in *entity.ServicePrice) (*entity.ServicePrice, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameServicePrice, serviceNameServicePriceRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateServicePrice").String(in.Id))
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if !in.IsPromo {
+		query, args, err := p.db.Sq.Builder.Update(p.tableName).
+			Set("effective_to", in.EffectiveFrom).
+			Where(p.db.Sq.EqualMany(map[string]any{
+				"doctor_service_id": in.DoctorServiceId,
+				"modality":          in.Modality,
+				"is_promo":          false,
+				"deleted_at":        nil,
+			})).
+			Where("effective_from < ?", in.EffectiveFrom).
+			Where("(effective_to IS NULL OR effective_to > ?)", in.EffectiveFrom).
+			ToSql()
+		if err != nil {
+			return nil, p.db.ErrSQLBuild(err, p.tableName+" close previous")
+		}
+		if _, err = tx.Exec(ctx, query, args...); err != nil {
+			return nil, p.db.Error(err)
+		}
+	}
+
+	data := map[string]any{
+		"id":                in.Id,
+		"doctor_service_id": in.DoctorServiceId,
+		"modality":          in.Modality,
+		"amount":            in.Amount,
+		"currency":          in.Currency,
+		"effective_from":    in.EffectiveFrom,
+		"effective_to":      nullTime(in.EffectiveTo),
+		"is_promo":          in.IsPromo,
+		"promo_name":        in.PromoName,
+	}
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).
+		SetMap(data).
+		Suffix(fmt.Sprintf("RETURNING %s", p.servicePriceSelectQueryPrefix())).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
+	}
+
+	resp, err := p.scanServicePrice(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
+	return resp, nil
+}
+
+func (p *ServicePrice) GetServicePriceHistory(ctx context.Context, in *entity.GetServicePrices) (*entity.ListServicePrices, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameServicePrice, serviceNameServicePriceRepoPrefix+"History")
+	span.SetAttributes(attribute.Key("doctor_service_id").String(in.DoctorServiceId))
+	defer span.End()
+
+	offset := in.Limit * (in.Page - 1)
+
+	queryBuilder := p.db.Sq.Builder.Select(p.servicePriceSelectQueryPrefix()).From(p.tableName).
+		Where(p.db.Sq.Equal("doctor_service_id", in.DoctorServiceId))
+	countBuilder := p.db.Sq.Builder.Select("count(*)").From(p.tableName).
+		Where(p.db.Sq.Equal("doctor_service_id", in.DoctorServiceId))
+	if in.Modality != "" {
+		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("modality", in.Modality))
+		countBuilder = countBuilder.Where(p.db.Sq.Equal("modality", in.Modality))
+	}
+	queryBuilder = queryBuilder.OrderBy("effective_from DESC", "created_at DESC").
+		Limit(uint64(in.Limit)).Offset(uint64(offset))
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" history")
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var prices entity.ListServicePrices
+	for rows.Next() {
+		price, err := p.scanServicePrice(rows)
+		if err != nil {
+			return nil, err
+		}
+		prices.Prices = append(prices.Prices, *price)
+	}
+
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" count")
+	}
+	if err = p.db.QueryRow(ctx, queryCount, countArgs...).Scan(&prices.Count); err != nil {
+		return nil, p.db.Error(err)
+	}
+	return &prices, nil
+}
+
+// GetEffectivePrice returns the entry in force at the given moment, preferring
+// a running promo over the regular price.
+func (p *ServicePrice) GetEffectivePrice(ctx context.Context, in *entity.GetEffectivePrice) (*entity.ServicePrice, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameServicePrice, serviceNameServicePriceRepoPrefix+"Effective")
+	span.SetAttributes(attribute.Key("doctor_service_id").String(in.DoctorServiceId))
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.Select(p.servicePriceSelectQueryPrefix()).From(p.tableName).
+		Where(p.db.Sq.EqualMany(map[string]any{
+			"doctor_service_id": in.DoctorServiceId,
+			"modality":          in.Modality,
+			"deleted_at":        nil,
+		})).
+		Where("effective_from <= ?", in.At).
+		Where("(effective_to IS NULL OR effective_to > ?)", in.At).
+		OrderBy("is_promo DESC", "effective_from DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" effective")
+	}
+
+	price, err := p.scanServicePrice(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	return price, nil
+}
+
+func (p *ServicePrice) DeleteServicePrice(ctx context.Context, in *entity.GetReqStr) (bool, error) {
+
+	ctx, span := otlp.Start(ctx, serviceNameServicePrice, serviceNameServicePriceRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key(in.Field).String(in.Value))
+	defer span.End()
+
+	// price entries are kept for the history, so delete is always soft
+	query, args, err := p.db.Sq.Builder.Update(p.tableName).
+		Set("deleted_at", time.Now().Add(time.Hour*5)).
+		Where(p.db.Sq.And(p.db.Sq.Equal(in.Field, in.Value), p.db.Sq.Equal("deleted_at", nil))).
+		ToSql()
+	if err != nil {
+		return false, p.db.ErrSQLBuild(err, p.tableName+" delete")
+	}
+	resp, err := p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, p.db.Error(err)
+	}
+	return resp.RowsAffected() > 0, nil
+}
+
+// nullTime stores a zero time as NULL, e.g. an open-ended price period.
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
@@ -142,6 +142,10 @@ func (p *Specialization) GetAllSpecializations(ctx context.Context, all *entity.
 		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("department_id", all.DepartmentId))
 		countBuilder = countBuilder.Where(fmt.Sprintf("department_id = '%s'", all.DepartmentId))
 	}
+	if all.BranchId != "" {
+		queryBuilder = queryBuilder.Where(departmentInBranch("department_id"), all.BranchId)
+		countBuilder = countBuilder.Where(departmentInBranch("department_id"), all.BranchId)
+	}
 
 	if all.Field != "" {
 		queryBuilder = queryBuilder.Where(fmt.Sprintf(`%s ILIKE '%s'`, all.Field, all.Value+"%"))
@@ -189,9 +193,11 @@ func (p *Specialization) GetAllSpecializations(ctx context.Context, all *entity.
 		Specializations.Specializations = append(Specializations.Specializations, spec)
 	}
 	var count int32
-	queryCount, _, err := countBuilder.ToSql()
-	fmt.Println(queryCount)
-	err = p.db.QueryRow(ctx, queryCount).Scan(&count)
+	queryCount, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" count")
+	}
+	err = p.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+)
+
+type ResourceRepository interface {
+	CreateResource(ctx context.Context, resource *entity.Resource) (*entity.Resource, error)
+	GetResourceById(ctx context.Context, get *entity.GetReqStr) (*entity.Resource, error)
+	GetAllResources(ctx context.Context, all *entity.GetAllResources) (*entity.ListResources, error)
+	UpdateResource(ctx context.Context, resource *entity.Resource) (*entity.Resource, error)
+	DeleteResource(ctx context.Context, get *entity.GetReqStr) (bool, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+)
+
+type ReviewRepository interface {
+	CreateReview(ctx context.Context, review *entity.Review) (*entity.Review, error)
+	GetReviewById(ctx context.Context, get *entity.GetReqStr) (*entity.Review, error)
+	GetAllReviews(ctx context.Context, all *entity.GetAllReviews) (*entity.ListReviews, error)
+	ModerateReview(ctx context.Context, in *entity.ModerateReview) (*entity.Review, error)
+	ReplyToReview(ctx context.Context, in *entity.ReplyReview) (*entity.Review, error)
+	DeleteReview(ctx context.Context, get *entity.GetReqStr) (bool, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+)
+
+type RoomRepository interface {
+	CreateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error)
+	GetRoomById(ctx context.Context, get *entity.GetReqStr) (*entity.Room, error)
+	GetAllRooms(ctx context.Context, all *entity.GetAllRooms) (*entity.ListRooms, error)
+	UpdateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error)
+	DeleteRoom(ctx context.Context, get *entity.GetReqStr) (bool, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"context"
+)
+
+type ServicePrices interface {
+	CreateServicePrice(ctx context.Context, in *entity.ServicePrice) (*entity.ServicePrice, error)
+	GetServicePriceHistory(ctx context.Context, in *entity.GetServicePrices) (*entity.ListServicePrices, error)
+	GetEffectivePrice(ctx context.Context, in *entity.GetEffectivePrice) (*entity.ServicePrice, error)
+	DeleteServicePrice(ctx context.Context, in *entity.GetReqStr) (bool, error)
+}
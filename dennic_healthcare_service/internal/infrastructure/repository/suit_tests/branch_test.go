@@ -0,0 +1,166 @@
+package suit_tests
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	repo "Healthcare_Evrone/internal/infrastructure/repository/postgresql"
+	"Healthcare_Evrone/internal/pkg/config"
+	db "Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"log"
+	"testing"
+	"time"
+)
+
+type BranchTestSuite struct {
+	suite.Suite
+	CleanUpFunc func()
+	Repository  *repo.Branch
+	Rooms       *repo.Room
+	Resources   *repo.Resource
+}
+
+func (s *BranchTestSuite) SetupTest() {
+	pgPool, err := db.New(config.New())
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	s.Repository = repo.NewBranchRepo(pgPool)
+	s.Rooms = repo.NewRoomRepo(pgPool)
+	s.Resources = repo.NewResourceRepo(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *BranchTestSuite) TestBranchCrud() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	branch := &entity.Branch{
+		Id:          uuid.NewString(),
+		Name:        "Test branch",
+		Address:     "Test address",
+		Latitude:    41.311081,
+		Longitude:   69.240562,
+		Timezone:    "Asia/Tashkent",
+		PhoneNumber: "+998901234567",
+		OpeningHours: []entity.BranchOpeningHours{
+			{DayOfWeek: "Monday", OpenTime: "08:00", CloseTime: "18:00"},
+		},
+	}
+	respBranch, err := s.Repository.CreateBranch(ctx, branch)
+	s.Suite.NoError(err)
+	s.Suite.NotNil(respBranch)
+	s.Suite.Equal(branch.Id, respBranch.Id)
+	s.Suite.Equal(branch.Name, respBranch.Name)
+	s.Suite.Equal(branch.Timezone, respBranch.Timezone)
+	s.Suite.Equal(branch.OpeningHours, respBranch.OpeningHours)
+
+	getBranch, err := s.Repository.GetBranchById(ctx, &entity.GetReqStr{
+		Field: "id",
+		Value: branch.Id,
+	})
+	s.Suite.NoError(err)
+	s.Suite.NotNil(getBranch)
+	s.Suite.Equal(branch.Address, getBranch.Address)
+	s.Suite.Equal(branch.Latitude, getBranch.Latitude)
+
+	respAll, err := s.Repository.GetAllBranches(ctx, &entity.GetAll{
+		Page:  1,
+		Limit: 10,
+	})
+	s.Suite.NoError(err)
+	s.Suite.NotNil(respAll)
+
+	room := &entity.Room{
+		Id:          uuid.NewString(),
+		BranchId:    branch.Id,
+		RoomNumber:  "101",
+		FloorNumber: 1,
+		Name:        "Test room",
+	}
+	respRoom, err := s.Rooms.CreateRoom(ctx, room)
+	s.Suite.NoError(err)
+	s.Suite.NotNil(respRoom)
+	s.Suite.Equal(branch.Id, respRoom.BranchId)
+	s.Suite.Equal(room.RoomNumber, respRoom.RoomNumber)
+
+	branchRooms, err := s.Rooms.GetAllRooms(ctx, &entity.GetAllRooms{
+		Page:     1,
+		Limit:    10,
+		BranchId: branch.Id,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), branchRooms.Count)
+
+	room.Name = "Update room"
+	updatedRoom, err := s.Rooms.UpdateRoom(ctx, room)
+	s.Suite.NoError(err)
+	s.Suite.Equal(room.Name, updatedRoom.Name)
+
+	resource := &entity.Resource{
+		Id:           uuid.NewString(),
+		BranchId:     branch.Id,
+		RoomId:       room.Id,
+		ResourceType: "ultrasound",
+		Name:         "Test ultrasound",
+	}
+	respResource, err := s.Resources.CreateResource(ctx, resource)
+	s.Suite.NoError(err)
+	s.Suite.NotNil(respResource)
+	s.Suite.Equal(room.Id, respResource.RoomId)
+	s.Suite.Equal(resource.ResourceType, respResource.ResourceType)
+
+	branchResources, err := s.Resources.GetAllResources(ctx, &entity.GetAllResources{
+		Page:         1,
+		Limit:        10,
+		BranchId:     branch.Id,
+		ResourceType: resource.ResourceType,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), branchResources.Count)
+
+	resource.Name = "Update ultrasound"
+	updatedResource, err := s.Resources.UpdateResource(ctx, resource)
+	s.Suite.NoError(err)
+	s.Suite.Equal(resource.Name, updatedResource.Name)
+
+	deleteResource, err := s.Resources.DeleteResource(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    resource.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(deleteResource)
+
+	deleteRoom, err := s.Rooms.DeleteRoom(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    room.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(deleteRoom)
+
+	branch.Name = "Update branch"
+	updatedBranch, err := s.Repository.UpdateBranch(ctx, branch)
+	s.Suite.NoError(err)
+	s.Suite.NotNil(updatedBranch)
+	s.Suite.Equal(branch.Name, updatedBranch.Name)
+
+	deleteBranch, err := s.Repository.DeleteBranch(ctx, &entity.GetReqStr{
+		Field:    "id",
+		Value:    branch.Id,
+		IsActive: true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(deleteBranch)
+}
+
+func (s *BranchTestSuite) TearDownTest() {
+	s.CleanUpFunc()
+}
+
+func TestBranchTestSuite(t *testing.T) {
+	suite.Run(t, new(BranchTestSuite))
+}
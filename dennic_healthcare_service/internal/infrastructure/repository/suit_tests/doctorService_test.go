@@ -20,6 +20,7 @@ type DoctorServiceTestSuite struct {
 	RepositoryDoctor         *repo.DocTor
 	RepositorySpecialization *repo.Specialization
 	RepositoryDepartment     *repo.DepartMent
+	Prices                   *repo.ServicePrice
 }
 
 func (s *DoctorServiceTestSuite) SetupTest() {
@@ -32,6 +33,7 @@ func (s *DoctorServiceTestSuite) SetupTest() {
 	s.RepositoryDoctor = repo.NewDoctorRepo(pgPool)
 	s.RepositorySpecialization = repo.NewSpecializationRepo(pgPool)
 	s.RepositoryDepartment = repo.NewDepartmentRepo(pgPool)
+	s.Prices = repo.NewServicePricesRepo(pgPool)
 	s.CleanUpFunc = pgPool.Close
 }
 
@@ -115,16 +117,16 @@ func (s *DoctorServiceTestSuite) TestDoctorServiceCrud() {
 	s.Suite.Equal(respSpec.Description, special.Description)
 	s.Suite.Equal(respSpec.DepartmentId, special.DepartmentId)
 
-	tm, err := time.Parse("15:04", "12:12")
-	s.Suite.NoError(err)
 	doctorServices := &entity.DoctorServices{
 		Id:               uuid.NewString(),
 		DoctorId:         doctor.Id,
 		SpecializationId: special.ID,
-		OnlinePrice:      1,
-		OfflinePrice:     1,
+		OnlinePrice:      "1.00",
+		OfflinePrice:     "1.00",
+		Currency:         "UZS",
 		Name:             "Test Name",
-		Duration:         tm,
+		DurationMinutes:  30,
+		PrepInstructions: "Come on an empty stomach",
 	}
 	respDoctorService, err := s.Repository.CreateDoctorServices(ctx, doctorServices)
 	s.Suite.NoError(err)
@@ -136,7 +138,8 @@ func (s *DoctorServiceTestSuite) TestDoctorServiceCrud() {
 	s.Suite.Equal(respDoctorService.OnlinePrice, doctorServices.OnlinePrice)
 	s.Suite.Equal(respDoctorService.OfflinePrice, doctorServices.OfflinePrice)
 	s.Suite.Equal(respDoctorService.Name, doctorServices.Name)
-	s.Suite.Equal(respDoctorService.Duration, doctorServices.Duration)
+	s.Suite.Equal(respDoctorService.DurationMinutes, doctorServices.DurationMinutes)
+	s.Suite.Equal(respDoctorService.PrepInstructions, doctorServices.PrepInstructions)
 
 	getDoctorService, err := s.Repository.GetDoctorServiceByID(ctx, &entity.GetReqStr{
 		Field:    "id",
@@ -151,7 +154,7 @@ func (s *DoctorServiceTestSuite) TestDoctorServiceCrud() {
 	s.Suite.Equal(respDoctorService.OnlinePrice, doctorServices.OnlinePrice)
 	s.Suite.Equal(respDoctorService.OfflinePrice, doctorServices.OfflinePrice)
 	s.Suite.Equal(respDoctorService.Name, doctorServices.Name)
-	s.Suite.Equal(respDoctorService.Duration, doctorServices.Duration)
+	s.Suite.Equal(respDoctorService.DurationMinutes, doctorServices.DurationMinutes)
 
 	respAll, err := s.Repository.GetAllDoctorServices(ctx, &entity.GetAll{
 		Page:     1,
@@ -164,27 +167,93 @@ func (s *DoctorServiceTestSuite) TestDoctorServiceCrud() {
 	s.Suite.NoError(err)
 	s.Suite.NotNil(respAll)
 
-	newUpOnlinePrice := 2
-	newUpOfflinePrice := 2
+	newUpOnlinePrice := "2.50"
+	newUpOfflinePrice := "3.00"
 
 	updatedDoctorService, err := s.Repository.UpdateDoctorServices(ctx, &entity.DoctorServices{
 		Id:               doctorServices.Id,
 		DoctorId:         doctorServices.DoctorId,
 		SpecializationId: doctorServices.SpecializationId,
-		OnlinePrice:      float32(newUpOnlinePrice),
-		OfflinePrice:     float32(newUpOfflinePrice),
+		OnlinePrice:      newUpOnlinePrice,
+		OfflinePrice:     newUpOfflinePrice,
+		Currency:         doctorServices.Currency,
 		Name:             doctorServices.Name,
-		Duration:         doctorServices.Duration,
+		DurationMinutes:  doctorServices.DurationMinutes,
 	})
 	s.Suite.NoError(err)
 	s.Suite.NotNil(updatedDoctorService)
 	s.Suite.NotNil(updatedDoctorService.UpdatedAt)
 	s.Suite.Equal(doctorServices.DoctorId, updatedDoctorService.DoctorId)
 	s.Suite.Equal(doctorServices.SpecializationId, updatedDoctorService.SpecializationId)
-	s.Suite.Equal(float32(newUpOnlinePrice), updatedDoctorService.OfflinePrice)
-	s.Suite.Equal(float32(newUpOfflinePrice), updatedDoctorService.OfflinePrice)
+	s.Suite.Equal(newUpOnlinePrice, updatedDoctorService.OnlinePrice)
+	s.Suite.Equal(newUpOfflinePrice, updatedDoctorService.OfflinePrice)
 	s.Suite.Equal(doctorServices.Name, updatedDoctorService.Name)
-	s.Suite.Equal(doctorServices.Duration, updatedDoctorService.Duration)
+	s.Suite.Equal(doctorServices.DurationMinutes, updatedDoctorService.DurationMinutes)
+
+	regularFrom := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	regular, err := s.Prices.CreateServicePrice(ctx, &entity.ServicePrice{
+		Id:              uuid.NewString(),
+		DoctorServiceId: doctorServices.Id,
+		Modality:        entity.ModalityOffline,
+		Amount:          "100000.00",
+		Currency:        "UZS",
+		EffectiveFrom:   regularFrom,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal("100000.00", regular.Amount)
+
+	raisedFrom := regularFrom.AddDate(0, 6, 0)
+	_, err = s.Prices.CreateServicePrice(ctx, &entity.ServicePrice{
+		Id:              uuid.NewString(),
+		DoctorServiceId: doctorServices.Id,
+		Modality:        entity.ModalityOffline,
+		Amount:          "120000.00",
+		Currency:        "UZS",
+		EffectiveFrom:   raisedFrom,
+	})
+	s.Suite.NoError(err)
+
+	_, err = s.Prices.CreateServicePrice(ctx, &entity.ServicePrice{
+		Id:              uuid.NewString(),
+		DoctorServiceId: doctorServices.Id,
+		Modality:        entity.ModalityOffline,
+		Amount:          "90000.00",
+		Currency:        "UZS",
+		EffectiveFrom:   raisedFrom.AddDate(0, 1, 0),
+		EffectiveTo:     raisedFrom.AddDate(0, 2, 0),
+		IsPromo:         true,
+		PromoName:       "Spring",
+	})
+	s.Suite.NoError(err)
+
+	// the first price was closed when the raise started
+	effective, err := s.Prices.GetEffectivePrice(ctx, &entity.GetEffectivePrice{
+		DoctorServiceId: doctorServices.Id,
+		Modality:        entity.ModalityOffline,
+		At:              raisedFrom.AddDate(0, 0, 1),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal("120000.00", effective.Amount)
+
+	// the promo overlays the regular price for its period
+	effective, err = s.Prices.GetEffectivePrice(ctx, &entity.GetEffectivePrice{
+		DoctorServiceId: doctorServices.Id,
+		Modality:        entity.ModalityOffline,
+		At:              raisedFrom.AddDate(0, 1, 1),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal("90000.00", effective.Amount)
+	s.Suite.True(effective.IsPromo)
+
+	history, err := s.Prices.GetServicePriceHistory(ctx, &entity.GetServicePrices{
+		DoctorServiceId: doctorServices.Id,
+		Page:            1,
+		Limit:           10,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(3), history.Count)
+	s.Suite.Equal(raisedFrom, history.Prices[1].EffectiveFrom)
+	s.Suite.Equal(raisedFrom, history.Prices[2].EffectiveTo)
 
 	deleteDoctorService, err := s.Repository.DeleteDoctorService(ctx, &entity.GetReqStr{
 		Field:    "id",
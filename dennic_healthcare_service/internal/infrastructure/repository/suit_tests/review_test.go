@@ -0,0 +1,171 @@
+package suit_tests
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	repo "Healthcare_Evrone/internal/infrastructure/repository/postgresql"
+	"Healthcare_Evrone/internal/pkg/config"
+	db "Healthcare_Evrone/internal/pkg/postgres"
+	"context"
+	"github.com/google/uuid"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/suite"
+	"log"
+	"testing"
+	"time"
+)
+
+type ReviewTestSuite struct {
+	suite.Suite
+	CleanUpFunc          func()
+	Repository           *repo.Review
+	RepositoryDoctor     *repo.DocTor
+	RepositoryDepartment *repo.DepartMent
+	RepositoryDWH        *repo.Dwh
+}
+
+func (s *ReviewTestSuite) SetupTest() {
+	pgPool, err := db.New(config.New())
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	s.Repository = repo.NewReviewRepo(pgPool)
+	s.RepositoryDoctor = repo.NewDoctorRepo(pgPool)
+	s.RepositoryDepartment = repo.NewDepartmentRepo(pgPool)
+	s.RepositoryDWH = repo.NewDoctorWorkingHoursRepo(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *ReviewTestSuite) TestReviewCrud() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	department := &entity.Department{
+		Id:          uuid.NewString(),
+		Name:        "Test name",
+		Description: "Test description",
+		ImageUrl:    "Test imageUrl",
+		FloorNumber: 1,
+	}
+	_, err := s.RepositoryDepartment.CreateDepartment(ctx, department)
+	s.Suite.NoError(err)
+
+	doctor := &entity.Doctor{
+		Id:            uuid.NewString(),
+		FirstName:     "Test first name",
+		LastName:      "Test last name",
+		Gender:        "male",
+		BirthDate:     "12-12-12",
+		PhoneNumber:   "Testphonenumber",
+		Email:         "Test review email",
+		StartWorkDate: "12-12-12",
+		DepartmentId:  department.Id,
+		Password:      "Test password",
+	}
+	_, err = s.RepositoryDoctor.CreateDoctor(ctx, doctor)
+	s.Suite.NoError(err)
+
+	dwh := &entity.DoctorWorkingHours{
+		DoctorId:   doctor.Id,
+		DayOfWeek:  "Monday",
+		StartTime:  "12-12-12 12:12:12",
+		FinishTime: "12-12-12 12:12:12",
+	}
+	_, err = s.RepositoryDWH.CreateDoctorWorkingHours(ctx, dwh)
+	s.Suite.NoError(err)
+
+	first := &entity.Review{
+		Id:            uuid.NewString(),
+		DoctorId:      doctor.Id,
+		PatientId:     uuid.NewString(),
+		AppointmentId: 1001,
+		Rating:        4,
+		Comment:       "Attentive doctor",
+		Status:        entity.ReviewPending,
+	}
+	respReview, err := s.Repository.CreateReview(ctx, first)
+	s.Suite.NoError(err)
+	s.Suite.NotNil(respReview)
+	s.Suite.Equal(first.Id, respReview.Id)
+	s.Suite.Equal(first.Rating, respReview.Rating)
+	s.Suite.Equal(entity.ReviewPending, respReview.Status)
+
+	// only one review per appointment
+	_, err = s.Repository.CreateReview(ctx, &entity.Review{
+		Id:            uuid.NewString(),
+		DoctorId:      doctor.Id,
+		PatientId:     first.PatientId,
+		AppointmentId: first.AppointmentId,
+		Rating:        1,
+		Status:        entity.ReviewPending,
+	})
+	s.Suite.ErrorIs(err, entity.ErrorConflict)
+
+	second := &entity.Review{
+		Id:            uuid.NewString(),
+		DoctorId:      doctor.Id,
+		PatientId:     uuid.NewString(),
+		AppointmentId: 1002,
+		Rating:        5,
+		Status:        entity.ReviewPending,
+	}
+	_, err = s.Repository.CreateReview(ctx, second)
+	s.Suite.NoError(err)
+
+	for _, id := range []string{first.Id, second.Id} {
+		moderated, err := s.Repository.ModerateReview(ctx, &entity.ModerateReview{
+			Id:     id,
+			Status: entity.ReviewApproved,
+		})
+		s.Suite.NoError(err)
+		s.Suite.Equal(entity.ReviewApproved, moderated.Status)
+	}
+
+	getDoctor, err := s.RepositoryDoctor.GetDoctorById(ctx, &entity.GetReqStr{Field: "id", Value: doctor.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(float32(4.5), getDoctor.Rating)
+	s.Suite.Equal(int32(2), getDoctor.ReviewCount)
+
+	replied, err := s.Repository.ReplyToReview(ctx, &entity.ReplyReview{
+		Id:       first.Id,
+		DoctorId: doctor.Id,
+		Reply:    "Thank you",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal("Thank you", replied.Reply)
+	s.Suite.False(replied.RepliedAt.IsZero())
+
+	reviews, err := s.Repository.GetAllReviews(ctx, &entity.GetAllReviews{
+		Page:     1,
+		Limit:    10,
+		DoctorId: doctor.Id,
+		Status:   entity.ReviewApproved,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), reviews.Count)
+
+	// a deleted review no longer counts towards the rating
+	deleted, err := s.Repository.DeleteReview(ctx, &entity.GetReqStr{Field: "id", Value: second.Id})
+	s.Suite.NoError(err)
+	s.Suite.True(deleted)
+
+	getDoctor, err = s.RepositoryDoctor.GetDoctorById(ctx, &entity.GetReqStr{Field: "id", Value: doctor.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(float32(4), getDoctor.Rating)
+	s.Suite.Equal(int32(1), getDoctor.ReviewCount)
+
+	_, err = s.RepositoryDWH.DeleteDoctorWorkingHours(ctx, &entity.GetReqStr{Field: "id", Value: cast.ToString(dwh.Id), IsActive: true})
+	s.Suite.NoError(err)
+	_, err = s.RepositoryDoctor.DeleteDoctor(ctx, &entity.GetReqStr{Field: "id", Value: doctor.Id, IsActive: true})
+	s.Suite.NoError(err)
+	_, err = s.RepositoryDepartment.DeleteDepartment(ctx, &entity.GetReqStr{Field: "id", Value: department.Id, IsActive: true})
+	s.Suite.NoError(err)
+}
+
+func (s *ReviewTestSuite) TearDownTest() {
+	s.CleanUpFunc()
+}
+
+func TestReviewTestSuite(t *testing.T) {
+	suite.Run(t, new(ReviewTestSuite))
+}
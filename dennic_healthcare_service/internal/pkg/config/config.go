@@ -44,6 +44,14 @@ type Config struct {
 		Topic   struct {
 			Healthcare string
 		}
+		// Retry bounds how often a failed message is handled again before
+		// it is parked on its topic with DeadLetterSuffix appended.
+		Retry struct {
+			MaxAttempts string
+			Backoff     string
+			MaxBackoff  string
+		}
+		DeadLetterSuffix string
 	}
 	MinioService Minio
 }
@@ -72,7 +80,11 @@ func New() *Config {
 
 	// kafka configuration
 	config.Kafka.Address = strings.Split(getEnv("KAFKA_ADDRESS", "localhost:29092"), ",")
-	config.Kafka.Topic.Healthcare = getEnv("KAFKA_TOPIC_HEALTHCARE_CREATE", "user.created")
+	config.Kafka.Topic.Healthcare = getEnv("KAFKA_TOPIC_HEALTHCARE_CREATE", "doctor.created")
+	config.Kafka.Retry.MaxAttempts = getEnv("KAFKA_RETRY_MAX_ATTEMPTS", "5")
+	config.Kafka.Retry.Backoff = getEnv("KAFKA_RETRY_BACKOFF", "1s")
+	config.Kafka.Retry.MaxBackoff = getEnv("KAFKA_RETRY_MAX_BACKOFF", "30s")
+	config.Kafka.DeadLetterSuffix = getEnv("KAFKA_DEAD_LETTER_SUFFIX", ".dlq")
 
 	// Minio
 	config.MinioService.Endpoint = getEnv("MINIO_SERVICE_ENDPOINT", "minio:9000")
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"context"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"time"
+)
+
+const (
+	serviceNameBranchUseCase           = "branchUseCase"
+	serviceNameBranchUseCaseRepoPrefix = "branchUseCase"
+)
+
+type BranchUsecase interface {
+	CreateBranch(ctx context.Context, branch *entity.Branch) (*entity.Branch, error)
+	GetBranchById(ctx context.Context, get *entity.GetReqStr) (*entity.Branch, error)
+	GetAllBranches(ctx context.Context, all *entity.GetAll) (*entity.ListBranches, error)
+	UpdateBranch(ctx context.Context, update *entity.Branch) (*entity.Branch, error)
+	DeleteBranch(ctx context.Context, del *entity.GetReqStr) (bool, error)
+}
+
+type branchService struct {
+	BaseUseCase
+	repo       repository.BranchRepository
+	ctxTimeout time.Duration
+}
+
+func NewBranchService(ctxTimeout time.Duration, repo repository.BranchRepository) branchService {
+	return branchService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+// validateBranch checks the timezone and opening hours before they are stored,
+// slot search relies on both being parseable.
+func (u branchService) validateBranch(branch *entity.Branch) error {
+	if branch.Timezone == "" {
+		branch.Timezone = "Asia/Tashkent"
+	}
+	if _, err := time.LoadLocation(branch.Timezone); err != nil {
+		return u.Error("invalid timezone", err)
+	}
+	for _, hours := range branch.OpeningHours {
+		openTime, err := time.Parse("15:04", hours.OpenTime)
+		if err != nil {
+			return u.Error("invalid open_time", err)
+		}
+		closeTime, err := time.Parse("15:04", hours.CloseTime)
+		if err != nil {
+			return u.Error("invalid close_time", err)
+		}
+		if !closeTime.After(openTime) {
+			return fmt.Errorf("close_time must be after open_time on %s", hours.DayOfWeek)
+		}
+	}
+	return nil
+}
+
+func (u branchService) CreateBranch(ctx context.Context, branch *entity.Branch) (*entity.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBranchUseCase, serviceNameBranchUseCaseRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateBranch").String(branch.Id))
+	defer span.End()
+
+	if err := u.validateBranch(branch); err != nil {
+		return nil, err
+	}
+	return u.repo.CreateBranch(ctx, branch)
+}
+
+func (u branchService) GetBranchById(ctx context.Context, get *entity.GetReqStr) (*entity.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBranchUseCase, serviceNameBranchUseCaseRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key(get.Field).String(get.Value))
+	defer span.End()
+
+	return u.repo.GetBranchById(ctx, get)
+}
+
+func (u branchService) GetAllBranches(ctx context.Context, all *entity.GetAll) (*entity.ListBranches, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBranchUseCase, serviceNameBranchUseCaseRepoPrefix+"Get all")
+	span.SetAttributes(attribute.Key(all.Field).String(all.Value))
+	defer span.End()
+
+	return u.repo.GetAllBranches(ctx, all)
+}
+
+func (u branchService) UpdateBranch(ctx context.Context, update *entity.Branch) (*entity.Branch, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBranchUseCase, serviceNameBranchUseCaseRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateBranch").String(update.Id))
+	defer span.End()
+
+	if err := u.validateBranch(update); err != nil {
+		return nil, err
+	}
+	return u.repo.UpdateBranch(ctx, update)
+}
+
+func (u branchService) DeleteBranch(ctx context.Context, del *entity.GetReqStr) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameBranchUseCase, serviceNameBranchUseCaseRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteBranch").String(del.Value))
+	defer span.End()
+
+	return u.repo.DeleteBranch(ctx, del)
+}
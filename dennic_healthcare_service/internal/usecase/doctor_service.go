@@ -5,7 +5,10 @@ import (
 	"Healthcare_Evrone/internal/infrastructure/repository"
 	"Healthcare_Evrone/internal/pkg/otlp"
 	"context"
+	"errors"
+	"fmt"
 	"go.opentelemetry.io/otel/attribute"
+	"regexp"
 	"time"
 )
 
@@ -20,19 +23,82 @@ type DoctorServices interface {
 	GetAllDoctorServices(ctx context.Context, all *entity.GetAll) (*entity.ListDoctorServices, error)
 	UpdateDoctorServices(ctx context.Context, in *entity.DoctorServices) (*entity.DoctorServices, error)
 	DeleteDoctorService(ctx context.Context, in *entity.GetReqStr) (bool, error)
+
+	CreateServicePrice(ctx context.Context, in *entity.ServicePrice) (*entity.ServicePrice, error)
+	GetServicePriceHistory(ctx context.Context, in *entity.GetServicePrices) (*entity.ListServicePrices, error)
+	GetEffectivePrice(ctx context.Context, in *entity.GetEffectivePrice) (*entity.ServicePrice, error)
+	DeleteServicePrice(ctx context.Context, in *entity.GetReqStr) (bool, error)
 }
 
 type newsDoctorSService struct {
 	BaseUseCase
 	repo       repository.DoctorServices
+	prices     repository.ServicePrices
 	ctxTimeout time.Duration
 }
 
-func NewDoctorServices(ctxTimeout time.Duration, ds repository.DoctorServices) newsDoctorSService {
+func NewDoctorServices(ctxTimeout time.Duration, ds repository.DoctorServices, prices repository.ServicePrices) newsDoctorSService {
 	return newsDoctorSService{
 		ctxTimeout: ctxTimeout,
 		repo:       ds,
+		prices:     prices,
+	}
+}
+
+const defaultCurrency = "UZS"
+
+// amountPattern accepts non-negative decimal amounts with at most two
+// fractional digits, matching the NUMERIC(14,2) columns.
+var (
+	amountPattern   = regexp.MustCompile(`^\d{1,12}(\.\d{1,2})?$`)
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+func (u newsDoctorSService) validateDoctorService(in *entity.DoctorServices) error {
+	if in.Currency == "" {
+		in.Currency = defaultCurrency
+	}
+	if !currencyPattern.MatchString(in.Currency) {
+		return fmt.Errorf("invalid currency %q", in.Currency)
+	}
+	if !amountPattern.MatchString(in.OnlinePrice) {
+		return fmt.Errorf("invalid online_price %q", in.OnlinePrice)
+	}
+	if !amountPattern.MatchString(in.OfflinePrice) {
+		return fmt.Errorf("invalid offline_price %q", in.OfflinePrice)
+	}
+	if in.DurationMinutes <= 0 {
+		return fmt.Errorf("duration_minutes must be positive")
+	}
+	return nil
+}
+
+func (u newsDoctorSService) validateServicePrice(in *entity.ServicePrice) error {
+	if in.DoctorServiceId == "" {
+		return entity.NewErrNoRequiredParameter("doctor_service_id")
+	}
+	if in.Modality != entity.ModalityOnline && in.Modality != entity.ModalityOffline {
+		return fmt.Errorf("modality must be %q or %q", entity.ModalityOnline, entity.ModalityOffline)
+	}
+	if in.Currency == "" {
+		in.Currency = defaultCurrency
 	}
+	if !currencyPattern.MatchString(in.Currency) {
+		return fmt.Errorf("invalid currency %q", in.Currency)
+	}
+	if !amountPattern.MatchString(in.Amount) {
+		return fmt.Errorf("invalid amount %q", in.Amount)
+	}
+	if in.EffectiveFrom.IsZero() {
+		in.EffectiveFrom = time.Now().Add(time.Hour * 5)
+	}
+	if !in.EffectiveTo.IsZero() && !in.EffectiveTo.After(in.EffectiveFrom) {
+		return fmt.Errorf("effective_to must be after effective_from")
+	}
+	if in.IsPromo && in.EffectiveTo.IsZero() {
+		return entity.NewErrNoRequiredParameter("effective_to")
+	}
+	return nil
 }
 
 func (u newsDoctorSService) CreateDoctorServices(ctx context.Context, in *entity.DoctorServices) (*entity.DoctorServices, error) {
@@ -43,6 +109,10 @@ func (u newsDoctorSService) CreateDoctorServices(ctx context.Context, in *entity
 	span.SetAttributes(attribute.Key("CreateDoctorServices").String(in.Id))
 	defer span.End()
 
+	if err := u.validateDoctorService(in); err != nil {
+		return nil, err
+	}
+
 	return u.repo.CreateDoctorServices(ctx, in)
 }
 
@@ -78,6 +148,10 @@ func (u newsDoctorSService) UpdateDoctorServices(ctx context.Context, in *entity
 	span.SetAttributes(attribute.Key("doctorService").String(in.Id))
 	defer span.End()
 
+	if err := u.validateDoctorService(in); err != nil {
+		return nil, err
+	}
+
 	return u.repo.UpdateDoctorServices(ctx, in)
 }
 
@@ -91,3 +165,80 @@ func (u newsDoctorSService) DeleteDoctorService(ctx context.Context, in *entity.
 
 	return u.repo.DeleteDoctorService(ctx, in)
 }
+
+func (u newsDoctorSService) CreateServicePrice(ctx context.Context, in *entity.ServicePrice) (*entity.ServicePrice, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorServiceUseCase, serviceNameDoctorServiceUseCaseRepoPrefix+"CreatePrice")
+	span.SetAttributes(attribute.Key("doctorService").String(in.DoctorServiceId))
+	defer span.End()
+
+	if err := u.validateServicePrice(in); err != nil {
+		return nil, err
+	}
+	return u.prices.CreateServicePrice(ctx, in)
+}
+
+func (u newsDoctorSService) GetServicePriceHistory(ctx context.Context, in *entity.GetServicePrices) (*entity.ListServicePrices, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorServiceUseCase, serviceNameDoctorServiceUseCaseRepoPrefix+"PriceHistory")
+	span.SetAttributes(attribute.Key("doctorService").String(in.DoctorServiceId))
+	defer span.End()
+
+	return u.prices.GetServicePriceHistory(ctx, in)
+}
+
+// GetEffectivePrice resolves the price in force at in.At (now by default). When
+// the price list has no entry for that moment the service's base price is used.
+func (u newsDoctorSService) GetEffectivePrice(ctx context.Context, in *entity.GetEffectivePrice) (*entity.ServicePrice, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorServiceUseCase, serviceNameDoctorServiceUseCaseRepoPrefix+"EffectivePrice")
+	span.SetAttributes(attribute.Key("doctorService").String(in.DoctorServiceId))
+	defer span.End()
+
+	if in.Modality != entity.ModalityOnline && in.Modality != entity.ModalityOffline {
+		return nil, fmt.Errorf("modality must be %q or %q", entity.ModalityOnline, entity.ModalityOffline)
+	}
+	if in.At.IsZero() {
+		in.At = time.Now().Add(time.Hour * 5)
+	}
+
+	price, err := u.prices.GetEffectivePrice(ctx, in)
+	if err == nil {
+		return price, nil
+	}
+	if !errors.Is(err, entity.ErrorNotFound) {
+		return nil, err
+	}
+
+	service, err := u.repo.GetDoctorServiceByID(ctx, &entity.GetReqStr{Field: "id", Value: in.DoctorServiceId})
+	if err != nil {
+		return nil, err
+	}
+	base := &entity.ServicePrice{
+		DoctorServiceId: service.Id,
+		Modality:        in.Modality,
+		Amount:          service.OfflinePrice,
+		Currency:        service.Currency,
+	}
+	if in.Modality == entity.ModalityOnline {
+		base.Amount = service.OnlinePrice
+	}
+	return base, nil
+}
+
+func (u newsDoctorSService) DeleteServicePrice(ctx context.Context, in *entity.GetReqStr) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameDoctorServiceUseCase, serviceNameDoctorServiceUseCaseRepoPrefix+"DeletePrice")
+	span.SetAttributes(attribute.Key("servicePrice").String(in.Value))
+	defer span.End()
+
+	return u.prices.DeleteServicePrice(ctx, in)
+}
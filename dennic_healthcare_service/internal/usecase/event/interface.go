@@ -3,14 +3,12 @@ package event
 import (
 	"Healthcare_Evrone/internal/entity"
 	"context"
+	"dennic_kafka/eventbus"
 )
 
-type ConsumerConfig interface {
-	GetBrokers() []string
-	GetTopic() string
-	GetGroupID() string
-	GetHandler() func(ctx context.Context, key, value []byte) error
-}
+// ConsumerConfig is a topic to consume and the handler of its messages,
+// see eventbus.NewConsumerConfig.
+type ConsumerConfig = eventbus.ConsumerConfig
 
 type BrokerConsumer interface {
 	Run()
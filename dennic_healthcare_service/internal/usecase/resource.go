@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	"time"
+)
+
+const (
+	serviceNameResourceUseCase           = "resourceUseCase"
+	serviceNameResourceUseCaseRepoPrefix = "resourceUseCase"
+)
+
+type ResourceUsecase interface {
+	CreateResource(ctx context.Context, resource *entity.Resource) (*entity.Resource, error)
+	GetResourceById(ctx context.Context, get *entity.GetReqStr) (*entity.Resource, error)
+	GetAllResources(ctx context.Context, all *entity.GetAllResources) (*entity.ListResources, error)
+	UpdateResource(ctx context.Context, update *entity.Resource) (*entity.Resource, error)
+	DeleteResource(ctx context.Context, del *entity.GetReqStr) (bool, error)
+}
+
+type resourceService struct {
+	BaseUseCase
+	repo       repository.ResourceRepository
+	ctxTimeout time.Duration
+}
+
+func NewResourceService(ctxTimeout time.Duration, repo repository.ResourceRepository) resourceService {
+	return resourceService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (u resourceService) CreateResource(ctx context.Context, resource *entity.Resource) (*entity.Resource, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameResourceUseCase, serviceNameResourceUseCaseRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateResource").String(resource.Id))
+	defer span.End()
+
+	if resource.BranchId == "" {
+		return nil, entity.NewErrNoRequiredParameter("branch_id")
+	}
+	if resource.ResourceType == "" {
+		return nil, entity.NewErrNoRequiredParameter("resource_type")
+	}
+	return u.repo.CreateResource(ctx, resource)
+}
+
+func (u resourceService) GetResourceById(ctx context.Context, get *entity.GetReqStr) (*entity.Resource, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameResourceUseCase, serviceNameResourceUseCaseRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key(get.Field).String(get.Value))
+	defer span.End()
+
+	return u.repo.GetResourceById(ctx, get)
+}
+
+func (u resourceService) GetAllResources(ctx context.Context, all *entity.GetAllResources) (*entity.ListResources, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameResourceUseCase, serviceNameResourceUseCaseRepoPrefix+"Get all")
+	span.SetAttributes(attribute.Key(all.Field).String(all.Value))
+	defer span.End()
+
+	return u.repo.GetAllResources(ctx, all)
+}
+
+func (u resourceService) UpdateResource(ctx context.Context, update *entity.Resource) (*entity.Resource, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameResourceUseCase, serviceNameResourceUseCaseRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateResource").String(update.Id))
+	defer span.End()
+
+	if update.BranchId == "" {
+		return nil, entity.NewErrNoRequiredParameter("branch_id")
+	}
+	if update.ResourceType == "" {
+		return nil, entity.NewErrNoRequiredParameter("resource_type")
+	}
+	return u.repo.UpdateResource(ctx, update)
+}
+
+func (u resourceService) DeleteResource(ctx context.Context, del *entity.GetReqStr) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameResourceUseCase, serviceNameResourceUseCaseRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteResource").String(del.Value))
+	defer span.End()
+
+	return u.repo.DeleteResource(ctx, del)
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	serviceNameReviewUseCase           = "reviewUseCase"
+	serviceNameReviewUseCaseRepoPrefix = "reviewUseCase"
+)
+
+type ReviewUsecase interface {
+	CreateReview(ctx context.Context, review *entity.Review) (*entity.Review, error)
+	GetReviewById(ctx context.Context, get *entity.GetReqStr) (*entity.Review, error)
+	GetAllReviews(ctx context.Context, all *entity.GetAllReviews) (*entity.ListReviews, error)
+	ModerateReview(ctx context.Context, in *entity.ModerateReview) (*entity.Review, error)
+	ReplyToReview(ctx context.Context, in *entity.ReplyReview) (*entity.Review, error)
+	DeleteReview(ctx context.Context, del *entity.GetReqStr) (bool, error)
+}
+
+type reviewService struct {
+	BaseUseCase
+	repo       repository.ReviewRepository
+	ctxTimeout time.Duration
+}
+
+func NewReviewService(ctxTimeout time.Duration, repo repository.ReviewRepository) reviewService {
+	return reviewService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+// CreateReview stores a new review awaiting moderation. The caller is
+// responsible for checking that the appointment was attended; one review per
+// appointment is enforced by the database.
+func (u reviewService) CreateReview(ctx context.Context, review *entity.Review) (*entity.Review, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameReviewUseCase, serviceNameReviewUseCaseRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateReview").String(review.Id))
+	defer span.End()
+
+	if review.DoctorId == "" {
+		return nil, entity.NewErrNoRequiredParameter("doctor_id")
+	}
+	if review.PatientId == "" {
+		return nil, entity.NewErrNoRequiredParameter("patient_id")
+	}
+	if review.AppointmentId == 0 {
+		return nil, entity.NewErrNoRequiredParameter("appointment_id")
+	}
+	if review.Rating < 1 || review.Rating > 5 {
+		return nil, u.Error("rating", fmt.Errorf("must be between 1 and 5, got %d", review.Rating))
+	}
+	review.Comment = strings.TrimSpace(review.Comment)
+	review.Status = entity.ReviewPending
+
+	return u.repo.CreateReview(ctx, review)
+}
+
+func (u reviewService) GetReviewById(ctx context.Context, get *entity.GetReqStr) (*entity.Review, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameReviewUseCase, serviceNameReviewUseCaseRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key(get.Field).String(get.Value))
+	defer span.End()
+
+	return u.repo.GetReviewById(ctx, get)
+}
+
+func (u reviewService) GetAllReviews(ctx context.Context, all *entity.GetAllReviews) (*entity.ListReviews, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameReviewUseCase, serviceNameReviewUseCaseRepoPrefix+"Get all")
+	span.SetAttributes(attribute.Key("doctor_id").String(all.DoctorId))
+	defer span.End()
+
+	return u.repo.GetAllReviews(ctx, all)
+}
+
+func (u reviewService) ModerateReview(ctx context.Context, in *entity.ModerateReview) (*entity.Review, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameReviewUseCase, serviceNameReviewUseCaseRepoPrefix+"Moderate")
+	span.SetAttributes(attribute.Key("ModerateReview").String(in.Id))
+	defer span.End()
+
+	switch in.Status {
+	case entity.ReviewPending, entity.ReviewApproved, entity.ReviewRejected:
+	default:
+		return nil, u.Error("status", fmt.Errorf("unknown review status %q", in.Status))
+	}
+
+	return u.repo.ModerateReview(ctx, in)
+}
+
+// ReplyToReview lets the reviewed doctor answer publicly. Replying again
+// replaces the previous answer.
+func (u reviewService) ReplyToReview(ctx context.Context, in *entity.ReplyReview) (*entity.Review, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameReviewUseCase, serviceNameReviewUseCaseRepoPrefix+"Reply")
+	span.SetAttributes(attribute.Key("ReplyToReview").String(in.Id))
+	defer span.End()
+
+	in.Reply = strings.TrimSpace(in.Reply)
+	if in.Reply == "" {
+		return nil, entity.NewErrNoRequiredParameter("reply")
+	}
+
+	review, err := u.repo.GetReviewById(ctx, &entity.GetReqStr{Field: "id", Value: in.Id})
+	if err != nil {
+		return nil, err
+	}
+	if review.DoctorId != in.DoctorId {
+		return nil, entity.NewErrPermissionDenied("review")
+	}
+
+	return u.repo.ReplyToReview(ctx, in)
+}
+
+func (u reviewService) DeleteReview(ctx context.Context, del *entity.GetReqStr) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameReviewUseCase, serviceNameReviewUseCaseRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteReview").String(del.Value))
+	defer span.End()
+
+	return u.repo.DeleteReview(ctx, del)
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"Healthcare_Evrone/internal/entity"
+	"Healthcare_Evrone/internal/infrastructure/repository"
+	"Healthcare_Evrone/internal/pkg/otlp"
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	"time"
+)
+
+const (
+	serviceNameRoomUseCase           = "roomUseCase"
+	serviceNameRoomUseCaseRepoPrefix = "roomUseCase"
+)
+
+type RoomUsecase interface {
+	CreateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error)
+	GetRoomById(ctx context.Context, get *entity.GetReqStr) (*entity.Room, error)
+	GetAllRooms(ctx context.Context, all *entity.GetAllRooms) (*entity.ListRooms, error)
+	UpdateRoom(ctx context.Context, update *entity.Room) (*entity.Room, error)
+	DeleteRoom(ctx context.Context, del *entity.GetReqStr) (bool, error)
+}
+
+type roomService struct {
+	BaseUseCase
+	repo       repository.RoomRepository
+	ctxTimeout time.Duration
+}
+
+func NewRoomService(ctxTimeout time.Duration, repo repository.RoomRepository) roomService {
+	return roomService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (u roomService) CreateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameRoomUseCase, serviceNameRoomUseCaseRepoPrefix+"Create")
+	span.SetAttributes(attribute.Key("CreateRoom").String(room.Id))
+	defer span.End()
+
+	if room.BranchId == "" {
+		return nil, entity.NewErrNoRequiredParameter("branch_id")
+	}
+	return u.repo.CreateRoom(ctx, room)
+}
+
+func (u roomService) GetRoomById(ctx context.Context, get *entity.GetReqStr) (*entity.Room, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameRoomUseCase, serviceNameRoomUseCaseRepoPrefix+"Get")
+	span.SetAttributes(attribute.Key(get.Field).String(get.Value))
+	defer span.End()
+
+	return u.repo.GetRoomById(ctx, get)
+}
+
+func (u roomService) GetAllRooms(ctx context.Context, all *entity.GetAllRooms) (*entity.ListRooms, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameRoomUseCase, serviceNameRoomUseCaseRepoPrefix+"Get all")
+	span.SetAttributes(attribute.Key(all.Field).String(all.Value))
+	defer span.End()
+
+	return u.repo.GetAllRooms(ctx, all)
+}
+
+func (u roomService) UpdateRoom(ctx context.Context, update *entity.Room) (*entity.Room, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameRoomUseCase, serviceNameRoomUseCaseRepoPrefix+"Update")
+	span.SetAttributes(attribute.Key("UpdateRoom").String(update.Id))
+	defer span.End()
+
+	if update.BranchId == "" {
+		return nil, entity.NewErrNoRequiredParameter("branch_id")
+	}
+	return u.repo.UpdateRoom(ctx, update)
+}
+
+func (u roomService) DeleteRoom(ctx context.Context, del *entity.GetReqStr) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameRoomUseCase, serviceNameRoomUseCaseRepoPrefix+"Delete")
+	span.SetAttributes(attribute.Key("DeleteRoom").String(del.Value))
+	defer span.End()
+
+	return u.repo.DeleteRoom(ctx, del)
+}
@@ -0,0 +1,3 @@
+ALTER TABLE departments ADD COLUMN branch_id UUID REFERENCES branches(id);
+
+CREATE INDEX departments_branch_id_idx ON departments(branch_id);
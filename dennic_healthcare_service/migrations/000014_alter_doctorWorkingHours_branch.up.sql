@@ -0,0 +1,4 @@
+ALTER TABLE doctor_working_hours ADD COLUMN branch_id UUID REFERENCES branches(id);
+ALTER TABLE doctor_working_hours ADD COLUMN room_id UUID REFERENCES rooms(id);
+
+CREATE INDEX doctor_working_hours_branch_id_idx ON doctor_working_hours(branch_id);
@@ -0,0 +1 @@
+ALTER TABLE doctor_service ADD COLUMN required_resource_type VARCHAR(50) NOT NULL DEFAULT '';
@@ -0,0 +1,12 @@
+ALTER TABLE doctor_service ADD COLUMN duration TIMESTAMP;
+
+UPDATE doctor_service
+SET duration = '0001-01-01 00:00:00'::timestamp + make_interval(mins => duration_minutes);
+
+ALTER TABLE doctor_service
+    ALTER COLUMN duration SET NOT NULL,
+    ALTER COLUMN online_price TYPE FLOAT USING online_price::float,
+    ALTER COLUMN offline_price TYPE FLOAT USING offline_price::float,
+    DROP COLUMN currency,
+    DROP COLUMN duration_minutes,
+    DROP COLUMN prep_instructions;
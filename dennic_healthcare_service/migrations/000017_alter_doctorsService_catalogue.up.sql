@@ -0,0 +1,11 @@
+ALTER TABLE doctor_service
+    ALTER COLUMN online_price TYPE NUMERIC(14, 2) USING round(online_price::numeric, 2),
+    ALTER COLUMN offline_price TYPE NUMERIC(14, 2) USING round(offline_price::numeric, 2),
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'UZS',
+    ADD COLUMN duration_minutes INT NOT NULL DEFAULT 30 CHECK (duration_minutes > 0),
+    ADD COLUMN prep_instructions TEXT NOT NULL DEFAULT '';
+
+UPDATE doctor_service
+SET duration_minutes = GREATEST(EXTRACT(HOUR FROM duration)::int * 60 + EXTRACT(MINUTE FROM duration)::int, 1);
+
+ALTER TABLE doctor_service DROP COLUMN duration;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS doctor_reviews
+(
+    id              UUID PRIMARY KEY,
+    doctor_id       UUID NOT NULL REFERENCES doctors(id) ON DELETE CASCADE,
+    patient_id      UUID NOT NULL,
+    appointment_id  BIGINT NOT NULL,
+    rating          SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    comment         TEXT NOT NULL DEFAULT '',
+    status          VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    moderation_note TEXT NOT NULL DEFAULT '',
+    reply           TEXT NOT NULL DEFAULT '',
+    replied_at      TIMESTAMP,
+    created_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP,
+    deleted_at      TIMESTAMP
+    );
+
+CREATE UNIQUE INDEX doctor_reviews_appointment_id_idx ON doctor_reviews(appointment_id) WHERE deleted_at IS NULL;
+CREATE INDEX doctor_reviews_doctor_id_idx ON doctor_reviews(doctor_id, status);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS doctors_rating_idx;
+
+ALTER TABLE doctors
+    DROP COLUMN rating,
+    DROP COLUMN review_count;
@@ -0,0 +1,435 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+const (
+	MinBytes = 10e3 // 10KB
+	MaxBytes = 10e6 // 10MB
+)
+
+// Headers the dead-letter copy of a message carries next to its own ones.
+const (
+	HeaderPrefix            = "dlq-"
+	HeaderOriginalTopic     = HeaderPrefix + "original-topic"
+	HeaderOriginalPartition = HeaderPrefix + "original-partition"
+	HeaderOriginalOffset    = HeaderPrefix + "original-offset"
+	HeaderConsumerGroup     = HeaderPrefix + "consumer-group"
+	HeaderError             = HeaderPrefix + "error"
+	HeaderAttempts          = HeaderPrefix + "attempts"
+	HeaderFailedAt          = HeaderPrefix + "failed-at"
+)
+
+type HandlerFunc func(ctx context.Context, key, value []byte) error
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks a handler error retrying cannot fix, e.g. a message that
+// does not decode, so the message goes to the dead-letter topic at once.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// RetryOptions bound how often a failed message is handled again before it
+// is parked on the dead-letter topic. Backoff doubles after every attempt up
+// to MaxBackoff and paces reader restarts the same way.
+type RetryOptions struct {
+	MaxAttempts      int
+	Backoff          time.Duration
+	MaxBackoff       time.Duration
+	DeadLetterSuffix string
+}
+
+// Config is what the consumer and the replay of dead letters need of the
+// configuration of a service.
+type Config struct {
+	Brokers          []string
+	MaxAttempts      string
+	Backoff          string
+	MaxBackoff       string
+	DeadLetterSuffix string
+}
+
+func NewRetryOptions(cfg Config) (RetryOptions, error) {
+	var options RetryOptions
+
+	maxAttempts, err := strconv.Atoi(cfg.MaxAttempts)
+	if err != nil || maxAttempts < 1 {
+		return options, fmt.Errorf("invalid kafka retry max attempts %q", cfg.MaxAttempts)
+	}
+	options.MaxAttempts = maxAttempts
+	options.Backoff, err = time.ParseDuration(cfg.Backoff)
+	if err != nil {
+		return options, fmt.Errorf("invalid kafka retry backoff: %w", err)
+	}
+	options.MaxBackoff, err = time.ParseDuration(cfg.MaxBackoff)
+	if err != nil {
+		return options, fmt.Errorf("invalid kafka retry max backoff: %w", err)
+	}
+	if cfg.DeadLetterSuffix == "" {
+		return options, errors.New("kafka dead letter suffix is empty")
+	}
+	options.DeadLetterSuffix = cfg.DeadLetterSuffix
+
+	return options, nil
+}
+
+// delay is the pause before the next try after the given failed attempt.
+func (o RetryOptions) delay(attempt int) time.Duration {
+	delay := o.Backoff
+	for i := 1; i < attempt && delay < o.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > o.MaxBackoff {
+		delay = o.MaxBackoff
+	}
+	return delay
+}
+
+type consumer struct {
+	logger          *zap.Logger
+	options         RetryOptions
+	consumerConfigs []ConsumerConfig
+	running         int
+	deadLetters     []*kafka.Writer
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewConsumer(cfg Config, logger *zap.Logger) (*consumer, error) {
+	options, err := NewRetryOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &consumer{
+		logger:  logger,
+		options: options,
+		ctx:     ctx,
+		cancel:  cancel,
+	}, nil
+}
+
+func (c *consumer) RegisterConsumer(consumerConfig ConsumerConfig) {
+	c.consumerConfigs = append(c.consumerConfigs, consumerConfig)
+}
+
+// Run starts a reader for every consumer registered since the last call.
+func (c *consumer) Run() {
+	for _, consumerConfig := range c.consumerConfigs[c.running:] {
+		deadLetters := &kafka.Writer{
+			Addr:                   kafka.TCP(consumerConfig.GetBrokers()...),
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+		}
+		c.deadLetters = append(c.deadLetters, deadLetters)
+
+		c.wg.Add(1)
+		go c.runReader(consumerConfig, deadLetters)
+	}
+	c.running = len(c.consumerConfigs)
+}
+
+// Close stops the readers, leaving the message in hand uncommitted, and
+// waits for them to return.
+func (c *consumer) Close() {
+	c.cancel()
+	c.wg.Wait()
+
+	for _, writer := range c.deadLetters {
+		if err := writer.Close(); err != nil {
+			c.logger.Error("consumer dead letter writer close", zap.Error(err))
+		}
+	}
+}
+
+// runReader keeps a reader consuming until the consumer is closed; a reader
+// that fails to fetch or commit is replaced by a new one after a backoff.
+func (c *consumer) runReader(consumerConfig ConsumerConfig, deadLetters *kafka.Writer) {
+	defer c.wg.Done()
+
+	topic := consumerConfig.GetTopic()
+	for failures := 1; ; failures++ {
+		r := kafka.NewReader(kafka.ReaderConfig{
+			Brokers:  consumerConfig.GetBrokers(),
+			Topic:    topic,
+			GroupID:  consumerConfig.GetGroupID(),
+			MinBytes: MinBytes,
+			MaxBytes: MaxBytes,
+		})
+		consumed, err := c.consume(r, consumerConfig, deadLetters)
+		if err := r.Close(); err != nil {
+			c.logger.Error("consumer reader close", zap.String("topic", topic), zap.Error(err))
+		}
+		if c.ctx.Err() != nil {
+			return
+		}
+		if consumed {
+			failures = 1
+		}
+
+		delay := c.options.delay(failures)
+		c.logger.Error("consumer reader stopped, restarting", zap.String("topic", topic), zap.Duration("after", delay), zap.Error(err))
+		if !sleep(c.ctx, delay) {
+			return
+		}
+	}
+}
+
+// consume handles messages one by one and commits each once it is handled or
+// parked on the dead-letter topic. It reports whether anything was committed.
+func (c *consumer) consume(r *kafka.Reader, consumerConfig ConsumerConfig, deadLetters *kafka.Writer) (bool, error) {
+	consumed := false
+	for {
+		m, err := r.FetchMessage(c.ctx)
+		if err != nil {
+			return consumed, fmt.Errorf("fetch message: %w", err)
+		}
+
+		ctx, span := startConsumerSpan(c.ctx, consumerConfig.GetGroupID(), m)
+		attempts, err := c.handle(ctx, consumerConfig.GetHandler(), m)
+		endConsumerSpan(span, attempts, err)
+		if err != nil {
+			if c.ctx.Err() != nil {
+				return consumed, c.ctx.Err()
+			}
+			c.logger.Error("consumer failed to handle message, sending it to the dead letter topic",
+				zap.ByteString("value", m.Value), zap.String("topic", m.Topic), zap.Int("partition", m.Partition),
+				zap.Int64("offset", m.Offset), zap.Int("attempts", attempts), zap.Error(err))
+
+			dead := deadLetterMessage(m, c.options.DeadLetterSuffix, consumerConfig.GetGroupID(), attempts, err, time.Now())
+			if err := deadLetters.WriteMessages(c.ctx, dead); err != nil {
+				return consumed, fmt.Errorf("write dead letter to %s: %w", dead.Topic, err)
+			}
+		}
+
+		if err := r.CommitMessages(c.ctx, m); err != nil {
+			return consumed, fmt.Errorf("commit message: %w", err)
+		}
+		consumed = true
+	}
+}
+
+// handle runs the handler until it succeeds, fails permanently or runs out of
+// attempts, returning the number of attempts made. ctx carries the span the
+// message is handled in.
+func (c *consumer) handle(ctx context.Context, handler HandlerFunc, m kafka.Message) (int, error) {
+	for attempt := 1; ; attempt++ {
+		err := call(ctx, handler, m)
+		if err == nil {
+			return attempt, nil
+		}
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) || attempt >= c.options.MaxAttempts {
+			return attempt, err
+		}
+
+		delay := c.options.delay(attempt)
+		c.logger.Warn("consumer failed to handle message, retrying", zap.String("topic", m.Topic),
+			zap.Int("partition", m.Partition), zap.Int64("offset", m.Offset), zap.Int("attempt", attempt),
+			zap.Duration("after", delay), zap.Error(err))
+		if !sleep(ctx, delay) {
+			return attempt, ctx.Err()
+		}
+	}
+}
+
+func call(ctx context.Context, handler HandlerFunc, m kafka.Message) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("handler panic: %v", p)
+		}
+	}()
+	return handler(ctx, m.Key, m.Value)
+}
+
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// deadLetterMessage copies a message that could not be handled to the
+// dead-letter topic of its own, recording where it came from and why it failed.
+func deadLetterMessage(m kafka.Message, suffix, groupID string, attempts int, cause error, failedAt time.Time) kafka.Message {
+	headers := make([]kafka.Header, 0, len(m.Headers)+7)
+	for _, header := range m.Headers {
+		if !strings.HasPrefix(header.Key, HeaderPrefix) {
+			headers = append(headers, header)
+		}
+	}
+	headers = append(headers,
+		kafka.Header{Key: HeaderOriginalTopic, Value: []byte(m.Topic)},
+		kafka.Header{Key: HeaderOriginalPartition, Value: []byte(strconv.Itoa(m.Partition))},
+		kafka.Header{Key: HeaderOriginalOffset, Value: []byte(strconv.FormatInt(m.Offset, 10))},
+		kafka.Header{Key: HeaderConsumerGroup, Value: []byte(groupID)},
+		kafka.Header{Key: HeaderError, Value: []byte(cause.Error())},
+		kafka.Header{Key: HeaderAttempts, Value: []byte(strconv.Itoa(attempts))},
+		kafka.Header{Key: HeaderFailedAt, Value: []byte(failedAt.UTC().Format(time.RFC3339))},
+	)
+
+	return kafka.Message{
+		Topic:   m.Topic + suffix,
+		Key:     m.Key,
+		Value:   m.Value,
+		Headers: headers,
+	}
+}
+
+// replayMessage turns a dead letter back into the message that failed, bound
+// for the topic it was read from.
+func replayMessage(m kafka.Message, suffix string) (kafka.Message, error) {
+	var topic string
+	headers := make([]kafka.Header, 0, len(m.Headers))
+	for _, header := range m.Headers {
+		if header.Key == HeaderOriginalTopic {
+			topic = string(header.Value)
+		}
+		if !strings.HasPrefix(header.Key, HeaderPrefix) {
+			headers = append(headers, header)
+		}
+	}
+	if topic == "" {
+		if !strings.HasSuffix(m.Topic, suffix) {
+			return kafka.Message{}, fmt.Errorf("dead letter %s/%d/%d has no %s header", m.Topic, m.Partition, m.Offset, HeaderOriginalTopic)
+		}
+		topic = strings.TrimSuffix(m.Topic, suffix)
+	}
+
+	return kafka.Message{
+		Topic:   topic,
+		Key:     m.Key,
+		Value:   m.Value,
+		Headers: headers,
+	}, nil
+}
+
+// Replay re-drives the messages parked on a dead-letter topic to the topics
+// they failed on. The group commits every replayed dead letter, so a replay
+// picks up where the last one stopped. It ends after limit messages (all of
+// them when limit is 0) or once no message arrives for idle.
+func Replay(ctx context.Context, cfg Config, logger *zap.Logger, topic, groupID string, limit int, idle time.Duration) (int, error) {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  cfg.Brokers,
+		Topic:    topic,
+		GroupID:  groupID,
+		MinBytes: 1,
+		MaxBytes: MaxBytes,
+	})
+	defer r.Close()
+
+	w := &kafka.Writer{
+		Addr:         kafka.TCP(cfg.Brokers...),
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}
+	defer w.Close()
+
+	replayed := 0
+	for limit == 0 || replayed < limit {
+		fetchCtx, cancel := context.WithTimeout(ctx, idle)
+		m, err := r.FetchMessage(fetchCtx)
+		cancel()
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+				break
+			}
+			return replayed, fmt.Errorf("fetch dead letter: %w", err)
+		}
+
+		msg, err := replayMessage(m, cfg.DeadLetterSuffix)
+		if err != nil {
+			return replayed, err
+		}
+		if err := w.WriteMessages(ctx, msg); err != nil {
+			return replayed, fmt.Errorf("replay to %s: %w", msg.Topic, err)
+		}
+		if err := r.CommitMessages(ctx, m); err != nil {
+			return replayed, fmt.Errorf("commit dead letter: %w", err)
+		}
+
+		replayed++
+		logger.Info("replayed dead letter", zap.String("topic", msg.Topic), zap.Int("partition", m.Partition), zap.Int64("offset", m.Offset))
+	}
+
+	return replayed, nil
+}
+
+// ConsumerConfig is a topic to consume and the handler of its messages.
+type ConsumerConfig interface {
+	GetBrokers() []string
+	GetTopic() string
+	GetGroupID() string
+	GetHandler() func(ctx context.Context, key, value []byte) error
+}
+
+type consumerConfig struct {
+	brokers []string
+	topic   string
+	groupID string
+	handler HandlerFunc
+}
+
+func NewConsumerConfig(
+	brokers []string,
+	topic string,
+	groupID string,
+	handler HandlerFunc,
+) ConsumerConfig {
+	return &consumerConfig{
+		brokers: brokers,
+		topic:   topic,
+		groupID: groupID,
+		handler: handler,
+	}
+}
+
+func (c *consumerConfig) GetBrokers() []string {
+	return c.brokers
+}
+
+func (c *consumerConfig) GetTopic() string {
+	return c.topic
+}
+
+func (c *consumerConfig) GetGroupID() string {
+	return c.groupID
+}
+
+func (c *consumerConfig) GetHandler() func(ctx context.Context, key, value []byte) error {
+	return c.handler
+}
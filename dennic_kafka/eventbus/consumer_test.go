@@ -0,0 +1,109 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestRetryDelay(t *testing.T) {
+	options := RetryOptions{Backoff: time.Second, MaxBackoff: 10 * time.Second}
+
+	assert.Equal(t, time.Second, options.delay(1))
+	assert.Equal(t, 2*time.Second, options.delay(2))
+	assert.Equal(t, 8*time.Second, options.delay(4))
+	assert.Equal(t, 10*time.Second, options.delay(5))
+	assert.Equal(t, 10*time.Second, options.delay(100))
+}
+
+func TestHandleRetries(t *testing.T) {
+	c := &consumer{
+		logger:  zap.NewNop(),
+		options: RetryOptions{MaxAttempts: 3, Backoff: time.Millisecond, MaxBackoff: time.Millisecond},
+		ctx:     context.Background(),
+	}
+
+	calls := 0
+	attempts, err := c.handle(c.ctx, func(ctx context.Context, key, value []byte) error {
+		calls++
+		if calls < 2 {
+			return errors.New("database is down")
+		}
+		return nil
+	}, kafka.Message{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+
+	attempts, err = c.handle(c.ctx, func(ctx context.Context, key, value []byte) error {
+		return errors.New("database is down")
+	}, kafka.Message{})
+	assert.Error(t, err)
+	assert.Equal(t, 3, attempts)
+
+	// permanent failures and panics are not retried in vain
+	attempts, err = c.handle(c.ctx, func(ctx context.Context, key, value []byte) error {
+		return Permanent(fmt.Errorf("decode: %w", errors.New("unexpected end of JSON input")))
+	}, kafka.Message{})
+	assert.EqualError(t, err, "decode: unexpected end of JSON input")
+	assert.Equal(t, 1, attempts)
+
+	calls = 0
+	attempts, err = c.handle(c.ctx, func(ctx context.Context, key, value []byte) error {
+		calls++
+		panic("nil map")
+	}, kafka.Message{})
+	assert.EqualError(t, err, "handler panic: nil map")
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestDeadLetterRoundTrip(t *testing.T) {
+	failed := kafka.Message{
+		Topic:     "booking.appointments",
+		Partition: 2,
+		Offset:    41,
+		Key:       []byte("17"),
+		Value:     []byte(`{"type":"appointment.booked"}`),
+		Headers: []kafka.Header{
+			{Key: "traceparent", Value: []byte("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")},
+			{Key: HeaderError, Value: []byte("an earlier failure")},
+		},
+	}
+	failedAt := time.Date(2024, 5, 6, 9, 30, 0, 0, time.FixedZone("UZT", 5*60*60))
+
+	dead := deadLetterMessage(failed, ".dlq", "dennic_notification_service", 5, errors.New("connection refused"), failedAt)
+	assert.Equal(t, "booking.appointments.dlq", dead.Topic)
+	assert.Equal(t, failed.Key, dead.Key)
+	assert.Equal(t, failed.Value, dead.Value)
+	assert.Equal(t, []kafka.Header{
+		{Key: "traceparent", Value: []byte("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")},
+		{Key: HeaderOriginalTopic, Value: []byte("booking.appointments")},
+		{Key: HeaderOriginalPartition, Value: []byte("2")},
+		{Key: HeaderOriginalOffset, Value: []byte("41")},
+		{Key: HeaderConsumerGroup, Value: []byte("dennic_notification_service")},
+		{Key: HeaderError, Value: []byte("connection refused")},
+		{Key: HeaderAttempts, Value: []byte("5")},
+		{Key: HeaderFailedAt, Value: []byte("2024-05-06T04:30:00Z")},
+	}, dead.Headers)
+
+	replay, err := replayMessage(dead, ".dlq")
+	assert.NoError(t, err)
+	assert.Equal(t, "booking.appointments", replay.Topic)
+	assert.Equal(t, failed.Key, replay.Key)
+	assert.Equal(t, failed.Value, replay.Value)
+	assert.Equal(t, failed.Headers[:1], replay.Headers)
+
+	// dead letters written without metadata go back by the topic name
+	replay, err = replayMessage(kafka.Message{Topic: "api.user.create.dlq"}, ".dlq")
+	assert.NoError(t, err)
+	assert.Equal(t, "api.user.create", replay.Topic)
+
+	_, err = replayMessage(kafka.Message{Topic: "api.user.create"}, ".dlq")
+	assert.Error(t, err)
+}
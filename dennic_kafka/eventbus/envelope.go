@@ -0,0 +1,136 @@
+// Package eventbus holds what the dennic services share to publish and consume
+// events on Kafka: the envelope every event is published in, the schemas
+// consumers decode it with, the consumer that retries and dead-letters failed
+// messages, and the trace context carried in message headers.
+package eventbus
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Payload is an event message of dennic_protos/events.
+type Payload interface {
+	Marshal() ([]byte, error)
+}
+
+// NewEnvelope wraps the payload of an event in the envelope every event is
+// published in, with the trace context of ctx.
+func NewEnvelope(ctx context.Context, producer, eventType string, version int32, occurredAt time.Time, payload Payload) ([]byte, error) {
+	if producer == "" || eventType == "" || version < 1 {
+		return nil, fmt.Errorf("invalid event %q version %d of %q", eventType, version, producer)
+	}
+	if occurredAt.IsZero() {
+		return nil, fmt.Errorf("event %s has no occurred at", eventType)
+	}
+
+	value, err := payload.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s payload: %w", eventType, err)
+	}
+
+	envelope := &Envelope{
+		Id:           uuid.NewString(),
+		Type:         eventType,
+		Version:      version,
+		OccurredAt:   occurredAt.Format(time.RFC3339Nano),
+		Producer:     producer,
+		TraceContext: map[string]string{},
+		Payload:      value,
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(envelope.TraceContext))
+
+	return envelope.Marshal()
+}
+
+// TraceContext continues the trace the event was published in. Consumers
+// follow the traceparent header first; the envelope keeps a copy for messages
+// whose headers are lost, e.g. ones published before producers set them.
+func TraceContext(ctx context.Context, envelope *Envelope) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(envelope.TraceContext))
+}
+
+// Upcaster turns the payload of one version into the payload of the next.
+type Upcaster func(payload []byte) ([]byte, error)
+
+// Schema is the version of an event type a consumer knows; Upcasters[v]
+// brings a payload of version v up to v+1.
+type Schema struct {
+	Version   int32
+	Upcasters map[int32]Upcaster
+}
+
+// Legacy wraps a JSON message published before the envelope in an envelope
+// of version 0.
+type Legacy func(value []byte) (*Envelope, error)
+
+// LegacyId is the event id of a message published without one, the same on
+// every redelivery of it.
+func LegacyId(value []byte) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, value).String()
+}
+
+// Schemas are the event types the consumer of a topic accepts.
+type Schemas struct {
+	types  map[string]Schema
+	legacy Legacy
+}
+
+// NewSchemas accepts legacy JSON messages only when legacy is set.
+func NewSchemas(types map[string]Schema, legacy Legacy) *Schemas {
+	return &Schemas{
+		types:  types,
+		legacy: legacy,
+	}
+}
+
+// Decode unwraps an event, checks it is one the consumer knows and upcasts
+// its payload to the known version. Retrying does not fix its errors.
+func (s *Schemas) Decode(value []byte) (*Envelope, error) {
+	envelope := &Envelope{}
+	if trimmed := bytes.TrimSpace(value); s.legacy != nil && len(trimmed) > 0 && trimmed[0] == '{' {
+		legacy, err := s.legacy(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("legacy event: %w", err)
+		}
+		envelope = legacy
+	} else if err := envelope.Unmarshal(value); err != nil {
+		return nil, fmt.Errorf("unmarshal envelope: %w", err)
+	}
+
+	if envelope.Id == "" {
+		return nil, errors.New("event has no id")
+	}
+	schema, ok := s.types[envelope.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown event type %q", envelope.Type)
+	}
+	if envelope.Version > schema.Version {
+		return nil, fmt.Errorf("event %s version %d is newer than the known %d", envelope.Type, envelope.Version, schema.Version)
+	}
+	if _, err := time.Parse(time.RFC3339, envelope.OccurredAt); err != nil {
+		return nil, fmt.Errorf("event %s occurred at: %w", envelope.Type, err)
+	}
+
+	for envelope.Version < schema.Version {
+		upcast, ok := schema.Upcasters[envelope.Version]
+		if !ok {
+			return nil, fmt.Errorf("event %s version %d can not be upcast", envelope.Type, envelope.Version)
+		}
+		payload, err := upcast(envelope.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("upcast %s version %d: %w", envelope.Type, envelope.Version, err)
+		}
+		envelope.Payload = payload
+		envelope.Version++
+	}
+
+	return envelope, nil
+}
@@ -0,0 +1,118 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// appointment stands in for a payload of dennic_protos/events.
+type appointment struct {
+	Id       int64  `json:"id"`
+	Status   string `json:"status"`
+	Modality string `json:"modality,omitempty"`
+}
+
+func (a *appointment) Marshal() ([]byte, error) {
+	return json.Marshal(a)
+}
+
+func TestEnvelope(t *testing.T) {
+	schemas := NewSchemas(map[string]Schema{
+		"appointment.created": {
+			Version: 2,
+			Upcasters: map[int32]Upcaster{
+				0: func(payload []byte) ([]byte, error) {
+					var legacy struct {
+						Id     int64  `json:"appointment_id"`
+						Status string `json:"status"`
+					}
+					if err := json.Unmarshal(payload, &legacy); err != nil {
+						return nil, err
+					}
+					return (&appointment{Id: legacy.Id, Status: legacy.Status}).Marshal()
+				},
+				1: func(payload []byte) ([]byte, error) {
+					var a appointment
+					if err := json.Unmarshal(payload, &a); err != nil {
+						return nil, err
+					}
+					a.Modality = "offline"
+					return a.Marshal()
+				},
+			},
+		},
+	}, func(value []byte) (*Envelope, error) {
+		var message struct {
+			Type        string          `json:"type"`
+			Appointment json.RawMessage `json:"appointment"`
+		}
+		if err := json.Unmarshal(value, &message); err != nil {
+			return nil, err
+		}
+		return &Envelope{
+			Id:         LegacyId(value),
+			Type:       message.Type,
+			OccurredAt: "2024-05-06T09:30:00+05:00",
+			Payload:    message.Appointment,
+		}, nil
+	})
+	occurredAt := time.Date(2024, 5, 6, 9, 30, 0, 0, time.UTC)
+
+	value, err := NewEnvelope(context.Background(), "dennic_booking_service", "appointment.created", 2, occurredAt,
+		&appointment{Id: 17, Status: "booked", Modality: "online"})
+	assert.NoError(t, err)
+
+	envelope, err := schemas.Decode(value)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, envelope.Id)
+	assert.Equal(t, "appointment.created", envelope.Type)
+	assert.Equal(t, int32(2), envelope.Version)
+	assert.Equal(t, "2024-05-06T09:30:00Z", envelope.OccurredAt)
+	assert.Equal(t, "dennic_booking_service", envelope.Producer)
+
+	var decoded appointment
+	assert.NoError(t, json.Unmarshal(envelope.Payload, &decoded))
+	assert.Equal(t, "online", decoded.Modality)
+
+	// older versions are upcast step by step
+	value, err = NewEnvelope(context.Background(), "dennic_booking_service", "appointment.created", 1, occurredAt,
+		&appointment{Id: 17, Status: "booked"})
+	assert.NoError(t, err)
+	envelope, err = schemas.Decode(value)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), envelope.Version)
+	assert.NoError(t, json.Unmarshal(envelope.Payload, &decoded))
+	assert.Equal(t, "offline", decoded.Modality)
+
+	legacy := []byte(`{"type":"appointment.created","appointment":{"appointment_id":17,"status":"booked"}}`)
+	envelope, err = schemas.Decode(legacy)
+	assert.NoError(t, err)
+	assert.Equal(t, LegacyId(legacy), envelope.Id)
+	assert.Equal(t, int32(2), envelope.Version)
+	decoded = appointment{}
+	assert.NoError(t, json.Unmarshal(envelope.Payload, &decoded))
+	assert.Equal(t, int64(17), decoded.Id)
+	assert.Equal(t, "booked", decoded.Status)
+	assert.Equal(t, "offline", decoded.Modality)
+
+	// events the consumer does not know are refused
+	value, err = NewEnvelope(context.Background(), "dennic_booking_service", "appointment.created", 3, occurredAt, &appointment{})
+	assert.NoError(t, err)
+	_, err = schemas.Decode(value)
+	assert.EqualError(t, err, "event appointment.created version 3 is newer than the known 2")
+
+	value, err = NewEnvelope(context.Background(), "dennic_booking_service", "appointment.moved", 1, occurredAt, &appointment{})
+	assert.NoError(t, err)
+	_, err = schemas.Decode(value)
+	assert.EqualError(t, err, `unknown event type "appointment.moved"`)
+
+	_, err = schemas.Decode([]byte{0xff, 0xff})
+	assert.Error(t, err)
+
+	_, err = NewEnvelope(context.Background(), "", "appointment.created", 1, occurredAt, &appointment{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,95 @@
+package eventbus
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// headerCarrier lets the propagator read and write the W3C traceparent and
+// tracestate headers of a message.
+type headerCarrier struct {
+	headers *[]kafka.Header
+}
+
+func (c headerCarrier) Get(key string) string {
+	for _, header := range *c.headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (c headerCarrier) Set(key, value string) {
+	for i, header := range *c.headers {
+		if header.Key == key {
+			(*c.headers)[i].Value = []byte(value)
+			return
+		}
+	}
+	*c.headers = append(*c.headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(*c.headers))
+	for _, header := range *c.headers {
+		keys = append(keys, header.Key)
+	}
+	return keys
+}
+
+// InjectTraceContext puts the trace of ctx on the headers of m for the
+// consumer to continue.
+func InjectTraceContext(ctx context.Context, m *kafka.Message) {
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier{headers: &m.Headers})
+}
+
+// ExtractTraceContext continues the trace the headers of m carry. A message
+// published before producers set the headers continues the trace of its
+// envelope instead.
+func ExtractTraceContext(ctx context.Context, m kafka.Message) context.Context {
+	headers := m.Headers
+	traced := otel.GetTextMapPropagator().Extract(ctx, headerCarrier{headers: &headers})
+	if trace.SpanContextFromContext(traced).IsValid() {
+		return traced
+	}
+
+	var envelope Envelope
+	if err := envelope.Unmarshal(m.Value); err != nil {
+		return ctx
+	}
+	return TraceContext(ctx, &envelope)
+}
+
+// startConsumerSpan starts the span a message is handled in, a child of the
+// span that published it.
+func startConsumerSpan(ctx context.Context, groupID string, m kafka.Message) (context.Context, trace.Span) {
+	return otel.Tracer("kafka consumer").Start(ExtractTraceContext(ctx, m), m.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "kafka"),
+			attribute.String("messaging.operation", "process"),
+			attribute.String("messaging.destination.name", m.Topic),
+			attribute.String("messaging.kafka.consumer.group", groupID),
+			attribute.String("messaging.kafka.message.key", string(m.Key)),
+			attribute.String("messaging.kafka.destination.partition", strconv.Itoa(m.Partition)),
+			attribute.Int64("messaging.kafka.message.offset", m.Offset),
+		),
+	)
+}
+
+// endConsumerSpan records how handling the message went and ends its span.
+func endConsumerSpan(span trace.Span, attempts int, err error) {
+	span.SetAttributes(attribute.Int("messaging.kafka.attempts", attempts))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,51 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceContext(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	traceId, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanId, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	published := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceId,
+		SpanID:     spanId,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), published)
+
+	m := kafka.Message{Key: []byte("17"), Headers: []kafka.Header{{Key: "content-type", Value: []byte("application/x-protobuf")}}}
+	InjectTraceContext(ctx, &m)
+	assert.Len(t, m.Headers, 2)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", headerCarrier{headers: &m.Headers}.Get("traceparent"))
+
+	continued := trace.SpanContextFromContext(ExtractTraceContext(context.Background(), m))
+	assert.True(t, continued.IsRemote())
+	assert.Equal(t, traceId, continued.TraceID())
+	assert.Equal(t, spanId, continued.SpanID())
+
+	// injecting again replaces the header instead of adding another one
+	InjectTraceContext(ctx, &m)
+	assert.Len(t, m.Headers, 2)
+
+	// a message without the header continues the trace of its envelope
+	value, err := NewEnvelope(ctx, "dennic_booking_service", "appointment.created", 1, time.Now(),
+		&appointment{Id: 17})
+	assert.NoError(t, err)
+	continued = trace.SpanContextFromContext(ExtractTraceContext(context.Background(), kafka.Message{Value: value}))
+	assert.Equal(t, traceId, continued.TraceID())
+
+	// and one without either starts a new trace
+	continued = trace.SpanContextFromContext(ExtractTraceContext(context.Background(), kafka.Message{Value: []byte("{}")}))
+	assert.False(t, continued.IsValid())
+}
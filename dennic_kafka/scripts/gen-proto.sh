@@ -0,0 +1,14 @@
+#!/bin/bash
+CURRENT_DIR=$(pwd)
+
+protoc -I /usr/local/include \
+       -I "$GOPATH"/pkg/mod/github.com/gogo/protobuf@v1.3.2 \
+       -I "$CURRENT_DIR"/ \
+        --gofast_out=plugins=grpc,paths=source_relative:"$CURRENT_DIR"/ \
+        "$CURRENT_DIR"/eventbus/*.proto;
+
+if [[ "$OSTYPE" == "darwin"* ]]; then
+  sed -i "" -e "s/,omitempty//g" "$CURRENT_DIR"/eventbus/*.pb.go
+else
+  sed -i -e "s/,omitempty//g" "$CURRENT_DIR"/eventbus/*.pb.go
+fi
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"dennic_notification_service/internal/app"
+	"dennic_notification_service/internal/pkg/config"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	// branches' time zones are resolved without the system's zoneinfo
+	_ "time/tzdata"
+
+	"go.uber.org/zap"
+)
+
+func main() {
+	// initialization config
+	config := config.New()
+
+	// initialization app
+	app, err := app.NewApp(config)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// runing
+	go func() {
+		if err := app.Run(); err != nil {
+			app.Logger.Error("app run", zap.Error(err))
+		}
+	}()
+
+	// graceful shutdown
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	<-sigs
+
+	app.Logger.Info("Notification service stops !")
+
+	// app stops
+	app.Stop()
+
+}
@@ -0,0 +1,332 @@
+package app
+
+import (
+	"context"
+	"dennic_kafka/eventbus"
+	pb "dennic_notification_service/genproto/notification_service"
+	grpc_server "dennic_notification_service/internal/delivery/grpc/server"
+	invest_grpc "dennic_notification_service/internal/delivery/grpc/services"
+	"dennic_notification_service/internal/delivery/kafka/handlers"
+	"dennic_notification_service/internal/infrastructure/channel"
+	"dennic_notification_service/internal/infrastructure/grpc_service_clients"
+	"dennic_notification_service/internal/infrastructure/kafka"
+	repo "dennic_notification_service/internal/infrastructure/repository/postgresql"
+	"dennic_notification_service/internal/infrastructure/templates"
+	"dennic_notification_service/internal/infrastructure/webhook"
+	"dennic_notification_service/internal/pkg/config"
+	"dennic_notification_service/internal/pkg/logger"
+	"dennic_notification_service/internal/pkg/otlp"
+	"dennic_notification_service/internal/pkg/postgres"
+	"dennic_notification_service/internal/usecase"
+	"dennic_notification_service/internal/usecase/event"
+	"fmt"
+	"strconv"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
+	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+type App struct {
+	Config         *config.Config
+	Logger         *zap.Logger
+	DB             *postgres.PostgresDB
+	GrpcServer     *grpc.Server
+	ShutdownOTLP   func() error
+	ServiceClients grpc_service_clients.ServiceClients
+	BrokerConsumer event.BrokerConsumer
+	BrokerProducer event.BrokerProducer
+	stopWorkers    context.CancelFunc
+}
+
+func NewApp(cfg *config.Config) (*App, error) {
+	// init logger
+	logger, err := logger.New(cfg.LogLevel, cfg.Environment, cfg.APP+".log")
+	if err != nil {
+		return nil, err
+	}
+
+	kafkaConsumer, err := eventbus.NewConsumer(eventbus.Config{
+		Brokers:          cfg.Kafka.Address,
+		MaxAttempts:      cfg.Kafka.Retry.MaxAttempts,
+		Backoff:          cfg.Kafka.Retry.Backoff,
+		MaxBackoff:       cfg.Kafka.Retry.MaxBackoff,
+		DeadLetterSuffix: cfg.Kafka.DeadLetterSuffix,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	kafkaProducer := kafka.NewProducer(cfg, logger)
+
+	// otlp collector initialization
+	shutdownOTLP, err := otlp.InitOTLPProvider(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// init db
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// grpc server init
+	grpcServer := grpc.NewServer(
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			grpc_ctxtags.StreamServerInterceptor(),
+			grpc_zap.StreamServerInterceptor(logger),
+			grpc_recovery.StreamServerInterceptor(),
+		)),
+		grpc.UnaryInterceptor(grpc_server.UnaryInterceptor(
+			grpc_middleware.ChainUnaryServer(
+				grpc_ctxtags.UnaryServerInterceptor(),
+				grpc_zap.UnaryServerInterceptor(logger),
+				grpc_recovery.UnaryServerInterceptor(),
+			),
+			grpc_server.UnaryInterceptorData(logger),
+		)),
+	)
+
+	return &App{
+		Config:         cfg,
+		Logger:         logger,
+		DB:             db,
+		GrpcServer:     grpcServer,
+		ShutdownOTLP:   shutdownOTLP,
+		BrokerConsumer: kafkaConsumer,
+		BrokerProducer: kafkaProducer,
+	}, nil
+}
+
+func (a *App) Run() error {
+	// context timeout initialization
+	contextTimeout, err := time.ParseDuration(a.Config.Context.Timeout)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for context timeout : %w", err)
+	}
+
+	// reminder options initialization
+	options, sweepInterval, err := reminderOptions(a.Config)
+	if err != nil {
+		return err
+	}
+
+	// webhook options initialization
+	hookOptions, webhookSweepInterval, err := webhookOptions(a.Config)
+	if err != nil {
+		return err
+	}
+
+	// Initialize Service Clients
+	serviceClients, err := grpc_service_clients.New(a.Config)
+	if err != nil {
+		return fmt.Errorf("error during initialize service clients: %w", err)
+	}
+	a.ServiceClients = serviceClients
+
+	// channel providers initialization
+	senders, err := channel.New(a.Config, a.Logger)
+	if err != nil {
+		return fmt.Errorf("error during initialize notification channels: %w", err)
+	}
+	messageTemplates, err := templates.New()
+	if err != nil {
+		return fmt.Errorf("error during parse message templates: %w", err)
+	}
+
+	// repositories initialization
+	preferencesRepo := repo.NewNotificationPreferences(a.DB)
+	remindersRepo := repo.NewNotificationReminders(a.DB)
+	deliveriesRepo := repo.NewNotificationDeliveries(a.DB)
+	processedEventsRepo := repo.NewProcessedEvents(a.DB)
+	inboxRepo := repo.NewNotificationInbox(a.DB)
+	webhooksRepo := repo.NewNotificationWebhooks(a.DB)
+
+	// usecase initialization
+	usecaseSenders := make([]usecase.Sender, 0, len(senders))
+	for _, sender := range senders {
+		usecaseSenders = append(usecaseSenders, sender)
+	}
+	directory := grpc_service_clients.NewDirectory(serviceClients)
+	notificationUseCase := usecase.NewNotification(preferencesRepo, remindersRepo, deliveriesRepo,
+		directory, messageTemplates, usecaseSenders, options, contextTimeout)
+	inboxUseCase := usecase.NewInbox(inboxRepo, preferencesRepo, directory, messageTemplates,
+		a.BrokerProducer, options, contextTimeout)
+	webhooksUseCase := usecase.NewWebhooks(webhooksRepo, webhook.New(hookOptions.Timeout), hookOptions, contextTimeout)
+
+	pb.RegisterNotificationServiceServer(a.GrpcServer, invest_grpc.NotificationNewRPC(a.Logger, notificationUseCase, inboxUseCase))
+	pb.RegisterWebhookServiceServer(a.GrpcServer, invest_grpc.WebhookNewRPC(a.Logger, webhooksUseCase))
+
+	// appointment events from the booking service schedule the reminders
+	// and fill the inboxes
+	if err := handlers.NewAppointmentHandler(a.Config, a.BrokerConsumer, a.Logger, notificationUseCase, inboxUseCase, processedEventsRepo).HandlerEvents(); err != nil {
+		return fmt.Errorf("error during run appointment consumer: %w", err)
+	}
+	// released lab results and issued prescriptions reach the patient's inbox
+	if err := handlers.NewLabOrderHandler(a.Config, a.BrokerConsumer, a.Logger, inboxUseCase, processedEventsRepo).HandlerEvents(); err != nil {
+		return fmt.Errorf("error during run lab order consumer: %w", err)
+	}
+	if err := handlers.NewPrescriptionHandler(a.Config, a.BrokerConsumer, a.Logger, inboxUseCase, processedEventsRepo).HandlerEvents(); err != nil {
+		return fmt.Errorf("error during run prescription consumer: %w", err)
+	}
+	// patients registered through the gateway are welcomed
+	if err := handlers.NewUserCreatedHandler(a.Config, a.BrokerConsumer, a.Logger, notificationUseCase, processedEventsRepo).HandlerEvents(); err != nil {
+		return fmt.Errorf("error during run user created consumer: %w", err)
+	}
+	// partners are told about the events they subscribed to
+	if err := handlers.NewWebhookHandler(a.Config, a.BrokerConsumer, a.Logger, webhooksUseCase, processedEventsRepo).HandlerEvents(); err != nil {
+		return fmt.Errorf("error during run webhook consumer: %w", err)
+	}
+
+	// due reminders are rendered and their deliveries sent
+	workers, stopWorkers := context.WithCancel(context.Background())
+	a.stopWorkers = stopWorkers
+	go a.sendReminders(workers, notificationUseCase, sweepInterval)
+	go a.sendWebhooks(workers, webhooksUseCase, webhookSweepInterval)
+
+	a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
+
+	if err := grpc_server.Run(a.Config, a.GrpcServer); err != nil {
+		return fmt.Errorf("gRPC fatal to serve grpc server over %s %w", a.Config.RPCPort, err)
+	}
+
+	return nil
+}
+
+func reminderOptions(cfg *config.Config) (usecase.ReminderOptions, time.Duration, error) {
+	var options usecase.ReminderOptions
+
+	sweepInterval, err := time.ParseDuration(cfg.Reminder.SweepInterval)
+	if err != nil {
+		return options, 0, fmt.Errorf("invalid reminder sweep interval: %w", err)
+	}
+	options.RetryBackoff, err = time.ParseDuration(cfg.Reminder.RetryBackoff)
+	if err != nil {
+		return options, 0, fmt.Errorf("invalid reminder retry backoff: %w", err)
+	}
+	options.BatchSize, err = strconv.ParseUint(cfg.Reminder.BatchSize, 10, 64)
+	if err != nil {
+		return options, 0, fmt.Errorf("invalid reminder batch size: %w", err)
+	}
+	maxAttempts, err := strconv.ParseInt(cfg.Reminder.MaxAttempts, 10, 32)
+	if err != nil {
+		return options, 0, fmt.Errorf("invalid reminder max attempts: %w", err)
+	}
+	options.MaxAttempts = int32(maxAttempts)
+	options.Location, err = time.LoadLocation(cfg.Reminder.TimeZone)
+	if err != nil {
+		return options, 0, fmt.Errorf("invalid reminder time zone: %w", err)
+	}
+	options.Language = cfg.Reminder.DefaultLanguage
+
+	return options, sweepInterval, nil
+}
+
+func webhookOptions(cfg *config.Config) (usecase.WebhookOptions, time.Duration, error) {
+	var options usecase.WebhookOptions
+
+	sweepInterval, err := time.ParseDuration(cfg.Webhook.SweepInterval)
+	if err != nil {
+		return options, 0, fmt.Errorf("invalid webhook sweep interval: %w", err)
+	}
+	options.RetryBackoff, err = time.ParseDuration(cfg.Webhook.RetryBackoff)
+	if err != nil {
+		return options, 0, fmt.Errorf("invalid webhook retry backoff: %w", err)
+	}
+	options.Timeout, err = time.ParseDuration(cfg.Webhook.Timeout)
+	if err != nil {
+		return options, 0, fmt.Errorf("invalid webhook timeout: %w", err)
+	}
+	options.BatchSize, err = strconv.ParseUint(cfg.Webhook.BatchSize, 10, 64)
+	if err != nil {
+		return options, 0, fmt.Errorf("invalid webhook batch size: %w", err)
+	}
+	maxAttempts, err := strconv.ParseInt(cfg.Webhook.MaxAttempts, 10, 32)
+	if err != nil {
+		return options, 0, fmt.Errorf("invalid webhook max attempts: %w", err)
+	}
+	options.MaxAttempts = int32(maxAttempts)
+
+	return options, sweepInterval, nil
+}
+
+func (a *App) sendReminders(ctx context.Context, notifications usecase.Notification, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			dispatched, err := notifications.DispatchDueReminders(ctx, now)
+			if err != nil {
+				a.Logger.Error("dispatch due reminders", zap.Error(err))
+			}
+			if dispatched > 0 {
+				a.Logger.Info("dispatched reminders", zap.Int("count", dispatched))
+			}
+
+			sent, err := notifications.SendPendingDeliveries(ctx, time.Now())
+			if err != nil {
+				a.Logger.Error("send pending deliveries", zap.Error(err))
+			}
+			if sent > 0 {
+				a.Logger.Info("sent notifications", zap.Int("count", sent))
+			}
+		}
+	}
+}
+
+func (a *App) sendWebhooks(ctx context.Context, webhooks usecase.Webhooks, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			delivered, err := webhooks.SendPendingWebhooks(ctx, now)
+			if err != nil {
+				a.Logger.Error("send pending webhooks", zap.Error(err))
+			}
+			if delivered > 0 {
+				a.Logger.Info("delivered webhooks", zap.Int("count", delivered))
+			}
+		}
+	}
+}
+
+func (a *App) Stop() {
+	// stop background workers
+	if a.stopWorkers != nil {
+		a.stopWorkers()
+	}
+	// close broker consumer
+	a.BrokerConsumer.Close()
+	// close broker producer
+	a.BrokerProducer.Close()
+	// closing client service connections
+	if a.ServiceClients != nil {
+		a.ServiceClients.Close()
+	}
+	// stop gRPC server
+	a.GrpcServer.Stop()
+
+	// database connection
+	a.DB.Close()
+
+	// shutdown otlp collector
+	if err := a.ShutdownOTLP(); err != nil {
+		a.Logger.Error("shutdown otlp collector", zap.Error(err))
+	}
+
+	// zap logger sync
+	a.Logger.Sync()
+}
@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	pb "dennic_notification_service/genproto/notification_service"
+	"dennic_notification_service/internal/delivery/grpc"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/pkg/otlp"
+	"dennic_notification_service/internal/usecase"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	serviceNameNotification     = "NotificationService"
+	spanNameNotificationService = "NotificationService"
+)
+
+type NotificationRPC struct {
+	logger              *zap.Logger
+	notificationUseCase usecase.Notification
+	inboxUseCase        usecase.Inbox
+}
+
+func NotificationNewRPC(logger *zap.Logger, notificationUseCase usecase.Notification, inboxUseCase usecase.Inbox) *NotificationRPC {
+	return &NotificationRPC{
+		logger:              logger,
+		notificationUseCase: notificationUseCase,
+		inboxUseCase:        inboxUseCase,
+	}
+}
+
+// formatTime writes the absolute instants of notifications with their offset;
+// unset times are empty.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func preferencesToPb(preferences *entity.Preferences) *pb.NotificationPreferences {
+	return &pb.NotificationPreferences{
+		UserId:       preferences.UserId,
+		Language:     preferences.Language,
+		Push:         preferences.Push,
+		Sms:          preferences.Sms,
+		Email:        preferences.Email,
+		EmailAddress: preferences.EmailAddress,
+		Reminders:    preferences.Reminders,
+		UpdatedAt:    formatTime(preferences.UpdatedAt),
+	}
+}
+
+func (r *NotificationRPC) GetPreferences(ctx context.Context, req *pb.NotificationUserReq) (*pb.NotificationPreferences, error) {
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotificationService+"GetPreferences")
+	span.SetAttributes(attribute.Key("user_id").String(req.UserId))
+	defer span.End()
+
+	preferences, err := r.notificationUseCase.GetPreferences(ctx, req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return preferencesToPb(preferences), nil
+}
+
+func (r *NotificationRPC) UpdatePreferences(ctx context.Context, req *pb.NotificationPreferences) (*pb.NotificationPreferences, error) {
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotificationService+"UpdatePreferences")
+	span.SetAttributes(attribute.Key("user_id").String(req.UserId))
+	defer span.End()
+
+	preferences, err := r.notificationUseCase.UpdatePreferences(ctx, &entity.Preferences{
+		UserId:       req.UserId,
+		Language:     req.Language,
+		Push:         req.Push,
+		Sms:          req.Sms,
+		Email:        req.Email,
+		EmailAddress: req.EmailAddress,
+		Reminders:    req.Reminders,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return preferencesToPb(preferences), nil
+}
+
+func (r *NotificationRPC) GetAllDeliveries(ctx context.Context, req *pb.GetAllDeliveriesReq) (*pb.Deliveries, error) {
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotificationService+"GetAllDeliveries")
+	defer span.End()
+
+	list, err := r.notificationUseCase.GetAllDeliveries(ctx, &entity.GetAllDeliveries{
+		Page:          req.Page,
+		Limit:         req.Limit,
+		UserId:        req.UserId,
+		PatientId:     req.PatientId,
+		AppointmentId: req.AppointmentId,
+		Channel:       req.Channel,
+		Status:        req.Status,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res := &pb.Deliveries{Count: list.Count}
+	for _, delivery := range list.Deliveries {
+		res.Deliveries = append(res.Deliveries, &pb.Delivery{
+			Id:            delivery.Id,
+			ReminderId:    delivery.ReminderId,
+			AppointmentId: delivery.AppointmentId,
+			Kind:          delivery.Kind,
+			UserId:        delivery.UserId,
+			PatientId:     delivery.PatientId,
+			Channel:       delivery.Channel,
+			Recipient:     delivery.Recipient,
+			Language:      delivery.Language,
+			Title:         delivery.Title,
+			Body:          delivery.Body,
+			Status:        delivery.Status,
+			Attempts:      delivery.Attempts,
+			LastError:     delivery.LastError,
+			ProviderRef:   delivery.ProviderRef,
+			NextAttemptAt: formatTime(delivery.NextAttemptAt),
+			SentAt:        formatTime(delivery.SentAt),
+			CreatedAt:     formatTime(delivery.CreatedAt),
+		})
+	}
+
+	return res, nil
+}
+
+func (r *NotificationRPC) GetAppointmentReminders(ctx context.Context, req *pb.AppointmentRemindersReq) (*pb.Reminders, error) {
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotificationService+"GetAppointmentReminders")
+	span.SetAttributes(attribute.Key("appointment_id").Int64(req.AppointmentId))
+	defer span.End()
+
+	reminders, err := r.notificationUseCase.GetAppointmentReminders(ctx, req.AppointmentId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res := &pb.Reminders{}
+	for _, reminder := range reminders {
+		res.Reminders = append(res.Reminders, &pb.Reminder{
+			Id:            reminder.Id,
+			AppointmentId: reminder.AppointmentId,
+			Kind:          reminder.Kind,
+			PatientId:     reminder.PatientId,
+			StartsAt:      formatTime(reminder.StartsAt),
+			SendAt:        formatTime(reminder.SendAt),
+			Status:        reminder.Status,
+			UpdatedAt:     formatTime(reminder.UpdatedAt),
+		})
+	}
+
+	return res, nil
+}
+
+func (r *NotificationRPC) GetInbox(ctx context.Context, req *pb.GetInboxReq) (*pb.Inbox, error) {
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotificationService+"GetInbox")
+	span.SetAttributes(attribute.Key("user_id").String(req.UserId))
+	defer span.End()
+
+	list, err := r.inboxUseCase.GetInbox(ctx, &entity.GetInbox{
+		Page:       req.Page,
+		Limit:      req.Limit,
+		UserId:     req.UserId,
+		UnreadOnly: req.Unread,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res := &pb.Inbox{Count: list.Count, Unread: list.Unread}
+	for _, item := range list.Items {
+		res.Notifications = append(res.Notifications, &pb.InboxNotification{
+			Id:        item.Id,
+			UserId:    item.UserId,
+			Kind:      item.Kind,
+			Title:     item.Title,
+			Body:      item.Body,
+			Link:      item.Link,
+			Data:      item.Data,
+			Read:      !item.ReadAt.IsZero(),
+			ReadAt:    formatTime(item.ReadAt),
+			CreatedAt: formatTime(item.CreatedAt),
+		})
+	}
+
+	return res, nil
+}
+
+func (r *NotificationRPC) MarkInboxRead(ctx context.Context, req *pb.MarkInboxReadReq) (*pb.UnreadCount, error) {
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotificationService+"MarkInboxRead")
+	span.SetAttributes(attribute.Key("user_id").String(req.UserId))
+	defer span.End()
+
+	count, err := r.inboxUseCase.MarkInboxRead(ctx, &entity.MarkInboxRead{
+		UserId: req.UserId,
+		Ids:    req.Ids,
+		All:    req.All,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.UnreadCount{UserId: count.UserId, Unread: count.Unread}, nil
+}
+
+func (r *NotificationRPC) GetUnreadCount(ctx context.Context, req *pb.NotificationUserReq) (*pb.UnreadCount, error) {
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotificationService+"GetUnreadCount")
+	span.SetAttributes(attribute.Key("user_id").String(req.UserId))
+	defer span.End()
+
+	count, err := r.inboxUseCase.GetUnreadCount(ctx, req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.UnreadCount{UserId: count.UserId, Unread: count.Unread}, nil
+}
@@ -0,0 +1,341 @@
+package services
+
+import (
+	"context"
+	pb "dennic_notification_service/genproto/notification_service"
+	"dennic_notification_service/internal/delivery/grpc"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/pkg/otlp"
+	"dennic_notification_service/internal/usecase"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	serviceNameWebhook     = "WebhookService"
+	spanNameWebhookService = "WebhookService"
+)
+
+type WebhookRPC struct {
+	logger          *zap.Logger
+	webhooksUseCase usecase.Webhooks
+}
+
+func WebhookNewRPC(logger *zap.Logger, webhooksUseCase usecase.Webhooks) *WebhookRPC {
+	return &WebhookRPC{
+		logger:          logger,
+		webhooksUseCase: webhooksUseCase,
+	}
+}
+
+func partnerToPb(partner *entity.WebhookPartner) *pb.WebhookPartner {
+	return &pb.WebhookPartner{
+		Id:        partner.Id,
+		Name:      partner.Name,
+		CreatedAt: formatTime(partner.CreatedAt),
+	}
+}
+
+func partnerPatientToPb(link *entity.WebhookPartnerPatient) *pb.WebhookPartnerPatient {
+	return &pb.WebhookPartnerPatient{
+		PartnerId: link.PartnerId,
+		PatientId: link.PatientId,
+		CreatedAt: formatTime(link.CreatedAt),
+	}
+}
+
+func subscriptionToPb(subscription *entity.WebhookSubscription) *pb.WebhookSubscription {
+	return &pb.WebhookSubscription{
+		Id:          subscription.Id,
+		PartnerId:   subscription.PartnerId,
+		Url:         subscription.Url,
+		EventTypes:  subscription.EventTypes,
+		Description: subscription.Description,
+		Active:      subscription.Active,
+		Secret:      subscription.Secret,
+		CreatedAt:   formatTime(subscription.CreatedAt),
+		UpdatedAt:   formatTime(subscription.UpdatedAt),
+	}
+}
+
+func webhookDeliveryToPb(delivery *entity.WebhookDelivery) *pb.WebhookDelivery {
+	res := &pb.WebhookDelivery{
+		Id:             delivery.Id,
+		SubscriptionId: delivery.SubscriptionId,
+		EventId:        delivery.EventId,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseCode:   delivery.ResponseCode,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  formatTime(delivery.NextAttemptAt),
+		DeliveredAt:    formatTime(delivery.DeliveredAt),
+		CreatedAt:      formatTime(delivery.CreatedAt),
+	}
+	for _, attempt := range delivery.AttemptLog {
+		res.AttemptLog = append(res.AttemptLog, &pb.WebhookAttempt{
+			Attempt:      attempt.Attempt,
+			ResponseCode: attempt.ResponseCode,
+			Error:        attempt.Error,
+			DurationMs:   attempt.Duration.Milliseconds(),
+			CreatedAt:    formatTime(attempt.CreatedAt),
+		})
+	}
+
+	return res
+}
+
+func (r *WebhookRPC) CreatePartner(ctx context.Context, req *pb.WebhookPartner) (*pb.WebhookPartner, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"CreatePartner")
+	defer span.End()
+
+	partner, err := r.webhooksUseCase.CreatePartner(ctx, &entity.WebhookPartner{
+		Name: req.Name,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return partnerToPb(partner), nil
+}
+
+func (r *WebhookRPC) GetAllPartners(ctx context.Context, req *pb.GetAllWebhookPartnersReq) (*pb.WebhookPartners, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"GetAllPartners")
+	defer span.End()
+
+	list, err := r.webhooksUseCase.GetAllPartners(ctx, &entity.GetAllWebhookPartners{
+		Page:  req.Page,
+		Limit: req.Limit,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res := &pb.WebhookPartners{Count: list.Count}
+	for _, partner := range list.Partners {
+		res.Partners = append(res.Partners, partnerToPb(partner))
+	}
+
+	return res, nil
+}
+
+func (r *WebhookRPC) DeletePartner(ctx context.Context, req *pb.WebhookPartnerReq) (*pb.WebhookPartner, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"DeletePartner")
+	span.SetAttributes(attribute.Key("id").String(req.Id))
+	defer span.End()
+
+	partner, err := r.webhooksUseCase.DeletePartner(ctx, req.Id)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return partnerToPb(partner), nil
+}
+
+func (r *WebhookRPC) LinkPatient(ctx context.Context, req *pb.WebhookPartnerPatient) (*pb.WebhookPartnerPatient, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"LinkPatient")
+	span.SetAttributes(attribute.Key("partner_id").String(req.PartnerId))
+	defer span.End()
+
+	link, err := r.webhooksUseCase.LinkPatient(ctx, &entity.WebhookPartnerPatient{
+		PartnerId: req.PartnerId,
+		PatientId: req.PatientId,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return partnerPatientToPb(link), nil
+}
+
+func (r *WebhookRPC) UnlinkPatient(ctx context.Context, req *pb.WebhookPartnerPatient) (*pb.WebhookPartnerPatient, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"UnlinkPatient")
+	span.SetAttributes(attribute.Key("partner_id").String(req.PartnerId))
+	defer span.End()
+
+	link, err := r.webhooksUseCase.UnlinkPatient(ctx, &entity.WebhookPartnerPatient{
+		PartnerId: req.PartnerId,
+		PatientId: req.PatientId,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return partnerPatientToPb(link), nil
+}
+
+func (r *WebhookRPC) GetAllPartnerPatients(ctx context.Context, req *pb.GetAllWebhookPartnerPatientsReq) (*pb.WebhookPartnerPatients, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"GetAllPartnerPatients")
+	span.SetAttributes(attribute.Key("partner_id").String(req.PartnerId))
+	defer span.End()
+
+	list, err := r.webhooksUseCase.GetAllPartnerPatients(ctx, &entity.GetAllWebhookPartnerPatients{
+		PartnerId: req.PartnerId,
+		Page:      req.Page,
+		Limit:     req.Limit,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res := &pb.WebhookPartnerPatients{Count: list.Count}
+	for _, link := range list.Patients {
+		res.Patients = append(res.Patients, partnerPatientToPb(link))
+	}
+
+	return res, nil
+}
+
+func (r *WebhookRPC) CreateSubscription(ctx context.Context, req *pb.WebhookSubscription) (*pb.WebhookSubscription, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"CreateSubscription")
+	span.SetAttributes(attribute.Key("url").String(req.Url))
+	defer span.End()
+
+	subscription, err := r.webhooksUseCase.CreateSubscription(ctx, &entity.WebhookSubscription{
+		PartnerId:   req.PartnerId,
+		Url:         req.Url,
+		EventTypes:  req.EventTypes,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return subscriptionToPb(subscription), nil
+}
+
+func (r *WebhookRPC) GetSubscription(ctx context.Context, req *pb.WebhookSubscriptionReq) (*pb.WebhookSubscription, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"GetSubscription")
+	span.SetAttributes(attribute.Key("id").String(req.Id))
+	defer span.End()
+
+	subscription, err := r.webhooksUseCase.GetSubscription(ctx, req.Id)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return subscriptionToPb(subscription), nil
+}
+
+func (r *WebhookRPC) GetAllSubscriptions(ctx context.Context, req *pb.GetAllWebhookSubscriptionsReq) (*pb.WebhookSubscriptions, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"GetAllSubscriptions")
+	span.SetAttributes(attribute.Key("event_type").String(req.EventType))
+	defer span.End()
+
+	list, err := r.webhooksUseCase.GetAllSubscriptions(ctx, &entity.GetAllWebhookSubscriptions{
+		Page:      req.Page,
+		Limit:     req.Limit,
+		EventType: req.EventType,
+		PartnerId: req.PartnerId,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res := &pb.WebhookSubscriptions{Count: list.Count}
+	for _, subscription := range list.Subscriptions {
+		res.Subscriptions = append(res.Subscriptions, subscriptionToPb(subscription))
+	}
+
+	return res, nil
+}
+
+func (r *WebhookRPC) UpdateSubscription(ctx context.Context, req *pb.WebhookSubscription) (*pb.WebhookSubscription, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"UpdateSubscription")
+	span.SetAttributes(attribute.Key("id").String(req.Id))
+	defer span.End()
+
+	subscription, err := r.webhooksUseCase.UpdateSubscription(ctx, &entity.WebhookSubscription{
+		Id:          req.Id,
+		Url:         req.Url,
+		EventTypes:  req.EventTypes,
+		Description: req.Description,
+		Active:      req.Active,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return subscriptionToPb(subscription), nil
+}
+
+func (r *WebhookRPC) RotateSecret(ctx context.Context, req *pb.WebhookSubscriptionReq) (*pb.WebhookSubscription, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"RotateSecret")
+	span.SetAttributes(attribute.Key("id").String(req.Id))
+	defer span.End()
+
+	subscription, err := r.webhooksUseCase.RotateSecret(ctx, req.Id)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return subscriptionToPb(subscription), nil
+}
+
+func (r *WebhookRPC) DeleteSubscription(ctx context.Context, req *pb.WebhookSubscriptionReq) (*pb.WebhookSubscription, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"DeleteSubscription")
+	span.SetAttributes(attribute.Key("id").String(req.Id))
+	defer span.End()
+
+	subscription, err := r.webhooksUseCase.DeleteSubscription(ctx, req.Id)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return subscriptionToPb(subscription), nil
+}
+
+func (r *WebhookRPC) GetAllDeliveries(ctx context.Context, req *pb.GetAllWebhookDeliveriesReq) (*pb.WebhookDeliveries, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"GetAllDeliveries")
+	span.SetAttributes(
+		attribute.Key("subscription_id").String(req.SubscriptionId),
+		attribute.Key("status").String(req.Status),
+	)
+	defer span.End()
+
+	list, err := r.webhooksUseCase.GetAllWebhookDeliveries(ctx, &entity.GetAllWebhookDeliveries{
+		Page:           req.Page,
+		Limit:          req.Limit,
+		SubscriptionId: req.SubscriptionId,
+		EventType:      req.EventType,
+		Status:         req.Status,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res := &pb.WebhookDeliveries{Count: list.Count}
+	for _, delivery := range list.Deliveries {
+		res.Deliveries = append(res.Deliveries, webhookDeliveryToPb(delivery))
+	}
+
+	return res, nil
+}
+
+func (r *WebhookRPC) GetDelivery(ctx context.Context, req *pb.WebhookDeliveryReq) (*pb.WebhookDelivery, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"GetDelivery")
+	span.SetAttributes(attribute.Key("id").String(req.Id))
+	defer span.End()
+
+	delivery, err := r.webhooksUseCase.GetWebhookDelivery(ctx, req.Id)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return webhookDeliveryToPb(delivery), nil
+}
+
+func (r *WebhookRPC) Redeliver(ctx context.Context, req *pb.WebhookDeliveryReq) (*pb.WebhookDelivery, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhook, spanNameWebhookService+"Redeliver")
+	span.SetAttributes(attribute.Key("id").String(req.Id))
+	defer span.End()
+
+	delivery, err := r.webhooksUseCase.Redeliver(ctx, req.Id)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return webhookDeliveryToPb(delivery), nil
+}
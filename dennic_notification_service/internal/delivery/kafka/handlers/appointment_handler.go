@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"context"
+	"dennic_kafka/eventbus"
+	"dennic_notification_service/genproto/events"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/infrastructure/kafka"
+	"dennic_notification_service/internal/pkg/config"
+	"dennic_notification_service/internal/usecase"
+	"dennic_notification_service/internal/usecase/event"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// appointmentSchema is events.AppointmentChanged at version 1; version 0 is
+// the JSON the booking service published before the envelope.
+var appointmentSchema = eventbus.Schema{
+	Version: 1,
+	Upcasters: map[int32]eventbus.Upcaster{
+		0: upcastAppointmentJSON,
+	},
+}
+
+var appointmentSchemas = eventbus.NewSchemas(map[string]eventbus.Schema{
+	entity.EventAppointmentCreated:       appointmentSchema,
+	entity.EventAppointmentUpdated:       appointmentSchema,
+	entity.EventAppointmentConfirmed:     appointmentSchema,
+	entity.EventAppointmentStatusChanged: appointmentSchema,
+	entity.EventAppointmentDeleted:       appointmentSchema,
+}, legacyAppointmentEvent)
+
+// legacyEvent is the JSON message the booking service published on every
+// change of an appointment before the envelope.
+type legacyEvent struct {
+	Type        string          `json:"type"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Appointment json.RawMessage `json:"appointment"`
+}
+
+type legacyAppointment struct {
+	Id            int64     `json:"id"`
+	PatientId     string    `json:"patient_id"`
+	DoctorId      string    `json:"doctor_id"`
+	DepartmentId  string    `json:"department_id"`
+	BranchId      string    `json:"branch_id"`
+	StartsAt      time.Time `json:"starts_at"`
+	Duration      int64     `json:"duration"`
+	Modality      string    `json:"modality"`
+	Status        string    `json:"status"`
+	PatientStatus bool      `json:"patient_status"`
+}
+
+func legacyAppointmentEvent(value []byte) (*eventbus.Envelope, error) {
+	var message legacyEvent
+	if err := json.Unmarshal(value, &message); err != nil {
+		return nil, err
+	}
+
+	return &eventbus.Envelope{
+		Id:         eventbus.LegacyId(value),
+		Type:       message.Type,
+		OccurredAt: message.OccurredAt.Format(time.RFC3339Nano),
+		Producer:   "dennic_booking_service",
+		Payload:    message.Appointment,
+	}, nil
+}
+
+func upcastAppointmentJSON(payload []byte) ([]byte, error) {
+	var appointment legacyAppointment
+	if err := json.Unmarshal(payload, &appointment); err != nil {
+		return nil, err
+	}
+
+	return (&events.AppointmentChanged{
+		Id:            appointment.Id,
+		PatientId:     appointment.PatientId,
+		DoctorId:      appointment.DoctorId,
+		DepartmentId:  appointment.DepartmentId,
+		BranchId:      appointment.BranchId,
+		StartsAt:      appointment.StartsAt.Format(time.RFC3339),
+		Duration:      appointment.Duration,
+		Modality:      appointment.Modality,
+		Status:        appointment.Status,
+		PatientStatus: appointment.PatientStatus,
+	}).Marshal()
+}
+
+type appointmentHandler struct {
+	config              *config.Config
+	brokerConsumer      event.BrokerConsumer
+	logger              *zap.Logger
+	notificationUsecase usecase.Notification
+	inboxUsecase        usecase.Inbox
+	processedEvents     event.ProcessedEvents
+}
+
+func NewAppointmentHandler(config *config.Config,
+	brokerConsumer event.BrokerConsumer,
+	logger *zap.Logger,
+	notificationUsecase usecase.Notification,
+	inboxUsecase usecase.Inbox,
+	processedEvents event.ProcessedEvents) *appointmentHandler {
+	return &appointmentHandler{
+		config:              config,
+		brokerConsumer:      brokerConsumer,
+		logger:              logger,
+		notificationUsecase: notificationUsecase,
+		inboxUsecase:        inboxUsecase,
+		processedEvents:     processedEvents,
+	}
+}
+
+func (h *appointmentHandler) HandlerEvents() error {
+	consumerConfig := eventbus.NewConsumerConfig(
+		h.config.Kafka.Address,
+		h.config.Kafka.Topic.Appointments,
+		h.config.Kafka.GroupId,
+		func(ctx context.Context, key, value []byte) error {
+			envelope, err := appointmentSchemas.Decode(value)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			var appointment events.AppointmentChanged
+			if err := appointment.Unmarshal(envelope.Payload); err != nil {
+				return eventbus.Permanent(err)
+			}
+			occurredAt, err := time.Parse(time.RFC3339, envelope.OccurredAt)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+			startsAt, err := time.Parse(time.RFC3339, appointment.StartsAt)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+			var previousStartsAt time.Time
+			if appointment.PreviousStartsAt != "" {
+				if previousStartsAt, err = time.Parse(time.RFC3339, appointment.PreviousStartsAt); err != nil {
+					return eventbus.Permanent(err)
+				}
+			}
+
+			appointmentEvent := &entity.AppointmentEvent{
+				Type:             envelope.Type,
+				OccurredAt:       occurredAt,
+				AppointmentId:    appointment.Id,
+				PatientId:        appointment.PatientId,
+				DoctorId:         appointment.DoctorId,
+				BranchId:         appointment.BranchId,
+				StartsAt:         startsAt,
+				PreviousStartsAt: previousStartsAt,
+				Modality:         appointment.Modality,
+				Status:           appointment.Status,
+			}
+
+			// the reminders and the inbox notifications are written in the
+			// transaction recording the event, so neither is done twice
+			return kafka.Once(ctx, h.logger, h.processedEvents, h.config.Kafka.GroupId, envelope,
+				func(ctx context.Context) error {
+					if err := h.notificationUsecase.HandleAppointmentEvent(ctx, appointmentEvent); err != nil {
+						return err
+					}
+					return h.inboxUsecase.HandleAppointmentEvent(ctx, appointmentEvent)
+				})
+		},
+	)
+
+	h.brokerConsumer.RegisterConsumer(consumerConfig)
+	h.brokerConsumer.Run()
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"dennic_kafka/eventbus"
+	"dennic_notification_service/genproto/events"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/infrastructure/kafka"
+	"dennic_notification_service/internal/pkg/config"
+	"dennic_notification_service/internal/usecase"
+	"dennic_notification_service/internal/usecase/event"
+
+	"go.uber.org/zap"
+)
+
+var labOrderSchemas = eventbus.NewSchemas(map[string]eventbus.Schema{
+	entity.EventLabOrderReleased: {Version: 1},
+}, nil)
+
+type labOrderHandler struct {
+	config          *config.Config
+	brokerConsumer  event.BrokerConsumer
+	logger          *zap.Logger
+	inboxUsecase    usecase.Inbox
+	processedEvents event.ProcessedEvents
+}
+
+func NewLabOrderHandler(config *config.Config,
+	brokerConsumer event.BrokerConsumer,
+	logger *zap.Logger,
+	inboxUsecase usecase.Inbox,
+	processedEvents event.ProcessedEvents) *labOrderHandler {
+	return &labOrderHandler{
+		config:          config,
+		brokerConsumer:  brokerConsumer,
+		logger:          logger,
+		inboxUsecase:    inboxUsecase,
+		processedEvents: processedEvents,
+	}
+}
+
+func (h *labOrderHandler) HandlerEvents() error {
+	consumerConfig := eventbus.NewConsumerConfig(
+		h.config.Kafka.Address,
+		h.config.Kafka.Topic.LabOrders,
+		h.config.Kafka.GroupId,
+		func(ctx context.Context, key, value []byte) error {
+			envelope, err := labOrderSchemas.Decode(value)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			var released events.LabResultsReleased
+			if err := released.Unmarshal(envelope.Payload); err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			return kafka.Once(ctx, h.logger, h.processedEvents, h.config.Kafka.GroupId, envelope,
+				func(ctx context.Context) error {
+					return h.inboxUsecase.HandleLabResultsReleased(ctx, &entity.LabResultsReleased{
+						LabOrderId:    released.Id,
+						AppointmentId: released.AppointmentId,
+						PatientId:     released.PatientId,
+						DoctorId:      released.DoctorId,
+					})
+				})
+		},
+	)
+
+	h.brokerConsumer.RegisterConsumer(consumerConfig)
+	h.brokerConsumer.Run()
+
+	return nil
+}
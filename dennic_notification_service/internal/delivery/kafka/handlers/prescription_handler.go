@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"dennic_kafka/eventbus"
+	"dennic_notification_service/genproto/events"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/infrastructure/kafka"
+	"dennic_notification_service/internal/pkg/config"
+	"dennic_notification_service/internal/usecase"
+	"dennic_notification_service/internal/usecase/event"
+
+	"go.uber.org/zap"
+)
+
+var prescriptionSchemas = eventbus.NewSchemas(map[string]eventbus.Schema{
+	entity.EventPrescriptionIssued: {Version: 1},
+}, nil)
+
+type prescriptionHandler struct {
+	config          *config.Config
+	brokerConsumer  event.BrokerConsumer
+	logger          *zap.Logger
+	inboxUsecase    usecase.Inbox
+	processedEvents event.ProcessedEvents
+}
+
+func NewPrescriptionHandler(config *config.Config,
+	brokerConsumer event.BrokerConsumer,
+	logger *zap.Logger,
+	inboxUsecase usecase.Inbox,
+	processedEvents event.ProcessedEvents) *prescriptionHandler {
+	return &prescriptionHandler{
+		config:          config,
+		brokerConsumer:  brokerConsumer,
+		logger:          logger,
+		inboxUsecase:    inboxUsecase,
+		processedEvents: processedEvents,
+	}
+}
+
+func (h *prescriptionHandler) HandlerEvents() error {
+	consumerConfig := eventbus.NewConsumerConfig(
+		h.config.Kafka.Address,
+		h.config.Kafka.Topic.Prescriptions,
+		h.config.Kafka.GroupId,
+		func(ctx context.Context, key, value []byte) error {
+			envelope, err := prescriptionSchemas.Decode(value)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			var issued events.PrescriptionIssued
+			if err := issued.Unmarshal(envelope.Payload); err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			return kafka.Once(ctx, h.logger, h.processedEvents, h.config.Kafka.GroupId, envelope,
+				func(ctx context.Context) error {
+					return h.inboxUsecase.HandlePrescriptionIssued(ctx, &entity.PrescriptionIssued{
+						PrescriptionId: issued.Id,
+						AppointmentId:  issued.AppointmentId,
+						PatientId:      issued.PatientId,
+						DoctorId:       issued.DoctorId,
+					})
+				})
+		},
+	)
+
+	h.brokerConsumer.RegisterConsumer(consumerConfig)
+	h.brokerConsumer.Run()
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"dennic_kafka/eventbus"
+	"dennic_notification_service/genproto/events"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/infrastructure/kafka"
+	"dennic_notification_service/internal/pkg/config"
+	"dennic_notification_service/internal/usecase"
+	"dennic_notification_service/internal/usecase/event"
+
+	"go.uber.org/zap"
+)
+
+var userCreatedSchemas = eventbus.NewSchemas(map[string]eventbus.Schema{
+	entity.EventUserCreated: {Version: 1},
+}, nil)
+
+type userCreatedHandler struct {
+	config              *config.Config
+	brokerConsumer      event.BrokerConsumer
+	logger              *zap.Logger
+	notificationUsecase usecase.Notification
+	processedEvents     event.ProcessedEvents
+}
+
+func NewUserCreatedHandler(config *config.Config,
+	brokerConsumer event.BrokerConsumer,
+	logger *zap.Logger,
+	notificationUsecase usecase.Notification,
+	processedEvents event.ProcessedEvents) *userCreatedHandler {
+	return &userCreatedHandler{
+		config:              config,
+		brokerConsumer:      brokerConsumer,
+		logger:              logger,
+		notificationUsecase: notificationUsecase,
+		processedEvents:     processedEvents,
+	}
+}
+
+func (h *userCreatedHandler) HandlerEvents() error {
+	consumerConfig := eventbus.NewConsumerConfig(
+		h.config.Kafka.Address,
+		h.config.Kafka.Topic.UserCreated,
+		h.config.Kafka.GroupId,
+		func(ctx context.Context, key, value []byte) error {
+			envelope, err := userCreatedSchemas.Decode(value)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			var user events.UserCreated
+			if err := user.Unmarshal(envelope.Payload); err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			welcome := &entity.Welcome{
+				UserId:      user.Id,
+				FirstName:   user.FirstName,
+				LastName:    user.LastName,
+				PhoneNumber: user.PhoneNumber,
+			}
+			if user.Device != nil {
+				welcome.FcmToken = user.Device.FcmToken
+			}
+
+			// the deliveries are queued in the transaction recording the
+			// event, so a patient is welcomed once
+			return kafka.Once(ctx, h.logger, h.processedEvents, h.config.Kafka.GroupId, envelope,
+				func(ctx context.Context) error {
+					return h.notificationUsecase.WelcomeUser(ctx, welcome)
+				})
+		},
+	)
+
+	h.brokerConsumer.RegisterConsumer(consumerConfig)
+	h.brokerConsumer.Run()
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"context"
+	"dennic_kafka/eventbus"
+	"dennic_notification_service/genproto/events"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/infrastructure/kafka"
+	"dennic_notification_service/internal/pkg/config"
+	"dennic_notification_service/internal/usecase"
+	"dennic_notification_service/internal/usecase/event"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// webhookHandler queues the domain events partners subscribe to. It has a
+// consumer group of its own, so it reads every event next to the
+// notification consumers.
+type webhookHandler struct {
+	config          *config.Config
+	brokerConsumer  event.BrokerConsumer
+	logger          *zap.Logger
+	webhooksUsecase usecase.Webhooks
+	processedEvents event.ProcessedEvents
+}
+
+func NewWebhookHandler(config *config.Config,
+	brokerConsumer event.BrokerConsumer,
+	logger *zap.Logger,
+	webhooksUsecase usecase.Webhooks,
+	processedEvents event.ProcessedEvents) *webhookHandler {
+	return &webhookHandler{
+		config:          config,
+		brokerConsumer:  brokerConsumer,
+		logger:          logger,
+		webhooksUsecase: webhooksUsecase,
+		processedEvents: processedEvents,
+	}
+}
+
+func (h *webhookHandler) HandlerEvents() error {
+	h.brokerConsumer.RegisterConsumer(eventbus.NewConsumerConfig(
+		h.config.Kafka.Address,
+		h.config.Kafka.Topic.Appointments,
+		h.config.Kafka.WebhookGroupId,
+		func(ctx context.Context, key, value []byte) error {
+			envelope, err := appointmentSchemas.Decode(value)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			var appointment events.AppointmentChanged
+			if err := appointment.Unmarshal(envelope.Payload); err != nil {
+				return eventbus.Permanent(err)
+			}
+			startsAt, err := time.Parse(time.RFC3339, appointment.StartsAt)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			return h.queue(ctx, envelope, appointment.PatientId, &entity.WebhookAppointment{
+				Id:        appointment.Id,
+				PatientId: appointment.PatientId,
+				DoctorId:  appointment.DoctorId,
+				BranchId:  appointment.BranchId,
+				StartsAt:  startsAt,
+				Modality:  appointment.Modality,
+				Status:    appointment.Status,
+			})
+		},
+	))
+
+	h.brokerConsumer.RegisterConsumer(eventbus.NewConsumerConfig(
+		h.config.Kafka.Address,
+		h.config.Kafka.Topic.LabOrders,
+		h.config.Kafka.WebhookGroupId,
+		func(ctx context.Context, key, value []byte) error {
+			envelope, err := labOrderSchemas.Decode(value)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			var released events.LabResultsReleased
+			if err := released.Unmarshal(envelope.Payload); err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			return h.queue(ctx, envelope, released.PatientId, &entity.WebhookLabOrder{
+				Id:            released.Id,
+				AppointmentId: released.AppointmentId,
+				PatientId:     released.PatientId,
+				DoctorId:      released.DoctorId,
+			})
+		},
+	))
+
+	h.brokerConsumer.RegisterConsumer(eventbus.NewConsumerConfig(
+		h.config.Kafka.Address,
+		h.config.Kafka.Topic.Prescriptions,
+		h.config.Kafka.WebhookGroupId,
+		func(ctx context.Context, key, value []byte) error {
+			envelope, err := prescriptionSchemas.Decode(value)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			var issued events.PrescriptionIssued
+			if err := issued.Unmarshal(envelope.Payload); err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			return h.queue(ctx, envelope, issued.PatientId, &entity.WebhookPrescription{
+				Id:            issued.Id,
+				AppointmentId: issued.AppointmentId,
+				PatientId:     issued.PatientId,
+				DoctorId:      issued.DoctorId,
+			})
+		},
+	))
+
+	h.brokerConsumer.Run()
+
+	return nil
+}
+
+// queue queues the deliveries of an event about a patient in the transaction
+// recording it, so a redelivered event is not posted twice.
+func (h *webhookHandler) queue(ctx context.Context, envelope *eventbus.Envelope, patientId string, data any) error {
+	occurredAt, err := time.Parse(time.RFC3339Nano, envelope.OccurredAt)
+	if err != nil {
+		return eventbus.Permanent(err)
+	}
+
+	webhookEvent := &entity.WebhookEvent{
+		Id:         envelope.Id,
+		Type:       envelope.Type,
+		PatientId:  patientId,
+		OccurredAt: occurredAt,
+		Data:       data,
+	}
+
+	return kafka.Once(ctx, h.logger, h.processedEvents, h.config.Kafka.WebhookGroupId, envelope,
+		func(ctx context.Context) error {
+			return h.webhooksUsecase.HandleEvent(ctx, webhookEvent)
+		})
+}
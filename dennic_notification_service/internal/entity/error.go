@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrorConflict = NewErrConflict("object")
+	ErrorNotFound = NewErrNotFound("object")
+)
+
+// error not found
+type ErrNotFound struct {
+	name string
+}
+
+func (e *ErrNotFound) Error() string {
+	return e.name + " not found"
+}
+
+func NewErrNotFound(text string) *ErrNotFound {
+	return &ErrNotFound{text}
+}
+
+// error conflict
+type ErrConflict struct {
+	name string
+}
+
+func (e *ErrConflict) Error() string {
+	return e.name + " already exist"
+}
+
+func NewErrConflict(text string) *ErrConflict {
+	return &ErrConflict{text}
+}
+
+// error permission denied
+type ErrPermissionDenied struct {
+	name string
+}
+
+func (e *ErrPermissionDenied) Error() string {
+	return "access to " + e.name + " denied"
+}
+
+func NewErrPermissionDenied(text string) *ErrPermissionDenied {
+	return &ErrPermissionDenied{text}
+}
+
+// error failed precondition
+type ErrFailedPrecondition struct {
+	reason string
+}
+
+func (e *ErrFailedPrecondition) Error() string {
+	return e.reason
+}
+
+func NewErrFailedPrecondition(reason string) *ErrFailedPrecondition {
+	return &ErrFailedPrecondition{reason}
+}
+
+// error validation
+type ErrValidation struct {
+	Err    error
+	Errors map[string]string
+}
+
+func (e ErrValidation) Error() string {
+	return e.Err.Error()
+}
+
+func NewErrValidation() *ErrValidation {
+	return &ErrValidation{Errors: make(map[string]string)}
+}
+
+type ErrNoRequiredParameter struct {
+	parameters []string
+}
+
+func NewErrNoRequiredParameter(parameters ...string) *ErrNoRequiredParameter {
+	return &ErrNoRequiredParameter{parameters: parameters}
+}
+
+func (e ErrNoRequiredParameter) Error() string {
+	var str strings.Builder
+	for _, param := range e.parameters {
+		if str.Len() != 0 {
+			str.WriteString(", ")
+		}
+		str.WriteString(param)
+	}
+
+	return fmt.Sprintf("could not find required parameter(s): %s", str.String())
+}
+
+// error undeliverable: the recipient cannot be reached over the channel, so
+// sending again would not help
+type ErrUndeliverable struct {
+	reason string
+}
+
+func (e *ErrUndeliverable) Error() string {
+	return e.reason
+}
+
+func NewErrUndeliverable(reason string) *ErrUndeliverable {
+	return &ErrUndeliverable{reason}
+}
@@ -0,0 +1,282 @@
+package entity
+
+import "time"
+
+// Channels a notification is delivered over.
+const (
+	ChannelPush  = "push"
+	ChannelSMS   = "sms"
+	ChannelEmail = "email"
+)
+
+// Languages messages are written in.
+const (
+	LanguageUz = "uz"
+	LanguageRu = "ru"
+	LanguageEn = "en"
+)
+
+// Kinds of notifications.
+const (
+	KindReminder24h = "reminder_24h"
+	KindReminder2h  = "reminder_2h"
+	KindWelcome     = "welcome"
+)
+
+// Kinds of inbox notifications. A booked appointment goes to the doctor's
+// inbox, lab results and prescriptions to the patient's, the others to both.
+const (
+	KindAppointmentBooked      = "appointment_booked"
+	KindAppointmentConfirmed   = "appointment_confirmed"
+	KindAppointmentRescheduled = "appointment_rescheduled"
+	KindAppointmentCancelled   = "appointment_cancelled"
+	KindLabResultsReady        = "lab_results_ready"
+	KindPrescriptionIssued     = "prescription_issued"
+)
+
+// Reminder statuses. A dispatched reminder has had its deliveries created.
+const (
+	ReminderScheduled  = "scheduled"
+	ReminderDispatched = "dispatched"
+	ReminderCancelled  = "cancelled"
+)
+
+// Delivery statuses. A failed delivery ran out of attempts or cannot reach
+// its recipient at all.
+const (
+	DeliveryPending = "pending"
+	DeliverySent    = "sent"
+	DeliveryFailed  = "failed"
+)
+
+// Appointment events published by the booking service.
+const (
+	EventAppointmentCreated       = "appointment.created"
+	EventAppointmentUpdated       = "appointment.updated"
+	EventAppointmentConfirmed     = "appointment.confirmed"
+	EventAppointmentStatusChanged = "appointment.status_changed"
+	EventAppointmentDeleted       = "appointment.deleted"
+)
+
+// EventUserCreated is published by the user service once a patient
+// registered.
+const EventUserCreated = "user.created"
+
+// Clinical events published by the booking service.
+const (
+	EventLabOrderReleased   = "lab_order.released"
+	EventPrescriptionIssued = "prescription.issued"
+)
+
+// EventUnreadCount is published whenever the inbox of a user changes, for
+// their devices to update the badge.
+const EventUnreadCount = "notification.unread_count"
+
+// ModalityOnline is an appointment held as a video consultation.
+const ModalityOnline = "online"
+
+// Appointment statuses after which there is nothing to remind of.
+const (
+	AppointmentAttended  = "attended"
+	AppointmentCancelled = "cancelled"
+	AppointmentNoShow    = "no_show"
+)
+
+// Preferences are how a user wants to be notified.
+type Preferences struct {
+	UserId       string
+	Language     string
+	Push         bool
+	Sms          bool
+	Email        bool
+	EmailAddress string
+	Reminders    bool
+	UpdatedAt    time.Time
+}
+
+// DefaultPreferences are used for users who never saved theirs and for
+// patients without an account.
+func DefaultPreferences(userId, language string) *Preferences {
+	return &Preferences{
+		UserId:    userId,
+		Language:  language,
+		Push:      true,
+		Sms:       true,
+		Reminders: true,
+	}
+}
+
+// AppointmentEvent is a change of an appointment in the booking service.
+// PreviousStartsAt is where an updated appointment was moved from, zero when
+// it did not move.
+type AppointmentEvent struct {
+	Type             string
+	OccurredAt       time.Time
+	AppointmentId    int64
+	PatientId        string
+	DoctorId         string
+	BranchId         string
+	StartsAt         time.Time
+	PreviousStartsAt time.Time
+	Modality         string
+	Status           string
+}
+
+// LabResultsReleased tells that the results of a lab order are ready for the
+// patient.
+type LabResultsReleased struct {
+	LabOrderId    string
+	AppointmentId int64
+	PatientId     string
+	DoctorId      string
+}
+
+// PrescriptionIssued tells that a doctor issued a prescription to a patient.
+type PrescriptionIssued struct {
+	PrescriptionId string
+	AppointmentId  int64
+	PatientId      string
+	DoctorId       string
+}
+
+// Welcome greets a patient who just registered on the device they
+// registered on. FcmToken is empty for devices without push.
+type Welcome struct {
+	UserId      string
+	FirstName   string
+	LastName    string
+	PhoneNumber string
+	FcmToken    string
+}
+
+// Reminder is a notification of an upcoming appointment due at SendAt.
+// EventAt is when the appointment change it follows happened, so an event
+// delivered late does not undo a newer one.
+type Reminder struct {
+	Id            string
+	AppointmentId int64
+	Kind          string
+	PatientId     string
+	DoctorId      string
+	BranchId      string
+	Modality      string
+	StartsAt      time.Time
+	SendAt        time.Time
+	Status        string
+	EventAt       time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Contact is the patient of an appointment as the booking service knows it.
+// UserId is the account managing the patient, empty for walk-in patients.
+type Contact struct {
+	PatientId   string
+	UserId      string
+	FirstName   string
+	LastName    string
+	PhoneNumber string
+}
+
+// MessageData fills the message templates.
+type MessageData struct {
+	PatientName string
+	DoctorName  string
+	BranchName  string
+	StartsAt    time.Time
+	Online      bool
+}
+
+// Message is a rendered notification on its way to one recipient.
+type Message struct {
+	Channel   string
+	Recipient string
+	Title     string
+	Body      string
+	// Data travels with push notifications for the app to open the right
+	// screen.
+	Data map[string]string
+}
+
+// Delivery is a message to one recipient over one channel and what became
+// of it. StartsAt is the appointment it is about, zero for messages about
+// none.
+type Delivery struct {
+	Id            string
+	ReminderId    string
+	AppointmentId int64
+	Kind          string
+	UserId        string
+	PatientId     string
+	Channel       string
+	Recipient     string
+	Language      string
+	StartsAt      time.Time
+	Title         string
+	Body          string
+	Status        string
+	Attempts      int32
+	LastError     string
+	ProviderRef   string
+	NextAttemptAt time.Time
+	SentAt        time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type GetAllDeliveries struct {
+	Page          uint64
+	Limit         uint64
+	UserId        string
+	PatientId     string
+	AppointmentId int64
+	Channel       string
+	Status        string
+}
+
+type DeliveriesType struct {
+	Count      int64
+	Deliveries []*Delivery
+}
+
+// InboxItem is a notification kept in the in-app inbox of a user. Link is
+// the deep link the app opens it with; Data carries the ids behind it.
+type InboxItem struct {
+	Id        string
+	UserId    string
+	Kind      string
+	Title     string
+	Body      string
+	Link      string
+	Data      map[string]string
+	ReadAt    time.Time
+	CreatedAt time.Time
+}
+
+type GetInbox struct {
+	Page   uint64
+	Limit  uint64
+	UserId string
+	// UnreadOnly leaves out the notifications already read.
+	UnreadOnly bool
+}
+
+type InboxType struct {
+	Count  int64
+	Unread int64
+	Items  []*InboxItem
+}
+
+// MarkInboxRead marks the notifications Ids of a user as read, or all of
+// them.
+type MarkInboxRead struct {
+	UserId string
+	Ids    []string
+	All    bool
+}
+
+// UnreadCount is how many notifications in the inbox of a user are unread.
+type UnreadCount struct {
+	UserId string
+	Unread int64
+}
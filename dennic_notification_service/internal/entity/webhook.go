@@ -0,0 +1,192 @@
+package entity
+
+import "time"
+
+// EventAppointmentCompleted is told to webhooks when the patient attended an
+// appointment, next to its appointment.status_changed.
+const EventAppointmentCompleted = "appointment.completed"
+
+// WebhookEventTypes are the events partners can subscribe to.
+var WebhookEventTypes = []string{
+	EventAppointmentCreated,
+	EventAppointmentUpdated,
+	EventAppointmentConfirmed,
+	EventAppointmentStatusChanged,
+	EventAppointmentDeleted,
+	EventAppointmentCompleted,
+	EventLabOrderReleased,
+	EventPrescriptionIssued,
+}
+
+// Webhook delivery statuses. A failed delivery ran out of attempts or its
+// subscription was deactivated; it can still be redelivered.
+const (
+	WebhookPending   = "pending"
+	WebhookDelivered = "delivered"
+	WebhookFailed    = "failed"
+)
+
+// WebhookPartner is a lab or corporate client. Its subscriptions are told
+// about the events of the patients linked to it only, e.g. its employees.
+type WebhookPartner struct {
+	Id        string
+	Name      string
+	CreatedAt time.Time
+}
+
+type GetAllWebhookPartners struct {
+	Page  uint64
+	Limit uint64
+}
+
+type WebhookPartnersType struct {
+	Count    int64
+	Partners []*WebhookPartner
+}
+
+type WebhookPartnerPatient struct {
+	PartnerId string
+	PatientId string
+	CreatedAt time.Time
+}
+
+type GetAllWebhookPartnerPatients struct {
+	PartnerId string
+	Page      uint64
+	Limit     uint64
+}
+
+type WebhookPartnerPatientsType struct {
+	Count    int64
+	Patients []*WebhookPartnerPatient
+}
+
+// WebhookSubscription is a partner endpoint told about the events of
+// EventTypes. Secret is only read when it is set or rotated.
+type WebhookSubscription struct {
+	Id          string
+	PartnerId   string
+	Url         string
+	EventTypes  []string
+	Description string
+	Secret      string
+	Active      bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type GetAllWebhookSubscriptions struct {
+	Page      uint64
+	Limit     uint64
+	EventType string
+	PartnerId string
+}
+
+type WebhookSubscriptionsType struct {
+	Count         int64
+	Subscriptions []*WebhookSubscription
+}
+
+// WebhookEvent is a domain event on its way to the webhooks; Data is
+// written as the data of the JSON body. Only the partners PatientId is
+// linked to are told about it.
+type WebhookEvent struct {
+	Id         string
+	Type       string
+	PatientId  string
+	OccurredAt time.Time
+	Data       any
+}
+
+// WebhookBody is the JSON posted to partners.
+type WebhookBody struct {
+	Id         string    `json:"id"`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+// WebhookAppointment is an appointment after the change told.
+type WebhookAppointment struct {
+	Id        int64     `json:"id"`
+	PatientId string    `json:"patient_id"`
+	DoctorId  string    `json:"doctor_id"`
+	BranchId  string    `json:"branch_id,omitempty"`
+	StartsAt  time.Time `json:"starts_at"`
+	Modality  string    `json:"modality"`
+	Status    string    `json:"status"`
+}
+
+// WebhookLabOrder is a lab order whose results were released.
+type WebhookLabOrder struct {
+	Id            string `json:"id"`
+	AppointmentId int64  `json:"appointment_id"`
+	PatientId     string `json:"patient_id"`
+	DoctorId      string `json:"doctor_id"`
+}
+
+// WebhookPrescription is an issued prescription.
+type WebhookPrescription struct {
+	Id            string `json:"id"`
+	AppointmentId int64  `json:"appointment_id"`
+	PatientId     string `json:"patient_id"`
+	DoctorId      string `json:"doctor_id"`
+}
+
+// WebhookDelivery is an event posted to one subscription and what became of
+// it. Url, Secret and Active are of the subscription when the delivery is
+// claimed for sending, so retries follow a changed url or secret.
+type WebhookDelivery struct {
+	Id             string
+	SubscriptionId string
+	EventId        string
+	EventType      string
+	Payload        string
+	Status         string
+	Attempts       int32
+	ResponseCode   int32
+	LastError      string
+	NextAttemptAt  time.Time
+	DeliveredAt    time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	AttemptLog     []*WebhookAttempt
+
+	Url    string
+	Secret string
+	Active bool
+}
+
+// WebhookAttempt is one try to send a delivery. ResponseCode is 0 when the
+// partner did not answer.
+type WebhookAttempt struct {
+	Id           string
+	DeliveryId   string
+	Attempt      int32
+	ResponseCode int32
+	Error        string
+	Duration     time.Duration
+	CreatedAt    time.Time
+}
+
+// WebhookRequest is a signed POST of a delivery.
+type WebhookRequest struct {
+	Url        string
+	Secret     string
+	DeliveryId string
+	EventType  string
+	Body       []byte
+}
+
+type GetAllWebhookDeliveries struct {
+	Page           uint64
+	Limit          uint64
+	SubscriptionId string
+	EventType      string
+	Status         string
+}
+
+type WebhookDeliveriesType struct {
+	Count      int64
+	Deliveries []*WebhookDelivery
+}
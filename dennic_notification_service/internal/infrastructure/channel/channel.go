@@ -0,0 +1,70 @@
+// Package channel delivers notifications over push, SMS and email. Each
+// channel's provider is selected by name from the configuration; "log" only
+// writes messages to the service log, for development.
+package channel
+
+import (
+	"context"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/pkg/config"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ProviderLog   = "log"
+	ProviderFCM   = "fcm"
+	ProviderEskiz = "eskiz"
+	ProviderSMTP  = "smtp"
+)
+
+// Sender -.
+type Sender interface {
+	Channel() string
+	Send(ctx context.Context, msg *entity.Message) (string, error)
+}
+
+// New returns a sender for every channel, with the providers configured by
+// PUSH_PROVIDER, SMS_PROVIDER and EMAIL_PROVIDER.
+func New(cfg *config.Config, logger *zap.Logger) ([]Sender, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	var push Sender
+	switch cfg.Push.Provider {
+	case "", ProviderLog:
+		push = NewLog(entity.ChannelPush, logger)
+	case ProviderFCM:
+		fcm, err := NewFCM(cfg.Push.CredentialsFile, client)
+		if err != nil {
+			return nil, err
+		}
+		push = fcm
+	default:
+		return nil, fmt.Errorf("unknown push provider %q", cfg.Push.Provider)
+	}
+
+	var sms Sender
+	switch cfg.SMS.Provider {
+	case "", ProviderLog:
+		sms = NewLog(entity.ChannelSMS, logger)
+	case ProviderEskiz:
+		sms = NewEskiz(cfg.SMS.BaseURL, cfg.SMS.Email, cfg.SMS.Password, cfg.SMS.From, client)
+	default:
+		return nil, fmt.Errorf("unknown sms provider %q", cfg.SMS.Provider)
+	}
+
+	var email Sender
+	switch cfg.Email.Provider {
+	case "", ProviderLog:
+		email = NewLog(entity.ChannelEmail, logger)
+	case ProviderSMTP:
+		email = NewSMTP(cfg.Email.Host, cfg.Email.Port, cfg.Email.Username, cfg.Email.Password, cfg.Email.From)
+	default:
+		return nil, fmt.Errorf("unknown email provider %q", cfg.Email.Provider)
+	}
+
+	return []Sender{push, sms, email}, nil
+}
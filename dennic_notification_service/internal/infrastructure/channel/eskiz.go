@@ -0,0 +1,143 @@
+package channel
+
+import (
+	"context"
+	"dennic_notification_service/internal/entity"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Eskiz sends SMS through the eskiz.uz gateway.
+type Eskiz struct {
+	baseURL  string
+	email    string
+	password string
+	from     string
+	client   *http.Client
+
+	mu    sync.Mutex
+	token string
+}
+
+func NewEskiz(baseURL, email, password, from string, client *http.Client) *Eskiz {
+	return &Eskiz{
+		baseURL:  strings.TrimSuffix(baseURL, "/"),
+		email:    email,
+		password: password,
+		from:     from,
+		client:   client,
+	}
+}
+
+func (e *Eskiz) Channel() string {
+	return entity.ChannelSMS
+}
+
+func (e *Eskiz) Send(ctx context.Context, msg *entity.Message) (string, error) {
+	phone := strings.TrimPrefix(strings.TrimSpace(msg.Recipient), "+")
+	if phone == "" || strings.Trim(phone, "0123456789") != "" {
+		return "", entity.NewErrUndeliverable("invalid phone number " + msg.Recipient)
+	}
+
+	form := url.Values{
+		"mobile_phone": {phone},
+		"message":      {msg.Body},
+		"from":         {e.from},
+	}
+
+	var sent struct {
+		Id      string `json:"id"`
+		Message string `json:"message"`
+	}
+	for attempt := 0; ; attempt++ {
+		token, err := e.login(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		status, body, err := e.post(ctx, "/message/sms/send", token, form)
+		if err != nil {
+			return "", err
+		}
+		if status == http.StatusUnauthorized && attempt == 0 {
+			// the token expired, sign in again once
+			e.mu.Lock()
+			e.token = ""
+			e.mu.Unlock()
+			continue
+		}
+		if status != http.StatusOK {
+			err = fmt.Errorf("eskiz: %d: %s", status, strings.TrimSpace(string(body)))
+			if status == http.StatusBadRequest {
+				return "", entity.NewErrUndeliverable(err.Error())
+			}
+			return "", err
+		}
+
+		if err = json.Unmarshal(body, &sent); err != nil {
+			return "", fmt.Errorf("eskiz: %w", err)
+		}
+		return sent.Id, nil
+	}
+}
+
+func (e *Eskiz) login(ctx context.Context) (string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.token != "" {
+		return e.token, nil
+	}
+
+	status, body, err := e.post(ctx, "/auth/login", "", url.Values{
+		"email":    {e.email},
+		"password": {e.password},
+	})
+	if err != nil {
+		return "", err
+	}
+	if status != http.StatusOK {
+		return "", fmt.Errorf("eskiz login: %d: %s", status, strings.TrimSpace(string(body)))
+	}
+
+	var login struct {
+		Data struct {
+			Token string `json:"token"`
+		} `json:"data"`
+	}
+	if err = json.Unmarshal(body, &login); err != nil {
+		return "", fmt.Errorf("eskiz login: %w", err)
+	}
+	if login.Data.Token == "" {
+		return "", errors.New("eskiz login: no token in response")
+	}
+	e.token = login.Data.Token
+
+	return e.token, nil
+}
+
+func (e *Eskiz) post(ctx context.Context, path, token string, form url.Values) (int, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.baseURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	res, err := e.client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<16))
+	return res.StatusCode, body, err
+}
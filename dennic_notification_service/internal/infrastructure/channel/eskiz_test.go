@@ -0,0 +1,45 @@
+package channel
+
+import (
+	"context"
+	"dennic_notification_service/internal/entity"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEskizSend(t *testing.T) {
+	var logins int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/login":
+			logins++
+			w.Write([]byte(`{"data":{"token":"token-` + strconv.Itoa(logins) + `"}}`))
+		case "/message/sms/send":
+			// the first token has expired
+			if r.Header.Get("Authorization") != "Bearer token-2" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			assert.Equal(t, "998901234567", r.FormValue("mobile_phone"))
+			assert.Equal(t, "4546", r.FormValue("from"))
+			w.Write([]byte(`{"id":"sms-1","status":"waiting"}`))
+		}
+	}))
+	defer server.Close()
+
+	sms := NewEskiz(server.URL+"/", "clinic@dennic.uz", "secret", "4546", server.Client())
+
+	ref, err := sms.Send(context.Background(), &entity.Message{Recipient: "+998901234567", Body: "Reminder"})
+	assert.NoError(t, err)
+	assert.Equal(t, "sms-1", ref)
+	assert.Equal(t, 2, logins)
+
+	_, err = sms.Send(context.Background(), &entity.Message{Recipient: "not a phone"})
+	var errUndeliverable *entity.ErrUndeliverable
+	assert.True(t, errors.As(err, &errUndeliverable))
+}
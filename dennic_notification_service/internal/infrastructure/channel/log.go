@@ -0,0 +1,39 @@
+package channel
+
+import (
+	"context"
+	"dennic_notification_service/internal/entity"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Log writes messages to the service log instead of sending them.
+type Log struct {
+	channel string
+	logger  *zap.Logger
+}
+
+func NewLog(channel string, logger *zap.Logger) *Log {
+	return &Log{
+		channel: channel,
+		logger:  logger,
+	}
+}
+
+func (l *Log) Channel() string {
+	return l.channel
+}
+
+func (l *Log) Send(ctx context.Context, msg *entity.Message) (string, error) {
+	ref := "log_" + uuid.NewString()
+	l.logger.Info("notification",
+		zap.String("ref", ref),
+		zap.String("channel", l.channel),
+		zap.String("recipient", msg.Recipient),
+		zap.String("title", msg.Title),
+		zap.String("body", msg.Body),
+	)
+
+	return ref, nil
+}
@@ -0,0 +1,72 @@
+package channel
+
+import (
+	"context"
+	"dennic_notification_service/internal/entity"
+	"fmt"
+	"mime"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SMTP sends email through an SMTP relay, authenticating with PLAIN when a
+// username is set.
+type SMTP struct {
+	addr string
+	host string
+	auth smtp.Auth
+	from string
+}
+
+func NewSMTP(host, port, username, password, from string) *SMTP {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTP{
+		addr: host + ":" + port,
+		host: host,
+		auth: auth,
+		from: from,
+	}
+}
+
+func (s *SMTP) Channel() string {
+	return entity.ChannelEmail
+}
+
+func (s *SMTP) Send(ctx context.Context, msg *entity.Message) (string, error) {
+	from, err := mail.ParseAddress(s.from)
+	if err != nil {
+		return "", fmt.Errorf("smtp from: %w", err)
+	}
+	to, err := mail.ParseAddress(msg.Recipient)
+	if err != nil {
+		return "", entity.NewErrUndeliverable("invalid email address " + msg.Recipient)
+	}
+
+	messageId := fmt.Sprintf("<%s@%s>", uuid.NewString(), s.host)
+	headers := []string{
+		"From: " + from.String(),
+		"To: " + to.String(),
+		"Subject: " + mime.QEncoding.Encode("utf-8", msg.Title),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"Message-ID: " + messageId,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Transfer-Encoding: 8bit",
+	}
+	body := strings.Join(headers, "\r\n") + "\r\n\r\n" + strings.ReplaceAll(msg.Body, "\n", "\r\n") + "\r\n"
+
+	// net/smtp does not take a context; the deadline is left to the relay
+	if err = smtp.SendMail(s.addr, s.auth, from.Address, []string{to.Address}, []byte(body)); err != nil {
+		return "", err
+	}
+
+	return messageId, nil
+}
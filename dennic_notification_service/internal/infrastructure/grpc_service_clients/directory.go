@@ -0,0 +1,109 @@
+package grpc_service_clients
+
+import (
+	"context"
+	bookingpb "dennic_notification_service/genproto/booking_service"
+	healthcarepb "dennic_notification_service/genproto/healthcare-service"
+	sessionpb "dennic_notification_service/genproto/session_service"
+	"dennic_notification_service/internal/entity"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Directory answers the notification usecase's lookups from the booking,
+// session and healthcare services.
+type Directory struct {
+	clients ServiceClients
+}
+
+func NewDirectory(clients ServiceClients) *Directory {
+	return &Directory{
+		clients: clients,
+	}
+}
+
+func (d *Directory) GetContact(ctx context.Context, patientId string) (*entity.Contact, error) {
+	patient, err := d.clients.PatientService().GetPatient(ctx, &bookingpb.PatientFieldValueReq{
+		Field: "id",
+		Value: patientId,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, entity.NewErrNotFound("patient")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Contact{
+		PatientId:   patient.Id,
+		UserId:      patient.UserId,
+		FirstName:   patient.FirstName,
+		LastName:    patient.LastName,
+		PhoneNumber: patient.PhoneNumber,
+	}, nil
+}
+
+// GetPushTokens returns the FCM tokens of the user's signed in devices.
+func (d *Directory) GetPushTokens(ctx context.Context, userId string) ([]string, error) {
+	sessions, err := d.clients.SessionService().GetUserSessions(ctx, &sessionpb.StrUserReq{UserId: userId})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		tokens []string
+		seen   = make(map[string]bool)
+	)
+	for _, session := range sessions.UserSessions {
+		// signed out sessions keep their row with deleted_at set
+		if session.FcmToken == "" || seen[session.FcmToken] || !sessionActive(session.DeletedAt) {
+			continue
+		}
+		seen[session.FcmToken] = true
+		tokens = append(tokens, session.FcmToken)
+	}
+
+	return tokens, nil
+}
+
+func sessionActive(deletedAt string) bool {
+	return deletedAt == "" || strings.HasPrefix(deletedAt, "0001-01-01")
+}
+
+func (d *Directory) GetDoctorName(ctx context.Context, doctorId string) (string, error) {
+	// is_active also matches deleted doctors, who keep their name
+	doctor, err := d.clients.DoctorService().GetDoctorById(ctx, &healthcarepb.GetReqStrDoctor{
+		Field:    "id",
+		Value:    doctorId,
+		IsActive: true,
+	})
+	if status.Code(err) == codes.NotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(doctor.FirstName + " " + doctor.LastName), nil
+}
+
+func (d *Directory) GetBranch(ctx context.Context, branchId string) (string, string, error) {
+	branch, err := d.clients.BranchService().GetBranchById(ctx, &healthcarepb.GetReqStrBranch{
+		Field:    "id",
+		Value:    branchId,
+		IsActive: true,
+	})
+	if status.Code(err) == codes.NotFound {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	return branch.Name, branch.Timezone, nil
+}
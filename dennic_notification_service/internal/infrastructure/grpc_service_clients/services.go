@@ -0,0 +1,85 @@
+package grpc_service_clients
+
+import (
+	bookingpb "dennic_notification_service/genproto/booking_service"
+	healthcarepb "dennic_notification_service/genproto/healthcare-service"
+	sessionpb "dennic_notification_service/genproto/session_service"
+	"dennic_notification_service/internal/pkg/config"
+	"fmt"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type ServiceClients interface {
+	PatientService() bookingpb.PatientsServiceClient
+	SessionService() sessionpb.SessionServiceClient
+	DoctorService() healthcarepb.DoctorServiceClient
+	BranchService() healthcarepb.BranchServiceClient
+	Close()
+}
+
+type serviceClients struct {
+	patientService bookingpb.PatientsServiceClient
+	sessionService sessionpb.SessionServiceClient
+	doctorService  healthcarepb.DoctorServiceClient
+	branchService  healthcarepb.BranchServiceClient
+	services       []*grpc.ClientConn
+}
+
+func New(config *config.Config) (ServiceClients, error) {
+	connBookingService, err := dial(config.BookingService.Host, config.BookingService.Port)
+	if err != nil {
+		return nil, err
+	}
+
+	connSessionService, err := dial(config.SessionService.Host, config.SessionService.Port)
+	if err != nil {
+		return nil, err
+	}
+
+	connHealthcareService, err := dial(config.HealthcareService.Host, config.HealthcareService.Port)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serviceClients{
+		patientService: bookingpb.NewPatientsServiceClient(connBookingService),
+		sessionService: sessionpb.NewSessionServiceClient(connSessionService),
+		doctorService:  healthcarepb.NewDoctorServiceClient(connHealthcareService),
+		branchService:  healthcarepb.NewBranchServiceClient(connHealthcareService),
+		services:       []*grpc.ClientConn{connBookingService, connSessionService, connHealthcareService},
+	}, nil
+}
+
+func dial(host, port string) (*grpc.ClientConn, error) {
+	return grpc.Dial(
+		fmt.Sprintf("%s%s", host, port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	)
+}
+
+func (s *serviceClients) PatientService() bookingpb.PatientsServiceClient {
+	return s.patientService
+}
+
+func (s *serviceClients) SessionService() sessionpb.SessionServiceClient {
+	return s.sessionService
+}
+
+func (s *serviceClients) DoctorService() healthcarepb.DoctorServiceClient {
+	return s.doctorService
+}
+
+func (s *serviceClients) BranchService() healthcarepb.BranchServiceClient {
+	return s.branchService
+}
+
+func (s *serviceClients) Close() {
+	for _, conn := range s.services {
+		conn.Close()
+	}
+}
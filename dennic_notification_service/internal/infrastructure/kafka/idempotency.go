@@ -0,0 +1,36 @@
+package kafka
+
+import (
+	"context"
+	"dennic_kafka/eventbus"
+	"dennic_notification_service/internal/usecase/event"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// duplicatesSkipped counts redelivered events a consumer did not handle again.
+var duplicatesSkipped, _ = otel.Meter("kafka consumer").Int64Counter("kafka.consumer.duplicates_skipped",
+	metric.WithDescription("Events skipped because the consumer had processed them before"))
+
+// Once handles an event at most once for the consumer: handle runs in the
+// transaction that records the event, and redeliveries of it are skipped.
+func Once(ctx context.Context, logger *zap.Logger, processed event.ProcessedEvents, consumer string, envelope *eventbus.Envelope, handle func(ctx context.Context) error) error {
+	handled, err := processed.Process(ctx, consumer, envelope.Id, envelope.Type, handle)
+	if err != nil {
+		return err
+	}
+
+	if !handled {
+		duplicatesSkipped.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("consumer", consumer),
+			attribute.String("event_type", envelope.Type),
+		))
+		logger.Info("consumer skipped a processed event", zap.String("consumer", consumer),
+			zap.String("event_id", envelope.Id), zap.String("event_type", envelope.Type))
+	}
+
+	return nil
+}
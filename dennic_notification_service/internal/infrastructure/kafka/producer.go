@@ -0,0 +1,81 @@
+package kafka
+
+import (
+	"context"
+	"dennic_kafka/eventbus"
+	"dennic_notification_service/genproto/events"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/pkg/config"
+	"dennic_notification_service/internal/pkg/otlp"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// unreadCountEventVersion is the version of the events published on the
+// inbox topic.
+const unreadCountEventVersion = 1
+
+type producer struct {
+	logger *zap.Logger
+	name   string
+	inbox  *kafka.Writer
+}
+
+func NewProducer(config *config.Config, logger *zap.Logger) *producer {
+	return &producer{
+		logger: logger,
+		name:   config.APP,
+		inbox: &kafka.Writer{
+			Addr:                   kafka.TCP(config.Kafka.Address...),
+			Topic:                  config.Kafka.Topic.Inbox,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+			Async:                  true, // make the writer asynchronous
+			Completion: func(messages []kafka.Message, err error) {
+				if err != nil {
+					logger.Error("kafka inbox", zap.Error(err))
+				}
+				for _, message := range messages {
+					logger.Info(
+						"kafka inbox message",
+						zap.Int("partition", message.Partition),
+						zap.Int64("offset", message.Offset),
+						zap.String("key", string(message.Key)),
+					)
+				}
+			},
+		},
+	}
+}
+
+// PublishUnreadCount keys the event by the user, so the counts of one user
+// are consumed in the order they changed.
+func (p *producer) PublishUnreadCount(ctx context.Context, count *entity.UnreadCount) error {
+	ctx, span := otlp.Start(ctx, "kafka producer", "UnreadCountProducer")
+	defer span.End()
+
+	value, err := eventbus.NewEnvelope(ctx, p.name, entity.EventUnreadCount, unreadCountEventVersion, time.Now(), &events.UnreadCount{
+		UserId: count.UserId,
+		Unread: count.Unread,
+	})
+	if err != nil {
+		return err
+	}
+
+	message := kafka.Message{
+		Key:   []byte(count.UserId),
+		Value: value,
+	}
+	eventbus.InjectTraceContext(ctx, &message)
+
+	return p.inbox.WriteMessages(ctx, message)
+}
+
+func (p *producer) Close() {
+	if err := p.inbox.Close(); err != nil {
+		p.logger.Error("error during close writer inbox", zap.Error(err))
+	}
+}
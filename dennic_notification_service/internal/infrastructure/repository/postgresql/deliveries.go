@@ -0,0 +1,271 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/pkg/otlp"
+	"dennic_notification_service/internal/pkg/postgres"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNameDeliveries    = "notification_deliveries"
+	serviceNameDeliveries  = "deliveriesRepo"
+	spanNameDeliveriesRepo = "deliveriesRepo"
+)
+
+type NotificationDeliveries struct {
+	db *postgres.PostgresDB
+}
+
+func NewNotificationDeliveries(db *postgres.PostgresDB) *NotificationDeliveries {
+	return &NotificationDeliveries{
+		db: db,
+	}
+}
+
+func tableColumDeliveries() string {
+	return `id,
+			COALESCE(reminder_id::text, ''),
+			COALESCE(appointment_id, 0),
+			kind,
+			COALESCE(user_id::text, ''),
+			COALESCE(patient_id::text, ''),
+			channel,
+			recipient,
+			language,
+			starts_at,
+			title,
+			body,
+			status,
+			attempts,
+			last_error,
+			provider_ref,
+			next_attempt_at,
+			sent_at,
+			created_at,
+			updated_at`
+}
+
+func scanDelivery(row pgx.Row) (*entity.Delivery, error) {
+	var (
+		delivery entity.Delivery
+		startsAt sql.NullTime
+		sentAt   sql.NullTime
+	)
+
+	if err := row.Scan(
+		&delivery.Id,
+		&delivery.ReminderId,
+		&delivery.AppointmentId,
+		&delivery.Kind,
+		&delivery.UserId,
+		&delivery.PatientId,
+		&delivery.Channel,
+		&delivery.Recipient,
+		&delivery.Language,
+		&startsAt,
+		&delivery.Title,
+		&delivery.Body,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.LastError,
+		&delivery.ProviderRef,
+		&delivery.NextAttemptAt,
+		&sentAt,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if startsAt.Valid {
+		delivery.StartsAt = startsAt.Time
+	}
+	if sentAt.Valid {
+		delivery.SentAt = sentAt.Time
+	}
+
+	return &delivery, nil
+}
+
+// QueueDeliveries queues deliveries about no reminder, in the transaction of
+// ctx when there is one.
+func (r *NotificationDeliveries) QueueDeliveries(ctx context.Context, deliveries []*entity.Delivery) error {
+	ctx, span := otlp.Start(ctx, serviceNameDeliveries, spanNameDeliveriesRepo+"Queue")
+	defer span.End()
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, appointment_id, kind, user_id, patient_id, channel, recipient, language, starts_at, title, body, status, next_attempt_at)
+		VALUES ($1, NULLIF($2, 0), $3, NULLIF($4, '')::uuid, NULLIF($5, '')::uuid, $6, $7, $8, $9, $10, $11, $12, $13)`, tableNameDeliveries)
+	for _, delivery := range deliveries {
+		var startsAt interface{}
+		if !delivery.StartsAt.IsZero() {
+			startsAt = delivery.StartsAt
+		}
+
+		if _, err := r.db.Conn(ctx).Exec(ctx, query,
+			delivery.Id,
+			delivery.AppointmentId,
+			delivery.Kind,
+			delivery.UserId,
+			delivery.PatientId,
+			delivery.Channel,
+			delivery.Recipient,
+			delivery.Language,
+			startsAt,
+			delivery.Title,
+			delivery.Body,
+			entity.DeliveryPending,
+			delivery.NextAttemptAt,
+		); err != nil {
+			return r.db.Error(err)
+		}
+	}
+
+	return nil
+}
+
+// ClaimPendingDeliveries returns the pending deliveries due to be sent and
+// leases them until now+lease, so other instances skip them meanwhile and a
+// crashed send is retried once the lease runs out.
+func (r *NotificationDeliveries) ClaimPendingDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit uint64) ([]*entity.Delivery, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDeliveries, spanNameDeliveriesRepo+"Claim")
+	defer span.End()
+
+	query := fmt.Sprintf(`UPDATE %[1]s SET next_attempt_at = $1
+		WHERE id IN (
+			SELECT id FROM %[1]s
+			WHERE status = $2 AND next_attempt_at <= $3
+			ORDER BY next_attempt_at
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING %[2]s`, tableNameDeliveries, tableColumDeliveries())
+
+	rows, err := r.db.Query(ctx, query, now.Add(lease), entity.DeliveryPending, now, limit)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer rows.Close()
+
+	var list []*entity.Delivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, delivery)
+	}
+
+	return list, rows.Err()
+}
+
+// UpdateDeliveryStatus records the outcome of an attempt to send a delivery.
+func (r *NotificationDeliveries) UpdateDeliveryStatus(ctx context.Context, req *entity.Delivery) error {
+	ctx, span := otlp.Start(ctx, serviceNameDeliveries, spanNameDeliveriesRepo+"Status")
+	defer span.End()
+
+	var sentAt interface{}
+	if !req.SentAt.IsZero() {
+		sentAt = req.SentAt
+	}
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameDeliveries).
+		SetMap(map[string]interface{}{
+			"status":          req.Status,
+			"attempts":        req.Attempts,
+			"last_error":      req.LastError,
+			"provider_ref":    req.ProviderRef,
+			"next_attempt_at": req.NextAttemptAt,
+			"sent_at":         sentAt,
+			"updated_at":      r.db.Sq.Expr("CURRENT_TIMESTAMP"),
+		}).
+		Where(r.db.Sq.Equal("id", req.Id)).
+		ToSql()
+	if err != nil {
+		return r.db.ErrSQLBuild(err, tableNameDeliveries+" status")
+	}
+
+	res, err := r.db.Exec(ctx, toSql, args...)
+	if err != nil {
+		return r.db.Error(err)
+	}
+	if res.RowsAffected() == 0 {
+		return entity.NewErrNotFound("delivery")
+	}
+
+	return nil
+}
+
+func (r *NotificationDeliveries) GetAllDeliveries(ctx context.Context, req *entity.GetAllDeliveries) (*entity.DeliveriesType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameDeliveries, spanNameDeliveriesRepo+"List")
+	defer span.End()
+
+	var list entity.DeliveriesType
+
+	where := r.db.Sq.And()
+	if req.UserId != "" {
+		where = append(where, r.db.Sq.Equal("user_id", req.UserId))
+	}
+	if req.PatientId != "" {
+		where = append(where, r.db.Sq.Equal("patient_id", req.PatientId))
+	}
+	if req.AppointmentId > 0 {
+		where = append(where, r.db.Sq.Equal("appointment_id", req.AppointmentId))
+	}
+	if req.Channel != "" {
+		where = append(where, r.db.Sq.Equal("channel", req.Channel))
+	}
+	if req.Status != "" {
+		where = append(where, r.db.Sq.Equal("status", req.Status))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumDeliveries()).
+		From(tableNameDeliveries).
+		Where(where).
+		OrderBy("created_at DESC")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameDeliveries+" list")
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNameDeliveries).Where(where).ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameDeliveries+" count")
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&list.Count); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		list.Deliveries = append(list.Deliveries, delivery)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
@@ -0,0 +1,216 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/pkg/otlp"
+	"dennic_notification_service/internal/pkg/postgres"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNameInbox    = "notification_inbox"
+	serviceNameInbox  = "inboxRepo"
+	spanNameInboxRepo = "inboxRepo"
+)
+
+type NotificationInbox struct {
+	db *postgres.PostgresDB
+}
+
+func NewNotificationInbox(db *postgres.PostgresDB) *NotificationInbox {
+	return &NotificationInbox{
+		db: db,
+	}
+}
+
+func tableColumInbox() string {
+	return `id,
+			user_id,
+			kind,
+			title,
+			body,
+			link,
+			data,
+			read_at,
+			created_at`
+}
+
+func scanInboxItem(row pgx.Row) (*entity.InboxItem, error) {
+	var (
+		item   entity.InboxItem
+		data   []byte
+		readAt sql.NullTime
+	)
+
+	if err := row.Scan(
+		&item.Id,
+		&item.UserId,
+		&item.Kind,
+		&item.Title,
+		&item.Body,
+		&item.Link,
+		&data,
+		&readAt,
+		&item.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &item.Data); err != nil {
+		return nil, err
+	}
+	if readAt.Valid {
+		item.ReadAt = readAt.Time
+	}
+
+	return &item, nil
+}
+
+// AddInboxItems puts unread notifications in the inboxes of their users, in
+// the transaction of ctx when there is one.
+func (r *NotificationInbox) AddInboxItems(ctx context.Context, items []*entity.InboxItem) error {
+	ctx, span := otlp.Start(ctx, serviceNameInbox, spanNameInboxRepo+"Add")
+	defer span.End()
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, user_id, kind, title, body, link, data)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`, tableNameInbox)
+	for _, item := range items {
+		data := []byte("{}")
+		if item.Data != nil {
+			var err error
+			if data, err = json.Marshal(item.Data); err != nil {
+				return err
+			}
+		}
+
+		if _, err := r.db.Conn(ctx).Exec(ctx, query,
+			item.Id,
+			item.UserId,
+			item.Kind,
+			item.Title,
+			item.Body,
+			item.Link,
+			data,
+		); err != nil {
+			return r.db.Error(err)
+		}
+	}
+
+	return nil
+}
+
+// GetInbox lists the notifications of a user, the newest first, with how
+// many of all of them are unread.
+func (r *NotificationInbox) GetInbox(ctx context.Context, req *entity.GetInbox) (*entity.InboxType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInbox, spanNameInboxRepo+"List")
+	defer span.End()
+
+	var list entity.InboxType
+
+	where := r.db.Sq.And(r.db.Sq.Equal("user_id", req.UserId))
+	if req.UnreadOnly {
+		where = append(where, r.db.Sq.Equal("read_at", nil))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumInbox()).
+		From(tableNameInbox).
+		Where(where).
+		OrderBy("created_at DESC", "id")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameInbox+" list")
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.
+		Select("count(*)", "count(*) FILTER (WHERE read_at IS NULL)").
+		From(tableNameInbox).
+		Where(r.db.Sq.Equal("user_id", req.UserId)).
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameInbox+" count")
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&list.Count, &list.Unread); err != nil {
+		return nil, r.db.Error(err)
+	}
+	if req.UnreadOnly {
+		list.Count = list.Unread
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item, err := scanInboxItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		list.Items = append(list.Items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// MarkInboxRead marks notifications of the user as read; ones read before
+// keep when they were read.
+func (r *NotificationInbox) MarkInboxRead(ctx context.Context, req *entity.MarkInboxRead) error {
+	ctx, span := otlp.Start(ctx, serviceNameInbox, spanNameInboxRepo+"MarkRead")
+	defer span.End()
+
+	where := r.db.Sq.And(
+		r.db.Sq.Equal("user_id", req.UserId),
+		r.db.Sq.Equal("read_at", nil),
+	)
+	if !req.All {
+		where = append(where, r.db.Sq.Equal("id", req.Ids))
+	}
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameInbox).
+		Set("read_at", r.db.Sq.Expr("CURRENT_TIMESTAMP")).
+		Where(where).
+		ToSql()
+	if err != nil {
+		return r.db.ErrSQLBuild(err, tableNameInbox+" mark read")
+	}
+
+	if _, err := r.db.Conn(ctx).Exec(ctx, toSql, args...); err != nil {
+		return r.db.Error(err)
+	}
+
+	return nil
+}
+
+// CountUnread counts the unread notifications of a user, in the transaction
+// of ctx when there is one.
+func (r *NotificationInbox) CountUnread(ctx context.Context, userId string) (int64, error) {
+	ctx, span := otlp.Start(ctx, serviceNameInbox, spanNameInboxRepo+"CountUnread")
+	defer span.End()
+
+	query := fmt.Sprintf(`SELECT count(*) FROM %s WHERE user_id = $1 AND read_at IS NULL`, tableNameInbox)
+
+	var unread int64
+	if err := r.db.Conn(ctx).QueryRow(ctx, query, userId).Scan(&unread); err != nil {
+		return 0, r.db.Error(err)
+	}
+
+	return unread, nil
+}
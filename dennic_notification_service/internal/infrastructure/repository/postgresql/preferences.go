@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"context"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/pkg/otlp"
+	"dennic_notification_service/internal/pkg/postgres"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNamePreferences    = "notification_preferences"
+	serviceNamePreferences  = "preferencesRepo"
+	spanNamePreferencesRepo = "preferencesRepo"
+)
+
+type NotificationPreferences struct {
+	db *postgres.PostgresDB
+}
+
+func NewNotificationPreferences(db *postgres.PostgresDB) *NotificationPreferences {
+	return &NotificationPreferences{
+		db: db,
+	}
+}
+
+func tableColumPreferences() string {
+	return `user_id,
+			language,
+			push,
+			sms,
+			email,
+			email_address,
+			reminders,
+			updated_at`
+}
+
+func scanPreferences(row pgx.Row) (*entity.Preferences, error) {
+	var preferences entity.Preferences
+
+	if err := row.Scan(
+		&preferences.UserId,
+		&preferences.Language,
+		&preferences.Push,
+		&preferences.Sms,
+		&preferences.Email,
+		&preferences.EmailAddress,
+		&preferences.Reminders,
+		&preferences.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &preferences, nil
+}
+
+func (r *NotificationPreferences) GetPreferences(ctx context.Context, userId string) (*entity.Preferences, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePreferences, spanNamePreferencesRepo+"Get")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumPreferences()).
+		From(tableNamePreferences).
+		Where(r.db.Sq.Equal("user_id", userId)).
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNamePreferences+" get")
+	}
+
+	preferences, err := scanPreferences(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return preferences, nil
+}
+
+func (r *NotificationPreferences) UpsertPreferences(ctx context.Context, req *entity.Preferences) (*entity.Preferences, error) {
+	ctx, span := otlp.Start(ctx, serviceNamePreferences, spanNamePreferencesRepo+"Upsert")
+	defer span.End()
+
+	query := fmt.Sprintf(`INSERT INTO %s (user_id, language, push, sms, email, email_address, reminders, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP)
+		ON CONFLICT (user_id) DO UPDATE SET
+			language = EXCLUDED.language,
+			push = EXCLUDED.push,
+			sms = EXCLUDED.sms,
+			email = EXCLUDED.email,
+			email_address = EXCLUDED.email_address,
+			reminders = EXCLUDED.reminders,
+			updated_at = EXCLUDED.updated_at
+		RETURNING %s`, tableNamePreferences, tableColumPreferences())
+
+	preferences, err := scanPreferences(r.db.QueryRow(ctx, query,
+		req.UserId,
+		req.Language,
+		req.Push,
+		req.Sms,
+		req.Email,
+		req.EmailAddress,
+		req.Reminders,
+	))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return preferences, nil
+}
@@ -0,0 +1,62 @@
+package repo
+
+import (
+	"context"
+	"dennic_notification_service/internal/pkg/otlp"
+	"dennic_notification_service/internal/pkg/postgres"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	tableNameProcessedEvents    = "processed_events"
+	serviceNameProcessedEvents  = "processedEventsRepo"
+	spanNameProcessedEventsRepo = "processedEventsRepo"
+)
+
+type ProcessedEvents struct {
+	db *postgres.PostgresDB
+}
+
+func NewProcessedEvents(db *postgres.PostgresDB) *ProcessedEvents {
+	return &ProcessedEvents{
+		db: db,
+	}
+}
+
+// Process records the event first, so a concurrent redelivery waits for this
+// transaction and is skipped once it commits.
+func (r *ProcessedEvents) Process(ctx context.Context, consumer, eventId, eventType string, handle func(ctx context.Context) error) (bool, error) {
+	ctx, span := otlp.Start(ctx, serviceNameProcessedEvents, spanNameProcessedEventsRepo+"Process")
+	defer span.End()
+	span.SetAttributes(attribute.Key("event_id").String(eventId), attribute.Key("event_type").String(eventType))
+
+	processed := false
+	err := r.db.InTx(ctx, func(ctx context.Context) error {
+		query, args, err := r.db.Sq.Builder.
+			Insert(tableNameProcessedEvents).
+			Columns("consumer", "event_id", "event_type").
+			Values(consumer, eventId, eventType).
+			Suffix("ON CONFLICT DO NOTHING").
+			ToSql()
+		if err != nil {
+			return r.db.ErrSQLBuild(err, tableNameProcessedEvents+" process")
+		}
+
+		tag, err := r.db.Conn(ctx).Exec(ctx, query, args...)
+		if err != nil {
+			return r.db.Error(err)
+		}
+		if tag.RowsAffected() == 0 {
+			return nil
+		}
+
+		processed = true
+		return handle(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return processed, nil
+}
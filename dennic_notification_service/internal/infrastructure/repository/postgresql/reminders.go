@@ -0,0 +1,255 @@
+package repo
+
+import (
+	"context"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/pkg/otlp"
+	"dennic_notification_service/internal/pkg/postgres"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNameReminders    = "notification_reminders"
+	serviceNameReminders  = "remindersRepo"
+	spanNameRemindersRepo = "remindersRepo"
+)
+
+type NotificationReminders struct {
+	db *postgres.PostgresDB
+}
+
+func NewNotificationReminders(db *postgres.PostgresDB) *NotificationReminders {
+	return &NotificationReminders{
+		db: db,
+	}
+}
+
+func tableColumReminders() string {
+	return `id,
+			appointment_id,
+			kind,
+			patient_id,
+			COALESCE(doctor_id::text, ''),
+			COALESCE(branch_id::text, ''),
+			modality,
+			starts_at,
+			send_at,
+			status,
+			event_at,
+			created_at,
+			updated_at`
+}
+
+func scanReminder(row pgx.Row) (*entity.Reminder, error) {
+	var reminder entity.Reminder
+
+	if err := row.Scan(
+		&reminder.Id,
+		&reminder.AppointmentId,
+		&reminder.Kind,
+		&reminder.PatientId,
+		&reminder.DoctorId,
+		&reminder.BranchId,
+		&reminder.Modality,
+		&reminder.StartsAt,
+		&reminder.SendAt,
+		&reminder.Status,
+		&reminder.EventAt,
+		&reminder.CreatedAt,
+		&reminder.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &reminder, nil
+}
+
+// ScheduleReminder schedules the reminder of an appointment or moves it to the
+// appointment's new time. Events older than the one the reminder follows are
+// ignored, and a reminder already sent is only scheduled again when the
+// appointment moved.
+func (r *NotificationReminders) ScheduleReminder(ctx context.Context, req *entity.Reminder) error {
+	ctx, span := otlp.Start(ctx, serviceNameReminders, spanNameRemindersRepo+"Schedule")
+	defer span.End()
+
+	query := fmt.Sprintf(`INSERT INTO %[1]s (id, appointment_id, kind, patient_id, doctor_id, branch_id, modality, starts_at, send_at, status, event_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, NULLIF($6, '')::uuid, $7, $8, $9, $10, $11)
+		ON CONFLICT (appointment_id, kind) DO UPDATE SET
+			patient_id = EXCLUDED.patient_id,
+			doctor_id = EXCLUDED.doctor_id,
+			branch_id = EXCLUDED.branch_id,
+			modality = EXCLUDED.modality,
+			starts_at = EXCLUDED.starts_at,
+			send_at = EXCLUDED.send_at,
+			status = EXCLUDED.status,
+			event_at = EXCLUDED.event_at,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE %[1]s.event_at < EXCLUDED.event_at
+		  AND (%[1]s.status <> '%[2]s' OR %[1]s.starts_at <> EXCLUDED.starts_at)`, tableNameReminders, entity.ReminderDispatched)
+
+	_, err := r.db.Conn(ctx).Exec(ctx, query,
+		req.Id,
+		req.AppointmentId,
+		req.Kind,
+		req.PatientId,
+		req.DoctorId,
+		req.BranchId,
+		req.Modality,
+		req.StartsAt,
+		req.SendAt,
+		entity.ReminderScheduled,
+		req.EventAt,
+	)
+	if err != nil {
+		return r.db.Error(err)
+	}
+
+	return nil
+}
+
+// CancelReminders cancels the appointment's reminders of the given kinds, or
+// all of them, unless they follow a newer event.
+func (r *NotificationReminders) CancelReminders(ctx context.Context, appointmentId int64, eventAt time.Time, kinds ...string) error {
+	ctx, span := otlp.Start(ctx, serviceNameReminders, spanNameRemindersRepo+"Cancel")
+	defer span.End()
+
+	toSql := r.db.Sq.Builder.
+		Update(tableNameReminders).
+		Set("status", entity.ReminderCancelled).
+		Set("event_at", eventAt).
+		Set("updated_at", r.db.Sq.Expr("CURRENT_TIMESTAMP")).
+		Where(r.db.Sq.Equal("appointment_id", appointmentId)).
+		Where(r.db.Sq.Lt("event_at", eventAt)).
+		Where(r.db.Sq.Equal("status", entity.ReminderScheduled))
+	if len(kinds) > 0 {
+		toSql = toSql.Where(r.db.Sq.Equal("kind", kinds))
+	}
+
+	query, args, err := toSql.ToSql()
+	if err != nil {
+		return r.db.ErrSQLBuild(err, tableNameReminders+" cancel")
+	}
+
+	if _, err = r.db.Conn(ctx).Exec(ctx, query, args...); err != nil {
+		return r.db.Error(err)
+	}
+
+	return nil
+}
+
+// GetDueReminders returns the scheduled reminders whose time has come.
+func (r *NotificationReminders) GetDueReminders(ctx context.Context, now time.Time, limit uint64) ([]*entity.Reminder, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReminders, spanNameRemindersRepo+"Due")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumReminders()).
+		From(tableNameReminders).
+		Where(r.db.Sq.Equal("status", entity.ReminderScheduled)).
+		Where(r.db.Sq.Expr("send_at <= ?", now)).
+		OrderBy("send_at").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameReminders+" due")
+	}
+
+	return r.list(ctx, toSql, args...)
+}
+
+// DispatchReminder queues the reminder's deliveries and marks it dispatched in
+// one transaction. It reports false when the reminder was cancelled or moved
+// in the meantime; deliveries queued before are not queued twice.
+func (r *NotificationReminders) DispatchReminder(ctx context.Context, reminder *entity.Reminder, deliveries []*entity.Delivery) (bool, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReminders, spanNameRemindersRepo+"Dispatch")
+	defer span.End()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	res, err := tx.Exec(ctx, fmt.Sprintf(`UPDATE %s SET status = $1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND status = $3 AND starts_at = $4`, tableNameReminders),
+		entity.ReminderDispatched,
+		reminder.Id,
+		entity.ReminderScheduled,
+		reminder.StartsAt,
+	)
+	if err != nil {
+		return false, r.db.Error(err)
+	}
+	if res.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, reminder_id, appointment_id, kind, user_id, patient_id, channel, recipient, language, starts_at, title, body, status, next_attempt_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, NULLIF($6, '')::uuid, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (reminder_id, starts_at, channel, recipient) DO NOTHING`, tableNameDeliveries)
+	for _, delivery := range deliveries {
+		if _, err = tx.Exec(ctx, query,
+			delivery.Id,
+			delivery.ReminderId,
+			delivery.AppointmentId,
+			delivery.Kind,
+			delivery.UserId,
+			delivery.PatientId,
+			delivery.Channel,
+			delivery.Recipient,
+			delivery.Language,
+			delivery.StartsAt,
+			delivery.Title,
+			delivery.Body,
+			entity.DeliveryPending,
+			delivery.NextAttemptAt,
+		); err != nil {
+			return false, r.db.Error(err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return false, r.db.Error(err)
+	}
+
+	return true, nil
+}
+
+func (r *NotificationReminders) GetAppointmentReminders(ctx context.Context, appointmentId int64) ([]*entity.Reminder, error) {
+	ctx, span := otlp.Start(ctx, serviceNameReminders, spanNameRemindersRepo+"List")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumReminders()).
+		From(tableNameReminders).
+		Where(r.db.Sq.Equal("appointment_id", appointmentId)).
+		OrderBy("send_at").
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameReminders+" list")
+	}
+
+	return r.list(ctx, toSql, args...)
+}
+
+func (r *NotificationReminders) list(ctx context.Context, query string, args ...interface{}) ([]*entity.Reminder, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer rows.Close()
+
+	var list []*entity.Reminder
+	for rows.Next() {
+		reminder, err := scanReminder(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, reminder)
+	}
+
+	return list, rows.Err()
+}
@@ -0,0 +1,810 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/pkg/otlp"
+	"dennic_notification_service/internal/pkg/postgres"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableNameWebhookPartners        = "webhook_partners"
+	tableNameWebhookPartnerPatients = "webhook_partner_patients"
+	tableNameWebhookSubscriptions   = "webhook_subscriptions"
+	tableNameWebhookDeliveries      = "webhook_deliveries"
+	tableNameWebhookAttempts        = "webhook_attempts"
+	serviceNameWebhooks             = "webhooksRepo"
+	spanNameWebhooksRepo            = "webhooksRepo"
+)
+
+type NotificationWebhooks struct {
+	db *postgres.PostgresDB
+}
+
+func NewNotificationWebhooks(db *postgres.PostgresDB) *NotificationWebhooks {
+	return &NotificationWebhooks{
+		db: db,
+	}
+}
+
+// the secret is left out, it is only read when it is set
+func tableColumWebhookSubscriptions() string {
+	return `id,
+			partner_id,
+			url,
+			event_types,
+			description,
+			active,
+			created_at,
+			updated_at`
+}
+
+func scanWebhookSubscription(row pgx.Row) (*entity.WebhookSubscription, error) {
+	var subscription entity.WebhookSubscription
+
+	if err := row.Scan(
+		&subscription.Id,
+		&subscription.PartnerId,
+		&subscription.Url,
+		&subscription.EventTypes,
+		&subscription.Description,
+		&subscription.Active,
+		&subscription.CreatedAt,
+		&subscription.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func tableColumWebhookDeliveries() string {
+	return `id,
+			subscription_id,
+			event_id,
+			event_type,
+			payload,
+			status,
+			attempts,
+			response_code,
+			last_error,
+			next_attempt_at,
+			delivered_at,
+			created_at,
+			updated_at`
+}
+
+func webhookDeliveryFields(delivery *entity.WebhookDelivery, deliveredAt *sql.NullTime) []interface{} {
+	return []interface{}{
+		&delivery.Id,
+		&delivery.SubscriptionId,
+		&delivery.EventId,
+		&delivery.EventType,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.ResponseCode,
+		&delivery.LastError,
+		&delivery.NextAttemptAt,
+		deliveredAt,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+	}
+}
+
+func scanWebhookDelivery(row pgx.Row) (*entity.WebhookDelivery, error) {
+	var (
+		delivery    entity.WebhookDelivery
+		deliveredAt sql.NullTime
+	)
+
+	if err := row.Scan(webhookDeliveryFields(&delivery, &deliveredAt)...); err != nil {
+		return nil, err
+	}
+	if deliveredAt.Valid {
+		delivery.DeliveredAt = deliveredAt.Time
+	}
+
+	return &delivery, nil
+}
+
+func (r *NotificationWebhooks) CreatePartner(ctx context.Context, req *entity.WebhookPartner) (*entity.WebhookPartner, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"CreatePartner")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Insert(tableNameWebhookPartners).
+		SetMap(map[string]interface{}{
+			"id":   req.Id,
+			"name": req.Name,
+		}).
+		Suffix("RETURNING id, name, created_at").
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookPartners+" create")
+	}
+
+	var partner entity.WebhookPartner
+	if err = r.db.QueryRow(ctx, toSql, args...).Scan(&partner.Id, &partner.Name, &partner.CreatedAt); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return &partner, nil
+}
+
+func (r *NotificationWebhooks) GetPartner(ctx context.Context, id string) (*entity.WebhookPartner, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"GetPartner")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select("id, name, created_at").
+		From(tableNameWebhookPartners).
+		Where(r.db.Sq.Equal("id", id)).
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookPartners+" get")
+	}
+
+	var partner entity.WebhookPartner
+	if err = r.db.QueryRow(ctx, toSql, args...).Scan(&partner.Id, &partner.Name, &partner.CreatedAt); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return &partner, nil
+}
+
+func (r *NotificationWebhooks) GetAllPartners(ctx context.Context, req *entity.GetAllWebhookPartners) (*entity.WebhookPartnersType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"ListPartners")
+	defer span.End()
+
+	var list entity.WebhookPartnersType
+
+	toSql := r.db.Sq.Builder.
+		Select("id, name, created_at").
+		From(tableNameWebhookPartners).
+		OrderBy("name", "id")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookPartners+" list")
+	}
+
+	if err = r.db.QueryRow(ctx, "SELECT count(*) FROM "+tableNameWebhookPartners).Scan(&list.Count); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var partner entity.WebhookPartner
+		if err := rows.Scan(&partner.Id, &partner.Name, &partner.CreatedAt); err != nil {
+			return nil, err
+		}
+		list.Partners = append(list.Partners, &partner)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// DeletePartner deletes a partner with its subscriptions, their delivery
+// logs and its patient links.
+func (r *NotificationWebhooks) DeletePartner(ctx context.Context, id string) (*entity.WebhookPartner, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"DeletePartner")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Delete(tableNameWebhookPartners).
+		Where(r.db.Sq.Equal("id", id)).
+		Suffix("RETURNING id, name, created_at").
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookPartners+" delete")
+	}
+
+	var partner entity.WebhookPartner
+	if err = r.db.QueryRow(ctx, toSql, args...).Scan(&partner.Id, &partner.Name, &partner.CreatedAt); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return &partner, nil
+}
+
+// LinkPatient links a patient to a partner; linking them again keeps the
+// first link.
+func (r *NotificationWebhooks) LinkPatient(ctx context.Context, req *entity.WebhookPartnerPatient) (*entity.WebhookPartnerPatient, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"LinkPatient")
+	defer span.End()
+
+	query := fmt.Sprintf(`INSERT INTO %s (partner_id, patient_id) VALUES ($1, $2)
+		ON CONFLICT (partner_id, patient_id) DO UPDATE SET partner_id = EXCLUDED.partner_id
+		RETURNING partner_id, patient_id, created_at`, tableNameWebhookPartnerPatients)
+
+	var link entity.WebhookPartnerPatient
+	if err := r.db.QueryRow(ctx, query, req.PartnerId, req.PatientId).Scan(&link.PartnerId, &link.PatientId, &link.CreatedAt); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return &link, nil
+}
+
+func (r *NotificationWebhooks) UnlinkPatient(ctx context.Context, req *entity.WebhookPartnerPatient) (*entity.WebhookPartnerPatient, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"UnlinkPatient")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Delete(tableNameWebhookPartnerPatients).
+		Where(r.db.Sq.And(
+			r.db.Sq.Equal("partner_id", req.PartnerId),
+			r.db.Sq.Equal("patient_id", req.PatientId),
+		)).
+		Suffix("RETURNING partner_id, patient_id, created_at").
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookPartnerPatients+" delete")
+	}
+
+	var link entity.WebhookPartnerPatient
+	if err = r.db.QueryRow(ctx, toSql, args...).Scan(&link.PartnerId, &link.PatientId, &link.CreatedAt); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return &link, nil
+}
+
+func (r *NotificationWebhooks) GetAllPartnerPatients(ctx context.Context, req *entity.GetAllWebhookPartnerPatients) (*entity.WebhookPartnerPatientsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"ListPartnerPatients")
+	defer span.End()
+
+	var list entity.WebhookPartnerPatientsType
+
+	where := r.db.Sq.Equal("partner_id", req.PartnerId)
+
+	toSql := r.db.Sq.Builder.
+		Select("partner_id, patient_id, created_at").
+		From(tableNameWebhookPartnerPatients).
+		Where(where).
+		OrderBy("created_at DESC", "patient_id")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookPartnerPatients+" list")
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNameWebhookPartnerPatients).Where(where).ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookPartnerPatients+" count")
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&list.Count); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var link entity.WebhookPartnerPatient
+		if err := rows.Scan(&link.PartnerId, &link.PatientId, &link.CreatedAt); err != nil {
+			return nil, err
+		}
+		list.Patients = append(list.Patients, &link)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+func (r *NotificationWebhooks) CreateSubscription(ctx context.Context, req *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"CreateSubscription")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Insert(tableNameWebhookSubscriptions).
+		SetMap(map[string]interface{}{
+			"id":          req.Id,
+			"partner_id":  req.PartnerId,
+			"url":         req.Url,
+			"event_types": req.EventTypes,
+			"description": req.Description,
+			"secret":      req.Secret,
+			"active":      req.Active,
+		}).
+		Suffix("RETURNING " + tableColumWebhookSubscriptions()).
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookSubscriptions+" create")
+	}
+
+	subscription, err := scanWebhookSubscription(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return subscription, nil
+}
+
+func (r *NotificationWebhooks) GetSubscription(ctx context.Context, id string) (*entity.WebhookSubscription, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"GetSubscription")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumWebhookSubscriptions()).
+		From(tableNameWebhookSubscriptions).
+		Where(r.db.Sq.Equal("id", id)).
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookSubscriptions+" get")
+	}
+
+	subscription, err := scanWebhookSubscription(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return subscription, nil
+}
+
+func (r *NotificationWebhooks) GetAllSubscriptions(ctx context.Context, req *entity.GetAllWebhookSubscriptions) (*entity.WebhookSubscriptionsType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"ListSubscriptions")
+	defer span.End()
+
+	var list entity.WebhookSubscriptionsType
+
+	where := r.db.Sq.And()
+	if req.EventType != "" {
+		where = append(where, r.db.Sq.Expr("? = ANY(event_types)", req.EventType))
+	}
+	if req.PartnerId != "" {
+		where = append(where, r.db.Sq.Equal("partner_id", req.PartnerId))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumWebhookSubscriptions()).
+		From(tableNameWebhookSubscriptions).
+		Where(where).
+		OrderBy("created_at DESC", "id")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookSubscriptions+" list")
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNameWebhookSubscriptions).Where(where).ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookSubscriptions+" count")
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&list.Count); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		subscription, err := scanWebhookSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		list.Subscriptions = append(list.Subscriptions, subscription)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// GetSubscribers returns the active subscriptions to an event type of the
+// partners a patient is linked to.
+func (r *NotificationWebhooks) GetSubscribers(ctx context.Context, eventType, patientId string) ([]*entity.WebhookSubscription, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"GetSubscribers")
+	defer span.End()
+
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE active AND $1 = ANY(event_types)
+		AND partner_id IN (SELECT partner_id FROM %s WHERE patient_id = $2)`,
+		tableColumWebhookSubscriptions(), tableNameWebhookSubscriptions, tableNameWebhookPartnerPatients)
+
+	rows, err := r.db.Conn(ctx).Query(ctx, query, eventType, patientId)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer rows.Close()
+
+	var list []*entity.WebhookSubscription
+	for rows.Next() {
+		subscription, err := scanWebhookSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, subscription)
+	}
+
+	return list, rows.Err()
+}
+
+// UpdateSubscription changes the url, event types, description and active of
+// a subscription; the secret is changed by SetSecret only.
+func (r *NotificationWebhooks) UpdateSubscription(ctx context.Context, req *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"UpdateSubscription")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameWebhookSubscriptions).
+		SetMap(map[string]interface{}{
+			"url":         req.Url,
+			"event_types": req.EventTypes,
+			"description": req.Description,
+			"active":      req.Active,
+			"updated_at":  r.db.Sq.Expr("CURRENT_TIMESTAMP"),
+		}).
+		Where(r.db.Sq.Equal("id", req.Id)).
+		Suffix("RETURNING " + tableColumWebhookSubscriptions()).
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookSubscriptions+" update")
+	}
+
+	subscription, err := scanWebhookSubscription(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return subscription, nil
+}
+
+// SetSecret replaces the secret of a subscription; deliveries claimed after
+// it are signed with the new one.
+func (r *NotificationWebhooks) SetSecret(ctx context.Context, id, secret string) (*entity.WebhookSubscription, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"SetSecret")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameWebhookSubscriptions).
+		SetMap(map[string]interface{}{
+			"secret":     secret,
+			"updated_at": r.db.Sq.Expr("CURRENT_TIMESTAMP"),
+		}).
+		Where(r.db.Sq.Equal("id", id)).
+		Suffix("RETURNING " + tableColumWebhookSubscriptions()).
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookSubscriptions+" secret")
+	}
+
+	subscription, err := scanWebhookSubscription(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return subscription, nil
+}
+
+// DeleteSubscription deletes a subscription with its delivery log.
+func (r *NotificationWebhooks) DeleteSubscription(ctx context.Context, id string) (*entity.WebhookSubscription, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"DeleteSubscription")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Delete(tableNameWebhookSubscriptions).
+		Where(r.db.Sq.Equal("id", id)).
+		Suffix("RETURNING " + tableColumWebhookSubscriptions()).
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookSubscriptions+" delete")
+	}
+
+	subscription, err := scanWebhookSubscription(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return subscription, nil
+}
+
+// QueueWebhookDeliveries queues deliveries, in the transaction of ctx when
+// there is one. An event is queued once per subscription.
+func (r *NotificationWebhooks) QueueWebhookDeliveries(ctx context.Context, deliveries []*entity.WebhookDelivery) error {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"Queue")
+	defer span.End()
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, subscription_id, event_id, event_type, payload, status, next_attempt_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (subscription_id, event_id, event_type) DO NOTHING`, tableNameWebhookDeliveries)
+	for _, delivery := range deliveries {
+		if _, err := r.db.Conn(ctx).Exec(ctx, query,
+			delivery.Id,
+			delivery.SubscriptionId,
+			delivery.EventId,
+			delivery.EventType,
+			delivery.Payload,
+			entity.WebhookPending,
+			delivery.NextAttemptAt,
+		); err != nil {
+			return r.db.Error(err)
+		}
+	}
+
+	return nil
+}
+
+// ClaimPendingWebhookDeliveries returns the pending deliveries due to be sent
+// with the url, secret and active of their subscriptions, and leases them
+// until now+lease, so other instances skip them meanwhile and a crashed send
+// is retried once the lease runs out.
+func (r *NotificationWebhooks) ClaimPendingWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit uint64) ([]*entity.WebhookDelivery, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"Claim")
+	defer span.End()
+
+	query := fmt.Sprintf(`UPDATE %[1]s d SET next_attempt_at = $1
+		FROM %[2]s s
+		WHERE s.id = d.subscription_id AND d.id IN (
+			SELECT id FROM %[1]s
+			WHERE status = $2 AND next_attempt_at <= $3
+			ORDER BY next_attempt_at
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.status, d.attempts, d.response_code,
+			d.last_error, d.next_attempt_at, d.delivered_at, d.created_at, d.updated_at, s.url, s.secret, s.active`,
+		tableNameWebhookDeliveries, tableNameWebhookSubscriptions)
+
+	rows, err := r.db.Query(ctx, query, now.Add(lease), entity.WebhookPending, now, limit)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer rows.Close()
+
+	var list []*entity.WebhookDelivery
+	for rows.Next() {
+		var (
+			delivery    entity.WebhookDelivery
+			deliveredAt sql.NullTime
+		)
+		fields := append(webhookDeliveryFields(&delivery, &deliveredAt), &delivery.Url, &delivery.Secret, &delivery.Active)
+		if err := rows.Scan(fields...); err != nil {
+			return nil, err
+		}
+		if deliveredAt.Valid {
+			delivery.DeliveredAt = deliveredAt.Time
+		}
+		list = append(list, &delivery)
+	}
+
+	return list, rows.Err()
+}
+
+// RecordWebhookAttempt logs an attempt to send a delivery and keeps its
+// outcome on the delivery.
+func (r *NotificationWebhooks) RecordWebhookAttempt(ctx context.Context, delivery *entity.WebhookDelivery, attempt *entity.WebhookAttempt) error {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"RecordAttempt")
+	defer span.End()
+
+	var deliveredAt interface{}
+	if !delivery.DeliveredAt.IsZero() {
+		deliveredAt = delivery.DeliveredAt
+	}
+
+	return r.db.InTx(ctx, func(ctx context.Context) error {
+		query := fmt.Sprintf(`INSERT INTO %s (id, delivery_id, attempt, response_code, error, duration_ms)
+			VALUES ($1, $2, $3, $4, $5, $6)`, tableNameWebhookAttempts)
+		if _, err := r.db.Conn(ctx).Exec(ctx, query,
+			attempt.Id,
+			delivery.Id,
+			attempt.Attempt,
+			attempt.ResponseCode,
+			attempt.Error,
+			attempt.Duration.Milliseconds(),
+		); err != nil {
+			return r.db.Error(err)
+		}
+
+		toSql, args, err := r.db.Sq.Builder.
+			Update(tableNameWebhookDeliveries).
+			SetMap(map[string]interface{}{
+				"status":          delivery.Status,
+				"attempts":        delivery.Attempts,
+				"response_code":   delivery.ResponseCode,
+				"last_error":      delivery.LastError,
+				"next_attempt_at": delivery.NextAttemptAt,
+				"delivered_at":    deliveredAt,
+				"updated_at":      r.db.Sq.Expr("CURRENT_TIMESTAMP"),
+			}).
+			Where(r.db.Sq.Equal("id", delivery.Id)).
+			ToSql()
+		if err != nil {
+			return r.db.ErrSQLBuild(err, tableNameWebhookDeliveries+" attempt")
+		}
+
+		res, err := r.db.Conn(ctx).Exec(ctx, toSql, args...)
+		if err != nil {
+			return r.db.Error(err)
+		}
+		if res.RowsAffected() == 0 {
+			return entity.NewErrNotFound("webhook delivery")
+		}
+
+		return nil
+	})
+}
+
+// GetWebhookDelivery returns a delivery with every attempt made to send it,
+// the first first.
+func (r *NotificationWebhooks) GetWebhookDelivery(ctx context.Context, id string) (*entity.WebhookDelivery, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"GetDelivery")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Select(tableColumWebhookDeliveries()).
+		From(tableNameWebhookDeliveries).
+		Where(r.db.Sq.Equal("id", id)).
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookDeliveries+" get")
+	}
+
+	delivery, err := scanWebhookDelivery(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	query := fmt.Sprintf(`SELECT id, delivery_id, attempt, response_code, error, duration_ms, created_at
+		FROM %s WHERE delivery_id = $1 ORDER BY created_at, attempt`, tableNameWebhookAttempts)
+	rows, err := r.db.Query(ctx, query, id)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			attempt    entity.WebhookAttempt
+			durationMs int64
+		)
+		if err := rows.Scan(
+			&attempt.Id,
+			&attempt.DeliveryId,
+			&attempt.Attempt,
+			&attempt.ResponseCode,
+			&attempt.Error,
+			&durationMs,
+			&attempt.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		attempt.Duration = time.Duration(durationMs) * time.Millisecond
+		delivery.AttemptLog = append(delivery.AttemptLog, &attempt)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (r *NotificationWebhooks) GetAllWebhookDeliveries(ctx context.Context, req *entity.GetAllWebhookDeliveries) (*entity.WebhookDeliveriesType, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"ListDeliveries")
+	defer span.End()
+
+	var list entity.WebhookDeliveriesType
+
+	where := r.db.Sq.And()
+	if req.SubscriptionId != "" {
+		where = append(where, r.db.Sq.Equal("subscription_id", req.SubscriptionId))
+	}
+	if req.EventType != "" {
+		where = append(where, r.db.Sq.Equal("event_type", req.EventType))
+	}
+	if req.Status != "" {
+		where = append(where, r.db.Sq.Equal("status", req.Status))
+	}
+
+	toSql := r.db.Sq.Builder.
+		Select(tableColumWebhookDeliveries()).
+		From(tableNameWebhookDeliveries).
+		Where(where).
+		OrderBy("created_at DESC", "id")
+	if req.Page >= 1 && req.Limit >= 1 {
+		toSql = toSql.
+			Limit(req.Limit).
+			Offset(req.Limit * (req.Page - 1))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookDeliveries+" list")
+	}
+
+	queryCount, countArgs, err := r.db.Sq.Builder.Select("count(*)").From(tableNameWebhookDeliveries).Where(where).ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookDeliveries+" count")
+	}
+
+	if err = r.db.QueryRow(ctx, queryCount, countArgs...).Scan(&list.Count); err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	rows, err := r.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		list.Deliveries = append(list.Deliveries, delivery)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// RedeliverWebhook queues a delivery to be sent again at now with a fresh
+// set of attempts; the attempts made before stay in the log.
+func (r *NotificationWebhooks) RedeliverWebhook(ctx context.Context, id string, now time.Time) (*entity.WebhookDelivery, error) {
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooksRepo+"Redeliver")
+	defer span.End()
+
+	toSql, args, err := r.db.Sq.Builder.
+		Update(tableNameWebhookDeliveries).
+		SetMap(map[string]interface{}{
+			"status":          entity.WebhookPending,
+			"attempts":        0,
+			"last_error":      "",
+			"next_attempt_at": now,
+			"updated_at":      r.db.Sq.Expr("CURRENT_TIMESTAMP"),
+		}).
+		Where(r.db.Sq.Equal("id", id)).
+		Suffix("RETURNING " + tableColumWebhookDeliveries()).
+		ToSql()
+	if err != nil {
+		return nil, r.db.ErrSQLBuild(err, tableNameWebhookDeliveries+" redeliver")
+	}
+
+	delivery, err := scanWebhookDelivery(r.db.QueryRow(ctx, toSql, args...))
+	if err != nil {
+		return nil, r.db.Error(err)
+	}
+
+	return delivery, nil
+}
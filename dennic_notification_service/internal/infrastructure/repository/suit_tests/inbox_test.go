@@ -0,0 +1,100 @@
+package suit_tests
+
+import (
+	"context"
+	"dennic_notification_service/internal/entity"
+	repo "dennic_notification_service/internal/infrastructure/repository/postgresql"
+	"dennic_notification_service/internal/pkg/config"
+	db "dennic_notification_service/internal/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type NotificationInboxTestSite struct {
+	suite.Suite
+	Repository  *repo.NotificationInbox
+	CleanUpFunc func()
+}
+
+func (s *NotificationInboxTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewNotificationInbox(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *NotificationInboxTestSite) TestInbox() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	userId := uuid.NewString()
+	items := []*entity.InboxItem{
+		{
+			Id:     uuid.NewString(),
+			UserId: userId,
+			Kind:   entity.KindAppointmentConfirmed,
+			Title:  "Confirmed",
+			Body:   "Your appointment is confirmed",
+			Link:   "dennic://appointments/17",
+			Data:   map[string]string{"appointment_id": "17"},
+		},
+		{
+			Id:     uuid.NewString(),
+			UserId: userId,
+			Kind:   entity.KindLabResultsReady,
+			Title:  "Lab results",
+			Body:   "Your lab results are ready",
+		},
+	}
+	s.Suite.NoError(s.Repository.AddInboxItems(ctx, items))
+
+	inbox, err := s.Repository.GetInbox(ctx, &entity.GetInbox{UserId: userId, Page: 1, Limit: 10})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), inbox.Count)
+	s.Suite.Equal(int64(2), inbox.Unread)
+	s.Suite.Len(inbox.Items, 2)
+	for _, item := range inbox.Items {
+		s.Suite.True(item.ReadAt.IsZero())
+		if item.Id == items[0].Id {
+			s.Suite.Equal("dennic://appointments/17", item.Link)
+			s.Suite.Equal("17", item.Data["appointment_id"])
+		}
+	}
+
+	// a page holds at most limit notifications
+	inbox, err = s.Repository.GetInbox(ctx, &entity.GetInbox{UserId: userId, Page: 2, Limit: 1})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), inbox.Count)
+	s.Suite.Len(inbox.Items, 1)
+
+	s.Suite.NoError(s.Repository.MarkInboxRead(ctx, &entity.MarkInboxRead{UserId: userId, Ids: []string{items[0].Id}}))
+	unread, err := s.Repository.CountUnread(ctx, userId)
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), unread)
+
+	inbox, err = s.Repository.GetInbox(ctx, &entity.GetInbox{UserId: userId, UnreadOnly: true})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), inbox.Count)
+	s.Suite.Len(inbox.Items, 1)
+	s.Suite.Equal(items[1].Id, inbox.Items[0].Id)
+
+	// marking read only touches the inbox of the user
+	s.Suite.NoError(s.Repository.MarkInboxRead(ctx, &entity.MarkInboxRead{UserId: uuid.NewString(), All: true}))
+	unread, err = s.Repository.CountUnread(ctx, userId)
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), unread)
+
+	s.Suite.NoError(s.Repository.MarkInboxRead(ctx, &entity.MarkInboxRead{UserId: userId, All: true}))
+	unread, err = s.Repository.CountUnread(ctx, userId)
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(0), unread)
+}
+
+func (s *NotificationInboxTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestNotificationInboxTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationInboxTestSite))
+}
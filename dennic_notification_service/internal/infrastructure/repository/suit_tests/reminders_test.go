@@ -0,0 +1,191 @@
+package suit_tests
+
+import (
+	"context"
+	"dennic_notification_service/internal/entity"
+	repo "dennic_notification_service/internal/infrastructure/repository/postgresql"
+	"dennic_notification_service/internal/pkg/config"
+	db "dennic_notification_service/internal/pkg/postgres"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+type NotificationRemindersTestSite struct {
+	suite.Suite
+	Repository  *repo.NotificationReminders
+	Deliveries  *repo.NotificationDeliveries
+	Processed   *repo.ProcessedEvents
+	CleanUpFunc func()
+}
+
+func (s *NotificationRemindersTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewNotificationReminders(pgPool)
+	s.Deliveries = repo.NewNotificationDeliveries(pgPool)
+	s.Processed = repo.NewProcessedEvents(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *NotificationRemindersTestSite) TestReminderLifecycle() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	now := time.Now().Truncate(time.Second)
+	appointmentId := rand.Int63n(1<<31-1) + 1
+	reminder := &entity.Reminder{
+		Id:            uuid.NewString(),
+		AppointmentId: appointmentId,
+		Kind:          entity.KindReminder2h,
+		PatientId:     uuid.NewString(),
+		Modality:      "offline",
+		StartsAt:      now.Add(time.Hour),
+		SendAt:        now.Add(-time.Hour),
+		EventAt:       now.Add(-time.Minute),
+	}
+	s.Suite.NoError(s.Repository.ScheduleReminder(ctx, reminder))
+
+	// an older event does not undo the schedule
+	s.Suite.NoError(s.Repository.CancelReminders(ctx, appointmentId, now.Add(-time.Hour)))
+	list, err := s.Repository.GetAppointmentReminders(ctx, appointmentId)
+	s.Suite.NoError(err)
+	s.Suite.Len(list, 1)
+	s.Suite.Equal(entity.ReminderScheduled, list[0].Status)
+
+	due, err := s.Repository.GetDueReminders(ctx, now, 1000)
+	s.Suite.NoError(err)
+	var found *entity.Reminder
+	for _, r := range due {
+		if r.AppointmentId == appointmentId {
+			found = r
+		}
+	}
+	s.Suite.NotNil(found)
+
+	delivery := &entity.Delivery{
+		Id:            uuid.NewString(),
+		ReminderId:    found.Id,
+		AppointmentId: appointmentId,
+		Kind:          found.Kind,
+		PatientId:     found.PatientId,
+		Channel:       entity.ChannelSMS,
+		Recipient:     "+998901234567",
+		Language:      entity.LanguageUz,
+		StartsAt:      found.StartsAt,
+		Body:          "Reminder",
+		NextAttemptAt: now,
+	}
+	ok, err := s.Repository.DispatchReminder(ctx, found, []*entity.Delivery{delivery})
+	s.Suite.NoError(err)
+	s.Suite.True(ok)
+
+	// a reminder is dispatched once
+	ok, err = s.Repository.DispatchReminder(ctx, found, []*entity.Delivery{delivery})
+	s.Suite.NoError(err)
+	s.Suite.False(ok)
+
+	deliveries, err := s.Deliveries.GetAllDeliveries(ctx, &entity.GetAllDeliveries{AppointmentId: appointmentId})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), deliveries.Count)
+	s.Suite.Equal(entity.DeliveryPending, deliveries.Deliveries[0].Status)
+
+	// cancelling a newer event leaves a dispatched reminder alone
+	s.Suite.NoError(s.Repository.CancelReminders(ctx, appointmentId, now))
+	list, err = s.Repository.GetAppointmentReminders(ctx, appointmentId)
+	s.Suite.NoError(err)
+	s.Suite.Equal(entity.ReminderDispatched, list[0].Status)
+}
+
+func (s *NotificationRemindersTestSite) TestProcessedEvents() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	now := time.Now().Truncate(time.Second)
+	appointmentId := rand.Int63n(1<<31-1) + 1
+	schedule := func(ctx context.Context) error {
+		return s.Repository.ScheduleReminder(ctx, &entity.Reminder{
+			Id:            uuid.NewString(),
+			AppointmentId: appointmentId,
+			Kind:          entity.KindReminder24h,
+			PatientId:     uuid.NewString(),
+			Modality:      "offline",
+			StartsAt:      now.Add(48 * time.Hour),
+			SendAt:        now.Add(24 * time.Hour),
+			EventAt:       now,
+		})
+	}
+
+	// a failed handling records neither the event nor its writes
+	eventId := uuid.NewString()
+	processed, err := s.Processed.Process(ctx, "suit_tests", eventId, entity.EventAppointmentCreated, func(ctx context.Context) error {
+		s.Suite.NoError(schedule(ctx))
+		return errors.New("directory is down")
+	})
+	s.Suite.Error(err)
+	s.Suite.False(processed)
+	list, err := s.Repository.GetAppointmentReminders(ctx, appointmentId)
+	s.Suite.NoError(err)
+	s.Suite.Len(list, 0)
+
+	processed, err = s.Processed.Process(ctx, "suit_tests", eventId, entity.EventAppointmentCreated, schedule)
+	s.Suite.NoError(err)
+	s.Suite.True(processed)
+
+	// the redelivery is skipped, other consumers still get it
+	processed, err = s.Processed.Process(ctx, "suit_tests", eventId, entity.EventAppointmentCreated, func(ctx context.Context) error {
+		s.Suite.Fail("handled a processed event")
+		return nil
+	})
+	s.Suite.NoError(err)
+	s.Suite.False(processed)
+
+	processed, err = s.Processed.Process(ctx, "suit_tests_other", eventId, entity.EventAppointmentCreated, func(ctx context.Context) error {
+		return nil
+	})
+	s.Suite.NoError(err)
+	s.Suite.True(processed)
+
+	list, err = s.Repository.GetAppointmentReminders(ctx, appointmentId)
+	s.Suite.NoError(err)
+	s.Suite.Len(list, 1)
+}
+
+func (s *NotificationRemindersTestSite) TestQueueDeliveries() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	now := time.Now().Truncate(time.Second)
+	userId := uuid.NewString()
+	err := s.Deliveries.QueueDeliveries(ctx, []*entity.Delivery{{
+		Id:            uuid.NewString(),
+		Kind:          entity.KindWelcome,
+		UserId:        userId,
+		Channel:       entity.ChannelSMS,
+		Recipient:     "+998901234567",
+		Language:      entity.LanguageUz,
+		Title:         "Welcome",
+		Body:          "Welcome",
+		NextAttemptAt: now,
+	}})
+	s.Suite.NoError(err)
+
+	// a welcome is about no appointment
+	deliveries, err := s.Deliveries.GetAllDeliveries(ctx, &entity.GetAllDeliveries{UserId: userId})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), deliveries.Count)
+	s.Suite.Equal(entity.KindWelcome, deliveries.Deliveries[0].Kind)
+	s.Suite.Equal(int64(0), deliveries.Deliveries[0].AppointmentId)
+	s.Suite.True(deliveries.Deliveries[0].StartsAt.IsZero())
+	s.Suite.Equal(entity.DeliveryPending, deliveries.Deliveries[0].Status)
+}
+
+func (s *NotificationRemindersTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestNotificationRemindersTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationRemindersTestSite))
+}
@@ -0,0 +1,189 @@
+package suit_tests
+
+import (
+	"context"
+	"dennic_notification_service/internal/entity"
+	repo "dennic_notification_service/internal/infrastructure/repository/postgresql"
+	"dennic_notification_service/internal/pkg/config"
+	db "dennic_notification_service/internal/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type NotificationWebhooksTestSite struct {
+	suite.Suite
+	Repository  *repo.NotificationWebhooks
+	CleanUpFunc func()
+}
+
+func (s *NotificationWebhooksTestSite) SetupSuite() {
+	pgPool, _ := db.New(config.New())
+	s.Repository = repo.NewNotificationWebhooks(pgPool)
+	s.CleanUpFunc = pgPool.Close
+}
+
+func (s *NotificationWebhooksTestSite) TestWebhooks() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(2))
+	defer cancel()
+
+	partner, err := s.Repository.CreatePartner(ctx, &entity.WebhookPartner{
+		Id:   uuid.NewString(),
+		Name: "Partner lab",
+	})
+	s.Suite.NoError(err)
+
+	employeeId, otherPatientId := uuid.NewString(), uuid.NewString()
+	_, err = s.Repository.LinkPatient(ctx, &entity.WebhookPartnerPatient{PartnerId: partner.Id, PatientId: employeeId})
+	s.Suite.NoError(err)
+	// linking again keeps the link
+	_, err = s.Repository.LinkPatient(ctx, &entity.WebhookPartnerPatient{PartnerId: partner.Id, PatientId: employeeId})
+	s.Suite.NoError(err)
+	patients, err := s.Repository.GetAllPartnerPatients(ctx, &entity.GetAllWebhookPartnerPatients{PartnerId: partner.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), patients.Count)
+
+	subscription, err := s.Repository.CreateSubscription(ctx, &entity.WebhookSubscription{
+		Id:          uuid.NewString(),
+		PartnerId:   partner.Id,
+		Url:         "https://partner-lab.example/hooks",
+		EventTypes:  []string{entity.EventAppointmentCreated, entity.EventAppointmentCompleted},
+		Description: "Partner lab",
+		Secret:      "whsec_test",
+		Active:      true,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Empty(subscription.Secret)
+	s.Suite.Equal(partner.Id, subscription.PartnerId)
+	s.Suite.Equal([]string{entity.EventAppointmentCreated, entity.EventAppointmentCompleted}, subscription.EventTypes)
+
+	subscribers, err := s.Repository.GetSubscribers(ctx, entity.EventAppointmentCompleted, employeeId)
+	s.Suite.NoError(err)
+	s.Suite.Contains(subscriptionIds(subscribers), subscription.Id)
+
+	subscribers, err = s.Repository.GetSubscribers(ctx, entity.EventPrescriptionIssued, employeeId)
+	s.Suite.NoError(err)
+	s.Suite.NotContains(subscriptionIds(subscribers), subscription.Id)
+
+	// the partner is not told about patients not linked to it
+	subscribers, err = s.Repository.GetSubscribers(ctx, entity.EventAppointmentCompleted, otherPatientId)
+	s.Suite.NoError(err)
+	s.Suite.NotContains(subscriptionIds(subscribers), subscription.Id)
+
+	// an event is queued once per subscription
+	eventId := uuid.NewString()
+	delivery := &entity.WebhookDelivery{
+		Id:             uuid.NewString(),
+		SubscriptionId: subscription.Id,
+		EventId:        eventId,
+		EventType:      entity.EventAppointmentCreated,
+		Payload:        `{"id":"` + eventId + `"}`,
+		NextAttemptAt:  time.Now().Add(-time.Minute),
+	}
+	s.Suite.NoError(s.Repository.QueueWebhookDeliveries(ctx, []*entity.WebhookDelivery{delivery}))
+	s.Suite.NoError(s.Repository.QueueWebhookDeliveries(ctx, []*entity.WebhookDelivery{{
+		Id:             uuid.NewString(),
+		SubscriptionId: subscription.Id,
+		EventId:        eventId,
+		EventType:      entity.EventAppointmentCreated,
+		Payload:        delivery.Payload,
+		NextAttemptAt:  time.Now(),
+	}}))
+
+	list, err := s.Repository.GetAllWebhookDeliveries(ctx, &entity.GetAllWebhookDeliveries{SubscriptionId: subscription.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(1), list.Count)
+
+	// claimed deliveries carry the current secret of their subscription
+	_, err = s.Repository.SetSecret(ctx, subscription.Id, "whsec_rotated")
+	s.Suite.NoError(err)
+	claimed, err := s.Repository.ClaimPendingWebhookDeliveries(ctx, time.Now(), time.Minute, 100)
+	s.Suite.NoError(err)
+	var mine *entity.WebhookDelivery
+	for _, claim := range claimed {
+		if claim.Id == delivery.Id {
+			mine = claim
+		}
+	}
+	s.Suite.NotNil(mine)
+	s.Suite.Equal("whsec_rotated", mine.Secret)
+	s.Suite.Equal("https://partner-lab.example/hooks", mine.Url)
+	s.Suite.True(mine.Active)
+
+	// a leased delivery is not claimed again
+	claimed, err = s.Repository.ClaimPendingWebhookDeliveries(ctx, time.Now(), time.Minute, 100)
+	s.Suite.NoError(err)
+	for _, claim := range claimed {
+		s.Suite.NotEqual(delivery.Id, claim.Id)
+	}
+
+	mine.Attempts, mine.ResponseCode, mine.Status, mine.LastError = 1, 503, entity.WebhookFailed, "webhook: unexpected status 503"
+	s.Suite.NoError(s.Repository.RecordWebhookAttempt(ctx, mine, &entity.WebhookAttempt{
+		Id:           uuid.NewString(),
+		Attempt:      1,
+		ResponseCode: 503,
+		Error:        mine.LastError,
+		Duration:     120 * time.Millisecond,
+	}))
+
+	redelivered, err := s.Repository.RedeliverWebhook(ctx, delivery.Id, time.Now())
+	s.Suite.NoError(err)
+	s.Suite.Equal(entity.WebhookPending, redelivered.Status)
+	s.Suite.Equal(int32(0), redelivered.Attempts)
+
+	mine.Attempts, mine.ResponseCode, mine.Status, mine.LastError, mine.DeliveredAt = 1, 200, entity.WebhookDelivered, "", time.Now()
+	s.Suite.NoError(s.Repository.RecordWebhookAttempt(ctx, mine, &entity.WebhookAttempt{
+		Id:           uuid.NewString(),
+		Attempt:      1,
+		ResponseCode: 200,
+		Duration:     80 * time.Millisecond,
+	}))
+
+	// the log keeps the attempts made before the redelivery
+	logged, err := s.Repository.GetWebhookDelivery(ctx, delivery.Id)
+	s.Suite.NoError(err)
+	s.Suite.Equal(entity.WebhookDelivered, logged.Status)
+	s.Suite.Equal(int32(200), logged.ResponseCode)
+	s.Suite.False(logged.DeliveredAt.IsZero())
+	s.Suite.Len(logged.AttemptLog, 2)
+	s.Suite.Equal(int32(503), logged.AttemptLog[0].ResponseCode)
+	s.Suite.Equal(120*time.Millisecond, logged.AttemptLog[0].Duration)
+
+	subscription.Active = false
+	subscription, err = s.Repository.UpdateSubscription(ctx, subscription)
+	s.Suite.NoError(err)
+	s.Suite.False(subscription.Active)
+	subscribers, err = s.Repository.GetSubscribers(ctx, entity.EventAppointmentCreated, employeeId)
+	s.Suite.NoError(err)
+	s.Suite.NotContains(subscriptionIds(subscribers), subscription.Id)
+
+	// deleting the subscription deletes its log
+	_, err = s.Repository.DeleteSubscription(ctx, subscription.Id)
+	s.Suite.NoError(err)
+	_, err = s.Repository.GetWebhookDelivery(ctx, delivery.Id)
+	s.Suite.ErrorIs(err, entity.ErrorNotFound)
+
+	_, err = s.Repository.UnlinkPatient(ctx, &entity.WebhookPartnerPatient{PartnerId: partner.Id, PatientId: employeeId})
+	s.Suite.NoError(err)
+	_, err = s.Repository.DeletePartner(ctx, partner.Id)
+	s.Suite.NoError(err)
+	_, err = s.Repository.GetPartner(ctx, partner.Id)
+	s.Suite.ErrorIs(err, entity.ErrorNotFound)
+}
+
+func subscriptionIds(subscriptions []*entity.WebhookSubscription) []string {
+	var ids []string
+	for _, subscription := range subscriptions {
+		ids = append(ids, subscription.Id)
+	}
+	return ids
+}
+
+func (s *NotificationWebhooksTestSite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func TestNotificationWebhooksTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationWebhooksTestSite))
+}
@@ -0,0 +1,189 @@
+// Package templates writes notification messages in Uzbek, Russian and
+// English. A language without a template of the kind falls back to Uzbek.
+package templates
+
+import (
+	"dennic_notification_service/internal/entity"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// appointmentBody is shared by the reminder kinds of a language, only the
+// title says how soon the appointment is.
+var appointmentBody = map[string]string{
+	entity.LanguageUz: `{{with .PatientName}}Hurmatli {{.}}, {{end}}` +
+		`{{date .StartsAt}} soat {{clock .StartsAt}} da{{with .DoctorName}} shifokor {{.}}{{end}} qabuliga yozilgansiz.` +
+		`{{if .Online}} Konsultatsiya onlayn bo'lib o'tadi.{{else}}{{with .BranchName}} Manzil: {{.}}.{{end}}{{end}}`,
+	entity.LanguageRu: `{{with .PatientName}}{{.}}, в{{else}}В{{end}}ы записаны на приём` +
+		`{{with .DoctorName}} к врачу {{.}}{{end}} {{date .StartsAt}} в {{clock .StartsAt}}.` +
+		`{{if .Online}} Консультация пройдёт онлайн.{{else}}{{with .BranchName}} Адрес: {{.}}.{{end}}{{end}}`,
+	entity.LanguageEn: `{{with .PatientName}}{{.}}, y{{else}}Y{{end}}ou have an appointment` +
+		`{{with .DoctorName}} with Dr. {{.}}{{end}} on {{date .StartsAt}} at {{clock .StartsAt}}.` +
+		`{{if .Online}} The consultation is online.{{else}}{{with .BranchName}} Location: {{.}}.{{end}}{{end}}`,
+}
+
+var welcomeBody = map[string]string{
+	entity.LanguageUz: `{{with .PatientName}}Hurmatli {{.}}, {{end}}Dennic'da ro'yxatdan o'tdingiz. ` +
+		`Endi shifokor qabuliga ilova orqali yozilishingiz mumkin.`,
+	entity.LanguageRu: `{{with .PatientName}}{{.}}, в{{else}}В{{end}}ы зарегистрированы в Dennic. ` +
+		`Теперь вы можете записываться на приём к врачу в приложении.`,
+	entity.LanguageEn: `{{with .PatientName}}{{.}}, y{{else}}Y{{end}}ou are registered with Dennic. ` +
+		`You can now book appointments with doctors in the app.`,
+}
+
+// The inbox notifications about appointments. A rescheduled or cancelled
+// appointment is told to the patient and the doctor alike.
+var (
+	bookedBody = map[string]string{
+		entity.LanguageUz: `{{with .PatientName}}{{.}}{{else}}Bemor{{end}} {{date .StartsAt}} soat {{clock .StartsAt}} ga qabulga yozildi.` +
+			`{{if .Online}} Konsultatsiya onlayn.{{end}}`,
+		entity.LanguageRu: `{{with .PatientName}}{{.}}{{else}}Пациент{{end}} записан(а) на приём {{date .StartsAt}} в {{clock .StartsAt}}.` +
+			`{{if .Online}} Консультация онлайн.{{end}}`,
+		entity.LanguageEn: `{{with .PatientName}}{{.}}{{else}}A patient{{end}} booked an appointment on {{date .StartsAt}} at {{clock .StartsAt}}.` +
+			`{{if .Online}} The consultation is online.{{end}}`,
+	}
+	confirmedBody = map[string]string{
+		entity.LanguageUz: `{{date .StartsAt}} soat {{clock .StartsAt}} dagi{{with .DoctorName}} shifokor {{.}}{{end}} qabulingiz tasdiqlandi.` +
+			`{{if .Online}} Video konsultatsiya havolasi boshlanishidan biroz oldin beriladi.{{end}}`,
+		entity.LanguageRu: `Ваш приём{{with .DoctorName}} у врача {{.}}{{end}} {{date .StartsAt}} в {{clock .StartsAt}} подтверждён.` +
+			`{{if .Online}} Ссылка на видеоконсультацию будет доступна незадолго до начала.{{end}}`,
+		entity.LanguageEn: `Your appointment{{with .DoctorName}} with Dr. {{.}}{{end}} on {{date .StartsAt}} at {{clock .StartsAt}} is confirmed.` +
+			`{{if .Online}} The link to the video consultation is available shortly before it starts.{{end}}`,
+	}
+	rescheduledBody = map[string]string{
+		entity.LanguageUz: `Qabul{{with .DoctorName}} (shifokor {{.}}){{end}} {{date .StartsAt}} soat {{clock .StartsAt}} ga ko'chirildi.` +
+			`{{with .PatientName}} Bemor: {{.}}.{{end}}`,
+		entity.LanguageRu: `Приём{{with .DoctorName}} у врача {{.}}{{end}} перенесён на {{date .StartsAt}} в {{clock .StartsAt}}.` +
+			`{{with .PatientName}} Пациент: {{.}}.{{end}}`,
+		entity.LanguageEn: `The appointment{{with .DoctorName}} with Dr. {{.}}{{end}} was moved to {{date .StartsAt}} at {{clock .StartsAt}}.` +
+			`{{with .PatientName}} Patient: {{.}}.{{end}}`,
+	}
+	cancelledBody = map[string]string{
+		entity.LanguageUz: `{{date .StartsAt}} soat {{clock .StartsAt}} dagi qabul{{with .DoctorName}} (shifokor {{.}}){{end}} bekor qilindi.` +
+			`{{with .PatientName}} Bemor: {{.}}.{{end}}`,
+		entity.LanguageRu: `Приём{{with .DoctorName}} у врача {{.}}{{end}} {{date .StartsAt}} в {{clock .StartsAt}} отменён.` +
+			`{{with .PatientName}} Пациент: {{.}}.{{end}}`,
+		entity.LanguageEn: `The appointment{{with .DoctorName}} with Dr. {{.}}{{end}} on {{date .StartsAt}} at {{clock .StartsAt}} was cancelled.` +
+			`{{with .PatientName}} Patient: {{.}}.{{end}}`,
+	}
+)
+
+var labResultsBody = map[string]string{
+	entity.LanguageUz: `{{with .PatientName}}Hurmatli {{.}}, {{end}}tahlil natijalaringiz tayyor. Ularni ilovada ko'rishingiz mumkin.`,
+	entity.LanguageRu: `{{with .PatientName}}{{.}}, р{{else}}Р{{end}}езультаты ваших анализов готовы. Их можно посмотреть в приложении.`,
+	entity.LanguageEn: `{{with .PatientName}}{{.}}, y{{else}}Y{{end}}our lab results are ready. You can see them in the app.`,
+}
+
+var prescriptionBody = map[string]string{
+	entity.LanguageUz: `{{with .DoctorName}}Shifokor {{.}} sizga retsept yozib berdi{{else}}Sizga retsept yozib berildi{{end}}. ` +
+		`Uni ilovada ko'rishingiz mumkin.`,
+	entity.LanguageRu: `{{with .DoctorName}}Врач {{.}} выписал вам рецепт{{else}}Вам выписан рецепт{{end}}. Он доступен в приложении.`,
+	entity.LanguageEn: `{{with .DoctorName}}Dr. {{.}} issued you a prescription{{else}}You were issued a prescription{{end}}. ` +
+		`You can find it in the app.`,
+}
+
+// bodies are the message templates of each kind by language.
+var bodies = map[string]map[string]string{
+	entity.KindReminder24h:            appointmentBody,
+	entity.KindReminder2h:             appointmentBody,
+	entity.KindWelcome:                welcomeBody,
+	entity.KindAppointmentBooked:      bookedBody,
+	entity.KindAppointmentConfirmed:   confirmedBody,
+	entity.KindAppointmentRescheduled: rescheduledBody,
+	entity.KindAppointmentCancelled:   cancelledBody,
+	entity.KindLabResultsReady:        labResultsBody,
+	entity.KindPrescriptionIssued:     prescriptionBody,
+}
+
+var titles = map[string]map[string]string{
+	entity.KindReminder24h: {
+		entity.LanguageUz: "Ertaga qabulingiz bor",
+		entity.LanguageRu: "Напоминание о приёме завтра",
+		entity.LanguageEn: "Your appointment is tomorrow",
+	},
+	entity.KindReminder2h: {
+		entity.LanguageUz: "Qabulingizga 2 soat qoldi",
+		entity.LanguageRu: "Ваш приём через 2 часа",
+		entity.LanguageEn: "Your appointment is in 2 hours",
+	},
+	entity.KindWelcome: {
+		entity.LanguageUz: "Dennic'ga xush kelibsiz",
+		entity.LanguageRu: "Добро пожаловать в Dennic",
+		entity.LanguageEn: "Welcome to Dennic",
+	},
+	entity.KindAppointmentBooked: {
+		entity.LanguageUz: "Yangi qabul",
+		entity.LanguageRu: "Новая запись",
+		entity.LanguageEn: "New appointment",
+	},
+	entity.KindAppointmentConfirmed: {
+		entity.LanguageUz: "Qabulingiz tasdiqlandi",
+		entity.LanguageRu: "Запись подтверждена",
+		entity.LanguageEn: "Appointment confirmed",
+	},
+	entity.KindAppointmentRescheduled: {
+		entity.LanguageUz: "Qabul vaqti o'zgardi",
+		entity.LanguageRu: "Приём перенесён",
+		entity.LanguageEn: "Appointment rescheduled",
+	},
+	entity.KindAppointmentCancelled: {
+		entity.LanguageUz: "Qabul bekor qilindi",
+		entity.LanguageRu: "Приём отменён",
+		entity.LanguageEn: "Appointment cancelled",
+	},
+	entity.KindLabResultsReady: {
+		entity.LanguageUz: "Tahlil natijalari tayyor",
+		entity.LanguageRu: "Результаты анализов готовы",
+		entity.LanguageEn: "Lab results ready",
+	},
+	entity.KindPrescriptionIssued: {
+		entity.LanguageUz: "Yangi retsept",
+		entity.LanguageRu: "Новый рецепт",
+		entity.LanguageEn: "New prescription",
+	},
+}
+
+var funcs = template.FuncMap{
+	"date":  func(t time.Time) string { return t.Format("02.01.2006") },
+	"clock": func(t time.Time) string { return t.Format("15:04") },
+}
+
+type Templates struct {
+	messages map[string]map[string]*template.Template
+}
+
+// New parses the templates, so a broken one stops the service at start.
+func New() (*Templates, error) {
+	t := &Templates{messages: make(map[string]map[string]*template.Template)}
+	for kind, byLanguage := range titles {
+		t.messages[kind] = make(map[string]*template.Template)
+		for language := range byLanguage {
+			body, err := template.New(kind + "." + language).Funcs(funcs).Parse(bodies[kind][language])
+			if err != nil {
+				return nil, fmt.Errorf("template %s.%s: %w", kind, language, err)
+			}
+			t.messages[kind][language] = body
+		}
+	}
+
+	return t, nil
+}
+
+func (t *Templates) Render(kind, language string, data *entity.MessageData) (string, string, error) {
+	byLanguage, ok := t.messages[kind]
+	if !ok {
+		return "", "", fmt.Errorf("no template of %q notifications", kind)
+	}
+	if _, ok = byLanguage[language]; !ok {
+		language = entity.LanguageUz
+	}
+
+	var body strings.Builder
+	if err := byLanguage[language].Execute(&body, data); err != nil {
+		return "", "", err
+	}
+
+	return titles[kind][language], body.String(), nil
+}
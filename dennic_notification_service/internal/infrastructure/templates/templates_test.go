@@ -0,0 +1,58 @@
+package templates
+
+import (
+	"dennic_notification_service/internal/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	messages, err := New()
+	assert.NoError(t, err)
+
+	data := &entity.MessageData{
+		PatientName: "Husan Gofurov",
+		DoctorName:  "Aziz Karimov",
+		BranchName:  "Chilonzor",
+		StartsAt:    time.Date(2024, 5, 6, 9, 30, 0, 0, time.UTC),
+	}
+
+	title, body, err := messages.Render(entity.KindReminder24h, entity.LanguageRu, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Напоминание о приёме завтра", title)
+	assert.Equal(t, "Husan Gofurov, вы записаны на приём к врачу Aziz Karimov 06.05.2024 в 09:30. Адрес: Chilonzor.", body)
+
+	data.Online = true
+	title, body, err = messages.Render(entity.KindReminder2h, entity.LanguageEn, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Your appointment is in 2 hours", title)
+	assert.Equal(t, "Husan Gofurov, you have an appointment with Dr. Aziz Karimov on 06.05.2024 at 09:30. The consultation is online.", body)
+
+	// unknown languages fall back to Uzbek
+	title, body, err = messages.Render(entity.KindReminder2h, "de", &entity.MessageData{StartsAt: data.StartsAt})
+	assert.NoError(t, err)
+	assert.Equal(t, "Qabulingizga 2 soat qoldi", title)
+	assert.Equal(t, "06.05.2024 soat 09:30 da qabuliga yozilgansiz.", body)
+
+	title, body, err = messages.Render(entity.KindWelcome, entity.LanguageEn, &entity.MessageData{PatientName: "Husan Gofurov"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Welcome to Dennic", title)
+	assert.Equal(t, "Husan Gofurov, you are registered with Dennic. You can now book appointments with doctors in the app.", body)
+
+	// the inbox tells the patient and the doctor of a moved appointment alike
+	data.Online = false
+	title, body, err = messages.Render(entity.KindAppointmentRescheduled, entity.LanguageRu, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Приём перенесён", title)
+	assert.Equal(t, "Приём у врача Aziz Karimov перенесён на 06.05.2024 в 09:30. Пациент: Husan Gofurov.", body)
+
+	title, body, err = messages.Render(entity.KindPrescriptionIssued, entity.LanguageEn, &entity.MessageData{})
+	assert.NoError(t, err)
+	assert.Equal(t, "New prescription", title)
+	assert.Equal(t, "You were issued a prescription. You can find it in the app.", body)
+
+	_, _, err = messages.Render("unknown", entity.LanguageUz, data)
+	assert.Error(t, err)
+}
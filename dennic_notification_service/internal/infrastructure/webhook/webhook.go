@@ -0,0 +1,173 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"dennic_notification_service/internal/entity"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Headers every delivery carries.
+const (
+	HeaderEvent     = "X-Dennic-Event"
+	HeaderDelivery  = "X-Dennic-Delivery"
+	HeaderSignature = "X-Dennic-Signature"
+)
+
+const userAgent = "Dennic-Webhooks/1"
+
+// ErrPrivateAddress is returned for an endpoint inside a private network:
+// webhooks must not be a way into the clinic's own services.
+var ErrPrivateAddress = errors.New("webhook: the endpoint is not a public address")
+
+// carrier-grade NAT, private to the provider's network
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// IsPublic tells whether ip can be reached from the internet, i.e. it is not
+// loopback, private, link-local (cloud metadata among them), multicast or
+// unspecified.
+func IsPublic(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() || sharedAddressSpace.Contains(ip))
+}
+
+// dialPublic refuses to connect to an address that is not public. It runs
+// on the address a host name resolved to when dialing, so a name resolving
+// to a private address after the endpoint was checked is refused too.
+func dialPublic(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !IsPublic(ip) {
+		return ErrPrivateAddress
+	}
+	return nil
+}
+
+// Client posts deliveries to partner endpoints.
+type Client struct {
+	client   *http.Client
+	resolver *net.Resolver
+	now      func() time.Time
+}
+
+// New is a client connecting to public addresses only.
+func New(timeout time.Duration) *Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: dialPublic,
+	}
+
+	return NewClient(&http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// no proxy, it would connect on the client's behalf
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		// a partner redirecting elsewhere is told by its status code
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	})
+}
+
+func NewClient(client *http.Client) *Client {
+	return &Client{
+		client:   client,
+		resolver: net.DefaultResolver,
+		now:      time.Now,
+	}
+}
+
+// CheckUrl tells whether rawUrl can be a partner endpoint: an https url of a
+// host every address of which is public.
+func (c *Client) CheckUrl(ctx context.Context, rawUrl string) error {
+	endpoint, err := url.Parse(rawUrl)
+	if err != nil || endpoint.Scheme != "https" || endpoint.Hostname() == "" {
+		return errors.New("must be an https url")
+	}
+
+	if ip := net.ParseIP(endpoint.Hostname()); ip != nil {
+		if !IsPublic(ip) {
+			return ErrPrivateAddress
+		}
+		return nil
+	}
+
+	addresses, err := c.resolver.LookupIPAddr(ctx, endpoint.Hostname())
+	if err != nil {
+		return fmt.Errorf("webhook: cannot resolve %s", endpoint.Hostname())
+	}
+	for _, address := range addresses {
+		if !IsPublic(address.IP) {
+			return ErrPrivateAddress
+		}
+	}
+
+	return nil
+}
+
+// Sign is the signature header of a body posted at timestamp: the partner
+// recomputes the HMAC-SHA256 of "<t>.<body>" with the secret and checks t is
+// recent, so a captured delivery cannot be replayed later.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Post sends a delivery and returns the status code of the answer, 0 when
+// there was none. An answer other than 2xx is an error; an endpoint that is
+// not https or not public cannot be delivered to.
+func (c *Client) Post(ctx context.Context, req *entity.WebhookRequest) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, req.Url, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, entity.NewErrUndeliverable("invalid url: " + err.Error())
+	}
+	if request.URL.Scheme != "https" {
+		return 0, entity.NewErrUndeliverable("the url is not https")
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", userAgent)
+	request.Header.Set(HeaderEvent, req.EventType)
+	request.Header.Set(HeaderDelivery, req.DeliveryId)
+	request.Header.Set(HeaderSignature, Sign(req.Secret, c.now(), req.Body))
+
+	response, err := c.client.Do(request)
+	if errors.Is(err, ErrPrivateAddress) {
+		return 0, entity.NewErrUndeliverable(ErrPrivateAddress.Error())
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	// drained so the connection is reused
+	io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, fmt.Errorf("webhook: unexpected status %s", response.Status)
+	}
+
+	return response.StatusCode, nil
+}
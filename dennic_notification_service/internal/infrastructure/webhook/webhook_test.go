@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"context"
+	"dennic_notification_service/internal/entity"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	timestamp := time.Unix(1718000000, 0)
+	body := []byte(`{"id":"1"}`)
+
+	signature := Sign("whsec_test", timestamp, body)
+	assert.Equal(t, "t=1718000000,v1=", signature[:16])
+	assert.Len(t, signature, 16+64)
+
+	assert.Equal(t, signature, Sign("whsec_test", timestamp, body))
+	assert.NotEqual(t, signature, Sign("whsec_other", timestamp, body))
+	assert.NotEqual(t, signature, Sign("whsec_test", timestamp.Add(time.Second), body))
+}
+
+func TestPost(t *testing.T) {
+	now := time.Unix(1718000000, 0)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "appointment.created", r.Header.Get(HeaderEvent))
+		assert.Equal(t, Sign("whsec_test", now, body), r.Header.Get(HeaderSignature))
+
+		switch r.Header.Get(HeaderDelivery) {
+		case "down":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "moved":
+			http.Redirect(w, r, "/elsewhere", http.StatusFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.now = func() time.Time { return now }
+	post := func(deliveryId string) (int, error) {
+		return client.Post(context.Background(), &entity.WebhookRequest{
+			Url:        server.URL,
+			Secret:     "whsec_test",
+			DeliveryId: deliveryId,
+			EventType:  "appointment.created",
+			Body:       []byte(`{"id":"1"}`),
+		})
+	}
+
+	code, err := post("ok")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+
+	code, err = post("down")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	client.client.CheckRedirect = New(time.Second).client.CheckRedirect
+	code, err = post("moved")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusFound, code)
+
+	// the server listens on loopback, which the client of New refuses
+	var errUndeliverable *entity.ErrUndeliverable
+	client = New(time.Second)
+	_, err = post("ok")
+	assert.ErrorAs(t, err, &errUndeliverable)
+	assert.ErrorContains(t, err, "not a public address")
+
+	_, err = client.Post(context.Background(), &entity.WebhookRequest{Url: "http://93.184.216.34/hooks"})
+	assert.ErrorAs(t, err, &errUndeliverable)
+}
+
+func TestCheckUrl(t *testing.T) {
+	client := New(time.Second)
+
+	for _, rawUrl := range []string{
+		"http://93.184.216.34/hooks",
+		"ftp://93.184.216.34/hooks",
+		"https:///hooks",
+		"https://127.0.0.1/hooks",
+		"https://10.1.2.3/hooks",
+		"https://172.16.0.1/hooks",
+		"https://192.168.1.1/hooks",
+		"https://169.254.169.254/latest/meta-data",
+		"https://100.64.0.1/hooks",
+		"https://0.0.0.0/hooks",
+		"https://[::1]/hooks",
+		"https://[fe80::1]/hooks",
+		"https://[fd00::1]/hooks",
+		"https://[::ffff:127.0.0.1]/hooks",
+	} {
+		assert.Error(t, client.CheckUrl(context.Background(), rawUrl), rawUrl)
+	}
+
+	assert.NoError(t, client.CheckUrl(context.Background(), "https://93.184.216.34/hooks"))
+	assert.NoError(t, client.CheckUrl(context.Background(), "https://[2606:2800:220:1::]/hooks"))
+}
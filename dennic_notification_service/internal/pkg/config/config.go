@@ -0,0 +1,194 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+type webAddress struct {
+	Host string
+	Port string
+}
+
+type Config struct {
+	APP         string
+	Environment string
+	LogLevel    string
+	RPCPort     string
+
+	Context struct {
+		Timeout string
+	}
+
+	DB struct {
+		Host     string
+		Port     string
+		Name     string
+		User     string
+		Password string
+		SslMode  string
+	}
+
+	OTLPCollector webAddress
+
+	BookingService    webAddress
+	HealthcareService webAddress
+	SessionService    webAddress
+
+	Kafka struct {
+		Address []string
+		GroupId string
+		Topic   struct {
+			Appointments  string
+			LabOrders     string
+			Prescriptions string
+			UserCreated   string
+			// Inbox carries the unread counts of inboxes for the gateway to
+			// push to devices.
+			Inbox string
+		}
+		// Retry bounds how often a failed message is handled again before
+		// it is parked on its topic with DeadLetterSuffix appended.
+		Retry struct {
+			MaxAttempts string
+			Backoff     string
+			MaxBackoff  string
+		}
+		DeadLetterSuffix string
+		// WebhookGroupId consumes the domain events for webhooks apart from
+		// the notifications, so a partner being slow holds neither back.
+		WebhookGroupId string
+	}
+
+	Reminder struct {
+		// TimeZone is the zone appointment times are written in when the
+		// branch has none of its own.
+		TimeZone        string
+		DefaultLanguage string
+		SweepInterval   string
+		BatchSize       string
+		MaxAttempts     string
+		RetryBackoff    string
+	}
+
+	// Webhook paces the deliveries to partner systems; a failed one is tried
+	// again after RetryBackoff, doubling each time, up to MaxAttempts.
+	Webhook struct {
+		SweepInterval string
+		BatchSize     string
+		MaxAttempts   string
+		RetryBackoff  string
+		Timeout       string
+	}
+
+	Push struct {
+		Provider        string
+		CredentialsFile string
+	}
+
+	SMS struct {
+		Provider string
+		BaseURL  string
+		Email    string
+		Password string
+		From     string
+	}
+
+	Email struct {
+		Provider string
+		Host     string
+		Port     string
+		Username string
+		Password string
+		From     string
+	}
+}
+
+func New() *Config {
+	var config Config
+
+	// general configuration
+	config.APP = getEnv("APP", "dennic_notification_service")
+	config.Environment = getEnv("ENVIRONMENT", "develop")
+	config.LogLevel = getEnv("LOG_LEVEL", "debug")
+	config.RPCPort = getEnv("RPC_PORT", ":9040")
+	config.Context.Timeout = getEnv("CONTEXT_TIMEOUT", "30s")
+
+	// db configuration
+	config.DB.Host = getEnv("POSTGRES_HOST", "postgresdb")
+	config.DB.Port = getEnv("POSTGRES_PORT", "5432")
+	config.DB.User = getEnv("POSTGRES_USER", "postgres")
+	config.DB.Password = getEnv("POSTGRES_PASSWORD", "20030505")
+	config.DB.SslMode = getEnv("POSTGRES_SSLMODE", "disable")
+	config.DB.Name = getEnv("POSTGRES_DATABASE", "dennic")
+
+	// otlp collector configuration
+	config.OTLPCollector.Host = getEnv("OTLP_COLLECTOR_HOST", "otlp-collector")
+	config.OTLPCollector.Port = getEnv("OTLP_COLLECTOR_PORT", ":4317")
+
+	// service clients configuration
+	config.BookingService.Host = getEnv("BOOKING_SERVICE_GRPC_HOST", "dennic_booking_service")
+	config.BookingService.Port = getEnv("BOOKING_SERVICE_GRPC_PORT", ":9090")
+	config.HealthcareService.Host = getEnv("HEALTHCARE_SERVICE_GRPC_HOST", "dennic_healthcare_service")
+	config.HealthcareService.Port = getEnv("HEALTHCARE_SERVICE_GRPC_PORT", ":9080")
+	config.SessionService.Host = getEnv("SESSION_SERVICE_GRPC_HOST", "dennic_session_service")
+	config.SessionService.Port = getEnv("SESSION_SERVICE_GRPC_PORT", ":9060")
+
+	// kafka configuration
+	config.Kafka.Address = strings.Split(getEnv("KAFKA_ADDRESS", "localhost:29092"), ",")
+	config.Kafka.GroupId = getEnv("KAFKA_GROUP_ID", "dennic_notification_service")
+	config.Kafka.Topic.Appointments = getEnv("KAFKA_TOPIC_APPOINTMENTS", "booking.appointments")
+	config.Kafka.Topic.LabOrders = getEnv("KAFKA_TOPIC_LAB_ORDERS", "booking.lab_orders")
+	config.Kafka.Topic.Prescriptions = getEnv("KAFKA_TOPIC_PRESCRIPTIONS", "booking.prescriptions")
+	config.Kafka.Topic.UserCreated = getEnv("KAFKA_TOPIC_USER_CREATED", "user.created")
+	config.Kafka.Topic.Inbox = getEnv("KAFKA_TOPIC_INBOX", "notification.inbox")
+	config.Kafka.Retry.MaxAttempts = getEnv("KAFKA_RETRY_MAX_ATTEMPTS", "5")
+	config.Kafka.Retry.Backoff = getEnv("KAFKA_RETRY_BACKOFF", "1s")
+	config.Kafka.Retry.MaxBackoff = getEnv("KAFKA_RETRY_MAX_BACKOFF", "30s")
+	config.Kafka.DeadLetterSuffix = getEnv("KAFKA_DEAD_LETTER_SUFFIX", ".dlq")
+	config.Kafka.WebhookGroupId = getEnv("KAFKA_WEBHOOK_GROUP_ID", "dennic_notification_service.webhooks")
+
+	// reminder configuration
+	config.Reminder.TimeZone = getEnv("REMINDER_TIME_ZONE", "Asia/Tashkent")
+	config.Reminder.DefaultLanguage = getEnv("REMINDER_DEFAULT_LANGUAGE", "uz")
+	config.Reminder.SweepInterval = getEnv("REMINDER_SWEEP_INTERVAL", "30s")
+	config.Reminder.BatchSize = getEnv("REMINDER_BATCH_SIZE", "100")
+	config.Reminder.MaxAttempts = getEnv("REMINDER_MAX_ATTEMPTS", "5")
+	config.Reminder.RetryBackoff = getEnv("REMINDER_RETRY_BACKOFF", "1m")
+
+	// webhook configuration
+	config.Webhook.SweepInterval = getEnv("WEBHOOK_SWEEP_INTERVAL", "10s")
+	config.Webhook.BatchSize = getEnv("WEBHOOK_BATCH_SIZE", "20")
+	config.Webhook.MaxAttempts = getEnv("WEBHOOK_MAX_ATTEMPTS", "10")
+	config.Webhook.RetryBackoff = getEnv("WEBHOOK_RETRY_BACKOFF", "30s")
+	config.Webhook.Timeout = getEnv("WEBHOOK_TIMEOUT", "10s")
+
+	// push configuration
+	config.Push.Provider = getEnv("PUSH_PROVIDER", "log")
+	config.Push.CredentialsFile = getEnv("FCM_CREDENTIALS_FILE", "fcm-service-account.json")
+
+	// sms configuration
+	config.SMS.Provider = getEnv("SMS_PROVIDER", "log")
+	config.SMS.BaseURL = getEnv("SMS_BASE_URL", "https://notify.eskiz.uz/api")
+	config.SMS.Email = getEnv("SMS_EMAIL", "")
+	config.SMS.Password = getEnv("SMS_PASSWORD", "")
+	config.SMS.From = getEnv("SMS_FROM", "4546")
+
+	// email configuration
+	config.Email.Provider = getEnv("EMAIL_PROVIDER", "log")
+	config.Email.Host = getEnv("SMTP_HOST", "smtp.gmail.com")
+	config.Email.Port = getEnv("SMTP_PORT", "587")
+	config.Email.Username = getEnv("SMTP_USERNAME", "")
+	config.Email.Password = getEnv("SMTP_PASSWORD", "")
+	config.Email.From = getEnv("SMTP_FROM", "Dennic <no-reply@dennic.uz>")
+
+	return &config
+}
+
+func getEnv(key string, defaultVaule string) string {
+	value, exists := os.LookupEnv(key)
+	if exists {
+		return value
+	}
+	return defaultVaule
+}
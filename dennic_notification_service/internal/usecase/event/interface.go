@@ -0,0 +1,29 @@
+package event
+
+import (
+	"context"
+	"dennic_kafka/eventbus"
+	"dennic_notification_service/internal/entity"
+)
+
+type BrokerProducer interface {
+	PublishUnreadCount(ctx context.Context, count *entity.UnreadCount) error
+	Close()
+}
+
+// ConsumerConfig is a topic to consume and the handler of its messages,
+// see eventbus.NewConsumerConfig.
+type ConsumerConfig = eventbus.ConsumerConfig
+
+type BrokerConsumer interface {
+	Run()
+	RegisterConsumer(config ConsumerConfig)
+	Close()
+}
+
+// ProcessedEvents remembers the events each consumer has handled. Process
+// runs handle unless the consumer handled the event before and records it
+// in the transaction of handle; it reports whether handle ran.
+type ProcessedEvents interface {
+	Process(ctx context.Context, consumer, eventId, eventType string, handle func(ctx context.Context) error) (bool, error)
+}
@@ -0,0 +1,322 @@
+package usecase
+
+import (
+	"context"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/pkg/otlp"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	serviceNameInbox = "InboxService"
+	spanNameInbox    = "InboxUsecase"
+)
+
+// Deep links the app opens inbox notifications with.
+const (
+	linkAppointment  = "dennic://appointments/"
+	linkLabResult    = "dennic://lab-results/"
+	linkPrescription = "dennic://prescriptions/"
+)
+
+// InboxUseCase keeps the in-app inboxes of patients and doctors, fed by the
+// events of the booking service. A doctor's inbox is kept under their doctor
+// id, the id their account signs in with.
+type InboxUseCase struct {
+	Inbox       InboxRepo
+	Preferences PreferencesRepo
+	Directory   Directory
+	Templates   Templates
+	events      InboxEvents
+	options     ReminderOptions
+	ctxTimeout  time.Duration
+}
+
+// NewInbox -.
+func NewInbox(inbox InboxRepo, preferences PreferencesRepo, directory Directory, templates Templates,
+	events InboxEvents, options ReminderOptions, ctxTimeout time.Duration) *InboxUseCase {
+	return &InboxUseCase{
+		Inbox:       inbox,
+		Preferences: preferences,
+		Directory:   directory,
+		Templates:   templates,
+		events:      events,
+		options:     options,
+		ctxTimeout:  ctxTimeout,
+	}
+}
+
+// HandleAppointmentEvent tells the doctor about a booked appointment, the
+// patient about a confirmed one and both of them about one moved or
+// cancelled. Other changes are not worth an inbox notification.
+func (r *InboxUseCase) HandleAppointmentEvent(ctx context.Context, event *entity.AppointmentEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInbox, spanNameInbox+"AppointmentEvent")
+	defer span.End()
+
+	var (
+		kind                string
+		toPatient, toDoctor bool
+	)
+	switch {
+	case event.Type == entity.EventAppointmentCreated:
+		kind, toDoctor = entity.KindAppointmentBooked, true
+	case event.Type == entity.EventAppointmentConfirmed:
+		kind, toPatient = entity.KindAppointmentConfirmed, true
+	case event.Type == entity.EventAppointmentUpdated && !event.PreviousStartsAt.IsZero():
+		kind, toPatient, toDoctor = entity.KindAppointmentRescheduled, true, true
+	case event.Type == entity.EventAppointmentDeleted,
+		event.Type == entity.EventAppointmentStatusChanged && event.Status == entity.AppointmentCancelled:
+		kind, toPatient, toDoctor = entity.KindAppointmentCancelled, true, true
+	default:
+		return nil
+	}
+
+	contact, err := r.contact(ctx, event.PatientId)
+	if err != nil {
+		return err
+	}
+	data, err := appointmentMessageData(ctx, r.Directory, r.options.Location, contact,
+		event.DoctorId, event.BranchId, event.Modality, event.StartsAt)
+	if err != nil {
+		return err
+	}
+
+	var recipients []string
+	if toPatient && contact.UserId != "" {
+		recipients = append(recipients, contact.UserId)
+	}
+	if toDoctor && event.DoctorId != "" {
+		recipients = append(recipients, event.DoctorId)
+	}
+
+	appointmentId := strconv.FormatInt(event.AppointmentId, 10)
+	return r.notify(ctx, recipients, kind, data, linkAppointment+appointmentId, map[string]string{
+		"appointment_id": appointmentId,
+	})
+}
+
+// HandleLabResultsReleased tells the patient their lab results are ready.
+func (r *InboxUseCase) HandleLabResultsReleased(ctx context.Context, event *entity.LabResultsReleased) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInbox, spanNameInbox+"LabResultsReleased")
+	defer span.End()
+
+	contact, err := r.contact(ctx, event.PatientId)
+	if err != nil || contact.UserId == "" {
+		return err
+	}
+
+	return r.notify(ctx, []string{contact.UserId}, entity.KindLabResultsReady, &entity.MessageData{
+		PatientName: contactName(contact),
+	}, linkLabResult+event.LabOrderId, map[string]string{
+		"lab_order_id":   event.LabOrderId,
+		"appointment_id": strconv.FormatInt(event.AppointmentId, 10),
+	})
+}
+
+// HandlePrescriptionIssued tells the patient a doctor issued them a
+// prescription.
+func (r *InboxUseCase) HandlePrescriptionIssued(ctx context.Context, event *entity.PrescriptionIssued) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInbox, spanNameInbox+"PrescriptionIssued")
+	defer span.End()
+
+	contact, err := r.contact(ctx, event.PatientId)
+	if err != nil || contact.UserId == "" {
+		return err
+	}
+
+	data := &entity.MessageData{PatientName: contactName(contact)}
+	if event.DoctorId != "" {
+		if data.DoctorName, err = r.Directory.GetDoctorName(ctx, event.DoctorId); err != nil {
+			return err
+		}
+	}
+
+	return r.notify(ctx, []string{contact.UserId}, entity.KindPrescriptionIssued, data,
+		linkPrescription+event.PrescriptionId, map[string]string{
+			"prescription_id": event.PrescriptionId,
+			"appointment_id":  strconv.FormatInt(event.AppointmentId, 10),
+		})
+}
+
+// contact is the patient as the booking service knows them; a patient it
+// does not know has no account to notify.
+func (r *InboxUseCase) contact(ctx context.Context, patientId string) (*entity.Contact, error) {
+	if patientId == "" {
+		return &entity.Contact{}, nil
+	}
+
+	contact, err := r.Directory.GetContact(ctx, patientId)
+	var errNotFound *entity.ErrNotFound
+	if errors.As(err, &errNotFound) {
+		return &entity.Contact{PatientId: patientId}, nil
+	}
+
+	return contact, err
+}
+
+// notify puts a notification of kind in the inbox of every recipient, in
+// their language, and tells their devices the new unread count.
+func (r *InboxUseCase) notify(ctx context.Context, recipients []string, kind string, data *entity.MessageData,
+	link string, payload map[string]string) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	items := make([]*entity.InboxItem, 0, len(recipients))
+	for _, userId := range recipients {
+		language, err := r.language(ctx, userId)
+		if err != nil {
+			return err
+		}
+		title, body, err := r.Templates.Render(kind, language, data)
+		if err != nil {
+			return err
+		}
+
+		items = append(items, &entity.InboxItem{
+			Id:     uuid.NewString(),
+			UserId: userId,
+			Kind:   kind,
+			Title:  title,
+			Body:   body,
+			Link:   link,
+			Data:   payload,
+		})
+	}
+
+	if err := r.Inbox.AddInboxItems(ctx, items); err != nil {
+		return err
+	}
+
+	for _, userId := range recipients {
+		r.publishUnread(ctx, userId)
+	}
+
+	return nil
+}
+
+func (r *InboxUseCase) language(ctx context.Context, userId string) (string, error) {
+	preferences, err := r.Preferences.GetPreferences(ctx, userId)
+	var errNotFound *entity.ErrNotFound
+	if errors.As(err, &errNotFound) {
+		return r.options.Language, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return preferences.Language, nil
+}
+
+// publishUnread tells the devices of a user their unread count. The inbox
+// is the truth the app reads on start, so a count that cannot be handed
+// over is only recorded on the span.
+func (r *InboxUseCase) publishUnread(ctx context.Context, userId string) {
+	ctx, span := otlp.Start(ctx, serviceNameInbox, spanNameInbox+"PublishUnread")
+	defer span.End()
+
+	unread, err := r.Inbox.CountUnread(ctx, userId)
+	if err == nil {
+		err = r.events.PublishUnreadCount(ctx, &entity.UnreadCount{UserId: userId, Unread: unread})
+	}
+	span.Error(err)
+}
+
+func (r *InboxUseCase) GetInbox(ctx context.Context, req *entity.GetInbox) (*entity.InboxType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInbox, spanNameInbox+"GetInbox")
+	defer span.End()
+
+	if err := validateInboxUser(req.UserId); err != nil {
+		return nil, err
+	}
+
+	return r.Inbox.GetInbox(ctx, req)
+}
+
+// MarkInboxRead marks notifications of a user as read and returns how many
+// are left unread, which their other devices are told as well.
+func (r *InboxUseCase) MarkInboxRead(ctx context.Context, req *entity.MarkInboxRead) (*entity.UnreadCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInbox, spanNameInbox+"MarkRead")
+	defer span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid inbox notifications")
+	if _, err := uuid.Parse(req.UserId); err != nil {
+		errValidation.Errors["user_id"] = "must be a uuid"
+	}
+	if !req.All && len(req.Ids) == 0 {
+		errValidation.Errors["ids"] = "are required unless all are marked"
+	}
+	for _, id := range req.Ids {
+		if _, err := uuid.Parse(id); err != nil {
+			errValidation.Errors["ids"] = "must be uuids"
+		}
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	if err := r.Inbox.MarkInboxRead(ctx, req); err != nil {
+		return nil, err
+	}
+
+	unread, err := r.Inbox.CountUnread(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	count := &entity.UnreadCount{UserId: req.UserId, Unread: unread}
+	if err := r.events.PublishUnreadCount(ctx, count); err != nil {
+		span.Error(err)
+	}
+
+	return count, nil
+}
+
+func (r *InboxUseCase) GetUnreadCount(ctx context.Context, userId string) (*entity.UnreadCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameInbox, spanNameInbox+"GetUnreadCount")
+	defer span.End()
+
+	if err := validateInboxUser(userId); err != nil {
+		return nil, err
+	}
+
+	unread, err := r.Inbox.CountUnread(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.UnreadCount{UserId: userId, Unread: unread}, nil
+}
+
+func validateInboxUser(userId string) error {
+	if _, err := uuid.Parse(userId); err != nil {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("invalid inbox")
+		errValidation.Errors["user_id"] = "must be a uuid"
+		return errValidation
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Package usecase implements application business logic. Each logic group in own file.
+package usecase
+
+import (
+	"context"
+	"dennic_notification_service/internal/entity"
+	"time"
+)
+
+type (
+	// PreferencesRepo -.
+	PreferencesRepo interface {
+		GetPreferences(ctx context.Context, userId string) (*entity.Preferences, error)
+		UpsertPreferences(ctx context.Context, req *entity.Preferences) (*entity.Preferences, error)
+	}
+
+	// RemindersRepo -.
+	RemindersRepo interface {
+		ScheduleReminder(ctx context.Context, req *entity.Reminder) error
+		CancelReminders(ctx context.Context, appointmentId int64, eventAt time.Time, kinds ...string) error
+		GetDueReminders(ctx context.Context, now time.Time, limit uint64) ([]*entity.Reminder, error)
+		DispatchReminder(ctx context.Context, reminder *entity.Reminder, deliveries []*entity.Delivery) (bool, error)
+		GetAppointmentReminders(ctx context.Context, appointmentId int64) ([]*entity.Reminder, error)
+	}
+
+	// DeliveriesRepo -.
+	DeliveriesRepo interface {
+		QueueDeliveries(ctx context.Context, deliveries []*entity.Delivery) error
+		ClaimPendingDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit uint64) ([]*entity.Delivery, error)
+		UpdateDeliveryStatus(ctx context.Context, req *entity.Delivery) error
+		GetAllDeliveries(ctx context.Context, req *entity.GetAllDeliveries) (*entity.DeliveriesType, error)
+	}
+
+	// InboxRepo -.
+	InboxRepo interface {
+		AddInboxItems(ctx context.Context, items []*entity.InboxItem) error
+		GetInbox(ctx context.Context, req *entity.GetInbox) (*entity.InboxType, error)
+		MarkInboxRead(ctx context.Context, req *entity.MarkInboxRead) error
+		CountUnread(ctx context.Context, userId string) (int64, error)
+	}
+
+	// WebhooksRepo -.
+	WebhooksRepo interface {
+		CreatePartner(ctx context.Context, req *entity.WebhookPartner) (*entity.WebhookPartner, error)
+		GetPartner(ctx context.Context, id string) (*entity.WebhookPartner, error)
+		GetAllPartners(ctx context.Context, req *entity.GetAllWebhookPartners) (*entity.WebhookPartnersType, error)
+		DeletePartner(ctx context.Context, id string) (*entity.WebhookPartner, error)
+		LinkPatient(ctx context.Context, req *entity.WebhookPartnerPatient) (*entity.WebhookPartnerPatient, error)
+		UnlinkPatient(ctx context.Context, req *entity.WebhookPartnerPatient) (*entity.WebhookPartnerPatient, error)
+		GetAllPartnerPatients(ctx context.Context, req *entity.GetAllWebhookPartnerPatients) (*entity.WebhookPartnerPatientsType, error)
+		CreateSubscription(ctx context.Context, req *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+		GetSubscription(ctx context.Context, id string) (*entity.WebhookSubscription, error)
+		GetAllSubscriptions(ctx context.Context, req *entity.GetAllWebhookSubscriptions) (*entity.WebhookSubscriptionsType, error)
+		GetSubscribers(ctx context.Context, eventType, patientId string) ([]*entity.WebhookSubscription, error)
+		UpdateSubscription(ctx context.Context, req *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+		SetSecret(ctx context.Context, id, secret string) (*entity.WebhookSubscription, error)
+		DeleteSubscription(ctx context.Context, id string) (*entity.WebhookSubscription, error)
+		QueueWebhookDeliveries(ctx context.Context, deliveries []*entity.WebhookDelivery) error
+		ClaimPendingWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit uint64) ([]*entity.WebhookDelivery, error)
+		RecordWebhookAttempt(ctx context.Context, delivery *entity.WebhookDelivery, attempt *entity.WebhookAttempt) error
+		GetWebhookDelivery(ctx context.Context, id string) (*entity.WebhookDelivery, error)
+		GetAllWebhookDeliveries(ctx context.Context, req *entity.GetAllWebhookDeliveries) (*entity.WebhookDeliveriesType, error)
+		RedeliverWebhook(ctx context.Context, id string, now time.Time) (*entity.WebhookDelivery, error)
+	}
+
+	// WebhookClient posts a signed delivery to a partner and returns the
+	// status code it answered with, 0 when it did not. CheckUrl tells whether
+	// a url can be an endpoint: https on public addresses only.
+	WebhookClient interface {
+		Post(ctx context.Context, req *entity.WebhookRequest) (int, error)
+		CheckUrl(ctx context.Context, rawUrl string) error
+	}
+
+	// InboxEvents tells the devices of a user how many notifications in
+	// their inbox are unread.
+	InboxEvents interface {
+		PublishUnreadCount(ctx context.Context, count *entity.UnreadCount) error
+	}
+
+	// Directory looks up who and what a notification is about in the other
+	// services.
+	Directory interface {
+		GetContact(ctx context.Context, patientId string) (*entity.Contact, error)
+		GetPushTokens(ctx context.Context, userId string) ([]string, error)
+		GetDoctorName(ctx context.Context, doctorId string) (string, error)
+		// GetBranch returns the branch name and its IANA time zone, empty
+		// when the branch has none set.
+		GetBranch(ctx context.Context, branchId string) (string, string, error)
+	}
+
+	// Templates -.
+	Templates interface {
+		Render(kind, language string, data *entity.MessageData) (title, body string, err error)
+	}
+
+	// Sender delivers messages over one channel and returns the provider's
+	// reference of the message.
+	Sender interface {
+		Channel() string
+		Send(ctx context.Context, msg *entity.Message) (string, error)
+	}
+
+	// Notification -.
+	Notification interface {
+		HandleAppointmentEvent(ctx context.Context, event *entity.AppointmentEvent) error
+		WelcomeUser(ctx context.Context, welcome *entity.Welcome) error
+		DispatchDueReminders(ctx context.Context, now time.Time) (int, error)
+		SendPendingDeliveries(ctx context.Context, now time.Time) (int, error)
+		GetPreferences(ctx context.Context, userId string) (*entity.Preferences, error)
+		UpdatePreferences(ctx context.Context, req *entity.Preferences) (*entity.Preferences, error)
+		GetAllDeliveries(ctx context.Context, req *entity.GetAllDeliveries) (*entity.DeliveriesType, error)
+		GetAppointmentReminders(ctx context.Context, appointmentId int64) ([]*entity.Reminder, error)
+	}
+
+	// Inbox -.
+	Inbox interface {
+		HandleAppointmentEvent(ctx context.Context, event *entity.AppointmentEvent) error
+		HandleLabResultsReleased(ctx context.Context, event *entity.LabResultsReleased) error
+		HandlePrescriptionIssued(ctx context.Context, event *entity.PrescriptionIssued) error
+		GetInbox(ctx context.Context, req *entity.GetInbox) (*entity.InboxType, error)
+		MarkInboxRead(ctx context.Context, req *entity.MarkInboxRead) (*entity.UnreadCount, error)
+		GetUnreadCount(ctx context.Context, userId string) (*entity.UnreadCount, error)
+	}
+
+	// Webhooks -.
+	Webhooks interface {
+		HandleEvent(ctx context.Context, event *entity.WebhookEvent) error
+		SendPendingWebhooks(ctx context.Context, now time.Time) (int, error)
+		CreatePartner(ctx context.Context, req *entity.WebhookPartner) (*entity.WebhookPartner, error)
+		GetAllPartners(ctx context.Context, req *entity.GetAllWebhookPartners) (*entity.WebhookPartnersType, error)
+		DeletePartner(ctx context.Context, id string) (*entity.WebhookPartner, error)
+		LinkPatient(ctx context.Context, req *entity.WebhookPartnerPatient) (*entity.WebhookPartnerPatient, error)
+		UnlinkPatient(ctx context.Context, req *entity.WebhookPartnerPatient) (*entity.WebhookPartnerPatient, error)
+		GetAllPartnerPatients(ctx context.Context, req *entity.GetAllWebhookPartnerPatients) (*entity.WebhookPartnerPatientsType, error)
+		CreateSubscription(ctx context.Context, req *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+		GetSubscription(ctx context.Context, id string) (*entity.WebhookSubscription, error)
+		GetAllSubscriptions(ctx context.Context, req *entity.GetAllWebhookSubscriptions) (*entity.WebhookSubscriptionsType, error)
+		UpdateSubscription(ctx context.Context, req *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+		RotateSecret(ctx context.Context, id string) (*entity.WebhookSubscription, error)
+		DeleteSubscription(ctx context.Context, id string) (*entity.WebhookSubscription, error)
+		GetAllWebhookDeliveries(ctx context.Context, req *entity.GetAllWebhookDeliveries) (*entity.WebhookDeliveriesType, error)
+		GetWebhookDelivery(ctx context.Context, id string) (*entity.WebhookDelivery, error)
+		Redeliver(ctx context.Context, id string) (*entity.WebhookDelivery, error)
+	}
+)
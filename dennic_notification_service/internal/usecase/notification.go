@@ -0,0 +1,507 @@
+package usecase
+
+import (
+	"context"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/pkg/otlp"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	serviceNameNotification = "NotificationService"
+	spanNameNotification    = "NotificationUsecase"
+)
+
+// reminderOffsets are how long before an appointment each reminder goes out.
+var reminderOffsets = []struct {
+	kind   string
+	offset time.Duration
+}{
+	{entity.KindReminder24h, 24 * time.Hour},
+	{entity.KindReminder2h, 2 * time.Hour},
+}
+
+// sendLease is how long a claimed delivery is kept from other workers while
+// it is being sent.
+const sendLease = time.Minute
+
+// ReminderOptions -.
+type ReminderOptions struct {
+	BatchSize    uint64
+	MaxAttempts  int32
+	RetryBackoff time.Duration
+	Language     string
+	// Location is the time zone of branches that have none set.
+	Location *time.Location
+}
+
+// NotificationUseCase -.
+type NotificationUseCase struct {
+	Preferences PreferencesRepo
+	Reminders   RemindersRepo
+	Deliveries  DeliveriesRepo
+	Directory   Directory
+	Templates   Templates
+	senders     map[string]Sender
+	options     ReminderOptions
+	ctxTimeout  time.Duration
+}
+
+// NewNotification -.
+func NewNotification(preferences PreferencesRepo, reminders RemindersRepo, deliveries DeliveriesRepo, directory Directory,
+	templates Templates, senders []Sender, options ReminderOptions, ctxTimeout time.Duration) *NotificationUseCase {
+	bySenders := make(map[string]Sender, len(senders))
+	for _, sender := range senders {
+		bySenders[sender.Channel()] = sender
+	}
+
+	return &NotificationUseCase{
+		Preferences: preferences,
+		Reminders:   reminders,
+		Deliveries:  deliveries,
+		Directory:   directory,
+		Templates:   templates,
+		senders:     bySenders,
+		options:     options,
+		ctxTimeout:  ctxTimeout,
+	}
+}
+
+// HandleAppointmentEvent keeps the appointment's reminders in step with it:
+// reminders are scheduled for appointments still to come and cancelled once
+// the appointment is deleted, cancelled or closed out.
+func (r *NotificationUseCase) HandleAppointmentEvent(ctx context.Context, event *entity.AppointmentEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotification+"AppointmentEvent")
+	defer span.End()
+
+	if event.AppointmentId <= 0 {
+		return fmt.Errorf("appointment event %q without appointment id", event.Type)
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+
+	switch {
+	case event.Type == entity.EventAppointmentDeleted,
+		event.Status == entity.AppointmentAttended,
+		event.Status == entity.AppointmentCancelled,
+		event.Status == entity.AppointmentNoShow,
+		event.StartsAt.IsZero():
+		return r.Reminders.CancelReminders(ctx, event.AppointmentId, event.OccurredAt)
+	}
+
+	now := time.Now()
+	for _, reminder := range reminderOffsets {
+		sendAt := event.StartsAt.Add(-reminder.offset)
+		if !sendAt.After(now) {
+			// booked or moved too late for this reminder
+			if err := r.Reminders.CancelReminders(ctx, event.AppointmentId, event.OccurredAt, reminder.kind); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := r.Reminders.ScheduleReminder(ctx, &entity.Reminder{
+			Id:            uuid.NewString(),
+			AppointmentId: event.AppointmentId,
+			Kind:          reminder.kind,
+			PatientId:     event.PatientId,
+			DoctorId:      event.DoctorId,
+			BranchId:      event.BranchId,
+			Modality:      event.Modality,
+			StartsAt:      event.StartsAt,
+			SendAt:        sendAt,
+			EventAt:       event.OccurredAt,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WelcomeUser queues the welcome of a patient who just registered: a push to
+// the device they registered on and an SMS. They have no preferences saved
+// yet, so it goes out in the default language.
+func (r *NotificationUseCase) WelcomeUser(ctx context.Context, welcome *entity.Welcome) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotification+"WelcomeUser")
+	defer span.End()
+
+	if welcome.UserId == "" {
+		return errors.New("welcome of a user without id")
+	}
+
+	language := r.options.Language
+	title, body, err := r.Templates.Render(entity.KindWelcome, language, &entity.MessageData{
+		PatientName: strings.TrimSpace(welcome.FirstName + " " + welcome.LastName),
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var deliveries []*entity.Delivery
+	queue := func(channel, recipient string) {
+		if _, ok := r.senders[channel]; !ok || recipient == "" {
+			return
+		}
+		deliveries = append(deliveries, &entity.Delivery{
+			Id:            uuid.NewString(),
+			Kind:          entity.KindWelcome,
+			UserId:        welcome.UserId,
+			Channel:       channel,
+			Recipient:     recipient,
+			Language:      language,
+			Title:         title,
+			Body:          body,
+			NextAttemptAt: now,
+		})
+	}
+	queue(entity.ChannelPush, welcome.FcmToken)
+	queue(entity.ChannelSMS, welcome.PhoneNumber)
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return r.Deliveries.QueueDeliveries(ctx, deliveries)
+}
+
+// DispatchDueReminders renders the reminders whose time has come for every
+// channel the patient's account wants them on and queues the deliveries.
+func (r *NotificationUseCase) DispatchDueReminders(ctx context.Context, now time.Time) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotification+"DispatchReminders")
+	defer span.End()
+
+	reminders, err := r.Reminders.GetDueReminders(ctx, now, r.options.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		dispatched int
+		errs       []error
+	)
+	for _, reminder := range reminders {
+		deliveries, err := r.reminderDeliveries(ctx, reminder, now)
+		if err != nil {
+			// left scheduled to be tried on the next sweep
+			errs = append(errs, fmt.Errorf("reminder %s: %w", reminder.Id, err))
+			continue
+		}
+
+		ok, err := r.Reminders.DispatchReminder(ctx, reminder, deliveries)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reminder %s: %w", reminder.Id, err))
+			continue
+		}
+		if ok {
+			dispatched++
+		}
+	}
+
+	return dispatched, errors.Join(errs...)
+}
+
+func (r *NotificationUseCase) reminderDeliveries(ctx context.Context, reminder *entity.Reminder, now time.Time) ([]*entity.Delivery, error) {
+	if !reminder.StartsAt.After(now) {
+		// the sweep fell behind the appointment itself
+		return nil, nil
+	}
+
+	contact, err := r.Directory.GetContact(ctx, reminder.PatientId)
+	var errNotFound *entity.ErrNotFound
+	if errors.As(err, &errNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	preferences := entity.DefaultPreferences(contact.UserId, r.options.Language)
+	if contact.UserId != "" {
+		saved, err := r.Preferences.GetPreferences(ctx, contact.UserId)
+		switch {
+		case err == nil:
+			preferences = saved
+		case !errors.As(err, &errNotFound):
+			return nil, err
+		}
+	}
+	if !preferences.Reminders {
+		return nil, nil
+	}
+
+	data, err := appointmentMessageData(ctx, r.Directory, r.options.Location, contact,
+		reminder.DoctorId, reminder.BranchId, reminder.Modality, reminder.StartsAt)
+	if err != nil {
+		return nil, err
+	}
+	title, body, err := r.Templates.Render(reminder.Kind, preferences.Language, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries []*entity.Delivery
+	queue := func(channel string, recipients ...string) {
+		if _, ok := r.senders[channel]; !ok {
+			return
+		}
+		for _, recipient := range recipients {
+			if recipient == "" {
+				continue
+			}
+			deliveries = append(deliveries, &entity.Delivery{
+				Id:            uuid.NewString(),
+				ReminderId:    reminder.Id,
+				AppointmentId: reminder.AppointmentId,
+				Kind:          reminder.Kind,
+				UserId:        contact.UserId,
+				PatientId:     reminder.PatientId,
+				Channel:       channel,
+				Recipient:     recipient,
+				Language:      preferences.Language,
+				StartsAt:      reminder.StartsAt,
+				Title:         title,
+				Body:          body,
+				NextAttemptAt: now,
+			})
+		}
+	}
+
+	if preferences.Push && contact.UserId != "" {
+		tokens, err := r.Directory.GetPushTokens(ctx, contact.UserId)
+		if err != nil {
+			return nil, err
+		}
+		queue(entity.ChannelPush, tokens...)
+	}
+	if preferences.Sms {
+		queue(entity.ChannelSMS, contact.PhoneNumber)
+	}
+	if preferences.Email {
+		queue(entity.ChannelEmail, preferences.EmailAddress)
+	}
+
+	return deliveries, nil
+}
+
+// appointmentMessageData fills the templates about an appointment, its time
+// written in the zone of its branch or else in location.
+func appointmentMessageData(ctx context.Context, directory Directory, location *time.Location, contact *entity.Contact,
+	doctorId, branchId, modality string, startsAt time.Time) (*entity.MessageData, error) {
+	data := &entity.MessageData{
+		PatientName: contactName(contact),
+		Online:      modality == entity.ModalityOnline,
+	}
+
+	if doctorId != "" {
+		name, err := directory.GetDoctorName(ctx, doctorId)
+		if err != nil {
+			return nil, err
+		}
+		data.DoctorName = name
+	}
+	if branchId != "" {
+		name, timezone, err := directory.GetBranch(ctx, branchId)
+		if err != nil {
+			return nil, err
+		}
+		data.BranchName = name
+		if zone, err := time.LoadLocation(timezone); timezone != "" && err == nil {
+			location = zone
+		}
+	}
+	data.StartsAt = startsAt.In(location)
+
+	return data, nil
+}
+
+// contactName is how messages address the patient.
+func contactName(contact *entity.Contact) string {
+	return strings.TrimSpace(contact.FirstName + " " + contact.LastName)
+}
+
+// SendPendingDeliveries sends the queued deliveries that are due. Failed sends
+// are retried with exponential backoff until MaxAttempts; a recipient the
+// channel cannot reach fails at once.
+func (r *NotificationUseCase) SendPendingDeliveries(ctx context.Context, now time.Time) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotification+"SendDeliveries")
+	defer span.End()
+
+	deliveries, err := r.Deliveries.ClaimPendingDeliveries(ctx, now, sendLease, r.options.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		sent int
+		errs []error
+	)
+	for _, delivery := range deliveries {
+		r.send(ctx, delivery, now)
+		if delivery.Status == entity.DeliverySent {
+			sent++
+		}
+		if err := r.Deliveries.UpdateDeliveryStatus(ctx, delivery); err != nil {
+			errs = append(errs, fmt.Errorf("delivery %s: %w", delivery.Id, err))
+		}
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+func (r *NotificationUseCase) send(ctx context.Context, delivery *entity.Delivery, now time.Time) {
+	delivery.Attempts++
+
+	var err error
+	sender, ok := r.senders[delivery.Channel]
+	switch {
+	case !ok:
+		err = entity.NewErrUndeliverable("channel " + delivery.Channel + " is not configured")
+	case !delivery.StartsAt.IsZero() && !delivery.StartsAt.After(now):
+		err = entity.NewErrUndeliverable("the appointment has already started")
+	default:
+		delivery.ProviderRef, err = sender.Send(ctx, &entity.Message{
+			Channel:   delivery.Channel,
+			Recipient: delivery.Recipient,
+			Title:     delivery.Title,
+			Body:      delivery.Body,
+			Data:      messageData(delivery),
+		})
+	}
+
+	var errUndeliverable *entity.ErrUndeliverable
+	switch {
+	case err == nil:
+		delivery.Status = entity.DeliverySent
+		delivery.LastError = ""
+		delivery.SentAt = now
+	case errors.As(err, &errUndeliverable), delivery.Attempts >= r.options.MaxAttempts:
+		delivery.Status = entity.DeliveryFailed
+		delivery.LastError = err.Error()
+	default:
+		delivery.Status = entity.DeliveryPending
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = now.Add(RetryDelay(r.options.RetryBackoff, delivery.Attempts))
+	}
+}
+
+func messageData(delivery *entity.Delivery) map[string]string {
+	data := map[string]string{
+		"kind": delivery.Kind,
+	}
+	if delivery.AppointmentId > 0 {
+		data["appointment_id"] = strconv.FormatInt(delivery.AppointmentId, 10)
+	}
+	return data
+}
+
+// RetryDelay is the wait before the next attempt after the given number of
+// failed ones: base, 2*base, 4*base and so on, capped at a day.
+func RetryDelay(base time.Duration, attempts int32) time.Duration {
+	const maxDelay = 24 * time.Hour
+
+	delay := base
+	for i := int32(1); i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+func (r *NotificationUseCase) GetPreferences(ctx context.Context, userId string) (*entity.Preferences, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotification+"GetPreferences")
+	defer span.End()
+
+	if _, err := uuid.Parse(userId); err != nil {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("invalid notification preferences")
+		errValidation.Errors["user_id"] = "must be a uuid"
+		return nil, errValidation
+	}
+
+	preferences, err := r.Preferences.GetPreferences(ctx, userId)
+	var errNotFound *entity.ErrNotFound
+	if errors.As(err, &errNotFound) {
+		return entity.DefaultPreferences(userId, r.options.Language), nil
+	}
+
+	return preferences, err
+}
+
+func (r *NotificationUseCase) UpdatePreferences(ctx context.Context, req *entity.Preferences) (*entity.Preferences, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotification+"UpdatePreferences")
+	defer span.End()
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid notification preferences")
+	if _, err := uuid.Parse(req.UserId); err != nil {
+		errValidation.Errors["user_id"] = "must be a uuid"
+	}
+	switch req.Language {
+	case entity.LanguageUz, entity.LanguageRu, entity.LanguageEn:
+	default:
+		errValidation.Errors["language"] = "must be one of uz, ru, en"
+	}
+	req.EmailAddress = strings.TrimSpace(req.EmailAddress)
+	if req.EmailAddress != "" {
+		if address, err := mail.ParseAddress(req.EmailAddress); err != nil || address.Address != req.EmailAddress {
+			errValidation.Errors["email_address"] = "is not a valid email address"
+		}
+	} else if req.Email {
+		errValidation.Errors["email_address"] = "is required to receive email"
+	}
+	if len(errValidation.Errors) > 0 {
+		return nil, errValidation
+	}
+
+	return r.Preferences.UpsertPreferences(ctx, req)
+}
+
+func (r *NotificationUseCase) GetAllDeliveries(ctx context.Context, req *entity.GetAllDeliveries) (*entity.DeliveriesType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotification+"GetAllDeliveries")
+	defer span.End()
+
+	return r.Deliveries.GetAllDeliveries(ctx, req)
+}
+
+func (r *NotificationUseCase) GetAppointmentReminders(ctx context.Context, appointmentId int64) ([]*entity.Reminder, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameNotification, spanNameNotification+"GetAppointmentReminders")
+	defer span.End()
+
+	return r.Reminders.GetAppointmentReminders(ctx, appointmentId)
+}
@@ -0,0 +1,524 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"dennic_notification_service/internal/entity"
+	"dennic_notification_service/internal/pkg/otlp"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	serviceNameWebhooks = "WebhookService"
+	spanNameWebhooks    = "WebhookUsecase"
+)
+
+// secretPrefix tells a webhook secret apart from other credentials of a
+// partner.
+const secretPrefix = "whsec_"
+
+// WebhookOptions -.
+type WebhookOptions struct {
+	BatchSize    uint64
+	MaxAttempts  int32
+	RetryBackoff time.Duration
+	// Timeout bounds the wait for a partner to answer one delivery.
+	Timeout time.Duration
+}
+
+// WebhooksUseCase tells partner systems about domain events: every event is
+// queued for the active subscriptions to its type of the partners its patient
+// is linked to, in the transaction of the consumer, and posted by the sweep
+// with retries.
+type WebhooksUseCase struct {
+	Webhooks   WebhooksRepo
+	Client     WebhookClient
+	options    WebhookOptions
+	ctxTimeout time.Duration
+}
+
+// NewWebhooks -.
+func NewWebhooks(webhooks WebhooksRepo, client WebhookClient, options WebhookOptions, ctxTimeout time.Duration) *WebhooksUseCase {
+	return &WebhooksUseCase{
+		Webhooks:   webhooks,
+		Client:     client,
+		options:    options,
+		ctxTimeout: ctxTimeout,
+	}
+}
+
+// HandleEvent queues an event for the subscriptions to its type of the
+// partners its patient is linked to. An appointment the patient attended is
+// told as appointment.completed too.
+func (r *WebhooksUseCase) HandleEvent(ctx context.Context, event *entity.WebhookEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"HandleEvent")
+	defer span.End()
+
+	// no partner is linked to a patient the event does not name
+	if _, err := uuid.Parse(event.PatientId); err != nil {
+		return nil
+	}
+
+	types := []string{event.Type}
+	if appointment, ok := event.Data.(*entity.WebhookAppointment); ok &&
+		event.Type == entity.EventAppointmentStatusChanged && appointment.Status == entity.AppointmentAttended {
+		types = append(types, entity.EventAppointmentCompleted)
+	}
+
+	now := time.Now()
+	var deliveries []*entity.WebhookDelivery
+	for _, eventType := range types {
+		subscriptions, err := r.Webhooks.GetSubscribers(ctx, eventType, event.PatientId)
+		if err != nil {
+			return err
+		}
+		if len(subscriptions) == 0 {
+			continue
+		}
+
+		payload, err := json.Marshal(&entity.WebhookBody{
+			Id:         event.Id,
+			Type:       eventType,
+			OccurredAt: event.OccurredAt,
+			Data:       event.Data,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, subscription := range subscriptions {
+			deliveries = append(deliveries, &entity.WebhookDelivery{
+				Id:             uuid.NewString(),
+				SubscriptionId: subscription.Id,
+				EventId:        event.Id,
+				EventType:      eventType,
+				Payload:        string(payload),
+				NextAttemptAt:  now,
+			})
+		}
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return r.Webhooks.QueueWebhookDeliveries(ctx, deliveries)
+}
+
+// SendPendingWebhooks posts the queued deliveries that are due and logs
+// every attempt. Failed posts are retried with exponential backoff until
+// MaxAttempts; deliveries of a deactivated subscription fail at once.
+func (r *WebhooksUseCase) SendPendingWebhooks(ctx context.Context, now time.Time) (int, error) {
+	// long enough for every claimed partner to time out
+	lease := r.options.Timeout*time.Duration(r.options.BatchSize) + r.ctxTimeout
+
+	ctx, cancel := context.WithTimeout(ctx, lease)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"SendWebhooks")
+	defer span.End()
+
+	deliveries, err := r.Webhooks.ClaimPendingWebhookDeliveries(ctx, now, lease, r.options.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		delivered int
+		errs      []error
+	)
+	for _, delivery := range deliveries {
+		attempt := r.post(ctx, delivery)
+		if delivery.Status == entity.WebhookDelivered {
+			delivered++
+		}
+		if err := r.Webhooks.RecordWebhookAttempt(ctx, delivery, attempt); err != nil {
+			errs = append(errs, fmt.Errorf("webhook delivery %s: %w", delivery.Id, err))
+		}
+	}
+
+	return delivered, errors.Join(errs...)
+}
+
+func (r *WebhooksUseCase) post(ctx context.Context, delivery *entity.WebhookDelivery) *entity.WebhookAttempt {
+	delivery.Attempts++
+	startedAt := time.Now()
+
+	var (
+		code int
+		err  error
+	)
+	if delivery.Active {
+		code, err = r.Client.Post(ctx, &entity.WebhookRequest{
+			Url:        delivery.Url,
+			Secret:     delivery.Secret,
+			DeliveryId: delivery.Id,
+			EventType:  delivery.EventType,
+			Body:       []byte(delivery.Payload),
+		})
+	} else {
+		err = entity.NewErrUndeliverable("the subscription is inactive")
+	}
+
+	finishedAt := time.Now()
+	attempt := &entity.WebhookAttempt{
+		Id:           uuid.NewString(),
+		DeliveryId:   delivery.Id,
+		Attempt:      delivery.Attempts,
+		ResponseCode: int32(code),
+		Duration:     finishedAt.Sub(startedAt),
+	}
+	delivery.ResponseCode = int32(code)
+
+	var errUndeliverable *entity.ErrUndeliverable
+	switch {
+	case err == nil:
+		delivery.Status = entity.WebhookDelivered
+		delivery.LastError = ""
+		delivery.DeliveredAt = finishedAt
+	case errors.As(err, &errUndeliverable), delivery.Attempts >= r.options.MaxAttempts:
+		delivery.Status = entity.WebhookFailed
+		delivery.LastError = err.Error()
+	default:
+		delivery.Status = entity.WebhookPending
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = finishedAt.Add(RetryDelay(r.options.RetryBackoff, delivery.Attempts))
+	}
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+
+	return attempt
+}
+
+func (r *WebhooksUseCase) CreatePartner(ctx context.Context, req *entity.WebhookPartner) (*entity.WebhookPartner, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"CreatePartner")
+	defer span.End()
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" || len(req.Name) > 100 {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("invalid webhook partner")
+		errValidation.Errors["name"] = "must be 1 to 100 characters"
+		return nil, errValidation
+	}
+	req.Id = uuid.NewString()
+
+	return r.Webhooks.CreatePartner(ctx, req)
+}
+
+func (r *WebhooksUseCase) GetAllPartners(ctx context.Context, req *entity.GetAllWebhookPartners) (*entity.WebhookPartnersType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"GetAllPartners")
+	defer span.End()
+
+	return r.Webhooks.GetAllPartners(ctx, req)
+}
+
+// DeletePartner deletes a partner with its subscriptions and patient links.
+func (r *WebhooksUseCase) DeletePartner(ctx context.Context, id string) (*entity.WebhookPartner, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"DeletePartner")
+	defer span.End()
+
+	if err := validateWebhookId("id", id); err != nil {
+		return nil, err
+	}
+
+	return r.Webhooks.DeletePartner(ctx, id)
+}
+
+// LinkPatient tells a partner about the events of a patient from now on,
+// e.g. of an employee of a corporate client.
+func (r *WebhooksUseCase) LinkPatient(ctx context.Context, req *entity.WebhookPartnerPatient) (*entity.WebhookPartnerPatient, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"LinkPatient")
+	defer span.End()
+
+	if err := validateWebhookId("partner_id", req.PartnerId); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookId("patient_id", req.PatientId); err != nil {
+		return nil, err
+	}
+	if _, err := r.Webhooks.GetPartner(ctx, req.PartnerId); err != nil {
+		return nil, err
+	}
+
+	return r.Webhooks.LinkPatient(ctx, req)
+}
+
+func (r *WebhooksUseCase) UnlinkPatient(ctx context.Context, req *entity.WebhookPartnerPatient) (*entity.WebhookPartnerPatient, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"UnlinkPatient")
+	defer span.End()
+
+	if err := validateWebhookId("partner_id", req.PartnerId); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookId("patient_id", req.PatientId); err != nil {
+		return nil, err
+	}
+
+	return r.Webhooks.UnlinkPatient(ctx, req)
+}
+
+func (r *WebhooksUseCase) GetAllPartnerPatients(ctx context.Context, req *entity.GetAllWebhookPartnerPatients) (*entity.WebhookPartnerPatientsType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"GetAllPartnerPatients")
+	defer span.End()
+
+	if err := validateWebhookId("partner_id", req.PartnerId); err != nil {
+		return nil, err
+	}
+
+	return r.Webhooks.GetAllPartnerPatients(ctx, req)
+}
+
+// CreateSubscription registers an endpoint of a partner with a new secret,
+// the only time the secret is returned besides RotateSecret.
+func (r *WebhooksUseCase) CreateSubscription(ctx context.Context, req *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"CreateSubscription")
+	defer span.End()
+
+	if err := validateWebhookId("partner_id", req.PartnerId); err != nil {
+		return nil, err
+	}
+	if err := r.validateSubscription(ctx, req); err != nil {
+		return nil, err
+	}
+	if _, err := r.Webhooks.GetPartner(ctx, req.PartnerId); err != nil {
+		return nil, err
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	req.Id = uuid.NewString()
+	req.Secret = secret
+	req.Active = true
+
+	subscription, err := r.Webhooks.CreateSubscription(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	subscription.Secret = secret
+
+	return subscription, nil
+}
+
+func (r *WebhooksUseCase) GetSubscription(ctx context.Context, id string) (*entity.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"GetSubscription")
+	defer span.End()
+
+	if err := validateWebhookId("id", id); err != nil {
+		return nil, err
+	}
+
+	return r.Webhooks.GetSubscription(ctx, id)
+}
+
+func (r *WebhooksUseCase) GetAllSubscriptions(ctx context.Context, req *entity.GetAllWebhookSubscriptions) (*entity.WebhookSubscriptionsType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"GetAllSubscriptions")
+	defer span.End()
+
+	if req.PartnerId != "" {
+		if err := validateWebhookId("partner_id", req.PartnerId); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.Webhooks.GetAllSubscriptions(ctx, req)
+}
+
+func (r *WebhooksUseCase) UpdateSubscription(ctx context.Context, req *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"UpdateSubscription")
+	defer span.End()
+
+	if err := validateWebhookId("id", req.Id); err != nil {
+		return nil, err
+	}
+	if err := r.validateSubscription(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return r.Webhooks.UpdateSubscription(ctx, req)
+}
+
+// RotateSecret gives a subscription a new secret; deliveries sent from now
+// on, retries included, are signed with it.
+func (r *WebhooksUseCase) RotateSecret(ctx context.Context, id string) (*entity.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"RotateSecret")
+	defer span.End()
+
+	if err := validateWebhookId("id", id); err != nil {
+		return nil, err
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := r.Webhooks.SetSecret(ctx, id, secret)
+	if err != nil {
+		return nil, err
+	}
+	subscription.Secret = secret
+
+	return subscription, nil
+}
+
+func (r *WebhooksUseCase) DeleteSubscription(ctx context.Context, id string) (*entity.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"DeleteSubscription")
+	defer span.End()
+
+	if err := validateWebhookId("id", id); err != nil {
+		return nil, err
+	}
+
+	return r.Webhooks.DeleteSubscription(ctx, id)
+}
+
+func (r *WebhooksUseCase) GetAllWebhookDeliveries(ctx context.Context, req *entity.GetAllWebhookDeliveries) (*entity.WebhookDeliveriesType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"GetAllDeliveries")
+	defer span.End()
+
+	if req.SubscriptionId != "" {
+		if err := validateWebhookId("subscription_id", req.SubscriptionId); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.Webhooks.GetAllWebhookDeliveries(ctx, req)
+}
+
+func (r *WebhooksUseCase) GetWebhookDelivery(ctx context.Context, id string) (*entity.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"GetDelivery")
+	defer span.End()
+
+	if err := validateWebhookId("id", id); err != nil {
+		return nil, err
+	}
+
+	return r.Webhooks.GetWebhookDelivery(ctx, id)
+}
+
+// Redeliver sends a delivery again with the body it was first sent with,
+// e.g. once a partner fixed their endpoint.
+func (r *WebhooksUseCase) Redeliver(ctx context.Context, id string) (*entity.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameWebhooks, spanNameWebhooks+"Redeliver")
+	defer span.End()
+
+	if err := validateWebhookId("id", id); err != nil {
+		return nil, err
+	}
+
+	return r.Webhooks.RedeliverWebhook(ctx, id, time.Now())
+}
+
+// validateSubscription checks the url is an https endpoint on a public
+// address; the sender checks the address again on every delivery.
+func (r *WebhooksUseCase) validateSubscription(ctx context.Context, req *entity.WebhookSubscription) error {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("invalid webhook subscription")
+
+	req.Url = strings.TrimSpace(req.Url)
+	if err := r.Client.CheckUrl(ctx, req.Url); err != nil {
+		errValidation.Errors["url"] = err.Error()
+	}
+
+	var eventTypes []string
+	for _, eventType := range req.EventTypes {
+		if !slices.Contains(entity.WebhookEventTypes, eventType) {
+			errValidation.Errors["event_types"] = "must be some of " + strings.Join(entity.WebhookEventTypes, ", ")
+			break
+		}
+		if !slices.Contains(eventTypes, eventType) {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+	if len(req.EventTypes) == 0 {
+		errValidation.Errors["event_types"] = "are required"
+	}
+	req.EventTypes = eventTypes
+
+	if len(req.Description) > 255 {
+		errValidation.Errors["description"] = "must be at most 255 characters"
+	}
+
+	if len(errValidation.Errors) > 0 {
+		return errValidation
+	}
+	return nil
+}
+
+func validateWebhookId(field, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("invalid " + field)
+		errValidation.Errors[field] = "must be a uuid"
+		return errValidation
+	}
+	return nil
+}
+
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return secretPrefix + hex.EncodeToString(secret), nil
+}
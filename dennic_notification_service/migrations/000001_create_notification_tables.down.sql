@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "notification_deliveries";
+DROP TABLE IF EXISTS "notification_reminders";
+DROP TABLE IF EXISTS "notification_preferences";
@@ -0,0 +1,59 @@
+-- Notifications keep absolute instants shared with other services, so their
+-- times carry a time zone.
+CREATE TABLE "notification_preferences"(
+                                           "user_id" UUID PRIMARY KEY NOT NULL,
+                                           "language" VARCHAR(2) NOT NULL DEFAULT 'uz' CHECK ("language" IN ('uz', 'ru', 'en')),
+                                           "push" BOOLEAN NOT NULL DEFAULT TRUE,
+                                           "sms" BOOLEAN NOT NULL DEFAULT TRUE,
+                                           "email" BOOLEAN NOT NULL DEFAULT FALSE,
+                                           "email_address" VARCHAR(255) NOT NULL DEFAULT '',
+                                           "reminders" BOOLEAN NOT NULL DEFAULT TRUE,
+                                           "updated_at" TIMESTAMPTZ(0) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE "notification_reminders"(
+                                         "id" UUID PRIMARY KEY NOT NULL,
+                                         "appointment_id" INTEGER NOT NULL,
+                                         "kind" VARCHAR(20) NOT NULL CHECK ("kind" IN ('reminder_24h', 'reminder_2h')),
+                                         "patient_id" UUID NOT NULL,
+                                         "doctor_id" UUID NULL,
+                                         "branch_id" UUID NULL,
+                                         "modality" VARCHAR(20) NOT NULL DEFAULT '',
+                                         "starts_at" TIMESTAMPTZ(0) NOT NULL,
+                                         "send_at" TIMESTAMPTZ(0) NOT NULL,
+                                         "status" VARCHAR(20) NOT NULL DEFAULT 'scheduled' CHECK ("status" IN ('scheduled', 'dispatched', 'cancelled')),
+                                         "event_at" TIMESTAMPTZ(3) NOT NULL,
+                                         "created_at" TIMESTAMPTZ(0) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                         "updated_at" TIMESTAMPTZ(0) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                         CONSTRAINT "notification_reminders_appointment_kind_unique" UNIQUE ("appointment_id", "kind")
+);
+CREATE INDEX "notification_reminders_due_idx" ON "notification_reminders" ("send_at") WHERE "status" = 'scheduled';
+
+CREATE TABLE "notification_deliveries"(
+                                          "id" UUID PRIMARY KEY NOT NULL,
+                                          "reminder_id" UUID NULL REFERENCES "notification_reminders"("id") ON DELETE CASCADE,
+                                          "appointment_id" INTEGER NULL,
+                                          "kind" VARCHAR(20) NOT NULL,
+                                          "user_id" UUID NULL,
+                                          "patient_id" UUID NULL,
+                                          "channel" VARCHAR(10) NOT NULL CHECK ("channel" IN ('push', 'sms', 'email')),
+                                          "recipient" TEXT NOT NULL,
+                                          "language" VARCHAR(2) NOT NULL,
+                                          "starts_at" TIMESTAMPTZ(0) NOT NULL,
+                                          "title" VARCHAR(255) NOT NULL DEFAULT '',
+                                          "body" TEXT NOT NULL,
+                                          "status" VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK ("status" IN ('pending', 'sent', 'failed')),
+                                          "attempts" INTEGER NOT NULL DEFAULT 0,
+                                          "last_error" TEXT NOT NULL DEFAULT '',
+                                          "provider_ref" VARCHAR(255) NOT NULL DEFAULT '',
+                                          "next_attempt_at" TIMESTAMPTZ(0) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                          "sent_at" TIMESTAMPTZ(0) NULL,
+                                          "created_at" TIMESTAMPTZ(0) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                          "updated_at" TIMESTAMPTZ(0) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                          -- a reminder reaches each recipient once per channel and
+                                          -- appointment time, so a rescheduled appointment is reminded again
+                                          CONSTRAINT "notification_deliveries_reminder_unique" UNIQUE ("reminder_id", "starts_at", "channel", "recipient")
+);
+CREATE INDEX "notification_deliveries_pending_idx" ON "notification_deliveries" ("next_attempt_at") WHERE "status" = 'pending';
+CREATE INDEX "notification_deliveries_user_id_idx" ON "notification_deliveries" ("user_id", "created_at" DESC);
+CREATE INDEX "notification_deliveries_appointment_id_idx" ON "notification_deliveries" ("appointment_id");
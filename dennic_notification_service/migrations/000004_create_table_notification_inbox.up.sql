@@ -0,0 +1,15 @@
+CREATE TABLE "notification_inbox"(
+                                     "id" UUID PRIMARY KEY NOT NULL,
+                                     "user_id" UUID NOT NULL,
+                                     "kind" VARCHAR(30) NOT NULL,
+                                     "title" VARCHAR(255) NOT NULL DEFAULT '',
+                                     "body" TEXT NOT NULL,
+                                     -- the deep link the app opens the notification with and the
+                                     -- ids behind it
+                                     "link" TEXT NOT NULL DEFAULT '',
+                                     "data" JSONB NOT NULL DEFAULT '{}',
+                                     "read_at" TIMESTAMPTZ(0) NULL,
+                                     "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX "notification_inbox_user_id_idx" ON "notification_inbox" ("user_id", "created_at" DESC);
+CREATE INDEX "notification_inbox_unread_idx" ON "notification_inbox" ("user_id") WHERE "read_at" IS NULL;
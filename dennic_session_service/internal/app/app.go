@@ -1,9 +1,11 @@
 package app
 
 import (
+	"dennic_kafka/eventbus"
 	pb "dennic_session_service/genproto/session_service"
 	grpc_server "dennic_session_service/internal/delivery/grpc/server"
 	invest_grpc "dennic_session_service/internal/delivery/grpc/services"
+	"dennic_session_service/internal/delivery/kafka/handlers"
 	"dennic_session_service/internal/infrastructure/grpc_service_clients"
 	"dennic_session_service/internal/infrastructure/repository/postgresql"
 	"dennic_session_service/internal/pkg/config"
@@ -29,7 +31,7 @@ type App struct {
 	GrpcServer     *grpc.Server
 	ShutdownOTLP   func() error
 	ServiceClients grpc_service_clients.ServiceClients
-	BrokerProducer event.BrokerProducer
+	BrokerConsumer event.BrokerConsumer
 }
 
 func NewApp(cfg *config.Config) (*App, error) {
@@ -39,7 +41,16 @@ func NewApp(cfg *config.Config) (*App, error) {
 		return nil, err
 	}
 
-	//kafkaProducer := kafka.NewProducer(cfg, logger)
+	kafkaConsumer, err := eventbus.NewConsumer(eventbus.Config{
+		Brokers:          cfg.Kafka.Address,
+		MaxAttempts:      cfg.Kafka.Retry.MaxAttempts,
+		Backoff:          cfg.Kafka.Retry.Backoff,
+		MaxBackoff:       cfg.Kafka.Retry.MaxBackoff,
+		DeadLetterSuffix: cfg.Kafka.DeadLetterSuffix,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
 
 	// otlp collector initialization
 	shutdownOTLP, err := otlp.InitOTLPProvider(cfg)
@@ -71,11 +82,12 @@ func NewApp(cfg *config.Config) (*App, error) {
 	)
 
 	return &App{
-		Config:       cfg,
-		Logger:       logger,
-		DB:           db,
-		GrpcServer:   grpcServer,
-		ShutdownOTLP: shutdownOTLP,
+		Config:         cfg,
+		Logger:         logger,
+		DB:             db,
+		GrpcServer:     grpcServer,
+		ShutdownOTLP:   shutdownOTLP,
+		BrokerConsumer: kafkaConsumer,
 	}, nil
 }
 
@@ -103,6 +115,11 @@ func (a *App) Run() error {
 	sessionServicesUsecase := usecase.NewSessionService(contextTimeout, session)
 	pb.RegisterSessionServiceServer(a.GrpcServer, invest_grpc.SessionRPC(a.Logger, sessionServicesUsecase))
 
+	// users registered through the gateway are signed in on their device
+	if err := handlers.NewUserCreatedHandler(a.Config, a.BrokerConsumer, a.Logger, sessionServicesUsecase).HandlerEvents(); err != nil {
+		return fmt.Errorf("error during run user created consumer: %w", err)
+	}
+
 	a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
 	if err := grpc_server.Run(a.Config, a.GrpcServer); err != nil {
 		return fmt.Errorf("gRPC fatal to serve grpc server over %s %w", a.Config.RPCPort, err)
@@ -111,10 +128,12 @@ func (a *App) Run() error {
 }
 
 func (a *App) Stop() {
-	// close broker producer
-	a.BrokerProducer.Close()
+	// close broker consumer
+	a.BrokerConsumer.Close()
 	// closing client service connections
-	a.ServiceClients.Close()
+	if a.ServiceClients != nil {
+		a.ServiceClients.Close()
+	}
 	// stop gRPC server
 	a.GrpcServer.Stop()
 
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"dennic_kafka/eventbus"
+	"dennic_session_service/genproto/events"
+	"dennic_session_service/internal/entity"
+	"dennic_session_service/internal/pkg/config"
+	"dennic_session_service/internal/usecase"
+	"dennic_session_service/internal/usecase/event"
+	"errors"
+
+	"go.uber.org/zap"
+)
+
+var userCreatedSchemas = eventbus.NewSchemas(map[string]eventbus.Schema{
+	entity.EventUserCreated: {Version: 1},
+}, nil)
+
+type userCreatedHandler struct {
+	config         *config.Config
+	brokerConsumer event.BrokerConsumer
+	logger         *zap.Logger
+	sessionUsecase usecase.SessionUsecase
+}
+
+func NewUserCreatedHandler(config *config.Config,
+	brokerConsumer event.BrokerConsumer,
+	logger *zap.Logger,
+	sessionUsecase usecase.SessionUsecase) *userCreatedHandler {
+	return &userCreatedHandler{
+		config:         config,
+		brokerConsumer: brokerConsumer,
+		logger:         logger,
+		sessionUsecase: sessionUsecase,
+	}
+}
+
+func (h *userCreatedHandler) HandlerEvents() error {
+	consumerConfig := eventbus.NewConsumerConfig(
+		h.config.Kafka.Address,
+		h.config.Kafka.Topic.UserCreated,
+		h.config.Kafka.GroupId,
+		func(ctx context.Context, key, value []byte) error {
+			envelope, err := userCreatedSchemas.Decode(value)
+			if err != nil {
+				return eventbus.Permanent(err)
+			}
+
+			var user events.UserCreated
+			if err := user.Unmarshal(envelope.Payload); err != nil {
+				return eventbus.Permanent(err)
+			}
+			if user.Device == nil || user.Device.SessionId == "" {
+				// registered without signing a device in
+				return nil
+			}
+
+			_, err = h.sessionUsecase.CreateSession(ctx, &entity.SessionRequests{
+				Id:           user.Device.SessionId,
+				IpAddress:    user.Device.IpAddress,
+				UserId:       user.Id,
+				FcmToken:     user.Device.FcmToken,
+				PlatformName: user.Device.PlatformName,
+				PlatformType: user.Device.PlatformType,
+			})
+			// the gateway chose the session id, so a redelivered event
+			// conflicts with the session it created before
+			if errors.Is(err, entity.ErrorConflict) {
+				h.logger.Info("session of a registered user exists", zap.String("session_id", user.Device.SessionId),
+					zap.String("event_id", envelope.Id))
+				return nil
+			}
+
+			return err
+		},
+	)
+
+	h.brokerConsumer.RegisterConsumer(consumerConfig)
+	h.brokerConsumer.Run()
+
+	return nil
+}
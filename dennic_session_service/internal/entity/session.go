@@ -16,6 +16,10 @@ type Session struct {
 	DeletedAt    time.Time
 }
 
+// EventUserCreated is published by the user service once it registered a
+// user; the session of the device the user registered on is created then.
+const EventUserCreated = "user.created"
+
 type SessionRequests struct {
 	Id           string
 	IpAddress    string
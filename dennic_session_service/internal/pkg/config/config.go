@@ -31,9 +31,18 @@ type Config struct {
 
 	Kafka struct {
 		Address []string
+		GroupId string
 		Topic   struct {
-			SessinCreate string
+			UserCreated string
 		}
+		// Retry bounds how often a failed message is handled again before
+		// it is parked on its topic with DeadLetterSuffix appended.
+		Retry struct {
+			MaxAttempts string
+			Backoff     string
+			MaxBackoff  string
+		}
+		DeadLetterSuffix string
 	}
 }
 
@@ -61,7 +70,12 @@ func New() *Config {
 
 	// kafka configuration
 	config.Kafka.Address = strings.Split(getEnv("KAFKA_ADDRESS", "localhost:29092"), ",")
-	config.Kafka.Topic.SessinCreate = getEnv("KAFKA_TOPIC_SESSION_CREATE", "user.created")
+	config.Kafka.GroupId = getEnv("KAFKA_GROUP_ID", "dennic_session_service")
+	config.Kafka.Topic.UserCreated = getEnv("KAFKA_TOPIC_USER_CREATED", "user.created")
+	config.Kafka.Retry.MaxAttempts = getEnv("KAFKA_RETRY_MAX_ATTEMPTS", "5")
+	config.Kafka.Retry.Backoff = getEnv("KAFKA_RETRY_BACKOFF", "1s")
+	config.Kafka.Retry.MaxBackoff = getEnv("KAFKA_RETRY_MAX_BACKOFF", "30s")
+	config.Kafka.DeadLetterSuffix = getEnv("KAFKA_DEAD_LETTER_SUFFIX", ".dlq")
 
 	return &config
 }
@@ -2,15 +2,13 @@ package event
 
 import (
 	"context"
+	"dennic_kafka/eventbus"
 	"dennic_session_service/internal/entity"
 )
 
-type ConsumerConfig interface {
-	GetBrokers() []string
-	GetTopic() string
-	GetGroupID() string
-	GetHandler() func(ctx context.Context, key, value []byte) error
-}
+// ConsumerConfig is a topic to consume and the handler of its messages,
+// see eventbus.NewConsumerConfig.
+type ConsumerConfig = eventbus.ConsumerConfig
 
 type BrokerConsumer interface {
 	Run()
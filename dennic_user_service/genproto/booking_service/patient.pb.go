@@ -41,6 +41,10 @@ type Patient struct {
 	CreatedAt            string   `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string   `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	DeletedAt            string   `protobuf:"bytes,14,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	UserId               string   `protobuf:"bytes,15,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Relationship         string   `protobuf:"bytes,16,opt,name=relationship,proto3" json:"relationship"`
+	Consent              bool     `protobuf:"varint,17,opt,name=consent,proto3" json:"consent"`
+	ConsentAt            string   `protobuf:"bytes,18,opt,name=consent_at,json=consentAt,proto3" json:"consent_at"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -177,6 +181,34 @@ func (m *Patient) GetDeletedAt() string {
 	return ""
 }
 
+func (m *Patient) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Patient) GetRelationship() string {
+	if m != nil {
+		return m.Relationship
+	}
+	return ""
+}
+
+func (m *Patient) GetConsent() bool {
+	if m != nil {
+		return m.Consent
+	}
+	return false
+}
+
+func (m *Patient) GetConsentAt() string {
+	if m != nil {
+		return m.ConsentAt
+	}
+	return ""
+}
+
 type Patients struct {
 	Count                int64      `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
 	Patients             []*Patient `protobuf:"bytes,2,rep,name=patients,proto3" json:"patients"`
@@ -244,6 +276,9 @@ type CreatePatientReq struct {
 	City                 string   `protobuf:"bytes,9,opt,name=city,proto3" json:"city"`
 	Country              string   `protobuf:"bytes,10,opt,name=country,proto3" json:"country"`
 	PatientProblem       string   `protobuf:"bytes,11,opt,name=patient_problem,json=patientProblem,proto3" json:"patient_problem"`
+	UserId               string   `protobuf:"bytes,12,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Relationship         string   `protobuf:"bytes,13,opt,name=relationship,proto3" json:"relationship"`
+	Consent              bool     `protobuf:"varint,14,opt,name=consent,proto3" json:"consent"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -359,18 +394,44 @@ func (m *CreatePatientReq) GetPatientProblem() string {
 	return ""
 }
 
+func (m *CreatePatientReq) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *CreatePatientReq) GetRelationship() string {
+	if m != nil {
+		return m.Relationship
+	}
+	return ""
+}
+
+func (m *CreatePatientReq) GetConsent() bool {
+	if m != nil {
+		return m.Consent
+	}
+	return false
+}
+
 type UpdatePatientReq struct {
-	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
-	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
-	FirstName            string   `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name"`
-	LastName             string   `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name"`
-	BirthDate            string   `protobuf:"bytes,5,opt,name=birth_date,json=birthDate,proto3" json:"birth_date"`
-	Gender               string   `protobuf:"bytes,6,opt,name=gender,proto3" json:"gender"`
-	Address              string   `protobuf:"bytes,7,opt,name=address,proto3" json:"address"`
-	BloodGroup           string   `protobuf:"bytes,8,opt,name=blood_group,json=bloodGroup,proto3" json:"blood_group"`
-	City                 string   `protobuf:"bytes,9,opt,name=city,proto3" json:"city"`
-	Country              string   `protobuf:"bytes,10,opt,name=country,proto3" json:"country"`
-	PatientProblem       string   `protobuf:"bytes,11,opt,name=patient_problem,json=patientProblem,proto3" json:"patient_problem"`
+	Field          string `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	Value          string `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+	FirstName      string `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name"`
+	LastName       string `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name"`
+	BirthDate      string `protobuf:"bytes,5,opt,name=birth_date,json=birthDate,proto3" json:"birth_date"`
+	Gender         string `protobuf:"bytes,6,opt,name=gender,proto3" json:"gender"`
+	Address        string `protobuf:"bytes,7,opt,name=address,proto3" json:"address"`
+	BloodGroup     string `protobuf:"bytes,8,opt,name=blood_group,json=bloodGroup,proto3" json:"blood_group"`
+	City           string `protobuf:"bytes,9,opt,name=city,proto3" json:"city"`
+	Country        string `protobuf:"bytes,10,opt,name=country,proto3" json:"country"`
+	PatientProblem string `protobuf:"bytes,11,opt,name=patient_problem,json=patientProblem,proto3" json:"patient_problem"`
+	// when set, only a profile owned by this user is updated
+	UserId string `protobuf:"bytes,12,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	// relationship and consent are left untouched when relationship is empty
+	Relationship         string   `protobuf:"bytes,13,opt,name=relationship,proto3" json:"relationship"`
+	Consent              bool     `protobuf:"varint,14,opt,name=consent,proto3" json:"consent"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -486,6 +547,27 @@ func (m *UpdatePatientReq) GetPatientProblem() string {
 	return ""
 }
 
+func (m *UpdatePatientReq) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *UpdatePatientReq) GetRelationship() string {
+	if m != nil {
+		return m.Relationship
+	}
+	return ""
+}
+
+func (m *UpdatePatientReq) GetConsent() bool {
+	if m != nil {
+		return m.Consent
+	}
+	return false
+}
+
 type UpdatePhoneNumber struct {
 	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
@@ -550,9 +632,11 @@ func (m *UpdatePhoneNumber) GetPhoneNumber() string {
 }
 
 type PatientFieldValueReq struct {
-	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
-	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
-	IsActive             bool     `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	Value    string `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+	IsActive bool   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	// when set, only a profile owned by this user is matched
+	UserId               string   `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -612,6 +696,13 @@ func (m *PatientFieldValueReq) GetIsActive() bool {
 	return false
 }
 
+func (m *PatientFieldValueReq) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type PatientStatus struct {
 	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -666,6 +757,7 @@ type GetAllPatientsReq struct {
 	Page                 uint64   `protobuf:"varint,4,opt,name=page,proto3" json:"page"`
 	Limit                uint64   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
 	OrderBy              string   `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
+	UserId               string   `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -746,6 +838,13 @@ func (m *GetAllPatientsReq) GetOrderBy() string {
 	return ""
 }
 
+func (m *GetAllPatientsReq) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Patient)(nil), "booking_service.Patient")
 	proto.RegisterType((*Patients)(nil), "booking_service.Patients")
@@ -760,49 +859,54 @@ func init() {
 func init() { proto.RegisterFile("booking_service/patient.proto", fileDescriptor_e8c5f44427ac9dcc) }
 
 var fileDescriptor_e8c5f44427ac9dcc = []byte{
-	// 663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xdf, 0x6e, 0xd3, 0x3e,
-	0x14, 0xfe, 0xb5, 0xe9, 0x9f, 0xf4, 0x74, 0xed, 0x36, 0x6b, 0xfa, 0xc9, 0xdb, 0xb4, 0xb2, 0x45,
-	0x42, 0xdb, 0xd5, 0x90, 0x06, 0x2f, 0xd0, 0x31, 0xb1, 0x1b, 0x18, 0x53, 0x26, 0x26, 0xee, 0x22,
-	0xa7, 0xf1, 0x3a, 0x8b, 0x34, 0x09, 0x8e, 0x3b, 0xa9, 0x6f, 0xc2, 0x15, 0x12, 0xcf, 0xc2, 0x0d,
-	0xe2, 0x8a, 0x47, 0x40, 0xe3, 0x41, 0x40, 0x3e, 0x76, 0x60, 0x6d, 0x49, 0x51, 0x11, 0xdc, 0x71,
-	0xe7, 0xef, 0xfb, 0x4e, 0x8e, 0x8f, 0xbf, 0x73, 0xec, 0x16, 0x76, 0xc2, 0x34, 0x7d, 0x25, 0x92,
-	0x61, 0x90, 0x73, 0x79, 0x23, 0x06, 0xfc, 0x41, 0xc6, 0x94, 0xe0, 0x89, 0x3a, 0xcc, 0x64, 0xaa,
-	0x52, 0xb2, 0x3a, 0x23, 0x7b, 0x6f, 0x1d, 0x68, 0x9e, 0x9b, 0x10, 0xd2, 0x85, 0xaa, 0x88, 0x68,
-	0x65, 0xb7, 0x72, 0xd0, 0xf2, 0xab, 0x22, 0x22, 0x3b, 0x00, 0x57, 0x42, 0xe6, 0x2a, 0x48, 0xd8,
-	0x88, 0xd3, 0x2a, 0xf2, 0x2d, 0x64, 0xce, 0xd8, 0x88, 0x93, 0x6d, 0x68, 0xc5, 0xac, 0x50, 0x1d,
-	0x54, 0x5d, 0x4d, 0xa0, 0xb8, 0x03, 0x10, 0x0a, 0xa9, 0xae, 0x83, 0x88, 0x29, 0x4e, 0x6b, 0xe6,
-	0x5b, 0x64, 0x4e, 0x98, 0xe2, 0xe4, 0x7f, 0x68, 0x0c, 0x79, 0x12, 0x71, 0x49, 0xeb, 0x28, 0x59,
-	0x44, 0x28, 0x34, 0x59, 0x14, 0x49, 0x9e, 0xe7, 0xb4, 0x81, 0x42, 0x01, 0xc9, 0x3d, 0x68, 0x87,
-	0x71, 0x9a, 0x46, 0xc1, 0x50, 0xa6, 0xe3, 0x8c, 0x36, 0x51, 0x05, 0xa4, 0x4e, 0x35, 0x43, 0xf6,
-	0x60, 0x25, 0xbb, 0x4e, 0x13, 0x1e, 0x24, 0xe3, 0x51, 0xc8, 0x25, 0x75, 0x31, 0xa2, 0x8d, 0xdc,
-	0x19, 0x52, 0x84, 0x40, 0x6d, 0x20, 0xd4, 0x84, 0xb6, 0x50, 0xc2, 0xb5, 0xde, 0x71, 0x90, 0x8e,
-	0x13, 0x25, 0x27, 0x14, 0xcc, 0x8e, 0x16, 0x92, 0x7d, 0x58, 0xb5, 0xe6, 0x05, 0x99, 0x4c, 0xc3,
-	0x98, 0x8f, 0x68, 0x1b, 0x23, 0xba, 0x96, 0x3e, 0x37, 0xac, 0x3e, 0xeb, 0x40, 0x72, 0xa6, 0x78,
-	0x14, 0x30, 0x45, 0x57, 0xcc, 0x59, 0x2d, 0xd3, 0x57, 0x5a, 0x1e, 0x67, 0x51, 0x21, 0x77, 0x8c,
-	0x6c, 0x19, 0x23, 0x47, 0x3c, 0xe6, 0x56, 0xee, 0x1a, 0xd9, 0x32, 0x7d, 0xe5, 0x5d, 0x82, 0x6b,
-	0xfb, 0x93, 0x93, 0x0d, 0xa8, 0x63, 0x71, 0xd8, 0x23, 0xc7, 0x37, 0x80, 0x3c, 0x02, 0xd7, 0x16,
-	0x94, 0xd3, 0xea, 0xae, 0x73, 0xd0, 0x3e, 0xa2, 0x87, 0x33, 0x6d, 0x3e, 0xb4, 0x29, 0xfc, 0xef,
-	0x91, 0xde, 0xc7, 0x2a, 0xac, 0x3d, 0xc6, 0x1a, 0x0b, 0x8d, 0xbf, 0xfe, 0x37, 0x01, 0xbf, 0x37,
-	0x01, 0xde, 0xfb, 0x2a, 0xac, 0xbd, 0xc0, 0x8e, 0xde, 0x31, 0x73, 0x03, 0xea, 0x57, 0x82, 0xc7,
-	0x85, 0x9f, 0x06, 0x68, 0xf6, 0x86, 0xc5, 0xe3, 0xc2, 0x4d, 0x03, 0x66, 0x8c, 0x76, 0x16, 0x1a,
-	0x5d, 0x5b, 0x68, 0x74, 0xbd, 0xdc, 0xe8, 0x46, 0x99, 0xd1, 0xcd, 0x85, 0x46, 0xbb, 0x73, 0x46,
-	0xff, 0x25, 0x17, 0x43, 0x58, 0xb7, 0x26, 0xde, 0xe9, 0xd8, 0x32, 0x2e, 0xce, 0x0e, 0x80, 0x33,
-	0x37, 0x00, 0x5e, 0x00, 0x1b, 0xb6, 0x45, 0x4f, 0x74, 0xa2, 0x4b, 0xfd, 0xdd, 0xb2, 0xcd, 0xda,
-	0x86, 0x96, 0xc8, 0x03, 0x36, 0x50, 0xe2, 0xc6, 0xf4, 0xca, 0xf5, 0x5d, 0x91, 0xf7, 0x11, 0x7b,
-	0xfb, 0xd0, 0xb1, 0x1b, 0x5c, 0x28, 0xa6, 0xc6, 0xb9, 0xf6, 0x3f, 0xc7, 0x15, 0xa6, 0x76, 0x7d,
-	0x8b, 0xbc, 0x77, 0x15, 0x58, 0x3f, 0xe5, 0xaa, 0x1f, 0xc7, 0xc5, 0xfd, 0xfe, 0x93, 0x75, 0xe8,
-	0x1e, 0x65, 0x6c, 0x68, 0xa6, 0xa5, 0xe6, 0xe3, 0x5a, 0xa7, 0x89, 0xc5, 0x48, 0x28, 0x1c, 0x92,
-	0x9a, 0x6f, 0x00, 0xd9, 0x04, 0x37, 0x95, 0x11, 0x97, 0x41, 0x38, 0x29, 0xae, 0x1c, 0xe2, 0xe3,
-	0xc9, 0xd1, 0x57, 0x07, 0x56, 0x8b, 0xea, 0x2e, 0xcc, 0x53, 0x42, 0x9e, 0x42, 0x67, 0xea, 0xdd,
-	0x20, 0x7b, 0x73, 0xaf, 0xcd, 0xec, 0xbb, 0xb2, 0x55, 0xfa, 0x20, 0x91, 0x67, 0x00, 0xa7, 0x5c,
-	0x15, 0xe8, 0x7e, 0x59, 0xdc, 0x54, 0xb3, 0x16, 0xa4, 0x7b, 0x0e, 0xdd, 0x69, 0x4f, 0x89, 0x37,
-	0x17, 0x3b, 0x67, 0xfa, 0xd6, 0x66, 0x59, 0xbe, 0x5c, 0x9f, 0x76, 0xea, 0x62, 0xff, 0xe4, 0xb4,
-	0xb3, 0x17, 0x7f, 0x41, 0x79, 0x2f, 0x81, 0xdc, 0x99, 0xf0, 0x82, 0xf5, 0xca, 0x52, 0xfe, 0x98,
-	0xdb, 0xad, 0x5e, 0x59, 0x4e, 0x3b, 0x65, 0x97, 0xd0, 0x39, 0xc1, 0xdf, 0x8c, 0x25, 0xad, 0xfc,
-	0x45, 0xde, 0xe3, 0xb5, 0x0f, 0xb7, 0xbd, 0xca, 0xa7, 0xdb, 0x5e, 0xe5, 0xf3, 0x6d, 0xaf, 0xf2,
-	0xe6, 0x4b, 0xef, 0xbf, 0xb0, 0x81, 0xff, 0x24, 0x1e, 0x7e, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x30,
-	0x03, 0x0e, 0x91, 0x6a, 0x08, 0x00, 0x00,
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0x13, 0x3b,
+	0x14, 0xbe, 0xc9, 0x4c, 0x92, 0xc9, 0xc9, 0x6f, 0xad, 0xea, 0x5e, 0xb7, 0x55, 0x73, 0xdb, 0x91,
+	0x50, 0xbb, 0x2a, 0x52, 0xe1, 0x05, 0x52, 0x2a, 0x2a, 0x24, 0x28, 0xd5, 0x54, 0x54, 0xec, 0x46,
+	0x9e, 0xd8, 0x4d, 0x2d, 0x26, 0x33, 0x83, 0xed, 0x54, 0xe4, 0x3d, 0x58, 0x20, 0x76, 0xbc, 0x06,
+	0x4f, 0xc0, 0x92, 0x47, 0x40, 0xe5, 0x41, 0x40, 0x63, 0x7b, 0x20, 0x3f, 0x24, 0xa8, 0x88, 0x0d,
+	0x12, 0x3b, 0x9f, 0xef, 0x3b, 0x73, 0xec, 0x73, 0xbe, 0xaf, 0xa7, 0x81, 0xed, 0x28, 0x4d, 0x5f,
+	0xf0, 0x64, 0x18, 0x4a, 0x26, 0xae, 0xf9, 0x80, 0xdd, 0xcd, 0x88, 0xe2, 0x2c, 0x51, 0x07, 0x99,
+	0x48, 0x55, 0x8a, 0x3a, 0x73, 0xb4, 0xff, 0xda, 0x85, 0xda, 0x99, 0x49, 0x41, 0x6d, 0x28, 0x73,
+	0x8a, 0x4b, 0x3b, 0xa5, 0xfd, 0x7a, 0x50, 0xe6, 0x14, 0x6d, 0x03, 0x5c, 0x72, 0x21, 0x55, 0x98,
+	0x90, 0x11, 0xc3, 0x65, 0x8d, 0xd7, 0x35, 0x72, 0x4a, 0x46, 0x0c, 0x6d, 0x41, 0x3d, 0x26, 0x05,
+	0xeb, 0x68, 0xd6, 0x8b, 0x89, 0x25, 0xb7, 0x01, 0x22, 0x2e, 0xd4, 0x55, 0x48, 0x89, 0x62, 0xd8,
+	0x35, 0xdf, 0x6a, 0xe4, 0x98, 0x28, 0x86, 0xfe, 0x85, 0xea, 0x90, 0x25, 0x94, 0x09, 0x5c, 0xd1,
+	0x94, 0x8d, 0x10, 0x86, 0x1a, 0xa1, 0x54, 0x30, 0x29, 0x71, 0x55, 0x13, 0x45, 0x88, 0xfe, 0x87,
+	0x46, 0x14, 0xa7, 0x29, 0x0d, 0x87, 0x22, 0x1d, 0x67, 0xb8, 0xa6, 0x59, 0xd0, 0xd0, 0x49, 0x8e,
+	0xa0, 0x5d, 0x68, 0x66, 0x57, 0x69, 0xc2, 0xc2, 0x64, 0x3c, 0x8a, 0x98, 0xc0, 0x9e, 0xce, 0x68,
+	0x68, 0xec, 0x54, 0x43, 0x08, 0x81, 0x3b, 0xe0, 0x6a, 0x82, 0xeb, 0x9a, 0xd2, 0xe7, 0xfc, 0xc6,
+	0x41, 0x3a, 0x4e, 0x94, 0x98, 0x60, 0x30, 0x37, 0xda, 0x10, 0xed, 0x41, 0xc7, 0x0e, 0x2f, 0xcc,
+	0x44, 0x1a, 0xc5, 0x6c, 0x84, 0x1b, 0x3a, 0xa3, 0x6d, 0xe1, 0x33, 0x83, 0xe6, 0xbd, 0x0e, 0x04,
+	0x23, 0x8a, 0xd1, 0x90, 0x28, 0xdc, 0x34, 0xbd, 0x5a, 0xa4, 0xaf, 0x72, 0x7a, 0x9c, 0xd1, 0x82,
+	0x6e, 0x19, 0xda, 0x22, 0x86, 0xa6, 0x2c, 0x66, 0x96, 0x6e, 0x1b, 0xda, 0x22, 0x7d, 0x85, 0xfe,
+	0x83, 0xda, 0x58, 0x32, 0x11, 0x72, 0x8a, 0x3b, 0x66, 0x54, 0x79, 0xf8, 0x88, 0x22, 0x1f, 0x9a,
+	0x82, 0xc5, 0x44, 0xf1, 0x34, 0x91, 0x57, 0x3c, 0xc3, 0x5d, 0xcd, 0xce, 0x60, 0xa6, 0xb9, 0x44,
+	0xb2, 0x44, 0xe1, 0xb5, 0x9d, 0xd2, 0xbe, 0x17, 0x14, 0xa1, 0x7e, 0xb3, 0x39, 0xe6, 0xb7, 0x22,
+	0xfb, 0x66, 0x83, 0xf4, 0x95, 0x7f, 0x01, 0x9e, 0x75, 0x85, 0x44, 0xeb, 0x50, 0xd1, 0x23, 0xd1,
+	0xce, 0x70, 0x02, 0x13, 0xa0, 0xfb, 0xe0, 0xd9, 0x31, 0x48, 0x5c, 0xde, 0x71, 0xf6, 0x1b, 0x87,
+	0xf8, 0x60, 0xce, 0x5c, 0x07, 0xb6, 0x44, 0xf0, 0x2d, 0xd3, 0x7f, 0xe7, 0x40, 0xf7, 0x81, 0x9e,
+	0x4c, 0xc1, 0xb1, 0x97, 0x7f, 0x7d, 0xf7, 0x8b, 0xbe, 0x9b, 0xb2, 0x46, 0x73, 0xa5, 0x35, 0x5a,
+	0xab, 0xad, 0xd1, 0x9e, 0xb1, 0x86, 0xff, 0xd6, 0x81, 0xee, 0xb3, 0x8c, 0xce, 0x6a, 0xb4, 0x0e,
+	0x95, 0x4b, 0xce, 0xe2, 0x42, 0x26, 0x13, 0xe4, 0xe8, 0x35, 0x89, 0xc7, 0x85, 0x48, 0x26, 0x98,
+	0xd3, 0xcf, 0x59, 0xa9, 0x9f, 0xbb, 0x52, 0xbf, 0xca, 0x72, 0xfd, 0xaa, 0xcb, 0xf4, 0xab, 0xad,
+	0xd4, 0xcf, 0x5b, 0xd0, 0xef, 0xcf, 0x12, 0x27, 0x82, 0x35, 0xab, 0xcd, 0x94, 0xbf, 0x6e, 0x23,
+	0xce, 0xbc, 0x5d, 0x9d, 0x05, 0xbb, 0xfa, 0xaf, 0x60, 0xdd, 0x2a, 0xff, 0x30, 0x2f, 0x74, 0x91,
+	0x7f, 0x77, 0x5b, 0x0f, 0x6c, 0x41, 0x9d, 0xcb, 0x90, 0x0c, 0x14, 0xbf, 0x36, 0x16, 0xf0, 0x02,
+	0x8f, 0xcb, 0xbe, 0x8e, 0xa7, 0x67, 0xe3, 0x4e, 0xcf, 0xc6, 0xdf, 0x83, 0x96, 0xbd, 0xf9, 0x5c,
+	0x11, 0x35, 0x96, 0xb9, 0xde, 0x52, 0x9f, 0xf4, 0x9d, 0x5e, 0x60, 0x23, 0xff, 0x7d, 0x09, 0xd6,
+	0x4e, 0x98, 0xea, 0xc7, 0xb1, 0xcd, 0x97, 0xbf, 0xf5, 0x81, 0x08, 0xdc, 0x8c, 0x0c, 0x8d, 0x3b,
+	0xdd, 0x40, 0x9f, 0xf3, 0x32, 0x31, 0x1f, 0x71, 0xa5, 0x4d, 0xe9, 0x06, 0x26, 0x40, 0x1b, 0xe0,
+	0xa5, 0x82, 0x32, 0x11, 0x46, 0x93, 0x62, 0x73, 0xe8, 0xf8, 0x68, 0x32, 0xdd, 0x65, 0x6d, 0xba,
+	0xcb, 0xc3, 0x2f, 0x0e, 0x74, 0x8a, 0x67, 0x9f, 0x9b, 0x55, 0x89, 0x1e, 0x43, 0x6b, 0x66, 0x2f,
+	0xa2, 0xdd, 0x85, 0x6d, 0x3a, 0xbf, 0x37, 0x37, 0x97, 0x2e, 0x5c, 0xf4, 0x04, 0xe0, 0x84, 0xa9,
+	0x22, 0xba, 0xb3, 0x2c, 0x6f, 0x46, 0xde, 0x15, 0xe5, 0x9e, 0x42, 0x7b, 0x76, 0xd8, 0xc8, 0x5f,
+	0xc8, 0x5d, 0x50, 0x63, 0x73, 0x63, 0x59, 0x3d, 0x99, 0x77, 0x3b, 0xb3, 0x61, 0x7e, 0xd0, 0xed,
+	0xfc, 0x06, 0x5a, 0xf1, 0xbc, 0xe7, 0x80, 0xa6, 0xfe, 0x26, 0x0a, 0xd4, 0x5f, 0x56, 0xf2, 0xbb,
+	0xd3, 0x37, 0x7b, 0xcb, 0x6a, 0x5a, 0xfb, 0x5d, 0x40, 0xeb, 0x58, 0xff, 0x27, 0xbe, 0xe5, 0x28,
+	0x7f, 0x52, 0xf7, 0xa8, 0xfb, 0xe1, 0xa6, 0x57, 0xfa, 0x78, 0xd3, 0x2b, 0x7d, 0xba, 0xe9, 0x95,
+	0xde, 0x7c, 0xee, 0xfd, 0x13, 0x55, 0xf5, 0xef, 0xb3, 0x7b, 0x5f, 0x07, 0x00, 0x35, 0xb4, 0xeb,
+	0x43, 0xc0, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1091,6 +1195,43 @@ func (m *Patient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ConsentAt) > 0 {
+		i -= len(m.ConsentAt)
+		copy(dAtA[i:], m.ConsentAt)
+		i = encodeVarintPatient(dAtA, i, uint64(len(m.ConsentAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Consent {
+		i--
+		if m.Consent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.Relationship) > 0 {
+		i -= len(m.Relationship)
+		copy(dAtA[i:], m.Relationship)
+		i = encodeVarintPatient(dAtA, i, uint64(len(m.Relationship)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintPatient(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.DeletedAt) > 0 {
 		i -= len(m.DeletedAt)
 		copy(dAtA[i:], m.DeletedAt)
@@ -1262,6 +1403,30 @@ func (m *CreatePatientReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Consent {
+		i--
+		if m.Consent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Relationship) > 0 {
+		i -= len(m.Relationship)
+		copy(dAtA[i:], m.Relationship)
+		i = encodeVarintPatient(dAtA, i, uint64(len(m.Relationship)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintPatient(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.PatientProblem) > 0 {
 		i -= len(m.PatientProblem)
 		copy(dAtA[i:], m.PatientProblem)
@@ -1366,6 +1531,30 @@ func (m *UpdatePatientReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Consent {
+		i--
+		if m.Consent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Relationship) > 0 {
+		i -= len(m.Relationship)
+		copy(dAtA[i:], m.Relationship)
+		i = encodeVarintPatient(dAtA, i, uint64(len(m.Relationship)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintPatient(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.PatientProblem) > 0 {
 		i -= len(m.PatientProblem)
 		copy(dAtA[i:], m.PatientProblem)
@@ -1518,6 +1707,13 @@ func (m *PatientFieldValueReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintPatient(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.IsActive {
 		i--
 		if m.IsActive {
@@ -1606,6 +1802,13 @@ func (m *GetAllPatientsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintPatient(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.OrderBy) > 0 {
 		i -= len(m.OrderBy)
 		copy(dAtA[i:], m.OrderBy)
@@ -1723,6 +1926,21 @@ func (m *Patient) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPatient(uint64(l))
 	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovPatient(uint64(l))
+	}
+	l = len(m.Relationship)
+	if l > 0 {
+		n += 2 + l + sovPatient(uint64(l))
+	}
+	if m.Consent {
+		n += 3
+	}
+	l = len(m.ConsentAt)
+	if l > 0 {
+		n += 2 + l + sovPatient(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1800,6 +2018,17 @@ func (m *CreatePatientReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPatient(uint64(l))
 	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovPatient(uint64(l))
+	}
+	l = len(m.Relationship)
+	if l > 0 {
+		n += 1 + l + sovPatient(uint64(l))
+	}
+	if m.Consent {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1856,6 +2085,17 @@ func (m *UpdatePatientReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPatient(uint64(l))
 	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovPatient(uint64(l))
+	}
+	l = len(m.Relationship)
+	if l > 0 {
+		n += 1 + l + sovPatient(uint64(l))
+	}
+	if m.Consent {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1903,6 +2143,10 @@ func (m *PatientFieldValueReq) Size() (n int) {
 	if m.IsActive {
 		n += 2
 	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovPatient(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1951,6 +2195,10 @@ func (m *GetAllPatientsReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPatient(uint64(l))
 	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovPatient(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2440,6 +2688,122 @@ func (m *Patient) Unmarshal(dAtA []byte) error {
 			}
 			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relationship", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relationship = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Consent = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsentAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsentAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPatient(dAtA[iNdEx:])
@@ -2947,6 +3311,90 @@ func (m *CreatePatientReq) Unmarshal(dAtA []byte) error {
 			}
 			m.PatientProblem = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relationship", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relationship = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Consent = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPatient(dAtA[iNdEx:])
@@ -3350,6 +3798,90 @@ func (m *UpdatePatientReq) Unmarshal(dAtA []byte) error {
 			}
 			m.PatientProblem = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relationship", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relationship = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Consent = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPatient(dAtA[iNdEx:])
@@ -3632,6 +4164,38 @@ func (m *PatientFieldValueReq) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsActive = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPatient(dAtA[iNdEx:])
@@ -3908,6 +4472,38 @@ func (m *GetAllPatientsReq) Unmarshal(dAtA []byte) error {
 			}
 			m.OrderBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPatient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPatient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPatient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPatient(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ module dennic_user_service
 go 1.22
 
 require (
+	dennic_kafka v0.0.0
 	github.com/Masterminds/squirrel v1.5.4
 	github.com/golang/protobuf v1.5.3
 	github.com/google/uuid v1.3.0
@@ -45,7 +46,7 @@ require (
 	github.com/pierrec/lz4/v4 v4.1.15 // indirect
 	github.com/stretchr/testify v1.9.0
 	go.opentelemetry.io/otel/exporters/otlp/internal/retry v1.16.0 // indirect
-	go.opentelemetry.io/otel/metric v1.16.0 // indirect
+	go.opentelemetry.io/otel/metric v1.16.0
 	go.opentelemetry.io/proto/otlp v0.19.0 // indirect
 	go.uber.org/atomic v1.7.0 // indirect
 	go.uber.org/multierr v1.6.0 // indirect
@@ -56,3 +57,5 @@ require (
 	google.golang.org/genproto v0.0.0-20230525234025-438c736192d0 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20230525234020-1aefcd67740a // indirect
 )
+
+replace dennic_kafka => ../dennic_kafka
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"dennic_kafka/eventbus"
 	pb "dennic_user_service/genproto/user_service"
 	grpc_server "dennic_user_service/internal/delivery/grpc/server"
 	invest_grpc "dennic_user_service/internal/delivery/grpc/services"
@@ -33,7 +34,7 @@ type App struct {
 	ShutdownOTLP   func() error
 	ServiceClients grpc_service_clients.ServiceClients
 	BrokerProducer event.BrokerProducer
-	BrokerConsumer event.BrokerConsumer
+	UserCreate     *UserCreateConsumerCLI
 }
 
 func NewApp(cfg *config.Config) (*App, error) {
@@ -44,7 +45,16 @@ func NewApp(cfg *config.Config) (*App, error) {
 	}
 
 	kafkaProducer := kafka.NewProducer(cfg, logger)
-	kafkaConsumer := kafka.NewConsumer(logger)
+	kafkaConsumer, err := eventbus.NewConsumer(eventbus.Config{
+		Brokers:          cfg.Kafka.Address,
+		MaxAttempts:      cfg.Kafka.Retry.MaxAttempts,
+		Backoff:          cfg.Kafka.Retry.Backoff,
+		MaxBackoff:       cfg.Kafka.Retry.MaxBackoff,
+		DeadLetterSuffix: cfg.Kafka.DeadLetterSuffix,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
 
 	// otlp collector initialization
 	shutdownOTLP, err := otlp.InitOTLPProvider(cfg)
@@ -57,10 +67,7 @@ func NewApp(cfg *config.Config) (*App, error) {
 	if err != nil {
 		return nil, err
 	}
-	consumerApp, err := NewUserCreateConsumerCLI(cfg, logger, db, kafkaConsumer)
-	if err != nil {
-		return nil, err
-	}
+
 	// grpc server init
 	grpcServer := grpc.NewServer(
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
@@ -85,7 +92,7 @@ func NewApp(cfg *config.Config) (*App, error) {
 		GrpcServer:     grpcServer,
 		ShutdownOTLP:   shutdownOTLP,
 		BrokerProducer: kafkaProducer,
-		BrokerConsumer: consumerApp.BrokerConsumer,
+		UserCreate:     NewUserCreateConsumerCLI(cfg, logger, db, kafkaConsumer, kafkaProducer),
 	}, nil
 }
 
@@ -116,6 +123,11 @@ func (a *App) Run() error {
 
 	pb.RegisterUserServiceServer(a.GrpcServer, invest_grpc.NewUserRPC(a.Logger, userUsecase, a.BrokerProducer))
 	pb.RegisterAdminServiceServer(a.GrpcServer, invest_grpc.NewAdminRPC(a.Logger, adminUsecase, a.BrokerProducer))
+
+	// users registered through the gateway are created from its events
+	if err := a.UserCreate.Run(); err != nil {
+		return fmt.Errorf("error during run user create consumer: %w", err)
+	}
 	a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
 	if err := grpc_server.Run(a.Config, a.GrpcServer); err != nil {
 		return fmt.Errorf("gRPC fatal to serve grpc server over %s %w", a.Config.RPCPort, err)
@@ -125,6 +137,8 @@ func (a *App) Run() error {
 }
 
 func (a *App) Stop() {
+	// close broker consumer before the producer its handlers publish on
+	a.UserCreate.Close()
 	// close broker producer
 	a.BrokerProducer.Close()
 	// closing client service connections
@@ -2,61 +2,57 @@ package app
 
 import (
 	"dennic_user_service/internal/delivery/grpc/kafka/handlers"
-	"dennic_user_service/internal/infrastructure/kafka"
+	processedEvents "dennic_user_service/internal/infrastructure/repository/postgresql/processed_events"
 	"dennic_user_service/internal/infrastructure/repository/postgresql/user"
 	"dennic_user_service/internal/pkg/config"
-	logPkg "dennic_user_service/internal/pkg/logger"
 	"dennic_user_service/internal/pkg/postgres"
 	"dennic_user_service/internal/usecase"
 	"dennic_user_service/internal/usecase/event"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 )
 
+// UserCreateConsumerCLI registers the users the gateway publishes on the
+// user create topic and publishes how each registration went.
 type UserCreateConsumerCLI struct {
 	Config         *config.Config
 	Logger         *zap.Logger
 	DB             *postgres.PostgresDB
 	BrokerConsumer event.BrokerConsumer
+	BrokerProducer event.BrokerProducer
 }
 
-func NewUserCreateConsumerCLI(config *config.Config, logger *zap.Logger, db *postgres.PostgresDB, brokerConsumer event.BrokerConsumer) (*UserCreateConsumerCLI, error) {
-	logger, err := logPkg.New(config.LogLevel, config.Environment, config.APP+"_cli"+".log")
-	if err != nil {
-		return nil, err
-	}
-
-	consumer := kafka.NewConsumer(logger)
-
-	db, err = postgres.New(config)
-	if err != nil {
-		return nil, err
-	}
-
+func NewUserCreateConsumerCLI(config *config.Config, logger *zap.Logger, db *postgres.PostgresDB,
+	brokerConsumer event.BrokerConsumer, brokerProducer event.BrokerProducer) *UserCreateConsumerCLI {
 	return &UserCreateConsumerCLI{
 		Config:         config,
 		DB:             db,
 		Logger:         logger,
-		BrokerConsumer: consumer,
-	}, nil
+		BrokerConsumer: brokerConsumer,
+		BrokerProducer: brokerProducer,
+	}
 }
 
 func (c *UserCreateConsumerCLI) Run() error {
-	fmt.Print("consume is running ....")
+	contextTimeout, err := time.ParseDuration(c.Config.Context.Timeout)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for context timeout : %w", err)
+	}
+
 	// repo init
 	userRepo := postgresql.NewUserRepo(c.DB)
+	processedEventsRepo := processedEvents.NewProcessedEventsRepo(c.DB)
 
 	// usecase init
-	userUsecase := usecase.NewUserService(c.DB.Config().ConnConfig.ConnectTimeout, userRepo)
+	userUsecase := usecase.NewUserService(contextTimeout, userRepo)
 
-	eventHandler := handlers.NewUserCreateHandler(c.Config, c.BrokerConsumer, c.Logger, userUsecase)
+	eventHandler := handlers.NewUserCreateHandler(c.Config, c.BrokerConsumer, c.Logger, userUsecase, processedEventsRepo, c.BrokerProducer)
 
 	return eventHandler.HandlerEvents()
 }
 
 func (c *UserCreateConsumerCLI) Close() {
 	c.BrokerConsumer.Close()
-
-	c.Logger.Sync()
 }
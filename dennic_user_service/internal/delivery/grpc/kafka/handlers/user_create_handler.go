@@ -2,52 +2,152 @@ package handlers
 
 import (
 	"context"
-	"encoding/json"
+	"dennic_kafka/eventbus"
+	"dennic_user_service/genproto/events"
 	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/infrastructure/kafka"
 	"dennic_user_service/internal/pkg/config"
 	"dennic_user_service/internal/usecase"
 	"dennic_user_service/internal/usecase/event"
+	"encoding/json"
+	"errors"
+	"time"
 
 	"go.uber.org/zap"
 )
 
+// userCreateSchemas accept events.UserCreate at version 1; version 0 is the
+// JSON of entity.User published on the topic before the envelope.
+var userCreateSchemas = eventbus.NewSchemas(map[string]eventbus.Schema{
+	entity.EventUserCreate: {
+		Version: 1,
+		Upcasters: map[int32]eventbus.Upcaster{
+			0: upcastUserJSON,
+		},
+	},
+}, legacyUserCreate)
+
+func legacyUserCreate(value []byte) (*eventbus.Envelope, error) {
+	return &eventbus.Envelope{
+		Id:         eventbus.LegacyId(value),
+		Type:       entity.EventUserCreate,
+		OccurredAt: time.Now().Format(time.RFC3339Nano),
+		Payload:    value,
+	}, nil
+}
+
+func upcastUserJSON(payload []byte) ([]byte, error) {
+	var user entity.User
+	if err := json.Unmarshal(payload, &user); err != nil {
+		return nil, err
+	}
+
+	return (&events.UserCreate{
+		Id:           user.Id,
+		FirstName:    user.FirstName,
+		LastName:     user.LastName,
+		BirthDate:    user.BirthDate,
+		PhoneNumber:  user.PhoneNumber,
+		Password:     user.Password,
+		Gender:       user.Gender,
+		RefreshToken: user.RefreshToken,
+		ImageUrl:     user.ImageUrl,
+	}).Marshal()
+}
+
 type userCreateHandler struct {
-	config         *config.Config
-	brokerConsumer event.BrokerConsumer
-	logger         *zap.Logger
-	userUsecase    usecase.UserStorageI
+	config          *config.Config
+	brokerConsumer  event.BrokerConsumer
+	logger          *zap.Logger
+	userUsecase     usecase.UserStorageI
+	processedEvents event.ProcessedEvents
+	brokerProducer  event.BrokerProducer
 }
 
 func NewUserCreateHandler(config *config.Config,
 	brokerConsumer event.BrokerConsumer,
 	logger *zap.Logger,
-	userUsecase usecase.UserStorageI) *userCreateHandler {
+	userUsecase usecase.UserStorageI,
+	processedEvents event.ProcessedEvents,
+	brokerProducer event.BrokerProducer) *userCreateHandler {
 	return &userCreateHandler{
-		config:         config,
-		brokerConsumer: brokerConsumer,
-		logger:         logger,
-		userUsecase:    userUsecase,
+		config:          config,
+		brokerConsumer:  brokerConsumer,
+		logger:          logger,
+		userUsecase:     userUsecase,
+		processedEvents: processedEvents,
+		brokerProducer:  brokerProducer,
 	}
 }
 
 func (h *userCreateHandler) HandlerEvents() error {
-	consumerConfig := kafka.NewConsumerConfig(
+	consumerConfig := eventbus.NewConsumerConfig(
 		h.config.Kafka.Address,
-		"api.user.create",
-		"1",
+		h.config.Kafka.Topic.UserCreate,
+		h.config.Kafka.GroupId,
 		func(ctx context.Context, key, value []byte) error {
-			var user *entity.User
-
-			if err := json.Unmarshal(value, &user); err != nil {
-				return err
+			envelope, err := userCreateSchemas.Decode(value)
+			if err != nil {
+				return eventbus.Permanent(err)
 			}
 
-			if _, err := h.userUsecase.Create(ctx, user); err != nil {
-				return err
+			var user events.UserCreate
+			if err := user.Unmarshal(envelope.Payload); err != nil {
+				return eventbus.Permanent(err)
 			}
 
-			return nil
+			// a redelivered event would fail on the unique phone number; the
+			// outcome is published in the transaction, so it is published
+			// again when recording the event fails
+			return kafka.Once(ctx, h.logger, h.processedEvents, h.config.Kafka.GroupId, envelope,
+				func(ctx context.Context) error {
+					created := &entity.User{
+						Id:           user.Id,
+						FirstName:    user.FirstName,
+						LastName:     user.LastName,
+						BirthDate:    user.BirthDate,
+						PhoneNumber:  user.PhoneNumber,
+						Password:     user.Password,
+						Gender:       user.Gender,
+						RefreshToken: user.RefreshToken,
+						ImageUrl:     user.ImageUrl,
+					}
+
+					// registered twice, e.g. from two devices at once; the phone
+					// is looked up first so the insert does not fail in the
+					// transaction, and a conflict of a concurrent registration
+					// only rolls back the savepoint of Create
+					_, err := h.userUsecase.Get(ctx, &entity.FieldValueReq{
+						Field: "phone_number",
+						Value: user.PhoneNumber,
+					})
+					if err == nil {
+						return h.brokerProducer.PublishRegistrationFailed(ctx, created, "phone number is already registered")
+					}
+					if !errors.Is(err, entity.ErrorNotFound) {
+						return err
+					}
+
+					_, err = h.userUsecase.Create(ctx, created)
+					if errors.Is(err, entity.ErrorConflict) {
+						return h.brokerProducer.PublishRegistrationFailed(ctx, created, "phone number is already registered")
+					}
+					if err != nil {
+						return err
+					}
+
+					var device *entity.Device
+					if user.Device != nil {
+						device = &entity.Device{
+							SessionId:    user.Device.SessionId,
+							IpAddress:    user.Device.IpAddress,
+							FcmToken:     user.Device.FcmToken,
+							PlatformName: user.Device.PlatformName,
+							PlatformType: user.Device.PlatformType,
+						}
+					}
+					return h.brokerProducer.PublishUserCreated(ctx, created, device)
+				})
 		},
 	)
 
@@ -55,5 +155,4 @@ func (h *userCreateHandler) HandlerEvents() error {
 	h.brokerConsumer.Run()
 
 	return nil
-
 }
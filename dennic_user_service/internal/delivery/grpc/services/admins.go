@@ -60,6 +60,7 @@ func (a adminRPC) Create(ctx context.Context, admin *pb.Admin) (*pb.Admin, error
 		WorkYears:     admin.WorkYears,
 		RefreshToken:  admin.RefreshToken,
 		ImageUrl:      reqImageUrl,
+		BranchId:      admin.BranchId,
 	}
 	AdminId, err := a.admin.Create(ctx, &req)
 	if err != nil {
@@ -94,6 +95,7 @@ func (a adminRPC) Create(ctx context.Context, admin *pb.Admin) (*pb.Admin, error
 		WorkYears:     resp.WorkYears,
 		RefreshToken:  resp.RefreshToken,
 		ImageUrl:      respImageUrl,
+		BranchId:      resp.BranchId,
 		CreatedAt:     resp.CreatedAt.String(),
 	}, nil
 }
@@ -131,6 +133,7 @@ func (a adminRPC) Get(ctx context.Context, req *pb.GetAdminReq) (*pb.Admin, erro
 		WorkYears:     resp.WorkYears,
 		RefreshToken:  resp.RefreshToken,
 		ImageUrl:      respImageUrl,
+		BranchId:      resp.BranchId,
 		CreatedAt:     resp.CreatedAt.String(),
 		UpdatedAt:     resp.UpdatedAt.String(),
 		DeletedAt:     resp.DeletedAt.String(),
@@ -186,6 +189,7 @@ func (a adminRPC) ListAdmins(ctx context.Context, req *pb.ListAdminsReq) (*pb.Li
 			WorkYears:     in.WorkYears,
 			RefreshToken:  in.RefreshToken,
 			ImageUrl:      respImageUrl,
+			BranchId:      in.BranchId,
 			CreatedAt:     in.CreatedAt.String(),
 			UpdatedAt:     in.UpdatedAt.String(),
 			DeletedAt:     in.DeletedAt.String(),
@@ -220,6 +224,7 @@ func (a adminRPC) Update(ctx context.Context, admin *pb.Admin) (*pb.Admin, error
 		EndWorkYear:   admin.EndWorkYear,
 		WorkYears:     admin.WorkYears,
 		ImageUrl:      reqImageUrl,
+		BranchId:      admin.BranchId,
 		UpdatedAt:     time.Now().Add(time.Hour * 5),
 	}
 
@@ -259,6 +264,7 @@ func (a adminRPC) Update(ctx context.Context, admin *pb.Admin) (*pb.Admin, error
 		WorkYears:     resp.WorkYears,
 		RefreshToken:  resp.RefreshToken,
 		ImageUrl:      respImageUrl,
+		BranchId:      resp.BranchId,
 		CreatedAt:     resp.CreatedAt.String(),
 		UpdatedAt:     resp.UpdatedAt.String(),
 	}
@@ -19,6 +19,24 @@ type User struct {
 	DeletedAt    time.Time
 }
 
+// Events of registering a user through the gateway: user.create asks the
+// user service to register one, user.created and user.registration_failed
+// tell how it went.
+const (
+	EventUserCreate         = "user.create"
+	EventUserCreated        = "user.created"
+	EventRegistrationFailed = "user.registration_failed"
+)
+
+// Device is the session the gateway issued a registering user's tokens for.
+type Device struct {
+	SessionId    string
+	IpAddress    string
+	FcmToken     string
+	PlatformName string
+	PlatformType string
+}
+
 type Admin struct {
 	Id            string
 	AdminOrder    int64
@@ -37,6 +55,7 @@ type Admin struct {
 	WorkYears     uint64
 	RefreshToken  string
 	ImageUrl      string
+	BranchId      string
 	Count         int64
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
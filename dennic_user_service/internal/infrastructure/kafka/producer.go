@@ -2,74 +2,112 @@ package kafka
 
 import (
 	"context"
+	"dennic_kafka/eventbus"
+	"dennic_user_service/genproto/events"
 	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/otlp"
+	"time"
 
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
 )
 
+// registrationEventVersion is the version of events.UserCreated and
+// events.RegistrationFailed.
+const registrationEventVersion = 1
+
 type producer struct {
-	logger            *zap.Logger
-	investmentCreated *kafka.Writer
+	logger             *zap.Logger
+	name               string
+	userCreated        *kafka.Writer
+	registrationFailed *kafka.Writer
 }
 
 func NewProducer(config *config.Config, logger *zap.Logger) *producer {
 	return &producer{
-		logger: logger,
-		investmentCreated: &kafka.Writer{
-			Addr:                   kafka.TCP(config.Kafka.Address...),
-			Topic:                  config.Kafka.Topic.InvestorCreate,
-			Balancer:               &kafka.Hash{},
-			RequiredAcks:           kafka.RequireAll,
-			AllowAutoTopicCreation: true,
-			Async:                  true, // make the writer asynchronous
-			Completion: func(messages []kafka.Message, err error) {
-				if err != nil {
-					logger.Error("kafka investmentCreated", zap.Error(err))
-				}
-				for _, message := range messages {
-					logger.Sugar().Info(
-						"kafka investmentCreated message",
-						zap.Int("partition", message.Partition),
-						zap.Int64("offset", message.Offset),
-						zap.String("key", string(message.Key)),
-						zap.String("value", string(message.Value)),
-					)
-				}
-			},
-		},
+		logger:             logger,
+		name:               config.APP,
+		userCreated:        newWriter(config, config.Kafka.Topic.UserCreated),
+		registrationFailed: newWriter(config, config.Kafka.Topic.RegistrationFailed),
+	}
+}
+
+// newWriter writes synchronously: a registration is only done once its
+// outcome is on the topic.
+func newWriter(config *config.Config, topic string) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:                   kafka.TCP(config.Kafka.Address...),
+		Topic:                  topic,
+		Balancer:               &kafka.Hash{},
+		RequiredAcks:           kafka.RequireAll,
+		AllowAutoTopicCreation: true,
 	}
 }
 
-// func (p *producer) buildMessageWithTracing(key string, value []byte, otlpSpan otlp.Span) kafka.Message {
-func (p *producer) BuildMessageWithTracing(key string, value []byte) kafka.Message {
-	return kafka.Message{
+// buildMessage carries the trace of ctx in the message headers.
+func (p *producer) buildMessage(ctx context.Context, key string, value []byte) kafka.Message {
+	message := kafka.Message{
 		Key:   []byte(key),
 		Value: value,
-		Headers: []kafka.Header{
-			{
-				Key: "trace_id",
-				// Value: []byte(otlpSpan.SpanContext().TraceID().String()),
-			},
-			{
-				Key: "span_id",
-				// Value: []byte(otlpSpan.SpanContext().SpanID().String()),
-			},
-		},
 	}
+	eventbus.InjectTraceContext(ctx, &message)
+	return message
 }
 
-func (p *producer) ProduceContent(ctx context.Context, key string, value *entity.User) error {
-	// tracing
-	// ctx, span := otlp.Start(ctx, "kafka producer", "ContentProducer")
-	// defer span.End()
+// PublishUserCreated keys the event by the user.
+func (p *producer) PublishUserCreated(ctx context.Context, user *entity.User, device *entity.Device) error {
+	ctx, span := otlp.Start(ctx, "kafka producer", "UserCreatedProducer")
+	defer span.End()
 
-	return nil
+	payload := &events.UserCreated{
+		Id:          user.Id,
+		FirstName:   user.FirstName,
+		LastName:    user.LastName,
+		BirthDate:   user.BirthDate,
+		PhoneNumber: user.PhoneNumber,
+		Gender:      user.Gender,
+		ImageUrl:    user.ImageUrl,
+	}
+	if device != nil {
+		payload.Device = &events.Device{
+			SessionId:    device.SessionId,
+			IpAddress:    device.IpAddress,
+			FcmToken:     device.FcmToken,
+			PlatformName: device.PlatformName,
+			PlatformType: device.PlatformType,
+		}
+	}
+
+	value, err := eventbus.NewEnvelope(ctx, p.name, entity.EventUserCreated, registrationEventVersion, time.Now(), payload)
+	if err != nil {
+		return err
+	}
+
+	return p.userCreated.WriteMessages(ctx, p.buildMessage(ctx, user.Id, value))
+}
+
+func (p *producer) PublishRegistrationFailed(ctx context.Context, user *entity.User, reason string) error {
+	ctx, span := otlp.Start(ctx, "kafka producer", "RegistrationFailedProducer")
+	defer span.End()
+
+	value, err := eventbus.NewEnvelope(ctx, p.name, entity.EventRegistrationFailed, registrationEventVersion, time.Now(), &events.RegistrationFailed{
+		Id:          user.Id,
+		PhoneNumber: user.PhoneNumber,
+		Reason:      reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.registrationFailed.WriteMessages(ctx, p.buildMessage(ctx, user.Id, value))
 }
 
 func (p *producer) Close() {
-	if err := p.investmentCreated.Close(); err != nil {
-		p.logger.Error("error during close writer articleCategoryCreated", zap.Error(err))
+	if err := p.userCreated.Close(); err != nil {
+		p.logger.Error("error during close writer userCreated", zap.Error(err))
+	}
+	if err := p.registrationFailed.Close(); err != nil {
+		p.logger.Error("error during close writer registrationFailed", zap.Error(err))
 	}
 }
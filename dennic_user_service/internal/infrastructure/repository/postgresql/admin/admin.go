@@ -45,6 +45,7 @@ func (p *adminRepo) adminSelectQueryPrefix() string {
 			end_work_year,
 			work_years,
 			image_url,
+			COALESCE(branch_id::TEXT, ''),
 			created_at,
 			updated_at,
 			deleted_at`
@@ -70,6 +71,7 @@ func (p adminRepo) Create(ctx context.Context, admin *entity.Admin) error {
 		"work_years":      admin.WorkYears,
 		"refresh_token":   admin.RefreshToken,
 		"image_url":       admin.ImageUrl,
+		"branch_id":       nullString(admin.BranchId),
 	}
 
 	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
@@ -132,6 +134,7 @@ func (p adminRepo) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.
 		&end_work_year,
 		&admin.WorkYears,
 		&admin.ImageUrl,
+		&admin.BranchId,
 		&admin.CreatedAt,
 		&updatedAt,
 		&deletedAt,
@@ -232,6 +235,7 @@ func (p adminRepo) List(ctx context.Context, req *entity.GetAllReq) ([]*entity.A
 			&end_work_year,
 			&admin.WorkYears,
 			&admin.ImageUrl,
+			&admin.BranchId,
 			&admin.CreatedAt,
 			&updatedAt,
 			&deletedAt,
@@ -276,6 +280,7 @@ func (p *adminRepo) Update(ctx context.Context, admin *entity.Admin) error {
 		"end_work_year":   admin.EndWorkYear,
 		"work_years":      admin.WorkYears,
 		"image_url":       admin.ImageUrl,
+		"branch_id":       nullString(admin.BranchId),
 		"updated_at":      admin.UpdatedAt,
 	}
 
@@ -411,3 +416,11 @@ func (p *adminRepo) UpdateRefreshToken(ctx context.Context, req *entity.UpdateRe
 
 	return &entity.UpdateRefreshTokenResp{Status: true}, nil
 }
+
+// nullString stores an empty optional value as NULL.
+func nullString(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,64 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/postgres"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	processedEventsTableName      = "processed_events"
+	processedEventsServiceName    = "processedEventsService"
+	processedEventsSpanRepoPrefix = "processedEventsRepo"
+)
+
+type processedEventsRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewProcessedEventsRepo(db *postgres.PostgresDB) *processedEventsRepo {
+	return &processedEventsRepo{
+		tableName: processedEventsTableName,
+		db:        db,
+	}
+}
+
+// Process records the event first, so a concurrent redelivery waits for this
+// transaction and is skipped once it commits.
+func (p *processedEventsRepo) Process(ctx context.Context, consumer, eventId, eventType string, handle func(ctx context.Context) error) (bool, error) {
+	ctx, span := otlp.Start(ctx, processedEventsServiceName, processedEventsSpanRepoPrefix+"Process")
+	defer span.End()
+	span.SetAttributes(attribute.Key("event_id").String(eventId), attribute.Key("event_type").String(eventType))
+
+	processed := false
+	err := p.db.InTx(ctx, func(ctx context.Context) error {
+		query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(map[string]any{
+			"consumer":   consumer,
+			"event_id":   eventId,
+			"event_type": eventType,
+		}).Suffix("ON CONFLICT DO NOTHING").ToSql()
+		if err != nil {
+			return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "process"))
+		}
+
+		tag, err := p.db.Conn(ctx).Exec(ctx, query, args...)
+		if err != nil {
+			return p.db.Error(err)
+		}
+		if tag.RowsAffected() == 0 {
+			return nil
+		}
+
+		processed = true
+		return handle(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return processed, nil
+}
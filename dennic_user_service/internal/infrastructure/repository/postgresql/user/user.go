@@ -67,12 +67,14 @@ func (p userRepo) Create(ctx context.Context, user *entity.User) error {
 		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
 	}
 
-	_, err = p.db.Exec(ctx, query, args...)
-	if err != nil {
-		return p.db.Error(err)
-	}
-
-	return nil
+	// in a savepoint of its own, so a conflict leaves the transaction of
+	// the caller usable
+	return p.db.InTx(ctx, func(ctx context.Context) error {
+		if _, err := p.db.Conn(ctx).Exec(ctx, query, args...); err != nil {
+			return p.db.Error(err)
+		}
+		return nil
+	})
 }
 
 func (p userRepo) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.User, error) {
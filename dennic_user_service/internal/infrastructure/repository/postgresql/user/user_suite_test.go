@@ -136,6 +136,38 @@ func (s *UserReposisitoryTestSuite) TestUserCRUD() {
 
 }
 
+// a duplicate phone number must leave the transaction of the caller usable,
+// as the registration consumer records the event in it
+func (s *UserReposisitoryTestSuite) TestCreateConflictInTx() {
+	ctx := context.Background()
+
+	user := entity.User{
+		FirstName:   "firstname",
+		LastName:    "lastname",
+		BirthDate:   "2000-08-30",
+		PhoneNumber: "+998994767317",
+		Password:    "testpassword",
+		Gender:      "male",
+	}
+	user.Id = uuid.New().String()
+	twice := user
+	twice.Id = uuid.New().String()
+
+	err := s.repo.db.InTx(ctx, func(ctx context.Context) error {
+		s.Suite.NoError(s.repo.Create(ctx, &user))
+		s.Suite.ErrorIs(s.repo.Create(ctx, &twice), entity.ErrorConflict)
+		return nil
+	})
+	s.Suite.NoError(err)
+
+	// committed with the first insert
+	_, err = s.repo.Get(ctx, &entity.FieldValueReq{Field: "id", Value: user.Id})
+	s.Suite.NoError(err)
+
+	_, err = s.repo.Delete(ctx, &entity.FieldValueReq{Field: "id", Value: user.Id})
+	s.Suite.NoError(err)
+}
+
 func TestExampleUserTestSuite(t *testing.T) {
 	suite.Run(t, new(UserReposisitoryTestSuite))
 }
@@ -38,9 +38,20 @@ type Config struct {
 
 	Kafka struct {
 		Address []string
+		GroupId string
 		Topic   struct {
-			InvestorCreate string
+			UserCreate         string
+			UserCreated        string
+			RegistrationFailed string
 		}
+		// Retry bounds how often a failed message is handled again before
+		// it is parked on its topic with DeadLetterSuffix appended.
+		Retry struct {
+			MaxAttempts string
+			Backoff     string
+			MaxBackoff  string
+		}
+		DeadLetterSuffix string
 	}
 	MinioService Minio
 }
@@ -69,7 +80,14 @@ func New() *Config {
 
 	// kafka configuration
 	c.Kafka.Address = strings.Split(getEnv("KAFKA_ADDRESS", "localhost:29092"), ",")
-	c.Kafka.Topic.InvestorCreate = getEnv("KAFKA_TOPIC_INVESTOR_CREATE", "investor.created")
+	c.Kafka.GroupId = getEnv("KAFKA_GROUP_ID", "dennic_user_service")
+	c.Kafka.Topic.UserCreate = getEnv("KAFKA_TOPIC_USER_CREATE", "api.user.create")
+	c.Kafka.Topic.UserCreated = getEnv("KAFKA_TOPIC_USER_CREATED", "user.created")
+	c.Kafka.Topic.RegistrationFailed = getEnv("KAFKA_TOPIC_REGISTRATION_FAILED", "user.registration_failed")
+	c.Kafka.Retry.MaxAttempts = getEnv("KAFKA_RETRY_MAX_ATTEMPTS", "5")
+	c.Kafka.Retry.Backoff = getEnv("KAFKA_RETRY_BACKOFF", "1s")
+	c.Kafka.Retry.MaxBackoff = getEnv("KAFKA_RETRY_MAX_BACKOFF", "30s")
+	c.Kafka.DeadLetterSuffix = getEnv("KAFKA_DEAD_LETTER_SUFFIX", ".dlq")
 
 	// Minio
 	c.MinioService.Endpoint = getEnv("MINIO_SERVICE_ENDPOINT", "minio:9000")
@@ -2,15 +2,13 @@ package event
 
 import (
 	"context"
+	"dennic_kafka/eventbus"
 	"dennic_user_service/internal/entity"
 )
 
-type ConsumerConfig interface {
-	GetBrokers() []string
-	GetTopic() string
-	GetGroupID() string
-	GetHandler() func(ctx context.Context, key, value []byte) error
-}
+// ConsumerConfig is a topic to consume and the handler of its messages,
+// see eventbus.NewConsumerConfig.
+type ConsumerConfig = eventbus.ConsumerConfig
 
 type BrokerConsumer interface {
 	Run()
@@ -18,7 +16,18 @@ type BrokerConsumer interface {
 	Close()
 }
 
+// ProcessedEvents remembers the events each consumer has handled. Process
+// runs handle unless the consumer handled the event before and records it
+// in the transaction of handle; it reports whether handle ran.
+type ProcessedEvents interface {
+	Process(ctx context.Context, consumer, eventId, eventType string, handle func(ctx context.Context) error) (bool, error)
+}
+
+// BrokerProducer publishes the outcome of registering a user. Both wait for
+// the broker, so a handler publishing in a transaction rolls it back when the
+// event is not published.
 type BrokerProducer interface {
-	ProduceContent(ctx context.Context, key string, value *entity.User) error
+	PublishUserCreated(ctx context.Context, user *entity.User, device *entity.Device) error
+	PublishRegistrationFailed(ctx context.Context, user *entity.User, reason string) error
 	Close()
 }
@@ -0,0 +1,9 @@
+-- Events a Kafka consumer has handled, recorded in the transaction of the
+-- handling, so a redelivered event is skipped. consumer is the consumer group.
+CREATE TABLE IF NOT EXISTS "processed_events"(
+                                   "consumer" VARCHAR(100) NOT NULL,
+                                   "event_id" VARCHAR(64) NOT NULL,
+                                   "event_type" VARCHAR(100) NOT NULL,
+                                   "processed_at" TIMESTAMPTZ(0) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                   PRIMARY KEY ("consumer", "event_id")
+);
@@ -0,0 +1,6 @@
+-- Staff sign in as admins; the role decides what the gateway lets them do.
+-- A doctor's account has the id of their doctor.
+ALTER TYPE role_type ADD VALUE IF NOT EXISTS 'doctor';
+ALTER TYPE role_type ADD VALUE IF NOT EXISTS 'reception';
+ALTER TYPE role_type ADD VALUE IF NOT EXISTS 'lab';
+ALTER TYPE role_type ADD VALUE IF NOT EXISTS 'cashier';
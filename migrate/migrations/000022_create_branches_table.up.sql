@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS branches
+(
+    id            UUID PRIMARY KEY,
+    branch_order  SERIAL,
+    name          VARCHAR(255) NOT NULL,
+    address       VARCHAR(500) NOT NULL,
+    latitude      DOUBLE PRECISION NOT NULL DEFAULT 0,
+    longitude     DOUBLE PRECISION NOT NULL DEFAULT 0,
+    timezone      VARCHAR(64) NOT NULL DEFAULT 'Asia/Tashkent',
+    phone_number  VARCHAR(20) NOT NULL,
+    opening_hours JSONB NOT NULL DEFAULT '[]',
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP,
+    deleted_at    TIMESTAMP
+    );
+
+CREATE INDEX branches_name_idx ON branches(lower(name));
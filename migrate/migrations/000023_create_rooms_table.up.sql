@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS rooms
+(
+    id            UUID PRIMARY KEY,
+    room_order    SERIAL,
+    branch_id     UUID NOT NULL REFERENCES branches(id),
+    department_id UUID REFERENCES departments(id),
+    room_number   VARCHAR(20) NOT NULL,
+    floor_number  INT NOT NULL DEFAULT 0,
+    name          VARCHAR(255) NOT NULL DEFAULT '',
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP,
+    deleted_at    TIMESTAMP
+    );
+
+CREATE UNIQUE INDEX rooms_branch_number_idx ON rooms(branch_id, room_number) WHERE deleted_at IS NULL;
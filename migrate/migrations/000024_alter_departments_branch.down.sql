@@ -0,0 +1 @@
+ALTER TABLE departments DROP COLUMN branch_id;
@@ -0,0 +1,2 @@
+ALTER TABLE doctor_working_hours DROP COLUMN room_id;
+ALTER TABLE doctor_working_hours DROP COLUMN branch_id;
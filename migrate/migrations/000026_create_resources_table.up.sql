@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS resources
+(
+    id            UUID PRIMARY KEY,
+    resource_order SERIAL,
+    branch_id     UUID NOT NULL REFERENCES branches(id),
+    room_id       UUID REFERENCES rooms(id),
+    resource_type VARCHAR(50) NOT NULL,
+    name          VARCHAR(255) NOT NULL DEFAULT '',
+    created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at    TIMESTAMP,
+    deleted_at    TIMESTAMP
+    );
+
+CREATE INDEX resources_branch_type_idx ON resources(branch_id, resource_type) WHERE deleted_at IS NULL;
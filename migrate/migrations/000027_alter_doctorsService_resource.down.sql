@@ -0,0 +1 @@
+ALTER TABLE doctor_service DROP COLUMN required_resource_type;
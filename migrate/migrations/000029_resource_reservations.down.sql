@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "resource_reservations";
+ALTER TABLE "booked_appointments" DROP COLUMN "resource_id";
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS doctor_service_prices
+(
+    id                UUID PRIMARY KEY,
+    doctor_service_id UUID NOT NULL REFERENCES doctor_service(id) ON DELETE CASCADE,
+    modality          VARCHAR(10) NOT NULL CHECK (modality IN ('online', 'offline')),
+    amount            NUMERIC(14, 2) NOT NULL CHECK (amount >= 0),
+    currency          VARCHAR(3) NOT NULL DEFAULT 'UZS',
+    effective_from    TIMESTAMP NOT NULL,
+    effective_to      TIMESTAMP,
+    is_promo          BOOLEAN NOT NULL DEFAULT FALSE,
+    promo_name        VARCHAR(255) NOT NULL DEFAULT '',
+    created_at        TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at        TIMESTAMP,
+    CHECK (effective_to IS NULL OR effective_to > effective_from)
+    );
+
+CREATE INDEX doctor_service_prices_lookup_idx ON doctor_service_prices(doctor_service_id, modality, effective_from);
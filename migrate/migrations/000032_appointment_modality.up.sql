@@ -0,0 +1,7 @@
+ALTER TABLE "booked_appointments" ADD COLUMN "modality" VARCHAR(10) NOT NULL DEFAULT 'offline';
+ALTER TABLE "booked_appointments" ADD COLUMN "doctor_service_id" UUID;
+ALTER TABLE "booked_appointments" ADD COLUMN "price" NUMERIC(14, 2);
+ALTER TABLE "booked_appointments" ADD COLUMN "currency" VARCHAR(3) NOT NULL DEFAULT '';
+ALTER TABLE "booked_appointments" ADD COLUMN "video_room_id" VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE "booked_appointments" ADD COLUMN "video_provider" VARCHAR(50) NOT NULL DEFAULT '';
+ALTER TABLE "booked_appointments" ADD CONSTRAINT "booked_appointments_modality_check" CHECK ("modality" IN ('offline', 'online'));
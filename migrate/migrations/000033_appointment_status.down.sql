@@ -0,0 +1,2 @@
+ALTER TABLE "booked_appointments" DROP CONSTRAINT IF EXISTS "booked_appointments_status_check";
+ALTER TABLE "booked_appointments" DROP COLUMN "status";
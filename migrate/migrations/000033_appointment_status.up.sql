@@ -0,0 +1,2 @@
+ALTER TABLE "booked_appointments" ADD COLUMN "status" VARCHAR(20) NOT NULL DEFAULT 'scheduled';
+ALTER TABLE "booked_appointments" ADD CONSTRAINT "booked_appointments_status_check" CHECK ("status" IN ('scheduled', 'attended', 'cancelled', 'no_show'));
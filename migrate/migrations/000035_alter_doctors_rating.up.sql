@@ -0,0 +1,5 @@
+ALTER TABLE doctors
+    ADD COLUMN rating REAL NOT NULL DEFAULT 0,
+    ADD COLUMN review_count INT NOT NULL DEFAULT 0;
+
+CREATE INDEX doctors_rating_idx ON doctors(rating DESC, review_count DESC);
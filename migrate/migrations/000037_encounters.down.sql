@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "encounter_procedures";
+DROP TABLE IF EXISTS "encounter_conditions";
+DROP TABLE IF EXISTS "encounter_allergies";
+DROP TABLE IF EXISTS "encounter_diagnoses";
+DROP TABLE IF EXISTS "encounters";
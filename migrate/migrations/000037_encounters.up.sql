@@ -0,0 +1,55 @@
+CREATE TABLE "encounters"(
+                             "id" UUID PRIMARY KEY NOT NULL,
+                             "appointment_id" INTEGER NOT NULL,
+                             "patient_id" UUID NOT NULL,
+                             "doctor_id" UUID NULL,
+                             "encountered_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+                             "chief_complaint" TEXT NOT NULL,
+                             "vitals" JSONB NOT NULL DEFAULT '{}',
+                             "follow_up_plan" TEXT NOT NULL DEFAULT '',
+                             "follow_up_date" DATE,
+                             "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                             "updated_at" TIMESTAMP(0) WITHOUT TIME ZONE ,
+                             "deleted_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                             CONSTRAINT "encounters_appointment_id_foreign" FOREIGN KEY("appointment_id") REFERENCES "booked_appointments"("id")
+);
+
+-- one encounter record per appointment
+CREATE UNIQUE INDEX "encounters_appointment_id_idx" ON "encounters" ("appointment_id") WHERE "deleted_at" IS NULL;
+CREATE INDEX "encounters_patient_timeline_idx" ON "encounters" ("patient_id", "encountered_at" DESC) WHERE "deleted_at" IS NULL;
+
+CREATE TABLE "encounter_diagnoses"(
+                                      "id" SERIAL PRIMARY KEY NOT NULL,
+                                      "encounter_id" UUID NOT NULL REFERENCES "encounters"("id") ON DELETE CASCADE,
+                                      "icd10_code" VARCHAR(8) NOT NULL,
+                                      "description" TEXT NOT NULL DEFAULT '',
+                                      "is_primary" BOOLEAN NOT NULL DEFAULT FALSE
+);
+CREATE INDEX "encounter_diagnoses_encounter_id_idx" ON "encounter_diagnoses" ("encounter_id");
+CREATE INDEX "encounter_diagnoses_icd10_code_idx" ON "encounter_diagnoses" ("icd10_code");
+
+CREATE TABLE "encounter_allergies"(
+                                      "id" SERIAL PRIMARY KEY NOT NULL,
+                                      "encounter_id" UUID NOT NULL REFERENCES "encounters"("id") ON DELETE CASCADE,
+                                      "substance" VARCHAR(100) NOT NULL,
+                                      "reaction" TEXT NOT NULL DEFAULT '',
+                                      "severity" VARCHAR(20) NOT NULL CHECK ("severity" IN ('mild', 'moderate', 'severe'))
+);
+CREATE INDEX "encounter_allergies_encounter_id_idx" ON "encounter_allergies" ("encounter_id");
+
+CREATE TABLE "encounter_conditions"(
+                                       "id" SERIAL PRIMARY KEY NOT NULL,
+                                       "encounter_id" UUID NOT NULL REFERENCES "encounters"("id") ON DELETE CASCADE,
+                                       "icd10_code" VARCHAR(8) NOT NULL,
+                                       "description" TEXT NOT NULL DEFAULT '',
+                                       "onset_date" DATE
+);
+CREATE INDEX "encounter_conditions_encounter_id_idx" ON "encounter_conditions" ("encounter_id");
+
+CREATE TABLE "encounter_procedures"(
+                                       "id" SERIAL PRIMARY KEY NOT NULL,
+                                       "encounter_id" UUID NOT NULL REFERENCES "encounters"("id") ON DELETE CASCADE,
+                                       "code" VARCHAR(20) NOT NULL,
+                                       "description" TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX "encounter_procedures_encounter_id_idx" ON "encounter_procedures" ("encounter_id");
@@ -0,0 +1,54 @@
+CREATE TABLE "medications"(
+                              "id" UUID PRIMARY KEY NOT NULL,
+                              "name" VARCHAR(200) NOT NULL,
+                              "form" VARCHAR(50) NOT NULL,
+                              "strength" VARCHAR(50) NOT NULL,
+                              "active_ingredient" VARCHAR(200) NOT NULL,
+                              "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                              "updated_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                              "deleted_at" TIMESTAMP(0) WITHOUT TIME ZONE
+);
+CREATE UNIQUE INDEX "medications_name_form_strength_idx" ON "medications" (LOWER("name"), LOWER("form"), LOWER("strength")) WHERE "deleted_at" IS NULL;
+
+-- ingredients are stored lower-cased with ingredient_a < ingredient_b, so a pair has one row
+CREATE TABLE "medication_interactions"(
+                                          "ingredient_a" VARCHAR(200) NOT NULL,
+                                          "ingredient_b" VARCHAR(200) NOT NULL,
+                                          "severity" VARCHAR(20) NOT NULL CHECK ("severity" IN ('mild', 'moderate', 'severe')),
+                                          "description" TEXT NOT NULL DEFAULT '',
+                                          PRIMARY KEY ("ingredient_a", "ingredient_b"),
+                                          CHECK ("ingredient_a" < "ingredient_b")
+);
+
+CREATE TABLE "prescriptions"(
+                                "id" UUID PRIMARY KEY NOT NULL,
+                                "appointment_id" INTEGER NOT NULL,
+                                "patient_id" UUID NOT NULL,
+                                "doctor_id" UUID NULL,
+                                "verification_code" VARCHAR(16) NOT NULL UNIQUE,
+                                "signature" VARCHAR(64) NOT NULL,
+                                "warnings" JSONB NOT NULL DEFAULT '[]',
+                                "issued_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+                                "revoked_at" TIMESTAMP(0) WITHOUT TIME ZONE,
+                                "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                CONSTRAINT "prescriptions_appointment_id_foreign" FOREIGN KEY("appointment_id") REFERENCES "booked_appointments"("id")
+);
+CREATE INDEX "prescriptions_patient_id_idx" ON "prescriptions" ("patient_id", "issued_at" DESC);
+CREATE INDEX "prescriptions_doctor_id_idx" ON "prescriptions" ("doctor_id", "issued_at" DESC);
+
+-- items keep a copy of the catalogue entry they were prescribed from
+CREATE TABLE "prescription_items"(
+                                     "id" SERIAL PRIMARY KEY NOT NULL,
+                                     "prescription_id" UUID NOT NULL REFERENCES "prescriptions"("id") ON DELETE CASCADE,
+                                     "medication_id" UUID NOT NULL REFERENCES "medications"("id"),
+                                     "medication_name" VARCHAR(200) NOT NULL,
+                                     "form" VARCHAR(50) NOT NULL,
+                                     "strength" VARCHAR(50) NOT NULL,
+                                     "active_ingredient" VARCHAR(200) NOT NULL,
+                                     "dose" VARCHAR(100) NOT NULL,
+                                     "frequency" VARCHAR(100) NOT NULL,
+                                     "duration_days" INTEGER NOT NULL CHECK ("duration_days" > 0),
+                                     "route" VARCHAR(20) NOT NULL,
+                                     "instructions" TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX "prescription_items_prescription_id_idx" ON "prescription_items" ("prescription_id");
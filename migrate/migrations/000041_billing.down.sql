@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "invoice_payments";
+DROP TABLE IF EXISTS "invoice_items";
+DROP TABLE IF EXISTS "invoices";
+DROP SEQUENCE IF EXISTS "invoice_number_seq";
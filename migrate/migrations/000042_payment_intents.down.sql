@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "payment_intents";
+
+UPDATE "invoice_payments" SET "method" = 'card' WHERE "method" = 'online';
+ALTER TABLE "invoice_payments" DROP CONSTRAINT "invoice_payments_method_check";
+ALTER TABLE "invoice_payments" ADD CONSTRAINT "invoice_payments_method_check" CHECK ("method" IN ('cash', 'card', 'insurance'));
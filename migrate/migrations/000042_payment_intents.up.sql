@@ -0,0 +1,26 @@
+ALTER TABLE "invoice_payments" DROP CONSTRAINT "invoice_payments_method_check";
+ALTER TABLE "invoice_payments" ADD CONSTRAINT "invoice_payments_method_check" CHECK ("method" IN ('cash', 'card', 'insurance', 'online'));
+
+CREATE TABLE "payment_intents"(
+                                  "id" UUID PRIMARY KEY NOT NULL,
+                                  "appointment_id" INTEGER NOT NULL REFERENCES "booked_appointments"("id"),
+                                  "patient_id" UUID NOT NULL,
+                                  "amount" NUMERIC(14, 2) NOT NULL CHECK ("amount" > 0),
+                                  "currency" VARCHAR(3) NOT NULL,
+                                  "provider" VARCHAR(20) NOT NULL,
+                                  "provider_ref" VARCHAR(255) NOT NULL DEFAULT '',
+                                  "checkout_url" TEXT NOT NULL DEFAULT '',
+                                  "status" VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK ("status" IN ('pending', 'succeeded', 'failed', 'expired', 'refund_required')),
+                                  "failure_reason" VARCHAR(255) NOT NULL DEFAULT '',
+                                  "idempotency_key" VARCHAR(255) NOT NULL,
+                                  "invoice_id" UUID NULL REFERENCES "invoices"("id"),
+                                  "expires_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+                                  "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                  "updated_at" TIMESTAMP(0) WITHOUT TIME ZONE
+);
+-- a retried request with the same key returns the first intent
+CREATE UNIQUE INDEX "payment_intents_idempotency_key_idx" ON "payment_intents" ("patient_id", "idempotency_key");
+-- one payment in flight or taken per booking
+CREATE UNIQUE INDEX "payment_intents_appointment_id_active_idx" ON "payment_intents" ("appointment_id") WHERE "status" IN ('pending', 'succeeded');
+CREATE UNIQUE INDEX "payment_intents_provider_ref_idx" ON "payment_intents" ("provider", "provider_ref") WHERE "provider_ref" <> '';
+CREATE INDEX "payment_intents_pending_idx" ON "payment_intents" ("expires_at") WHERE "status" = 'pending';
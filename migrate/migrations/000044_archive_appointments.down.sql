@@ -0,0 +1,28 @@
+-- Notes go back to the legacy archive rows of their bookings; notes on
+-- bookings that were never in the old archive cannot be expressed there and
+-- are kept in "doctor_notes_unarchived", still pointing at their booking.
+DROP INDEX IF EXISTS "doctor_notes_appointment_id_idx";
+ALTER TABLE "doctor_notes" DROP CONSTRAINT "doctor_notes_appointment_id_foreign";
+
+ALTER TABLE "doctor_notes" ADD COLUMN "archive_id" INTEGER;
+UPDATE "doctor_notes" n SET "archive_id" = (
+    SELECT l."id" FROM "archive_legacy" l WHERE l."appointment_id" = n."appointment_id" ORDER BY l."id" LIMIT 1
+);
+CREATE TABLE "doctor_notes_unarchived" AS SELECT * FROM "doctor_notes" WHERE "archive_id" IS NULL;
+DELETE FROM "doctor_notes" WHERE "archive_id" IS NULL;
+ALTER TABLE "doctor_notes_unarchived" DROP COLUMN "archive_id";
+UPDATE "doctor_notes" SET "appointment_id" = "archive_id";
+ALTER TABLE "doctor_notes" DROP COLUMN "archive_id";
+
+INSERT INTO "doctor_notes" SELECT * FROM "doctor_notes_unmatched";
+DROP TABLE "doctor_notes_unmatched";
+
+DROP TABLE "archive";
+
+ALTER TABLE "archive_legacy" DROP COLUMN "appointment_id";
+ALTER SEQUENCE "archive_legacy_id_seq" RENAME TO "archive_id_seq";
+ALTER INDEX "archive_legacy_pkey" RENAME TO "archive_pkey";
+ALTER TABLE "archive_legacy" RENAME TO "archive";
+
+ALTER TABLE "archive" ADD CONSTRAINT "archive_doctor_availability_id_foreign" FOREIGN KEY("doctor_availability_id") REFERENCES "doctor_availability"("id");
+ALTER TABLE "doctor_notes" ADD CONSTRAINT "doctor_notes_appointment_id_foreign" FOREIGN KEY("appointment_id") REFERENCES "archive"("id");
@@ -0,0 +1,2 @@
+DELETE FROM "notification_deliveries" WHERE "starts_at" IS NULL;
+ALTER TABLE "notification_deliveries" ALTER COLUMN "starts_at" SET NOT NULL;
@@ -0,0 +1,3 @@
+-- Deliveries about no appointment, like the welcome of a new patient, have
+-- no appointment time.
+ALTER TABLE "notification_deliveries" ALTER COLUMN "starts_at" DROP NOT NULL;
@@ -0,0 +1,47 @@
+-- Secure messaging between patients and the doctors they had appointments
+-- with. A conversation is about one appointment, or about none, and there is
+-- one of each kind per patient and doctor.
+CREATE TABLE "conversations"(
+                                "id" UUID PRIMARY KEY NOT NULL,
+                                "patient_id" UUID NOT NULL,
+                                "doctor_id" UUID NOT NULL,
+                                "appointment_id" INTEGER NULL,
+                                "last_message_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                CONSTRAINT "conversations_patient_id_foreign" FOREIGN KEY("patient_id") REFERENCES "patients"("id"),
+                                CONSTRAINT "conversations_appointment_id_foreign" FOREIGN KEY("appointment_id") REFERENCES "booked_appointments"("id")
+);
+CREATE UNIQUE INDEX "conversations_thread_unique" ON "conversations" ("patient_id", "doctor_id", COALESCE("appointment_id", 0));
+CREATE INDEX "conversations_doctor_id_idx" ON "conversations" ("doctor_id", "last_message_at" DESC);
+
+-- read_at is when the other side read the message. Messages older than the
+-- retention period are purged with their attachments.
+CREATE TABLE "conversation_messages"(
+                                        "id" UUID PRIMARY KEY NOT NULL,
+                                        "conversation_id" UUID NOT NULL,
+                                        "sender_id" VARCHAR(64) NOT NULL,
+                                        "sender_role" VARCHAR(10) NOT NULL CHECK ("sender_role" IN ('patient', 'doctor')),
+                                        "body" TEXT NOT NULL DEFAULT '',
+                                        "file_name" VARCHAR(255) NULL,
+                                        "content_type" VARCHAR(100) NULL,
+                                        "size_bytes" BIGINT NULL CHECK ("size_bytes" > 0),
+                                        "bucket" VARCHAR(63) NULL,
+                                        "object_key" VARCHAR(255) NULL,
+                                        "read_at" TIMESTAMP(0) WITHOUT TIME ZONE NULL,
+                                        "created_at" TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                        CONSTRAINT "conversation_messages_conversation_id_foreign" FOREIGN KEY("conversation_id") REFERENCES "conversations"("id") ON DELETE CASCADE,
+                                        CONSTRAINT "conversation_messages_content_check" CHECK ("body" <> '' OR "object_key" IS NOT NULL)
+);
+CREATE INDEX "conversation_messages_conversation_id_idx" ON "conversation_messages" ("conversation_id", "created_at" DESC);
+CREATE INDEX "conversation_messages_unread_idx" ON "conversation_messages" ("conversation_id", "sender_role") WHERE "read_at" IS NULL;
+CREATE INDEX "conversation_messages_created_at_idx" ON "conversation_messages" ("created_at");
+
+-- The weekly windows a doctor answers messages in, in the clinic's zone.
+CREATE TABLE "doctor_reply_hours"(
+                                     "doctor_id" UUID NOT NULL,
+                                     "weekday" SMALLINT NOT NULL CHECK ("weekday" BETWEEN 0 AND 6),
+                                     "starts_at" TIME(0) NOT NULL,
+                                     "ends_at" TIME(0) NOT NULL,
+                                     CONSTRAINT "doctor_reply_hours_pkey" PRIMARY KEY ("doctor_id", "weekday", "starts_at"),
+                                     CONSTRAINT "doctor_reply_hours_window_check" CHECK ("ends_at" > "starts_at")
+);
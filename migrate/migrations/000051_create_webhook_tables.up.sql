@@ -0,0 +1,63 @@
+-- Partners are labs and corporate clients. They are told about the events of
+-- the patients linked to them only, e.g. their employees.
+CREATE TABLE "webhook_partners"(
+                                   "id" UUID PRIMARY KEY NOT NULL,
+                                   "name" VARCHAR(100) NOT NULL,
+                                   "created_at" TIMESTAMPTZ(0) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE "webhook_partner_patients"(
+                                           "partner_id" UUID NOT NULL REFERENCES "webhook_partners"("id") ON DELETE CASCADE,
+                                           "patient_id" UUID NOT NULL,
+                                           "created_at" TIMESTAMPTZ(0) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                           PRIMARY KEY ("partner_id", "patient_id")
+);
+CREATE INDEX "webhook_partner_patients_patient_id_idx" ON "webhook_partner_patients" ("patient_id");
+
+-- Partner systems subscribe to domain events; the secret signs the bodies
+-- posted to them, so it is kept as is.
+CREATE TABLE "webhook_subscriptions"(
+                                        "id" UUID PRIMARY KEY NOT NULL,
+                                        "partner_id" UUID NOT NULL REFERENCES "webhook_partners"("id") ON DELETE CASCADE,
+                                        "url" TEXT NOT NULL,
+                                        "event_types" TEXT[] NOT NULL CHECK (cardinality("event_types") > 0),
+                                        "description" VARCHAR(255) NOT NULL DEFAULT '',
+                                        "secret" VARCHAR(100) NOT NULL,
+                                        "active" BOOLEAN NOT NULL DEFAULT TRUE,
+                                        "created_at" TIMESTAMPTZ(0) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                        "updated_at" TIMESTAMPTZ(0) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX "webhook_subscriptions_event_types_idx" ON "webhook_subscriptions" USING GIN ("event_types") WHERE "active";
+CREATE INDEX "webhook_subscriptions_partner_id_idx" ON "webhook_subscriptions" ("partner_id");
+
+CREATE TABLE "webhook_deliveries"(
+                                     "id" UUID PRIMARY KEY NOT NULL,
+                                     "subscription_id" UUID NOT NULL REFERENCES "webhook_subscriptions"("id") ON DELETE CASCADE,
+                                     "event_id" VARCHAR(64) NOT NULL,
+                                     "event_type" VARCHAR(100) NOT NULL,
+                                     "payload" TEXT NOT NULL,
+                                     "status" VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK ("status" IN ('pending', 'delivered', 'failed')),
+                                     "attempts" INTEGER NOT NULL DEFAULT 0,
+                                     -- of the last attempt, 0 when the partner did not answer
+                                     "response_code" INTEGER NOT NULL DEFAULT 0,
+                                     "last_error" TEXT NOT NULL DEFAULT '',
+                                     "next_attempt_at" TIMESTAMPTZ(0) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                     "delivered_at" TIMESTAMPTZ(0) NULL,
+                                     "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                     "updated_at" TIMESTAMPTZ(0) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                     CONSTRAINT "webhook_deliveries_event_unique" UNIQUE ("subscription_id", "event_id", "event_type")
+);
+CREATE INDEX "webhook_deliveries_pending_idx" ON "webhook_deliveries" ("next_attempt_at") WHERE "status" = 'pending';
+CREATE INDEX "webhook_deliveries_subscription_id_idx" ON "webhook_deliveries" ("subscription_id", "created_at" DESC);
+
+-- Every try to send a delivery, kept across redeliveries.
+CREATE TABLE "webhook_attempts"(
+                                   "id" UUID PRIMARY KEY NOT NULL,
+                                   "delivery_id" UUID NOT NULL REFERENCES "webhook_deliveries"("id") ON DELETE CASCADE,
+                                   "attempt" INTEGER NOT NULL,
+                                   "response_code" INTEGER NOT NULL DEFAULT 0,
+                                   "error" TEXT NOT NULL DEFAULT '',
+                                   "duration_ms" BIGINT NOT NULL DEFAULT 0,
+                                   "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX "webhook_attempts_delivery_id_idx" ON "webhook_attempts" ("delivery_id", "created_at");
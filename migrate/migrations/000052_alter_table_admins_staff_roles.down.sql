@@ -0,0 +1,8 @@
+-- Fails while staff accounts with these roles exist rather than dropping them.
+ALTER TYPE role_type RENAME TO role_type_staff;
+
+CREATE TYPE role_type AS ENUM('superadmin', 'admin');
+
+ALTER TABLE admins ALTER COLUMN role TYPE role_type USING role::TEXT::role_type;
+
+DROP TYPE IF EXISTS role_type_staff;
@@ -0,0 +1,3 @@
+-- The branch a reception account works at; the gateway streams the
+-- appointments of that branch to it.
+ALTER TABLE admins ADD COLUMN IF NOT EXISTS branch_id UUID;
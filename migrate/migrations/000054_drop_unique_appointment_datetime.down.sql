@@ -0,0 +1,2 @@
+ALTER TABLE booked_appointments
+ADD CONSTRAINT unique_appointment_datetime UNIQUE (appointment_date, appointment_time);
@@ -0,0 +1,3 @@
+-- Appointments of different doctors share dates and times; a doctor's
+-- overlapping appointments are rejected by the booking service instead.
+ALTER TABLE booked_appointments DROP CONSTRAINT IF EXISTS unique_appointment_datetime;